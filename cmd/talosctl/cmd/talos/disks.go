@@ -6,20 +6,25 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/cosi-project/runtime/pkg/safe"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
 var disksCmdFlags struct {
 	insecure bool
+	health   bool
 }
 
 var disksCmd = &cobra.Command{
@@ -27,11 +32,17 @@ var disksCmd = &cobra.Command{
 	Short: "Get the list of disks from /sys/block on the machine",
 	Long:  ``,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		printFn := printDisks
+
+		if disksCmdFlags.health {
+			printFn = printDisksHealth
+		}
+
 		if disksCmdFlags.insecure {
-			return WithClientMaintenance(nil, printDisks)
+			return WithClientMaintenance(nil, printFn)
 		}
 
-		return WithClient(printDisks)
+		return WithClient(printFn)
 	},
 }
 
@@ -136,7 +147,59 @@ func printDisks(ctx context.Context, c *client.Client) error {
 	return w.Flush()
 }
 
+func printDisksHealth(ctx context.Context, c *client.Client) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tDEV\tPROTOCOL\tHEALTHY\tTEMPERATURE\tPERCENTAGE USED\tMEDIA ERRORS\tREALLOCATED SECTORS\tPOWER ON HOURS")
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	nodes := md.Get("nodes")
+
+	if len(nodes) == 0 {
+		nodes = []string{""}
+	}
+
+	var errs error
+
+	for _, node := range nodes {
+		nodeCtx := ctx
+
+		if node != "" {
+			nodeCtx = client.WithNode(ctx, node)
+		}
+
+		disksHealth, err := safe.StateListAll[*block.DiskHealth](nodeCtx, c.COSI)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error getting disk health for node %q: %w", node, err))
+
+			continue
+		}
+
+		for diskHealth := range disksHealth.All() {
+			spec := diskHealth.TypedSpec()
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%d°C\t%d%%\t%d\t%d\t%d\n",
+				node,
+				spec.DevPath,
+				spec.Protocol,
+				spec.Healthy,
+				spec.Temperature,
+				spec.PercentageUsed,
+				spec.MediaErrors,
+				spec.ReallocatedSectors,
+				spec.PowerOnHours,
+			)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return errs
+}
+
 func init() {
 	disksCmd.Flags().BoolVarP(&disksCmdFlags.insecure, "insecure", "i", false, "get disks using the insecure (encrypted with no auth) maintenance service")
+	disksCmd.Flags().BoolVar(&disksCmdFlags.health, "health", false, "show SMART/NVMe health information of the disks")
 	addCommand(disksCmd)
 }
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var keySlotCmd = &cobra.Command{
+	Use:   "keyslot",
+	Short: "Manage the key slots of the encrypted volumes",
+	Long: `Add, remove and rotate LUKS2 key slots of the encrypted volumes (e.g. STATE or EPHEMERAL) without wiping them.
+
+The keys are taken from the volume encryption configuration, so the machine configuration should be updated first:
+the key slot should be added to the configuration before adding it to the volume,
+and removed from the configuration before removing it from the volume.
+
+The current key slot layout is reported in the VolumeStatus resource.`,
+	Args: cobra.NoArgs,
+}
+
+func keySlotRunE(operation machine.EncryptionKeySlotRequest_Operation) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		slot, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("error parsing key slot: %w", err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.EncryptionKeySlot(ctx, &machine.EncryptionKeySlotRequest{
+				VolumeId:  args[0],
+				Operation: operation,
+				Slot:      int32(slot),
+			}, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error managing key slot: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tVOLUME\tKEY SLOTS")
+
+			defaultNode := client.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\n",
+					node,
+					args[0],
+					strings.Join(xslices.Map(msg.Slots, func(slot int32) string { return strconv.Itoa(int(slot)) }), ","),
+				)
+			}
+
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
+			return helpers.CheckErrors(resp.Messages...)
+		})
+	}
+}
+
+var keySlotAddCmd = &cobra.Command{
+	Use:   "add <volume> <slot>",
+	Short: "Add the configured key to the key slot of the encrypted volume",
+	Long:  ``,
+	Args:  cobra.ExactArgs(2),
+	RunE:  keySlotRunE(machine.EncryptionKeySlotRequest_ADD),
+}
+
+var keySlotRemoveCmd = &cobra.Command{
+	Use:   "remove <volume> <slot>",
+	Short: "Remove the key slot which is no longer configured from the encrypted volume",
+	Long:  ``,
+	Args:  cobra.ExactArgs(2),
+	RunE:  keySlotRunE(machine.EncryptionKeySlotRequest_REMOVE),
+}
+
+var keySlotRotateCmd = &cobra.Command{
+	Use:   "rotate <volume> <slot>",
+	Short: "Replace the key in the key slot of the encrypted volume with a newly generated one",
+	Long:  ``,
+	Args:  cobra.ExactArgs(2),
+	RunE:  keySlotRunE(machine.EncryptionKeySlotRequest_ROTATE),
+}
+
+func init() {
+	keySlotCmd.AddCommand(keySlotAddCmd)
+	keySlotCmd.AddCommand(keySlotRemoveCmd)
+	keySlotCmd.AddCommand(keySlotRotateCmd)
+	addCommand(keySlotCmd)
+}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var wipeMethodOptions = map[string]storage.BlockDeviceWipeDescriptor_Method{
+	wipeMethodFast:        storage.BlockDeviceWipeDescriptor_FAST,
+	wipeMethodZeroes:      storage.BlockDeviceWipeDescriptor_ZEROES,
+	wipeMethodDiscard:     storage.BlockDeviceWipeDescriptor_DISCARD,
+	wipeMethodCryptoErase: storage.BlockDeviceWipeDescriptor_CRYPTO_ERASE,
+}
+
+// WipeMethod is the block device wipe method.
+type WipeMethod storage.BlockDeviceWipeDescriptor_Method
+
+const (
+	wipeMethodFast        = "fast"
+	wipeMethodZeroes      = "zeroes"
+	wipeMethodDiscard     = "discard"
+	wipeMethodCryptoErase = "crypto-erase"
+)
+
+func (m WipeMethod) String() string {
+	for name, method := range wipeMethodOptions {
+		if method == storage.BlockDeviceWipeDescriptor_Method(m) {
+			return name
+		}
+	}
+
+	return wipeMethodFast
+}
+
+// Set implements Flag interface.
+func (m *WipeMethod) Set(value string) error {
+	method, ok := wipeMethodOptions[value]
+	if !ok {
+		return fmt.Errorf("possible options are: %s", m.Type())
+	}
+
+	*m = WipeMethod(method)
+
+	return nil
+}
+
+// Type implements Flag interface.
+func (m *WipeMethod) Type() string {
+	options := maps.Keys(wipeMethodOptions)
+	sort.Strings(options)
+
+	return strings.Join(options, ", ")
+}
+
+var wipeCmd = &cobra.Command{
+	Use:   "wipe",
+	Short: "Wipe block device or volumes",
+	Args:  cobra.NoArgs,
+}
+
+var wipeDiskCmdFlags struct {
+	method WipeMethod
+}
+
+var wipeDiskCmd = &cobra.Command{
+	Use:   "disk <device names>...",
+	Short: "Wipe a block device (disk or partition) which is not used as a volume",
+	Long: `Wipe a block device (disk or partition) which is not used as a volume.
+
+The device is refused to be wiped if it is the system disk, or if it (or any of its partitions) backs a volume,
+including RAID array members and LVM physical volumes.
+
+Wipe methods:
+
+  - fast: wipe the partition table and filesystem signatures, and discard the rest of the device (if supported)
+  - zeroes: overwrite the whole device with zeroes
+  - discard: discard (TRIM) all blocks of the device
+  - crypto-erase: cryptographically erase the whole NVMe drive by changing the media encryption key`,
+	Example: `  talosctl wipe disk sdb --method zeroes`,
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.BlockDeviceWipe(ctx, &storage.BlockDeviceWipeRequest{
+				Devices: xslices.Map(args, func(device string) *storage.BlockDeviceWipeDescriptor {
+					return &storage.BlockDeviceWipeDescriptor{
+						Device: device,
+						Method: storage.BlockDeviceWipeDescriptor_Method(wipeDiskCmdFlags.method),
+					}
+				}),
+			})
+			if err != nil {
+				return fmt.Errorf("error wiping devices: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(msg *storage.BlockDeviceWipeProgress, node string, multipleNodes bool) error {
+				prefix := msg.Device
+
+				if multipleNodes {
+					prefix = node + ": " + prefix
+				}
+
+				if msg.Done {
+					fmt.Fprintf(os.Stderr, "%s: wiped %s with method %s\n", prefix, humanize.Bytes(msg.TotalBytes), WipeMethod(msg.Method))
+
+					return nil
+				}
+
+				var percentage float64
+
+				if msg.TotalBytes > 0 {
+					percentage = float64(msg.WipedBytes) * 100 / float64(msg.TotalBytes)
+				}
+
+				fmt.Fprintf(os.Stderr, "%s: wiped %s of %s (%.1f%%)\n", prefix, humanize.Bytes(msg.WipedBytes), humanize.Bytes(msg.TotalBytes), percentage)
+
+				return nil
+			})
+		})
+	},
+}
+
+func init() {
+	wipeDiskCmd.Flags().Var(&wipeDiskCmdFlags.method, "method", "wipe method to use")
+
+	wipeCmd.AddCommand(wipeDiskCmd)
+	addCommand(wipeCmd)
+}
@@ -450,6 +450,8 @@ func formatBasicTypeName(typPkg string, typ string) (importPath, fullName string
 		return "", "uint32"
 	case typeData{"github.com/siderolabs/talos/pkg/machinery/nethelpers", "RouteFlags"}:
 		return "", "uint32"
+	case typeData{"github.com/siderolabs/talos/pkg/machinery/nethelpers", "WOLModes"}:
+		return "", "uint32"
 	default:
 		return "", ""
 	}
@@ -136,6 +136,7 @@ func apidMain() error {
 		"/machine.MachineService/Read",
 		"/os.OSService/Dmesg",
 		"/cluster.ClusterService/HealthCheck",
+		"/storage.StorageService/BlockDeviceWipe",
 	} {
 		router.RegisterStreamedRegex("^" + regexp.QuoteMeta(methodName) + "$")
 	}
@@ -0,0 +1,206 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	blockdev "github.com/siderolabs/go-blockdevice/v2/block"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/pkg/logging"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+const encryptionKeySlotTimeout = time.Minute
+
+// EncryptionKeySlot implements the machine.MachineServer interface.
+//
+//nolint:gocyclo,cyclop
+func (s *Server) EncryptionKeySlot(ctx context.Context, req *machine.EncryptionKeySlotRequest) (*machine.EncryptionKeySlotResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, encryptionKeySlotTimeout)
+	defer cancel()
+
+	if req.Slot < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "key slot must be non-negative")
+	}
+
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	volumeStatus, err := safe.StateGetByID[*block.VolumeStatus](ctx, st, req.VolumeId)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %q not found", req.VolumeId)
+		}
+
+		return nil, err
+	}
+
+	if volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %q is not encrypted", req.VolumeId)
+	}
+
+	if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume %q is not ready", req.VolumeId)
+	}
+
+	volumeConfig, err := safe.StateGetByID[*block.VolumeConfig](ctx, st, req.VolumeId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching volume configuration: %w", err)
+	}
+
+	systemInformation, err := safe.StateGetByID[*hardware.SystemInformation](ctx, st, hardware.SystemInformationID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error fetching system information: %w", err)
+	}
+
+	getSystemInformation := func(context.Context) (*hardware.SystemInformation, error) {
+		if systemInformation != nil {
+			return systemInformation, nil
+		}
+
+		return nil, errors.New("system information not available")
+	}
+
+	apiCerts, err := safe.StateGetByID[*secrets.API](ctx, st, secrets.APIID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error fetching API certificates: %w", err)
+	}
+
+	getNodeIdentity := func(context.Context) (*x509.PEMEncodedCertificateAndKey, error) {
+		if apiCerts != nil && apiCerts.TypedSpec().NodeIdentity != nil {
+			return apiCerts.TypedSpec().NodeIdentity, nil
+		}
+
+		return nil, errors.New("node identity not available")
+	}
+
+	handler, err := encryption.NewHandler(volumeConfig.TypedSpec().Encryption, req.VolumeId, getSystemInformation, getNodeIdentity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption handler: %w", err)
+	}
+
+	// lock either the parent device or the device itself, as the volume manager does
+	devPath := volumeStatus.TypedSpec().ParentLocation
+	if devPath == "" {
+		devPath = volumeStatus.TypedSpec().Location
+	}
+
+	dev, err := blockdev.NewFromPath(devPath, blockdev.OpenForWrite())
+	if err != nil {
+		return nil, fmt.Errorf("error opening disk: %w", err)
+	}
+
+	defer dev.Close() //nolint:errcheck
+
+	if err = dev.RetryLockWithTimeout(ctx, true, 10*time.Second); err != nil {
+		return nil, fmt.Errorf("error locking disk: %w", err)
+	}
+
+	defer dev.Unlock() //nolint:errcheck
+
+	logger := logging.Wrap(log.Writer()).With(zap.String("volume", req.VolumeId))
+	location := volumeStatus.TypedSpec().Location
+
+	switch req.Operation {
+	case machine.EncryptionKeySlotRequest_ADD:
+		err = handler.AddKeySlot(ctx, logger, location, int(req.Slot))
+	case machine.EncryptionKeySlotRequest_REMOVE:
+		err = handler.RemoveKeySlot(ctx, logger, location, int(req.Slot))
+	case machine.EncryptionKeySlotRequest_ROTATE:
+		err = handler.RotateKeySlot(ctx, logger, location, int(req.Slot))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported operation %s", req.Operation)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error managing key slot %d of volume %q: %s", req.Slot, req.VolumeId, err)
+	}
+
+	keySlots, err := encryption.ReadKeySlots(location, volumeConfig.TypedSpec().Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key slots: %w", err)
+	}
+
+	if err = requestKeySlotRefresh(ctx, st, req.VolumeId); err != nil {
+		return nil, err
+	}
+
+	return &machine.EncryptionKeySlotResponse{
+		Messages: []*machine.EncryptionKeySlot{
+			{
+				Slots: xslices.Map(keySlots, func(keySlot block.EncryptionKeySlotStatus) int32 { return int32(keySlot.Slot) }),
+			},
+		},
+	}, nil
+}
+
+// requestKeySlotRefresh makes the volume manager refresh the key slots in the volume status.
+//
+// The volume manager acknowledges the handled request with a finalizer, and the request is destroyed afterwards.
+func requestKeySlotRefresh(ctx context.Context, st state.State, volumeID string) error {
+	refreshRequest := block.NewKeySlotRefreshRequest(block.NamespaceName, volumeID)
+	refreshRequest.TypedSpec().Request = 1
+
+	// the request might be left over from the interrupted call
+	if err := destroyKeySlotRefreshRequest(ctx, st, refreshRequest.Metadata()); err != nil {
+		return err
+	}
+
+	if err := st.Create(ctx, refreshRequest); err != nil {
+		return fmt.Errorf("error requesting key slot refresh: %w", err)
+	}
+
+	if _, err := st.WatchFor(ctx, refreshRequest.Metadata(), state.WithCondition(func(r resource.Resource) (bool, error) {
+		return !r.Metadata().Finalizers().Empty(), nil
+	})); err != nil {
+		return fmt.Errorf("error waiting for key slot refresh: %w", err)
+	}
+
+	return destroyKeySlotRefreshRequest(ctx, st, refreshRequest.Metadata())
+}
+
+// destroyKeySlotRefreshRequest tears down the key slot refresh request and destroys it once the finalizers are removed.
+func destroyKeySlotRefreshRequest(ctx context.Context, st state.State, md *resource.Metadata) error {
+	for {
+		ready, err := st.Teardown(ctx, md)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return fmt.Errorf("error tearing down key slot refresh request: %w", err)
+		}
+
+		if ready {
+			break
+		}
+
+		if _, err = st.WatchFor(ctx, md, state.WithFinalizerEmpty()); err != nil {
+			return fmt.Errorf("error waiting for key slot refresh request finalizers: %w", err)
+		}
+	}
+
+	if err := st.Destroy(ctx, md); err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error destroying key slot refresh request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// GENEVESpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive,golint
+func GENEVESpec(r *network.GENEVESpec) geneveSpec {
+	return geneveSpec{
+		GENEVESpec: r,
+	}
+}
+
+type geneveSpec struct {
+	*network.GENEVESpec
+}
+
+// Encode the GENEVESpec into netlink attributes.
+func (a geneveSpec) Encode() ([]byte, error) {
+	geneve := a.GENEVESpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_GENEVE_ID, geneve.VNI)
+
+	if geneve.Remote.Is4() {
+		encoder.Bytes(unix.IFLA_GENEVE_REMOTE, geneve.Remote.AsSlice())
+	} else if geneve.Remote.Is6() {
+		encoder.Bytes(unix.IFLA_GENEVE_REMOTE6, geneve.Remote.AsSlice())
+	}
+
+	encoder.Bytes(unix.IFLA_GENEVE_PORT, binary.BigEndian.AppendUint16(nil, geneve.Port))
+
+	return encoder.Encode()
+}
+
+// Decode the GENEVESpec from netlink attributes.
+func (a geneveSpec) Decode(data []byte) error {
+	geneve := a.GENEVESpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_GENEVE_ID:
+			geneve.VNI = decoder.Uint32()
+		case unix.IFLA_GENEVE_REMOTE, unix.IFLA_GENEVE_REMOTE6:
+			geneve.Remote, _ = netip.AddrFromSlice(decoder.Bytes())
+		case unix.IFLA_GENEVE_PORT:
+			geneve.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		}
+	}
+
+	return decoder.Err()
+}
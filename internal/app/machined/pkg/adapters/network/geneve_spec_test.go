@@ -0,0 +1,32 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestGENEVESpec(t *testing.T) {
+	spec := network.GENEVESpec{
+		VNI:    42,
+		Remote: netip.MustParseAddr("2001:db8::1"),
+		Port:   6081,
+	}
+
+	b, err := networkadapter.GENEVESpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decodedSpec network.GENEVESpec
+
+	require.NoError(t, networkadapter.GENEVESpec(&decodedSpec).Decode(b))
+
+	require.Equal(t, spec, decodedSpec)
+}
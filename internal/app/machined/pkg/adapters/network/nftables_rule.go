@@ -656,6 +656,101 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 		)
 	}
 
+	if a.NfTablesRule.Masquerade {
+		rulePost = append(rulePost,
+			// [ masq ]
+			&expr.Masq{},
+		)
+	}
+
+	nat := func(natType expr.NATType, target *network.NfTablesNATTarget) bool {
+		family := uint32(unix.NFPROTO_IPV4)
+
+		// the translation is only possible within the address family of the target,
+		// so restrict the rule to that family
+		if target.Address.Is4() {
+			if rule4 == nil && rule6 != nil {
+				return false
+			}
+
+			if rule4 == nil {
+				rule4 = []expr.Any{}
+			}
+
+			rule6 = nil
+		} else {
+			family = unix.NFPROTO_IPV6
+
+			if rule6 == nil && rule4 != nil {
+				return false
+			}
+
+			if rule6 == nil {
+				rule6 = []expr.Any{}
+			}
+
+			rule4 = nil
+		}
+
+		natExpr := &expr.NAT{
+			Type:       natType,
+			Family:     family,
+			RegAddrMin: 1,
+		}
+
+		rulePost = append(rulePost,
+			// [ immediate reg 1 <address> ]
+			&expr.Immediate{
+				Register: 1,
+				Data:     target.Address.AsSlice(),
+			},
+		)
+
+		if target.Port != 0 {
+			natExpr.RegProtoMin = 2
+
+			rulePost = append(rulePost,
+				// [ immediate reg 2 <port> ]
+				&expr.Immediate{
+					Register: 2,
+					Data:     binaryutil.BigEndian.PutUint16(target.Port),
+				},
+			)
+		}
+
+		rulePost = append(rulePost,
+			// [ nat snat|dnat ip|ip6 addr_min reg 1 [proto_min reg 2] ]
+			natExpr,
+		)
+
+		return true
+	}
+
+	if a.NfTablesRule.SNAT != nil {
+		if !nat(expr.NATTypeSourceNAT, a.NfTablesRule.SNAT) {
+			// this rule doesn't match anything
+			return &NfTablesCompiled{}, nil
+		}
+	}
+
+	if a.NfTablesRule.DNAT != nil {
+		if !nat(expr.NATTypeDestNAT, a.NfTablesRule.DNAT) {
+			// this rule doesn't match anything
+			return &NfTablesCompiled{}, nil
+		}
+	}
+
+	if a.NfTablesRule.Log != nil {
+		rulePost = append(rulePost,
+			// [ log prefix <prefix> group <group> ]
+			&expr.Log{
+				Key:   1<<unix.NFTA_LOG_GROUP | 1<<unix.NFTA_LOG_PREFIX,
+				Group: a.NfTablesRule.Log.Group,
+				Data:  []byte(a.NfTablesRule.Log.Prefix),
+			},
+		)
+	}
+
 	if a.NfTablesRule.Verdict != nil {
 		rulePost = append(rulePost,
 			// [ verdict accept|drop ]
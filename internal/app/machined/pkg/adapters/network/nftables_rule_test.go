@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go4.org/netipx"
+	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -703,6 +704,142 @@ func TestNfTablesRuleCompile(t *testing.T) { //nolint:tparallel
 				},
 			},
 		},
+		{
+			name: "masquerade",
+			spec: networkres.NfTablesRule{
+				MatchOIfName: &networkres.NfTablesIfNameMatch{
+					InterfaceNames: []string{"eth0"},
+					Operator:       nethelpers.OperatorEqual,
+				},
+				Masquerade: true,
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte("eth0\000\000\000\000\000\000\000\000\000\000\000\000"),
+					},
+					&expr.Masq{},
+				},
+			},
+		},
+		{
+			name: "log limit",
+			spec: networkres.NfTablesRule{
+				MatchLimit: &networkres.NfTablesLimitMatch{
+					PacketRatePerSecond: 5,
+				},
+				Log: &networkres.NfTablesLog{
+					Prefix: "ingress",
+					Group:  100,
+				},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Limit{
+						Type:  expr.LimitTypePkts,
+						Rate:  5,
+						Burst: 5,
+						Unit:  expr.LimitTimeSecond,
+					},
+					&expr.Log{
+						Key:   1<<unix.NFTA_LOG_GROUP | 1<<unix.NFTA_LOG_PREFIX,
+						Group: 100,
+						Data:  []byte("ingress"),
+					},
+				},
+			},
+		},
+		{
+			name: "snat",
+			spec: networkres.NfTablesRule{
+				SNAT: &networkres.NfTablesNATTarget{
+					Address: netip.MustParseAddr("192.168.1.1"),
+				},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv4)},
+					},
+					&expr.Immediate{
+						Register: 1,
+						Data:     []byte{192, 168, 1, 1},
+					},
+					&expr.NAT{
+						Type:       expr.NATTypeSourceNAT,
+						Family:     unix.NFPROTO_IPV4,
+						RegAddrMin: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "dnat with port",
+			spec: networkres.NfTablesRule{
+				MatchDestinationAddress: &networkres.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("0.0.0.0/0"),
+						netip.MustParsePrefix("::/0"),
+					},
+				},
+				MatchLayer4: &networkres.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolTCP,
+				},
+				DNAT: &networkres.NfTablesNATTarget{
+					Address: netip.MustParseAddr("fd00::1"),
+					Port:    8080,
+				},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nethelpers.ProtocolTCP)},
+					},
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv6)},
+					},
+					&expr.Immediate{
+						Register: 1,
+						Data:     netip.MustParseAddr("fd00::1").AsSlice(),
+					},
+					&expr.Immediate{
+						Register: 2,
+						Data:     []byte{0x1f, 0x90},
+					},
+					&expr.NAT{
+						Type:        expr.NATTypeDestNAT,
+						Family:      unix.NFPROTO_IPV6,
+						RegAddrMin:  1,
+						RegProtoMin: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "snat family mismatch",
+			spec: networkres.NfTablesRule{
+				MatchSourceAddress: &networkres.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("fd00::/64"),
+					},
+				},
+				SNAT: &networkres.NfTablesNATTarget{
+					Address: netip.MustParseAddr("192.168.1.1"),
+				},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			result, err := network.NfTablesRule(&test.spec).Compile()
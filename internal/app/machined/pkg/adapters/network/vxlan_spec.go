@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// VXLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive,golint
+func VXLANSpec(r *network.VXLANSpec) vxlanSpec {
+	return vxlanSpec{
+		VXLANSpec: r,
+	}
+}
+
+type vxlanSpec struct {
+	*network.VXLANSpec
+}
+
+// Encode the VXLANSpec into netlink attributes.
+//
+// VXLAN ignores the link index of the interface, so the index of the underlying
+// link (if any) is passed as linkIndex.
+func (a vxlanSpec) Encode(linkIndex uint32) ([]byte, error) {
+	vxlan := a.VXLANSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_VXLAN_ID, vxlan.VNI)
+
+	if vxlan.Remote.Is4() {
+		encoder.Bytes(unix.IFLA_VXLAN_GROUP, vxlan.Remote.AsSlice())
+	} else if vxlan.Remote.Is6() {
+		encoder.Bytes(unix.IFLA_VXLAN_GROUP6, vxlan.Remote.AsSlice())
+	}
+
+	if vxlan.Local.Is4() {
+		encoder.Bytes(unix.IFLA_VXLAN_LOCAL, vxlan.Local.AsSlice())
+	} else if vxlan.Local.Is6() {
+		encoder.Bytes(unix.IFLA_VXLAN_LOCAL6, vxlan.Local.AsSlice())
+	}
+
+	if linkIndex != 0 {
+		encoder.Uint32(unix.IFLA_VXLAN_LINK, linkIndex)
+	}
+
+	encoder.Bytes(unix.IFLA_VXLAN_PORT, binary.BigEndian.AppendUint16(nil, vxlan.Port))
+
+	return encoder.Encode()
+}
+
+// Decode the VXLANSpec from netlink attributes.
+func (a vxlanSpec) Decode(data []byte) error {
+	vxlan := a.VXLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_VXLAN_ID:
+			vxlan.VNI = decoder.Uint32()
+		case unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6:
+			vxlan.Remote, _ = netip.AddrFromSlice(decoder.Bytes())
+		case unix.IFLA_VXLAN_LOCAL, unix.IFLA_VXLAN_LOCAL6:
+			vxlan.Local, _ = netip.AddrFromSlice(decoder.Bytes())
+		case unix.IFLA_VXLAN_PORT:
+			vxlan.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		}
+	}
+
+	return decoder.Err()
+}
@@ -17,9 +17,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/inotify"
-	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/kobject"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/sysblock"
 	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/kobject"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -19,6 +19,7 @@ import (
 	"github.com/siderolabs/go-blockdevice/v2/partitioning"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/mdraid"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
@@ -205,6 +206,12 @@ func (ctrl *DiscoveryController) rescan(ctx context.Context, r controller.Runtim
 
 		logger.Debug("probed device", zap.String("id", id), zap.Any("info", info))
 
+		// md arrays report the degraded/resync state via sysfs
+		raidStatus, err := mdraid.ReadStatus("/sys/block", id)
+		if err != nil {
+			logger.Debug("failed to read md array status", zap.String("id", id), zap.Error(err))
+		}
+
 		if err = safe.WriterModify(ctx, r, block.NewDiscoveredVolume(block.NamespaceName, id), func(dv *block.DiscoveredVolume) error {
 			dv.TypedSpec().DevPath = filepath.Join("/dev", id)
 			dv.TypedSpec().Type = device.TypedSpec().Type
@@ -218,6 +225,7 @@ func (ctrl *DiscoveryController) rescan(ctx context.Context, r controller.Runtim
 			dv.TypedSpec().SetSize(info.Size)
 			dv.TypedSpec().SectorSize = info.SectorSize
 			dv.TypedSpec().IOSize = info.IOSize
+			dv.TypedSpec().RAID = raidStatus
 
 			ctrl.fillDiscoveredVolumeFromInfo(dv, info.ProbeResult)
 
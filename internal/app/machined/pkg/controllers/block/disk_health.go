@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/smart"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// DiskHealthController reads SMART/NVMe health information of the disks.
+type DiskHealthController struct {
+	// ReadHealth reads the health information of the disk, defaults to smart.Read.
+	ReadHealth func(devPath string, protocol smart.Protocol) (*smart.Health, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DiskHealthController) Name() string {
+	return "block.DiskHealthController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DiskHealthController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.DiskType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DiskHealthController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.DiskHealthType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+const diskHealthRefreshInterval = 5 * time.Minute
+
+// Run implements controller.Controller interface.
+func (ctrl *DiskHealthController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.ReadHealth == nil {
+		ctrl.ReadHealth = smart.Read
+	}
+
+	// health logs are refreshed periodically
+	ticker := time.NewTicker(diskHealthRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		disks, err := safe.ReaderListAll[*block.Disk](ctx, r)
+		if err != nil {
+			return fmt.Errorf("failed to list disks: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for disk := range disks.All() {
+			if disk.TypedSpec().CDROM || disk.TypedSpec().Size == 0 {
+				continue
+			}
+
+			protocol, err := smart.ProtocolForTransport(disk.TypedSpec().Transport)
+			if err != nil {
+				continue
+			}
+
+			health, err := ctrl.ReadHealth(disk.TypedSpec().DevPath, protocol)
+			if err != nil {
+				if errors.Is(err, smart.ErrNotSupported) {
+					continue
+				}
+
+				logger.Warn("failed to read disk health", zap.String("disk", disk.Metadata().ID()), zap.Error(err))
+
+				// keep the last known disk health on transient errors
+				if err = ctrl.keepDiskHealth(ctx, r, disk.Metadata().ID()); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, block.NewDiskHealth(block.NamespaceName, disk.Metadata().ID()), func(h *block.DiskHealth) error {
+				h.TypedSpec().DevPath = disk.TypedSpec().DevPath
+				h.TypedSpec().Protocol = string(health.Protocol)
+				h.TypedSpec().Healthy = health.Healthy
+				h.TypedSpec().CriticalWarning = uint32(health.CriticalWarning)
+				h.TypedSpec().Temperature = int32(health.Temperature)
+				h.TypedSpec().PercentageUsed = uint32(health.PercentageUsed)
+				h.TypedSpec().MediaErrors = health.MediaErrors
+				h.TypedSpec().ReallocatedSectors = health.ReallocatedSectors
+				h.TypedSpec().PowerOnHours = health.PowerOnHours
+				h.TypedSpec().PowerCycles = health.PowerCycles
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating disk health: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*block.DiskHealth](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up disk health: %w", err)
+		}
+	}
+}
+
+// keepDiskHealth touches the existing disk health, so that it is not cleaned up.
+func (ctrl *DiskHealthController) keepDiskHealth(ctx context.Context, r controller.Runtime, id string) error {
+	_, err := safe.ReaderGetByID[*block.DiskHealth](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error reading disk health: %w", err)
+	}
+
+	if err = safe.WriterModify(ctx, r, block.NewDiskHealth(block.NamespaceName, id), func(*block.DiskHealth) error {
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating disk health: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/pkg/smart"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+type DiskHealthSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestDiskHealthSuite(t *testing.T) {
+	t.Parallel()
+
+	var sdcReads atomic.Int32
+
+	suite.Run(t, &DiskHealthSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 3 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.DiskHealthController{
+					ReadHealth: func(devPath string, protocol smart.Protocol) (*smart.Health, error) {
+						switch devPath {
+						case "/dev/nvme0n1":
+							return &smart.Health{
+								Protocol:       protocol,
+								Healthy:        true,
+								Temperature:    38,
+								PercentageUsed: 4,
+								PowerOnHours:   1200,
+							}, nil
+						case "/dev/sda":
+							return &smart.Health{
+								Protocol:           protocol,
+								Healthy:            false,
+								Temperature:        41,
+								ReallocatedSectors: 16,
+							}, nil
+						case "/dev/sdc":
+							// the first read succeeds, and the following reads fail
+							if sdcReads.Add(1) > 1 {
+								return nil, errors.New("input/output error")
+							}
+
+							return &smart.Health{
+								Protocol:    protocol,
+								Healthy:     true,
+								Temperature: 35,
+							}, nil
+						default:
+							return nil, errors.New("permission denied")
+						}
+					},
+				}))
+			},
+		},
+	})
+}
+
+func (suite *DiskHealthSuite) createDisk(id, transport string) *block.Disk {
+	disk := block.NewDisk(block.NamespaceName, id)
+	disk.TypedSpec().DevPath = "/dev/" + id
+	disk.TypedSpec().Transport = transport
+	disk.TypedSpec().SetSize(1024 * 1024 * 1024)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), disk))
+
+	return disk
+}
+
+func (suite *DiskHealthSuite) TestReconcile() {
+	suite.createDisk("nvme0n1", "nvme")
+	sda := suite.createDisk("sda", "sata")
+	suite.createDisk("sdb", "sata")
+	suite.createDisk("vda", "virtio")
+
+	ctest.AssertResource(suite, "nvme0n1", func(r *block.DiskHealth, asrt *assert.Assertions) {
+		asrt.Equal("/dev/nvme0n1", r.TypedSpec().DevPath)
+		asrt.Equal("nvme", r.TypedSpec().Protocol)
+		asrt.True(r.TypedSpec().Healthy)
+		asrt.EqualValues(38, r.TypedSpec().Temperature)
+		asrt.EqualValues(4, r.TypedSpec().PercentageUsed)
+		asrt.EqualValues(1200, r.TypedSpec().PowerOnHours)
+	})
+
+	ctest.AssertResource(suite, "sda", func(r *block.DiskHealth, asrt *assert.Assertions) {
+		asrt.Equal("ata", r.TypedSpec().Protocol)
+		asrt.False(r.TypedSpec().Healthy)
+		asrt.EqualValues(16, r.TypedSpec().ReallocatedSectors)
+	})
+
+	ctest.AssertNoResource[*block.DiskHealth](suite, "sdb")
+	ctest.AssertNoResource[*block.DiskHealth](suite, "vda")
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), sda.Metadata()))
+
+	ctest.AssertNoResource[*block.DiskHealth](suite, "sda")
+}
+
+func (suite *DiskHealthSuite) TestTransientError() {
+	suite.createDisk("sdc", "sata")
+
+	ctest.AssertResource(suite, "sdc", func(r *block.DiskHealth, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Healthy)
+		asrt.EqualValues(35, r.TypedSpec().Temperature)
+	})
+
+	// trigger another reconcile, the disk health read of sdc fails now
+	suite.createDisk("nvme0n1", "nvme")
+
+	ctest.AssertResource(suite, "nvme0n1", func(r *block.DiskHealth, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Healthy)
+	})
+
+	// the last known disk health is kept
+	ctest.AssertResource(suite, "sdc", func(r *block.DiskHealth, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Healthy)
+		asrt.EqualValues(35, r.TypedSpec().Temperature)
+	})
+}
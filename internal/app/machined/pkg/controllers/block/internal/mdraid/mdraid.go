@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package mdraid implements gathering software RAID (md) array information from /sys/block filesystem and mdadm.
+package mdraid
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// ReadStatus reads the md array status from /sys/block/<device>/md.
+//
+// If the device is not an md array, nil is returned.
+func ReadStatus(root, device string) (*block.RAIDStatus, error) {
+	path := filepath.Join(root, device, "md")
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to stat %q: %w", path, err)
+	}
+
+	var (
+		status block.RAIDStatus
+		err    error
+	)
+
+	if status.Level, err = readAttribute(path, "level"); err != nil {
+		return nil, err
+	}
+
+	if status.ArrayState, err = readAttribute(path, "array_state"); err != nil {
+		return nil, err
+	}
+
+	if status.Devices, err = readIntAttribute(path, "raid_disks"); err != nil {
+		return nil, err
+	}
+
+	// degraded and sync_* attributes are only present for redundant arrays
+	if status.DegradedDevices, err = readIntAttribute(path, "degraded"); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if status.SyncAction, err = readAttribute(path, "sync_action"); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	syncCompleted, err := readAttribute(path, "sync_completed")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	status.SyncProgress = parseSyncCompleted(syncCompleted)
+
+	members, err := os.ReadDir(filepath.Join(root, device, "slaves"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read members of %q: %w", device, err)
+	}
+
+	for _, member := range members {
+		status.Members = append(status.Members, filepath.Join("/dev", member.Name()))
+	}
+
+	return &status, nil
+}
+
+func readAttribute(path, name string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(path, name))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+func readIntAttribute(path, name string) (int, error) {
+	value, err := readAttribute(path, name)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %q: %w", name, err)
+	}
+
+	return v, nil
+}
+
+// parseSyncCompleted parses the sync_completed attribute ("<done> / <total>" in sectors, or "none").
+func parseSyncCompleted(value string) float64 {
+	done, total, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0
+	}
+
+	doneSectors, err := strconv.ParseUint(strings.TrimSpace(done), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	totalSectors, err := strconv.ParseUint(strings.TrimSpace(total), 10, 64)
+	if err != nil || totalSectors == 0 {
+		return 0
+	}
+
+	return float64(doneSectors) * 100 / float64(totalSectors)
+}
+
+// Detail is the md array information as reported by `mdadm --detail --export`.
+type Detail struct {
+	Name    string
+	Level   string
+	UUID    string
+	Members []string
+}
+
+// ParseDetail parses the output of `mdadm --detail --export`.
+func ParseDetail(output string) Detail {
+	var detail Detail
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok {
+			continue
+		}
+
+		switch {
+		case key == "MD_NAME":
+			// the name is prefixed with the homehost, e.g. "any:EPHEMERAL"
+			if _, name, found := strings.Cut(value, ":"); found {
+				value = name
+			}
+
+			detail.Name = value
+		case key == "MD_LEVEL":
+			detail.Level = value
+		case key == "MD_UUID":
+			detail.UUID = value
+		case strings.HasPrefix(key, "MD_DEVICE_") && strings.HasSuffix(key, "_DEV"):
+			detail.Members = append(detail.Members, value)
+		}
+	}
+
+	slices.Sort(detail.Members)
+
+	return detail
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mdraid_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/mdraid"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+func TestReadStatus(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	for path, contents := range map[string]string{
+		"md127/md/level":          "raid1\n",
+		"md127/md/array_state":    "clean\n",
+		"md127/md/raid_disks":     "2\n",
+		"md127/md/degraded":       "1\n",
+		"md127/md/sync_action":    "recover\n",
+		"md127/md/sync_completed": "262144 / 1048576\n",
+		"md127/slaves/sdb/dev":    "8:16\n",
+		"sda/size":                "1048576\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(contents), 0o644))
+	}
+
+	status, err := mdraid.ReadStatus(root, "md127")
+	require.NoError(t, err)
+
+	assert.Equal(t, &block.RAIDStatus{
+		Level:           "raid1",
+		ArrayState:      "clean",
+		Devices:         2,
+		Members:         []string{"/dev/sdb"},
+		DegradedDevices: 1,
+		SyncAction:      "recover",
+		SyncProgress:    25,
+	}, status)
+
+	status, err = mdraid.ReadStatus(root, "sda")
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestParseDetail(t *testing.T) {
+	t.Parallel()
+
+	detail := mdraid.ParseDetail(`MD_LEVEL=raid10
+MD_DEVICES=4
+MD_METADATA=1.2
+MD_UUID=5b4b2f4a:7c9e2b1d:a3f5e6c7:d8e9f0a1
+MD_DEVNAME=EPHEMERAL
+MD_NAME=any:EPHEMERAL
+MD_DEVICE_dev_nvme1n1_ROLE=1
+MD_DEVICE_dev_nvme1n1_DEV=/dev/nvme1n1
+MD_DEVICE_dev_nvme0n1_ROLE=0
+MD_DEVICE_dev_nvme0n1_DEV=/dev/nvme0n1
+`)
+
+	assert.Equal(t, mdraid.Detail{
+		Name:    "EPHEMERAL",
+		Level:   "raid10",
+		UUID:    "5b4b2f4a:7c9e2b1d:a3f5e6c7:d8e9f0a1",
+		Members: []string{"/dev/nvme0n1", "/dev/nvme1n1"},
+	}, detail)
+}
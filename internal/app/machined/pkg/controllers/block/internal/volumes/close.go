@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xerrors"
 	"go.uber.org/zap"
 
@@ -27,6 +28,14 @@ func Close(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext
 		return nil, fmt.Errorf("system information not available")
 	}
 
+	getNodeIdentity := func(ctx context.Context) (*x509.PEMEncodedCertificateAndKey, error) {
+		if volumeContext.NodeIdentity != nil {
+			return volumeContext.NodeIdentity, nil
+		}
+
+		return nil, fmt.Errorf("node identity not available")
+	}
+
 	switch volumeContext.Cfg.TypedSpec().Encryption.Provider {
 	case block.EncryptionProviderNone:
 		// nothing to do
@@ -36,7 +45,7 @@ func Close(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext
 	case block.EncryptionProviderLUKS2:
 		encryptionConfig := volumeContext.Cfg.TypedSpec().Encryption
 
-		handler, err := encryption.NewHandler(encryptionConfig, volumeContext.Cfg.Metadata().ID(), getSystemInformation)
+		handler, err := encryption.NewHandler(encryptionConfig, volumeContext.Cfg.Metadata().ID(), getSystemInformation, getNodeIdentity)
 		if err != nil {
 			return fmt.Errorf("failed to create encryption handler: %w", err)
 		}
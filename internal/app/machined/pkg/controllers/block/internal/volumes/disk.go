@@ -38,6 +38,12 @@ func CheckDiskForProvisioning(logger *zap.Logger, diskPath string, volumeCfg *bl
 			CanProvision: info.Name == "",
 			DiskSize:     info.Size,
 		}
+	case block.VolumeTypeRAID:
+		// the md array takes the whole disk, so the disk should be empty
+		return CheckDiskResult{
+			CanProvision: info.Name == "" && info.Size >= raidMemberMinSize(volumeCfg.TypedSpec().Provisioning),
+			DiskSize:     info.Size,
+		}
 	case block.VolumeTypePartition:
 		if info.Name == "" {
 			// if the disk is not partitioned, it can be used for partitioning, but we need to check the size
@@ -112,6 +112,57 @@ func TestCheckDiskForProvisioning(t *testing.T) {
 				DiskSize:     1 << 24,
 			},
 		},
+		{
+			name: "empty disk for raid10",
+
+			diskSetup: func(t *testing.T) string {
+				return prepareRawImage(t, 1<<20)
+			},
+			volumeConfig: block.VolumeConfigSpec{
+				Type: block.VolumeTypeRAID,
+				Provisioning: block.ProvisioningSpec{
+					PartitionSpec: block.PartitionSpec{
+						MinSize: 1 << 21,
+					},
+					RAIDSpec: block.RAIDSpec{
+						Level:   block.RAIDLevel10,
+						Devices: 4,
+					},
+				},
+			},
+
+			expected: volumes.CheckDiskResult{
+				CanProvision: true,
+				DiskSize:     1 << 20,
+			},
+		},
+		{
+			name: "GPT disk for raid1",
+
+			diskSetup: func(t *testing.T) string {
+				disk := prepareRawImage(t, 1<<24)
+
+				prepareGPT(t, disk)
+
+				return disk
+			},
+			volumeConfig: block.VolumeConfigSpec{
+				Type: block.VolumeTypeRAID,
+				Provisioning: block.ProvisioningSpec{
+					PartitionSpec: block.PartitionSpec{
+						MinSize: 1 << 20,
+					},
+					RAIDSpec: block.RAIDSpec{
+						Level:   block.RAIDLevel1,
+						Devices: 2,
+					},
+				},
+			},
+
+			expected: volumes.CheckDiskResult{
+				DiskSize: 1 << 24,
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			diskPath := test.diskSetup(t)
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/go-blockdevice/v2/blkid"
 	blockdev "github.com/siderolabs/go-blockdevice/v2/block"
@@ -30,6 +31,14 @@ func HandleEncryption(ctx context.Context, logger *zap.Logger, volumeContext Man
 		return nil, fmt.Errorf("system information not available")
 	}
 
+	getNodeIdentity := func(ctx context.Context) (*x509.PEMEncodedCertificateAndKey, error) {
+		if volumeContext.NodeIdentity != nil {
+			return volumeContext.NodeIdentity, nil
+		}
+
+		return nil, fmt.Errorf("node identity not available")
+	}
+
 	switch volumeContext.Cfg.TypedSpec().Encryption.Provider {
 	case block.EncryptionProviderNone:
 		// nothing to do
@@ -41,7 +50,7 @@ func HandleEncryption(ctx context.Context, logger *zap.Logger, volumeContext Man
 	case block.EncryptionProviderLUKS2:
 		encryptionConfig := volumeContext.Cfg.TypedSpec().Encryption
 
-		handler, err := encryption.NewHandler(encryptionConfig, volumeContext.Cfg.Metadata().ID(), getSystemInformation)
+		handler, err := encryption.NewHandler(encryptionConfig, volumeContext.Cfg.Metadata().ID(), getSystemInformation, getNodeIdentity)
 		if err != nil {
 			return fmt.Errorf("failed to create encryption handler: %w", err)
 		}
@@ -107,9 +116,15 @@ func HandleEncryptionWithHandler(ctx context.Context, logger *zap.Logger, volume
 		return xerrors.NewTaggedf[Retryable]("error opening encrypted volume: %w", err)
 	}
 
+	keySlots, err := encryption.ReadKeySlots(volumeContext.Status.Location, volumeContext.Cfg.TypedSpec().Encryption)
+	if err != nil {
+		return xerrors.NewTaggedf[Retryable]("error reading encryption key slots: %w", err)
+	}
+
 	volumeContext.Status.Phase = block.VolumePhasePrepared
 	volumeContext.Status.MountLocation = encryptedPath
 	volumeContext.Status.EncryptionProvider = volumeContext.Cfg.TypedSpec().Encryption.Provider
+	volumeContext.Status.EncryptionKeySlots = keySlots
 
 	return nil
 }
@@ -111,6 +111,40 @@ func Format(ctx context.Context, logger *zap.Logger, volumeContext ManagerContex
 		if err = makefs.XFS(volumeContext.Status.MountLocation, makefsOptions...); err != nil {
 			return fmt.Errorf("error formatting XFS: %w", err)
 		}
+	case block.FilesystemTypeEXT4:
+		var makefsOptions []makefs.Option
+
+		if volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label != "" {
+			makefsOptions = append(makefsOptions, makefs.WithLabel(volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label))
+		}
+
+		if ext4Spec := volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.EXT4; ext4Spec != nil {
+			makefsOptions = append(makefsOptions, makefs.WithReservedBlocksPercentage(ext4Spec.ReservedBlocksPercentage))
+		}
+
+		if err = makefs.Ext4(volumeContext.Status.MountLocation, makefsOptions...); err != nil {
+			return fmt.Errorf("error formatting ext4: %w", err)
+		}
+	case block.FilesystemTypeBTRFS:
+		var makefsOptions []makefs.Option
+
+		if volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label != "" {
+			makefsOptions = append(makefsOptions, makefs.WithLabel(volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label))
+		}
+
+		if err = makefs.Btrfs(volumeContext.Status.MountLocation, makefsOptions...); err != nil {
+			return fmt.Errorf("error formatting btrfs: %w", err)
+		}
+	case block.FilesystemTypeSwap:
+		var makefsOptions []makefs.Option
+
+		if volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label != "" {
+			makefsOptions = append(makefsOptions, makefs.WithLabel(volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Label))
+		}
+
+		if err = makefs.Swap(volumeContext.Status.MountLocation, makefsOptions...); err != nil {
+			return fmt.Errorf("error formatting swap: %w", err)
+		}
 	default:
 		return fmt.Errorf("unsupported filesystem type: %s", volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type)
 	}
@@ -126,26 +160,41 @@ func GrowFilesystem(logger *zap.Logger, volumeContext ManagerContext) error {
 	switch volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type { //nolint:exhaustive
 	case block.FilesystemTypeXFS:
 		// XFS requires partition to be mounted to grow
-		tmpDir, err := os.MkdirTemp("", "talos-growfs-")
-		if err != nil {
-			return fmt.Errorf("error creating temporary directory: %w", err)
-		}
+		return growMounted(logger, volumeContext, func(mountpoint string) error {
+			logger.Info("growing XFS filesystem", zap.String("device", volumeContext.Status.MountLocation))
 
-		defer os.Remove(tmpDir) //nolint:errcheck
+			if err := makefs.XFSGrow(mountpoint); err != nil {
+				return fmt.Errorf("error growing XFS: %w", err)
+			}
+
+			return nil
+		})
+	case block.FilesystemTypeBTRFS:
+		// btrfs requires partition to be mounted to grow
+		return growMounted(logger, volumeContext, func(mountpoint string) error {
+			logger.Info("growing btrfs filesystem", zap.String("device", volumeContext.Status.MountLocation))
 
-		mountpoint := mountv2.NewPoint(volumeContext.Status.MountLocation, tmpDir, volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type.String())
+			if err := makefs.BtrfsGrow(mountpoint); err != nil {
+				return fmt.Errorf("error growing btrfs: %w", err)
+			}
 
-		unmounter, err := mountpoint.Mount(mountv2.WithMountPrinter(logger.Sugar().Infof))
+			return nil
+		})
+	case block.FilesystemTypeEXT4:
+		// ext4 is grown offline, resize2fs requires a freshly checked filesystem
+		modified, err := makefs.Ext4Repair(volumeContext.Status.MountLocation, makefs.FilesystemTypeEXT4)
 		if err != nil {
-			return fmt.Errorf("error mounting partition: %w", err)
+			return fmt.Errorf("error checking ext4: %w", err)
 		}
 
-		defer unmounter() //nolint:errcheck
+		if modified {
+			logger.Info("ext4 filesystem errors were corrected", zap.String("device", volumeContext.Status.MountLocation))
+		}
 
-		logger.Info("growing XFS filesystem", zap.String("device", volumeContext.Status.MountLocation))
+		logger.Info("growing ext4 filesystem", zap.String("device", volumeContext.Status.MountLocation))
 
-		if err = makefs.XFSGrow(tmpDir); err != nil {
-			return fmt.Errorf("error growing XFS: %w", err)
+		if err := makefs.Ext4Resize(volumeContext.Status.MountLocation); err != nil {
+			return fmt.Errorf("error growing ext4: %w", err)
 		}
 
 		return nil
@@ -153,3 +202,24 @@ func GrowFilesystem(logger *zap.Logger, volumeContext ManagerContext) error {
 		return fmt.Errorf("unsupported filesystem type to grow: %s", volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type)
 	}
 }
+
+// growMounted mounts the filesystem to a temporary location and calls the grow function.
+func growMounted(logger *zap.Logger, volumeContext ManagerContext, grow func(mountpoint string) error) error {
+	tmpDir, err := os.MkdirTemp("", "talos-growfs-")
+	if err != nil {
+		return fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	defer os.Remove(tmpDir) //nolint:errcheck
+
+	mountpoint := mountv2.NewPoint(volumeContext.Status.MountLocation, tmpDir, volumeContext.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type.String())
+
+	unmounter, err := mountpoint.Mount(mountv2.WithMountPrinter(logger.Sugar().Infof))
+	if err != nil {
+		return fmt.Errorf("error mounting partition: %w", err)
+	}
+
+	defer unmounter() //nolint:errcheck
+
+	return grow(tmpDir)
+}
@@ -21,6 +21,10 @@ import (
 //
 //nolint:gocyclo
 func Grow(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext) error {
+	if volumeContext.Cfg.TypedSpec().Type == block.VolumeTypeLVM {
+		return GrowLVM(ctx, logger, volumeContext)
+	}
+
 	if !(volumeContext.Cfg.TypedSpec().Type == block.VolumeTypePartition && volumeContext.Cfg.TypedSpec().Provisioning.PartitionSpec.Grow) {
 		// nothing to do
 		volumeContext.Status.Phase = block.VolumePhaseProvisioned
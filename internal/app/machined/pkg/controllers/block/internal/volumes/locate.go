@@ -33,6 +33,14 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, volumeContext M
 		return nil
 	}
 
+	if volumeType == block.VolumeTypeLVM {
+		return LocateAndProvisionLVM(ctx, logger, volumeContext)
+	}
+
+	if volumeType == block.VolumeTypeRAID {
+		return LocateAndProvisionRAID(ctx, logger, volumeContext)
+	}
+
 	// below for partition/disk volumes:
 	if value.IsZero(volumeContext.Cfg.TypedSpec().Locator) {
 		return fmt.Errorf("volume locator is not set")
@@ -84,29 +92,9 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, volumeContext M
 	}
 
 	// locate the disk(s) for the volume
-	var matchedDisks []string
-
-	for _, diskCtx := range volumeContext.Disks {
-		if diskCtx.Disk.Readonly {
-			// skip readonly disks, they can't be provisioned either way
-			continue
-		}
-
-		matches, err := volumeContext.Cfg.TypedSpec().Provisioning.DiskSelector.Match.EvalBool(celenv.DiskLocator(), map[string]any{
-			"disk":        diskCtx.Disk,
-			"system_disk": diskCtx.SystemDisk,
-		})
-		if err != nil {
-			return fmt.Errorf("error evaluating disk locator: %w", err)
-		}
-
-		if matches {
-			matchedDisks = append(matchedDisks, diskCtx.Disk.DevPath)
-		}
-	}
-
-	if len(matchedDisks) == 0 {
-		return fmt.Errorf("no disks matched for volume")
+	matchedDisks, err := matchDisks(volumeContext)
+	if err != nil {
+		return err
 	}
 
 	logger.Debug("matched disks", zap.Strings("disks", matchedDisks))
@@ -158,3 +146,33 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, volumeContext M
 
 	return nil
 }
+
+// matchDisks returns the list of disks matching the disk selector of the volume.
+func matchDisks(volumeContext ManagerContext) ([]string, error) {
+	var matchedDisks []string
+
+	for _, diskCtx := range volumeContext.Disks {
+		if diskCtx.Disk.Readonly {
+			// skip readonly disks, they can't be provisioned either way
+			continue
+		}
+
+		matches, err := volumeContext.Cfg.TypedSpec().Provisioning.DiskSelector.Match.EvalBool(celenv.DiskLocator(), map[string]any{
+			"disk":        diskCtx.Disk,
+			"system_disk": diskCtx.SystemDisk,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating disk locator: %w", err)
+		}
+
+		if matches {
+			matchedDisks = append(matchedDisks, diskCtx.Disk.DevPath)
+		}
+	}
+
+	if len(matchedDisks) == 0 {
+		return nil, fmt.Errorf("no disks matched for volume")
+	}
+
+	return matchedDisks, nil
+}
@@ -0,0 +1,527 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volumes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/go-blockdevice/v2/blkid"
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+const lvmBinary = "/sbin/lvm"
+
+const (
+	// lvmPVOverhead is the space of the disk which is not available for the logical volumes
+	// (LVM metadata area and rounding to the extent size).
+	lvmPVOverhead = 8 * 1024 * 1024
+
+	// thin pool metadata size limits enforced by LVM.
+	minThinPoolMetadataSize = 4 * 1024 * 1024
+	maxThinPoolMetadataSize = 15 * 1024 * 1024 * 1024
+)
+
+// LVMPhysicalVolume describes an LVM physical volume as reported by `lvm pvs`.
+type LVMPhysicalVolume struct {
+	Name        string `json:"pv_name"`
+	VolumeGroup string `json:"vg_name"`
+}
+
+// LVMVolumeGroup describes an LVM volume group as reported by `lvm vgs`.
+type LVMVolumeGroup struct {
+	Name string `json:"vg_name"`
+	Size string `json:"vg_size"`
+	Free string `json:"vg_free"`
+}
+
+// LVMLogicalVolume describes an LVM logical volume as reported by `lvm lvs`.
+type LVMLogicalVolume struct {
+	Name        string `json:"lv_name"`
+	VolumeGroup string `json:"vg_name"`
+	Size        string `json:"lv_size"`
+	DMPath      string `json:"lv_dm_path"`
+	Pool        string `json:"pool_lv"`
+}
+
+// lvmReport is the JSON report format of the LVM reporting commands.
+type lvmReport struct {
+	Report []struct {
+		PV []LVMPhysicalVolume `json:"pv"`
+		VG []LVMVolumeGroup    `json:"vg"`
+		LV []LVMLogicalVolume  `json:"lv"`
+	} `json:"report"`
+}
+
+// ParseLVMReport parses the JSON output of `lvm pvs/vgs/lvs --reportformat json`.
+func ParseLVMReport(output string) ([]LVMPhysicalVolume, []LVMVolumeGroup, []LVMLogicalVolume, error) {
+	var report lvmReport
+
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		return nil, nil, nil, fmt.Errorf("error parsing LVM report: %w", err)
+	}
+
+	var (
+		pvs []LVMPhysicalVolume
+		vgs []LVMVolumeGroup
+		lvs []LVMLogicalVolume
+	)
+
+	for _, r := range report.Report {
+		pvs = append(pvs, r.PV...)
+		vgs = append(vgs, r.VG...)
+		lvs = append(lvs, r.LV...)
+	}
+
+	return pvs, vgs, lvs, nil
+}
+
+func parseLVMSize(size string) uint64 {
+	// sizes are reported in bytes (--units b --nosuffix), so parsing error means the value is empty
+	v, _ := strconv.ParseUint(size, 10, 64) //nolint:errcheck
+
+	return v
+}
+
+func lvmReportCommand(ctx context.Context, command string, fields string, args ...string) (string, error) {
+	return cmd.RunContext(ctx, lvmBinary, append([]string{
+		command,
+		"--reportformat", "json",
+		"--units", "b",
+		"--nosuffix",
+		"-o", fields,
+	}, args...)...)
+}
+
+type lvmState struct {
+	pvs []LVMPhysicalVolume
+	vg  *LVMVolumeGroup
+	lvs []LVMLogicalVolume
+}
+
+func (s lvmState) findLV(name string) *LVMLogicalVolume {
+	for i := range s.lvs {
+		if s.lvs[i].Name == name {
+			return &s.lvs[i]
+		}
+	}
+
+	return nil
+}
+
+func (s lvmState) vgPVs(vgName string) []string {
+	var result []string
+
+	for _, pv := range s.pvs {
+		if pv.VolumeGroup == vgName {
+			result = append(result, pv.Name)
+		}
+	}
+
+	return result
+}
+
+func (s lvmState) findPV(devPath string) *LVMPhysicalVolume {
+	for i := range s.pvs {
+		if s.pvs[i].Name == devPath {
+			return &s.pvs[i]
+		}
+	}
+
+	return nil
+}
+
+func readLVMState(ctx context.Context, vgName string) (lvmState, error) {
+	var state lvmState
+
+	out, err := lvmReportCommand(ctx, "pvs", "pv_name,vg_name")
+	if err != nil {
+		return state, fmt.Errorf("error listing LVM physical volumes: %w", err)
+	}
+
+	if state.pvs, _, _, err = ParseLVMReport(out); err != nil {
+		return state, err
+	}
+
+	if len(state.vgPVs(vgName)) == 0 {
+		// volume group doesn't exist
+		return state, nil
+	}
+
+	out, err = lvmReportCommand(ctx, "vgs", "vg_name,vg_size,vg_free", vgName)
+	if err != nil {
+		return state, fmt.Errorf("error listing LVM volume group %q: %w", vgName, err)
+	}
+
+	_, vgs, _, err := ParseLVMReport(out)
+	if err != nil {
+		return state, err
+	}
+
+	if len(vgs) > 0 {
+		state.vg = &vgs[0]
+	}
+
+	out, err = lvmReportCommand(ctx, "lvs", "lv_name,vg_name,lv_size,lv_dm_path,pool_lv", vgName)
+	if err != nil {
+		return state, fmt.Errorf("error listing LVM logical volumes in %q: %w", vgName, err)
+	}
+
+	_, _, state.lvs, err = ParseLVMReport(out)
+
+	return state, err
+}
+
+// LVMDisk describes an empty disk which can be added to the LVM volume group.
+type LVMDisk struct {
+	DevPath string
+	Size    uint64
+}
+
+// LVMPlan describes the changes to the volume group required to provision the logical volume.
+type LVMPlan struct {
+	// Disks to be added to the volume group.
+	NewPhysicalVolumes []string
+
+	// Size of the thin pool (and its metadata) to be created, zero if no thin pool should be created.
+	ThinPoolSize         uint64
+	ThinPoolMetadataSize uint64
+}
+
+// PlanLVM calculates the changes to the volume group required to provision the logical volume.
+//
+// The volume group (vg is nil if it doesn't exist yet) is extended with as few disks as needed
+// to fit the requested size of the volume: maxSize if set, minSize otherwise.
+// If the thin pool doesn't exist yet, it is sized from the thin pool size or the max size of the volume.
+func PlanLVM(provisioning block.ProvisioningSpec, vg *LVMVolumeGroup, lvs []LVMLogicalVolume, candidates []LVMDisk) (LVMPlan, error) {
+	var (
+		plan                   LVMPlan
+		minSize, requestedSize uint64
+	)
+
+	lvmSpec := provisioning.LVMSpec
+
+	switch {
+	case lvmSpec.ThinPool != "" && slices.ContainsFunc(lvs, func(lv LVMLogicalVolume) bool { return lv.Name == lvmSpec.ThinPool }):
+		// thin volume is created in the existing pool, no space required in the volume group
+	case lvmSpec.ThinPool != "":
+		plan.ThinPoolSize = lvmSpec.ThinPoolSize
+		if plan.ThinPoolSize == 0 {
+			plan.ThinPoolSize = provisioning.PartitionSpec.MaxSize
+		}
+
+		plan.ThinPoolMetadataSize = min(max(plan.ThinPoolSize/1000, minThinPoolMetadataSize), maxThinPoolMetadataSize)
+
+		// LVM keeps a spare copy of the pool metadata
+		minSize = plan.ThinPoolSize + 2*plan.ThinPoolMetadataSize
+		requestedSize = minSize
+	default:
+		minSize = provisioning.PartitionSpec.MinSize
+		requestedSize = provisioning.PartitionSpec.MaxSize
+
+		if requestedSize == 0 {
+			requestedSize = minSize
+		}
+	}
+
+	var vgFree uint64
+
+	if vg != nil {
+		vgFree = parseLVMSize(vg.Free)
+	}
+
+	for _, candidate := range candidates {
+		// volume group should have at least one disk
+		if (vg != nil || len(plan.NewPhysicalVolumes) > 0) && vgFree >= requestedSize {
+			break
+		}
+
+		if candidate.Size <= lvmPVOverhead {
+			continue
+		}
+
+		plan.NewPhysicalVolumes = append(plan.NewPhysicalVolumes, candidate.DevPath)
+		vgFree += candidate.Size - lvmPVOverhead
+	}
+
+	switch {
+	case vg == nil && len(plan.NewPhysicalVolumes) == 0:
+		return LVMPlan{}, xerrors.NewTaggedf[Retryable]("no disks available for volume group %q", lvmSpec.VolumeGroup)
+	case vgFree < minSize:
+		return LVMPlan{}, xerrors.NewTaggedf[Retryable]("not enough free space for volume group %q: %d < %d", lvmSpec.VolumeGroup, vgFree, minSize)
+	}
+
+	return plan, nil
+}
+
+// LocateAndProvisionLVM locates and provisions an LVM logical volume.
+//
+// The volume group is created on (or extended to) the empty disks matched by the disk selector
+// as needed to fit the requested size, and the logical volume is created in the volume group
+// if it doesn't exist yet.
+//
+//nolint:gocyclo,cyclop
+func LocateAndProvisionLVM(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext) error {
+	lvmSpec := volumeContext.Cfg.TypedSpec().Provisioning.LVMSpec
+
+	if lvmSpec.VolumeGroup == "" || lvmSpec.LogicalVolume == "" {
+		return fmt.Errorf("LVM volume group and logical volume names are required")
+	}
+
+	if !volumeContext.DevicesReady {
+		// LVM volumes can only be located once all devices are discovered
+		volumeContext.Status.Phase = block.VolumePhaseWaiting
+
+		return nil
+	}
+
+	state, err := readLVMState(ctx, lvmSpec.VolumeGroup)
+	if err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	if lv := state.findLV(lvmSpec.LogicalVolume); lv != nil {
+		volumeContext.Status.Phase = block.VolumePhaseLocated
+		setLVMStatus(volumeContext, state, lv)
+
+		return nil
+	}
+
+	if !volumeContext.PreviousWaveProvisioned {
+		// previous wave is not provisioned yet
+		volumeContext.Status.Phase = block.VolumePhaseWaiting
+
+		return nil
+	}
+
+	matchedDisks, err := matchDisks(volumeContext)
+	if err != nil {
+		return err
+	}
+
+	diskSizes := make(map[string]uint64, len(volumeContext.Disks))
+
+	for _, diskCtx := range volumeContext.Disks {
+		diskSizes[diskCtx.Disk.DevPath] = diskCtx.Disk.Size
+	}
+
+	// pick the disks which are not yet part of the volume group, and are empty
+	var candidates []LVMDisk
+
+	for _, matchedDisk := range matchedDisks {
+		if pv := state.findPV(matchedDisk); pv != nil {
+			if pv.VolumeGroup != lvmSpec.VolumeGroup {
+				logger.Debug("skipping disk which is a member of another volume group", zap.String("disk", matchedDisk), zap.String("vg", pv.VolumeGroup))
+			}
+
+			continue
+		}
+
+		info, err := blkid.ProbePath(matchedDisk)
+		if err != nil {
+			logger.Error("error probing disk", zap.String("disk", matchedDisk), zap.Error(err))
+
+			continue
+		}
+
+		if info.Name != "" {
+			// disk is not empty
+			continue
+		}
+
+		candidates = append(candidates, LVMDisk{DevPath: matchedDisk, Size: diskSizes[matchedDisk]})
+	}
+
+	plan, err := PlanLVM(volumeContext.Cfg.TypedSpec().Provisioning, state.vg, state.lvs, candidates)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case state.vg == nil:
+		logger.Info("creating LVM volume group", zap.String("vg", lvmSpec.VolumeGroup), zap.Strings("disks", plan.NewPhysicalVolumes))
+
+		if _, err = cmd.RunContext(ctx, lvmBinary, append([]string{"vgcreate", "-y", lvmSpec.VolumeGroup}, plan.NewPhysicalVolumes...)...); err != nil {
+			return fmt.Errorf("error creating volume group %q: %w", lvmSpec.VolumeGroup, err)
+		}
+	case len(plan.NewPhysicalVolumes) > 0:
+		logger.Info("extending LVM volume group", zap.String("vg", lvmSpec.VolumeGroup), zap.Strings("disks", plan.NewPhysicalVolumes))
+
+		if _, err = cmd.RunContext(ctx, lvmBinary, append([]string{"vgextend", "-y", lvmSpec.VolumeGroup}, plan.NewPhysicalVolumes...)...); err != nil {
+			return fmt.Errorf("error extending volume group %q: %w", lvmSpec.VolumeGroup, err)
+		}
+	}
+
+	if state, err = readLVMState(ctx, lvmSpec.VolumeGroup); err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	if state.vg == nil {
+		return fmt.Errorf("volume group %q not found after provisioning", lvmSpec.VolumeGroup)
+	}
+
+	if err = createLV(ctx, logger, volumeContext.Cfg.TypedSpec().Provisioning, plan, state); err != nil {
+		return err
+	}
+
+	if state, err = readLVMState(ctx, lvmSpec.VolumeGroup); err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	lv := state.findLV(lvmSpec.LogicalVolume)
+	if lv == nil {
+		return fmt.Errorf("logical volume %q not found after provisioning", lvmSpec.LogicalVolume)
+	}
+
+	volumeContext.Status.Phase = block.VolumePhaseProvisioned
+	setLVMStatus(volumeContext, state, lv)
+
+	return nil
+}
+
+func createLV(ctx context.Context, logger *zap.Logger, provisioning block.ProvisioningSpec, plan LVMPlan, state lvmState) error {
+	lvmSpec := provisioning.LVMSpec
+	vgFree := parseLVMSize(state.vg.Free)
+
+	if lvmSpec.ThinPool != "" {
+		if state.findLV(lvmSpec.ThinPool) == nil {
+			logger.Info("creating LVM thin pool", zap.String("vg", lvmSpec.VolumeGroup), zap.String("pool", lvmSpec.ThinPool), zap.Uint64("size", plan.ThinPoolSize))
+
+			if _, err := cmd.RunContext(ctx, lvmBinary,
+				"lvcreate", "-y",
+				"--type", "thin-pool",
+				"-L", strconv.FormatUint(plan.ThinPoolSize, 10)+"b",
+				"--poolmetadatasize", strconv.FormatUint(plan.ThinPoolMetadataSize, 10)+"b",
+				"-n", lvmSpec.ThinPool,
+				lvmSpec.VolumeGroup,
+			); err != nil {
+				return fmt.Errorf("error creating thin pool %q: %w", lvmSpec.ThinPool, err)
+			}
+		}
+
+		logger.Info("creating LVM thin logical volume", zap.String("vg", lvmSpec.VolumeGroup), zap.String("lv", lvmSpec.LogicalVolume))
+
+		if _, err := cmd.RunContext(ctx, lvmBinary,
+			"lvcreate", "-y",
+			"--type", "thin",
+			"-V", strconv.FormatUint(provisioning.PartitionSpec.MaxSize, 10)+"b",
+			"--thinpool", lvmSpec.ThinPool,
+			"-n", lvmSpec.LogicalVolume,
+			lvmSpec.VolumeGroup,
+		); err != nil {
+			return fmt.Errorf("error creating thin logical volume %q: %w", lvmSpec.LogicalVolume, err)
+		}
+
+		return nil
+	}
+
+	if vgFree < provisioning.PartitionSpec.MinSize {
+		return xerrors.NewTaggedf[Retryable]("not enough free space in volume group %q: %d < %d", lvmSpec.VolumeGroup, vgFree, provisioning.PartitionSpec.MinSize)
+	}
+
+	sizeArgs := []string{"-l", "100%FREE"}
+
+	if provisioning.PartitionSpec.MaxSize > 0 && provisioning.PartitionSpec.MaxSize < vgFree {
+		sizeArgs = []string{"-L", strconv.FormatUint(provisioning.PartitionSpec.MaxSize, 10) + "b"}
+	}
+
+	logger.Info("creating LVM logical volume", zap.String("vg", lvmSpec.VolumeGroup), zap.String("lv", lvmSpec.LogicalVolume), zap.Strings("size", sizeArgs))
+
+	args := slices.Concat([]string{"lvcreate", "-y", "--wipesignatures", "y"}, sizeArgs, []string{"-n", lvmSpec.LogicalVolume, lvmSpec.VolumeGroup})
+
+	if _, err := cmd.RunContext(ctx, lvmBinary, args...); err != nil {
+		return fmt.Errorf("error creating logical volume %q: %w", lvmSpec.LogicalVolume, err)
+	}
+
+	return nil
+}
+
+// GrowLVM extends the LVM logical volume to the free space of the volume group.
+func GrowLVM(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext) error {
+	provisioning := volumeContext.Cfg.TypedSpec().Provisioning
+
+	if !provisioning.PartitionSpec.Grow || provisioning.LVMSpec.ThinPool != "" {
+		// nothing to do, thin volumes have fixed virtual size
+		volumeContext.Status.Phase = block.VolumePhaseProvisioned
+
+		return nil
+	}
+
+	if provisioning.PartitionSpec.MaxSize > 0 && volumeContext.Status.Size >= provisioning.PartitionSpec.MaxSize {
+		// nowhere to grow
+		volumeContext.Status.Phase = block.VolumePhaseProvisioned
+
+		return nil
+	}
+
+	state, err := readLVMState(ctx, provisioning.LVMSpec.VolumeGroup)
+	if err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	if state.vg == nil {
+		return fmt.Errorf("volume group %q not found", provisioning.LVMSpec.VolumeGroup)
+	}
+
+	availableGrowth := parseLVMSize(state.vg.Free)
+
+	if availableGrowth <= 1024*1024 { // don't grow by less than 1MiB
+		volumeContext.Status.Phase = block.VolumePhaseProvisioned
+
+		return nil
+	}
+
+	if provisioning.PartitionSpec.MaxSize > 0 && availableGrowth > provisioning.PartitionSpec.MaxSize-volumeContext.Status.Size {
+		availableGrowth = provisioning.PartitionSpec.MaxSize - volumeContext.Status.Size
+	}
+
+	logger.Debug("growing logical volume", zap.String("vg", provisioning.LVMSpec.VolumeGroup), zap.String("lv", provisioning.LVMSpec.LogicalVolume), zap.Uint64("size", availableGrowth))
+
+	if _, err = cmd.RunContext(ctx, lvmBinary,
+		"lvextend",
+		"-L", "+"+strconv.FormatUint(availableGrowth, 10)+"b",
+		provisioning.LVMSpec.VolumeGroup+"/"+provisioning.LVMSpec.LogicalVolume,
+	); err != nil {
+		return fmt.Errorf("error growing logical volume: %w", err)
+	}
+
+	if state, err = readLVMState(ctx, provisioning.LVMSpec.VolumeGroup); err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	volumeContext.Status.Phase = block.VolumePhaseProvisioned
+
+	if lv := state.findLV(provisioning.LVMSpec.LogicalVolume); lv != nil {
+		setLVMStatus(volumeContext, state, lv)
+	}
+
+	return nil
+}
+
+func setLVMStatus(volumeContext ManagerContext, state lvmState, lv *LVMLogicalVolume) {
+	volumeContext.Status.Location = lv.DMPath
+	volumeContext.Status.ParentLocation = ""
+	volumeContext.Status.PartitionIndex = 0
+	volumeContext.Status.SetSize(parseLVMSize(lv.Size))
+
+	volumeContext.Status.LVM = &block.LVMStatus{
+		VolumeGroup:     lv.VolumeGroup,
+		LogicalVolume:   lv.Name,
+		ThinPool:        lv.Pool,
+		PhysicalVolumes: state.vgPVs(lv.VolumeGroup),
+	}
+
+	if state.vg != nil {
+		volumeContext.Status.LVM.VolumeGroupSize = parseLVMSize(state.vg.Size)
+		volumeContext.Status.LVM.VolumeGroupFree = parseLVMSize(state.vg.Free)
+	}
+}
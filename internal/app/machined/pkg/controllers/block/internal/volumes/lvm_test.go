@@ -0,0 +1,291 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volumes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/volumes"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+func TestParseLVMReport(t *testing.T) {
+	t.Parallel()
+
+	pvs, vgs, lvs, err := volumes.ParseLVMReport(`  {
+      "report": [
+          {
+              "pv": [
+                  {"pv_name":"/dev/nvme0n1", "vg_name":"vg-data"},
+                  {"pv_name":"/dev/nvme1n1", "vg_name":"vg-data"},
+                  {"pv_name":"/dev/sdb", "vg_name":""}
+              ]
+          }
+      ]
+  }
+`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []volumes.LVMPhysicalVolume{
+		{Name: "/dev/nvme0n1", VolumeGroup: "vg-data"},
+		{Name: "/dev/nvme1n1", VolumeGroup: "vg-data"},
+		{Name: "/dev/sdb"},
+	}, pvs)
+	assert.Empty(t, vgs)
+	assert.Empty(t, lvs)
+
+	_, vgs, _, err = volumes.ParseLVMReport(`{"report": [{"vg": [{"vg_name":"vg-data", "vg_size":"2000381018112", "vg_free":"1000190509056"}]}]}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []volumes.LVMVolumeGroup{
+		{Name: "vg-data", Size: "2000381018112", Free: "1000190509056"},
+	}, vgs)
+
+	_, _, lvs, err = volumes.ParseLVMReport(`{
+      "report": [
+          {
+              "lv": [
+                  {"lv_name":"pool0", "vg_name":"vg-data", "lv_size":"1000190509056", "lv_dm_path":"/dev/mapper/vg--data-pool0", "pool_lv":""},
+                  {"lv_name":"data", "vg_name":"vg-data", "lv_size":"107374182400", "lv_dm_path":"/dev/mapper/vg--data-data", "pool_lv":"pool0"}
+              ]
+          }
+      ]
+  }`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []volumes.LVMLogicalVolume{
+		{Name: "pool0", VolumeGroup: "vg-data", Size: "1000190509056", DMPath: "/dev/mapper/vg--data-pool0"},
+		{Name: "data", VolumeGroup: "vg-data", Size: "107374182400", DMPath: "/dev/mapper/vg--data-data", Pool: "pool0"},
+	}, lvs)
+
+	_, _, _, err = volumes.ParseLVMReport(`  WARNING: not a JSON`)
+	require.Error(t, err)
+}
+
+func TestPlanLVM(t *testing.T) {
+	t.Parallel()
+
+	const (
+		MiB = 1024 * 1024
+		GiB = 1024 * MiB
+	)
+
+	disks := []volumes.LVMDisk{
+		{DevPath: "/dev/nvme0n1", Size: 100 * GiB},
+		{DevPath: "/dev/nvme1n1", Size: 100 * GiB},
+		{DevPath: "/dev/nvme2n1", Size: 100 * GiB},
+	}
+
+	for _, test := range []struct {
+		name string
+
+		provisioning block.ProvisioningSpec
+		vg           *volumes.LVMVolumeGroup
+		lvs          []volumes.LVMLogicalVolume
+		candidates   []volumes.LVMDisk
+
+		expectedPlan  volumes.LVMPlan
+		expectedError string
+	}{
+		{
+			name: "new volume group, single disk",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, MaxSize: 50 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes: []string{"/dev/nvme0n1"},
+			},
+		},
+		{
+			name: "new volume group, several disks",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, MaxSize: 150 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes: []string{"/dev/nvme0n1", "/dev/nvme1n1"},
+			},
+		},
+		{
+			name: "new volume group, no max size",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, Grow: true},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes: []string{"/dev/nvme0n1"},
+			},
+		},
+		{
+			name: "new volume group, partially fits",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 150 * GiB, MaxSize: 500 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes: []string{"/dev/nvme0n1", "/dev/nvme1n1", "/dev/nvme2n1"},
+			},
+		},
+		{
+			name: "new volume group, no disks",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+
+			expectedError: `no disks available for volume group "vg-data"`,
+		},
+		{
+			name: "new volume group, not enough space",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 400 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1"},
+			},
+			candidates: disks,
+
+			expectedError: `not enough free space for volume group "vg-data": 322097381376 < 429496729600`,
+		},
+		{
+			name: "shared volume group, enough free space",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, MaxSize: 40 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data2"},
+			},
+			vg: &volumes.LVMVolumeGroup{Name: "vg-data", Size: "107365793792", Free: "53682896896"},
+			lvs: []volumes.LVMLogicalVolume{
+				{Name: "data1", VolumeGroup: "vg-data", Size: "53682896896"},
+			},
+			candidates: disks[1:],
+
+			expectedPlan: volumes.LVMPlan{},
+		},
+		{
+			name: "shared volume group, extend",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, MaxSize: 100 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data2"},
+			},
+			vg: &volumes.LVMVolumeGroup{Name: "vg-data", Size: "107365793792", Free: "53682896896"},
+			lvs: []volumes.LVMLogicalVolume{
+				{Name: "data1", VolumeGroup: "vg-data", Size: "53682896896"},
+			},
+			candidates: disks[1:],
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes: []string{"/dev/nvme1n1"},
+			},
+		},
+		{
+			name: "shared volume group, full",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MinSize: 10 * GiB, MaxSize: 100 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data3"},
+			},
+			vg: &volumes.LVMVolumeGroup{Name: "vg-data", Size: "214731587584", Free: "0"},
+			lvs: []volumes.LVMLogicalVolume{
+				{Name: "data1", VolumeGroup: "vg-data", Size: "53682896896"},
+				{Name: "data2", VolumeGroup: "vg-data", Size: "161048690688"},
+			},
+
+			expectedError: `not enough free space for volume group "vg-data": 0 < 10737418240`,
+		},
+		{
+			name: "new thin pool, size from the volume",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MaxSize: 50 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1", ThinPool: "pool0"},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes:   []string{"/dev/nvme0n1"},
+				ThinPoolSize:         50 * GiB,
+				ThinPoolMetadataSize: 50 * GiB / 1000,
+			},
+		},
+		{
+			name: "new thin pool, explicit size",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MaxSize: 500 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1", ThinPool: "pool0", ThinPoolSize: 150 * GiB},
+			},
+			candidates: disks,
+
+			expectedPlan: volumes.LVMPlan{
+				NewPhysicalVolumes:   []string{"/dev/nvme0n1", "/dev/nvme1n1"},
+				ThinPoolSize:         150 * GiB,
+				ThinPoolMetadataSize: 150 * GiB / 1000,
+			},
+		},
+		{
+			name: "new thin pool, small",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MaxSize: 1 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data1", ThinPool: "pool0"},
+			},
+			vg:  &volumes.LVMVolumeGroup{Name: "vg-data", Size: "107365793792", Free: "53682896896"},
+			lvs: []volumes.LVMLogicalVolume{{Name: "data1", VolumeGroup: "vg-data", Size: "53682896896"}},
+
+			expectedPlan: volumes.LVMPlan{
+				ThinPoolSize:         1 * GiB,
+				ThinPoolMetadataSize: 4 * MiB,
+			},
+		},
+		{
+			name: "shared thin pool",
+
+			provisioning: block.ProvisioningSpec{
+				PartitionSpec: block.PartitionSpec{MaxSize: 500 * GiB},
+				LVMSpec:       block.LVMSpec{VolumeGroup: "vg-data", LogicalVolume: "data2", ThinPool: "pool0"},
+			},
+			vg: &volumes.LVMVolumeGroup{Name: "vg-data", Size: "107365793792", Free: "0"},
+			lvs: []volumes.LVMLogicalVolume{
+				{Name: "pool0", VolumeGroup: "vg-data", Size: "107365793792"},
+				{Name: "data1", VolumeGroup: "vg-data", Size: "536870912000", Pool: "pool0"},
+			},
+			candidates: disks[1:],
+
+			expectedPlan: volumes.LVMPlan{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := volumes.PlanLVM(test.provisioning, test.vg, test.lvs, test.candidates)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPlan, plan)
+		})
+	}
+}
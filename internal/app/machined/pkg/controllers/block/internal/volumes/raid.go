@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/mdraid"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+const (
+	// mdadm is not part of the Talos rootfs, it is provided by the system extension.
+	mdadmBinary = "mdadm"
+	sysBlock    = "/sys/block"
+)
+
+type raidArray struct {
+	device string
+	detail mdraid.Detail
+}
+
+// listRAIDArrays lists all active md arrays.
+func listRAIDArrays(ctx context.Context, logger *zap.Logger) ([]raidArray, error) {
+	entries, err := os.ReadDir(sysBlock)
+	if err != nil {
+		return nil, fmt.Errorf("error listing block devices: %w", err)
+	}
+
+	var arrays []raidArray
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "md") {
+			continue
+		}
+
+		out, err := cmd.RunContext(ctx, mdadmBinary, "--detail", "--export", filepath.Join("/dev", entry.Name()))
+		if err != nil {
+			var exitErr *cmd.ExitError
+
+			if !errors.As(err, &exitErr) {
+				return nil, fmt.Errorf("error running mdadm: %w", err)
+			}
+
+			// the device is not an active array
+			logger.Debug("error getting md array details", zap.String("device", entry.Name()), zap.Error(err))
+
+			continue
+		}
+
+		arrays = append(arrays, raidArray{
+			device: entry.Name(),
+			detail: mdraid.ParseDetail(out),
+		})
+	}
+
+	return arrays, nil
+}
+
+func findRAIDArray(arrays []raidArray, name string) *raidArray {
+	for i := range arrays {
+		if arrays[i].detail.Name == name {
+			return &arrays[i]
+		}
+	}
+
+	return nil
+}
+
+// raidMemberMinSize returns the minimum size of the member disk for the array to fit the volume.
+func raidMemberMinSize(provisioning block.ProvisioningSpec) uint64 {
+	minSize := provisioning.PartitionSpec.MinSize
+
+	if provisioning.RAIDSpec.Level == block.RAIDLevel10 && provisioning.RAIDSpec.Devices > 1 {
+		// raid10 (near layout, two copies) capacity is half of the total size of the members
+		devices := uint64(provisioning.RAIDSpec.Devices)
+
+		minSize = (minSize*2 + devices - 1) / devices
+	}
+
+	return minSize
+}
+
+// LocateAndProvisionRAID locates and provisions a software RAID (md) array.
+//
+// Existing arrays are assembled from the member superblocks, and if the array is still missing,
+// it is created on the empty disks matched by the disk selector.
+//
+//nolint:gocyclo,cyclop
+func LocateAndProvisionRAID(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext) error {
+	raidSpec := volumeContext.Cfg.TypedSpec().Provisioning.RAIDSpec
+
+	if raidSpec.Name == "" || raidSpec.Level == "" {
+		return fmt.Errorf("RAID array name and level are required")
+	}
+
+	if _, err := exec.LookPath(mdadmBinary); err != nil {
+		return errors.New("mdadm is not available, RAID volumes require the mdadm system extension")
+	}
+
+	if !volumeContext.DevicesReady {
+		// RAID arrays can only be assembled once all devices are discovered
+		volumeContext.Status.Phase = block.VolumePhaseWaiting
+
+		return nil
+	}
+
+	arrays, err := listRAIDArrays(ctx, logger)
+	if err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	if array := findRAIDArray(arrays, raidSpec.Name); array == nil {
+		// try to assemble the array from the existing member disks
+		if _, err = cmd.RunContext(ctx, mdadmBinary, "--assemble", "--scan", "--run"); err != nil {
+			logger.Debug("md arrays were not assembled", zap.Error(err))
+		}
+
+		if arrays, err = listRAIDArrays(ctx, logger); err != nil {
+			return xerrors.NewTagged[Retryable](err)
+		}
+	}
+
+	if array := findRAIDArray(arrays, raidSpec.Name); array != nil {
+		volumeContext.Status.Phase = block.VolumePhaseLocated
+
+		return setRAIDStatus(volumeContext, array)
+	}
+
+	if !volumeContext.PreviousWaveProvisioned {
+		// previous wave is not provisioned yet
+		volumeContext.Status.Phase = block.VolumePhaseWaiting
+
+		return nil
+	}
+
+	matchedDisks, err := matchDisks(volumeContext)
+	if err != nil {
+		return err
+	}
+
+	devices := raidSpec.Devices
+	if devices == 0 {
+		devices, _ = block.RAIDMinDevices(raidSpec.Level)
+	}
+
+	var members []string
+
+	for _, matchedDisk := range matchedDisks {
+		if len(members) == devices {
+			break
+		}
+
+		if CheckDiskForProvisioning(logger, matchedDisk, volumeContext.Cfg).CanProvision {
+			members = append(members, matchedDisk)
+		}
+	}
+
+	if len(members) < devices {
+		return xerrors.NewTaggedf[Retryable]("not enough disks available for RAID array %q: %d < %d", raidSpec.Name, len(members), devices)
+	}
+
+	logger.Info("creating md array", zap.String("name", raidSpec.Name), zap.String("level", raidSpec.Level), zap.Strings("disks", members))
+
+	if _, err = cmd.RunContext(ctx, mdadmBinary, append([]string{
+		"--create", filepath.Join("/dev/md", raidSpec.Name),
+		"--run",
+		"--metadata=1.2",
+		"--homehost=any",
+		"--name=" + raidSpec.Name,
+		"--level=" + raidSpec.Level,
+		"--raid-devices=" + strconv.Itoa(devices),
+	}, members...)...); err != nil {
+		return fmt.Errorf("error creating md array %q: %w", raidSpec.Name, err)
+	}
+
+	if arrays, err = listRAIDArrays(ctx, logger); err != nil {
+		return xerrors.NewTagged[Retryable](err)
+	}
+
+	array := findRAIDArray(arrays, raidSpec.Name)
+	if array == nil {
+		return fmt.Errorf("md array %q not found after provisioning", raidSpec.Name)
+	}
+
+	volumeContext.Status.Phase = block.VolumePhaseProvisioned
+
+	return setRAIDStatus(volumeContext, array)
+}
+
+func setRAIDStatus(volumeContext ManagerContext, array *raidArray) error {
+	status, err := mdraid.ReadStatus(sysBlock, array.device)
+	if err != nil {
+		return xerrors.NewTaggedf[Retryable]("error reading md array status: %w", err)
+	}
+
+	if status == nil {
+		return xerrors.NewTaggedf[Retryable]("md array %q is not active", array.detail.Name)
+	}
+
+	sizeContents, err := os.ReadFile(filepath.Join(sysBlock, array.device, "size"))
+	if err != nil {
+		return xerrors.NewTaggedf[Retryable]("error reading md array size: %w", err)
+	}
+
+	// size is always in 512-byte sectors
+	sectors, err := strconv.ParseUint(strings.TrimSpace(string(sizeContents)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("error parsing md array size: %w", err)
+	}
+
+	status.Name = array.detail.Name
+
+	if len(array.detail.Members) > 0 {
+		status.Members = array.detail.Members
+	}
+
+	volumeContext.Status.Location = filepath.Join("/dev", array.device)
+	volumeContext.Status.ParentLocation = ""
+	volumeContext.Status.PartitionIndex = 0
+	volumeContext.Status.SetSize(sectors * 512)
+	volumeContext.Status.RAID = status
+
+	return nil
+}
@@ -8,6 +8,8 @@ package volumes
 import (
 	"cmp"
 
+	"github.com/siderolabs/crypto/x509"
+
 	blockpb "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
@@ -53,5 +55,6 @@ type ManagerContext struct {
 	DevicesReady            bool
 	PreviousWaveProvisioned bool
 	SystemInformation       *hardware.SystemInformation
+	NodeIdentity            *x509.PEMEncodedCertificateAndKey
 	Lifecycle               *block.VolumeLifecycle
 }
@@ -0,0 +1,274 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/projectquota"
+	configconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type appliedProjectQuota struct {
+	projectID uint32
+	softLimit uint64
+	hardLimit uint64
+}
+
+// ProjectQuotaController applies the directory project quotas on the EPHEMERAL volume.
+type ProjectQuotaController struct {
+	// SetProjectID assigns the project ID to the directory tree, defaults to projectquota.SetProjectID.
+	SetProjectID func(root string, projectID uint32, skip func(path string) bool) error
+	// SetLimits sets the project limits, defaults to projectquota.SetLimits.
+	SetLimits func(mountPoint string, projectID uint32, softLimit, hardLimit uint64) error
+	// GetQuota reads the project limits and usage, defaults to projectquota.Get.
+	GetQuota func(mountPoint string, projectID uint32) (projectquota.Quota, error)
+
+	applied map[string]appliedProjectQuota
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProjectQuotaController) Name() string {
+	return "block.ProjectQuotaController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProjectQuotaController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      runtimeres.MountStatusType,
+			ID:        optional.Some(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProjectQuotaController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.ProjectQuotaStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+const projectQuotaRefreshInterval = time.Minute
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *ProjectQuotaController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.SetProjectID == nil {
+		ctrl.SetProjectID = projectquota.SetProjectID
+	}
+
+	if ctrl.SetLimits == nil {
+		ctrl.SetLimits = projectquota.SetLimits
+	}
+
+	if ctrl.GetQuota == nil {
+		ctrl.GetQuota = projectquota.Get
+	}
+
+	// quota usage is refreshed periodically
+	ticker := time.NewTicker(projectQuotaRefreshInterval)
+	defer ticker.Stop()
+
+	var notEnabledWarned bool
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching machine configuration: %w", err)
+		}
+
+		mountStatus, err := safe.ReaderGetByID[*runtimeres.MountStatus](ctx, r, constants.EphemeralPartitionLabel)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching EPHEMERAL mount status: %w", err)
+		}
+
+		var quotas []configconfig.ProjectQuotaConfig
+
+		if cfg != nil {
+			quotas = cfg.Config().ProjectQuotas()
+		}
+
+		r.StartTrackingOutputs()
+
+		switch {
+		case mountStatus == nil:
+			// EPHEMERAL is not mounted (or got unmounted), quotas should be re-applied on the next mount
+			ctrl.applied = nil
+		case !slices.Contains(mountStatus.TypedSpec().Options, "prjquota"):
+			if len(quotas) > 0 && !notEnabledWarned {
+				logger.Warn("project quotas are not enabled on the EPHEMERAL volume, reboot is required to apply the directory quotas")
+
+				notEnabledWarned = true
+			}
+		default:
+			if err = ctrl.reconcile(ctx, r, logger, mountStatus.TypedSpec().Target, quotas); err != nil {
+				return err
+			}
+		}
+
+		if err = safe.CleanupOutputs[*block.ProjectQuotaStatus](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up project quota statuses: %w", err)
+		}
+	}
+}
+
+func (ctrl *ProjectQuotaController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger, mountPoint string, quotas []configconfig.ProjectQuotaConfig) error {
+	if ctrl.applied == nil {
+		ctrl.applied = map[string]appliedProjectQuota{}
+	}
+
+	paths := xslices.Map(quotas, configconfig.ProjectQuotaConfig.Path)
+	projectIDs := AssignProjectIDs(paths)
+
+	// nested quota directories are accounted separately
+	skip := func(path string) bool {
+		return slices.Contains(paths, path)
+	}
+
+	// remove limits for the quotas which are not configured anymore
+	for path, applied := range ctrl.applied {
+		if slices.Contains(paths, path) {
+			continue
+		}
+
+		if err := ctrl.SetLimits(mountPoint, applied.projectID, 0, 0); err != nil {
+			logger.Error("failed to remove project quota limits", zap.String("path", path), zap.Error(err))
+
+			continue
+		}
+
+		logger.Info("removed project quota limits", zap.String("path", path), zap.Uint32("project_id", applied.projectID))
+
+		delete(ctrl.applied, path)
+	}
+
+	for _, quota := range quotas {
+		desired := appliedProjectQuota{
+			projectID: projectIDs[quota.Path()],
+			softLimit: quota.SoftLimit().ValueOr(0),
+			hardLimit: quota.HardLimit(),
+		}
+
+		if applied, ok := ctrl.applied[quota.Path()]; !ok || applied != desired {
+			if err := ctrl.SetProjectID(quota.Path(), desired.projectID, skip); err != nil {
+				// the directory is not created by the controller, as the owner should pick the permissions
+				if errors.Is(err, fs.ErrNotExist) {
+					logger.Debug("project quota directory doesn't exist yet", zap.String("path", quota.Path()))
+				} else {
+					logger.Error("failed to set project ID", zap.String("path", quota.Path()), zap.Error(err))
+				}
+
+				continue
+			}
+
+			if err := ctrl.SetLimits(mountPoint, desired.projectID, desired.softLimit, desired.hardLimit); err != nil {
+				logger.Error("failed to set project quota limits", zap.String("path", quota.Path()), zap.Error(err))
+
+				continue
+			}
+
+			logger.Info("applied project quota",
+				zap.String("path", quota.Path()),
+				zap.Uint32("project_id", desired.projectID),
+				zap.Uint64("hard_limit", desired.hardLimit),
+				zap.Uint64("soft_limit", desired.softLimit),
+			)
+
+			ctrl.applied[quota.Path()] = desired
+		}
+
+		usage, err := ctrl.GetQuota(mountPoint, desired.projectID)
+		if err != nil {
+			logger.Error("failed to get project quota usage", zap.String("path", quota.Path()), zap.Error(err))
+
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, block.NewProjectQuotaStatus(block.NamespaceName, quota.Path()), func(status *block.ProjectQuotaStatus) error {
+			status.TypedSpec().ProjectID = desired.projectID
+			status.TypedSpec().HardLimit = usage.HardLimit
+			status.TypedSpec().SoftLimit = usage.SoftLimit
+			status.TypedSpec().UsedBytes = usage.UsedBytes
+			status.TypedSpec().PrettyUsedBytes = humanize.IBytes(usage.UsedBytes)
+			status.TypedSpec().UsedInodes = usage.UsedInodes
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating project quota status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AssignProjectIDs assigns unique project IDs to the paths.
+//
+// The project ID is derived from the path, on collision the next free project ID is used.
+func AssignProjectIDs(paths []string) map[string]uint32 {
+	sorted := slices.Clone(paths)
+	slices.Sort(sorted)
+
+	result := make(map[string]uint32, len(sorted))
+	used := map[uint32]struct{}{}
+
+	for _, path := range sorted {
+		id := projectquota.ProjectID(path)
+
+		for {
+			if _, taken := used[id]; !taken {
+				break
+			}
+
+			id++
+
+			if id > projectquota.MaxProjectID {
+				id = projectquota.MinProjectID
+			}
+		}
+
+		used[id] = struct{}{}
+		result[path] = id
+	}
+
+	return result
+}
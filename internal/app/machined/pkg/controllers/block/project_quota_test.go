@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"fmt"
+	"io/fs"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/pkg/projectquota"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type fakeQuotas struct {
+	mu sync.Mutex
+
+	projectIDs map[string]uint32
+	limits     map[uint32]projectquota.Quota
+	missing    map[string]struct{}
+}
+
+func (q *fakeQuotas) setProjectID(root string, projectID uint32, _ func(string) bool) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, missing := q.missing[root]; missing {
+		return fmt.Errorf("error: %w", fs.ErrNotExist)
+	}
+
+	q.projectIDs[root] = projectID
+
+	return nil
+}
+
+func (q *fakeQuotas) setLimits(_ string, projectID uint32, softLimit, hardLimit uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.limits[projectID] = projectquota.Quota{
+		HardLimit: hardLimit,
+		SoftLimit: softLimit,
+	}
+
+	return nil
+}
+
+func (q *fakeQuotas) get(_ string, projectID uint32) (projectquota.Quota, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	quota := q.limits[projectID]
+	quota.UsedBytes = 1024 * 1024
+	quota.UsedInodes = 42
+
+	return quota, nil
+}
+
+func (q *fakeQuotas) setMissing(path string, missing bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if missing {
+		q.missing[path] = struct{}{}
+	} else {
+		delete(q.missing, path)
+	}
+}
+
+func (q *fakeQuotas) limit(projectID uint32) projectquota.Quota {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.limits[projectID]
+}
+
+type ProjectQuotaSuite struct {
+	ctest.DefaultSuite
+
+	quotas *fakeQuotas
+}
+
+func TestProjectQuotaSuite(t *testing.T) {
+	t.Parallel()
+
+	quotas := &fakeQuotas{
+		projectIDs: map[string]uint32{},
+		limits:     map[uint32]projectquota.Quota{},
+		missing:    map[string]struct{}{},
+	}
+
+	suite.Run(t, &ProjectQuotaSuite{
+		quotas: quotas,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 3 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.ProjectQuotaController{
+					SetProjectID: quotas.setProjectID,
+					SetLimits:    quotas.setLimits,
+					GetQuota:     quotas.get,
+				}))
+			},
+		},
+	})
+}
+
+func (suite *ProjectQuotaSuite) TestReconcile() {
+	logQuota := blockcfg.NewProjectQuotaConfigV1Alpha1()
+	logQuota.MetaName = "/var/log"
+	logQuota.QuotaHardLimit = blockcfg.MustByteSize("10GiB")
+	logQuota.QuotaSoftLimit = blockcfg.MustByteSize("8GiB")
+
+	etcdQuota := blockcfg.NewProjectQuotaConfigV1Alpha1()
+	etcdQuota.MetaName = "/var/lib/etcd"
+	etcdQuota.QuotaHardLimit = blockcfg.MustByteSize("20GiB")
+
+	ctr, err := container.New(logQuota, etcdQuota)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	mountStatus := runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel)
+	mountStatus.TypedSpec().Target = constants.EphemeralMountPoint
+	mountStatus.TypedSpec().FilesystemType = "xfs"
+	mountStatus.TypedSpec().Options = []string{"prjquota"}
+	suite.Create(mountStatus)
+
+	projectIDs := blockctrls.AssignProjectIDs([]string{"/var/log", "/var/lib/etcd"})
+
+	ctest.AssertResource(suite, "/var/log", func(r *block.ProjectQuotaStatus, asrt *assert.Assertions) {
+		asrt.Equal(projectIDs["/var/log"], r.TypedSpec().ProjectID)
+		asrt.EqualValues(10*1024*1024*1024, r.TypedSpec().HardLimit)
+		asrt.EqualValues(8*1024*1024*1024, r.TypedSpec().SoftLimit)
+		asrt.EqualValues(1024*1024, r.TypedSpec().UsedBytes)
+		asrt.Equal("1.0 MiB", r.TypedSpec().PrettyUsedBytes)
+		asrt.EqualValues(42, r.TypedSpec().UsedInodes)
+	})
+	ctest.AssertResource(suite, "/var/lib/etcd", func(r *block.ProjectQuotaStatus, asrt *assert.Assertions) {
+		asrt.Equal(projectIDs["/var/lib/etcd"], r.TypedSpec().ProjectID)
+		asrt.EqualValues(20*1024*1024*1024, r.TypedSpec().HardLimit)
+		asrt.Zero(r.TypedSpec().SoftLimit)
+	})
+
+	// drop the etcd quota, limits should be removed
+	ctr, err = container.New(logQuota)
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	ctest.AssertNoResource[*block.ProjectQuotaStatus](suite, "/var/lib/etcd")
+	ctest.AssertResource(suite, "/var/log", func(*block.ProjectQuotaStatus, *assert.Assertions) {})
+
+	suite.Assert().Equal(projectquota.Quota{}, suite.quotas.limit(projectIDs["/var/lib/etcd"]))
+
+	// unmount EPHEMERAL, statuses should be removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), mountStatus.Metadata()))
+
+	ctest.AssertNoResource[*block.ProjectQuotaStatus](suite, "/var/log")
+}
+
+func (suite *ProjectQuotaSuite) TestMissingDirectory() {
+	suite.quotas.setMissing("/var/lib/missing", true)
+
+	missingQuota := blockcfg.NewProjectQuotaConfigV1Alpha1()
+	missingQuota.MetaName = "/var/lib/missing"
+	missingQuota.QuotaHardLimit = blockcfg.MustByteSize("1GiB")
+
+	ctr, err := container.New(missingQuota)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(ctr))
+
+	mountStatus := runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel)
+	mountStatus.TypedSpec().Target = constants.EphemeralMountPoint
+	mountStatus.TypedSpec().FilesystemType = "xfs"
+	mountStatus.TypedSpec().Options = []string{"prjquota"}
+	suite.Create(mountStatus)
+
+	// the quota is not applied until the directory is created
+	ctest.AssertNoResource[*block.ProjectQuotaStatus](suite, "/var/lib/missing")
+
+	suite.quotas.setMissing("/var/lib/missing", false)
+
+	// trigger the reconcile
+	mountStatus.TypedSpec().Source = "/dev/sda6"
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), mountStatus))
+
+	ctest.AssertResource(suite, "/var/lib/missing", func(r *block.ProjectQuotaStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(1024*1024*1024, r.TypedSpec().HardLimit)
+	})
+}
+
+func TestAssignProjectIDs(t *testing.T) {
+	t.Parallel()
+
+	paths := []string{"/var/log", "/var/lib/containerd", "/var/lib/etcd"}
+
+	ids := blockctrls.AssignProjectIDs(paths)
+	assert.Len(t, ids, len(paths))
+
+	seen := map[uint32]struct{}{}
+
+	for _, path := range paths {
+		assert.Equal(t, projectquota.ProjectID(path), ids[path])
+
+		seen[ids[path]] = struct{}{}
+	}
+
+	assert.Len(t, seen, len(paths))
+}
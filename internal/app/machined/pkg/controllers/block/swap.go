@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/swap"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/makefs"
+)
+
+const (
+	zramDevice = "zram0"
+
+	// zram swap is preferred over the swap volumes.
+	zramSwapPriority = 100
+)
+
+type zramSettings struct {
+	size      uint64
+	algorithm string
+}
+
+// SwapController activates swap volumes and the zram swap device.
+type SwapController struct {
+	activeVolumes map[string]string
+	zram          *zramSettings
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SwapController) Name() string {
+	return "block.SwapController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SwapController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeLifecycleType,
+			ID:        optional.Some(block.VolumeLifecycleID),
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SwapController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *SwapController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.activeVolumes == nil {
+		ctrl.activeVolumes = map[string]string{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching machine configuration: %w", err)
+		}
+
+		volumeLifecycle, err := safe.ReaderGetByID[*block.VolumeLifecycle](ctx, r, block.VolumeLifecycleID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching volume lifecycle: %w", err)
+		}
+
+		if volumeLifecycle == nil {
+			// no volume lifecycle, cease all operations
+			continue
+		}
+
+		tearingDown := volumeLifecycle.Metadata().Phase() == resource.PhaseTearingDown
+
+		if !tearingDown {
+			if err = r.AddFinalizer(ctx, volumeLifecycle.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer to volume lifecycle: %w", err)
+			}
+		}
+
+		volumeStatuses, err := safe.ReaderListAll[*block.VolumeStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error fetching volume statuses: %w", err)
+		}
+
+		// swap volumes which are ready are activated, unless the volumes are being torn down
+		desiredVolumes := map[string]string{}
+
+		if !tearingDown {
+			for volumeStatus := range volumeStatuses.All() {
+				if !strings.HasPrefix(volumeStatus.Metadata().ID(), constants.SwapVolumePrefix) {
+					continue
+				}
+
+				if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady || volumeStatus.TypedSpec().Filesystem != block.FilesystemTypeSwap {
+					continue
+				}
+
+				desiredVolumes[volumeStatus.Metadata().ID()] = volumeStatus.TypedSpec().MountLocation
+			}
+		}
+
+		for id, device := range ctrl.activeVolumes {
+			if desiredVolumes[id] == device {
+				continue
+			}
+
+			logger.Info("deactivating swap volume", zap.String("volume", id), zap.String("device", device))
+
+			if err = swap.Off(device); err != nil && !errors.Is(err, unix.EINVAL) {
+				return err
+			}
+
+			delete(ctrl.activeVolumes, id)
+		}
+
+		for id, device := range desiredVolumes {
+			if _, active := ctrl.activeVolumes[id]; active {
+				continue
+			}
+
+			logger.Info("activating swap volume", zap.String("volume", id), zap.String("device", device))
+
+			if err = swap.On(device, -1); err != nil && !errors.Is(err, unix.EBUSY) {
+				return err
+			}
+
+			ctrl.activeVolumes[id] = device
+		}
+
+		var desiredZram *zramSettings
+
+		if cfg != nil {
+			if zram := cfg.Config().ZramSwap(); zram != nil {
+				desiredZram = &zramSettings{
+					size:      zram.Size(),
+					algorithm: zram.Algorithm().ValueOr(""),
+				}
+			}
+		}
+
+		if err = ctrl.reconcileZram(logger, desiredZram); err != nil {
+			return err
+		}
+
+		if tearingDown && len(ctrl.activeVolumes) == 0 {
+			if err = r.RemoveFinalizer(ctx, volumeLifecycle.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error removing finalizer from volume lifecycle: %w", err)
+			}
+		}
+	}
+}
+
+func (ctrl *SwapController) reconcileZram(logger *zap.Logger, desired *zramSettings) error {
+	sysfsPath := filepath.Join("/sys/block", zramDevice)
+	devPath := filepath.Join("/dev", zramDevice)
+
+	if ctrl.zram != nil && (desired == nil || *ctrl.zram != *desired) {
+		logger.Info("deactivating zram swap", zap.String("device", devPath))
+
+		if err := resetZram(sysfsPath, devPath); err != nil {
+			return err
+		}
+
+		ctrl.zram = nil
+	}
+
+	if desired == nil || ctrl.zram != nil {
+		return nil
+	}
+
+	// the device appears once the zram module is loaded, wait for the next event
+	if _, err := os.Stat(sysfsPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Debug("zram device is not available yet", zap.String("device", devPath))
+
+			return nil
+		}
+
+		return fmt.Errorf("error checking zram device: %w", err)
+	}
+
+	// the device might be left initialized by a previous failed attempt, and it can't be reconfigured without a reset
+	if err := resetZram(sysfsPath, devPath); err != nil {
+		return err
+	}
+
+	if desired.algorithm != "" {
+		if err := os.WriteFile(filepath.Join(sysfsPath, "comp_algorithm"), []byte(desired.algorithm), 0o644); err != nil {
+			return fmt.Errorf("error setting zram compression algorithm: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(sysfsPath, "disksize"), []byte(strconv.FormatUint(desired.size, 10)), 0o644); err != nil {
+		return fmt.Errorf("error setting zram device size: %w", err)
+	}
+
+	if err := makefs.Swap(devPath, makefs.WithLabel(zramDevice)); err != nil {
+		return fmt.Errorf("error formatting zram swap: %w", err)
+	}
+
+	logger.Info("activating zram swap", zap.String("device", devPath), zap.Uint64("size", desired.size), zap.String("algorithm", desired.algorithm))
+
+	if err := swap.On(devPath, zramSwapPriority); err != nil {
+		return err
+	}
+
+	ctrl.zram = desired
+
+	return nil
+}
+
+// resetZram deactivates the zram swap (if active) and resets the device, so that it can be configured again.
+func resetZram(sysfsPath, devPath string) error {
+	if err := swap.Off(devPath); err != nil && !errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(sysfsPath, "reset"), []byte("1"), 0o644); err != nil {
+		return fmt.Errorf("error resetting zram device: %w", err)
+	}
+
+	return nil
+}
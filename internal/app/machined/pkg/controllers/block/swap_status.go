@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/swap"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// SwapStatusController reports the status of the active swap devices.
+type SwapStatusController struct {
+	// ListSwaps lists the active swap devices, defaults to swap.List.
+	ListSwaps func() ([]swap.Device, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SwapStatusController) Name() string {
+	return "block.SwapStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SwapStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SwapStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.SwapStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SwapStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	if ctrl.ListSwaps == nil {
+		ctrl.ListSwaps = swap.List
+	}
+
+	// swap usage is refreshed periodically
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		devices, err := ctrl.ListSwaps()
+		if err != nil {
+			return fmt.Errorf("error listing swap devices: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for _, device := range devices {
+			if err = safe.WriterModify(ctx, r, block.NewSwapStatus(block.NamespaceName, device.Name), func(s *block.SwapStatus) error {
+				s.TypedSpec().Device = device.Name
+				s.TypedSpec().Type = device.Type
+				s.TypedSpec().SizeBytes = device.SizeBytes
+				s.TypedSpec().SizeHuman = humanize.IBytes(device.SizeBytes)
+				s.TypedSpec().UsedBytes = device.UsedBytes
+				s.TypedSpec().UsedHuman = humanize.IBytes(device.UsedBytes)
+				s.TypedSpec().Priority = device.Priority
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating swap status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*block.SwapStatus](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up swap statuses: %w", err)
+		}
+	}
+}
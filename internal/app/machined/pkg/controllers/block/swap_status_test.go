@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/pkg/swap"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+type SwapStatusSuite struct {
+	ctest.DefaultSuite
+
+	mu      sync.Mutex
+	devices []swap.Device
+}
+
+func TestSwapStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &SwapStatusSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 3 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.SwapStatusController{
+				ListSwaps: s.listSwaps,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *SwapStatusSuite) listSwaps() ([]swap.Device, error) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	return append([]swap.Device(nil), suite.devices...), nil
+}
+
+func (suite *SwapStatusSuite) setDevices(devices ...swap.Device) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	suite.devices = devices
+}
+
+func (suite *SwapStatusSuite) TestReconcile() {
+	suite.setDevices(
+		swap.Device{
+			Name:      "/dev/dm-1",
+			Type:      "partition",
+			SizeBytes: 4 * 1024 * 1024 * 1024,
+			UsedBytes: 1024 * 1024,
+			Priority:  -2,
+		},
+		swap.Device{
+			Name:      "/dev/zram0",
+			Type:      "partition",
+			SizeBytes: 2 * 1024 * 1024 * 1024,
+			Priority:  100,
+		},
+	)
+
+	// trigger the reconcile via the volume status change
+	volumeStatus := block.NewVolumeStatus(block.NamespaceName, "s-swap1")
+	volumeStatus.TypedSpec().Phase = block.VolumePhaseReady
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), volumeStatus))
+
+	ctest.AssertResource(suite, "/dev/dm-1", func(r *block.SwapStatus, asrt *assert.Assertions) {
+		asrt.Equal("partition", r.TypedSpec().Type)
+		asrt.EqualValues(4*1024*1024*1024, r.TypedSpec().SizeBytes)
+		asrt.Equal("4.0 GiB", r.TypedSpec().SizeHuman)
+		asrt.EqualValues(1024*1024, r.TypedSpec().UsedBytes)
+		asrt.Equal("1.0 MiB", r.TypedSpec().UsedHuman)
+		asrt.EqualValues(-2, r.TypedSpec().Priority)
+	})
+
+	ctest.AssertResource(suite, "/dev/zram0", func(r *block.SwapStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(100, r.TypedSpec().Priority)
+	})
+
+	suite.setDevices(
+		swap.Device{
+			Name:      "/dev/zram0",
+			Type:      "partition",
+			SizeBytes: 2 * 1024 * 1024 * 1024,
+			Priority:  100,
+		},
+	)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), volumeStatus.Metadata()))
+
+	ctest.AssertNoResource[*block.SwapStatus](suite, "/dev/dm-1")
+	ctest.AssertResource(suite, "/dev/zram0", func(*block.SwapStatus, *assert.Assertions) {})
+}
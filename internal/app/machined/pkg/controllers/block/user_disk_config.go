@@ -18,11 +18,13 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/partition"
 	"github.com/siderolabs/talos/pkg/machinery/cel"
 	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	cfg "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
 
-// UserDiskConfigController provides volume configuration based on Talos v1alpha1 user disks.
+// UserDiskConfigController provides volume configuration based on Talos v1alpha1 user disks, user volume and swap volume documents.
 type UserDiskConfigController struct{}
 
 // Name implements controller.Controller interface.
@@ -134,6 +136,26 @@ func (ctrl *UserDiskConfigController) Run(ctx context.Context, r controller.Runt
 					}
 				}
 			}
+
+			// user volumes
+			for _, userVolume := range cfg.Config().Volumes().UserVolumes() {
+				if err = safe.WriterModify(ctx, r,
+					block.NewVolumeConfig(block.NamespaceName, constants.UserVolumePrefix+userVolume.Name()),
+					ctrl.manageUserVolume(userVolume),
+				); err != nil {
+					return fmt.Errorf("error creating user volume configuration: %w", err)
+				}
+			}
+
+			// swap volumes
+			for _, swapVolume := range cfg.Config().SwapVolumes() {
+				if err = safe.WriterModify(ctx, r,
+					block.NewVolumeConfig(block.NamespaceName, constants.SwapVolumePrefix+swapVolume.Name()),
+					ctrl.manageSwapVolume(swapVolume),
+				); err != nil {
+					return fmt.Errorf("error creating swap volume configuration: %w", err)
+				}
+			}
 		}
 
 		if err = safe.CleanupOutputs[*block.VolumeConfig](ctx, r); err != nil {
@@ -154,3 +176,113 @@ func (ctrl *UserDiskConfigController) Run(ctx context.Context, r controller.Runt
 		}
 	}
 }
+
+func (ctrl *UserDiskConfigController) manageUserVolume(userVolume cfg.VolumeConfig) func(vc *block.VolumeConfig) error {
+	return func(vc *block.VolumeConfig) error {
+		label := constants.UserVolumePrefix + userVolume.Name()
+
+		// user volumes are mounted along with the user disks
+		vc.Metadata().Labels().Set(block.UserDiskLabel, "")
+
+		vc.TypedSpec().Type = block.VolumeTypePartition
+
+		vc.TypedSpec().Provisioning = block.ProvisioningSpec{
+			Wave: block.WaveUserDisks,
+			DiskSelector: block.DiskSelector{
+				Match: userVolume.Provisioning().DiskSelector().ValueOr(noMatch),
+			},
+			PartitionSpec: block.PartitionSpec{
+				MinSize:  userVolume.Provisioning().MinSize().ValueOr(partition.UserVolumeMinSize),
+				MaxSize:  userVolume.Provisioning().MaxSize().ValueOr(0),
+				Grow:     userVolume.Provisioning().Grow().ValueOr(false),
+				Label:    label,
+				TypeUUID: partition.LinuxFilesystemData,
+			},
+			FilesystemSpec: userVolumeFilesystemSpec(userVolume.Filesystem()),
+		}
+
+		vc.TypedSpec().Locator = block.LocatorSpec{
+			Match: labelVolumeMatch(label),
+		}
+
+		if volumeGroup, ok := userVolume.Provisioning().LVM().VolumeGroup().Get(); ok {
+			// LVM logical volume is located by the volume group and logical volume names
+			vc.TypedSpec().Type = block.VolumeTypeLVM
+			vc.TypedSpec().Provisioning.PartitionSpec.Label = ""
+			vc.TypedSpec().Provisioning.PartitionSpec.TypeUUID = ""
+			vc.TypedSpec().Provisioning.LVMSpec = block.LVMSpec{
+				VolumeGroup:   volumeGroup,
+				LogicalVolume: userVolume.Name(),
+				ThinPool:      userVolume.Provisioning().LVM().ThinPool().ValueOr(""),
+				ThinPoolSize:  userVolume.Provisioning().LVM().ThinPoolSize().ValueOr(0),
+			}
+			vc.TypedSpec().Locator = block.LocatorSpec{}
+		}
+
+		convertRAID(label, userVolume.Provisioning().RAID(), vc.TypedSpec())
+
+		vc.TypedSpec().Mount = block.MountSpec{
+			TargetPath: filepath.Join(constants.UserVolumeMountPoint, userVolume.Name()),
+		}
+
+		return nil
+	}
+}
+
+func (ctrl *UserDiskConfigController) manageSwapVolume(swapVolume cfg.SwapVolumeConfig) func(vc *block.VolumeConfig) error {
+	return func(vc *block.VolumeConfig) error {
+		label := constants.SwapVolumePrefix + swapVolume.Name()
+
+		vc.TypedSpec().Type = block.VolumeTypePartition
+
+		vc.TypedSpec().Provisioning = block.ProvisioningSpec{
+			Wave: block.WaveUserDisks,
+			DiskSelector: block.DiskSelector{
+				Match: swapVolume.Provisioning().DiskSelector().ValueOr(noMatch),
+			},
+			PartitionSpec: block.PartitionSpec{
+				MinSize:  swapVolume.Provisioning().MinSize().ValueOr(partition.UserVolumeMinSize),
+				MaxSize:  swapVolume.Provisioning().MaxSize().ValueOr(0),
+				Label:    label,
+				TypeUUID: partition.LinuxSwap,
+			},
+			FilesystemSpec: block.FilesystemSpec{
+				Type:  block.FilesystemTypeSwap,
+				Label: label,
+			},
+		}
+
+		vc.TypedSpec().Locator = block.LocatorSpec{
+			Match: labelVolumeMatch(label),
+		}
+
+		// swap volumes are not mounted, but activated by the SwapController
+		vc.TypedSpec().Mount = block.MountSpec{}
+
+		if err := convertEncryption(swapVolume.Encryption(), vc.TypedSpec()); err != nil {
+			return fmt.Errorf("error converting encryption for swap volume %q: %w", swapVolume.Name(), err)
+		}
+
+		return nil
+	}
+}
+
+func userVolumeFilesystemSpec(filesystem cfg.VolumeFilesystemConfig) block.FilesystemSpec {
+	spec := block.FilesystemSpec{
+		Type: filesystem.Type().ValueOr(block.FilesystemTypeXFS),
+	}
+
+	if percentage, ok := filesystem.EXT4ReservedBlocksPercentage().Get(); ok {
+		spec.EXT4 = &block.EXT4FilesystemSpec{
+			ReservedBlocksPercentage: percentage,
+		}
+	}
+
+	if compression, ok := filesystem.BTRFSCompression().Get(); ok {
+		spec.BTRFS = &block.BTRFSFilesystemSpec{
+			Compression: compression,
+		}
+	}
+
+	return spec
+}
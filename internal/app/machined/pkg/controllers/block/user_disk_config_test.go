@@ -11,13 +11,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 )
@@ -139,3 +145,182 @@ func (suite *UserDiskConfigSuite) TestReconcileUserDisk() {
 		asrt.True(r.TypedSpec().Ready)
 	})
 }
+
+func (suite *UserDiskConfigSuite) TestReconcileUserVolume() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: constants.EphemeralPartitionLabel,
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				ProvisioningMaxSize: blockcfg.MustByteSize("10GiB"),
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: "data",
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`!system_disk`, celenv.DiskLocator())),
+				},
+				ProvisioningMaxSize: blockcfg.MustByteSize("50GiB"),
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: "cache",
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`!system_disk`, celenv.DiskLocator())),
+				},
+			},
+			FilesystemSpec: blockcfg.FilesystemSpec{
+				FilesystemType: block.FilesystemTypeEXT4,
+				EXT4Spec: blockcfg.EXT4Spec{
+					ReservedBlocksPercentage: pointer.To[uint32](1),
+				},
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: "pooled",
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme"`, celenv.DiskLocator())),
+				},
+				ProvisioningMaxSize: blockcfg.MustByteSize("100GiB"),
+				LVMSpec: blockcfg.LVMSpec{
+					VolumeGroupName: "vg-data",
+					ThinPoolName:    "pool0",
+				},
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: "mirrored",
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "sata" && !system_disk`, celenv.DiskLocator())),
+				},
+				RAIDSpec: blockcfg.RAIDSpec{
+					RAIDLevel:   "raid1",
+					RAIDDevices: 3,
+				},
+			},
+		},
+		&blockcfg.SwapVolumeConfigV1Alpha1{
+			MetaName: "swap1",
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`!system_disk`, celenv.DiskLocator())),
+				},
+				ProvisioningMaxSize: blockcfg.MustByteSize("4GiB"),
+			},
+			EncryptionSpec: blockcfg.EncryptionSpec{
+				EncryptionKeys: []blockcfg.EncryptionKey{
+					{
+						KeyNodeID: &blockcfg.EncryptionKeyNodeID{},
+					},
+				},
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(ctr)))
+
+	ctest.AssertResource(suite, constants.UserVolumePrefix+"data", func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.Contains(r.Metadata().Labels().Raw(), block.UserDiskLabel)
+
+		asrt.Equal(block.VolumeTypePartition, r.TypedSpec().Type)
+		asrt.Equal("/var/mnt/data", r.TypedSpec().Mount.TargetPath)
+
+		selector, err := r.TypedSpec().Provisioning.DiskSelector.Match.MarshalText()
+		asrt.NoError(err)
+		asrt.Equal(`!system_disk`, string(selector))
+
+		asrt.Equal("u-data", r.TypedSpec().Provisioning.PartitionSpec.Label)
+		asrt.EqualValues(partition.UserVolumeMinSize, r.TypedSpec().Provisioning.PartitionSpec.MinSize)
+		asrt.EqualValues(50*1024*1024*1024, r.TypedSpec().Provisioning.PartitionSpec.MaxSize)
+		asrt.False(r.TypedSpec().Provisioning.PartitionSpec.Grow)
+
+		locator, err := r.TypedSpec().Locator.Match.MarshalText()
+		asrt.NoError(err)
+		asrt.Equal(`volume.partition_label == "u-data"`, string(locator))
+
+		asrt.Equal(block.FilesystemTypeXFS, r.TypedSpec().Provisioning.FilesystemSpec.Type)
+		asrt.Nil(r.TypedSpec().Provisioning.FilesystemSpec.EXT4)
+	})
+
+	ctest.AssertResource(suite, constants.UserVolumePrefix+"cache", func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.Equal("/var/mnt/cache", r.TypedSpec().Mount.TargetPath)
+		asrt.EqualValues(partition.UserVolumeMinSize, r.TypedSpec().Provisioning.PartitionSpec.MinSize)
+
+		asrt.Equal(block.FilesystemTypeEXT4, r.TypedSpec().Provisioning.FilesystemSpec.Type)
+
+		if asrt.NotNil(r.TypedSpec().Provisioning.FilesystemSpec.EXT4) {
+			asrt.EqualValues(1, r.TypedSpec().Provisioning.FilesystemSpec.EXT4.ReservedBlocksPercentage)
+		}
+
+		asrt.Nil(r.TypedSpec().Provisioning.FilesystemSpec.BTRFS)
+	})
+
+	ctest.AssertResource(suite, constants.UserVolumePrefix+"pooled", func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.Contains(r.Metadata().Labels().Raw(), block.UserDiskLabel)
+
+		asrt.Equal(block.VolumeTypeLVM, r.TypedSpec().Type)
+		asrt.Equal("/var/mnt/pooled", r.TypedSpec().Mount.TargetPath)
+
+		asrt.Equal(block.LVMSpec{
+			VolumeGroup:   "vg-data",
+			LogicalVolume: "pooled",
+			ThinPool:      "pool0",
+		}, r.TypedSpec().Provisioning.LVMSpec)
+		asrt.EqualValues(100*1024*1024*1024, r.TypedSpec().Provisioning.PartitionSpec.MaxSize)
+		asrt.Empty(r.TypedSpec().Provisioning.PartitionSpec.Label)
+		asrt.True(r.TypedSpec().Locator.Match.IsZero())
+	})
+
+	ctest.AssertResource(suite, constants.UserVolumePrefix+"mirrored", func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.Equal(block.VolumeTypeRAID, r.TypedSpec().Type)
+		asrt.Equal("/var/mnt/mirrored", r.TypedSpec().Mount.TargetPath)
+
+		asrt.Equal(block.RAIDSpec{
+			Name:    "u-mirrored",
+			Level:   block.RAIDLevel1,
+			Devices: 3,
+		}, r.TypedSpec().Provisioning.RAIDSpec)
+		asrt.Empty(r.TypedSpec().Provisioning.PartitionSpec.Label)
+		asrt.True(r.TypedSpec().Locator.Match.IsZero())
+	})
+
+	ctest.AssertResource(suite, constants.SwapVolumePrefix+"swap1", func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.NotContains(r.Metadata().Labels().Raw(), block.UserDiskLabel)
+
+		asrt.Equal(block.VolumeTypePartition, r.TypedSpec().Type)
+		asrt.Empty(r.TypedSpec().Mount.TargetPath)
+
+		asrt.Equal("s-swap1", r.TypedSpec().Provisioning.PartitionSpec.Label)
+		asrt.Equal(partition.LinuxSwap, r.TypedSpec().Provisioning.PartitionSpec.TypeUUID)
+		asrt.EqualValues(4*1024*1024*1024, r.TypedSpec().Provisioning.PartitionSpec.MaxSize)
+		asrt.Equal(block.FilesystemTypeSwap, r.TypedSpec().Provisioning.FilesystemSpec.Type)
+
+		asrt.Equal(block.EncryptionProviderLUKS2, r.TypedSpec().Encryption.Provider)
+		asrt.Len(r.TypedSpec().Encryption.Keys, 1)
+	})
+
+	// EPHEMERAL is handled by VolumeConfigController
+	ctest.AssertNoResource[*block.VolumeConfig](suite, constants.UserVolumePrefix+constants.EphemeralPartitionLabel)
+
+	ctest.AssertResource(suite, block.UserDiskConfigStatusID, func(r *block.UserDiskConfigStatus, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Ready)
+	})
+}
@@ -0,0 +1,271 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/mount"
+	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type userVolumeMount struct {
+	point  *mountv2.Point
+	status runtimeres.MountStatusSpec
+}
+
+func (m userVolumeMount) equal(other userVolumeMount) bool {
+	return m.status.Source == other.status.Source &&
+		m.status.Target == other.status.Target &&
+		m.status.FilesystemType == other.status.FilesystemType &&
+		slices.Equal(m.status.Options, other.status.Options)
+}
+
+// UserVolumeMountController mounts the user disks and user volumes once the volumes are ready.
+//
+// The user volumes are mounted only while the EPHEMERAL volume is mounted, as the mount points are under /var.
+// The controller holds a finalizer on the EPHEMERAL mount status, so tearing down the EPHEMERAL mount status
+// unmounts the user volumes before EPHEMERAL is unmounted.
+type UserVolumeMountController struct {
+	V1Alpha1Mode runtimetalos.Mode
+
+	// Mount mounts the volume, defaults to mounting the mount point.
+	Mount func(logger *zap.Logger, point *mountv2.Point) error
+	// Unmount unmounts the volume, defaults to unmounting the mount point.
+	Unmount func(logger *zap.Logger, point *mountv2.Point) error
+
+	mounted map[string]userVolumeMount
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UserVolumeMountController) Name() string {
+	return "block.UserVolumeMountController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UserVolumeMountController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeConfigType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeLifecycleType,
+			ID:        optional.Some(block.VolumeLifecycleID),
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      runtimeres.MountStatusType,
+			ID:        optional.Some(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputStrong,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UserVolumeMountController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.MountStatusType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *UserVolumeMountController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// user disks are not mounted in container mode
+	if ctrl.V1Alpha1Mode == runtimetalos.ModeContainer {
+		return nil
+	}
+
+	if ctrl.Mount == nil {
+		ctrl.Mount = func(logger *zap.Logger, point *mountv2.Point) error {
+			_, err := point.Mount(mountv2.WithMountPrinter(logger.Sugar().Infof))
+
+			return err
+		}
+	}
+
+	if ctrl.Unmount == nil {
+		ctrl.Unmount = func(logger *zap.Logger, point *mountv2.Point) error {
+			return point.Unmount(mountv2.WithUnmountPrinter(logger.Sugar().Infof))
+		}
+	}
+
+	if ctrl.mounted == nil {
+		ctrl.mounted = map[string]userVolumeMount{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		volumeLifecycle, err := safe.ReaderGetByID[*block.VolumeLifecycle](ctx, r, block.VolumeLifecycleID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching volume lifecycle: %w", err)
+		}
+
+		if volumeLifecycle == nil {
+			// no volume lifecycle, cease all operations
+			continue
+		}
+
+		tearingDown := volumeLifecycle.Metadata().Phase() == resource.PhaseTearingDown
+
+		if !tearingDown {
+			if err = r.AddFinalizer(ctx, volumeLifecycle.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer to volume lifecycle: %w", err)
+			}
+		}
+
+		ephemeralMountStatus, err := safe.ReaderGetByID[*runtimeres.MountStatus](ctx, r, constants.EphemeralPartitionLabel)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching EPHEMERAL mount status: %w", err)
+		}
+
+		ephemeralMounted := ephemeralMountStatus != nil && ephemeralMountStatus.Metadata().Phase() == resource.PhaseRunning
+
+		if !tearingDown && ephemeralMounted {
+			if err = r.AddFinalizer(ctx, ephemeralMountStatus.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer to EPHEMERAL mount status: %w", err)
+			}
+		}
+
+		// user volumes which are ready are mounted, unless the volumes are being torn down
+		desiredMounts := map[string]userVolumeMount{}
+
+		if !tearingDown && ephemeralMounted {
+			volumeConfigs, err := safe.ReaderListAll[*block.VolumeConfig](ctx, r, state.WithLabelQuery(resource.LabelExists(block.UserDiskLabel)))
+			if err != nil {
+				return fmt.Errorf("error fetching volume configs: %w", err)
+			}
+
+			for volumeConfig := range volumeConfigs.All() {
+				if volumeConfig.TypedSpec().Mount.TargetPath == "" {
+					continue
+				}
+
+				volumeStatus, err := safe.ReaderGetByID[*block.VolumeStatus](ctx, r, volumeConfig.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						continue
+					}
+
+					return fmt.Errorf("error fetching volume status: %w", err)
+				}
+
+				if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady {
+					continue
+				}
+
+				point := mountv2.NewPoint(
+					volumeStatus.TypedSpec().MountLocation,
+					volumeConfig.TypedSpec().Mount.TargetPath,
+					volumeStatus.TypedSpec().Filesystem.String(),
+					mount.VolumeMountOptions(volumeConfig.TypedSpec())...,
+				)
+
+				desiredMounts[volumeConfig.Metadata().ID()] = userVolumeMount{
+					point: point,
+					status: runtimeres.MountStatusSpec{
+						Source:         volumeStatus.TypedSpec().MountLocation,
+						Target:         volumeConfig.TypedSpec().Mount.TargetPath,
+						FilesystemType: volumeStatus.TypedSpec().Filesystem.String(),
+						Options:        point.Options(),
+						Encrypted:      volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderNone,
+					},
+				}
+			}
+		}
+
+		for id, mounted := range ctrl.mounted {
+			if desired, ok := desiredMounts[id]; ok && desired.equal(mounted) {
+				continue
+			}
+
+			logger.Info("unmounting user volume", zap.String("volume", id), zap.String("target", mounted.status.Target))
+
+			if err = ctrl.Unmount(logger, mounted.point); err != nil {
+				return fmt.Errorf("error unmounting user volume %q: %w", id, err)
+			}
+
+			delete(ctrl.mounted, id)
+		}
+
+		for id, desired := range desiredMounts {
+			if _, mounted := ctrl.mounted[id]; mounted {
+				continue
+			}
+
+			logger.Info("mounting user volume", zap.String("volume", id), zap.String("source", desired.status.Source), zap.String("target", desired.status.Target))
+
+			if err = ctrl.Mount(logger, desired.point); err != nil {
+				return fmt.Errorf("error mounting user volume %q: %w", id, err)
+			}
+
+			ctrl.mounted[id] = desired
+		}
+
+		r.StartTrackingOutputs()
+
+		for id, mounted := range ctrl.mounted {
+			if err = safe.WriterModify(ctx, r, runtimeres.NewMountStatus(v1alpha1.NamespaceName, id), func(status *runtimeres.MountStatus) error {
+				*status.TypedSpec() = mounted.status
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating mount status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtimeres.MountStatus](ctx, r); err != nil {
+			return fmt.Errorf("error cleaning up mount statuses: %w", err)
+		}
+
+		if len(ctrl.mounted) > 0 {
+			continue
+		}
+
+		if ephemeralMountStatus != nil && (tearingDown || !ephemeralMounted) {
+			if err = r.RemoveFinalizer(ctx, ephemeralMountStatus.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error removing finalizer from EPHEMERAL mount status: %w", err)
+			}
+		}
+
+		if tearingDown {
+			if err = r.RemoveFinalizer(ctx, volumeLifecycle.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error removing finalizer from volume lifecycle: %w", err)
+			}
+		}
+	}
+}
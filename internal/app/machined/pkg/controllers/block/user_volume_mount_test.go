@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	blockctrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type fakeMounts struct {
+	mu sync.Mutex
+
+	mounted map[*mountv2.Point]struct{}
+}
+
+func (m *fakeMounts) mount(_ *zap.Logger, point *mountv2.Point) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mounted[point] = struct{}{}
+
+	return nil
+}
+
+func (m *fakeMounts) unmount(_ *zap.Logger, point *mountv2.Point) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.mounted, point)
+
+	return nil
+}
+
+func (m *fakeMounts) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.mounted)
+}
+
+type UserVolumeMountSuite struct {
+	ctest.DefaultSuite
+
+	mounts *fakeMounts
+}
+
+func TestUserVolumeMountSuite(t *testing.T) {
+	t.Parallel()
+
+	mounts := &fakeMounts{
+		mounted: map[*mountv2.Point]struct{}{},
+	}
+
+	suite.Run(t, &UserVolumeMountSuite{
+		mounts: mounts,
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 3 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&blockctrls.UserVolumeMountController{
+					Mount:   mounts.mount,
+					Unmount: mounts.unmount,
+				}))
+			},
+		},
+	})
+}
+
+func (suite *UserVolumeMountSuite) createVolume(id, targetPath string, userDisk bool, phase block.VolumePhase) *block.VolumeStatus {
+	volumeConfig := block.NewVolumeConfig(block.NamespaceName, id)
+	volumeConfig.TypedSpec().Mount.TargetPath = targetPath
+
+	if userDisk {
+		volumeConfig.Metadata().Labels().Set(block.UserDiskLabel, "")
+	}
+
+	suite.Create(volumeConfig)
+
+	volumeStatus := block.NewVolumeStatus(block.NamespaceName, id)
+	volumeStatus.TypedSpec().Phase = phase
+	volumeStatus.TypedSpec().MountLocation = "/dev/" + id
+	volumeStatus.TypedSpec().Filesystem = block.FilesystemTypeXFS
+	suite.Create(volumeStatus)
+
+	return volumeStatus
+}
+
+func (suite *UserVolumeMountSuite) TestReconcile() {
+	volumeLifecycle := block.NewVolumeLifecycle(block.NamespaceName, block.VolumeLifecycleID)
+	suite.Create(volumeLifecycle)
+
+	suite.createVolume("u-data", "/var/mnt/data", true, block.VolumePhaseReady)
+	lateVolume := suite.createVolume("u-late", "/var/mnt/late", true, block.VolumePhaseMissing)
+	suite.createVolume("SYSTEM", "/system/data", false, block.VolumePhaseReady)
+
+	// EPHEMERAL is not mounted yet, so user volumes are not mounted
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "u-data")
+
+	ephemeralMountStatus := runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel)
+	ephemeralMountStatus.TypedSpec().Target = constants.EphemeralMountPoint
+	suite.Create(ephemeralMountStatus)
+
+	ctest.AssertResource(suite, "u-data", func(r *runtimeres.MountStatus, asrt *assert.Assertions) {
+		asrt.Equal("/dev/u-data", r.TypedSpec().Source)
+		asrt.Equal("/var/mnt/data", r.TypedSpec().Target)
+		asrt.Equal("xfs", r.TypedSpec().FilesystemType)
+	})
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "u-late")
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "SYSTEM")
+
+	suite.Assert().Equal(1, suite.mounts.count())
+
+	// the missing volume is mounted once it becomes ready (e.g. the disk is attached later)
+	ctest.UpdateWithConflicts(suite, lateVolume, func(vs *block.VolumeStatus) error {
+		vs.TypedSpec().Phase = block.VolumePhaseReady
+
+		return nil
+	})
+
+	ctest.AssertResource(suite, "u-late", func(r *runtimeres.MountStatus, asrt *assert.Assertions) {
+		asrt.Equal("/var/mnt/late", r.TypedSpec().Target)
+	})
+
+	suite.Assert().Equal(2, suite.mounts.count())
+
+	// removing the volume config unmounts the volume
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), block.NewVolumeConfig(block.NamespaceName, "u-late").Metadata()))
+
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "u-late")
+
+	suite.Assert().Equal(1, suite.mounts.count())
+
+	// tearing down the volume lifecycle unmounts all volumes and releases the lifecycle
+	_, err := suite.State().Teardown(suite.Ctx(), volumeLifecycle.Metadata())
+	suite.Require().NoError(err)
+
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "u-data")
+	ctest.AssertResource(suite, block.VolumeLifecycleID, func(r *block.VolumeLifecycle, asrt *assert.Assertions) {
+		asrt.Equal(resource.PhaseTearingDown, r.Metadata().Phase())
+		asrt.True(r.Metadata().Finalizers().Empty())
+	})
+
+	suite.Assert().Equal(0, suite.mounts.count())
+
+	// the controller releases the EPHEMERAL mount status
+	ctest.AssertResource(suite, constants.EphemeralPartitionLabel, func(r *runtimeres.MountStatus, asrt *assert.Assertions) {
+		asrt.True(r.Metadata().Finalizers().Empty())
+	})
+}
+
+func (suite *UserVolumeMountSuite) TestEphemeralTeardown() {
+	volumeLifecycle := block.NewVolumeLifecycle(block.NamespaceName, block.VolumeLifecycleID)
+	suite.Create(volumeLifecycle)
+
+	suite.createVolume("/dev/sdb-1", "/var/lib/extra", true, block.VolumePhaseReady)
+	suite.createVolume("u-data", "/var/mnt/data", true, block.VolumePhaseReady)
+
+	ephemeralMountStatus := runtimeres.NewMountStatus(v1alpha1.NamespaceName, constants.EphemeralPartitionLabel)
+	ephemeralMountStatus.TypedSpec().Target = constants.EphemeralMountPoint
+	suite.Create(ephemeralMountStatus)
+
+	ctest.AssertResources(suite, []resource.ID{"/dev/sdb-1", "u-data"}, func(*runtimeres.MountStatus, *assert.Assertions) {})
+
+	// the controller holds the EPHEMERAL mount status while the user volumes are mounted
+	ctest.AssertResource(suite, constants.EphemeralPartitionLabel, func(r *runtimeres.MountStatus, asrt *assert.Assertions) {
+		asrt.True(r.Metadata().Finalizers().Has((&blockctrls.UserVolumeMountController{}).Name()))
+	})
+
+	suite.Assert().Equal(2, suite.mounts.count())
+
+	// tearing down the EPHEMERAL mount status unmounts the user volumes before EPHEMERAL is unmounted
+	_, err := suite.State().Teardown(suite.Ctx(), ephemeralMountStatus.Metadata())
+	suite.Require().NoError(err)
+
+	_, err = suite.State().WatchFor(suite.Ctx(), ephemeralMountStatus.Metadata(), state.WithFinalizerEmpty())
+	suite.Require().NoError(err)
+
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "/dev/sdb-1")
+	ctest.AssertNoResource[*runtimeres.MountStatus](suite, "u-data")
+
+	suite.Assert().Equal(0, suite.mounts.count())
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), ephemeralMountStatus.Metadata()))
+
+	// the volume lifecycle is still held, as the controller is not torn down
+	ctest.AssertResource(suite, block.VolumeLifecycleID, func(r *block.VolumeLifecycle, asrt *assert.Assertions) {
+		asrt.False(r.Metadata().Finalizers().Empty())
+	})
+}
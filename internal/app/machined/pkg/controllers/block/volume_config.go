@@ -85,7 +85,32 @@ func systemDiskMatch() cel.Expression {
 	return cel.MustExpression(cel.ParseBooleanExpression("system_disk", celenv.DiskLocator()))
 }
 
-func (ctrl *VolumeConfigController) convertEncryption(in cfg.Encryption, out *block.VolumeConfigSpec) error {
+// convertRAID switches the volume to be provisioned on the md array, if RAID provisioning is configured.
+func convertRAID(name string, raid cfg.VolumeRAIDConfig, spec *block.VolumeConfigSpec) {
+	level, ok := raid.Level().Get()
+	if !ok {
+		return
+	}
+
+	devices, ok := raid.Devices().Get()
+	if !ok {
+		devices, _ = block.RAIDMinDevices(level)
+	}
+
+	// md array is located by the array name
+	spec.Type = block.VolumeTypeRAID
+	spec.Provisioning.PartitionSpec.Label = ""
+	spec.Provisioning.PartitionSpec.TypeUUID = ""
+	spec.Provisioning.PartitionSpec.Grow = false
+	spec.Provisioning.RAIDSpec = block.RAIDSpec{
+		Name:    name,
+		Level:   level,
+		Devices: devices,
+	}
+	spec.Locator = block.LocatorSpec{}
+}
+
+func convertEncryption(in cfg.Encryption, out *block.VolumeConfigSpec) error {
 	if in == nil {
 		out.Encryption = block.EncryptionSpec{}
 
@@ -121,6 +146,9 @@ func (ctrl *VolumeConfigController) convertEncryption(in cfg.Encryption, out *bl
 		case key.TPM() != nil:
 			out.Encryption.Keys[i].Type = block.EncryptionKeyTPM
 			out.Encryption.Keys[i].TPMCheckSecurebootStatusOnEnroll = key.TPM().CheckSecurebootOnEnroll()
+		case key.Vault() != nil:
+			out.Encryption.Keys[i].Type = block.EncryptionKeyVault
+			out.Encryption.Keys[i].Vault = convertVaultKey(key.Vault())
 		default:
 			return fmt.Errorf("unsupported encryption key type: slot %d", key.Slot())
 		}
@@ -129,6 +157,33 @@ func (ctrl *VolumeConfigController) convertEncryption(in cfg.Encryption, out *bl
 	return nil
 }
 
+func convertVaultKey(in cfg.EncryptionKeyVault) block.EncryptionKeyVaultSpec {
+	out := block.EncryptionKeyVaultSpec{
+		Address:      in.Address(),
+		CACert:       in.CA(),
+		TransitMount: in.TransitMount(),
+		TransitKey:   in.TransitKey(),
+	}
+
+	if appRole := in.AppRole(); appRole != nil {
+		out.AppRoleMount = appRole.MountPath()
+		out.AppRoleRoleID = appRole.RoleID()
+		out.AppRoleSecretID = appRole.SecretID()
+	}
+
+	if cert := in.Cert(); cert != nil {
+		out.CertMount = cert.MountPath()
+		out.CertRole = cert.Name()
+
+		if identity := cert.ClientIdentity(); identity != nil {
+			out.CertClientCert = identity.Crt
+			out.CertClientKey = identity.Key
+		}
+	}
+
+	return out
+}
+
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo
@@ -239,7 +294,9 @@ func (ctrl *VolumeConfigController) manageEphemeral(config cfg.Config) func(vc *
 			Match: labelVolumeMatch(constants.EphemeralPartitionLabel),
 		}
 
-		if err := ctrl.convertEncryption(
+		convertRAID(constants.EphemeralPartitionLabel, extraVolumeConfig.Provisioning().RAID(), vc.TypedSpec())
+
+		if err := convertEncryption(
 			config.Machine().SystemDiskEncryption().Get(constants.EphemeralPartitionLabel),
 			vc.TypedSpec(),
 		); err != nil {
@@ -278,7 +335,7 @@ func (ctrl *VolumeConfigController) manageStateConfigPresent(config cfg.Config)
 			Match: labelVolumeMatch(constants.StatePartitionLabel),
 		}
 
-		if err := ctrl.convertEncryption(
+		if err := convertEncryption(
 			config.Machine().SystemDiskEncryption().Get(constants.StatePartitionLabel),
 			vc.TypedSpec(),
 		); err != nil {
@@ -313,7 +370,7 @@ func (ctrl *VolumeConfigController) manageStateNoConfig(encryptionMeta *runtime.
 				return fmt.Errorf("error unmarshalling state encryption meta key: %w", err)
 			}
 
-			if err := ctrl.convertEncryption(
+			if err := convertEncryption(
 				encryptionFromMeta,
 				vc.TypedSpec(),
 			); err != nil {
@@ -136,6 +136,17 @@ func (suite *VolumeConfigSuite) TestReconcileEncryptedSTATE() {
 				KeySlot: 2,
 				KeyTPM:  &v1alpha1.EncryptionKeyTPM{},
 			},
+			{
+				KeySlot: 3,
+				KeyVault: &v1alpha1.EncryptionKeyVault{
+					VaultAddress:    "https://vault.example.com:8200",
+					VaultTransitKey: "talos",
+					VaultAppRole: &v1alpha1.EncryptionKeyVaultAppRole{
+						AppRoleRoleID:   "role",
+						AppRoleSecretID: "secret",
+					},
+				},
+			},
 		},
 	}
 
@@ -157,9 +168,9 @@ func (suite *VolumeConfigSuite) TestReconcileEncryptedSTATE() {
 		asrt.NotEmpty(r.TypedSpec().Encryption)
 
 		asrt.Equal(block.EncryptionProviderLUKS2, r.TypedSpec().Encryption.Provider)
-		asrt.Len(r.TypedSpec().Encryption.Keys, 2)
+		asrt.Len(r.TypedSpec().Encryption.Keys, 3)
 
-		if len(r.TypedSpec().Encryption.Keys) != 2 {
+		if len(r.TypedSpec().Encryption.Keys) != 3 {
 			return
 		}
 
@@ -170,6 +181,17 @@ func (suite *VolumeConfigSuite) TestReconcileEncryptedSTATE() {
 
 		asrt.Equal(2, r.TypedSpec().Encryption.Keys[1].Slot)
 		asrt.Equal(block.EncryptionKeyTPM, r.TypedSpec().Encryption.Keys[1].Type)
+
+		asrt.Equal(3, r.TypedSpec().Encryption.Keys[2].Slot)
+		asrt.Equal(block.EncryptionKeyVault, r.TypedSpec().Encryption.Keys[2].Type)
+		asrt.Equal(block.EncryptionKeyVaultSpec{
+			Address:         "https://vault.example.com:8200",
+			TransitMount:    "transit",
+			TransitKey:      "talos",
+			AppRoleMount:    "approle",
+			AppRoleRoleID:   "role",
+			AppRoleSecretID: "secret",
+		}, r.TypedSpec().Encryption.Keys[2].Vault)
 	})
 	ctest.AssertNoResource[*block.VolumeConfig](suite, constants.EphemeralPartitionLabel)
 
@@ -260,3 +282,53 @@ func (suite *VolumeConfigSuite) TestReconcileExtraEPHEMERALConfig() {
 		asrt.EqualValues(partition.EphemeralMinSize, r.TypedSpec().Provisioning.PartitionSpec.MinSize)
 	})
 }
+
+func (suite *VolumeConfigSuite) TestReconcileRAIDEPHEMERALConfig() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		&blockcfg.VolumeConfigV1Alpha1{
+			MetaName: constants.EphemeralPartitionLabel,
+			ProvisioningSpec: blockcfg.ProvisioningSpec{
+				DiskSelectorSpec: blockcfg.DiskSelector{
+					Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme" && !system_disk`, celenv.DiskLocator())),
+				},
+				RAIDSpec: blockcfg.RAIDSpec{
+					RAIDLevel: "raid10",
+				},
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	ctest.AssertResource(suite, constants.EphemeralPartitionLabel, func(r *block.VolumeConfig, asrt *assert.Assertions) {
+		asrt.Equal(block.VolumeTypeRAID, r.TypedSpec().Type)
+		asrt.True(r.TypedSpec().Locator.Match.IsZero())
+
+		asrt.Equal(block.RAIDSpec{
+			Name:    constants.EphemeralPartitionLabel,
+			Level:   block.RAIDLevel10,
+			Devices: 4,
+		}, r.TypedSpec().Provisioning.RAIDSpec)
+
+		asrt.False(r.TypedSpec().Provisioning.PartitionSpec.Grow)
+		asrt.Empty(r.TypedSpec().Provisioning.PartitionSpec.Label)
+		asrt.EqualValues(partition.EphemeralMinSize, r.TypedSpec().Provisioning.PartitionSpec.MinSize)
+		asrt.Equal(constants.EphemeralPartitionLabel, r.TypedSpec().Provisioning.FilesystemSpec.Label)
+	})
+}
@@ -15,17 +15,20 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/block/internal/volumes"
+	"github.com/siderolabs/talos/internal/pkg/encryption"
 	blockpb "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/block"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
 
 // VolumeManagerController manages volumes in the system, converting VolumeConfig resources to VolumeStatuses.
@@ -77,12 +80,23 @@ func (ctrl *VolumeManagerController) Inputs() []controller.Input {
 			ID:        optional.Some(hardware.SystemInformationID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: block.NamespaceName,
 			Type:      block.VolumeLifecycleType,
 			ID:        optional.Some(block.VolumeLifecycleID),
 			Kind:      controller.InputStrong,
 		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.KeySlotRefreshRequestType,
+			Kind:      controller.InputStrong,
+		},
 	}
 }
 
@@ -127,6 +141,11 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 			shouldRetry = false
 		}
 
+		// key slots changed via the machine API
+		if err := ctrl.refreshRequestedEncryptionKeySlots(ctx, r, logger); err != nil {
+			return err
+		}
+
 		// if devices are not ready, we can't provision and locate most volumes
 		devicesStatus, err := safe.ReaderGetByID[*runtime.DevicesStatus](ctx, r, runtime.DevicesID)
 		if err != nil && !state.IsNotFoundError(err) {
@@ -186,6 +205,17 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 			return fmt.Errorf("error fetching system information: %w", err)
 		}
 
+		apiCerts, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error fetching API certificates: %w", err)
+		}
+
+		var nodeIdentity *x509.PEMEncodedCertificateAndKey
+
+		if apiCerts != nil {
+			nodeIdentity = apiCerts.TypedSpec().NodeIdentity
+		}
+
 		volumeLifecycle, err := safe.ReaderGetByID[*block.VolumeLifecycle](ctx, r, block.VolumeLifecycleID)
 		if err != nil && !state.IsNotFoundError(err) {
 			return fmt.Errorf("error fetching volume lifecycle: %w", err)
@@ -313,6 +343,7 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 					DevicesReady:            devicesReady,
 					PreviousWaveProvisioned: vc.TypedSpec().Provisioning.Wave <= fullyProvisionedWave,
 					SystemInformation:       systemInfo,
+					NodeIdentity:            nodeIdentity,
 					Lifecycle:               volumeLifecycle,
 				},
 			); err != nil {
@@ -359,6 +390,101 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 	}
 }
 
+// refreshRequestedEncryptionKeySlots updates the key slot layout of the volumes with the pending key slot refresh requests.
+//
+// The handled request is acknowledged with the controller finalizer, which is removed when the requester tears the request down.
+func (ctrl *VolumeManagerController) refreshRequestedEncryptionKeySlots(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	refreshRequests, err := safe.ReaderListAll[*block.KeySlotRefreshRequest](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error fetching key slot refresh requests: %w", err)
+	}
+
+	requested := map[resource.ID]struct{}{}
+
+	for refreshRequest := range refreshRequests.All() {
+		handled := refreshRequest.Metadata().Finalizers().Has(ctrl.Name())
+
+		switch {
+		case refreshRequest.Metadata().Phase() == resource.PhaseTearingDown:
+			if handled {
+				if err = r.RemoveFinalizer(ctx, refreshRequest.Metadata(), ctrl.Name()); err != nil {
+					return fmt.Errorf("error removing finalizer from key slot refresh request: %w", err)
+				}
+			}
+		case !handled:
+			requested[refreshRequest.Metadata().ID()] = struct{}{}
+		}
+	}
+
+	if len(requested) == 0 {
+		return nil
+	}
+
+	if err = ctrl.refreshEncryptionKeySlots(ctx, r, logger, func(id resource.ID) bool {
+		_, ok := requested[id]
+
+		return ok
+	}); err != nil {
+		return err
+	}
+
+	for id := range requested {
+		if err = r.AddFinalizer(ctx, block.NewKeySlotRefreshRequest(block.NamespaceName, id).Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error adding finalizer to key slot refresh request: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// refreshEncryptionKeySlots updates the key slot layout of the ready encrypted volumes matching the filter.
+func (ctrl *VolumeManagerController) refreshEncryptionKeySlots(ctx context.Context, r controller.Runtime, logger *zap.Logger, filter func(resource.ID) bool) error {
+	volumeStatuses, err := safe.ReaderListAll[*block.VolumeStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error fetching volume statuses: %w", err)
+	}
+
+	for volumeStatus := range volumeStatuses.All() {
+		if !filter(volumeStatus.Metadata().ID()) {
+			continue
+		}
+
+		if volumeStatus.TypedSpec().Phase != block.VolumePhaseReady || volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderLUKS2 {
+			continue
+		}
+
+		volumeConfig, err := safe.ReaderGetByID[*block.VolumeConfig](ctx, r, volumeStatus.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error fetching volume configuration: %w", err)
+		}
+
+		keySlots, err := encryption.ReadKeySlots(volumeStatus.TypedSpec().Location, volumeConfig.TypedSpec().Encryption)
+		if err != nil {
+			logger.Warn("failed to refresh encryption key slots", zap.String("volume", volumeStatus.Metadata().ID()), zap.Error(err))
+
+			continue
+		}
+
+		if slices.Equal(keySlots, volumeStatus.TypedSpec().EncryptionKeySlots) {
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, block.NewVolumeStatus(block.NamespaceName, volumeStatus.Metadata().ID()), func(vs *block.VolumeStatus) error {
+			vs.TypedSpec().EncryptionKeySlots = keySlots
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating volume status: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // processVolumeConfig implements the volume configuration automata.
 //
 //	Initial -> { Waiting }  ----> { Missing } // volume is not found (by locator)
@@ -7,6 +7,7 @@ package hardware
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,7 +19,9 @@ import (
 	"go.uber.org/zap"
 
 	runtimetalos "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/kobject"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
 // PCIDevicesController populates PCI device information.
@@ -33,7 +36,14 @@ func (ctrl *PCIDevicesController) Name() string {
 
 // Inputs implements controller.Controller interface.
 func (ctrl *PCIDevicesController) Inputs() []controller.Input {
-	return nil
+	return []controller.Input{
+		{
+			// rescan PCI devices when SR-IOV virtual functions are created or removed
+			Namespace: network.NamespaceName,
+			Type:      network.SRIOVVFStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
 }
 
 // Outputs implements controller.Controller interface.
@@ -55,12 +65,30 @@ func (ctrl *PCIDevicesController) Run(ctx context.Context, r controller.Runtime,
 		return nil
 	}
 
-	// [TODO]: a single run for now, need to figure out how to trigger rescan
+	// start the watcher first, so that the devices hotplugged during the initial scan are not missed
+	watcher, err := kobject.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create kobject watcher: %w", err)
+	}
+
+	defer watcher.Close() //nolint:errcheck
+
+	watchCh := watcher.Run(logger)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case ev, ok := <-watchCh:
+			if !ok {
+				return errors.New("kobject watcher stopped")
+			}
+
+			// rescan PCI devices when devices are hotplugged
+			if ev.Subsystem != "pci" || (ev.Action != kobject.ActionAdd && ev.Action != kobject.ActionRemove) {
+				continue
+			}
 		}
 
 		deviceIDs, err := os.ReadDir("/sys/bus/pci/devices")
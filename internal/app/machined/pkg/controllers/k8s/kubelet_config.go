@@ -76,8 +76,10 @@ func NewKubeletConfigController() *KubeletConfigController {
 				kubeletConfig.SkipNodeRegistration = cfgProvider.Machine().Kubelet().SkipNodeRegistration()
 				kubeletConfig.StaticPodListURL = staticPodURL.TypedSpec().URL
 				kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
-				kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled()
+				// EPHEMERAL is mounted with project quotas if directory quotas are configured
+				kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled() || len(cfgProvider.ProjectQuotas()) > 0
 				kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
+				kubeletConfig.SwapBehavior = cfgProvider.Machine().Kubelet().MemorySwap().SwapBehavior()
 
 				return nil
 			},
@@ -347,6 +347,10 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec, kubeletVersion comp
 		config.FailSwapOn = pointer.To(false)
 	}
 
+	if config.MemorySwap.SwapBehavior == "" {
+		config.MemorySwap.SwapBehavior = cfgSpec.SwapBehavior
+	}
+
 	if len(config.SystemReserved) == 0 {
 		config.SystemReserved = map[string]string{
 			"cpu":               constants.KubeletSystemReservedCPU,
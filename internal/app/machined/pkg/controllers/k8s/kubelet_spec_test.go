@@ -498,6 +498,37 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 			},
 			machineType: machine.TypeWorker,
 		},
+		{
+			name: "limited swap",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				SwapBehavior:  "LimitedSwap",
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.31.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.MemorySwap.SwapBehavior = "LimitedSwap"
+			},
+			machineType: machine.TypeWorker,
+		},
+		{
+			name: "swap behavior in extra config",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				SwapBehavior:  "LimitedSwap",
+				ExtraConfig: map[string]any{
+					"memorySwap": map[string]any{
+						"swapBehavior": "NoSwap",
+					},
+				},
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.31.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.MemorySwap.SwapBehavior = "NoSwap"
+			},
+			machineType: machine.TypeWorker,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -17,6 +17,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/labels"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
@@ -42,6 +43,11 @@ func (ctrl *NodeAnnotationSpecController) Inputs() []controller.Input {
 			Type:      runtime.ExtensionStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DelegatedPrefixType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -81,6 +87,24 @@ func (ctrl *NodeAnnotationSpecController) Run(ctx context.Context, r controller.
 			}
 		}
 
+		delegatedPrefixes, err := safe.ReaderListAll[*network.DelegatedPrefix](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing delegated prefixes: %w", err)
+		}
+
+		// subnets of the delegated prefixes (e.g. for the CNI), explicit annotations from the config take precedence
+		for delegatedPrefix := range delegatedPrefixes.All() {
+			for _, assignment := range delegatedPrefix.TypedSpec().Assignments {
+				if assignment.NodeAnnotation == "" {
+					continue
+				}
+
+				if _, exists := nodeAnnotations[assignment.NodeAnnotation]; !exists {
+					nodeAnnotations[assignment.NodeAnnotation] = assignment.Prefix.String()
+				}
+			}
+		}
+
 		if err = extensionsToNodeKV(
 			ctx, r, nodeAnnotations,
 			func(annotationValue string) bool {
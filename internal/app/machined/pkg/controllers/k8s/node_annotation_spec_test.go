@@ -5,6 +5,7 @@
 package k8s_test
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
@@ -120,3 +122,43 @@ func (suite *NodeAnnotationsSuite) TestExtensionAnnotations() {
 			asrt.Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", labelSpec.TypedSpec().Value)
 		})
 }
+
+func (suite *NodeAnnotationsSuite) TestDelegatedPrefixAnnotations() {
+	suite.updateMachineConfig(map[string]string{
+		"example.com/explicit": "fromConfig",
+	})
+
+	delegatedPrefix := network.NewDelegatedPrefix(network.NamespaceName, "eth0/2001:db8:0:100::/56")
+	delegatedPrefix.TypedSpec().LinkName = "eth0"
+	delegatedPrefix.TypedSpec().Prefix = netip.MustParsePrefix("2001:db8:0:100::/56")
+	delegatedPrefix.TypedSpec().Assignments = []network.DelegatedPrefixAssignment{
+		{
+			Prefix:   netip.MustParsePrefix("2001:db8:0:101::/64"),
+			LinkName: "eth1",
+		},
+		{
+			Prefix:         netip.MustParsePrefix("2001:db8:0:102::/64"),
+			NodeAnnotation: "network.cilium.io/ipv6-pod-cidr",
+		},
+		{
+			Prefix:         netip.MustParsePrefix("2001:db8:0:103::/64"),
+			NodeAnnotation: "example.com/explicit",
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), delegatedPrefix))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"network.cilium.io/ipv6-pod-cidr"},
+		func(annotationSpec *k8s.NodeAnnotationSpec, asrt *assert.Assertions) {
+			asrt.Equal("2001:db8:0:102::/64", annotationSpec.TypedSpec().Value)
+		})
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{"example.com/explicit"},
+		func(annotationSpec *k8s.NodeAnnotationSpec, asrt *assert.Assertions) {
+			asrt.Equal("fromConfig", annotationSpec.TypedSpec().Value)
+		})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), delegatedPrefix.Metadata()))
+
+	rtestutils.AssertNoResource[*k8s.NodeAnnotationSpec](suite.Ctx(), suite.T(), suite.State(), "network.cilium.io/ipv6-pod-cidr")
+}
@@ -14,13 +14,15 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/value"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
-	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -38,11 +40,22 @@ func (ctrl *AddressConfigController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *AddressConfigController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.DeviceConfigSpecType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -88,7 +101,7 @@ func (ctrl *AddressConfigController) Run(ctx context.Context, r controller.Runti
 
 		ignoredInterfaces := map[string]struct{}{}
 
-		devices := make([]config.Device, len(items.Items))
+		devices := make([]talosconfig.Device, len(items.Items))
 
 		for i, item := range items.Items {
 			device := item.(*network.DeviceConfigSpec).TypedSpec().Device
@@ -133,6 +146,30 @@ func (ctrl *AddressConfigController) Run(ctx context.Context, r controller.Runti
 			}
 		}
 
+		// parse network configuration documents for static addresses
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg != nil && len(cfg.Config().NetworkStaticAddressConfigs()) > 0 {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			ids, err := ctrl.apply(ctx, r, ctrl.processStaticAddressConfigs(cfg.Config(), resolver))
+			if err != nil {
+				return fmt.Errorf("error applying static address configuration: %w", err)
+			}
+
+			for _, id := range ids {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
 		// list addresses for cleanup
 		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.AddressSpecType, "", resource.VersionUndefined))
 		if err != nil {
@@ -251,7 +288,7 @@ func parseIPOrIPPrefix(address string) (netip.Prefix, error) {
 	return netip.PrefixFrom(ip, ip.BitLen()), nil
 }
 
-func (ctrl *AddressConfigController) processDevicesConfiguration(logger *zap.Logger, devices []config.Device) (addresses []network.AddressSpecSpec) {
+func (ctrl *AddressConfigController) processDevicesConfiguration(logger *zap.Logger, devices []talosconfig.Device) (addresses []network.AddressSpecSpec) {
 	for _, device := range devices {
 		if device.Ignore() {
 			continue
@@ -312,3 +349,32 @@ func (ctrl *AddressConfigController) processDevicesConfiguration(logger *zap.Log
 
 	return addresses
 }
+
+func (ctrl *AddressConfigController) processStaticAddressConfigs(cfg talosconfig.Config, resolver *linkResolver) (addresses []network.AddressSpecSpec) {
+	for _, addressConfig := range cfg.NetworkStaticAddressConfigs() {
+		linkName, ok := resolver.Resolve(addressConfig.Link())
+		if !ok {
+			continue
+		}
+
+		for _, ipPrefix := range addressConfig.Addresses() {
+			address := network.AddressSpecSpec{
+				Address:     ipPrefix,
+				Scope:       nethelpers.ScopeGlobal,
+				LinkName:    linkName,
+				ConfigLayer: network.ConfigMachineConfiguration,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+			}
+
+			if address.Address.Addr().Is6() {
+				address.Family = nethelpers.FamilyInet6
+			} else {
+				address.Family = nethelpers.FamilyInet4
+			}
+
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -235,6 +236,58 @@ func (suite *AddressConfigSuite) TestMachineConfiguration() {
 	)
 }
 
+func (suite *AddressConfigSuite) TestNetworkDocuments() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.AddressConfigController{}))
+
+	suite.startRuntime()
+
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "enp0s2")
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+	linkStatus.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x3c, 0xfd, 0xfe, 0x12, 0x34, 0x56}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, linkStatus))
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: LinkConfig
+name: uplink
+selector:
+  match: mac(link.hardware_addr) == "3c:fd:fe:12:34:56"
+---
+apiVersion: v1alpha1
+kind: StaticAddressConfig
+name: uplink
+addresses:
+  - 192.168.1.10/24
+  - 2001:db8::10/64
+---
+apiVersion: v1alpha1
+kind: StaticAddressConfig
+name: eth5
+addresses:
+  - 10.5.0.7/24
+`))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(provider)))
+
+	suite.assertAddresses(
+		[]string{
+			"configuration/enp0s2/192.168.1.10/24",
+			"configuration/enp0s2/2001:db8::10/64",
+			"configuration/eth5/10.5.0.7/24",
+		}, func(r *network.AddressSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			asrt.Equal(nethelpers.ScopeGlobal, r.TypedSpec().Scope)
+
+			if r.TypedSpec().Address.Addr().Is6() {
+				asrt.Equal(nethelpers.FamilyInet6, r.TypedSpec().Family)
+			} else {
+				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+			}
+		},
+	)
+}
+
 func (suite *AddressConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
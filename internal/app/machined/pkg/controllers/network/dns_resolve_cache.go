@@ -10,14 +10,15 @@ import (
 	"fmt"
 	"iter"
 	"net/netip"
+	"slices"
 	"sync"
 
-	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xiter"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/thejerf/suture/v4"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -143,19 +144,65 @@ func (ctrl *DNSResolveCacheController) run(ctx context.Context, r controller.Run
 
 	prxs := xiter.Map(
 		// We are using iterator here to preserve finalizer on
-		func(upstream *network.DNSUpstream) *proxy.Proxy {
-			return upstream.TypedSpec().Value.Conn.Proxy().(*proxy.Proxy)
-		},
-		upstreams.All(),
+		toUpstream,
+		xiter.Filter(func(upstream *network.DNSUpstream) bool { return upstream.TypedSpec().Value.Zone == "" }, upstreams.All()),
 	)
 
 	if ctrl.manager.SetUpstreams(prxs) {
 		ctrl.Logger.Info("updated dns server nameservers", zap.Array("addrs", addrsArr(upstreams)))
 	}
 
+	if ctrl.manager.SetZones(forwardZones(upstreams)) {
+		ctrl.Logger.Info("updated dns forward zones", zap.Int("zones", len(cfg.TypedSpec().ForwardZones)))
+	}
+
+	ctrl.manager.SetStaticRecords(xslices.Map(cfg.TypedSpec().StaticRecords, func(record network.HostDNSStaticRecord) dns.StaticRecord {
+		return dns.StaticRecord{
+			Name:      record.Name,
+			Addresses: record.Addresses,
+			CNAME:     record.CNAME,
+			TTL:       record.TTL,
+		}
+	}))
+
+	if ctrl.manager.SetCacheTTLs(dns.CacheTTLs{
+		Min:      cfg.TypedSpec().CacheMinTTL,
+		Max:      cfg.TypedSpec().CacheMaxTTL,
+		Negative: cfg.TypedSpec().CacheNegativeTTL,
+	}) {
+		ctrl.Logger.Info("updated dns cache ttls, cache flushed")
+	}
+
 	return nil
 }
 
+func toUpstream(upstream *network.DNSUpstream) dns.Upstream {
+	return upstream.TypedSpec().Value.Conn.Proxy().(dns.Upstream)
+}
+
+// forwardZones groups the zone upstreams by the zone in the order of their appearance.
+func forwardZones(upstreams safe.List[*network.DNSUpstream]) []dns.Zone {
+	var zones []dns.Zone
+
+	for upstream := range upstreams.All() {
+		zone := upstream.TypedSpec().Value.Zone
+
+		if zone == "" || slices.ContainsFunc(zones, func(z dns.Zone) bool { return z.Domain == zone }) {
+			continue
+		}
+
+		zones = append(zones, dns.Zone{
+			Domain: zone,
+			Upstreams: xiter.Map(
+				toUpstream,
+				xiter.Filter(func(upstream *network.DNSUpstream) bool { return upstream.TypedSpec().Value.Zone == zone }, upstreams.All()),
+			),
+		})
+	}
+
+	return zones
+}
+
 func cleanupOutputs(ctx context.Context, r controller.Runtime, resErr *error) {
 	if err := safe.CleanupOutputs[*network.DNSResolveCache](ctx, r); err != nil {
 		*resErr = cmp.Or(*resErr, fmt.Errorf("error cleaning up dns resolve cache: %w", err))
@@ -7,7 +7,7 @@ package network
 import (
 	"context"
 	"fmt"
-	"net"
+	"net/netip"
 
 	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -17,11 +17,18 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/pkg/dns"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
 // DNSUpstreamController is a controller that manages DNS upstreams.
-type DNSUpstreamController struct{}
+//
+// If encrypted upstreams are configured, only they are used, otherwise the upstreams are
+// the nameservers from the resolver status. Conditional forwarding zones get their own upstreams.
+type DNSUpstreamController struct {
+	// encryptedConns keeps the connections to the encrypted upstreams by their configuration.
+	encryptedConns map[network.HostDNSEncryptedUpstream]*network.DNSConn
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *DNSUpstreamController) Name() string {
@@ -94,6 +101,54 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		return nil
 	}
 
+	initConn, err := existingConnections(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.TypedSpec().EncryptedUpstreams) > 0 {
+		if err = ctrl.runEncrypted(ctx, r, cfg.TypedSpec().EncryptedUpstreams, touchedIDs, l); err != nil {
+			return err
+		}
+	} else {
+		ctrl.encryptedConns = nil
+
+		if err = runPlain(ctx, r, initConn, touchedIDs, l); err != nil {
+			return err
+		}
+	}
+
+	for _, zone := range cfg.TypedSpec().ForwardZones {
+		for i, srv := range zone.Servers {
+			if err = safe.WriterModify[*network.DNSUpstream](
+				ctx,
+				r,
+				network.NewDNSUpstream(fmt.Sprintf("%s #%03d %s", zone.Domain, i, srv)),
+				func(u *network.DNSUpstream) error {
+					touchedIDs[u.Metadata().ID()] = struct{}{}
+
+					u.TypedSpec().Value.Zone = zone.Domain
+
+					initConn(&u.TypedSpec().Value, srv, l)
+
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func runPlain(
+	ctx context.Context,
+	r controller.Runtime,
+	initConn func(*network.DNSUpstreamSpecSpec, netip.AddrPort, *zap.Logger),
+	touchedIDs map[resource.ID]struct{},
+	l *zap.Logger,
+) error {
 	rs, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
 	if err != nil {
 		if state.IsNotFoundError(err) {
@@ -103,22 +158,58 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		return err
 	}
 
-	initConn, err := existingConnections(ctx, r)
-	if err != nil {
-		return err
+	for i, srv := range rs.TypedSpec().DNSServers {
+		if err = safe.WriterModify[*network.DNSUpstream](
+			ctx,
+			r,
+			network.NewDNSUpstream(fmt.Sprintf("#%03d %s", i, srv)),
+			func(u *network.DNSUpstream) error {
+				touchedIDs[u.Metadata().ID()] = struct{}{}
+
+				initConn(&u.TypedSpec().Value, netip.AddrPortFrom(srv, 53), l)
+
+				return nil
+			},
+		); err != nil {
+			return err
+		}
 	}
 
-	for i, srv := range rs.TypedSpec().DNSServers {
-		remoteHost := srv.String()
+	return nil
+}
 
-		if err = safe.WriterModify[*network.DNSUpstream](
+func (ctrl *DNSUpstreamController) runEncrypted(
+	ctx context.Context,
+	r controller.Runtime,
+	upstreams []network.HostDNSEncryptedUpstream,
+	touchedIDs map[resource.ID]struct{},
+	l *zap.Logger,
+) error {
+	conns := make(map[network.HostDNSEncryptedUpstream]*network.DNSConn, len(upstreams))
+
+	for i, upstream := range upstreams {
+		conn, ok := ctrl.encryptedConns[upstream]
+		if !ok {
+			prx, err := dns.NewEncryptedUpstream(upstream.URL, upstream.ServerName, upstream.CACertificates)
+			if err != nil {
+				return fmt.Errorf("error creating upstream %q: %w", upstream.URL, err)
+			}
+
+			conn = network.NewDNSConn(prx, l)
+
+			l.Debug("created new encrypted upstream connection", zap.String("url", upstream.URL))
+		}
+
+		conns[upstream] = conn
+
+		if err := safe.WriterModify[*network.DNSUpstream](
 			ctx,
 			r,
-			network.NewDNSUpstream(fmt.Sprintf("#%03d %s", i, remoteHost)),
+			network.NewDNSUpstream(fmt.Sprintf("#%03d %s", i, upstream.URL)),
 			func(u *network.DNSUpstream) error {
 				touchedIDs[u.Metadata().ID()] = struct{}{}
 
-				initConn(&u.TypedSpec().Value, remoteHost, l)
+				u.TypedSpec().Value.Conn = conn
 
 				return nil
 			},
@@ -127,10 +218,13 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		}
 	}
 
+	// drop the connections to the upstreams which are no longer configured
+	ctrl.encryptedConns = conns
+
 	return nil
 }
 
-func existingConnections(ctx context.Context, r controller.Runtime) (func(*network.DNSUpstreamSpecSpec, string, *zap.Logger), error) {
+func existingConnections(ctx context.Context, r controller.Runtime) (func(*network.DNSUpstreamSpecSpec, netip.AddrPort, *zap.Logger), error) {
 	upstream, err := safe.ReaderListAll[*network.DNSUpstream](ctx, r)
 	if err != nil {
 		return nil, err
@@ -142,8 +236,8 @@ func existingConnections(ctx context.Context, r controller.Runtime) (func(*netwo
 		existingConn[u.TypedSpec().Value.Conn.Addr()] = u.TypedSpec().Value.Conn
 	}
 
-	return func(spec *network.DNSUpstreamSpecSpec, remoteHost string, l *zap.Logger) {
-		remoteAddr := net.JoinHostPort(remoteHost, "53")
+	return func(spec *network.DNSUpstreamSpecSpec, remote netip.AddrPort, l *zap.Logger) {
+		remoteAddr := remote.String()
 		if spec.Conn != nil && spec.Conn.Addr() == remoteAddr {
 			l.Debug("reusing existing upstream spec", zap.String("addr", remoteAddr))
 
@@ -158,7 +252,7 @@ func existingConnections(ctx context.Context, r controller.Runtime) (func(*netwo
 			return
 		}
 
-		spec.Conn = network.NewDNSConn(proxy.NewProxy(remoteHost, remoteAddr, "dns"), l)
+		spec.Conn = network.NewDNSConn(proxy.NewProxy(remote.Addr().String(), remoteAddr, "dns"), l)
 
 		l.Debug("created new upstream connection", zap.String("addr", remoteAddr))
 
@@ -0,0 +1,302 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/eapol"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// Dot1XController runs 802.1X supplicants on the configured links and outputs Dot1XStatuses.
+type Dot1XController struct {
+	V1Alpha1Mode runtime.Mode
+
+	// Listen overrides the EAPOL socket of the supplicants (used in tests).
+	Listen func(linkName string, spec eapol.Spec) (net.PacketConn, error)
+
+	runners map[string]*eapol.Runner
+	// links with 802.1X configuration
+	links map[string]dot1xLink
+	// last notification of the running supplicants
+	notifications map[string]eapol.Notification
+}
+
+// dot1xLink is the 802.1X configuration of the link.
+type dot1xLink struct {
+	identity string
+	// err is set if the supplicant can't be started
+	err error
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *Dot1XController) Name() string {
+	return "network.Dot1XController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *Dot1XController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *Dot1XController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.Dot1XStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *Dot1XController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// there are no physical links inside a container, so skip the controller
+	if ctrl.V1Alpha1Mode == runtime.ModeContainer {
+		return nil
+	}
+
+	notifyCh := make(chan eapol.Notification)
+
+	ctrl.runners = make(map[string]*eapol.Runner)
+	ctrl.notifications = make(map[string]eapol.Notification)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileRunners(ctx, r, logger, notifyCh); err != nil {
+				return err
+			}
+		case ev := <-notifyCh:
+			if _, exists := ctrl.runners[ev.LinkName]; !exists {
+				// supplicant was already stopped, late notification, ignore it
+				continue
+			}
+
+			ctrl.notifications[ev.LinkName] = ev
+		}
+
+		if err := ctrl.reconcileOutputs(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *Dot1XController) reconcileRunners(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- eapol.Notification) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	apiSecrets, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting API secrets: %w", err)
+	}
+
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	ctrl.links = make(map[string]dot1xLink)
+
+	// supplicants run on the configured links which are up
+	shouldRun := make(map[string]eapol.Spec)
+
+	if cfg != nil && len(cfg.Config().NetworkDot1XConfigs()) > 0 {
+		resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+		for _, dot1xCfg := range cfg.Config().NetworkDot1XConfigs() {
+			linkName, ok := resolver.Resolve(dot1xCfg.Name())
+			if !ok {
+				continue
+			}
+
+			spec, err := dot1xSpec(dot1xCfg, apiSecrets)
+			if err != nil {
+				ctrl.links[linkName] = dot1xLink{err: err}
+
+				continue
+			}
+
+			ctrl.links[linkName] = dot1xLink{identity: spec.Identity}
+
+			linkStatus, ok := linkStatuses.Find(func(link *network.LinkStatus) bool {
+				return link.Metadata().ID() == linkName
+			})
+			if !ok {
+				continue
+			}
+
+			if operState := linkStatus.TypedSpec().OperationalState; operState != nethelpers.OperStateUp && operState != nethelpers.OperStateUnknown {
+				continue
+			}
+
+			spec.LinkIndex = int(linkStatus.TypedSpec().Index)
+
+			shouldRun[linkName] = spec
+		}
+	}
+
+	// stop supplicants which shouldn't run
+	for linkName := range ctrl.runners {
+		if spec, exists := shouldRun[linkName]; !exists || !spec.Equal(ctrl.runners[linkName].Spec) {
+			logger.Debug("stopping 802.1X supplicant", zap.String("link", linkName))
+
+			ctrl.runners[linkName].Stop()
+			delete(ctrl.runners, linkName)
+			delete(ctrl.notifications, linkName)
+		}
+	}
+
+	// start supplicants which aren't running
+	for linkName, spec := range shouldRun {
+		if _, exists := ctrl.runners[linkName]; !exists {
+			ctrl.runners[linkName] = &eapol.Runner{
+				LinkName: linkName,
+				Spec:     spec,
+				Listen:   ctrl.Listen,
+			}
+
+			logger.Debug("starting 802.1X supplicant", zap.String("link", linkName))
+			ctrl.runners[linkName].Start(ctx, notifyCh, logger)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *Dot1XController) reconcileOutputs(ctx context.Context, r controller.Runtime) error {
+	r.StartTrackingOutputs()
+
+	for linkName, link := range ctrl.links {
+		if err := safe.WriterModify(ctx, r, network.NewDot1XStatus(network.NamespaceName, linkName),
+			func(res *network.Dot1XStatus) error {
+				spec := res.TypedSpec()
+
+				spec.LinkName = linkName
+				spec.Identity = link.identity
+				// the port is not authorized until the supplicant reports otherwise
+				spec.State = nethelpers.Dot1XStateConnecting
+				spec.Authenticator = nil
+				spec.LastError = ""
+
+				if link.err != nil {
+					spec.LastError = link.err.Error()
+				}
+
+				if ev, ok := ctrl.notifications[linkName]; ok {
+					spec.State = ev.State
+					spec.Authenticator = nethelpers.HardwareAddr(ev.Authenticator)
+
+					if ev.Err != nil {
+						spec.LastError = ev.Err.Error()
+					}
+				}
+
+				return nil
+			}); err != nil {
+			return fmt.Errorf("error modifying 802.1X status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*network.Dot1XStatus](ctx, r)
+}
+
+// dot1xSpec builds the supplicant settings from the configuration.
+func dot1xSpec(dot1xCfg talosconfig.NetworkDot1XConfig, apiSecrets *secrets.API) (eapol.Spec, error) {
+	var certPEM, keyPEM []byte
+
+	if dot1xCfg.NodeIdentity() {
+		if apiSecrets == nil {
+			return eapol.Spec{}, errors.New("node identity certificate is not available")
+		}
+
+		// client certificate is only issued on the control plane nodes
+		pair := apiSecrets.TypedSpec().Client
+		if pair == nil {
+			pair = apiSecrets.TypedSpec().Server
+		}
+
+		if pair == nil {
+			return eapol.Spec{}, errors.New("node identity certificate is not available")
+		}
+
+		certPEM, keyPEM = pair.Crt, pair.Key
+	} else {
+		certPEM, keyPEM = []byte(dot1xCfg.Certificate()), []byte(dot1xCfg.Key())
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return eapol.Spec{}, fmt.Errorf("error loading client certificate: %w", err)
+	}
+
+	spec := eapol.Spec{
+		Identity:    dot1xCfg.Identity(),
+		Certificate: cert,
+		ServerName:  dot1xCfg.ServerName(),
+	}
+
+	if spec.Identity == "" {
+		spec.Identity = cert.Leaf.Subject.CommonName
+	}
+
+	if dot1xCfg.CA() != "" {
+		spec.RootCAs = x509.NewCertPool()
+
+		if !spec.RootCAs.AppendCertsFromPEM([]byte(dot1xCfg.CA())) {
+			return eapol.Spec{}, errors.New("no valid certificates found in ca")
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/eapol"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+type Dot1XSuite struct {
+	ctest.DefaultSuite
+
+	connCh chan *lldpConn
+}
+
+func (suite *Dot1XSuite) TestReconcile() {
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	keyPair, err := x509.NewKeyPair(ca, x509.CommonName("node01.example.com"))
+	suite.Require().NoError(err)
+
+	certificateCfg := networkcfg.NewDot1XConfigV1Alpha1()
+	certificateCfg.MetaName = "eth0"
+	certificateCfg.Dot1XCertificate = string(keyPair.CrtPEM)
+	certificateCfg.Dot1XKey = string(keyPair.KeyPEM)
+
+	nodeIdentityCfg := networkcfg.NewDot1XConfigV1Alpha1()
+	nodeIdentityCfg.MetaName = "eth1"
+	nodeIdentityCfg.Dot1XNodeIdentity = true
+
+	ctr, err := container.New(certificateCfg, nodeIdentityCfg)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	// the port is not authorized until the link is up and authenticated
+	ctest.AssertResource(suite, "eth0", func(status *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XStatusSpec{
+			LinkName: "eth0",
+			State:    nethelpers.Dot1XStateConnecting,
+			Identity: "node01.example.com",
+		}, *status.TypedSpec())
+	})
+
+	// node identity certificate is not available yet
+	ctest.AssertResource(suite, "eth1", func(status *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XStatusSpec{
+			LinkName:  "eth1",
+			State:     nethelpers.Dot1XStateConnecting,
+			LastError: "node identity certificate is not available",
+		}, *status.TypedSpec())
+	})
+
+	link := network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().Index = 2
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Create(link)
+
+	var conn *lldpConn
+
+	select {
+	case conn = <-suite.connCh:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout waiting for the supplicant")
+	}
+
+	select {
+	case frame := <-conn.txCh:
+		packetType, _, err := eapol.DecodeFrame(frame)
+		suite.Require().NoError(err)
+
+		suite.Assert().EqualValues(eapol.PacketTypeStart, packetType)
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout waiting for EAPOL-Start")
+	}
+
+	success := eapol.Packet{Code: eapol.CodeSuccess, ID: 1}
+
+	select {
+	case conn.rxCh <- eapol.EncodeFrame(eapol.PacketTypeEAP, success.Encode()):
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout sending EAP-Success")
+	}
+
+	ctest.AssertResource(suite, "eth0", func(status *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.Dot1XStateAuthenticated, status.TypedSpec().State)
+		asrt.Empty(status.TypedSpec().LastError)
+	})
+
+	// node identity becomes available
+	apiSecrets := secrets.NewAPI()
+	apiSecrets.TypedSpec().Client = &x509.PEMEncodedCertificateAndKey{
+		Crt: keyPair.CrtPEM,
+		Key: keyPair.KeyPEM,
+	}
+	suite.Create(apiSecrets)
+
+	ctest.AssertResource(suite, "eth1", func(status *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(network.Dot1XStatusSpec{
+			LinkName: "eth1",
+			State:    nethelpers.Dot1XStateConnecting,
+			Identity: "node01.example.com",
+		}, *status.TypedSpec())
+	})
+
+	// link goes down, supplicant is stopped
+	link.TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), link))
+
+	ctest.AssertResource(suite, "eth0", func(status *network.Dot1XStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.Dot1XStateConnecting, status.TypedSpec().State)
+	})
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	ctest.AssertNoResource[*network.Dot1XStatus](suite, "eth0")
+	ctest.AssertNoResource[*network.Dot1XStatus](suite, "eth1")
+}
+
+func TestDot1XSuite(t *testing.T) {
+	s := &Dot1XSuite{
+		connCh: make(chan *lldpConn, 4),
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 5 * time.Second,
+		AfterSetup: func(*ctest.DefaultSuite) {
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.Dot1XController{
+				Listen: func(string, eapol.Spec) (net.PacketConn, error) {
+					conn := &lldpConn{
+						rxCh:   make(chan []byte),
+						txCh:   make(chan []byte, 1),
+						closed: make(chan struct{}),
+					}
+
+					s.connCh <- conn
+
+					return conn, nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
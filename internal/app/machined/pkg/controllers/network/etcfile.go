@@ -148,7 +148,7 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, _
 			// in container mode, keep the original resolv.conf to use the resolvers supplied by the container runtime
 			if err = safe.WriterModify(ctx, r, files.NewEtcFileSpec(files.NamespaceName, "resolv.conf"),
 				func(r *files.EtcFileSpec) error {
-					r.TypedSpec().Contents = renderResolvConf(pickNameservers(hostDNSCfg, resolverStatus), hostnameStatusSpec, cfgProvider)
+					r.TypedSpec().Contents = renderResolvConf(pickNameservers(hostDNSCfg, resolverStatus), resolverStatus.TypedSpec().SearchDomains, hostnameStatusSpec, cfgProvider)
 					r.TypedSpec().Mode = 0o644
 
 					return nil
@@ -167,7 +167,7 @@ func (ctrl *EtcFileController) Run(ctx context.Context, r controller.Runtime, _
 				dnsServers = resolverStatus.TypedSpec().DNSServers
 			}
 
-			conf := renderResolvConf(slices.All(dnsServers), hostnameStatusSpec, cfgProvider)
+			conf := renderResolvConf(slices.All(dnsServers), resolverStatus.TypedSpec().SearchDomains, hostnameStatusSpec, cfgProvider)
 
 			if err = os.MkdirAll(filepath.Dir(ctrl.PodResolvConfPath), 0o755); err != nil {
 				return fmt.Errorf("error creating pod resolv.conf dir: %w", err)
@@ -206,7 +206,9 @@ func pickNameservers(hostDNSCfg *network.HostDNSConfig, resolverStatus *network.
 	return slices.All(resolverStatus.TypedSpec().DNSServers)
 }
 
-func renderResolvConf(nameservers iter.Seq2[int, netip.Addr], hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Config) []byte {
+func renderResolvConf(
+	nameservers iter.Seq2[int, netip.Addr], searchDomains []string, hostnameStatus *network.HostnameStatusSpec, cfgProvider talosconfig.Config,
+) []byte {
 	var buf bytes.Buffer
 
 	for i, ns := range nameservers {
@@ -223,8 +225,21 @@ func renderResolvConf(nameservers iter.Seq2[int, netip.Addr], hostnameStatus *ne
 		disableSearchDomain = cfgProvider.Machine().Network().DisableSearchDomain()
 	}
 
+	var search []string
+
 	if !disableSearchDomain && hostnameStatus != nil && hostnameStatus.Domainname != "" {
-		fmt.Fprintf(&buf, "\nsearch %s\n", hostnameStatus.Domainname)
+		search = append(search, hostnameStatus.Domainname)
+	}
+
+	// search domains received e.g. via DHCP follow the hostname domain
+	for _, domain := range searchDomains {
+		if !slices.Contains(search, domain) {
+			search = append(search, domain)
+		}
+	}
+
+	if len(search) > 0 {
+		fmt.Fprintf(&buf, "\nsearch %s\n", strings.Join(search, " "))
 	}
 
 	return buf.Bytes()
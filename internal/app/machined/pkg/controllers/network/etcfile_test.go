@@ -267,6 +267,19 @@ func (suite *EtcFileConfigSuite) TestNoSearchDomain() {
 	)
 }
 
+func (suite *EtcFileConfigSuite) TestResolverSearchDomains() {
+	suite.resolverStatus.TypedSpec().SearchDomains = []string{"example.com", "example.org"}
+
+	suite.testFiles(
+		[]resource.Resource{suite.defaultAddress, suite.hostnameStatus, suite.resolverStatus, suite.hostDNSConfig},
+		etcFileContents{
+			hosts:            "127.0.0.1   localhost\n33.11.22.44 foo.example.com foo\n::1         localhost ip6-localhost ip6-loopback\nff02::1     ip6-allnodes\nff02::2     ip6-allrouters\n",
+			resolvConf:       "nameserver 127.0.0.53\n\nsearch example.com example.org\n",
+			resolvGlobalConf: "nameserver 169.254.116.108\n\nsearch example.com example.org\n",
+		},
+	)
+}
+
 func (suite *EtcFileConfigSuite) TestNoDomainname() {
 	suite.hostnameStatus.TypedSpec().Domainname = ""
 
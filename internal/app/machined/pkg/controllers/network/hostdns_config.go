@@ -14,10 +14,11 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
-	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -92,6 +93,12 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 			}
 
 			res.TypedSpec().ServiceHostDNSAddress = netip.Addr{}
+			res.TypedSpec().EncryptedUpstreams = nil
+			res.TypedSpec().ForwardZones = nil
+			res.TypedSpec().StaticRecords = nil
+			res.TypedSpec().CacheMinTTL = 0
+			res.TypedSpec().CacheMaxTTL = 0
+			res.TypedSpec().CacheNegativeTTL = 0
 
 			if cfgProvider == nil {
 				res.TypedSpec().Enabled = false
@@ -101,6 +108,43 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 
 			res.TypedSpec().Enabled = cfgProvider.Machine().Features().HostDNS().Enabled()
 			res.TypedSpec().ResolveMemberNames = cfgProvider.Machine().Features().HostDNS().ResolveMemberNames()
+			res.TypedSpec().EncryptedUpstreams = xslices.Map(
+				cfgProvider.NetworkDNSUpstreamConfigs(),
+				func(upstream talosconfig.NetworkDNSUpstreamConfig) network.HostDNSEncryptedUpstream {
+					return network.HostDNSEncryptedUpstream{
+						URL:            upstream.URL(),
+						ServerName:     upstream.ServerName(),
+						CACertificates: upstream.CACertificates(),
+					}
+				},
+			)
+
+			res.TypedSpec().ForwardZones = xslices.Map(
+				cfgProvider.NetworkDNSForwardZoneConfigs(),
+				func(zone talosconfig.NetworkDNSForwardZoneConfig) network.HostDNSForwardZone {
+					return network.HostDNSForwardZone{
+						Domain:  zone.Name(),
+						Servers: zone.Servers(),
+					}
+				},
+			)
+			res.TypedSpec().StaticRecords = xslices.Map(
+				cfgProvider.NetworkDNSStaticRecordConfigs(),
+				func(record talosconfig.NetworkDNSStaticRecordConfig) network.HostDNSStaticRecord {
+					return network.HostDNSStaticRecord{
+						Name:      record.Name(),
+						Addresses: record.Addresses(),
+						CNAME:     record.CNAME(),
+						TTL:       record.TTL(),
+					}
+				},
+			)
+
+			if cacheCfg := cfgProvider.NetworkDNSCacheConfig(); cacheCfg != nil {
+				res.TypedSpec().CacheMinTTL = cacheCfg.MinTTL()
+				res.TypedSpec().CacheMaxTTL = cacheCfg.MaxTTL()
+				res.TypedSpec().CacheNegativeTTL = cacheCfg.NegativeTTL()
+			}
 
 			if !cfgProvider.Machine().Features().HostDNS().ForwardKubeDNSToHost() {
 				return nil
@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package eapol implements a minimal IEEE 802.1X supplicant for the wired links with EAP-TLS authentication.
+package eapol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// EtherType of the EAPOL frames.
+const EtherType = 0x888e
+
+// PAEGroupAddr is the port access entity group address EAPOL frames are sent to.
+var PAEGroupAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x03}
+
+// protocolVersion is the EAPOL version sent by the supplicant (IEEE 802.1X-2004).
+const protocolVersion = 2
+
+// EAPOL packet types (see IEEE 802.1X, section 11.3.2).
+const (
+	PacketTypeEAP    = 0
+	PacketTypeStart  = 1
+	PacketTypeLogoff = 2
+)
+
+// EAP codes (see RFC 3748, section 4).
+const (
+	CodeRequest  = 1
+	CodeResponse = 2
+	CodeSuccess  = 3
+	CodeFailure  = 4
+)
+
+// EAP method types (see RFC 3748, section 5).
+const (
+	TypeIdentity     = 1
+	TypeNotification = 2
+	TypeNak          = 3
+	TypeTLS          = 13
+)
+
+// EAP-TLS flags (see RFC 5216, section 3.1).
+const (
+	FlagLengthIncluded = 0x80
+	FlagMoreFragments  = 0x40
+	FlagStart          = 0x20
+)
+
+// EncodeFrame encodes the EAPOL frame with the given packet type and body.
+func EncodeFrame(packetType uint8, body []byte) []byte {
+	frame := make([]byte, 4, 4+len(body))
+
+	frame[0] = protocolVersion
+	frame[1] = packetType
+	binary.BigEndian.PutUint16(frame[2:], uint16(len(body)))
+
+	return append(frame, body...)
+}
+
+// DecodeFrame decodes the EAPOL frame into the packet type and body.
+func DecodeFrame(frame []byte) (uint8, []byte, error) {
+	if len(frame) < 4 {
+		return 0, nil, errors.New("EAPOL frame is too short")
+	}
+
+	length := int(binary.BigEndian.Uint16(frame[2:]))
+
+	if len(frame) < 4+length {
+		return 0, nil, fmt.Errorf("EAPOL body is truncated: %d < %d", len(frame)-4, length)
+	}
+
+	// frames might be padded to the minimum Ethernet frame size
+	return frame[1], frame[4 : 4+length], nil
+}
+
+// Packet is an EAP packet.
+type Packet struct {
+	Code uint8
+	ID   uint8
+	// Type and Data are only present in requests and responses.
+	Type uint8
+	Data []byte
+}
+
+// Encode the EAP packet.
+func (p *Packet) Encode() []byte {
+	if p.Code != CodeRequest && p.Code != CodeResponse {
+		return []byte{p.Code, p.ID, 0, 4}
+	}
+
+	buf := make([]byte, 5, 5+len(p.Data))
+
+	buf[0] = p.Code
+	buf[1] = p.ID
+	binary.BigEndian.PutUint16(buf[2:], uint16(5+len(p.Data)))
+	buf[4] = p.Type
+
+	return append(buf, p.Data...)
+}
+
+// DecodePacket decodes the EAP packet.
+func DecodePacket(buf []byte) (*Packet, error) {
+	if len(buf) < 4 {
+		return nil, errors.New("EAP packet is too short")
+	}
+
+	length := int(binary.BigEndian.Uint16(buf[2:]))
+
+	if length < 4 || len(buf) < length {
+		return nil, fmt.Errorf("invalid EAP packet length %d", length)
+	}
+
+	p := &Packet{
+		Code: buf[0],
+		ID:   buf[1],
+	}
+
+	switch p.Code {
+	case CodeRequest, CodeResponse:
+		if length < 5 {
+			return nil, errors.New("EAP packet type is missing")
+		}
+
+		p.Type = buf[4]
+		p.Data = buf[5:length]
+	case CodeSuccess, CodeFailure:
+	default:
+		return nil, fmt.Errorf("unknown EAP code %d", p.Code)
+	}
+
+	return p, nil
+}
+
+// TLSMessage is the EAP-TLS message carried in the EAP packet data.
+type TLSMessage struct {
+	Flags uint8
+	// TotalLength is only present if FlagLengthIncluded is set.
+	TotalLength uint32
+	Data        []byte
+}
+
+// Encode the EAP-TLS message.
+func (m *TLSMessage) Encode() []byte {
+	buf := []byte{m.Flags}
+
+	if m.Flags&FlagLengthIncluded != 0 {
+		buf = binary.BigEndian.AppendUint32(buf, m.TotalLength)
+	}
+
+	return append(buf, m.Data...)
+}
+
+// DecodeTLSMessage decodes the EAP-TLS message.
+func DecodeTLSMessage(data []byte) (*TLSMessage, error) {
+	if len(data) < 1 {
+		return nil, errors.New("EAP-TLS flags are missing")
+	}
+
+	m := &TLSMessage{
+		Flags: data[0],
+		Data:  data[1:],
+	}
+
+	if m.Flags&FlagLengthIncluded != 0 {
+		if len(m.Data) < 4 {
+			return nil, errors.New("EAP-TLS message length is truncated")
+		}
+
+		m.TotalLength = binary.BigEndian.Uint32(m.Data)
+		m.Data = m.Data[4:]
+	}
+
+	return m, nil
+}
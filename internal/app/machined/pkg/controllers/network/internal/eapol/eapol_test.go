@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eapol_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/eapol"
+)
+
+func TestFrame(t *testing.T) {
+	t.Parallel()
+
+	frame := eapol.EncodeFrame(eapol.PacketTypeStart, nil)
+	assert.Equal(t, []byte{0x02, 0x01, 0x00, 0x00}, frame)
+
+	// padded to the minimum Ethernet frame size
+	padded := append(eapol.EncodeFrame(eapol.PacketTypeEAP, []byte{0x03, 0x01, 0x00, 0x04}), make([]byte, 38)...)
+
+	packetType, body, err := eapol.DecodeFrame(padded)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, eapol.PacketTypeEAP, packetType)
+	assert.Equal(t, []byte{0x03, 0x01, 0x00, 0x04}, body)
+
+	_, _, err = eapol.DecodeFrame([]byte{0x02, 0x00, 0x00, 0x10, 0x01})
+	assert.EqualError(t, err, "EAPOL body is truncated: 1 < 16")
+}
+
+func TestPacket(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		packet  eapol.Packet
+		encoded []byte
+	}{
+		{
+			name:    "identity response",
+			packet:  eapol.Packet{Code: eapol.CodeResponse, ID: 1, Type: eapol.TypeIdentity, Data: []byte("node01")},
+			encoded: []byte{0x02, 0x01, 0x00, 0x0b, 0x01, 'n', 'o', 'd', 'e', '0', '1'},
+		},
+		{
+			name:    "tls start",
+			packet:  eapol.Packet{Code: eapol.CodeRequest, ID: 2, Type: eapol.TypeTLS, Data: []byte{eapol.FlagStart}},
+			encoded: []byte{0x01, 0x02, 0x00, 0x06, 0x0d, 0x20},
+		},
+		{
+			name:    "success",
+			packet:  eapol.Packet{Code: eapol.CodeSuccess, ID: 3},
+			encoded: []byte{0x03, 0x03, 0x00, 0x04},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.encoded, test.packet.Encode())
+
+			decoded, err := eapol.DecodePacket(test.encoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.packet.Code, decoded.Code)
+			assert.Equal(t, test.packet.ID, decoded.ID)
+			assert.Equal(t, test.packet.Type, decoded.Type)
+			assert.Equal(t, string(test.packet.Data), string(decoded.Data))
+		})
+	}
+
+	_, err := eapol.DecodePacket([]byte{0x05, 0x01, 0x00, 0x04})
+	assert.EqualError(t, err, "unknown EAP code 5")
+}
+
+func TestTLSMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := eapol.TLSMessage{
+		Flags:       eapol.FlagLengthIncluded | eapol.FlagMoreFragments,
+		TotalLength: 2000,
+		Data:        []byte{0x16, 0x03, 0x01},
+	}
+
+	encoded := msg.Encode()
+	assert.Equal(t, []byte{0xc0, 0x00, 0x00, 0x07, 0xd0, 0x16, 0x03, 0x01}, encoded)
+
+	decoded, err := eapol.DecodeTLSMessage(encoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, msg, *decoded)
+
+	_, err = eapol.DecodeTLSMessage([]byte{eapol.FlagLengthIncluded, 0x00})
+	assert.EqualError(t, err, "EAP-TLS message length is truncated")
+}
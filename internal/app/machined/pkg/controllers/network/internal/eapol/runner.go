@@ -0,0 +1,410 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eapol
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/packet"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// Timers of the supplicant (see IEEE 802.1X-2004, section 8.2.11.1.2).
+const (
+	// startPeriod is the interval between EAPOL-Start frames while waiting for the authenticator.
+	startPeriod = 30 * time.Second
+	// authPeriod is the timeout of the authenticator request during the authentication.
+	authPeriod = 30 * time.Second
+	// heldPeriod is the delay before the authentication is restarted after the failure.
+	heldPeriod = 60 * time.Second
+)
+
+// retryInterval is the interval between attempts to open the EAPOL socket.
+const retryInterval = 10 * time.Second
+
+// errRejected is reported if the authenticator rejects the supplicant without a local error.
+var errRejected = errors.New("authentication rejected")
+
+// Spec describes the 802.1X supplicant settings of the link.
+type Spec struct {
+	LinkIndex int
+
+	Identity    string
+	Certificate tls.Certificate
+	// RootCAs verify the authentication server certificate, if nil the certificate is not verified.
+	RootCAs    *x509.CertPool
+	ServerName string
+}
+
+// Equal returns true if the specs are equal.
+func (spec Spec) Equal(other Spec) bool {
+	return spec.LinkIndex == other.LinkIndex &&
+		spec.Identity == other.Identity &&
+		slices.EqualFunc(spec.Certificate.Certificate, other.Certificate.Certificate, bytes.Equal) &&
+		spec.RootCAs.Equal(other.RootCAs) &&
+		spec.ServerName == other.ServerName
+}
+
+// TLSConfig builds the TLS client configuration for the spec.
+func (spec Spec) TLSConfig() *tls.Config {
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{spec.Certificate},
+		RootCAs:      spec.RootCAs,
+		ServerName:   spec.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if spec.ServerName == "" {
+		// verify the certificate chain without the name
+		cfg.InsecureSkipVerify = true
+
+		if spec.RootCAs != nil {
+			cfg.VerifyConnection = func(state tls.ConnectionState) error {
+				if len(state.PeerCertificates) == 0 {
+					return errors.New("authentication server certificate is missing")
+				}
+
+				opts := x509.VerifyOptions{
+					Roots:         spec.RootCAs,
+					Intermediates: x509.NewCertPool(),
+				}
+
+				for _, cert := range state.PeerCertificates[1:] {
+					opts.Intermediates.AddCert(cert)
+				}
+
+				_, err := state.PeerCertificates[0].Verify(opts)
+
+				return err
+			}
+		}
+	}
+
+	return cfg
+}
+
+// Runner is a 802.1X supplicant running on a single link.
+type Runner struct {
+	LinkName string
+	Spec     Spec
+
+	// Listen opens the connection to receive and send EAPOL frames, defaults to the packet socket bound to the link.
+	Listen func(linkName string, spec Spec) (net.PacketConn, error)
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Notification of an authentication state change.
+type Notification struct {
+	LinkName      string
+	State         nethelpers.Dot1XState
+	Authenticator net.HardwareAddr
+	Err           error
+}
+
+func (n Notification) equal(other Notification) bool {
+	return n.State == other.State &&
+		bytes.Equal(n.Authenticator, other.Authenticator) &&
+		errors.Is(n.Err, other.Err)
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	runner.wg.Add(1)
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.run(ctx, notifyCh, logger.With(zap.String("link", runner.LinkName)))
+	}()
+}
+
+// Stop a runner.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	if runner.Listen == nil {
+		runner.Listen = listen
+	}
+
+	for {
+		conn, err := runner.Listen(runner.LinkName, runner.Spec)
+		if err == nil {
+			runner.serve(ctx, conn, notifyCh, logger)
+
+			if ctx.Err() != nil {
+				return
+			}
+		} else {
+			logger.Warn("error opening EAPOL socket", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// eapFrame is the EAP packet received from the authenticator.
+type eapFrame struct {
+	packet *Packet
+	raw    []byte
+	source net.HardwareAddr
+}
+
+//nolint:gocyclo,cyclop
+func (runner *Runner) serve(ctx context.Context, conn net.PacketConn, notifyCh chan<- Notification, logger *zap.Logger) {
+	framesCh := make(chan eapFrame)
+
+	var readerWg sync.WaitGroup
+
+	readerWg.Add(1)
+
+	go func() {
+		defer readerWg.Done()
+
+		runner.read(ctx, conn, framesCh, logger)
+	}()
+
+	defer readerWg.Wait()
+	defer conn.Close() //nolint:errcheck
+
+	supplicant := &Supplicant{
+		Identity:  runner.Spec.Identity,
+		TLSConfig: runner.Spec.TLSConfig(),
+	}
+
+	defer supplicant.Close()
+
+	status := Notification{
+		LinkName: runner.LinkName,
+		State:    nethelpers.Dot1XStateConnecting,
+	}
+
+	// last request and the response to it, used to answer the retransmitted requests
+	var lastRequest, lastResponse []byte
+
+	timer := time.NewTimer(startPeriod)
+	defer timer.Stop()
+
+	runner.send(conn, PacketTypeStart, nil, logger)
+
+	if !channel.SendWithContext(ctx, notifyCh, status) {
+		return
+	}
+
+	for {
+		prev := status
+
+		select {
+		case <-ctx.Done():
+			// no EAPOL-Logoff is sent, as the runner is restarted on certificate rotation,
+			// and the port should stay authorized until the re-authentication
+			return
+		case <-timer.C:
+			if status.State == nethelpers.Dot1XStateAuthenticated {
+				continue
+			}
+
+			if status.State == nethelpers.Dot1XStateAuthenticating {
+				logger.Info("802.1X authentication timed out")
+			}
+
+			supplicant.Close()
+
+			lastRequest, lastResponse = nil, nil
+			status.State = nethelpers.Dot1XStateConnecting
+
+			runner.send(conn, PacketTypeStart, nil, logger)
+			timer.Reset(startPeriod)
+		case frame, ok := <-framesCh:
+			if !ok {
+				return
+			}
+
+			if frame.source != nil {
+				status.Authenticator = frame.source
+			}
+
+			switch frame.packet.Code {
+			case CodeRequest:
+				if bytes.Equal(frame.raw, lastRequest) && lastResponse != nil {
+					runner.send(conn, PacketTypeEAP, lastResponse, logger)
+
+					continue
+				}
+
+				response := supplicant.Handle(frame.packet)
+				if response == nil {
+					continue
+				}
+
+				lastRequest, lastResponse = frame.raw, response.Encode()
+
+				runner.send(conn, PacketTypeEAP, lastResponse, logger)
+
+				// re-authentication keeps the port authorized
+				if status.State != nethelpers.Dot1XStateAuthenticated {
+					status.State = nethelpers.Dot1XStateAuthenticating
+
+					timer.Reset(authPeriod)
+				}
+			case CodeSuccess:
+				lastRequest, lastResponse = nil, nil
+				status.State = nethelpers.Dot1XStateAuthenticated
+				status.Err = nil
+
+				timer.Stop()
+			case CodeFailure:
+				lastRequest, lastResponse = nil, nil
+				status.State = nethelpers.Dot1XStateFailed
+				status.Err = supplicant.Err()
+
+				if status.Err == nil {
+					status.Err = errRejected
+				}
+
+				timer.Reset(heldPeriod)
+			}
+		}
+
+		if status.equal(prev) {
+			continue
+		}
+
+		if status.State != prev.State {
+			logger.Info("802.1X state changed",
+				zap.Stringer("state", status.State),
+				zap.Stringer("authenticator", status.Authenticator),
+				zap.Error(status.Err),
+			)
+		}
+
+		if !channel.SendWithContext(ctx, notifyCh, status) {
+			return
+		}
+	}
+}
+
+func (runner *Runner) read(ctx context.Context, conn net.PacketConn, framesCh chan<- eapFrame, logger *zap.Logger) {
+	defer close(framesCh)
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("error reading EAPOL frame", zap.Error(err))
+			}
+
+			return
+		}
+
+		packetType, body, err := DecodeFrame(buf[:n])
+		if err != nil {
+			logger.Debug("error decoding EAPOL frame", zap.Error(err))
+
+			continue
+		}
+
+		if packetType != PacketTypeEAP {
+			continue
+		}
+
+		pkt, err := DecodePacket(body)
+		if err != nil {
+			logger.Debug("error decoding EAP packet", zap.Error(err))
+
+			continue
+		}
+
+		// responses are sent by the supplicants
+		if pkt.Code == CodeResponse {
+			continue
+		}
+
+		frame := eapFrame{
+			// the packet data is copied, as the buffer is reused
+			packet: &Packet{Code: pkt.Code, ID: pkt.ID, Type: pkt.Type, Data: slices.Clone(pkt.Data)},
+			raw:    slices.Clone(body),
+		}
+
+		if packetAddr, ok := addr.(*packet.Addr); ok {
+			frame.source = slices.Clone(packetAddr.HardwareAddr)
+		}
+
+		if !channel.SendWithContext(ctx, framesCh, frame) {
+			return
+		}
+	}
+}
+
+func (runner *Runner) send(conn net.PacketConn, packetType uint8, body []byte, logger *zap.Logger) {
+	if _, err := conn.WriteTo(EncodeFrame(packetType, body), &packet.Addr{HardwareAddr: PAEGroupAddr}); err != nil {
+		logger.Warn("error sending EAPOL frame", zap.Error(err))
+	}
+}
+
+// listen opens a packet socket on the link which receives the EAPOL frames sent to the PAE group address.
+func listen(linkName string, spec Spec) (net.PacketConn, error) {
+	conn, err := packet.Listen(&net.Interface{Index: spec.LinkIndex, Name: linkName}, packet.Datagram, EtherType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on the packet socket: %w", err)
+	}
+
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(spec.LinkIndex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(PAEGroupAddr)),
+	}
+
+	copy(mreq.Address[:], PAEGroupAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err == nil {
+		err = sockErr
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error joining PAE group: %w", err)
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,801 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eapol_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	mathrand "math/rand/v2"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/jsimonetti/rtnetlink/v2/driver"
+	"github.com/mdlayher/packet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/eapol"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+const testIdentity = "host/node01.example.com"
+
+var authenticatorAddr = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x02}
+
+// mockConn is one end of the in-memory connection between the supplicant and the authenticator.
+type mockConn struct {
+	net.PacketConn
+
+	rxCh   <-chan []byte
+	txCh   chan<- []byte
+	peer   net.HardwareAddr
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newMockConnPair() (supplicant, authenticator *mockConn) {
+	toSupplicant := make(chan []byte, 16)
+	toAuthenticator := make(chan []byte, 16)
+
+	supplicant = &mockConn{
+		rxCh:   toSupplicant,
+		txCh:   toAuthenticator,
+		peer:   authenticatorAddr,
+		closed: make(chan struct{}),
+	}
+
+	authenticator = &mockConn{
+		rxCh:   toAuthenticator,
+		txCh:   toSupplicant,
+		closed: make(chan struct{}),
+	}
+
+	return supplicant, authenticator
+}
+
+func (conn *mockConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	select {
+	case frame := <-conn.rxCh:
+		return copy(b, frame), &packet.Addr{HardwareAddr: conn.peer}, nil
+	case <-conn.closed:
+		return 0, nil, os.ErrClosed
+	}
+}
+
+func (conn *mockConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	select {
+	case conn.txCh <- append([]byte(nil), b...):
+		return len(b), nil
+	case <-conn.closed:
+		return 0, os.ErrClosed
+	}
+}
+
+func (conn *mockConn) Close() error {
+	conn.once.Do(func() { close(conn.closed) })
+
+	return nil
+}
+
+// fakeAuthenticator implements the authenticator and the EAP-TLS server.
+type fakeAuthenticator struct {
+	conn         net.PacketConn
+	tlsConfig    *tls.Config
+	fragmentSize int
+
+	id       uint8
+	identity string
+}
+
+func (auth *fakeAuthenticator) send(pkt *eapol.Packet) error {
+	_, err := auth.conn.WriteTo(eapol.EncodeFrame(eapol.PacketTypeEAP, pkt.Encode()), &packet.Addr{HardwareAddr: eapol.PAEGroupAddr})
+
+	return err
+}
+
+func (auth *fakeAuthenticator) request(typ uint8, data []byte) error {
+	auth.id++
+
+	return auth.send(&eapol.Packet{Code: eapol.CodeRequest, ID: auth.id, Type: typ, Data: data})
+}
+
+// recv receives the next EAPOL frame of the given type.
+func (auth *fakeAuthenticator) recv(packetType uint8) ([]byte, error) {
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := auth.conn.ReadFrom(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		typ, body, err := eapol.DecodeFrame(buf[:n])
+		if err != nil {
+			return nil, err
+		}
+
+		if typ == packetType {
+			return append([]byte(nil), body...), nil
+		}
+	}
+}
+
+// response receives the EAP response to the last request.
+func (auth *fakeAuthenticator) response(typ uint8) ([]byte, error) {
+	for {
+		body, err := auth.recv(eapol.PacketTypeEAP)
+		if err != nil {
+			return nil, err
+		}
+
+		pkt, err := eapol.DecodePacket(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if pkt.Code != eapol.CodeResponse || pkt.ID != auth.id {
+			continue
+		}
+
+		if pkt.Type != typ {
+			return nil, fmt.Errorf("unexpected response type %d", pkt.Type)
+		}
+
+		return pkt.Data, nil
+	}
+}
+
+// recvTLS reassembles the TLS data sent by the supplicant.
+func (auth *fakeAuthenticator) recvTLS() ([]byte, error) {
+	var data []byte
+
+	for {
+		resp, err := auth.response(eapol.TypeTLS)
+		if err != nil {
+			return nil, err
+		}
+
+		msg, err := eapol.DecodeTLSMessage(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, msg.Data...)
+
+		if msg.Flags&eapol.FlagMoreFragments == 0 {
+			return data, nil
+		}
+
+		if err = auth.request(eapol.TypeTLS, (&eapol.TLSMessage{}).Encode()); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sendTLS fragments the TLS data sent to the supplicant.
+func (auth *fakeAuthenticator) sendTLS(data []byte) error {
+	total := len(data)
+
+	for {
+		var msg eapol.TLSMessage
+
+		size := min(len(data), auth.fragmentSize)
+
+		if size < len(data) {
+			msg.Flags |= eapol.FlagMoreFragments
+
+			if len(data) == total {
+				msg.Flags |= eapol.FlagLengthIncluded
+				msg.TotalLength = uint32(total)
+			}
+		}
+
+		msg.Data, data = data[:size], data[size:]
+
+		if err := auth.request(eapol.TypeTLS, msg.Encode()); err != nil {
+			return err
+		}
+
+		if len(data) == 0 {
+			return nil
+		}
+
+		ack, err := auth.recvTLS()
+		if err != nil {
+			return err
+		}
+
+		if len(ack) > 0 {
+			return errors.New("expected fragment acknowledgement")
+		}
+	}
+}
+
+// readAvailable reads the data written by the TLS server until it waits for the client.
+func readAvailable(conn net.Conn) []byte {
+	var data []byte
+
+	buf := make([]byte, 4096)
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(200 * time.Millisecond)) //nolint:errcheck
+
+		n, err := conn.Read(buf)
+		data = append(data, buf[:n]...)
+
+		if err != nil {
+			return data
+		}
+	}
+}
+
+//nolint:gocyclo
+func (auth *fakeAuthenticator) run() error {
+	if _, err := auth.recv(eapol.PacketTypeStart); err != nil {
+		return err
+	}
+
+	if err := auth.request(eapol.TypeIdentity, nil); err != nil {
+		return err
+	}
+
+	identity, err := auth.response(eapol.TypeIdentity)
+	if err != nil {
+		return err
+	}
+
+	auth.identity = string(identity)
+
+	if err = auth.request(eapol.TypeTLS, []byte{eapol.FlagStart}); err != nil {
+		return err
+	}
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close() //nolint:errcheck
+	defer serverConn.Close() //nolint:errcheck
+
+	server := tls.Server(serverConn, auth.tlsConfig)
+
+	handshakeCh := make(chan error, 1)
+
+	go func() {
+		handshakeCh <- server.Handshake()
+	}()
+
+	for handshakeDone := false; !handshakeDone; {
+		data, err := auth.recvTLS()
+		if err != nil {
+			return err
+		}
+
+		if len(data) > 0 {
+			clientConn.SetWriteDeadline(time.Now().Add(time.Second)) //nolint:errcheck
+			clientConn.Write(data)                                   //nolint:errcheck
+		}
+
+		if out := readAvailable(clientConn); len(out) > 0 {
+			if err = auth.sendTLS(out); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		select {
+		case err = <-handshakeCh:
+			if err != nil {
+				auth.id++
+
+				return errors.Join(err, auth.send(&eapol.Packet{Code: eapol.CodeFailure, ID: auth.id}))
+			}
+
+			handshakeDone = true
+		case <-time.After(5 * time.Second):
+			return errors.New("TLS handshake stalled")
+		}
+	}
+
+	if server.ConnectionState().Version == tls.VersionTLS13 {
+		// commitment message
+		go server.Write([]byte{0}) //nolint:errcheck
+
+		if err = auth.sendTLS(readAvailable(clientConn)); err != nil {
+			return err
+		}
+
+		ack, err := auth.recvTLS()
+		if err != nil {
+			return err
+		}
+
+		if len(ack) > 0 {
+			return errors.New("expected commitment message acknowledgement")
+		}
+	}
+
+	auth.id++
+
+	return auth.send(&eapol.Packet{Code: eapol.CodeSuccess, ID: auth.id})
+}
+
+// testCA is a certificate authority for the test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T, commonName string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	return pool
+}
+
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage, dnsNames ...string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+// testCertificates builds the client and server certificates.
+//
+// The client certificate is large enough to be fragmented.
+func testCertificates(t *testing.T) (ca *testCA, client, server tls.Certificate) {
+	t.Helper()
+
+	ca = newTestCA(t, "RADIUS CA")
+
+	dnsNames := make([]string, 0, 150)
+
+	for i := range 150 {
+		dnsNames = append(dnsNames, fmt.Sprintf("node%03d.example.com", i))
+	}
+
+	client = ca.issue(t, "node01.example.com", x509.ExtKeyUsageClientAuth, dnsNames...)
+	server = ca.issue(t, "radius.example.com", x509.ExtKeyUsageServerAuth, "radius.example.com")
+
+	return ca, client, server
+}
+
+// collect the notifications until the final state.
+func collect(ctx context.Context, t *testing.T, notifyCh <-chan eapol.Notification, final nethelpers.Dot1XState) []eapol.Notification {
+	t.Helper()
+
+	var notifications []eapol.Notification
+
+	for {
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for the state", "state %s, notifications %v", final, notifications)
+		case notification := <-notifyCh:
+			notifications = append(notifications, notification)
+
+			if notification.State == final {
+				return notifications
+			}
+		}
+	}
+}
+
+func states(notifications []eapol.Notification) []nethelpers.Dot1XState {
+	result := make([]nethelpers.Dot1XState, 0, len(notifications))
+
+	for _, notification := range notifications {
+		result = append(result, notification.State)
+	}
+
+	return result
+}
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	ca, clientCert, serverCert := testCertificates(t)
+	otherCA := newTestCA(t, "Other CA")
+
+	for _, test := range []struct {
+		name string
+
+		maxVersion uint16
+		rootCAs    *x509.CertPool
+		clientCAs  *x509.CertPool
+
+		expectedState nethelpers.Dot1XState
+		expectedError string
+	}{
+		{
+			name:       "TLS 1.2",
+			maxVersion: tls.VersionTLS12,
+			rootCAs:    ca.pool(),
+			clientCAs:  ca.pool(),
+
+			expectedState: nethelpers.Dot1XStateAuthenticated,
+		},
+		{
+			name:       "TLS 1.3",
+			maxVersion: tls.VersionTLS13,
+			rootCAs:    ca.pool(),
+			clientCAs:  ca.pool(),
+
+			expectedState: nethelpers.Dot1XStateAuthenticated,
+		},
+		{
+			name:       "untrusted server",
+			maxVersion: tls.VersionTLS13,
+			rootCAs:    otherCA.pool(),
+			clientCAs:  ca.pool(),
+
+			expectedState: nethelpers.Dot1XStateFailed,
+			expectedError: "TLS handshake failed: tls: failed to verify certificate: x509: certificate signed by unknown authority",
+		},
+		{
+			name:       "rejected client",
+			maxVersion: tls.VersionTLS13,
+			rootCAs:    ca.pool(),
+			clientCAs:  otherCA.pool(),
+
+			expectedState: nethelpers.Dot1XStateFailed,
+			expectedError: "authentication rejected",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			supplicantConn, authenticatorConn := newMockConnPair()
+
+			auth := &fakeAuthenticator{
+				conn: authenticatorConn,
+				tlsConfig: &tls.Config{
+					Certificates:           []tls.Certificate{serverCert},
+					ClientAuth:             tls.RequireAndVerifyClientCert,
+					ClientCAs:              test.clientCAs,
+					MaxVersion:             test.maxVersion,
+					SessionTicketsDisabled: true,
+				},
+				fragmentSize: 500,
+			}
+
+			authErrCh := make(chan error, 1)
+
+			go func() {
+				authErrCh <- auth.run()
+			}()
+
+			t.Cleanup(func() { authenticatorConn.Close() }) //nolint:errcheck
+
+			runner := eapol.Runner{
+				LinkName: "eth0",
+				Spec: eapol.Spec{
+					LinkIndex:   2,
+					Identity:    testIdentity,
+					Certificate: clientCert,
+					RootCAs:     test.rootCAs,
+					ServerName:  "radius.example.com",
+				},
+				Listen: func(string, eapol.Spec) (net.PacketConn, error) {
+					return supplicantConn, nil
+				},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			t.Cleanup(cancel)
+
+			notifyCh := make(chan eapol.Notification)
+
+			runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+			t.Cleanup(runner.Stop)
+
+			notifications := collect(ctx, t, notifyCh, test.expectedState)
+
+			assert.Equal(t,
+				[]nethelpers.Dot1XState{nethelpers.Dot1XStateConnecting, nethelpers.Dot1XStateAuthenticating, test.expectedState},
+				states(notifications),
+			)
+
+			last := notifications[len(notifications)-1]
+
+			assert.Equal(t, "eth0", last.LinkName)
+			assert.Equal(t, authenticatorAddr, last.Authenticator)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, last.Err, test.expectedError)
+				assert.Error(t, <-authErrCh)
+			} else {
+				assert.NoError(t, last.Err)
+				assert.NoError(t, <-authErrCh)
+			}
+
+			assert.Equal(t, testIdentity, auth.identity)
+		})
+	}
+}
+
+// setupVeth creates a veth pair for the supplicant and the authenticator.
+func setupVeth(t *testing.T) (supplicant, authenticator *net.Interface) {
+	t.Helper()
+
+	conn, err := rtnetlink.Dial(nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	suffix := fmt.Sprintf("%04x", mathrand.IntN(0x10000)) //nolint:gosec
+	supplicantName, authenticatorName := "eaps"+suffix, "eapa"+suffix
+
+	require.NoError(t, conn.Link.New(&rtnetlink.LinkMessage{
+		Type: unix.ARPHRD_ETHER,
+		Attributes: &rtnetlink.LinkAttributes{
+			Name: supplicantName,
+			Info: &rtnetlink.LinkInfo{
+				Kind: "veth",
+				Data: &driver.Veth{
+					PeerInfo: &rtnetlink.LinkMessage{
+						Type: unix.ARPHRD_ETHER,
+						Attributes: &rtnetlink.LinkAttributes{
+							Name: authenticatorName,
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	supplicant, err = net.InterfaceByName(supplicantName)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Link.Delete(uint32(supplicant.Index)) }) //nolint:errcheck
+
+	authenticator, err = net.InterfaceByName(authenticatorName)
+	require.NoError(t, err)
+
+	for _, iface := range []*net.Interface{supplicant, authenticator} {
+		require.NoError(t, conn.Link.Set(&rtnetlink.LinkMessage{
+			Type:   unix.ARPHRD_ETHER,
+			Index:  uint32(iface.Index),
+			Flags:  unix.IFF_UP,
+			Change: unix.IFF_UP,
+		}))
+	}
+
+	return supplicant, authenticator
+}
+
+func TestRunnerVeth(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	supplicantLink, authenticatorLink := setupVeth(t)
+
+	ca, clientCert, serverCert := testCertificates(t)
+
+	authenticatorConn, err := packet.Listen(authenticatorLink, packet.Datagram, eapol.EtherType, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, authenticatorConn.SetPromiscuous(true))
+
+	t.Cleanup(func() { authenticatorConn.Close() }) //nolint:errcheck
+
+	auth := &fakeAuthenticator{
+		conn: authenticatorConn,
+		tlsConfig: &tls.Config{
+			Certificates:           []tls.Certificate{serverCert},
+			ClientAuth:             tls.RequireAndVerifyClientCert,
+			ClientCAs:              ca.pool(),
+			SessionTicketsDisabled: true,
+		},
+		fragmentSize: 1000,
+	}
+
+	authErrCh := make(chan error, 1)
+
+	go func() {
+		authErrCh <- auth.run()
+	}()
+
+	runner := eapol.Runner{
+		LinkName: supplicantLink.Name,
+		Spec: eapol.Spec{
+			LinkIndex:   supplicantLink.Index,
+			Identity:    testIdentity,
+			Certificate: clientCert,
+			RootCAs:     ca.pool(),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	notifyCh := make(chan eapol.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	notifications := collect(ctx, t, notifyCh, nethelpers.Dot1XStateAuthenticated)
+
+	assert.Equal(t, authenticatorLink.HardwareAddr, notifications[len(notifications)-1].Authenticator)
+	assert.NoError(t, <-authErrCh)
+}
+
+// syncBuffer is a bytes.Buffer safe for the concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+}
+
+// TestRunnerHostapd authenticates against the hostapd wired authenticator with the internal EAP server.
+func TestRunnerHostapd(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	hostapd, err := exec.LookPath("hostapd")
+	if err != nil {
+		t.Skip("hostapd is not installed")
+	}
+
+	supplicantLink, authenticatorLink := setupVeth(t)
+
+	ca, clientCert, serverCert := testCertificates(t)
+
+	dir := t.TempDir()
+
+	writePEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", ca.cert.Raw)
+	writePEM(t, filepath.Join(dir, "server.pem"), "CERTIFICATE", serverCert.Certificate[0])
+
+	serverKey, err := x509.MarshalPKCS8PrivateKey(serverCert.PrivateKey)
+	require.NoError(t, err)
+
+	writePEM(t, filepath.Join(dir, "server.key"), "PRIVATE KEY", serverKey)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "eap_user"), []byte(fmt.Sprintf("%q TLS\n", testIdentity)), 0o600))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hostapd.conf"), []byte(fmt.Sprintf(`interface=%s
+driver=wired
+logger_stdout=-1
+logger_stdout_level=1
+ieee8021x=1
+eapol_version=2
+use_pae_group_addr=1
+eap_server=1
+eap_user_file=%s
+ca_cert=%s
+server_cert=%s
+private_key=%s
+`,
+		authenticatorLink.Name,
+		filepath.Join(dir, "eap_user"),
+		filepath.Join(dir, "ca.pem"),
+		filepath.Join(dir, "server.pem"),
+		filepath.Join(dir, "server.key"),
+	)), 0o600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	var output syncBuffer
+
+	cmd := exec.CommandContext(ctx, hostapd, filepath.Join(dir, "hostapd.conf"))
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	require.NoError(t, cmd.Start())
+
+	t.Cleanup(func() {
+		cmd.Process.Kill() //nolint:errcheck
+		cmd.Wait()         //nolint:errcheck
+
+		t.Log(output.String())
+	})
+
+	// wait for the authenticator to be ready to receive EAPOL-Start
+	require.Eventually(t, func() bool {
+		return strings.Contains(output.String(), "ENABLED")
+	}, 10*time.Second, 100*time.Millisecond)
+
+	runner := eapol.Runner{
+		LinkName: supplicantLink.Name,
+		Spec: eapol.Spec{
+			LinkIndex:   supplicantLink.Index,
+			Identity:    testIdentity,
+			Certificate: clientCert,
+			RootCAs:     ca.pool(),
+			ServerName:  "radius.example.com",
+		},
+	}
+
+	notifyCh := make(chan eapol.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	notifications := collect(ctx, t, notifyCh, nethelpers.Dot1XStateAuthenticated)
+
+	assert.Equal(t, authenticatorLink.HardwareAddr, notifications[len(notifications)-1].Authenticator)
+}
@@ -0,0 +1,278 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package eapol
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// FragmentSize is the maximum size of the TLS data in the EAP-TLS message sent by the supplicant.
+//
+// It keeps the EAPOL frame within the default Ethernet MTU.
+const FragmentSize = 1398
+
+// Supplicant is the EAP peer state machine of a single authentication session.
+//
+// Supplicant handles EAP requests sequentially, it is not safe for concurrent use.
+type Supplicant struct {
+	Identity  string
+	TLSConfig *tls.Config
+
+	session *tlsSession
+	err     error
+
+	// reassembled incoming TLS data
+	in []byte
+	// outgoing TLS data which is not sent yet
+	out      []byte
+	outTotal int
+}
+
+// Handle the EAP request and return the response to it.
+//
+// Handle returns nil if the request should be silently discarded.
+func (s *Supplicant) Handle(req *Packet) *Packet {
+	switch req.Type {
+	case TypeIdentity:
+		// authenticator starts a new authentication session
+		s.reset()
+
+		return &Packet{Code: CodeResponse, ID: req.ID, Type: TypeIdentity, Data: []byte(s.Identity)}
+	case TypeNotification:
+		return &Packet{Code: CodeResponse, ID: req.ID, Type: TypeNotification}
+	case TypeTLS:
+		return s.handleTLS(req)
+	default:
+		// only EAP-TLS is supported
+		return &Packet{Code: CodeResponse, ID: req.ID, Type: TypeNak, Data: []byte{TypeTLS}}
+	}
+}
+
+// Err returns the error of the TLS handshake of the current session (if any).
+func (s *Supplicant) Err() error {
+	return s.err
+}
+
+// Close aborts the authentication session.
+func (s *Supplicant) Close() {
+	s.reset()
+}
+
+func (s *Supplicant) reset() {
+	if s.session != nil {
+		s.session.close()
+		s.session = nil
+	}
+
+	s.err = nil
+	s.in = nil
+	s.out = nil
+	s.outTotal = 0
+}
+
+func (s *Supplicant) handleTLS(req *Packet) *Packet {
+	msg, err := DecodeTLSMessage(req.Data)
+	if err != nil {
+		return nil
+	}
+
+	if msg.Flags&FlagStart != 0 {
+		s.reset()
+
+		s.session = startTLSSession(s.TLSConfig)
+		s.handleEvent(s.session.wait())
+
+		return s.nextFragment(req.ID)
+	}
+
+	if s.session == nil {
+		// no EAP-TLS start seen
+		return nil
+	}
+
+	if len(s.out) > 0 {
+		// the request acknowledges the previous fragment
+		return s.nextFragment(req.ID)
+	}
+
+	s.in = append(s.in, msg.Data...)
+
+	if msg.Flags&FlagMoreFragments != 0 {
+		return s.ack(req.ID)
+	}
+
+	data := s.in
+	s.in = nil
+
+	if s.session.done {
+		// handshake is complete, e.g. TLS 1.3 commitment message (RFC 9190, section 2.5)
+		return s.ack(req.ID)
+	}
+
+	s.handleEvent(s.session.feed(data))
+
+	return s.nextFragment(req.ID)
+}
+
+func (s *Supplicant) handleEvent(ev tlsEvent) {
+	s.out = ev.data
+	s.outTotal = len(ev.data)
+
+	if ev.done {
+		s.session.done = true
+
+		if ev.err != nil {
+			s.err = fmt.Errorf("TLS handshake failed: %w", ev.err)
+		}
+	}
+}
+
+func (s *Supplicant) ack(id uint8) *Packet {
+	return &Packet{Code: CodeResponse, ID: id, Type: TypeTLS, Data: (&TLSMessage{}).Encode()}
+}
+
+func (s *Supplicant) nextFragment(id uint8) *Packet {
+	if len(s.out) == 0 {
+		return s.ack(id)
+	}
+
+	var msg TLSMessage
+
+	size := min(len(s.out), FragmentSize)
+
+	if size < len(s.out) {
+		msg.Flags |= FlagMoreFragments
+
+		if len(s.out) == s.outTotal {
+			msg.Flags |= FlagLengthIncluded
+			msg.TotalLength = uint32(s.outTotal)
+		}
+	}
+
+	msg.Data = s.out[:size]
+	s.out = s.out[size:]
+
+	return &Packet{Code: CodeResponse, ID: id, Type: TypeTLS, Data: msg.Encode()}
+}
+
+// tlsEvent is sent when the TLS handshake waits for the data from the server or completes.
+type tlsEvent struct {
+	// TLS data to be sent to the server
+	data []byte
+	done bool
+	err  error
+}
+
+// tlsSession runs the TLS client handshake over the EAP-TLS messages.
+type tlsSession struct {
+	pipe *tlsPipe
+	wg   sync.WaitGroup
+	done bool
+}
+
+func startTLSSession(cfg *tls.Config) *tlsSession {
+	session := &tlsSession{
+		pipe: &tlsPipe{
+			inCh:    make(chan []byte),
+			eventCh: make(chan tlsEvent),
+			closed:  make(chan struct{}),
+		},
+	}
+
+	session.wg.Add(1)
+
+	go func() {
+		defer session.wg.Done()
+
+		err := tls.Client(session.pipe, cfg).Handshake()
+
+		session.pipe.flush(true, err)
+	}()
+
+	return session
+}
+
+// wait for the handshake to flush the outgoing data.
+func (session *tlsSession) wait() tlsEvent {
+	return <-session.pipe.eventCh
+}
+
+// feed the data from the server to the handshake.
+func (session *tlsSession) feed(data []byte) tlsEvent {
+	session.pipe.inCh <- data
+
+	return session.wait()
+}
+
+func (session *tlsSession) close() {
+	close(session.pipe.closed)
+
+	session.wg.Wait()
+}
+
+// tlsPipe is the net.Conn for the TLS client handshake.
+//
+// The outgoing data is buffered until the handshake tries to read the response from the server.
+type tlsPipe struct {
+	inCh    chan []byte
+	eventCh chan tlsEvent
+	closed  chan struct{}
+
+	pending []byte
+	out     []byte
+}
+
+func (p *tlsPipe) flush(done bool, err error) bool {
+	ev := tlsEvent{data: p.out, done: done, err: err}
+	p.out = nil
+
+	select {
+	case p.eventCh <- ev:
+		return true
+	case <-p.closed:
+		return false
+	}
+}
+
+func (p *tlsPipe) Read(b []byte) (int, error) {
+	for len(p.pending) == 0 {
+		if !p.flush(false, nil) {
+			return 0, net.ErrClosed
+		}
+
+		select {
+		case p.pending = <-p.inCh:
+		case <-p.closed:
+			return 0, net.ErrClosed
+		}
+	}
+
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+
+	return n, nil
+}
+
+func (p *tlsPipe) Write(b []byte) (int, error) {
+	p.out = append(p.out, b...)
+
+	return len(b), nil
+}
+
+func (p *tlsPipe) Close() error                     { return nil }
+func (p *tlsPipe) LocalAddr() net.Addr              { return pipeAddr{} }
+func (p *tlsPipe) RemoteAddr() net.Addr             { return pipeAddr{} }
+func (p *tlsPipe) SetDeadline(time.Time) error      { return nil }
+func (p *tlsPipe) SetReadDeadline(time.Time) error  { return nil }
+func (p *tlsPipe) SetWriteDeadline(time.Time) error { return nil }
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "eap-tls" }
+func (pipeAddr) String() string  { return "eap-tls" }
@@ -0,0 +1,475 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ethtoolnl implements a minimal ethtool netlink client for the link settings
+// which are not covered by github.com/mdlayher/ethtool: rings, channels, features and link modes.
+package ethtoolnl
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Autonegotiation and duplex values (see linux/ethtool.h).
+const (
+	autonegDisable = 0
+	autonegEnable  = 1
+
+	// DuplexHalf is the half duplex mode.
+	DuplexHalf = 0
+	// DuplexFull is the full duplex mode.
+	DuplexFull = 1
+	// DuplexUnknown is reported when the link is down.
+	DuplexUnknown = 0xff
+)
+
+// Rings describes the sizes of the RX/TX rings.
+type Rings struct {
+	RX    uint32
+	RXMax uint32
+	TX    uint32
+	TXMax uint32
+}
+
+// Channels describes the number of combined channels.
+type Channels struct {
+	Combined    uint32
+	CombinedMax uint32
+}
+
+// LinkMode describes the speed, duplex and autonegotiation settings.
+type LinkMode struct {
+	Autonegotiation bool
+	SpeedMegabits   uint32
+	// Duplex is one of DuplexHalf, DuplexFull or DuplexUnknown.
+	Duplex uint8
+}
+
+// Client is an ethtool generic netlink client.
+type Client struct {
+	conn   *genetlink.Conn
+	family uint16
+}
+
+// New dials the ethtool generic netlink family.
+func New() (*Client, error) {
+	conn, err := genetlink.Dial(&netlink.Config{Strict: true})
+	if err != nil {
+		return nil, fmt.Errorf("error dialing generic netlink: %w", err)
+	}
+
+	family, err := conn.GetFamily(unix.ETHTOOL_GENL_NAME)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error getting ethtool netlink family: %w", err)
+	}
+
+	return &Client{
+		conn:   conn,
+		family: family.ID,
+	}, nil
+}
+
+// Close the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Rings returns the ring sizes of the link.
+func (c *Client) Rings(name string) (Rings, error) {
+	ad, err := c.get(name, unix.ETHTOOL_MSG_RINGS_GET, unix.ETHTOOL_A_RINGS_HEADER)
+	if err != nil {
+		return Rings{}, err
+	}
+
+	return ParseRings(ad)
+}
+
+// SetRings sets the ring sizes of the link, zero values are not changed.
+func (c *Client) SetRings(name string, rx, tx uint32) error {
+	return c.set(name, unix.ETHTOOL_MSG_RINGS_SET, unix.ETHTOOL_A_RINGS_HEADER, func(ae *netlink.AttributeEncoder) {
+		if rx != 0 {
+			ae.Uint32(unix.ETHTOOL_A_RINGS_RX, rx)
+		}
+
+		if tx != 0 {
+			ae.Uint32(unix.ETHTOOL_A_RINGS_TX, tx)
+		}
+	})
+}
+
+// Channels returns the channel counts of the link.
+func (c *Client) Channels(name string) (Channels, error) {
+	ad, err := c.get(name, unix.ETHTOOL_MSG_CHANNELS_GET, unix.ETHTOOL_A_CHANNELS_HEADER)
+	if err != nil {
+		return Channels{}, err
+	}
+
+	return ParseChannels(ad)
+}
+
+// SetChannels sets the number of combined channels of the link.
+func (c *Client) SetChannels(name string, combined uint32) error {
+	return c.set(name, unix.ETHTOOL_MSG_CHANNELS_SET, unix.ETHTOOL_A_CHANNELS_HEADER, func(ae *netlink.AttributeEncoder) {
+		ae.Uint32(unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT, combined)
+	})
+}
+
+// Features returns the state of the link features which can be changed.
+func (c *Client) Features(name string) (map[string]bool, error) {
+	ad, err := c.get(name, unix.ETHTOOL_MSG_FEATURES_GET, unix.ETHTOOL_A_FEATURES_HEADER)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseFeatures(ad)
+}
+
+// SetFeatures changes the state of the specified link features, other features are not changed.
+func (c *Client) SetFeatures(name string, features map[string]bool) error {
+	return c.set(name, unix.ETHTOOL_MSG_FEATURES_SET, unix.ETHTOOL_A_FEATURES_HEADER, func(ae *netlink.AttributeEncoder) {
+		EncodeFeatures(ae, features)
+	})
+}
+
+// LinkMode returns the link mode settings of the link.
+func (c *Client) LinkMode(name string) (LinkMode, error) {
+	ad, err := c.get(name, unix.ETHTOOL_MSG_LINKMODES_GET, unix.ETHTOOL_A_LINKMODES_HEADER)
+	if err != nil {
+		return LinkMode{}, err
+	}
+
+	return ParseLinkMode(ad)
+}
+
+// SetLinkMode sets the link mode of the link.
+//
+// With autonegotiation enabled and the speed set, the kernel advertises only the link modes matching the speed and duplex.
+func (c *Client) SetLinkMode(name string, mode LinkMode) error {
+	return c.set(name, unix.ETHTOOL_MSG_LINKMODES_SET, unix.ETHTOOL_A_LINKMODES_HEADER, func(ae *netlink.AttributeEncoder) {
+		if mode.Autonegotiation {
+			ae.Uint8(unix.ETHTOOL_A_LINKMODES_AUTONEG, autonegEnable)
+		} else {
+			ae.Uint8(unix.ETHTOOL_A_LINKMODES_AUTONEG, autonegDisable)
+		}
+
+		if mode.SpeedMegabits != 0 {
+			ae.Uint32(unix.ETHTOOL_A_LINKMODES_SPEED, mode.SpeedMegabits)
+			ae.Uint8(unix.ETHTOOL_A_LINKMODES_DUPLEX, mode.Duplex)
+		}
+	})
+}
+
+// WakeOnLAN returns the enabled Wake-on-LAN modes (WAKE_* bits) of the link.
+func (c *Client) WakeOnLAN(name string) (uint32, error) {
+	ad, err := c.get(name, unix.ETHTOOL_MSG_WOL_GET, unix.ETHTOOL_A_WOL_HEADER)
+	if err != nil {
+		return 0, err
+	}
+
+	return ParseWakeOnLAN(ad)
+}
+
+// SetWakeOnLAN sets the enabled Wake-on-LAN modes (WAKE_* bits) of the link, zero disables Wake-on-LAN.
+func (c *Client) SetWakeOnLAN(name string, modes uint32) error {
+	return c.set(name, unix.ETHTOOL_MSG_WOL_SET, unix.ETHTOOL_A_WOL_HEADER, func(ae *netlink.AttributeEncoder) {
+		EncodeWakeOnLAN(ae, modes)
+	})
+}
+
+func (c *Client) get(name string, cmd uint8, header uint16) (*netlink.AttributeDecoder, error) {
+	msgs, err := c.execute(name, cmd, header, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("unexpected number of ethtool messages: %d", len(msgs))
+	}
+
+	return netlink.NewAttributeDecoder(msgs[0].Data)
+}
+
+func (c *Client) set(name string, cmd uint8, header uint16, encode func(*netlink.AttributeEncoder)) error {
+	_, err := c.execute(name, cmd, header, unix.ETHTOOL_FLAG_OMIT_REPLY, netlink.Acknowledge, encode)
+
+	return err
+}
+
+func (c *Client) execute(
+	name string, cmd uint8, header uint16, headerFlags uint32, flags netlink.HeaderFlags, encode func(*netlink.AttributeEncoder),
+) ([]genetlink.Message, error) {
+	ae := netlink.NewAttributeEncoder()
+
+	ae.Nested(header, func(nae *netlink.AttributeEncoder) error {
+		nae.String(unix.ETHTOOL_A_HEADER_DEV_NAME, name)
+
+		if headerFlags != 0 {
+			nae.Uint32(unix.ETHTOOL_A_HEADER_FLAGS, headerFlags)
+		}
+
+		return nil
+	})
+
+	if encode != nil {
+		encode(ae)
+	}
+
+	data, err := ae.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.conn.Execute(
+		genetlink.Message{
+			Header: genetlink.Header{
+				Command: cmd,
+				Version: unix.ETHTOOL_GENL_VERSION,
+			},
+			Data: data,
+		},
+		c.family,
+		netlink.Request|flags,
+	)
+}
+
+// ParseRings parses the ETHTOOL_MSG_RINGS_GET_REPLY attributes.
+func ParseRings(ad *netlink.AttributeDecoder) (Rings, error) {
+	var rings Rings
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_RINGS_RX:
+			rings.RX = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_RX_MAX:
+			rings.RXMax = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_TX:
+			rings.TX = ad.Uint32()
+		case unix.ETHTOOL_A_RINGS_TX_MAX:
+			rings.TXMax = ad.Uint32()
+		}
+	}
+
+	return rings, ad.Err()
+}
+
+// ParseChannels parses the ETHTOOL_MSG_CHANNELS_GET_REPLY attributes.
+func ParseChannels(ad *netlink.AttributeDecoder) (Channels, error) {
+	var channels Channels
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT:
+			channels.Combined = ad.Uint32()
+		case unix.ETHTOOL_A_CHANNELS_COMBINED_MAX:
+			channels.CombinedMax = ad.Uint32()
+		}
+	}
+
+	return channels, ad.Err()
+}
+
+// ParseLinkMode parses the ETHTOOL_MSG_LINKMODES_GET_REPLY attributes.
+func ParseLinkMode(ad *netlink.AttributeDecoder) (LinkMode, error) {
+	mode := LinkMode{
+		Duplex: DuplexUnknown,
+	}
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_LINKMODES_AUTONEG:
+			mode.Autonegotiation = ad.Uint8() == autonegEnable
+		case unix.ETHTOOL_A_LINKMODES_SPEED:
+			mode.SpeedMegabits = ad.Uint32()
+		case unix.ETHTOOL_A_LINKMODES_DUPLEX:
+			mode.Duplex = ad.Uint8()
+		}
+	}
+
+	return mode, ad.Err()
+}
+
+// ParseFeatures parses the ETHTOOL_MSG_FEATURES_GET_REPLY attributes.
+//
+// Only the features which can be changed (hw) are returned, with their active state.
+func ParseFeatures(ad *netlink.AttributeDecoder) (map[string]bool, error) {
+	var hw, active []bit
+
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.ETHTOOL_A_FEATURES_HW:
+			ad.Nested(decodeBitset(&hw))
+		case unix.ETHTOOL_A_FEATURES_ACTIVE:
+			ad.Nested(decodeBitset(&active))
+		}
+	}
+
+	if err := ad.Err(); err != nil {
+		return nil, err
+	}
+
+	features := make(map[string]bool, len(hw))
+
+	for _, b := range hw {
+		if b.value {
+			features[b.name] = false
+		}
+	}
+
+	for _, b := range active {
+		if _, ok := features[b.name]; ok && b.value {
+			features[b.name] = true
+		}
+	}
+
+	return features, nil
+}
+
+// EncodeFeatures encodes the ETHTOOL_MSG_FEATURES_SET attributes.
+func EncodeFeatures(ae *netlink.AttributeEncoder, features map[string]bool) {
+	bits := make([]bit, 0, len(features))
+
+	for _, name := range slices.Sorted(maps.Keys(features)) {
+		bits = append(bits, bit{name: name, value: features[name]})
+	}
+
+	ae.Nested(unix.ETHTOOL_A_FEATURES_WANTED, encodeBitset(bits, false))
+}
+
+// ParseWakeOnLAN parses the ETHTOOL_MSG_WOL_GET_REPLY attributes.
+func ParseWakeOnLAN(ad *netlink.AttributeDecoder) (uint32, error) {
+	var bits []bit
+
+	for ad.Next() {
+		if ad.Type() == unix.ETHTOOL_A_WOL_MODES {
+			ad.Nested(decodeBitset(&bits))
+		}
+	}
+
+	if err := ad.Err(); err != nil {
+		return 0, err
+	}
+
+	var modes uint32
+
+	for _, b := range bits {
+		if b.value && b.index < 32 {
+			modes |= 1 << b.index
+		}
+	}
+
+	return modes, nil
+}
+
+// EncodeWakeOnLAN encodes the ETHTOOL_MSG_WOL_SET attributes.
+func EncodeWakeOnLAN(ae *netlink.AttributeEncoder, modes uint32) {
+	var bits []bit
+
+	for index := range uint32(32) {
+		if modes&(1<<index) != 0 {
+			bits = append(bits, bit{index: index, value: true})
+		}
+	}
+
+	// the list (no mask) format replaces the whole set of enabled modes
+	ae.Nested(unix.ETHTOOL_A_WOL_MODES, encodeBitset(bits, true))
+}
+
+// bit is a single bit of the verbose ethtool bitset.
+type bit struct {
+	name  string
+	index uint32
+	value bool
+}
+
+func decodeBitset(bits *[]bit) func(*netlink.AttributeDecoder) error {
+	return func(ad *netlink.AttributeDecoder) error {
+		var noMask bool
+
+		for ad.Next() {
+			switch ad.Type() {
+			case unix.ETHTOOL_A_BITSET_NOMASK:
+				noMask = true
+			case unix.ETHTOOL_A_BITSET_BITS:
+				ad.Nested(func(nad *netlink.AttributeDecoder) error {
+					for nad.Next() {
+						if nad.Type() != unix.ETHTOOL_A_BITSET_BITS_BIT {
+							continue
+						}
+
+						nad.Nested(func(bad *netlink.AttributeDecoder) error {
+							var b bit
+
+							for bad.Next() {
+								switch bad.Type() {
+								case unix.ETHTOOL_A_BITSET_BIT_INDEX:
+									b.index = bad.Uint32()
+								case unix.ETHTOOL_A_BITSET_BIT_NAME:
+									b.name = bad.String()
+								case unix.ETHTOOL_A_BITSET_BIT_VALUE:
+									b.value = true
+								}
+							}
+
+							*bits = append(*bits, b)
+
+							return bad.Err()
+						})
+					}
+
+					return nad.Err()
+				})
+			}
+		}
+
+		if err := ad.Err(); err != nil {
+			return err
+		}
+
+		// in the list format, all listed bits are set
+		if noMask {
+			for i := range *bits {
+				(*bits)[i].value = true
+			}
+		}
+
+		return nil
+	}
+}
+
+func encodeBitset(bits []bit, noMask bool) func(*netlink.AttributeEncoder) error {
+	return func(ae *netlink.AttributeEncoder) error {
+		if noMask {
+			ae.Flag(unix.ETHTOOL_A_BITSET_NOMASK, true)
+		}
+
+		ae.Nested(unix.ETHTOOL_A_BITSET_BITS, func(nae *netlink.AttributeEncoder) error {
+			for _, b := range bits {
+				nae.Nested(unix.ETHTOOL_A_BITSET_BITS_BIT, func(bae *netlink.AttributeEncoder) error {
+					if b.name != "" {
+						bae.String(unix.ETHTOOL_A_BITSET_BIT_NAME, b.name)
+					} else {
+						bae.Uint32(unix.ETHTOOL_A_BITSET_BIT_INDEX, b.index)
+					}
+
+					if b.value {
+						bae.Flag(unix.ETHTOOL_A_BITSET_BIT_VALUE, true)
+					}
+
+					return nil
+				})
+			}
+
+			return nil
+		})
+
+		return nil
+	}
+}
@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ethtoolnl_test
+
+import (
+	"testing"
+
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtoolnl"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func decoder(t *testing.T, encode func(*netlink.AttributeEncoder)) *netlink.AttributeDecoder {
+	t.Helper()
+
+	ae := netlink.NewAttributeEncoder()
+	encode(ae)
+
+	b, err := ae.Encode()
+	require.NoError(t, err)
+
+	ad, err := netlink.NewAttributeDecoder(b)
+	require.NoError(t, err)
+
+	return ad
+}
+
+// bitset encodes a verbose bitset, bits are either indexes (uint32) or names (string).
+func bitset(noMask bool, bits map[any]bool) func(*netlink.AttributeEncoder) error {
+	return func(ae *netlink.AttributeEncoder) error {
+		if noMask {
+			ae.Flag(unix.ETHTOOL_A_BITSET_NOMASK, true)
+		}
+
+		ae.Nested(unix.ETHTOOL_A_BITSET_BITS, func(nae *netlink.AttributeEncoder) error {
+			for bit, value := range bits {
+				nae.Nested(unix.ETHTOOL_A_BITSET_BITS_BIT, func(bae *netlink.AttributeEncoder) error {
+					switch bit := bit.(type) {
+					case string:
+						bae.String(unix.ETHTOOL_A_BITSET_BIT_NAME, bit)
+					case uint32:
+						bae.Uint32(unix.ETHTOOL_A_BITSET_BIT_INDEX, bit)
+					}
+
+					bae.Flag(unix.ETHTOOL_A_BITSET_BIT_VALUE, value)
+
+					return nil
+				})
+			}
+
+			return nil
+		})
+
+		return nil
+	}
+}
+
+func TestParseRingsChannels(t *testing.T) {
+	t.Parallel()
+
+	rings, err := ethtoolnl.ParseRings(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ae.Uint32(unix.ETHTOOL_A_RINGS_RX_MAX, 4096)
+		ae.Uint32(unix.ETHTOOL_A_RINGS_TX_MAX, 2048)
+		ae.Uint32(unix.ETHTOOL_A_RINGS_RX, 512)
+		ae.Uint32(unix.ETHTOOL_A_RINGS_TX, 256)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, ethtoolnl.Rings{RX: 512, RXMax: 4096, TX: 256, TXMax: 2048}, rings)
+
+	channels, err := ethtoolnl.ParseChannels(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ae.Uint32(unix.ETHTOOL_A_CHANNELS_COMBINED_MAX, 16)
+		ae.Uint32(unix.ETHTOOL_A_CHANNELS_COMBINED_COUNT, 8)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, ethtoolnl.Channels{Combined: 8, CombinedMax: 16}, channels)
+}
+
+func TestParseLinkMode(t *testing.T) {
+	t.Parallel()
+
+	mode, err := ethtoolnl.ParseLinkMode(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ae.Uint8(unix.ETHTOOL_A_LINKMODES_AUTONEG, 1)
+		ae.Uint32(unix.ETHTOOL_A_LINKMODES_SPEED, 10000)
+		ae.Uint8(unix.ETHTOOL_A_LINKMODES_DUPLEX, ethtoolnl.DuplexFull)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, ethtoolnl.LinkMode{Autonegotiation: true, SpeedMegabits: 10000, Duplex: ethtoolnl.DuplexFull}, mode)
+}
+
+func TestParseFeatures(t *testing.T) {
+	t.Parallel()
+
+	features, err := ethtoolnl.ParseFeatures(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ae.Nested(unix.ETHTOOL_A_FEATURES_HW, bitset(true, map[any]bool{
+			"rx-gro":              false,
+			"tx-tcp-segmentation": false,
+			"rx-lro":              false,
+		}))
+		ae.Nested(unix.ETHTOOL_A_FEATURES_ACTIVE, bitset(true, map[any]bool{
+			"rx-gro":      false,
+			"rx-checksum": false,
+		}))
+	}))
+	require.NoError(t, err)
+
+	// rx-checksum can't be changed, so it's not reported
+	assert.Equal(t, map[string]bool{
+		"rx-gro":              true,
+		"tx-tcp-segmentation": false,
+		"rx-lro":              false,
+	}, features)
+}
+
+func TestEncodeFeatures(t *testing.T) {
+	t.Parallel()
+
+	ad := decoder(t, func(ae *netlink.AttributeEncoder) {
+		ethtoolnl.EncodeFeatures(ae, map[string]bool{
+			"rx-gro":              false,
+			"tx-tcp-segmentation": true,
+		})
+	})
+
+	require.True(t, ad.Next())
+	assert.Equal(t, uint16(unix.ETHTOOL_A_FEATURES_WANTED), ad.Type())
+
+	var bits map[string]bool
+
+	ad.Nested(collectBits(&bits))
+	require.NoError(t, ad.Err())
+
+	assert.Equal(t, map[string]bool{"rx-gro": false, "tx-tcp-segmentation": true}, bits)
+}
+
+func TestWakeOnLAN(t *testing.T) {
+	t.Parallel()
+
+	modes, err := ethtoolnl.ParseWakeOnLAN(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ae.Nested(unix.ETHTOOL_A_WOL_MODES, bitset(false, map[any]bool{
+			uint32(0): false,
+			uint32(5): true,
+			uint32(3): true,
+		}))
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(nethelpers.WOLModeMagic|nethelpers.WOLModeBroadcast), modes)
+
+	modes, err = ethtoolnl.ParseWakeOnLAN(decoder(t, func(ae *netlink.AttributeEncoder) {
+		ethtoolnl.EncodeWakeOnLAN(ae, uint32(nethelpers.WOLModeMagic|nethelpers.WOLModePHY))
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(nethelpers.WOLModeMagic|nethelpers.WOLModePHY), modes)
+}
+
+func collectBits(bits *map[string]bool) func(*netlink.AttributeDecoder) error {
+	return func(ad *netlink.AttributeDecoder) error {
+		*bits = map[string]bool{}
+
+		for ad.Next() {
+			if ad.Type() != unix.ETHTOOL_A_BITSET_BITS {
+				continue
+			}
+
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				for nad.Next() {
+					nad.Nested(func(bad *netlink.AttributeDecoder) error {
+						var (
+							name  string
+							value bool
+						)
+
+						for bad.Next() {
+							switch bad.Type() {
+							case unix.ETHTOOL_A_BITSET_BIT_NAME:
+								name = bad.String()
+							case unix.ETHTOOL_A_BITSET_BIT_VALUE:
+								value = true
+							}
+						}
+
+						(*bits)[name] = value
+
+						return bad.Err()
+					})
+				}
+
+				return nad.Err()
+			})
+		}
+
+		return ad.Err()
+	}
+}
@@ -0,0 +1,275 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lldp implements a minimal Link Layer Discovery Protocol (IEEE 802.1AB) agent.
+package lldp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+	"unicode"
+)
+
+// EtherType of the LLDP frames.
+const EtherType = 0x88cc
+
+// MulticastAddr is the nearest bridge group address LLDP frames are sent to.
+var MulticastAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+
+// TLV types (see IEEE 802.1AB, section 8.4).
+const (
+	tlvEnd               = 0
+	tlvChassisID         = 1
+	tlvPortID            = 2
+	tlvTTL               = 3
+	tlvPortDescription   = 4
+	tlvSystemName        = 5
+	tlvSystemDescription = 6
+	tlvManagementAddress = 8
+	tlvOrganization      = 127
+)
+
+// Chassis ID subtypes.
+const (
+	chassisIDMACAddress     = 4
+	chassisIDNetworkAddress = 5
+)
+
+// Port ID subtypes.
+const (
+	portIDMACAddress     = 3
+	portIDNetworkAddress = 4
+	portIDInterfaceName  = 5
+)
+
+// IEEE 802.1 organizationally specific TLVs.
+var oui8021 = [3]byte{0x00, 0x80, 0xc2}
+
+const (
+	subtypePortVLANID = 1
+	subtypeVLANName   = 3
+)
+
+// IANA address family numbers used in the network address subtypes and management address TLV.
+const (
+	afIPv4 = 1
+	afIPv6 = 2
+)
+
+// VLAN is a VLAN advertised with the IEEE 802.1 VLAN Name TLV.
+type VLAN struct {
+	ID   uint16
+	Name string
+}
+
+// DataUnit is a decoded LLDPDU.
+//
+// Chassis and port IDs are formatted according to their subtype: MAC addresses and network addresses
+// are formatted in the canonical form, printable values are kept as is and the rest is hex-encoded.
+type DataUnit struct {
+	ChassisID           string
+	PortID              string
+	TTL                 time.Duration
+	PortDescription     string
+	SystemName          string
+	SystemDescription   string
+	ManagementAddresses []netip.Addr
+	PortVLANID          uint16
+	VLANs               []VLAN
+}
+
+// Advertisement is the local system information sent in the LLDPDU.
+type Advertisement struct {
+	ChassisID         net.HardwareAddr
+	PortID            string
+	TTL               time.Duration
+	PortDescription   string
+	SystemName        string
+	SystemDescription string
+}
+
+// Decode parses the LLDPDU (the payload of the Ethernet frame).
+//
+//nolint:gocyclo,cyclop
+func Decode(b []byte) (*DataUnit, error) {
+	var (
+		du   DataUnit
+		seen int
+	)
+
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, errors.New("truncated TLV header")
+		}
+
+		header := binary.BigEndian.Uint16(b)
+		typ, length := int(header>>9), int(header&0x1ff)
+
+		if len(b) < 2+length {
+			return nil, fmt.Errorf("truncated TLV %d", typ)
+		}
+
+		value := b[2 : 2+length]
+		b = b[2+length:]
+
+		// mandatory TLVs should come first and in order
+		if seen < 3 && typ != seen+1 {
+			return nil, fmt.Errorf("unexpected TLV %d, expected %d", typ, seen+1)
+		}
+
+		seen++
+
+		switch typ {
+		case tlvEnd:
+			return &du, nil
+		case tlvChassisID, tlvPortID:
+			if len(value) < 2 {
+				return nil, fmt.Errorf("TLV %d is too short", typ)
+			}
+
+			id := formatID(typ, value[0], value[1:])
+
+			if typ == tlvChassisID {
+				du.ChassisID = id
+			} else {
+				du.PortID = id
+			}
+		case tlvTTL:
+			if len(value) < 2 {
+				return nil, errors.New("TTL TLV is too short")
+			}
+
+			du.TTL = time.Duration(binary.BigEndian.Uint16(value)) * time.Second
+		case tlvPortDescription:
+			du.PortDescription = string(value)
+		case tlvSystemName:
+			du.SystemName = string(value)
+		case tlvSystemDescription:
+			du.SystemDescription = string(value)
+		case tlvManagementAddress:
+			if addr, ok := decodeManagementAddress(value); ok {
+				du.ManagementAddresses = append(du.ManagementAddresses, addr)
+			}
+		case tlvOrganization:
+			if len(value) < 4 || [3]byte(value[:3]) != oui8021 {
+				continue
+			}
+
+			switch value[3] {
+			case subtypePortVLANID:
+				if len(value) >= 6 {
+					du.PortVLANID = binary.BigEndian.Uint16(value[4:])
+				}
+			case subtypeVLANName:
+				if len(value) >= 7 && len(value) >= 7+int(value[6]) {
+					du.VLANs = append(du.VLANs, VLAN{
+						ID:   binary.BigEndian.Uint16(value[4:]),
+						Name: string(value[7 : 7+int(value[6])]),
+					})
+				}
+			}
+		}
+	}
+
+	// some implementations omit the End TLV
+	if seen < 3 {
+		return nil, errors.New("mandatory TLVs are missing")
+	}
+
+	return &du, nil
+}
+
+// Encode builds the LLDPDU for the advertisement.
+func Encode(adv Advertisement) []byte {
+	var b []byte
+
+	b = appendTLV(b, tlvChassisID, append([]byte{chassisIDMACAddress}, adv.ChassisID...))
+	b = appendTLV(b, tlvPortID, append([]byte{portIDInterfaceName}, adv.PortID...))
+	b = appendTLV(b, tlvTTL, binary.BigEndian.AppendUint16(nil, uint16(min(adv.TTL/time.Second, 0xffff))))
+
+	for _, tlv := range []struct {
+		typ   int
+		value string
+	}{
+		{tlvPortDescription, adv.PortDescription},
+		{tlvSystemName, adv.SystemName},
+		{tlvSystemDescription, adv.SystemDescription},
+	} {
+		if tlv.value == "" {
+			continue
+		}
+
+		// TLV value is limited to 255 bytes for the string TLVs
+		b = appendTLV(b, tlv.typ, []byte(tlv.value[:min(len(tlv.value), 255)]))
+	}
+
+	return appendTLV(b, tlvEnd, nil)
+}
+
+func appendTLV(b []byte, typ int, value []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(typ<<9|len(value)))
+
+	return append(b, value...)
+}
+
+func formatID(typ int, subtype byte, value []byte) string {
+	switch {
+	case typ == tlvChassisID && subtype == chassisIDMACAddress,
+		typ == tlvPortID && subtype == portIDMACAddress:
+		if len(value) == 6 {
+			return net.HardwareAddr(value).String()
+		}
+	case typ == tlvChassisID && subtype == chassisIDNetworkAddress,
+		typ == tlvPortID && subtype == portIDNetworkAddress:
+		if addr, ok := decodeAddress(value); ok {
+			return addr.String()
+		}
+	}
+
+	for _, r := range string(value) {
+		if !unicode.IsPrint(r) {
+			return hex.EncodeToString(value)
+		}
+	}
+
+	return string(value)
+}
+
+// decodeAddress decodes the address prefixed with the IANA address family.
+func decodeAddress(value []byte) (netip.Addr, bool) {
+	if len(value) < 1 {
+		return netip.Addr{}, false
+	}
+
+	switch {
+	case value[0] == afIPv4 && len(value) == 1+net.IPv4len:
+		return netip.AddrFrom4([4]byte(value[1:])), true
+	case value[0] == afIPv6 && len(value) == 1+net.IPv6len:
+		return netip.AddrFrom16([16]byte(value[1:])), true
+	default:
+		return netip.Addr{}, false
+	}
+}
+
+// decodeManagementAddress decodes the address from the Management Address TLV.
+//
+// The interface numbering and the OID are ignored.
+func decodeManagementAddress(value []byte) (netip.Addr, bool) {
+	if len(value) < 1 {
+		return netip.Addr{}, false
+	}
+
+	// address string length covers the address subtype and the address
+	length := int(value[0])
+	if len(value) < 1+length {
+		return netip.Addr{}, false
+	}
+
+	return decodeAddress(value[1 : 1+length])
+}
@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"encoding/hex"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+)
+
+// switchFrame is a LLDPDU sent by a switch port.
+var switchFrame = strings.Join([]string{
+	"0207040c26b4a5f100",                 // chassis ID: MAC address 0c:26:b4:a5:f1:00
+	"040805457468312f3132",               // port ID: interface name Eth1/12
+	"06020078",                           // TTL: 120s
+	"080f736572766572732d7261636b2d3031", // port description: servers-rack-01
+	"0a09746f722d61312e6463",             // system name: tor-a1.dc
+	"0c094e582d4f5320392e33",             // system description: NX-OS 9.3
+	"100c0501c0a8000a020000000100",       // management address: 192.168.0.10, ifindex 1, no OID
+	"fe060080c2010064",                   // port VLAN ID: 100
+	"fe0e0080c20300640773657276657273",   // VLAN name: 100 servers
+	"0000",                               // end
+}, "")
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	b, err := hex.DecodeString(switchFrame)
+	require.NoError(t, err)
+
+	du, err := lldp.Decode(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, &lldp.DataUnit{
+		ChassisID:           "0c:26:b4:a5:f1:00",
+		PortID:              "Eth1/12",
+		TTL:                 2 * time.Minute,
+		PortDescription:     "servers-rack-01",
+		SystemName:          "tor-a1.dc",
+		SystemDescription:   "NX-OS 9.3",
+		ManagementAddresses: []netip.Addr{netip.MustParseAddr("192.168.0.10")},
+		PortVLANID:          100,
+		VLANs:               []lldp.VLAN{{ID: 100, Name: "servers"}},
+	}, du)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		frame string
+
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			expectedError: "mandatory TLVs are missing",
+		},
+		{
+			name:          "truncated",
+			frame:         "0207040c26",
+			expectedError: "truncated TLV 1",
+		},
+		{
+			name:          "out of order",
+			frame:         "040805457468312f3132",
+			expectedError: "unexpected TLV 2, expected 1",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := hex.DecodeString(test.frame)
+			require.NoError(t, err)
+
+			_, err = lldp.Decode(b)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	b := lldp.Encode(lldp.Advertisement{
+		ChassisID:         net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+		PortID:            "eth0",
+		TTL:               2 * time.Minute,
+		SystemName:        "talos-worker-1",
+		SystemDescription: "Talos (v1.9.0)",
+	})
+
+	du, err := lldp.Decode(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, &lldp.DataUnit{
+		ChassisID:         "02:00:00:00:00:01",
+		PortID:            "eth0",
+		TTL:               2 * time.Minute,
+		SystemName:        "talos-worker-1",
+		SystemDescription: "Talos (v1.9.0)",
+	}, du)
+}
@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/packet"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+// txHold is the multiplier of the transmit interval to get the advertised TTL (see IEEE 802.1AB msgTxHold).
+const txHold = 4
+
+// retryInterval is the interval between attempts to open the LLDP socket.
+const retryInterval = 10 * time.Second
+
+// Spec describes the LLDP agent settings of the link.
+type Spec struct {
+	LinkIndex    int
+	HardwareAddr net.HardwareAddr
+
+	Transmit          bool
+	TransmitInterval  time.Duration
+	SystemName        string
+	SystemDescription string
+}
+
+// Equal returns true if the specs are equal.
+func (spec Spec) Equal(other Spec) bool {
+	return spec.LinkIndex == other.LinkIndex &&
+		bytes.Equal(spec.HardwareAddr, other.HardwareAddr) &&
+		spec.Transmit == other.Transmit &&
+		spec.TransmitInterval == other.TransmitInterval &&
+		spec.SystemName == other.SystemName &&
+		spec.SystemDescription == other.SystemDescription
+}
+
+// Runner is a LLDP agent running on a single link.
+type Runner struct {
+	LinkName string
+	Spec     Spec
+
+	// Listen opens the connection to receive and send LLDPDUs, defaults to the packet socket bound to the link.
+	Listen func(linkName string, spec Spec) (net.PacketConn, error)
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Notification of a neighbor change.
+type Notification struct {
+	LinkName string
+	// Neighbor is nil if the neighbor is gone.
+	Neighbor *DataUnit
+}
+
+// Start a runner with a given context.
+func (runner *Runner) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	runner.wg.Add(1)
+
+	ctx, runner.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer runner.wg.Done()
+
+		runner.run(ctx, notifyCh, logger.With(zap.String("link", runner.LinkName)))
+	}()
+}
+
+// Stop a runner.
+func (runner *Runner) Stop() {
+	runner.cancel()
+
+	runner.wg.Wait()
+}
+
+func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	if runner.Listen == nil {
+		runner.Listen = listen
+	}
+
+	for {
+		conn, err := runner.Listen(runner.LinkName, runner.Spec)
+		if err == nil {
+			runner.serve(ctx, conn, notifyCh, logger)
+
+			return
+		}
+
+		logger.Warn("error opening LLDP socket", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+//nolint:gocyclo
+func (runner *Runner) serve(ctx context.Context, conn net.PacketConn, notifyCh chan<- Notification, logger *zap.Logger) {
+	framesCh := make(chan *DataUnit)
+
+	var readerWg sync.WaitGroup
+
+	readerWg.Add(1)
+
+	go func() {
+		defer readerWg.Done()
+
+		runner.read(ctx, conn, framesCh, logger)
+	}()
+
+	defer readerWg.Wait()
+	defer conn.Close() //nolint:errcheck
+
+	expiryTimer := time.NewTimer(0)
+	<-expiryTimer.C
+
+	defer expiryTimer.Stop()
+
+	var transmitCh <-chan time.Time
+
+	if runner.Spec.Transmit {
+		ticker := time.NewTicker(runner.Spec.TransmitInterval)
+		defer ticker.Stop()
+
+		transmitCh = ticker.C
+
+		runner.transmit(conn, logger)
+	}
+
+	for {
+		var neighbor *DataUnit
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-transmitCh:
+			runner.transmit(conn, logger)
+
+			continue
+		case <-expiryTimer.C:
+			logger.Debug("LLDP neighbor expired")
+		case neighbor = <-framesCh:
+			expiryTimer.Stop()
+
+			// zero TTL is sent on shutdown of the neighbor
+			if neighbor.TTL == 0 {
+				neighbor = nil
+			} else {
+				expiryTimer.Reset(neighbor.TTL)
+			}
+		}
+
+		if !channel.SendWithContext(ctx, notifyCh, Notification{
+			LinkName: runner.LinkName,
+			Neighbor: neighbor,
+		}) {
+			return
+		}
+	}
+}
+
+func (runner *Runner) read(ctx context.Context, conn net.PacketConn, framesCh chan<- *DataUnit, logger *zap.Logger) {
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("error reading LLDP frame", zap.Error(err))
+			}
+
+			return
+		}
+
+		du, err := Decode(buf[:n])
+		if err != nil {
+			logger.Debug("error decoding LLDP frame", zap.Error(err))
+
+			continue
+		}
+
+		if !channel.SendWithContext(ctx, framesCh, du) {
+			return
+		}
+	}
+}
+
+func (runner *Runner) transmit(conn net.PacketConn, logger *zap.Logger) {
+	frame := Encode(Advertisement{
+		ChassisID:         runner.Spec.HardwareAddr,
+		PortID:            runner.LinkName,
+		TTL:               txHold * runner.Spec.TransmitInterval,
+		SystemName:        runner.Spec.SystemName,
+		SystemDescription: runner.Spec.SystemDescription,
+	})
+
+	if _, err := conn.WriteTo(frame, &packet.Addr{HardwareAddr: MulticastAddr}); err != nil {
+		logger.Warn("error sending LLDP frame", zap.Error(err))
+	}
+}
+
+// listen opens a packet socket on the link which receives the LLDP frames sent to the nearest bridge group address.
+func listen(linkName string, spec Spec) (net.PacketConn, error) {
+	conn, err := packet.Listen(&net.Interface{Index: spec.LinkIndex, Name: linkName}, packet.Datagram, EtherType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on the packet socket: %w", err)
+	}
+
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(spec.LinkIndex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(MulticastAddr)),
+	}
+
+	copy(mreq.Address[:], MulticastAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err == nil {
+		err = sockErr
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error joining LLDP multicast group: %w", err)
+	}
+
+	return conn, nil
+}
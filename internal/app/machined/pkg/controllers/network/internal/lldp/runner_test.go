@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+)
+
+// mockConn delivers the frames from the channel and records the sent frames.
+type mockConn struct {
+	net.PacketConn
+
+	rxCh   chan []byte
+	txCh   chan []byte
+	closed chan struct{}
+}
+
+func newMockConn() *mockConn {
+	return &mockConn{
+		rxCh:   make(chan []byte),
+		txCh:   make(chan []byte, 16),
+		closed: make(chan struct{}),
+	}
+}
+
+func (conn *mockConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	select {
+	case frame := <-conn.rxCh:
+		return copy(b, frame), nil, nil
+	case <-conn.closed:
+		return 0, nil, os.ErrClosed
+	}
+}
+
+func (conn *mockConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	conn.txCh <- append([]byte(nil), b...)
+
+	return len(b), nil
+}
+
+func (conn *mockConn) Close() error {
+	close(conn.closed)
+
+	return nil
+}
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	conn := newMockConn()
+
+	runner := lldp.Runner{
+		LinkName: "eth0",
+		Spec: lldp.Spec{
+			LinkIndex:         2,
+			HardwareAddr:      net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+			Transmit:          true,
+			TransmitInterval:  time.Hour,
+			SystemName:        "talos-worker-1",
+			SystemDescription: "Talos (v1.9.0)",
+		},
+		Listen: func(string, lldp.Spec) (net.PacketConn, error) {
+			return conn, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	notifyCh := make(chan lldp.Notification)
+
+	runner.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(runner.Stop)
+
+	// the advertisement is sent right away
+	select {
+	case frame := <-conn.txCh:
+		du, err := lldp.Decode(frame)
+		require.NoError(t, err)
+
+		assert.Equal(t, "02:00:00:00:00:01", du.ChassisID)
+		assert.Equal(t, "eth0", du.PortID)
+		assert.Equal(t, 4*time.Hour, du.TTL)
+		assert.Equal(t, "talos-worker-1", du.SystemName)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the advertisement")
+	}
+
+	// the neighbor is discovered
+	conn.rxCh <- lldp.Encode(lldp.Advertisement{
+		ChassisID: net.HardwareAddr{0x0c, 0x26, 0xb4, 0xa5, 0xf1, 0x00},
+		PortID:    "Eth1/12",
+		TTL:       time.Second,
+	})
+
+	select {
+	case ev := <-notifyCh:
+		assert.Equal(t, "eth0", ev.LinkName)
+		require.NotNil(t, ev.Neighbor)
+		assert.Equal(t, "Eth1/12", ev.Neighbor.PortID)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the neighbor")
+	}
+
+	// the neighbor expires after the TTL
+	select {
+	case ev := <-notifyCh:
+		assert.Equal(t, "eth0", ev.LinkName)
+		assert.Nil(t, ev.Neighbor)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the neighbor to expire")
+	}
+}
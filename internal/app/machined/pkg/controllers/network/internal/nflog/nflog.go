@@ -0,0 +1,222 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nflog implements a minimal netfilter log (nfnetlink_log) client.
+package nflog
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// nfnetlink_log message types, attributes and commands (see linux/netfilter/nfnetlink_log.h).
+const (
+	msgPacket = 0
+	msgConfig = 1
+
+	attrIfIndexInDev  = 4
+	attrIfIndexOutDev = 5
+	attrPayload       = 9
+	attrPrefix        = 10
+
+	attrConfigCmd     = 1
+	attrConfigMode    = 2
+	attrConfigQThresh = 5
+
+	cmdBind = 1
+
+	copyPacket = 2
+
+	// copyRange is the number of bytes of the packet to copy, it's enough to cover IP and transport headers.
+	copyRange = 128
+)
+
+// Packet is a packet logged by the netfilter.
+type Packet struct {
+	Prefix string
+
+	InIfIndex  uint32
+	OutIfIndex uint32
+
+	Protocol        nethelpers.Protocol
+	Source          netip.Addr
+	SourcePort      uint16
+	Destination     netip.Addr
+	DestinationPort uint16
+}
+
+// Conn is a connection to the netfilter log group.
+type Conn struct {
+	conn *netlink.Conn
+}
+
+// Dial binds to the netfilter log group.
+func Dial(group uint16) (*Conn, error) {
+	conn, err := netlink.Dial(unix.NETLINK_NETFILTER, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing netfilter netlink socket: %w", err)
+	}
+
+	// the mode attribute is struct nfulnl_msg_config_mode {__be32 copy_range; __u8 copy_mode; __u8 _pad;}
+	attrs, err := netlink.MarshalAttributes([]netlink.Attribute{
+		{
+			Type: attrConfigCmd,
+			Data: []byte{cmdBind},
+		},
+		{
+			Type: attrConfigMode,
+			Data: append(binary.BigEndian.AppendUint32(nil, copyRange), copyPacket, 0),
+		},
+		{
+			Type: attrConfigQThresh,
+			Data: binary.BigEndian.AppendUint32(nil, 1),
+		},
+	})
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	if _, err = conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  netlink.HeaderType(unix.NFNL_SUBSYS_ULOG<<8 | msgConfig),
+			Flags: netlink.Request | netlink.Acknowledge,
+		},
+		Data: append(nfgenmsg(group), attrs...),
+	}); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error binding to netfilter log group %d: %w", group, err)
+	}
+
+	return &Conn{conn: conn}, nil
+}
+
+// Close the connection.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+// Receive the logged packets.
+//
+// Overruns of the socket receive buffer and malformed messages are ignored, as the log is best-effort.
+func (c *Conn) Receive() ([]Packet, error) {
+	msgs, err := c.conn.Receive()
+	if err != nil {
+		if errors.Is(err, unix.ENOBUFS) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	packets := make([]Packet, 0, len(msgs))
+
+	for _, msg := range msgs {
+		if msg.Header.Type != netlink.HeaderType(unix.NFNL_SUBSYS_ULOG<<8|msgPacket) {
+			continue
+		}
+
+		packet, err := ParsePacket(msg.Data)
+		if err != nil {
+			// skip malformed messages
+			continue
+		}
+
+		packets = append(packets, packet)
+	}
+
+	return packets, nil
+}
+
+// ParsePacket parses the nfnetlink_log packet message (without the netlink header).
+func ParsePacket(data []byte) (Packet, error) {
+	var packet Packet
+
+	// skip struct nfgenmsg
+	if len(data) < 4 {
+		return packet, errors.New("message is too short")
+	}
+
+	ad, err := netlink.NewAttributeDecoder(data[4:])
+	if err != nil {
+		return packet, err
+	}
+
+	ad.ByteOrder = binary.BigEndian
+
+	for ad.Next() {
+		switch ad.Type() {
+		case attrPrefix:
+			packet.Prefix = ad.String()
+		case attrIfIndexInDev:
+			packet.InIfIndex = ad.Uint32()
+		case attrIfIndexOutDev:
+			packet.OutIfIndex = ad.Uint32()
+		case attrPayload:
+			parsePayload(ad.Bytes(), &packet)
+		}
+	}
+
+	return packet, ad.Err()
+}
+
+// parsePayload parses the IP header and the ports of TCP/UDP packets.
+//
+// IPv6 extension headers are not parsed, so the ports are only reported if the transport header follows the IPv6 header.
+func parsePayload(payload []byte, packet *Packet) {
+	if len(payload) == 0 {
+		return
+	}
+
+	var transport []byte
+
+	switch payload[0] >> 4 {
+	case 4:
+		if len(payload) < 20 {
+			return
+		}
+
+		headerLen := int(payload[0]&0x0f) * 4
+
+		packet.Protocol = nethelpers.Protocol(payload[9])
+		packet.Source = netip.AddrFrom4([4]byte(payload[12:16]))
+		packet.Destination = netip.AddrFrom4([4]byte(payload[16:20]))
+
+		// only the first fragment carries the transport header
+		if binary.BigEndian.Uint16(payload[6:8])&0x1fff == 0 && len(payload) >= headerLen {
+			transport = payload[headerLen:]
+		}
+	case 6:
+		if len(payload) < 40 {
+			return
+		}
+
+		packet.Protocol = nethelpers.Protocol(payload[6])
+		packet.Source = netip.AddrFrom16([16]byte(payload[8:24]))
+		packet.Destination = netip.AddrFrom16([16]byte(payload[24:40]))
+
+		transport = payload[40:]
+	default:
+		return
+	}
+
+	if (packet.Protocol == nethelpers.ProtocolTCP || packet.Protocol == nethelpers.ProtocolUDP) && len(transport) >= 4 {
+		packet.SourcePort = binary.BigEndian.Uint16(transport[0:2])
+		packet.DestinationPort = binary.BigEndian.Uint16(transport[2:4])
+	}
+}
+
+// nfgenmsg builds struct nfgenmsg for the specified group.
+func nfgenmsg(group uint16) []byte {
+	return binary.BigEndian.AppendUint16([]byte{unix.AF_UNSPEC, unix.NFNETLINK_V0}, group)
+}
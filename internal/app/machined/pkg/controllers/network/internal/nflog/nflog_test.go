@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nflog_test
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/mdlayher/netlink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/nflog"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func message(t *testing.T, payload []byte) []byte {
+	t.Helper()
+
+	attrs, err := netlink.MarshalAttributes([]netlink.Attribute{
+		{Type: 10, Data: []byte("ingress\x00")},
+		{Type: 4, Data: binary.BigEndian.AppendUint32(nil, 2)},
+		{Type: 9, Data: payload},
+	})
+	require.NoError(t, err)
+
+	return append([]byte{2, 0, 0, 100}, attrs...)
+}
+
+func TestParsePacket(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		payload []byte
+
+		expected nflog.Packet
+	}{
+		{
+			name: "ipv4 tcp",
+			payload: []byte{
+				0x45, 0x00, 0x00, 0x3c, 0x00, 0x00, 0x40, 0x00, 0x40, 0x06, 0x00, 0x00,
+				192, 168, 1, 10,
+				10, 0, 0, 1,
+				0xc3, 0x50, 0x01, 0xbb, // 50000 -> 443
+			},
+
+			expected: nflog.Packet{
+				Prefix:          "ingress",
+				InIfIndex:       2,
+				Protocol:        nethelpers.ProtocolTCP,
+				Source:          netip.MustParseAddr("192.168.1.10"),
+				SourcePort:      50000,
+				Destination:     netip.MustParseAddr("10.0.0.1"),
+				DestinationPort: 443,
+			},
+		},
+		{
+			name: "ipv4 icmp",
+			payload: []byte{
+				0x45, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x40, 0x01, 0x00, 0x00,
+				192, 168, 1, 10,
+				10, 0, 0, 1,
+				0x08, 0x00, 0x00, 0x00,
+			},
+
+			expected: nflog.Packet{
+				Prefix:      "ingress",
+				InIfIndex:   2,
+				Protocol:    nethelpers.ProtocolICMP,
+				Source:      netip.MustParseAddr("192.168.1.10"),
+				Destination: netip.MustParseAddr("10.0.0.1"),
+			},
+		},
+		{
+			name: "ipv6 udp",
+			payload: append(
+				append(
+					append([]byte{0x60, 0x00, 0x00, 0x00, 0x00, 0x08, 0x11, 0x40}, netip.MustParseAddr("2001:db8::1").AsSlice()...),
+					netip.MustParseAddr("2001:db8::2").AsSlice()...,
+				),
+				0x00, 0x35, 0x13, 0x88, // 53 -> 5000
+			),
+
+			expected: nflog.Packet{
+				Prefix:          "ingress",
+				InIfIndex:       2,
+				Protocol:        nethelpers.ProtocolUDP,
+				Source:          netip.MustParseAddr("2001:db8::1"),
+				SourcePort:      53,
+				Destination:     netip.MustParseAddr("2001:db8::2"),
+				DestinationPort: 5000,
+			},
+		},
+		{
+			name:    "truncated",
+			payload: []byte{0x45, 0x00},
+
+			expected: nflog.Packet{
+				Prefix:    "ingress",
+				InIfIndex: 2,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			packet, err := nflog.ParsePacket(message(t, test.payload))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, packet)
+		})
+	}
+}
@@ -7,15 +7,25 @@ package probe
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
 	"net"
+	"net/http"
 	"sync"
 	"syscall"
 
 	"github.com/benbjohnson/clock"
+	"github.com/miekg/dns"
 	"github.com/siderolabs/gen/channel"
 	"go.uber.org/zap"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
 
+	"github.com/siderolabs/talos/pkg/httpdefaults"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -27,6 +37,9 @@ type Runner struct {
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
+
+	icmpID  uint16
+	icmpSeq uint16
 }
 
 // Notification of a runner status.
@@ -65,6 +78,8 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 		runner.Clock = clock.New()
 	}
 
+	runner.icmpID = uint16(rand.N(1 << 16))
+
 	ticker := runner.Clock.Ticker(runner.Spec.Interval)
 	defer ticker.Stop()
 
@@ -126,11 +141,22 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 
 // probe runs a probe.
 func (runner *Runner) probe(ctx context.Context) error {
-	var zeroTCP network.TCPProbeSpec
+	var (
+		zeroTCP  network.TCPProbeSpec
+		zeroICMP network.ICMPProbeSpec
+		zeroHTTP network.HTTPProbeSpec
+		zeroDNS  network.DNSProbeSpec
+	)
 
 	switch {
 	case runner.Spec.TCP != zeroTCP:
 		return runner.probeTCP(ctx)
+	case runner.Spec.ICMP != zeroICMP:
+		return runner.probeICMP(ctx)
+	case runner.Spec.HTTP != zeroHTTP:
+		return runner.probeHTTP(ctx)
+	case runner.Spec.DNS != zeroDNS:
+		return runner.probeDNS(ctx)
 	default:
 		return errors.New("no probe type specified")
 	}
@@ -141,9 +167,13 @@ func (runner *Runner) probeTCP(ctx context.Context) error {
 	dialer := &net.Dialer{
 		// The dialer reduces the TIME-WAIT period to 1 seconds instead of the OS default of 60 seconds.
 		Control: func(network, address string, c syscall.RawConn) error {
-			return c.Control(func(fd uintptr) {
+			if err := c.Control(func(fd uintptr) {
 				syscall.SetsockoptLinger(int(fd), syscall.SOL_SOCKET, syscall.SO_LINGER, &syscall.Linger{Onoff: 1, Linger: 1}) //nolint: errcheck
-			})
+			}); err != nil {
+				return err
+			}
+
+			return runner.bindToLink(network, address, c)
 		},
 	}
 
@@ -157,3 +187,189 @@ func (runner *Runner) probeTCP(ctx context.Context) error {
 
 	return conn.Close()
 }
+
+// probeICMP runs an ICMP echo probe.
+//
+//nolint:gocyclo
+func (runner *Runner) probeICMP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.ICMP.Timeout)
+	defer cancel()
+
+	address := runner.Spec.ICMP.Address
+
+	var (
+		listenNetwork, listenAddress string
+		protocol                     int
+		requestType, replyType       icmp.Type
+	)
+
+	if address.Is4() || address.Is4In6() {
+		address = address.Unmap()
+		listenNetwork, listenAddress = "ip4:icmp", "0.0.0.0"
+		protocol = 1 // ICMP
+		requestType, replyType = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	} else {
+		listenNetwork, listenAddress = "ip6:ipv6-icmp", "::"
+		protocol = 58 // ICMPv6
+		requestType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	// raw ICMP sockets are used, so the plain packet conn works the same way as the icmp.PacketConn
+	conn, err := (&net.ListenConfig{Control: runner.bindToLink}).ListenPacket(ctx, listenNetwork, listenAddress)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	runner.icmpSeq++
+
+	request, err := (&icmp.Message{
+		Type: requestType,
+		Body: &icmp.Echo{
+			ID:   int(runner.icmpID),
+			Seq:  int(runner.icmpSeq),
+			Data: []byte("talos"),
+		},
+	}).Marshal(nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = conn.WriteTo(request, &net.IPAddr{IP: address.AsSlice(), Zone: address.Zone()}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		// raw ICMP sockets receive all ICMP messages, so filter out replies to other requests
+		if peerAddr, ok := peer.(*net.IPAddr); !ok || !peerAddr.IP.Equal(address.AsSlice()) {
+			continue
+		}
+
+		reply, err := icmp.ParseMessage(protocol, buf[:n])
+		if err != nil {
+			continue
+		}
+
+		if reply.Type != replyType {
+			continue
+		}
+
+		if echo, ok := reply.Body.(*icmp.Echo); ok && echo.ID == int(runner.icmpID) && echo.Seq == int(runner.icmpSeq) {
+			return nil
+		}
+	}
+}
+
+// probeHTTP runs an HTTP(S) GET probe.
+func (runner *Runner) probeHTTP(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.HTTP.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, runner.Spec.HTTP.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		// connect directly (without a proxy) using a fresh connection for each probe
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{Control: runner.bindToLink}).DialContext,
+			TLSClientConfig: &tls.Config{
+				RootCAs: httpdefaults.RootCAs(),
+			},
+			DisableKeepAlives: true,
+		},
+		// the status code of the probed URL is checked, so don't follow redirects
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return err
+	}
+
+	expectedStatusCode := runner.Spec.HTTP.ExpectedStatusCode
+	if expectedStatusCode == 0 {
+		expectedStatusCode = http.StatusOK
+	}
+
+	if resp.StatusCode != expectedStatusCode {
+		return fmt.Errorf("unexpected HTTP status code %d, expected %d", resp.StatusCode, expectedStatusCode)
+	}
+
+	return nil
+}
+
+// probeDNS runs a DNS query probe.
+func (runner *Runner) probeDNS(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, runner.Spec.DNS.Timeout)
+	defer cancel()
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(runner.Spec.DNS.Name), dns.TypeA)
+
+	client := &dns.Client{
+		Dialer: &net.Dialer{
+			Timeout: runner.Spec.DNS.Timeout,
+			Control: runner.bindToLink,
+		},
+		Timeout: runner.Spec.DNS.Timeout,
+	}
+
+	resp, _, err := client.ExchangeContext(ctx, msg, runner.Spec.DNS.Server)
+	if err != nil {
+		return err
+	}
+
+	if resp.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("DNS query for %q failed: %s", runner.Spec.DNS.Name, dns.RcodeToString[resp.Rcode])
+	}
+
+	return nil
+}
+
+// bindToLink binds the probe socket to the link, if the probe has the link set.
+//
+// The probe then goes out through the link even if the default route points to another link.
+func (runner *Runner) bindToLink(_, _ string, c syscall.RawConn) error {
+	if runner.Spec.LinkName == "" {
+		return nil
+	}
+
+	var bindErr error
+
+	if err := c.Control(func(fd uintptr) {
+		bindErr = syscall.BindToDevice(int(fd), runner.Spec.LinkName)
+	}); err != nil {
+		return err
+	}
+
+	if bindErr != nil {
+		return fmt.Errorf("error binding to link %q: %w", runner.Spec.LinkName, bindErr)
+	}
+
+	return nil
+}
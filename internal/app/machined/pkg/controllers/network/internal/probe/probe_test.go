@@ -6,13 +6,18 @@ package probe_test
 
 import (
 	"context"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"net/url"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -150,3 +155,210 @@ func TestProbeConsecutiveFailures(t *testing.T) {
 	assert.Equal(t, "consecutive-failures", notify.ID)
 	assert.False(t, notify.Status.Success)
 }
+
+func runProbeOnce(t *testing.T, spec network.ProbeSpecSpec) network.ProbeStatusSpec {
+	t.Helper()
+
+	p := probe.Runner{
+		ID:    "test",
+		Spec:  spec,
+		Clock: clock.NewMock(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	notifyCh := make(chan probe.Notification)
+
+	p.Start(ctx, notifyCh, zaptest.NewLogger(t))
+	t.Cleanup(p.Stop)
+
+	var notification probe.Notification
+
+	select {
+	case notification = <-notifyCh:
+	case <-ctx.Done():
+		require.FailNow(t, "timeout waiting for the probe notification")
+	}
+
+	return notification.Status
+}
+
+func TestProbeHTTPStatusCode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	for _, test := range []struct {
+		name               string
+		path               string
+		expectedStatusCode int
+
+		expectedError string
+	}{
+		{
+			name:               "expected status",
+			path:               "/healthz",
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "default expected status",
+			path: "/healthz",
+
+			expectedError: "unexpected HTTP status code 204, expected 200",
+		},
+		{
+			name:               "redirect is not followed",
+			path:               "/redirect",
+			expectedStatusCode: http.StatusNoContent,
+
+			expectedError: "unexpected HTTP status code 302, expected 204",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := runProbeOnce(t, network.ProbeSpecSpec{
+				Interval: time.Second,
+				HTTP: network.HTTPProbeSpec{
+					URL:                server.URL + test.path,
+					ExpectedStatusCode: test.expectedStatusCode,
+					Timeout:            time.Second,
+				},
+			})
+
+			if test.expectedError == "" {
+				assert.Equal(t, network.ProbeStatusSpec{Success: true}, status)
+			} else {
+				assert.Equal(t, network.ProbeStatusSpec{LastError: test.expectedError}, status)
+			}
+		})
+	}
+}
+
+func TestProbeDNS(t *testing.T) {
+	t.Parallel()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		PacketConn: pc,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			msg := new(dns.Msg)
+			msg.SetReply(r)
+
+			if r.Question[0].Name == "example.com." {
+				msg.Answer = append(msg.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+					A:   net.ParseIP("192.0.2.1"),
+				})
+			} else {
+				msg.Rcode = dns.RcodeNameError
+			}
+
+			w.WriteMsg(msg) //nolint:errcheck
+		}),
+	}
+
+	go server.ActivateAndServe() //nolint:errcheck
+
+	t.Cleanup(func() { server.Shutdown() }) //nolint:errcheck
+
+	assert.Equal(t, network.ProbeStatusSpec{Success: true}, runProbeOnce(t, network.ProbeSpecSpec{
+		Interval: time.Second,
+		DNS: network.DNSProbeSpec{
+			Server:  pc.LocalAddr().String(),
+			Name:    "example.com",
+			Timeout: time.Second,
+		},
+	}))
+
+	assert.Equal(t, network.ProbeStatusSpec{LastError: `DNS query for "example.org" failed: NXDOMAIN`}, runProbeOnce(t, network.ProbeSpecSpec{
+		Interval: time.Second,
+		DNS: network.DNSProbeSpec{
+			Server:  pc.LocalAddr().String(),
+			Name:    "example.org",
+			Timeout: time.Second,
+		},
+	}))
+}
+
+func TestProbeICMP(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		if errors.Is(err, os.ErrPermission) {
+			t.Skip("raw ICMP sockets are not permitted")
+		}
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, conn.Close())
+
+	assert.Equal(t, network.ProbeStatusSpec{Success: true}, runProbeOnce(t, network.ProbeSpecSpec{
+		Interval: time.Second,
+		ICMP: network.ICMPProbeSpec{
+			Address: netip.MustParseAddr("127.0.0.1"),
+			Timeout: time.Second,
+		},
+	}))
+}
+
+func TestProbeLink(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	for _, test := range []struct {
+		name     string
+		linkName string
+
+		expectedError string
+	}{
+		{
+			name:     "loopback",
+			linkName: "lo",
+		},
+		{
+			name:     "missing link",
+			linkName: "nolink0",
+
+			expectedError: `error binding to link "nolink0"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := runProbeOnce(t, network.ProbeSpecSpec{
+				Interval: time.Second,
+				HTTP: network.HTTPProbeSpec{
+					URL:     server.URL,
+					Timeout: time.Second,
+				},
+				LinkName: test.linkName,
+			})
+
+			if test.expectedError == "" {
+				assert.Equal(t, network.ProbeStatusSpec{Success: true}, status)
+			} else {
+				assert.False(t, status.Success)
+				assert.Contains(t, status.LastError, test.expectedError)
+			}
+		})
+	}
+}
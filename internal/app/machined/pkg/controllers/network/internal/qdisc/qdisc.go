@@ -0,0 +1,435 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package qdisc implements building and applying the root queueing discipline hierarchy of the link.
+package qdisc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/florianl/go-tc"
+	"github.com/florianl/go-tc/core"
+	"github.com/siderolabs/go-pointer"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RootHandle is the handle of the root qdisc managed by Talos.
+var RootHandle = core.BuildHandle(1, 0)
+
+const (
+	// linkLayerEthernet is TC_LINKLAYER_ETHERNET, it tells the kernel to skip the legacy rate tables.
+	linkLayerEthernet = 1
+
+	defaultTBFLatency  = 50 * time.Millisecond
+	defaultTBFMinBurst = 16 * 1024
+
+	// htbParentClassID is the minor part of the HTB class which holds the total rate.
+	htbParentClassID = 1
+
+	// htbHZ and htbMTU are used to calculate the default HTB burst size the same way as tc does.
+	htbHZ  = 1000
+	htbMTU = 1600
+
+	// htbFilterPriority is the priority of the firewall mark filters.
+	htbFilterPriority = 1
+
+	// ethPAll is ETH_P_ALL in the network byte order.
+	ethPAll = (unix.ETH_P_ALL&0xff)<<8 | unix.ETH_P_ALL>>8
+
+	defaultNetemLimit = 1000
+)
+
+// Hierarchy is the set of the traffic control objects which make up the root qdisc of the link.
+type Hierarchy struct {
+	Qdisc   tc.Object
+	Classes []tc.Object
+	Leaves  []tc.Object
+	Filters []tc.Object
+}
+
+// Build the qdisc hierarchy for the link from the spec.
+//
+//nolint:gocyclo
+func Build(ifindex uint32, spec *network.QdiscSpecSpec) (Hierarchy, error) {
+	h := Hierarchy{
+		Qdisc: tc.Object{
+			Msg: tc.Msg{
+				Family:  unix.AF_UNSPEC,
+				Ifindex: ifindex,
+				Handle:  RootHandle,
+				Parent:  tc.HandleRoot,
+			},
+			Attribute: tc.Attribute{
+				Kind: spec.Kind,
+			},
+		},
+	}
+
+	switch spec.Kind {
+	case network.QdiscKindFQ:
+		h.Qdisc.Fq = &tc.Fq{}
+
+		if spec.FQ.FlowLimit != 0 {
+			h.Qdisc.Fq.FlowPLimit = &spec.FQ.FlowLimit
+		}
+
+		if spec.FQ.MaxRate != 0 {
+			h.Qdisc.Fq.FlowMaxRate = pointer.To(clamp32(spec.FQ.MaxRate / 8))
+		}
+	case network.QdiscKindFQCodel:
+		h.Qdisc.FqCodel = fqCodel(spec.FQCodel)
+	case network.QdiscKindCAKE:
+		h.Qdisc.Cake = &tc.Cake{}
+
+		if spec.CAKE.Bandwidth != 0 {
+			h.Qdisc.Cake.BaseRate = pointer.To(spec.CAKE.Bandwidth / 8)
+		}
+
+		if spec.CAKE.RTT != 0 {
+			h.Qdisc.Cake.Rtt = pointer.To(uint32(spec.CAKE.RTT.Microseconds()))
+		}
+	case network.QdiscKindTBF:
+		h.Qdisc.Tbf = tbf(spec.TBF)
+	case network.QdiscKindHTB:
+		h.Qdisc.Htb = &tc.Htb{
+			Init: &tc.HtbGlob{
+				Version:      3,
+				Rate2Quantum: 10,
+				Defcls:       uint32(spec.HTB.DefaultClass),
+			},
+		}
+
+		parentHandle := core.BuildHandle(1, htbParentClassID)
+
+		h.Classes = append(h.Classes, htbClass(ifindex, RootHandle, parentHandle, spec.HTB.Rate, spec.HTB.Rate, 0))
+
+		for _, class := range spec.HTB.Classes {
+			classHandle := core.BuildHandle(1, uint32(class.ID))
+
+			ceil := class.Ceil
+			if ceil == 0 {
+				ceil = spec.HTB.Rate
+			}
+
+			h.Classes = append(h.Classes, htbClass(ifindex, parentHandle, classHandle, class.Rate, ceil, class.Priority))
+
+			// each class gets a fq_codel leaf (with the kernel-allocated handle) instead of the default pfifo
+			h.Leaves = append(h.Leaves, tc.Object{
+				Msg: tc.Msg{
+					Family:  unix.AF_UNSPEC,
+					Ifindex: ifindex,
+					Parent:  classHandle,
+				},
+				Attribute: tc.Attribute{
+					Kind:    network.QdiscKindFQCodel,
+					FqCodel: &tc.FqCodel{ECN: pointer.To[uint32](1)},
+				},
+			})
+
+			if class.FirewallMark == 0 {
+				continue
+			}
+
+			h.Filters = append(h.Filters, tc.Object{
+				Msg: tc.Msg{
+					Family:  unix.AF_UNSPEC,
+					Ifindex: ifindex,
+					Handle:  class.FirewallMark,
+					Parent:  RootHandle,
+					Info:    core.BuildHandle(htbFilterPriority, ethPAll),
+				},
+				Attribute: tc.Attribute{
+					Kind: "fw",
+					Fw: &tc.Fw{
+						ClassID: pointer.To(classHandle),
+					},
+				},
+			})
+		}
+	case network.QdiscKindNetem:
+		h.Qdisc.Netem = netem(spec.Netem)
+	default:
+		return Hierarchy{}, fmt.Errorf("unsupported qdisc kind %q", spec.Kind)
+	}
+
+	return h, nil
+}
+
+func fqCodel(spec network.FQCodelQdiscSpec) *tc.FqCodel {
+	fqCodel := &tc.FqCodel{}
+
+	if spec.Limit != 0 {
+		fqCodel.Limit = &spec.Limit
+	}
+
+	if spec.Flows != 0 {
+		fqCodel.Flows = &spec.Flows
+	}
+
+	if spec.Target != 0 {
+		fqCodel.Target = pointer.To(uint32(spec.Target.Microseconds()))
+	}
+
+	if spec.Interval != 0 {
+		fqCodel.Interval = pointer.To(uint32(spec.Interval.Microseconds()))
+	}
+
+	if spec.ECN {
+		fqCodel.ECN = pointer.To[uint32](1)
+	} else {
+		fqCodel.ECN = pointer.To[uint32](0)
+	}
+
+	return fqCodel
+}
+
+func tbf(spec network.TBFQdiscSpec) *tc.Tbf {
+	rate := spec.Rate / 8
+
+	burst := spec.Burst
+	if burst == 0 {
+		// the amount of data sent at the rate in 10ms
+		burst = max(clamp32(rate/100), defaultTBFMinBurst)
+	}
+
+	latency := spec.Latency
+	if latency == 0 {
+		latency = defaultTBFLatency
+	}
+
+	return &tc.Tbf{
+		Parms: &tc.TbfQopt{
+			Rate: tc.RateSpec{
+				Rate:      clamp32(rate),
+				Linklayer: linkLayerEthernet,
+			},
+			Limit:  clamp32(uint64(float64(rate)*latency.Seconds()) + uint64(burst)),
+			Buffer: core.XmitTime(rate, burst),
+		},
+		Burst: &burst,
+	}
+}
+
+func htbClass(ifindex, parent, handle uint32, rate, ceil uint64, priority uint32) tc.Object {
+	rate, ceil = rate/8, ceil/8
+
+	burst := clamp32(rate/htbHZ + htbMTU)
+	cburst := clamp32(ceil/htbHZ + htbMTU)
+
+	htb := &tc.Htb{
+		Parms: &tc.HtbOpt{
+			Rate: tc.RateSpec{
+				Rate:      clamp32(rate),
+				Linklayer: linkLayerEthernet,
+			},
+			Ceil: tc.RateSpec{
+				Rate:      clamp32(ceil),
+				Linklayer: linkLayerEthernet,
+			},
+			Buffer:  core.XmitTime(rate, burst),
+			Cbuffer: core.XmitTime(ceil, cburst),
+			Prio:    priority,
+		},
+	}
+
+	// rates which don't fit into 32 bits are passed as separate attributes
+	if rate > math.MaxUint32 {
+		htb.Rate64 = &rate
+	}
+
+	if ceil > math.MaxUint32 {
+		htb.Ceil64 = &ceil
+	}
+
+	return tc.Object{
+		Msg: tc.Msg{
+			Family:  unix.AF_UNSPEC,
+			Ifindex: ifindex,
+			Handle:  handle,
+			Parent:  parent,
+		},
+		Attribute: tc.Attribute{
+			Kind: network.QdiscKindHTB,
+			Htb:  htb,
+		},
+	}
+}
+
+func netem(spec network.NetemQdiscSpec) *tc.Netem {
+	netem := &tc.Netem{
+		Qopt: tc.NetemQopt{
+			Limit:     spec.Limit,
+			Loss:      probability(spec.Loss),
+			Duplicate: probability(spec.Duplicate),
+		},
+		Latency64: pointer.To(int64(spec.Delay)),
+		Jitter64:  pointer.To(int64(spec.Jitter)),
+	}
+
+	if netem.Qopt.Limit == 0 {
+		netem.Qopt.Limit = defaultNetemLimit
+	}
+
+	if spec.Corrupt != 0 {
+		netem.Corrupt = &tc.NetemCorrupt{Probability: probability(spec.Corrupt)}
+	}
+
+	if spec.Reorder != 0 {
+		netem.Reorder = &tc.NetemReorder{Probability: probability(spec.Reorder)}
+
+		// the packets are sent immediately with the reorder probability, the rest is delayed
+		netem.Qopt.Gap = 1
+	}
+
+	if spec.Rate != 0 {
+		rate := spec.Rate / 8
+
+		netem.Rate = &tc.NetemRate{Rate: clamp32(rate)}
+
+		if rate > math.MaxUint32 {
+			netem.Rate64 = &rate
+		}
+	}
+
+	return netem
+}
+
+// Root returns the current root qdisc of the link.
+//
+// The default qdisc of the link has a zero handle.
+func Root(tcnl *tc.Tc, ifindex uint32) (*tc.Object, error) {
+	qdiscs, err := tcnl.Qdisc().Get()
+	if err != nil {
+		return nil, fmt.Errorf("error listing qdiscs: %w", err)
+	}
+
+	for i := range qdiscs {
+		if qdiscs[i].Ifindex == ifindex && qdiscs[i].Parent == tc.HandleRoot {
+			return &qdiscs[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Apply replaces the root qdisc of the link with the hierarchy.
+func Apply(tcnl *tc.Tc, h Hierarchy) error {
+	// the classes of the existing root qdisc can't be reused, so start from scratch
+	if err := DeleteRoot(tcnl, h.Qdisc.Ifindex); err != nil {
+		return err
+	}
+
+	if err := tcnl.Qdisc().Replace(&h.Qdisc); err != nil {
+		return fmt.Errorf("error adding %s qdisc: %w", h.Qdisc.Kind, err)
+	}
+
+	for _, class := range h.Classes {
+		if err := tcnl.Class().Add(&class); err != nil {
+			return fmt.Errorf("error adding %s class %s: %w", class.Kind, FormatHandle(class.Handle), err)
+		}
+	}
+
+	for _, leaf := range h.Leaves {
+		if err := tcnl.Qdisc().Add(&leaf); err != nil {
+			return fmt.Errorf("error adding %s qdisc to class %s: %w", leaf.Kind, FormatHandle(leaf.Parent), err)
+		}
+	}
+
+	for _, filter := range h.Filters {
+		if err := tcnl.Filter().Add(&filter); err != nil {
+			return fmt.Errorf("error adding %s filter 0x%x: %w", filter.Kind, filter.Handle, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteRoot deletes the root qdisc of the link managed by Talos, reverting the link to the default qdisc.
+//
+// The root qdiscs not managed by Talos are kept as is.
+func DeleteRoot(tcnl *tc.Tc, ifindex uint32) error {
+	root, err := Root(tcnl, ifindex)
+	if err != nil {
+		return err
+	}
+
+	if root == nil || root.Handle != RootHandle {
+		return nil
+	}
+
+	// go-tc requires the kind-specific attributes to be set, but the kernel ignores them on delete
+	obj := tc.Object{
+		Msg: tc.Msg{
+			Family:  unix.AF_UNSPEC,
+			Ifindex: ifindex,
+			Handle:  RootHandle,
+			Parent:  tc.HandleRoot,
+		},
+		Attribute: tc.Attribute{
+			Kind:    root.Kind,
+			Fq:      &tc.Fq{},
+			FqCodel: &tc.FqCodel{},
+			Cake:    &tc.Cake{},
+			Tbf:     &tc.Tbf{},
+			Htb:     &tc.Htb{},
+			Netem:   &tc.Netem{},
+		},
+	}
+
+	if err = tcnl.Qdisc().Delete(&obj); err != nil && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("error deleting %s qdisc: %w", root.Kind, err)
+	}
+
+	return nil
+}
+
+// FormatHandle formats the handle the same way as tc does, e.g. "1:" or "1:a".
+func FormatHandle(handle uint32) string {
+	switch handle {
+	case tc.HandleRoot:
+		return "root"
+	case 0:
+		return "0:"
+	}
+
+	major, minor := core.SplitHandle(handle)
+
+	if minor == 0 {
+		return fmt.Sprintf("%x:", major)
+	}
+
+	return fmt.Sprintf("%x:%x", major, minor)
+}
+
+// Rate returns the rate and the ceil (in bits per second) of the HTB class.
+func Rate(htb *tc.Htb) (rate, ceil uint64) {
+	if htb == nil || htb.Parms == nil {
+		return 0, 0
+	}
+
+	rate, ceil = uint64(htb.Parms.Rate.Rate), uint64(htb.Parms.Ceil.Rate)
+
+	if htb.Rate64 != nil {
+		rate = *htb.Rate64
+	}
+
+	if htb.Ceil64 != nil {
+		ceil = *htb.Ceil64
+	}
+
+	return rate * 8, ceil * 8
+}
+
+func probability(percent float64) uint32 {
+	return uint32(percent / 100 * math.MaxUint32)
+}
+
+func clamp32(v uint64) uint32 {
+	return uint32(min(v, math.MaxUint32))
+}
@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qdisc_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/florianl/go-tc"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/qdisc"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestBuildSimple(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		spec network.QdiscSpecSpec
+
+		check func(*testing.T, tc.Attribute)
+	}{
+		{
+			name: "fq",
+			spec: network.QdiscSpecSpec{
+				Kind: network.QdiscKindFQ,
+				FQ: network.FQQdiscSpec{
+					MaxRate: 1_000_000_000,
+				},
+			},
+			check: func(t *testing.T, attr tc.Attribute) {
+				assert.Nil(t, attr.Fq.FlowPLimit)
+				assert.Equal(t, pointer.To[uint32](125_000_000), attr.Fq.FlowMaxRate)
+			},
+		},
+		{
+			name: "fq_codel",
+			spec: network.QdiscSpecSpec{
+				Kind: network.QdiscKindFQCodel,
+				FQCodel: network.FQCodelQdiscSpec{
+					Target:   5 * time.Millisecond,
+					Interval: 100 * time.Millisecond,
+				},
+			},
+			check: func(t *testing.T, attr tc.Attribute) {
+				assert.Equal(t, pointer.To[uint32](5000), attr.FqCodel.Target)
+				assert.Equal(t, pointer.To[uint32](100_000), attr.FqCodel.Interval)
+				assert.Equal(t, pointer.To[uint32](0), attr.FqCodel.ECN)
+				assert.Nil(t, attr.FqCodel.Limit)
+			},
+		},
+		{
+			name: "cake",
+			spec: network.QdiscSpecSpec{
+				Kind: network.QdiscKindCAKE,
+				CAKE: network.CAKEQdiscSpec{
+					Bandwidth: 500_000_000,
+					RTT:       20 * time.Millisecond,
+				},
+			},
+			check: func(t *testing.T, attr tc.Attribute) {
+				assert.Equal(t, pointer.To[uint64](62_500_000), attr.Cake.BaseRate)
+				assert.Equal(t, pointer.To[uint32](20_000), attr.Cake.Rtt)
+			},
+		},
+		{
+			name: "tbf",
+			spec: network.QdiscSpecSpec{
+				Kind: network.QdiscKindTBF,
+				TBF: network.TBFQdiscSpec{
+					Rate: 100_000_000,
+				},
+			},
+			check: func(t *testing.T, attr tc.Attribute) {
+				assert.EqualValues(t, 12_500_000, attr.Tbf.Parms.Rate.Rate)
+				// 10ms of data at the rate
+				assert.Equal(t, pointer.To[uint32](125_000), attr.Tbf.Burst)
+				// 50ms of data at the rate + burst
+				assert.EqualValues(t, 625_000+125_000, attr.Tbf.Parms.Limit)
+			},
+		},
+		{
+			name: "netem",
+			spec: network.QdiscSpecSpec{
+				Kind: network.QdiscKindNetem,
+				Netem: network.NetemQdiscSpec{
+					Delay:   100 * time.Millisecond,
+					Jitter:  10 * time.Millisecond,
+					Loss:    50,
+					Reorder: 100,
+					Rate:    80_000_000_000,
+				},
+			},
+			check: func(t *testing.T, attr tc.Attribute) {
+				assert.Equal(t, pointer.To(int64(100*time.Millisecond)), attr.Netem.Latency64)
+				assert.Equal(t, pointer.To(int64(10*time.Millisecond)), attr.Netem.Jitter64)
+				assert.EqualValues(t, 1000, attr.Netem.Qopt.Limit)
+				assert.EqualValues(t, math.MaxUint32/2, attr.Netem.Qopt.Loss)
+				assert.EqualValues(t, 1, attr.Netem.Qopt.Gap)
+				assert.EqualValues(t, uint32(math.MaxUint32), attr.Netem.Reorder.Probability)
+				assert.Nil(t, attr.Netem.Corrupt)
+				assert.EqualValues(t, uint32(math.MaxUint32), attr.Netem.Rate.Rate)
+				assert.Equal(t, pointer.To[uint64](10_000_000_000), attr.Netem.Rate64)
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, err := qdisc.Build(3, &test.spec)
+			require.NoError(t, err)
+
+			assert.EqualValues(t, 3, h.Qdisc.Ifindex)
+			assert.Equal(t, qdisc.RootHandle, h.Qdisc.Handle)
+			assert.Equal(t, tc.HandleRoot, h.Qdisc.Parent)
+			assert.Equal(t, test.spec.Kind, h.Qdisc.Kind)
+
+			assert.Empty(t, h.Classes)
+			assert.Empty(t, h.Leaves)
+			assert.Empty(t, h.Filters)
+
+			test.check(t, h.Qdisc.Attribute)
+		})
+	}
+}
+
+func TestBuildHTB(t *testing.T) {
+	t.Parallel()
+
+	h, err := qdisc.Build(3, &network.QdiscSpecSpec{
+		Kind: network.QdiscKindHTB,
+		HTB: network.HTBQdiscSpec{
+			Rate:         80_000_000_000,
+			DefaultClass: 20,
+			Classes: []network.HTBClassSpec{
+				{
+					ID:           10,
+					Rate:         200_000_000,
+					Ceil:         1_000_000_000,
+					Priority:     1,
+					FirewallMark: 0x10,
+				},
+				{
+					ID:   20,
+					Rate: 9_000_000_000,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, network.QdiscKindHTB, h.Qdisc.Kind)
+	assert.EqualValues(t, 20, h.Qdisc.Htb.Init.Defcls)
+
+	require.Len(t, h.Classes, 3)
+
+	handles := make([]string, 0, len(h.Classes))
+	parents := make([]string, 0, len(h.Classes))
+
+	for _, class := range h.Classes {
+		handles = append(handles, qdisc.FormatHandle(class.Handle))
+		parents = append(parents, qdisc.FormatHandle(class.Parent))
+	}
+
+	assert.Equal(t, []string{"1:1", "1:a", "1:14"}, handles)
+	assert.Equal(t, []string{"1:", "1:1", "1:1"}, parents)
+
+	// total rate doesn't fit into 32 bits
+	rate, ceil := qdisc.Rate(h.Classes[0].Htb)
+	assert.EqualValues(t, 80_000_000_000, rate)
+	assert.EqualValues(t, 80_000_000_000, ceil)
+	assert.EqualValues(t, uint32(math.MaxUint32), h.Classes[0].Htb.Parms.Rate.Rate)
+
+	rate, ceil = qdisc.Rate(h.Classes[1].Htb)
+	assert.EqualValues(t, 200_000_000, rate)
+	assert.EqualValues(t, 1_000_000_000, ceil)
+	assert.EqualValues(t, 1, h.Classes[1].Htb.Parms.Prio)
+	assert.Nil(t, h.Classes[1].Htb.Rate64)
+
+	// ceil defaults to the total rate
+	rate, ceil = qdisc.Rate(h.Classes[2].Htb)
+	assert.EqualValues(t, 9_000_000_000, rate)
+	assert.EqualValues(t, 80_000_000_000, ceil)
+
+	require.Len(t, h.Leaves, 2)
+
+	for i, leaf := range h.Leaves {
+		assert.Equal(t, network.QdiscKindFQCodel, leaf.Kind)
+		assert.Equal(t, h.Classes[i+1].Handle, leaf.Parent)
+		assert.Zero(t, leaf.Handle)
+	}
+
+	require.Len(t, h.Filters, 1)
+
+	assert.Equal(t, "fw", h.Filters[0].Kind)
+	assert.EqualValues(t, 0x10, h.Filters[0].Handle)
+	assert.Equal(t, qdisc.RootHandle, h.Filters[0].Parent)
+	assert.EqualValues(t, 0x0001_0300, h.Filters[0].Info)
+	assert.Equal(t, pointer.To(h.Classes[1].Handle), h.Filters[0].Fw.ClassID)
+}
+
+func TestBuildUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := qdisc.Build(3, &network.QdiscSpecSpec{Kind: "sfq"})
+	assert.EqualError(t, err, `unsupported qdisc kind "sfq"`)
+}
+
+func TestFormatHandle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "root", qdisc.FormatHandle(tc.HandleRoot))
+	assert.Equal(t, "0:", qdisc.FormatHandle(0))
+	assert.Equal(t, "1:", qdisc.FormatHandle(qdisc.RootHandle))
+	assert.Equal(t, "1:ffff", qdisc.FormatHandle(0x1ffff))
+}
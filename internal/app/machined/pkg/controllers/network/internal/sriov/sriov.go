@@ -0,0 +1,339 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sriov implements SR-IOV virtual function management via sysfs and rtnetlink.
+package sriov
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// rtextFilterVF requests the virtual function information in RTM_GETLINK (see linux/rtnetlink.h).
+const rtextFilterVF = 1
+
+// PhysicalFunction provides access to the SR-IOV sysfs attributes of the physical function link.
+type PhysicalFunction struct {
+	path string
+}
+
+// NewPhysicalFunction returns the physical function for the link name.
+//
+// The sysfsPath is usually /sys.
+func NewPhysicalFunction(sysfsPath, linkName string) *PhysicalFunction {
+	return &PhysicalFunction{
+		path: filepath.Join(sysfsPath, "class", "net", linkName, "device"),
+	}
+}
+
+// TotalVFs returns the maximum number of virtual functions supported by the physical function.
+//
+// If the device doesn't support SR-IOV, the error matches os.ErrNotExist.
+func (pf *PhysicalFunction) TotalVFs() (uint32, error) {
+	return pf.readUint32("sriov_totalvfs")
+}
+
+// NumVFs returns the number of enabled virtual functions.
+func (pf *PhysicalFunction) NumVFs() (uint32, error) {
+	return pf.readUint32("sriov_numvfs")
+}
+
+// SetNumVFs changes the number of enabled virtual functions.
+//
+// The kernel doesn't allow to change the number of virtual functions directly,
+// so the virtual functions are disabled first.
+func (pf *PhysicalFunction) SetNumVFs(numVFs uint32) error {
+	current, err := pf.NumVFs()
+	if err != nil {
+		return err
+	}
+
+	if current == numVFs {
+		return nil
+	}
+
+	if current != 0 {
+		if err = pf.writeUint32("sriov_numvfs", 0); err != nil {
+			return err
+		}
+	}
+
+	if numVFs == 0 {
+		return nil
+	}
+
+	return pf.writeUint32("sriov_numvfs", numVFs)
+}
+
+// VirtualFunction describes the virtual function device.
+type VirtualFunction struct {
+	// PCIAddress of the virtual function.
+	PCIAddress string
+	// LinkName of the virtual function, empty if the driver doesn't create a link (e.g. vfio-pci).
+	LinkName string
+	// Driver bound to the virtual function, empty if no driver is bound.
+	Driver string
+}
+
+// VirtualFunction returns the device information for the virtual function.
+func (pf *PhysicalFunction) VirtualFunction(index uint32) (VirtualFunction, error) {
+	path := filepath.Join(pf.path, fmt.Sprintf("virtfn%d", index))
+
+	target, err := os.Readlink(path)
+	if err != nil {
+		return VirtualFunction{}, err
+	}
+
+	vf := VirtualFunction{
+		PCIAddress: filepath.Base(target),
+	}
+
+	if driver, err := os.Readlink(filepath.Join(path, "driver")); err == nil {
+		vf.Driver = filepath.Base(driver)
+	}
+
+	if links, err := os.ReadDir(filepath.Join(path, "net")); err == nil && len(links) > 0 {
+		vf.LinkName = links[0].Name()
+	}
+
+	return vf, nil
+}
+
+func (pf *PhysicalFunction) readUint32(name string) (uint32, error) {
+	contents, err := os.ReadFile(filepath.Join(pf.path, name))
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.ParseUint(string(bytes.TrimSpace(contents)), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	return uint32(v), nil
+}
+
+func (pf *PhysicalFunction) writeUint32(name string, v uint32) error {
+	return os.WriteFile(filepath.Join(pf.path, name), []byte(strconv.FormatUint(uint64(v), 10)), 0o644)
+}
+
+// VFInfo describes the settings of the virtual function on the physical function side.
+type VFInfo struct {
+	Index        uint32
+	HardwareAddr net.HardwareAddr
+	VLAN         uint16
+	SpoofCheck   bool
+	Trust        bool
+}
+
+// VFSettings describes the virtual function settings to change, nil values are not changed.
+type VFSettings struct {
+	Index        uint32
+	HardwareAddr net.HardwareAddr
+	VLAN         *uint16
+	SpoofCheck   *bool
+	Trust        *bool
+}
+
+// Client is a rtnetlink client for the virtual function settings.
+type Client struct {
+	conn *netlink.Conn
+}
+
+// New dials the rtnetlink.
+func New() (*Client, error) {
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, &netlink.Config{Strict: true})
+	if err != nil {
+		return nil, fmt.Errorf("error dialing rtnetlink: %w", err)
+	}
+
+	return &Client{
+		conn: conn,
+	}, nil
+}
+
+// Close the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// VFs returns the settings of the virtual functions of the physical function link.
+func (c *Client) VFs(linkIndex uint32) ([]VFInfo, error) {
+	ae := netlink.NewAttributeEncoder()
+	ae.Uint32(unix.IFLA_EXT_MASK, rtextFilterVF)
+
+	attrs, err := ae.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_GETLINK,
+			Flags: netlink.Request,
+		},
+		Data: append(ifInfoMsg(linkIndex), attrs...),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("unexpected number of link messages: %d", len(msgs))
+	}
+
+	if len(msgs[0].Data) < unix.SizeofIfInfomsg {
+		return nil, errors.New("link message is too short")
+	}
+
+	ad, err := netlink.NewAttributeDecoder(msgs[0].Data[unix.SizeofIfInfomsg:])
+	if err != nil {
+		return nil, err
+	}
+
+	var vfs []VFInfo
+
+	for ad.Next() {
+		if ad.Type() == unix.IFLA_VFINFO_LIST {
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				vfs, err = ParseVFInfoList(nad)
+
+				return err
+			})
+		}
+	}
+
+	return vfs, ad.Err()
+}
+
+// SetVF changes the settings of the virtual function of the physical function link.
+func (c *Client) SetVF(linkIndex uint32, settings VFSettings) error {
+	ae := netlink.NewAttributeEncoder()
+	EncodeVFSettings(ae, settings)
+
+	attrs, err := ae.Encode()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_SETLINK,
+			Flags: netlink.Request | netlink.Acknowledge,
+		},
+		Data: append(ifInfoMsg(linkIndex), attrs...),
+	})
+
+	return err
+}
+
+func ifInfoMsg(linkIndex uint32) []byte {
+	b := make([]byte, unix.SizeofIfInfomsg)
+	b[0] = unix.AF_UNSPEC
+	binary.NativeEndian.PutUint32(b[4:8], linkIndex)
+
+	return b
+}
+
+// ParseVFInfoList parses the contents of IFLA_VFINFO_LIST attribute.
+func ParseVFInfoList(ad *netlink.AttributeDecoder) ([]VFInfo, error) {
+	var vfs []VFInfo
+
+	for ad.Next() {
+		if ad.Type() != unix.IFLA_VF_INFO {
+			continue
+		}
+
+		var vf VFInfo
+
+		ad.Nested(func(nad *netlink.AttributeDecoder) error {
+			for nad.Next() {
+				b := nad.Bytes()
+
+				if len(b) < 8 {
+					continue
+				}
+
+				vf.Index = binary.NativeEndian.Uint32(b[0:4])
+
+				switch nad.Type() {
+				case unix.IFLA_VF_MAC:
+					// the kernel reports a 32-byte buffer, only Ethernet addresses are supported
+					vf.HardwareAddr = net.HardwareAddr(bytes.Clone(b[4:10]))
+				case unix.IFLA_VF_VLAN:
+					vf.VLAN = uint16(binary.NativeEndian.Uint32(b[4:8]))
+				case unix.IFLA_VF_SPOOFCHK:
+					// -1 is reported if the setting is not supported
+					vf.SpoofCheck = binary.NativeEndian.Uint32(b[4:8]) == 1
+				case unix.IFLA_VF_TRUST:
+					vf.Trust = binary.NativeEndian.Uint32(b[4:8]) == 1
+				}
+			}
+
+			return nil
+		})
+
+		vfs = append(vfs, vf)
+	}
+
+	return vfs, ad.Err()
+}
+
+// EncodeVFSettings encodes the virtual function settings as IFLA_VFINFO_LIST attribute.
+func EncodeVFSettings(ae *netlink.AttributeEncoder, settings VFSettings) {
+	ae.Nested(unix.IFLA_VFINFO_LIST, func(nae *netlink.AttributeEncoder) error {
+		nae.Nested(unix.IFLA_VF_INFO, func(vae *netlink.AttributeEncoder) error {
+			if settings.HardwareAddr != nil {
+				// struct ifla_vf_mac
+				b := make([]byte, 4+32)
+				binary.NativeEndian.PutUint32(b[0:4], settings.Index)
+				copy(b[4:], settings.HardwareAddr)
+
+				vae.Bytes(unix.IFLA_VF_MAC, b)
+			}
+
+			if settings.VLAN != nil {
+				// struct ifla_vf_vlan, QoS is always zero
+				b := make([]byte, 12)
+				binary.NativeEndian.PutUint32(b[0:4], settings.Index)
+				binary.NativeEndian.PutUint32(b[4:8], uint32(*settings.VLAN))
+
+				vae.Bytes(unix.IFLA_VF_VLAN, b)
+			}
+
+			if settings.SpoofCheck != nil {
+				vae.Bytes(unix.IFLA_VF_SPOOFCHK, vfSetting(settings.Index, *settings.SpoofCheck))
+			}
+
+			if settings.Trust != nil {
+				vae.Bytes(unix.IFLA_VF_TRUST, vfSetting(settings.Index, *settings.Trust))
+			}
+
+			return nil
+		})
+
+		return nil
+	})
+}
+
+// vfSetting encodes struct ifla_vf_spoofchk/ifla_vf_trust.
+func vfSetting(index uint32, enabled bool) []byte {
+	b := make([]byte, 8)
+	binary.NativeEndian.PutUint32(b[0:4], index)
+
+	if enabled {
+		binary.NativeEndian.PutUint32(b[4:8], 1)
+	}
+
+	return b
+}
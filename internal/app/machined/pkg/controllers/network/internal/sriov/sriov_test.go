@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sriov_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mdlayher/netlink"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+)
+
+func TestPhysicalFunction(t *testing.T) {
+	t.Parallel()
+
+	sysfs := t.TempDir()
+
+	pciDevices := filepath.Join(sysfs, "bus", "pci", "devices")
+	pfPath := filepath.Join(pciDevices, "0000:3b:00.0")
+	vfPath := filepath.Join(pciDevices, "0000:3b:02.0")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(sysfs, "class", "net", "enp59s0f0"), 0o755))
+	require.NoError(t, os.MkdirAll(pfPath, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(vfPath, "net", "enp59s0f0v0"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysfs, "bus", "pci", "drivers", "iavf"), 0o755))
+	require.NoError(t, os.Symlink(pfPath, filepath.Join(sysfs, "class", "net", "enp59s0f0", "device")))
+	require.NoError(t, os.Symlink(vfPath, filepath.Join(pfPath, "virtfn0")))
+	require.NoError(t, os.Symlink(filepath.Join(sysfs, "bus", "pci", "drivers", "iavf"), filepath.Join(vfPath, "driver")))
+	require.NoError(t, os.WriteFile(filepath.Join(pfPath, "sriov_totalvfs"), []byte("64\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(pfPath, "sriov_numvfs"), []byte("0\n"), 0o644))
+
+	pf := sriov.NewPhysicalFunction(sysfs, "enp59s0f0")
+
+	totalVFs, err := pf.TotalVFs()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(64), totalVFs)
+
+	require.NoError(t, pf.SetNumVFs(8))
+
+	numVFs, err := pf.NumVFs()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), numVFs)
+
+	vf, err := pf.VirtualFunction(0)
+	require.NoError(t, err)
+	assert.Equal(t, sriov.VirtualFunction{
+		PCIAddress: "0000:3b:02.0",
+		LinkName:   "enp59s0f0v0",
+		Driver:     "iavf",
+	}, vf)
+
+	_, err = pf.VirtualFunction(1)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = sriov.NewPhysicalFunction(sysfs, "eth0").TotalVFs()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestEncodeParseVFs(t *testing.T) {
+	t.Parallel()
+
+	ae := netlink.NewAttributeEncoder()
+
+	sriov.EncodeVFSettings(ae, sriov.VFSettings{
+		Index:        3,
+		HardwareAddr: net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+		VLAN:         pointer.To[uint16](100),
+		SpoofCheck:   pointer.To(false),
+		Trust:        pointer.To(true),
+	})
+
+	b, err := ae.Encode()
+	require.NoError(t, err)
+
+	ad, err := netlink.NewAttributeDecoder(b)
+	require.NoError(t, err)
+
+	require.True(t, ad.Next())
+	assert.Equal(t, uint16(unix.IFLA_VFINFO_LIST), ad.Type())
+
+	var vfs []sriov.VFInfo
+
+	ad.Nested(func(nad *netlink.AttributeDecoder) error {
+		vfs, err = sriov.ParseVFInfoList(nad)
+
+		return err
+	})
+	require.NoError(t, ad.Err())
+
+	assert.Equal(t, []sriov.VFInfo{
+		{
+			Index:        3,
+			HardwareAddr: net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+			VLAN:         100,
+			SpoofCheck:   false,
+			Trust:        true,
+		},
+	}, vfs)
+}
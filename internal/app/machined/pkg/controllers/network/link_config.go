@@ -8,22 +8,30 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/pair/ordered"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/cel"
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
 // LinkConfigController manages network.LinkSpec based on machine configuration, kernel cmdline.
+//
+// Links are configured via the legacy v1alpha1 machine configuration (via network.DeviceConfigSpec),
+// and via the network link configuration documents.
 type LinkConfigController struct {
 	Cmdline *procfs.Cmdline
 }
@@ -36,6 +44,12 @@ func (ctrl *LinkConfigController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *LinkConfigController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.DeviceConfigSpecType,
@@ -131,10 +145,17 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 			}
 		}
 
+		// links configured in the legacy machine configuration, document links with the same name are skipped
+		legacyLinks := map[string]struct{}{}
+
 		// parse machine configuration for link specs
 		if len(devices) > 0 {
 			links := ctrl.processDevicesConfiguration(logger, devices)
 
+			for _, link := range links {
+				legacyLinks[link.Name] = struct{}{}
+			}
+
 			var ids []string
 
 			ids, err = ctrl.apply(ctx, r, links)
@@ -147,9 +168,55 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 			}
 		}
 
+		var cfgProvider talosconfig.Config
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+		} else {
+			cfgProvider = cfg.Config()
+		}
+
+		linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing link statuses: %w", err)
+		}
+
+		resolver := newLinkResolver(logger, cfgProvider, linkStatuses)
+
+		// parse network link configuration documents
+		documentLinks := slices.DeleteFunc(ctrl.processLinkConfigs(logger, cfgProvider, resolver), func(link network.LinkSpecSpec) bool {
+			if _, conflict := legacyLinks[link.Name]; conflict {
+				logger.Error("link is configured both in the machine configuration interfaces and in the link configuration documents, ignoring the document", zap.String("link", link.Name))
+
+				return true
+			}
+
+			return false
+		})
+
+		if len(documentLinks) > 0 {
+			var ids []string
+
+			ids, err = ctrl.apply(ctx, r, documentLinks)
+			if err != nil {
+				return fmt.Errorf("error applying link configuration documents: %w", err)
+			}
+
+			for _, id := range ids {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
 		// bring up any physical link not mentioned explicitly in the machine configuration
 		configuredLinks := map[string]struct{}{}
 
+		for _, link := range documentLinks {
+			configuredLinks[link.Name] = struct{}{}
+		}
+
 		for _, linkName := range cmdlineIgnored {
 			configuredLinks[linkName] = struct{}{}
 		}
@@ -178,14 +245,7 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 			}
 		}
 
-		list, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
-		if err != nil {
-			return fmt.Errorf("error listing link statuses: %w", err)
-		}
-
-		for _, item := range list.Items {
-			linkStatus := item.(*network.LinkStatus) //nolint:errcheck,forcetypeassert
-
+		for linkStatus := range linkStatuses.All() {
 			if _, configured := configuredLinks[linkStatus.Metadata().ID()]; !configured {
 				if linkStatus.TypedSpec().Physical() {
 					var ids []string
@@ -209,7 +269,7 @@ func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime,
 		}
 
 		// list links for cleanup
-		list, err = r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.LinkSpecType, "", resource.VersionUndefined))
+		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.LinkSpecType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing resources: %w", err)
 		}
@@ -410,6 +470,257 @@ func (ctrl *LinkConfigController) processDevicesConfiguration(logger *zap.Logger
 	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
 }
 
+// processLinkConfigs converts network link configuration documents into link specs.
+//
+//nolint:gocyclo
+func (ctrl *LinkConfigController) processLinkConfigs(logger *zap.Logger, cfg talosconfig.Config, resolver *linkResolver) []network.LinkSpecSpec {
+	if cfg == nil {
+		return nil
+	}
+
+	linkMap := map[string]*network.LinkSpecSpec{}
+
+	linkSpec := func(name string) *network.LinkSpecSpec {
+		if _, exists := linkMap[name]; !exists {
+			linkMap[name] = &network.LinkSpecSpec{
+				Name:        name,
+				Up:          true,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+		}
+
+		return linkMap[name]
+	}
+
+	setCommon := func(link *network.LinkSpecSpec, linkConfig talosconfig.NetworkCommonLinkConfig) {
+		link.Up = linkConfig.Up()
+
+		if mtu, ok := linkConfig.MTU().Get(); ok {
+			link.MTU = mtu
+		}
+	}
+
+	// links which are members of bonds and bridges
+	memberLinks := func(links []string, selector optional.Optional[cel.Expression]) []string {
+		members := resolver.ResolveAll(links)
+
+		if expr, ok := selector.Get(); ok {
+			for _, link := range resolver.Select(expr) {
+				if !slices.Contains(members, link) {
+					members = append(members, link)
+				}
+			}
+		}
+
+		return members
+	}
+
+	for _, linkConfig := range cfg.NetworkLinkConfigs() {
+		linkName, ok := resolver.Resolve(linkConfig.Name())
+		if !ok {
+			continue
+		}
+
+		link := linkSpec(linkName)
+
+		setCommon(link, linkConfig)
+
+		if ethtool, ok := linkConfig.Ethtool().Get(); ok {
+			ethtoolLink(link, ethtool)
+		}
+	}
+
+	for _, bondConfig := range cfg.NetworkBondConfigs() {
+		link := linkSpec(bondConfig.Name())
+
+		bondLink(link, bondConfig)
+		setCommon(link, bondConfig)
+
+		for idx, slaveName := range memberLinks(bondConfig.Links(), bondConfig.LinkSelector()) {
+			if slave, exists := linkMap[slaveName]; exists && slave.BondSlave.MasterName != "" && slave.BondSlave.MasterName != bondConfig.Name() {
+				logger.Sugar().Warnf("link %q is included in both bonds %q and %q", slaveName, slave.BondSlave.MasterName, bondConfig.Name())
+			}
+
+			SetBondSlave(linkSpec(slaveName), ordered.MakePair(bondConfig.Name(), idx))
+		}
+	}
+
+	for _, bridgeConfig := range cfg.NetworkBridgeConfigs() {
+		link := linkSpec(bridgeConfig.Name())
+
+		bridgeLink(link, bridgeConfig)
+		setCommon(link, bridgeConfig)
+
+		for _, slaveName := range memberLinks(bridgeConfig.Links(), bridgeConfig.LinkSelector()) {
+			slave := linkSpec(slaveName)
+
+			if slave.BondSlave.MasterName != "" {
+				logger.Sugar().Warnf("link %q is included in both bond %q and bridge %q", slaveName, slave.BondSlave.MasterName, bridgeConfig.Name())
+			}
+
+			SetBridgeSlave(slave, bridgeConfig.Name())
+		}
+	}
+
+	for _, vlanConfig := range cfg.NetworkVLANConfigs() {
+		parentName, ok := resolver.Resolve(vlanConfig.ParentLink())
+		if !ok {
+			logger.Warn("skipping VLAN with unresolved parent link", zap.String("link", vlanConfig.Name()), zap.String("parent", vlanConfig.ParentLink()))
+
+			continue
+		}
+
+		link := linkSpec(vlanConfig.Name())
+		link.Logical = true
+		link.Kind = network.LinkKindVLAN
+		link.Type = nethelpers.LinkEther
+		link.ParentName = parentName
+		link.VLAN = network.VLANSpec{
+			VID:      vlanConfig.VLANID(),
+			Protocol: vlanConfig.VLANMode(),
+		}
+
+		setCommon(link, vlanConfig)
+	}
+
+	for _, vxlanConfig := range cfg.NetworkVXLANConfigs() {
+		var parentName string
+
+		if parent, ok := vxlanConfig.ParentLink().Get(); ok {
+			if parentName, ok = resolver.Resolve(parent); !ok {
+				logger.Warn("skipping VXLAN with unresolved parent link", zap.String("link", vxlanConfig.Name()), zap.String("parent", parent))
+
+				continue
+			}
+		}
+
+		link := linkSpec(vxlanConfig.Name())
+		link.Logical = true
+		link.Kind = network.LinkKindVXLAN
+		link.Type = nethelpers.LinkEther
+		link.ParentName = parentName
+		link.VXLAN = network.VXLANSpec{
+			VNI:    vxlanConfig.VNI(),
+			Remote: vxlanConfig.Remote(),
+			Local:  vxlanConfig.Local(),
+			Port:   vxlanConfig.Port(),
+		}
+
+		setCommon(link, vxlanConfig)
+	}
+
+	for _, geneveConfig := range cfg.NetworkGENEVEConfigs() {
+		link := linkSpec(geneveConfig.Name())
+		link.Logical = true
+		link.Kind = network.LinkKindGENEVE
+		link.Type = nethelpers.LinkEther
+		link.GENEVE = network.GENEVESpec{
+			VNI:    geneveConfig.VNI(),
+			Remote: geneveConfig.Remote(),
+			Port:   geneveConfig.Port(),
+		}
+
+		setCommon(link, geneveConfig)
+	}
+
+	for _, macvlanConfig := range cfg.NetworkMACVLANConfigs() {
+		parentName, ok := resolver.Resolve(macvlanConfig.ParentLink())
+		if !ok {
+			logger.Warn("skipping macvlan with unresolved parent link", zap.String("link", macvlanConfig.Name()), zap.String("parent", macvlanConfig.ParentLink()))
+
+			continue
+		}
+
+		link := linkSpec(macvlanConfig.Name())
+		link.Logical = true
+		link.Kind = network.LinkKindMACVLAN
+		link.Type = nethelpers.LinkEther
+		link.ParentName = parentName
+		link.MACVLAN = network.MACVLANSpec{
+			Mode: macvlanConfig.MACVLANMode(),
+		}
+
+		setCommon(link, macvlanConfig)
+	}
+
+	for _, ipvlanConfig := range cfg.NetworkIPVLANConfigs() {
+		parentName, ok := resolver.Resolve(ipvlanConfig.ParentLink())
+		if !ok {
+			logger.Warn("skipping ipvlan with unresolved parent link", zap.String("link", ipvlanConfig.Name()), zap.String("parent", ipvlanConfig.ParentLink()))
+
+			continue
+		}
+
+		link := linkSpec(ipvlanConfig.Name())
+		link.Logical = true
+		link.Kind = network.LinkKindIPVLAN
+		link.Type = nethelpers.LinkEther
+		link.ParentName = parentName
+		link.IPVLAN = network.IPVLANSpec{
+			Mode: ipvlanConfig.IPVLANMode(),
+		}
+
+		setCommon(link, ipvlanConfig)
+	}
+
+	return maps.ValuesFunc(linkMap, func(link *network.LinkSpecSpec) network.LinkSpecSpec { return *link })
+}
+
+func bondLink(link *network.LinkSpecSpec, bond talosconfig.NetworkBondConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindBond
+	link.Type = nethelpers.LinkEther
+	link.BondMaster = network.BondMasterSpec{
+		Mode:       bond.Mode(),
+		HashPolicy: bond.HashPolicy(),
+		LACPRate:   bond.LACPRate(),
+		ADSelect:   bond.ADSelect(),
+		MIIMon:     bond.MIIMon(),
+		UpDelay:    bond.UpDelay(),
+		DownDelay:  bond.DownDelay(),
+		MinLinks:   bond.MinLinks(),
+		UseCarrier: true,
+	}
+	networkadapter.BondMasterSpec(&link.BondMaster).FillDefaults()
+}
+
+func bridgeLink(link *network.LinkSpecSpec, bridge talosconfig.NetworkBridgeConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindBridge
+	link.Type = nethelpers.LinkEther
+	link.BridgeMaster = network.BridgeMasterSpec{
+		STP: network.STPSpec{
+			Enabled: bridge.STPEnabled(),
+		},
+		VLAN: network.BridgeVLANSpec{
+			FilteringEnabled: bridge.VLANFilteringEnabled(),
+		},
+	}
+}
+
+func ethtoolLink(link *network.LinkSpecSpec, ethtool talosconfig.NetworkEthtoolConfig) {
+	link.Ethtool = network.EthtoolSpec{
+		Features:         ethtool.Features(),
+		RXRingSize:       ethtool.RXRingSize(),
+		TXRingSize:       ethtool.TXRingSize(),
+		CombinedChannels: ethtool.CombinedChannels(),
+	}
+
+	if linkMode, ok := ethtool.LinkMode().Get(); ok {
+		link.Ethtool.LinkMode = &network.EthtoolLinkModeSpec{
+			Autonegotiation: linkMode.Autonegotiation(),
+			SpeedMegabits:   linkMode.Speed(),
+			Duplex:          linkMode.Duplex(),
+		}
+	}
+
+	if modes, ok := ethtool.WakeOnLAN().Get(); ok {
+		link.Ethtool.WakeOnLAN = &network.EthtoolWakeOnLANSpec{
+			Modes: modes,
+		}
+	}
+}
+
 type vlaner interface {
 	ID() uint16
 	MTU() uint32
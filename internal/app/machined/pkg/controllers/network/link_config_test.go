@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -497,6 +498,216 @@ func (suite *LinkConfigSuite) TestDefaultUp() {
 	)
 }
 
+func (suite *LinkConfigSuite) TestNetworkDocuments() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.LinkConfigController{}))
+
+	for _, link := range []struct {
+		name      string
+		driver    string
+		pciID     string
+		queueDisc string
+	}{
+		{name: "eth0", driver: "mlx5_core", pciID: "15B3:1017"},
+		{name: "eth1", driver: "mlx5_core", pciID: "15B3:1017"},
+		{name: "eth2", driver: "i40e", pciID: "8086:1572", queueDisc: "mq"},
+		{name: "eth3", driver: "e1000"},
+	} {
+		linkStatus := network.NewLinkStatus(network.NamespaceName, link.name)
+		linkStatus.TypedSpec().Type = nethelpers.LinkEther
+		linkStatus.TypedSpec().Driver = link.driver
+		linkStatus.TypedSpec().PCIID = link.pciID
+		linkStatus.TypedSpec().QueueDisc = link.queueDisc
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, linkStatus))
+	}
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: LinkConfig
+name: uplink
+selector:
+  match: link.pciid == "8086:1572" && link.queue_disc == "mq"
+mtu: 9000
+ethtool:
+  features:
+    rx-gro: false
+  rings:
+    rx: 4096
+  linkMode:
+    speed: 10000
+  wakeOnLAN:
+    modes: []
+---
+apiVersion: v1alpha1
+kind: LinkConfig
+name: missing
+selector:
+  match: link.driver == "ixgbe"
+---
+apiVersion: v1alpha1
+kind: BondConfig
+name: bond0
+linkSelector:
+  match: link.driver == "mlx5_core"
+bondMode: 802.3ad
+---
+apiVersion: v1alpha1
+kind: BridgeConfig
+name: br0
+links:
+  - eth3
+stp:
+  enabled: true
+---
+apiVersion: v1alpha1
+kind: VLANConfig
+name: uplink.100
+parent: uplink
+vlanID: 100
+---
+apiVersion: v1alpha1
+kind: VLANConfig
+name: missing.100
+parent: missing
+vlanID: 100
+---
+apiVersion: v1alpha1
+kind: VXLANConfig
+name: vxlan100
+parent: uplink
+vni: 100
+remote: 239.1.1.1
+---
+apiVersion: v1alpha1
+kind: GENEVEConfig
+name: geneve42
+vni: 42
+remote: 10.0.0.2
+port: 6082
+---
+apiVersion: v1alpha1
+kind: MACVLANConfig
+name: macvlan0
+parent: eth2
+---
+apiVersion: v1alpha1
+kind: IPVLANConfig
+name: ipvlan0
+parent: uplink
+mode: l3
+---
+apiVersion: v1alpha1
+kind: IPVLANConfig
+name: ipvlan1
+parent: missing
+`))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(provider)))
+
+	suite.startRuntime()
+
+	suite.assertLinks(
+		[]string{
+			"configuration/eth0",
+			"configuration/eth1",
+			"configuration/eth2",
+			"configuration/eth3",
+			"configuration/bond0",
+			"configuration/br0",
+			"configuration/uplink.100",
+			"configuration/vxlan100",
+			"configuration/geneve42",
+			"configuration/macvlan0",
+			"configuration/ipvlan0",
+		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			asrt.True(r.TypedSpec().Up)
+
+			switch r.TypedSpec().Name {
+			case "eth0", "eth1":
+				asrt.False(r.TypedSpec().Logical)
+				asrt.Equal("bond0", r.TypedSpec().BondSlave.MasterName)
+			case "eth2":
+				asrt.False(r.TypedSpec().Logical)
+				asrt.EqualValues(9000, r.TypedSpec().MTU)
+				asrt.Equal(network.EthtoolSpec{
+					Features:   map[string]bool{"rx-gro": false},
+					RXRingSize: 4096,
+					LinkMode: &network.EthtoolLinkModeSpec{
+						Autonegotiation: true,
+						SpeedMegabits:   10000,
+						Duplex:          nethelpers.Full,
+					},
+					WakeOnLAN: &network.EthtoolWakeOnLANSpec{},
+				}, r.TypedSpec().Ethtool)
+			case "eth3":
+				asrt.False(r.TypedSpec().Logical)
+				asrt.Equal("br0", r.TypedSpec().BridgeSlave.MasterName)
+			case "bond0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindBond, r.TypedSpec().Kind)
+				asrt.Equal(nethelpers.BondMode8023AD, r.TypedSpec().BondMaster.Mode)
+			case "br0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindBridge, r.TypedSpec().Kind)
+				asrt.True(r.TypedSpec().BridgeMaster.STP.Enabled)
+			case "uplink.100":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth2", r.TypedSpec().ParentName)
+				asrt.EqualValues(100, r.TypedSpec().VLAN.VID)
+				asrt.Equal(nethelpers.VLANProtocol8021Q, r.TypedSpec().VLAN.Protocol)
+			case "vxlan100":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth2", r.TypedSpec().ParentName)
+				asrt.Equal(network.VXLANSpec{
+					VNI:    100,
+					Remote: netip.MustParseAddr("239.1.1.1"),
+					Port:   4789,
+				}, r.TypedSpec().VXLAN)
+			case "geneve42":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindGENEVE, r.TypedSpec().Kind)
+				asrt.Empty(r.TypedSpec().ParentName)
+				asrt.Equal(network.GENEVESpec{
+					VNI:    42,
+					Remote: netip.MustParseAddr("10.0.0.2"),
+					Port:   6082,
+				}, r.TypedSpec().GENEVE)
+			case "macvlan0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth2", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModeBridge, r.TypedSpec().MACVLAN.Mode)
+			case "ipvlan0":
+				asrt.True(r.TypedSpec().Logical)
+				asrt.Equal(network.LinkKindIPVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth2", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.IPVLANModeL3, r.TypedSpec().IPVLAN.Mode)
+			}
+		},
+	)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoLinks(
+					[]string{
+						"configuration/missing.100",
+						"configuration/ipvlan1",
+						"configuration/uplink",
+						"default/eth0",
+						"default/eth1",
+						"default/eth2",
+						"default/eth3",
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *LinkConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
+	networkpb "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/network"
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// linkResolver resolves link references in the network configuration documents.
+//
+// A link reference is either a link name, or a name of the LinkConfig document
+// which selects the physical link by a CEL expression.
+type linkResolver struct {
+	logger *zap.Logger
+
+	// physical links, sorted by name
+	links []physicalLink
+
+	aliases    map[string]string
+	unresolved map[string]struct{}
+}
+
+type physicalLink struct {
+	name string
+	spec *networkpb.LinkStatusSpec
+}
+
+// linkStatusToProto converts the link status fields relevant for the physical link selection.
+//
+// The link kind specific fields (VLAN, bond, bridge, etc.) are never set for the physical links, so they are skipped.
+// proto.ResourceSpecToProto can't be used here, as protoenc encodes the uint16 fields of the link kind specific
+// specs as varints, while the proto definitions declare them as fixed32.
+func linkStatusToProto(spec *network.LinkStatusSpec) *networkpb.LinkStatusSpec {
+	return &networkpb.LinkStatusSpec{
+		Index:            spec.Index,
+		Type:             enums.NethelpersLinkType(spec.Type),
+		LinkIndex:        spec.LinkIndex,
+		Flags:            uint32(spec.Flags),
+		HardwareAddr:     spec.HardwareAddr,
+		PermanentAddr:    spec.PermanentAddr,
+		BroadcastAddr:    spec.BroadcastAddr,
+		Mtu:              spec.MTU,
+		QueueDisc:        spec.QueueDisc,
+		MasterIndex:      spec.MasterIndex,
+		OperationalState: enums.NethelpersOperationalState(spec.OperationalState),
+		Kind:             spec.Kind,
+		SlaveKind:        spec.SlaveKind,
+		BusPath:          spec.BusPath,
+		Pciid:            spec.PCIID,
+		Driver:           spec.Driver,
+		DriverVersion:    spec.DriverVersion,
+		FirmwareVersion:  spec.FirmwareVersion,
+		ProductId:        spec.ProductID,
+		VendorId:         spec.VendorID,
+		Product:          spec.Product,
+		Vendor:           spec.Vendor,
+		LinkState:        spec.LinkState,
+		SpeedMegabits:    int64(spec.SpeedMegabits),
+		Port:             enums.NethelpersPort(spec.Port),
+		Duplex:           enums.NethelpersDuplex(spec.Duplex),
+		Ethtool: &networkpb.EthtoolStatus{
+			Features:            spec.Ethtool.Features,
+			RxRingSize:          spec.Ethtool.RXRingSize,
+			RxRingSizeMax:       spec.Ethtool.RXRingSizeMax,
+			TxRingSize:          spec.Ethtool.TXRingSize,
+			TxRingSizeMax:       spec.Ethtool.TXRingSizeMax,
+			CombinedChannels:    spec.Ethtool.CombinedChannels,
+			CombinedChannelsMax: spec.Ethtool.CombinedChannelsMax,
+			Autonegotiation:     spec.Ethtool.Autonegotiation,
+			WakeOnLan:           uint32(spec.Ethtool.WakeOnLAN),
+		},
+		Dot1XState: enums.NethelpersDot1XState(spec.Dot1XState),
+	}
+}
+
+func newLinkResolver(logger *zap.Logger, cfg talosconfig.Config, linkStatuses safe.List[*network.LinkStatus]) *linkResolver {
+	resolver := &linkResolver{
+		logger:     logger,
+		aliases:    map[string]string{},
+		unresolved: map[string]struct{}{},
+	}
+
+	// link statuses are sorted by ID (link name)
+	for linkStatus := range linkStatuses.All() {
+		if !linkStatus.TypedSpec().Physical() {
+			continue
+		}
+
+		resolver.links = append(resolver.links, physicalLink{
+			name: linkStatus.Metadata().ID(),
+			spec: linkStatusToProto(linkStatus.TypedSpec()),
+		})
+	}
+
+	if cfg == nil {
+		return resolver
+	}
+
+	for _, linkConfig := range cfg.NetworkLinkConfigs() {
+		selector, ok := linkConfig.Selector().Get()
+		if !ok {
+			continue
+		}
+
+		matched := resolver.Select(selector)
+
+		switch len(matched) {
+		case 0:
+			resolver.unresolved[linkConfig.Name()] = struct{}{}
+
+			logger.Warn("no links matched the link selector", zap.String("link", linkConfig.Name()))
+		default:
+			if len(matched) > 1 {
+				logger.Warn("multiple links matched the link selector, using the first one",
+					zap.String("link", linkConfig.Name()), zap.Strings("matched", matched))
+			}
+
+			resolver.aliases[linkConfig.Name()] = matched[0]
+		}
+	}
+
+	return resolver
+}
+
+// Resolve returns the link name for the link reference.
+//
+// If the reference points to the link selector which matched no links, false is returned.
+func (resolver *linkResolver) Resolve(name string) (string, bool) {
+	if _, unresolved := resolver.unresolved[name]; unresolved {
+		return "", false
+	}
+
+	if linkName, ok := resolver.aliases[name]; ok {
+		return linkName, true
+	}
+
+	return name, true
+}
+
+// ResolveAll resolves the list of link references, skipping the unresolved ones.
+func (resolver *linkResolver) ResolveAll(names []string) []string {
+	result := make([]string, 0, len(names))
+
+	for _, name := range names {
+		linkName, ok := resolver.Resolve(name)
+		if !ok {
+			resolver.logger.Warn("skipping unresolved link", zap.String("link", name))
+
+			continue
+		}
+
+		result = append(result, linkName)
+	}
+
+	return result
+}
+
+// Select returns the names of the physical links matching the selector.
+func (resolver *linkResolver) Select(selector cel.Expression) []string {
+	var matched []string
+
+	for _, link := range resolver.links {
+		matches, err := selector.EvalBool(celenv.LinkLocator(), map[string]any{
+			"link": link.spec,
+		})
+		if err != nil {
+			resolver.logger.Warn("error evaluating link selector", zap.String("link", link.name), zap.Error(err))
+
+			continue
+		}
+
+		if matches {
+			matched = append(matched, link.name)
+		}
+	}
+
+	return matched
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// linkKindFields are not set for the physical links, so they are not mapped.
+var linkKindFields = map[string]struct{}{
+	"VLAN":         {},
+	"BridgeMaster": {},
+	"BondMaster":   {},
+	"Wireguard":    {},
+	"VXLAN":        {},
+	"GENEVE":       {},
+	"MACVLAN":      {},
+	"IPVLAN":       {},
+}
+
+// fillNonZero sets every field of v to a non-zero value.
+func fillNonZero(t *testing.T, v reflect.Value) {
+	t.Helper()
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if _, skip := linkKindFields[v.Type().Field(i).Name]; skip {
+				continue
+			}
+
+			fillNonZero(t, v.Field(i))
+		}
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillNonZero(t, v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+
+		key := reflect.New(v.Type().Key()).Elem()
+		fillNonZero(t, key)
+
+		val := reflect.New(v.Type().Elem()).Elem()
+		fillNonZero(t, val)
+
+		v.SetMapIndex(key, val)
+	default:
+		t.Fatalf("unsupported field kind %s", v.Kind())
+	}
+}
+
+// assertMapped checks that every field of v is set in the proto message field with the same number.
+func assertMapped(t *testing.T, v reflect.Value, msg protoreflect.Message) {
+	t.Helper()
+
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+
+		if _, skip := linkKindFields[field.Name]; skip {
+			continue
+		}
+
+		num, err := strconv.Atoi(field.Tag.Get("protobuf"))
+		require.NoError(t, err, "field %s", field.Name)
+
+		fd := msg.Descriptor().Fields().ByNumber(protoreflect.FieldNumber(num))
+		require.NotNil(t, fd, "field %s", field.Name)
+
+		if !assert.True(t, msg.Has(fd), "field %s is not mapped to %s", field.Name, fd.FullName()) {
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			assertMapped(t, v.Field(i), msg.Get(fd).Message())
+		}
+	}
+}
+
+func TestLinkStatusToProto(t *testing.T) {
+	t.Parallel()
+
+	var spec network.LinkStatusSpec
+
+	fillNonZero(t, reflect.ValueOf(&spec).Elem())
+
+	assertMapped(t, reflect.ValueOf(spec), netctrl.LinkStatusToProto(&spec).ProtoReflect())
+}
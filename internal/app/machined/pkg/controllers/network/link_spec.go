@@ -21,6 +21,7 @@ import (
 	"golang.zx2c4.com/wireguard/wgctrl"
 
 	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtoolnl"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -89,6 +90,13 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		defer wgClient.Close() //nolint:errcheck
 	}
 
+	ethClient, err := ethtoolnl.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool socket", zap.Error(err))
+	} else {
+		defer ethClient.Close() //nolint:errcheck
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -125,7 +133,7 @@ func (ctrl *LinkSpecController) Run(ctx context.Context, r controller.Runtime, l
 		SortBonds(&list)
 
 		for link := range list.All() {
-			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, &links, link); err != nil {
+			if err = ctrl.syncLink(ctx, r, logger, conn, wgClient, ethClient, &links, link); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 		}
@@ -169,6 +177,42 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 	return nil
 }
 
+// linkDataChanged checks whether the kind-specific settings of the existing link differ from the spec.
+//
+// Link kinds which settings can be changed on the fly (or which are not handled here) are never reported as changed.
+func linkDataChanged(spec *network.LinkSpecSpec, existingRawLinkData []byte) (bool, error) {
+	switch spec.Kind {
+	case network.LinkKindVXLAN:
+		return decodedDataChanged(existingRawLinkData, spec.VXLAN, func(s *network.VXLANSpec, data []byte) error {
+			return networkadapter.VXLANSpec(s).Decode(data)
+		})
+	case network.LinkKindGENEVE:
+		return decodedDataChanged(existingRawLinkData, spec.GENEVE, func(s *network.GENEVESpec, data []byte) error {
+			return networkadapter.GENEVESpec(s).Decode(data)
+		})
+	case network.LinkKindMACVLAN:
+		return decodedDataChanged(existingRawLinkData, spec.MACVLAN, func(s *network.MACVLANSpec, data []byte) error {
+			return networkadapter.MACVLANSpec(s).Decode(data)
+		})
+	case network.LinkKindIPVLAN:
+		return decodedDataChanged(existingRawLinkData, spec.IPVLAN, func(s *network.IPVLANSpec, data []byte) error {
+			return networkadapter.IPVLANSpec(s).Decode(data)
+		})
+	default:
+		return false, nil
+	}
+}
+
+func decodedDataChanged[T comparable](data []byte, expected T, decode func(*T, []byte) error) (bool, error) {
+	var existing T
+
+	if err := decode(&existing, data); err != nil {
+		return false, err
+	}
+
+	return existing != expected, nil
+}
+
 // syncLink syncs kernel state with the LinkSpec link.
 //
 // This method is really long, but it's hard to break it down in multiple pieces, are those pieces and steps are inter-dependent, so, instead,
@@ -177,12 +221,12 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 // First of all, if the spec is being torn down - remove the link from the kernel, done.
 // If the link spec is not being torn down, start the sync process:
 //
-//   - for physical links, there's not much we can sync - only MTU and 'UP' flag
+//   - for physical links, there's not much we can sync - only MTU, 'UP' flag and ethtool settings
 //   - for logical links, controller handles creation and sync of the settings depending on the interface type
 //
 // If the logical link kind or type got changed (for example, "link0" was a bond, and now it's wireguard interface), the link
 // is dropped and replaced with the new one.
-// Same replace flow is used for VLAN links, as VLAN settings can't be changed on the fly.
+// Same replace flow is used for VLAN, VXLAN, GENEVE, macvlan and ipvlan links, as their settings can't be changed on the fly.
 //
 // For bonded links, there are two sync steps applied:
 //
@@ -194,7 +238,7 @@ func findLink(links []rtnetlink.LinkMessage, name string) *rtnetlink.LinkMessage
 //
 //nolint:gocyclo,cyclop,dupl
 func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn, wgClient *wgctrl.Client,
-	links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
+	ethClient *ethtoolnl.Client, links *[]rtnetlink.LinkMessage, link *network.LinkSpec,
 ) error {
 	logger = logger.With(zap.String("link", link.TypedSpec().Name))
 
@@ -286,6 +330,20 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			// sync overlay and virtual link settings, as they can't be modified on the fly
+			if !replace {
+				changed, err := linkDataChanged(link.TypedSpec(), existingRawLinkData)
+				if err != nil {
+					return fmt.Errorf("error decoding %s properties on %q: %w", link.TypedSpec().Kind, link.TypedSpec().Name, err)
+				}
+
+				if changed {
+					logger.Info("replacing link with changed settings", zap.String("kind", link.TypedSpec().Kind))
+
+					replace = true
+				}
+			}
+
 			if replace {
 				if err := conn.Link.Delete(existing.Index); err != nil {
 					return fmt.Errorf("error deleting link %q: %w", link.TypedSpec().Name, err)
@@ -310,7 +368,7 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				err         error
 			)
 
-			// VLAN settings should be set on interface creation (parent + VLAN settings)
+			// VLAN, VXLAN, macvlan and ipvlan settings should be set on interface creation (parent + link settings)
 			if link.TypedSpec().ParentName != "" {
 				parent := findLink(*links, link.TypedSpec().ParentName)
 				if parent == nil {
@@ -321,11 +379,21 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				parentIndex = parent.Index
 			}
 
-			if link.TypedSpec().Kind == network.LinkKindVLAN {
+			switch link.TypedSpec().Kind {
+			case network.LinkKindVLAN:
 				data, err = networkadapter.VLANSpec(&link.TypedSpec().VLAN).Encode()
-				if err != nil {
-					return fmt.Errorf("error encoding VLAN attributes for link %q: %w", link.TypedSpec().Name, err)
-				}
+			case network.LinkKindVXLAN:
+				data, err = networkadapter.VXLANSpec(&link.TypedSpec().VXLAN).Encode(parentIndex)
+			case network.LinkKindGENEVE:
+				data, err = networkadapter.GENEVESpec(&link.TypedSpec().GENEVE).Encode()
+			case network.LinkKindMACVLAN:
+				data, err = networkadapter.MACVLANSpec(&link.TypedSpec().MACVLAN).Encode()
+			case network.LinkKindIPVLAN:
+				data, err = networkadapter.IPVLANSpec(&link.TypedSpec().IPVLAN).Encode()
+			}
+
+			if err != nil {
+				return fmt.Errorf("error encoding %s attributes for link %q: %w", link.TypedSpec().Kind, link.TypedSpec().Name, err)
 			}
 
 			if err = conn.Link.New(&rtnetlink.LinkMessage{
@@ -589,6 +657,8 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 			logger.Info("changed MTU for the link", zap.Uint32("mtu", link.TypedSpec().MTU))
 		}
 
+		syncEthtool(logger, ethClient, link.TypedSpec().Name, link.TypedSpec().Ethtool)
+
 		// sync master index (for links which are bridge or bond slaves)
 		var masterIndex uint32
 
@@ -639,3 +709,124 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 
 	return nil
 }
+
+// syncEthtool applies ethtool settings to the link.
+//
+// Failures are not fatal, as the link driver might not support some settings:
+// they are logged, and the settings are retried on the next sync.
+//
+//nolint:gocyclo
+func syncEthtool(logger *zap.Logger, ethClient *ethtoolnl.Client, name string, spec network.EthtoolSpec) {
+	if spec.IsZero() {
+		return
+	}
+
+	if ethClient == nil {
+		logger.Warn("ethtool client not available, skipping ethtool settings")
+
+		return
+	}
+
+	if len(spec.Features) > 0 {
+		current, err := ethClient.Features(name)
+		if err != nil {
+			logger.Warn("error getting link features", zap.Error(err))
+		} else {
+			changed := map[string]bool{}
+
+			for feature, enabled := range spec.Features {
+				active, ok := current[feature]
+
+				switch {
+				case !ok:
+					logger.Warn("link feature is not supported or can't be changed", zap.String("feature", feature))
+				case active != enabled:
+					changed[feature] = enabled
+				}
+			}
+
+			if len(changed) > 0 {
+				if err = ethClient.SetFeatures(name, changed); err != nil {
+					logger.Warn("error changing link features", zap.Error(err))
+				} else {
+					logger.Info("changed link features", zap.Any("features", changed))
+				}
+			}
+		}
+	}
+
+	if spec.RXRingSize != 0 || spec.TXRingSize != 0 {
+		current, err := ethClient.Rings(name)
+		if err != nil {
+			logger.Warn("error getting link ring sizes", zap.Error(err))
+		} else if (spec.RXRingSize != 0 && spec.RXRingSize != current.RX) || (spec.TXRingSize != 0 && spec.TXRingSize != current.TX) {
+			if err = ethClient.SetRings(name, spec.RXRingSize, spec.TXRingSize); err != nil {
+				logger.Warn("error changing link ring sizes", zap.Error(err))
+			} else {
+				logger.Info("changed link ring sizes", zap.Uint32("rx", spec.RXRingSize), zap.Uint32("tx", spec.TXRingSize))
+			}
+		}
+	}
+
+	if spec.CombinedChannels != 0 {
+		current, err := ethClient.Channels(name)
+		if err != nil {
+			logger.Warn("error getting link channels", zap.Error(err))
+		} else if current.Combined != spec.CombinedChannels {
+			if err = ethClient.SetChannels(name, spec.CombinedChannels); err != nil {
+				logger.Warn("error changing link channels", zap.Error(err))
+			} else {
+				logger.Info("changed link channels", zap.Uint32("combined", spec.CombinedChannels))
+			}
+		}
+	}
+
+	if spec.LinkMode != nil {
+		current, err := ethClient.LinkMode(name)
+		if err != nil {
+			logger.Warn("error getting link mode", zap.Error(err))
+		} else if linkModeChanged(current, *spec.LinkMode) {
+			if err = ethClient.SetLinkMode(name, ethtoolnl.LinkMode{
+				Autonegotiation: spec.LinkMode.Autonegotiation,
+				SpeedMegabits:   spec.LinkMode.SpeedMegabits,
+				Duplex:          uint8(spec.LinkMode.Duplex),
+			}); err != nil {
+				logger.Warn("error changing link mode", zap.Error(err))
+			} else {
+				logger.Info("changed link mode",
+					zap.Bool("autonegotiation", spec.LinkMode.Autonegotiation),
+					zap.Uint32("speedMbit", spec.LinkMode.SpeedMegabits),
+					zap.Stringer("duplex", spec.LinkMode.Duplex),
+				)
+			}
+		}
+	}
+
+	if spec.WakeOnLAN != nil {
+		current, err := ethClient.WakeOnLAN(name)
+		if err != nil {
+			logger.Warn("error getting link Wake-on-LAN modes", zap.Error(err))
+		} else if current != uint32(spec.WakeOnLAN.Modes) {
+			if err = ethClient.SetWakeOnLAN(name, uint32(spec.WakeOnLAN.Modes)); err != nil {
+				logger.Warn("error changing link Wake-on-LAN modes", zap.Error(err))
+			} else {
+				logger.Info("changed link Wake-on-LAN modes", zap.Stringer("modes", spec.WakeOnLAN.Modes))
+			}
+		}
+	}
+}
+
+// linkModeChanged checks if the link mode should be updated.
+func linkModeChanged(current ethtoolnl.LinkMode, expected network.EthtoolLinkModeSpec) bool {
+	if current.Autonegotiation != expected.Autonegotiation {
+		return true
+	}
+
+	// speed and duplex are reported as unknown while the link is down,
+	// so they can only be compared if known, otherwise the link would be renegotiated on every sync
+	if expected.SpeedMegabits == 0 || current.Duplex == ethtoolnl.DuplexUnknown {
+		return false
+	}
+
+	return current.SpeedMegabits != expected.SpeedMegabits || current.Duplex != uint8(expected.Duplex)
+}
@@ -354,6 +354,178 @@ func (suite *LinkSpecSuite) TestVLAN() {
 	)
 }
 
+func (suite *LinkSpecSuite) TestVXLAN() {
+	vxlanName := fmt.Sprintf("vxlan%02x%02x%02x", rand.Int32()&0xff, rand.Int32()&0xff, rand.Int32()&0xff)
+
+	vxlan := network.NewLinkSpec(network.NamespaceName, vxlanName)
+	*vxlan.TypedSpec() = network.LinkSpecSpec{
+		Name:        vxlanName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindVXLAN,
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+		VXLAN: network.VXLANSpec{
+			VNI:    100,
+			Remote: netip.MustParseAddr("192.0.2.1"),
+			Port:   4789,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, vxlan))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vxlanName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+						suite.Assert().Equal(vxlan.TypedSpec().VXLAN, r.TypedSpec().VXLAN)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// attempt to change VNI, the link should be re-created
+	ctest.UpdateWithConflicts(suite, vxlan, func(r *network.LinkSpec) error {
+		r.TypedSpec().VXLAN.VNI = 200
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{vxlanName}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().VXLAN.VNI != 200 {
+							return retry.ExpectedErrorf("VNI is not 200: %d", r.TypedSpec().VXLAN.VNI)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// teardown the link
+	for {
+		ready, err := suite.state.Teardown(suite.ctx, vxlan.Metadata())
+		suite.Require().NoError(err)
+
+		if ready {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(vxlanName)
+			},
+		),
+	)
+}
+
+func (suite *LinkSpecSuite) TestMACVLAN() {
+	dummyInterface := suite.uniqueDummyInterface()
+
+	dummy := network.NewLinkSpec(network.NamespaceName, dummyInterface)
+	*dummy.TypedSpec() = network.LinkSpecSpec{
+		Name:        dummyInterface,
+		Type:        nethelpers.LinkEther,
+		Kind:        "dummy",
+		Up:          true,
+		Logical:     true,
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	macvlanName := dummyInterface + "mv"
+	macvlan := network.NewLinkSpec(network.NamespaceName, macvlanName)
+	*macvlan.TypedSpec() = network.LinkSpecSpec{
+		Name:        macvlanName,
+		Type:        nethelpers.LinkEther,
+		Kind:        network.LinkKindMACVLAN,
+		Up:          true,
+		Logical:     true,
+		ParentName:  dummyInterface,
+		ConfigLayer: network.ConfigDefault,
+		MACVLAN: network.MACVLANSpec{
+			Mode: nethelpers.MACVLANModeBridge,
+		},
+	}
+
+	for _, res := range []resource.Resource{dummy, macvlan} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{macvlanName}, func(r *network.LinkStatus) error {
+						suite.Assert().Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+						suite.Assert().Equal(nethelpers.MACVLANModeBridge, r.TypedSpec().MACVLAN.Mode)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// attempt to change the mode, the link should be re-created
+	ctest.UpdateWithConflicts(suite, macvlan, func(r *network.LinkSpec) error {
+		r.TypedSpec().MACVLAN.Mode = nethelpers.MACVLANModePrivate
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertInterfaces(
+					[]string{macvlanName}, func(r *network.LinkStatus) error {
+						if r.TypedSpec().MACVLAN.Mode != nethelpers.MACVLANModePrivate {
+							return retry.ExpectedErrorf("macvlan mode is not private: %s", r.TypedSpec().MACVLAN.Mode)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// teardown the links
+	for _, r := range []resource.Resource{macvlan, dummy} {
+		for {
+			ready, err := suite.state.Teardown(suite.ctx, r.Metadata())
+			suite.Require().NoError(err)
+
+			if ready {
+				break
+			}
+
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoInterface(dummyInterface)
+			},
+		),
+	)
+}
+
 //nolint:gocyclo
 func (suite *LinkSpecSuite) TestBond() {
 	bondName := suite.uniqueDummyInterface()
@@ -23,6 +23,7 @@ import (
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
 	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtoolnl"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/internal/pkg/pci"
@@ -65,6 +66,11 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 				Type:      network.LinkSpecType,
 				Kind:      controller.InputStrong,
 			},
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.Dot1XStatusType,
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return err
@@ -101,6 +107,13 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 		defer ethClient.Close() //nolint:errcheck
 	}
 
+	ethNetlinkClient, err := ethtoolnl.New()
+	if err != nil {
+		logger.Warn("error dialing ethtool netlink socket", zap.Error(err))
+	} else {
+		defer ethNetlinkClient.Close() //nolint:errcheck
+	}
+
 	ethIoctlClient, err := ethtoolioctl.NewEthtool()
 	if err != nil {
 		logger.Warn("error dialing ethtool ioctl socket", zap.Error(err))
@@ -122,7 +135,7 @@ func (ctrl *LinkStatusController) Run(ctx context.Context, r controller.Runtime,
 		case <-r.EventCh():
 		}
 
-		if err = ctrl.reconcile(ctx, r, logger, conn, ethClient, ethIoctlClient, wgClient); err != nil {
+		if err = ctrl.reconcile(ctx, r, logger, conn, ethClient, ethNetlinkClient, ethIoctlClient, wgClient); err != nil {
 			return err
 		}
 
@@ -139,6 +152,7 @@ func (ctrl *LinkStatusController) reconcile(
 	logger *zap.Logger,
 	conn *rtnetlink.Conn,
 	ethClient *ethtool.Client,
+	ethNetlinkClient *ethtoolnl.Client,
 	ethtoolIoctlClient *ethtoolioctl.Ethtool,
 	wgClient *wgctrl.Client,
 ) error {
@@ -159,12 +173,18 @@ func (ctrl *LinkStatusController) reconcile(
 		return fmt.Errorf("error listing links: %w", err)
 	}
 
+	dot1xStatuses, err := safe.ReaderListAll[*network.Dot1XStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing 802.1X statuses: %w", err)
+	}
+
 	// for every rtnetlink discovered link
 	for _, link := range links {
 		var (
 			ethState      *ethtool.LinkState
 			ethInfo       *ethtool.LinkInfo
 			ethMode       *ethtool.LinkMode
+			ethStatus     network.EthtoolStatus
 			driverInfo    ethtoolioctl.DrvInfo
 			permanentAddr net.HardwareAddr
 		)
@@ -197,6 +217,11 @@ func (ctrl *LinkStatusController) reconcile(
 					logger.Warn("error querying ethtool link mode", zap.String("link", link.Attributes.Name), zap.Error(err))
 				}
 			}
+
+			// skip if previous call failed (e.g. not supported)
+			if err == nil && ethNetlinkClient != nil {
+				ethStatus = queryEthtoolStatus(logger.With(zap.String("link", link.Attributes.Name)), ethNetlinkClient, link.Attributes.Name)
+			}
 		}
 
 		if ethtoolIoctlClient != nil {
@@ -268,6 +293,16 @@ func (ctrl *LinkStatusController) reconcile(
 				status.Duplex = nethelpers.Duplex(ethtool.Unknown)
 			}
 
+			status.Ethtool = ethStatus
+
+			if dot1xStatus, ok := dot1xStatuses.Find(func(res *network.Dot1XStatus) bool {
+				return res.Metadata().ID() == link.Attributes.Name
+			}); ok {
+				status.Dot1XState = dot1xStatus.TypedSpec().State
+			} else {
+				status.Dot1XState = nethelpers.Dot1XStateDisabled
+			}
+
 			var deviceInfo *nethelpers.DeviceInfo
 
 			deviceInfo, err = nethelpers.GetDeviceInfo(link.Attributes.Name)
@@ -337,6 +372,27 @@ func (ctrl *LinkStatusController) reconcile(
 				} else if err = networkadapter.BridgeMasterSpec(&status.BridgeMaster).Decode(rawLinkData); err != nil {
 					logger.Warn("failure decoding bridge attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
 				}
+			case network.LinkKindVXLAN:
+				// optional attributes are not reported when unset, so reset the spec first
+				status.VXLAN = network.VXLANSpec{}
+
+				if err = networkadapter.VXLANSpec(&status.VXLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding VXLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindGENEVE:
+				status.GENEVE = network.GENEVESpec{}
+
+				if err = networkadapter.GENEVESpec(&status.GENEVE).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding GENEVE attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindMACVLAN:
+				if err = networkadapter.MACVLANSpec(&status.MACVLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding macvlan attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindIPVLAN:
+				if err = networkadapter.IPVLANSpec(&status.IPVLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding ipvlan attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
 			case network.LinkKindWireguard:
 				if wgClient == nil {
 					return fmt.Errorf("wireguard client not available, but wireguard interface was discovered: %q", link.Attributes.Name)
@@ -368,3 +424,57 @@ func (ctrl *LinkStatusController) reconcile(
 
 	return nil
 }
+
+// queryEthtoolStatus queries the ethtool settings of the link.
+//
+// Settings not supported by the link driver are left empty.
+func queryEthtoolStatus(logger *zap.Logger, ethClient *ethtoolnl.Client, name string) network.EthtoolStatus {
+	var status network.EthtoolStatus
+
+	warn := func(msg string, err error) {
+		if !errors.Is(err, unix.EOPNOTSUPP) && !errors.Is(err, unix.ENODEV) {
+			logger.Warn(msg, zap.Error(err))
+		}
+	}
+
+	features, err := ethClient.Features(name)
+	if err != nil {
+		warn("error querying ethtool features", err)
+	} else if len(features) > 0 {
+		status.Features = features
+	}
+
+	rings, err := ethClient.Rings(name)
+	if err != nil {
+		warn("error querying ethtool rings", err)
+	} else {
+		status.RXRingSize = rings.RX
+		status.RXRingSizeMax = rings.RXMax
+		status.TXRingSize = rings.TX
+		status.TXRingSizeMax = rings.TXMax
+	}
+
+	channels, err := ethClient.Channels(name)
+	if err != nil {
+		warn("error querying ethtool channels", err)
+	} else {
+		status.CombinedChannels = channels.Combined
+		status.CombinedChannelsMax = channels.CombinedMax
+	}
+
+	mode, err := ethClient.LinkMode(name)
+	if err != nil {
+		warn("error querying ethtool link mode", err)
+	} else {
+		status.Autonegotiation = mode.Autonegotiation
+	}
+
+	wol, err := ethClient.WakeOnLAN(name)
+	if err != nil {
+		warn("error querying ethtool Wake-on-LAN", err)
+	} else {
+		status.WakeOnLAN = nethelpers.WOLModes(wol)
+	}
+
+	return status
+}
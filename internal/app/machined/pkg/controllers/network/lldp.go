@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// defaultLLDPTransmitInterval is the default interval between the transmitted LLDP frames.
+const defaultLLDPTransmitInterval = 30 * time.Second
+
+// LLDPController runs LLDP agents on the physical links and outputs LLDPNeighbors.
+type LLDPController struct {
+	V1Alpha1Mode runtime.Mode
+
+	// Listen overrides the LLDP socket of the agents (used in tests).
+	Listen func(linkName string, spec lldp.Spec) (net.PacketConn, error)
+
+	runners map[string]*lldp.Runner
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LLDPController) Name() string {
+	return "network.LLDPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LLDPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        optional.Some(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LLDPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LLDPNeighborType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LLDPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// there are no physical links inside a container, so skip the controller
+	if ctrl.V1Alpha1Mode == runtime.ModeContainer {
+		return nil
+	}
+
+	notifyCh := make(chan lldp.Notification)
+
+	ctrl.runners = make(map[string]*lldp.Runner)
+
+	defer func() {
+		for _, runner := range ctrl.runners {
+			runner.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileRunners(ctx, r, logger, notifyCh); err != nil {
+				return err
+			}
+		case ev := <-notifyCh:
+			if err := ctrl.reconcileOutputs(ctx, r, ev); err != nil {
+				return err
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *LLDPController) reconcileRunners(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- lldp.Notification) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting machine config: %w", err)
+	}
+
+	hostnameStatus, err := safe.ReaderGetByID[*network.HostnameStatus](ctx, r, network.HostnameID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting hostname status: %w", err)
+	}
+
+	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing link statuses: %w", err)
+	}
+
+	var (
+		transmit         bool
+		transmitInterval = defaultLLDPTransmitInterval
+		systemName       string
+	)
+
+	if cfg != nil {
+		if lldpCfg := cfg.Config().NetworkLLDPConfig(); lldpCfg != nil {
+			transmit = lldpCfg.Transmit()
+
+			if lldpCfg.TransmitInterval() != 0 {
+				transmitInterval = lldpCfg.TransmitInterval()
+			}
+		}
+	}
+
+	if hostnameStatus != nil {
+		systemName = hostnameStatus.TypedSpec().FQDN()
+	}
+
+	// LLDP agents run on physical links which are up
+	shouldRun := make(map[string]lldp.Spec)
+
+	for link := range linkStatuses.All() {
+		if !link.TypedSpec().Physical() || link.TypedSpec().OperationalState != nethelpers.OperStateUp {
+			continue
+		}
+
+		shouldRun[link.Metadata().ID()] = lldp.Spec{
+			LinkIndex:         int(link.TypedSpec().Index),
+			HardwareAddr:      net.HardwareAddr(link.TypedSpec().HardwareAddr),
+			Transmit:          transmit,
+			TransmitInterval:  transmitInterval,
+			SystemName:        systemName,
+			SystemDescription: version.Short(),
+		}
+	}
+
+	// stop agents which shouldn't run
+	for linkName := range ctrl.runners {
+		if spec, exists := shouldRun[linkName]; !exists || !spec.Equal(ctrl.runners[linkName].Spec) {
+			logger.Debug("stopping LLDP agent", zap.String("link", linkName))
+
+			ctrl.runners[linkName].Stop()
+			delete(ctrl.runners, linkName)
+		}
+	}
+
+	// start agents which aren't running
+	for linkName, spec := range shouldRun {
+		if _, exists := ctrl.runners[linkName]; !exists {
+			ctrl.runners[linkName] = &lldp.Runner{
+				LinkName: linkName,
+				Spec:     spec,
+				Listen:   ctrl.Listen,
+			}
+
+			logger.Debug("starting LLDP agent", zap.String("link", linkName))
+			ctrl.runners[linkName].Start(ctx, notifyCh, logger)
+		}
+	}
+
+	// clean up neighbors of the links which are gone or down
+	neighborList, err := safe.ReaderListAll[*network.LLDPNeighbor](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing LLDP neighbors: %w", err)
+	}
+
+	for res := range neighborList.All() {
+		if _, exists := shouldRun[res.Metadata().ID()]; exists {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying LLDP neighbor: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *LLDPController) reconcileOutputs(ctx context.Context, r controller.Runtime, ev lldp.Notification) error {
+	if _, exists := ctrl.runners[ev.LinkName]; !exists {
+		// agent was already stopped, late notification, ignore it
+		return nil
+	}
+
+	if ev.Neighbor == nil {
+		if err := r.Destroy(ctx, network.NewLLDPNeighbor(network.NamespaceName, ev.LinkName).Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying LLDP neighbor: %w", err)
+		}
+
+		return nil
+	}
+
+	return safe.WriterModify(ctx, r, network.NewLLDPNeighbor(network.NamespaceName, ev.LinkName),
+		func(res *network.LLDPNeighbor) error {
+			spec := res.TypedSpec()
+
+			spec.LinkName = ev.LinkName
+			spec.ChassisID = ev.Neighbor.ChassisID
+			spec.PortID = ev.Neighbor.PortID
+			spec.PortDescription = ev.Neighbor.PortDescription
+			spec.SystemName = ev.Neighbor.SystemName
+			spec.SystemDescription = ev.Neighbor.SystemDescription
+			spec.ManagementAddresses = ev.Neighbor.ManagementAddresses
+			spec.PortVLANID = ev.Neighbor.PortVLANID
+			spec.TTL = ev.Neighbor.TTL
+
+			spec.VLANList = make([]network.LLDPVLAN, 0, len(ev.Neighbor.VLANs))
+
+			for _, vlan := range ev.Neighbor.VLANs {
+				spec.VLANList = append(spec.VLANList, network.LLDPVLAN{
+					ID:   vlan.ID,
+					Name: vlan.Name,
+				})
+			}
+
+			return nil
+		})
+}
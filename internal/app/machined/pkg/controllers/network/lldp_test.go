@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"encoding/hex"
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// lldpConn delivers the frames from the channel and records the sent frames.
+type lldpConn struct {
+	net.PacketConn
+
+	rxCh   chan []byte
+	txCh   chan []byte
+	closed chan struct{}
+}
+
+func (conn *lldpConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	select {
+	case frame := <-conn.rxCh:
+		return copy(b, frame), nil, nil
+	case <-conn.closed:
+		return 0, nil, os.ErrClosed
+	}
+}
+
+func (conn *lldpConn) WriteTo(b []byte, _ net.Addr) (int, error) {
+	select {
+	case conn.txCh <- append([]byte(nil), b...):
+	default:
+	}
+
+	return len(b), nil
+}
+
+func (conn *lldpConn) Close() error {
+	close(conn.closed)
+
+	return nil
+}
+
+type LLDPSuite struct {
+	ctest.DefaultSuite
+
+	connCh chan *lldpConn
+}
+
+func (suite *LLDPSuite) TestReconcile() {
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: LLDPConfig
+transmit: true
+`))
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(provider))
+
+	hostname := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostname.TypedSpec().Hostname = "talos-worker-1"
+	suite.Create(hostname)
+
+	// virtual links are skipped
+	bridge := network.NewLinkStatus(network.NamespaceName, "br0")
+	bridge.TypedSpec().Type = nethelpers.LinkEther
+	bridge.TypedSpec().Kind = "bridge"
+	bridge.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Create(bridge)
+
+	link := network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().Index = 2
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+	link.TypedSpec().OperationalState = nethelpers.OperStateUp
+	suite.Create(link)
+
+	var conn *lldpConn
+
+	select {
+	case conn = <-suite.connCh:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout waiting for the LLDP agent")
+	}
+
+	select {
+	case frame := <-conn.txCh:
+		du, err := lldp.Decode(frame)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal("02:00:00:00:00:01", du.ChassisID)
+		suite.Assert().Equal("eth0", du.PortID)
+		suite.Assert().Equal(2*time.Minute, du.TTL)
+		suite.Assert().Equal("talos-worker-1", du.SystemName)
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout waiting for the advertisement")
+	}
+
+	b, err := hex.DecodeString("0207040c26b4a5f100" + // chassis ID: MAC address
+		"040805457468312f3132" + // port ID: Eth1/12
+		"06020078" + // TTL: 120s
+		"0a09746f722d61312e6463" + // system name: tor-a1.dc
+		"100c0501c0a8000a020000000100" + // management address: 192.168.0.10
+		"fe060080c2010064" + // port VLAN ID: 100
+		"fe0e0080c20300640773657276657273" + // VLAN name: 100 servers
+		"0000")
+	suite.Require().NoError(err)
+
+	select {
+	case conn.rxCh <- b:
+	case <-suite.Ctx().Done():
+		suite.FailNow("timeout sending the neighbor frame")
+	}
+
+	ctest.AssertResource(suite, "eth0", func(neighbor *network.LLDPNeighbor, asrt *assert.Assertions) {
+		asrt.Equal(network.LLDPNeighborSpec{
+			LinkName:            "eth0",
+			ChassisID:           "0c:26:b4:a5:f1:00",
+			PortID:              "Eth1/12",
+			SystemName:          "tor-a1.dc",
+			ManagementAddresses: []netip.Addr{netip.MustParseAddr("192.168.0.10")},
+			PortVLANID:          100,
+			VLANList:            []network.LLDPVLAN{{ID: 100, Name: "servers"}},
+			TTL:                 2 * time.Minute,
+		}, *neighbor.TypedSpec())
+	})
+
+	// link goes down, neighbor is removed
+	link.TypedSpec().OperationalState = nethelpers.OperStateDown
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), link))
+
+	ctest.AssertNoResource[*network.LLDPNeighbor](suite, "eth0")
+}
+
+func TestLLDPSuite(t *testing.T) {
+	s := &LLDPSuite{
+		connCh: make(chan *lldpConn, 4),
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 5 * time.Second,
+		AfterSetup: func(*ctest.DefaultSuite) {
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.LLDPController{
+				Listen: func(string, lldp.Spec) (net.PacketConn, error) {
+					conn := &lldpConn{
+						rxCh:   make(chan []byte),
+						txCh:   make(chan []byte, 1),
+						closed: make(chan struct{}),
+					}
+
+					s.connCh <- conn
+
+					return conn, nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -8,8 +8,12 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"net"
 	"net/netip"
+	"net/url"
 	"slices"
+	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -19,17 +23,35 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/pkg/dns"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
-// IngressChainName is the name of the ingress chain.
-const IngressChainName = "ingress"
+// Names of the chains generated from the machine configuration.
+const (
+	IngressChainName = "ingress"
+	EgressChainName  = "egress"
+	ForwardChainName = "forward"
+	SNATChainName    = "snat"
+	DNATChainName    = "dnat"
+)
+
+// egressResolveInterval is the interval between the lookups of the essential egress destinations.
+const egressResolveInterval = time.Minute
 
 // NfTablesChainConfigController generates nftables rules based on machine configuration.
-type NfTablesChainConfigController struct{}
+type NfTablesChainConfigController struct {
+	// LookupNetIP overrides the host resolver (used in tests).
+	LookupNetIP func(ctx context.Context, network, host string) ([]netip.Addr, error)
+
+	// resolved keeps the last successfully resolved addresses by the hostname.
+	resolved map[string][]netip.Addr
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *NfTablesChainConfigController) Name() string {
@@ -45,6 +67,28 @@ func (ctrl *NfTablesChainConfigController) Inputs() []controller.Input {
 			ID:        optional.Some(config.V1Alpha1ID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.EndpointType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ResolverStatusType,
+			ID:        optional.Some(network.ResolverID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.TimeServerStatusType,
+			ID:        optional.Some(network.TimeServerID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.OperatorSpecType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -61,12 +105,17 @@ func (ctrl *NfTablesChainConfigController) Outputs() []controller.Output {
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo
-func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) (err error) {
+func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) (err error) {
+	// the essential egress destinations specified by the hostname are looked up periodically
+	ticker := time.NewTicker(egressResolveInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-ticker.C:
 		}
 
 		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
@@ -209,40 +258,45 @@ func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller
 						}
 					}
 
-					for _, rule := range cfg.Config().NetworkRules().Rules() {
-						portRanges := rule.PortRanges()
+					spec.Rules = append(spec.Rules, firewallRules(cfg.Config().NetworkRules().Rules(), defaultAction, false)...)
 
-						// sort port ranges, machine config validation ensures that there are no overlaps
-						slices.SortFunc(portRanges, func(a, b [2]uint16) int {
-							return cmp.Compare(a[0], b[0])
-						})
+					if cfg.Config().NetworkRules().LogDrops() {
+						logDrops(IngressChainName, spec)
+					}
 
-						// if default accept, drop anything that doesn't match the rule
-						verdict := nethelpers.VerdictDrop
+					return nil
+				}); err != nil {
+				return err
+			}
+		}
 
-						if defaultAction == nethelpers.DefaultActionBlock {
-							verdict = nethelpers.VerdictAccept
-						}
+		if cfg != nil && !(cfg.Config().NetworkRules().EgressDefaultAction() == nethelpers.DefaultActionAccept && cfg.Config().NetworkRules().EgressRules() == nil) {
+			essentials, err := ctrl.egressEssentials(ctx, r, cfg.Config(), logger)
+			if err != nil {
+				return err
+			}
 
-						spec.Rules = append(spec.Rules,
-							network.NfTablesRule{
-								MatchSourceAddress: &network.NfTablesAddressMatch{
-									IncludeSubnets: rule.Subnets(),
-									ExcludeSubnets: rule.ExceptSubnets(),
-									Invert:         defaultAction == nethelpers.DefaultActionAccept,
-								},
-								MatchLayer4: &network.NfTablesLayer4Match{
-									Protocol: rule.Protocol(),
-									MatchDestinationPort: &network.NfTablesPortMatch{
-										Ranges: xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
-											return network.PortRange{Lo: pr[0], Hi: pr[1]}
-										}),
-									},
-								},
-								AnonCounter: true,
-								Verdict:     pointer.To(verdict),
-							},
-						)
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, EgressChainName),
+				func(chain *network.NfTablesChain) error {
+					buildEgressChain(cfg.Config(), essentials, chain.TypedSpec())
+
+					if cfg.Config().NetworkRules().LogDrops() {
+						logDrops(EgressChainName, chain.TypedSpec())
+					}
+
+					return nil
+				}); err != nil {
+				return err
+			}
+		}
+
+		if cfg != nil && !(cfg.Config().NetworkRules().ForwardDefaultAction() == nethelpers.DefaultActionAccept && cfg.Config().NetworkRules().ForwardRules() == nil) {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, ForwardChainName),
+				func(chain *network.NfTablesChain) error {
+					buildForwardChain(cfg.Config(), chain.TypedSpec())
+
+					if cfg.Config().NetworkRules().LogDrops() {
+						logDrops(ForwardChainName, chain.TypedSpec())
 					}
 
 					return nil
@@ -251,8 +305,712 @@ func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller
 			}
 		}
 
+		if cfg != nil {
+			snatRules, dnatRules := natRules(cfg.Config().NetworkRules().NATRules())
+
+			if snatRules != nil {
+				if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, SNATChainName),
+					func(chain *network.NfTablesChain) error {
+						spec := chain.TypedSpec()
+
+						spec.Type = nethelpers.ChainTypeNAT
+						spec.Hook = nethelpers.ChainHookPostrouting
+						spec.Priority = nethelpers.ChainPriorityNATSource
+						spec.Policy = nethelpers.VerdictAccept
+						spec.Rules = snatRules
+
+						return nil
+					}); err != nil {
+					return err
+				}
+			}
+
+			if dnatRules != nil {
+				if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, DNATChainName),
+					func(chain *network.NfTablesChain) error {
+						spec := chain.TypedSpec()
+
+						spec.Type = nethelpers.ChainTypeNAT
+						spec.Hook = nethelpers.ChainHookPrerouting
+						spec.Priority = nethelpers.ChainPriorityNATDest
+						spec.Policy = nethelpers.VerdictAccept
+						spec.Rules = dnatRules
+
+						return nil
+					}); err != nil {
+					return err
+				}
+			}
+		}
+
 		if err = safe.CleanupOutputs[*network.NfTablesChain](ctx, r); err != nil {
 			return err
 		}
 	}
 }
+
+// egressEssentials describes the destinations the host should always be able to reach.
+type egressEssentials struct {
+	// controlPlaneAddrs are the addresses of the control plane nodes (Kubernetes API and etcd peers).
+	controlPlaneAddrs []netip.Addr
+	// endpointAddrs are the addresses of the control plane endpoint host (which might be a load balancer or a VIP).
+	endpointAddrs []netip.Addr
+	// discoveryAddrs are the addresses of the discovery service.
+	discoveryAddrs []netip.Addr
+	dnsServers     []netip.Addr
+	// encryptedDNSUpstreams are the DNS-over-TLS and DNS-over-HTTPS upstreams of the host DNS.
+	encryptedDNSUpstreams []netip.AddrPort
+	timeServers           []string
+	// dhcp4Links and dhcp6Links are the links with the active DHCP operators.
+	dhcp4Links []string
+	dhcp6Links []string
+}
+
+func (ctrl *NfTablesChainConfigController) egressEssentials(
+	ctx context.Context,
+	r controller.Reader,
+	cfg talosconfig.Config,
+	logger *zap.Logger,
+) (egressEssentials, error) {
+	var essentials egressEssentials
+
+	endpoints, err := safe.ReaderListAll[*k8s.Endpoint](ctx, r)
+	if err != nil {
+		return essentials, fmt.Errorf("error listing endpoints: %w", err)
+	}
+
+	var endpointAddrs k8s.EndpointList
+
+	for endpoint := range endpoints.All() {
+		endpointAddrs = endpointAddrs.Merge(endpoint)
+	}
+
+	essentials.controlPlaneAddrs = endpointAddrs
+
+	if cfg.Cluster() != nil {
+		// the control plane endpoint is required to join the cluster, so it is allowed even
+		// before the control plane endpoints are discovered
+		if endpoint := cfg.Cluster().Endpoint(); endpoint != nil {
+			essentials.endpointAddrs = ctrl.lookupHost(ctx, endpoint.Hostname(), logger)
+		}
+
+		if discovery := cfg.Cluster().Discovery(); discovery.Enabled() && discovery.Registries().Service().Enabled() {
+			if u, err := url.ParseRequestURI(discovery.Registries().Service().Endpoint()); err == nil {
+				essentials.discoveryAddrs = ctrl.lookupHost(ctx, u.Hostname(), logger)
+			}
+		}
+	}
+
+	resolverStatus, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return essentials, fmt.Errorf("error getting resolver status: %w", err)
+	}
+
+	for _, upstream := range cfg.NetworkDNSUpstreamConfigs() {
+		addrPort, err := dns.EncryptedUpstreamAddrPort(upstream.URL())
+		if err != nil {
+			// the upstream is not used by the host DNS either
+			continue
+		}
+
+		essentials.encryptedDNSUpstreams = append(essentials.encryptedDNSUpstreams, addrPort)
+	}
+
+	if resolverStatus != nil {
+		essentials.dnsServers = resolverStatus.TypedSpec().DNSServers
+	}
+
+	timeServerStatus, err := safe.ReaderGetByID[*network.TimeServerStatus](ctx, r, network.TimeServerID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return essentials, fmt.Errorf("error getting time server status: %w", err)
+	}
+
+	if timeServerStatus != nil {
+		essentials.timeServers = timeServerStatus.TypedSpec().NTPServers
+	}
+
+	operatorSpecs, err := safe.ReaderListAll[*network.OperatorSpec](ctx, r)
+	if err != nil {
+		return essentials, fmt.Errorf("error listing operator specs: %w", err)
+	}
+
+	for operatorSpec := range operatorSpecs.All() {
+		switch operatorSpec.TypedSpec().Operator {
+		case network.OperatorDHCP4:
+			essentials.dhcp4Links = append(essentials.dhcp4Links, operatorSpec.TypedSpec().LinkName)
+		case network.OperatorDHCP6:
+			essentials.dhcp6Links = append(essentials.dhcp6Links, operatorSpec.TypedSpec().LinkName)
+		case network.OperatorVIP:
+			// VIP operators don't need any essential egress traffic
+		}
+	}
+
+	for _, links := range []*[]string{&essentials.dhcp4Links, &essentials.dhcp6Links} {
+		slices.Sort(*links)
+		*links = slices.Compact(*links)
+	}
+
+	return essentials, nil
+}
+
+// lookupHost returns the addresses of the host, which might be an IP address or a hostname.
+//
+// On lookup errors the last resolved addresses are returned, the lookup is retried on the next reconcile.
+func (ctrl *NfTablesChainConfigController) lookupHost(ctx context.Context, host string, logger *zap.Logger) []netip.Addr {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr.Unmap()}
+	}
+
+	lookupNetIP := ctrl.LookupNetIP
+	if lookupNetIP == nil {
+		lookupNetIP = net.DefaultResolver.LookupNetIP
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	addrs, err := lookupNetIP(lookupCtx, "ip", host)
+	if err != nil {
+		logger.Warn("failed to resolve essential egress destination", zap.String("host", host), zap.Error(err))
+
+		return ctrl.resolved[host]
+	}
+
+	addrs = xslices.Map(addrs, netip.Addr.Unmap)
+
+	slices.SortFunc(addrs, netip.Addr.Compare)
+
+	addrs = slices.Compact(addrs)
+
+	if ctrl.resolved == nil {
+		ctrl.resolved = map[string][]netip.Addr{}
+	}
+
+	ctrl.resolved[host] = addrs
+
+	return addrs
+}
+
+// buildEgressChain builds the chain filtering the traffic originating from the host.
+func buildEgressChain(cfg talosconfig.Config, essentials egressEssentials, spec *network.NfTablesChainSpec) {
+	spec.Type = nethelpers.ChainTypeFilter
+	spec.Hook = nethelpers.ChainHookOutput
+	spec.Priority = nethelpers.ChainPriorityFilter
+	spec.Policy = nethelpers.VerdictAccept
+
+	// preamble
+	spec.Rules = []network.NfTablesRule{
+		// trusted interfaces: loopback, siderolink and kubespan
+		{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{
+					"lo",
+					constants.SideroLinkName,
+					constants.KubeSpanLinkName,
+				},
+				Operator: nethelpers.OperatorEqual,
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		},
+	}
+
+	defaultAction := cfg.NetworkRules().EgressDefaultAction()
+
+	if defaultAction == nethelpers.DefaultActionBlock {
+		spec.Policy = nethelpers.VerdictDrop
+
+		spec.Rules = append(spec.Rules, conntrackRules()...)
+		spec.Rules = append(spec.Rules,
+			// allow ICMP and ICMPv6 explicitly, ICMPv6 is required for the neighbor discovery
+			network.NfTablesRule{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMP,
+				},
+				AnonCounter: true,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			},
+			network.NfTablesRule{
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolICMPv6,
+				},
+				AnonCounter: true,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			},
+		)
+
+		if cfg.Cluster() != nil {
+			spec.Rules = append(spec.Rules,
+				// allow traffic to Kubernetes pods/services
+				network.NfTablesRule{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: clusterSubnets(cfg),
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+			)
+		}
+
+		spec.Rules = append(spec.Rules, essentialEgressRules(cfg, essentials)...)
+	}
+
+	spec.Rules = append(spec.Rules, firewallRules(cfg.NetworkRules().EgressRules(), defaultAction, true)...)
+}
+
+// essentialEgressRules allows the traffic required for the node to function with the default-deny egress policy:
+// DHCP servers, DNS resolvers, encrypted DNS upstreams, time servers, KubeSpan peers, the control plane endpoint
+// (Kubernetes API and trustd), the control plane nodes (Kubernetes API, trustd and etcd peers) and the discovery service.
+//
+//nolint:gocyclo
+func essentialEgressRules(cfg talosconfig.Config, essentials egressEssentials) []network.NfTablesRule {
+	var rules []network.NfTablesRule
+
+	// DHCP clients broadcast (or multicast) the requests, but the leases are renewed with unicast requests to the server,
+	// so the destination is not restricted
+	for _, dhcp := range []struct {
+		links []string
+		port  uint16
+	}{
+		{links: essentials.dhcp4Links, port: 67},
+		{links: essentials.dhcp6Links, port: 547},
+	} {
+		if len(dhcp.links) == 0 {
+			continue
+		}
+
+		rules = append(rules, network.NfTablesRule{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: dhcp.links,
+				Operator:       nethelpers.OperatorEqual,
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: dhcp.port, Hi: dhcp.port}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	}
+
+	if len(essentials.dnsServers) > 0 {
+		for _, protocol := range []nethelpers.Protocol{nethelpers.ProtocolUDP, nethelpers.ProtocolTCP} {
+			rules = append(rules,
+				network.NfTablesRule{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: addrPrefixes(essentials.dnsServers),
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: protocol,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{{Lo: 53, Hi: 53}},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+			)
+		}
+	}
+
+	// encrypted DNS upstreams are reached over TCP on the DNS-over-TLS or DNS-over-HTTPS port
+	for _, upstream := range essentials.encryptedDNSUpstreams {
+		rules = append(rules, tcpEgressRule([]netip.Addr{upstream.Addr()}, [][2]uint16{{upstream.Port(), upstream.Port()}}))
+	}
+
+	if len(essentials.timeServers) > 0 {
+		ntpRule := network.NfTablesRule{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 123, Hi: 123}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		}
+
+		// time servers specified by the hostname resolve to the addresses which change over time,
+		// so the destination is restricted only if all time servers are IP addresses
+		timeServerAddrs := make([]netip.Addr, 0, len(essentials.timeServers))
+
+		for _, server := range essentials.timeServers {
+			addr, err := netip.ParseAddr(server)
+			if err != nil {
+				timeServerAddrs = nil
+
+				break
+			}
+
+			timeServerAddrs = append(timeServerAddrs, addr)
+		}
+
+		if timeServerAddrs != nil {
+			ntpRule.MatchDestinationAddress = &network.NfTablesAddressMatch{
+				IncludeSubnets: addrPrefixes(timeServerAddrs),
+			}
+		}
+
+		rules = append(rules, ntpRule)
+	}
+
+	// KubeSpan peers are reached over the physical links, the encrypted traffic is sent from the WireGuard listen port
+	// to the endpoints of the peers
+	if cfg.Machine() != nil && cfg.Machine().Network().KubeSpan().Enabled() {
+		rules = append(rules, network.NfTablesRule{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchSourcePort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: constants.KubeSpanDefaultPort, Hi: constants.KubeSpanDefaultPort}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	}
+
+	if cfg.Cluster() == nil {
+		return rules
+	}
+
+	endpointPort, hasEndpointPort := urlPort(cfg.Cluster().Endpoint())
+
+	if len(essentials.endpointAddrs) > 0 {
+		portRanges := [][2]uint16{{constants.TrustdPort, constants.TrustdPort}}
+
+		if hasEndpointPort {
+			portRanges = append(portRanges, [2]uint16{endpointPort, endpointPort})
+		}
+
+		rules = append(rules, tcpEgressRule(essentials.endpointAddrs, portRanges))
+	}
+
+	if len(essentials.controlPlaneAddrs) > 0 {
+		portRanges := [][2]uint16{
+			{uint16(cfg.Cluster().LocalAPIServerPort()), uint16(cfg.Cluster().LocalAPIServerPort())},
+			{constants.EtcdClientPort, constants.EtcdPeerPort},
+			{constants.TrustdPort, constants.TrustdPort},
+		}
+
+		if hasEndpointPort {
+			portRanges = append(portRanges, [2]uint16{endpointPort, endpointPort})
+		}
+
+		rules = append(rules, tcpEgressRule(essentials.controlPlaneAddrs, portRanges))
+	}
+
+	if len(essentials.discoveryAddrs) > 0 {
+		if u, err := url.ParseRequestURI(cfg.Cluster().Discovery().Registries().Service().Endpoint()); err == nil {
+			if discoveryPort, ok := urlPort(u); ok {
+				rules = append(rules, tcpEgressRule(essentials.discoveryAddrs, [][2]uint16{{discoveryPort, discoveryPort}}))
+			}
+		}
+	}
+
+	return rules
+}
+
+// tcpEgressRule accepts the TCP traffic to the addresses and the port ranges.
+func tcpEgressRule(addrs []netip.Addr, portRanges [][2]uint16) network.NfTablesRule {
+	slices.SortFunc(portRanges, func(a, b [2]uint16) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	portRanges = slices.Compact(portRanges)
+
+	return network.NfTablesRule{
+		MatchDestinationAddress: &network.NfTablesAddressMatch{
+			IncludeSubnets: addrPrefixes(addrs),
+		},
+		MatchLayer4: &network.NfTablesLayer4Match{
+			Protocol: nethelpers.ProtocolTCP,
+			MatchDestinationPort: &network.NfTablesPortMatch{
+				Ranges: portMatchRanges(portRanges),
+			},
+		},
+		AnonCounter: true,
+		Verdict:     pointer.To(nethelpers.VerdictAccept),
+	}
+}
+
+// urlPort returns the port of the URL, defaulting to the well-known port of the scheme.
+func urlPort(u *url.URL) (uint16, bool) {
+	if u == nil {
+		return 0, false
+	}
+
+	if u.Port() == "" {
+		if u.Scheme == "http" {
+			return 80, true
+		}
+
+		return 443, true
+	}
+
+	port, err := strconv.ParseUint(u.Port(), 10, 16)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint16(port), true
+}
+
+func addrPrefixes(addrs []netip.Addr) []netip.Prefix {
+	return xslices.Map(addrs, func(addr netip.Addr) netip.Prefix {
+		return netip.PrefixFrom(addr, addr.BitLen())
+	})
+}
+
+// buildForwardChain builds the chain filtering the traffic forwarded by the host.
+func buildForwardChain(cfg talosconfig.Config, spec *network.NfTablesChainSpec) {
+	spec.Type = nethelpers.ChainTypeFilter
+	spec.Hook = nethelpers.ChainHookForward
+	spec.Priority = nethelpers.ChainPriorityFilter
+	spec.Policy = nethelpers.VerdictAccept
+
+	// preamble
+	spec.Rules = []network.NfTablesRule{
+		// trusted interfaces: siderolink and kubespan
+		{
+			MatchIIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{
+					constants.SideroLinkName,
+					constants.KubeSpanLinkName,
+				},
+				Operator: nethelpers.OperatorEqual,
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		},
+	}
+
+	defaultAction := cfg.NetworkRules().ForwardDefaultAction()
+
+	if defaultAction == nethelpers.DefaultActionBlock {
+		spec.Policy = nethelpers.VerdictDrop
+
+		spec.Rules = append(spec.Rules, conntrackRules()...)
+
+		if cfg.Cluster() != nil {
+			spec.Rules = append(spec.Rules,
+				// allow Kubernetes pod/service traffic, it is filtered with the Kubernetes network policies
+				network.NfTablesRule{
+					MatchSourceAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: clusterSubnets(cfg),
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				network.NfTablesRule{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: clusterSubnets(cfg),
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+			)
+		}
+	}
+
+	spec.Rules = append(spec.Rules, firewallRules(cfg.NetworkRules().ForwardRules(), defaultAction, false)...)
+}
+
+// logDrops adds rate-limited logging of the dropped packets to the chain.
+//
+// Each rule dropping the packets is preceded by a copy of the rule which logs the packets instead,
+// and the packets dropped by the chain policy are logged by the rule at the end of the chain.
+func logDrops(chainName string, spec *network.NfTablesChainSpec) {
+	logRule := func(rule network.NfTablesRule) network.NfTablesRule {
+		rule.AnonCounter = false
+		rule.Verdict = nil
+		rule.MatchLimit = &network.NfTablesLimitMatch{
+			PacketRatePerSecond: constants.NfTablesLogRate,
+		}
+		rule.Log = &network.NfTablesLog{
+			Prefix: chainName,
+			Group:  constants.NfTablesLogGroup,
+		}
+
+		return rule
+	}
+
+	rules := make([]network.NfTablesRule, 0, len(spec.Rules)+1)
+
+	for _, rule := range spec.Rules {
+		if rule.Verdict != nil && *rule.Verdict == nethelpers.VerdictDrop {
+			rules = append(rules, logRule(rule))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if spec.Policy == nethelpers.VerdictDrop {
+		rules = append(rules, logRule(network.NfTablesRule{}))
+	}
+
+	spec.Rules = rules
+}
+
+// conntrackRules accepts the established connections and drops the invalid packets.
+func conntrackRules() []network.NfTablesRule {
+	return []network.NfTablesRule{
+		{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateEstablished,
+					nethelpers.ConntrackStateRelated,
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		},
+		{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateInvalid,
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictDrop),
+		},
+	}
+}
+
+func clusterSubnets(cfg talosconfig.Config) []netip.Prefix {
+	return xslices.Map(
+		slices.Concat(cfg.Cluster().Network().PodCIDRs(), cfg.Cluster().Network().ServiceCIDRs()),
+		netip.MustParsePrefix,
+	)
+}
+
+// firewallRules converts the firewall rules to the nftables rules.
+//
+// The subnets of the rule match the source address, or the destination address if matchDestination is set.
+func firewallRules(rules []talosconfig.NetworkRule, defaultAction nethelpers.DefaultAction, matchDestination bool) []network.NfTablesRule {
+	result := make([]network.NfTablesRule, 0, len(rules))
+
+	for _, rule := range rules {
+		portRanges := rule.PortRanges()
+
+		// sort port ranges, machine config validation ensures that there are no overlaps
+		slices.SortFunc(portRanges, func(a, b [2]uint16) int {
+			return cmp.Compare(a[0], b[0])
+		})
+
+		// if default accept, drop anything that doesn't match the rule
+		verdict := nethelpers.VerdictDrop
+
+		if defaultAction == nethelpers.DefaultActionBlock {
+			verdict = nethelpers.VerdictAccept
+		}
+
+		addressMatch := &network.NfTablesAddressMatch{
+			IncludeSubnets: rule.Subnets(),
+			ExcludeSubnets: rule.ExceptSubnets(),
+			Invert:         defaultAction == nethelpers.DefaultActionAccept,
+		}
+
+		nfRule := network.NfTablesRule{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: rule.Protocol(),
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: portMatchRanges(portRanges),
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(verdict),
+		}
+
+		if matchDestination {
+			nfRule.MatchDestinationAddress = addressMatch
+		} else {
+			nfRule.MatchSourceAddress = addressMatch
+		}
+
+		result = append(result, nfRule)
+	}
+
+	return result
+}
+
+// natRules converts the NAT rules to the nftables rules for the source NAT and destination NAT chains.
+func natRules(rules []talosconfig.NetworkNATRule) (snatRules, dnatRules []network.NfTablesRule) {
+	for _, rule := range rules {
+		nfRule := network.NfTablesRule{
+			AnonCounter: true,
+		}
+
+		if iif, ok := rule.InputLink().Get(); ok {
+			nfRule.MatchIIfName = &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{iif},
+				Operator:       nethelpers.OperatorEqual,
+			}
+		}
+
+		if oif, ok := rule.OutputLink().Get(); ok {
+			nfRule.MatchOIfName = &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{oif},
+				Operator:       nethelpers.OperatorEqual,
+			}
+		}
+
+		if source, ok := rule.Source().Get(); ok {
+			nfRule.MatchSourceAddress = &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{source},
+			}
+		}
+
+		if destination, ok := rule.Destination().Get(); ok {
+			nfRule.MatchDestinationAddress = &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{destination},
+			}
+		}
+
+		if protocol, ok := rule.Protocol().Get(); ok {
+			portRanges := rule.PortRanges()
+
+			slices.SortFunc(portRanges, func(a, b [2]uint16) int {
+				return cmp.Compare(a[0], b[0])
+			})
+
+			nfRule.MatchLayer4 = &network.NfTablesLayer4Match{
+				Protocol: protocol,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: portMatchRanges(portRanges),
+				},
+			}
+		}
+
+		if target, ok := rule.DNAT().Get(); ok {
+			nfRule.DNAT = &network.NfTablesNATTarget{
+				Address: target.Addr(),
+				Port:    target.Port(),
+			}
+
+			dnatRules = append(dnatRules, nfRule)
+
+			continue
+		}
+
+		if target, ok := rule.SNAT().Get(); ok {
+			nfRule.SNAT = &network.NfTablesNATTarget{
+				Address: target.Addr(),
+				Port:    target.Port(),
+			}
+		} else {
+			nfRule.Masquerade = rule.Masquerade()
+		}
+
+		snatRules = append(snatRules, nfRule)
+	}
+
+	return snatRules, dnatRules
+}
+
+func portMatchRanges(portRanges [][2]uint16) []network.PortRange {
+	return xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
+		return network.PortRange{Lo: pr[0], Hi: pr[1]}
+	})
+}
@@ -5,7 +5,10 @@
 package network_test
 
 import (
+	"context"
+	"net"
 	"net/netip"
+	"net/url"
 	"testing"
 	"time"
 
@@ -18,9 +21,11 @@ import (
 	configtypes "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -271,12 +276,655 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 	})
 }
 
+func (suite *NfTablesChainConfigTestSuite) TestEgressBlock() {
+	httpsEgressCfg := networkcfg.NewRuleConfigV1Alpha1()
+	httpsEgressCfg.MetaName = "https-egress"
+	httpsEgressCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 443,
+			Hi: 443,
+		},
+	}
+	httpsEgressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	httpsEgressCfg.Egress = []networkcfg.EgressRule{
+		{
+			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+		},
+	}
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	cfg, err := container.New(httpsEgressCfg, defaultActionCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeFilter, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityFilter, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookOutput, spec.Hook)
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchOIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{
+							"lo",
+							constants.SideroLinkName,
+							constants.KubeSpanLinkName,
+						},
+						Operator: nethelpers.OperatorEqual,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateEstablished,
+							nethelpers.ConntrackStateRelated,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictDrop),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMP,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMPv6,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.0.0.0/8"),
+						},
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolTCP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{
+								{
+									Lo: 443,
+									Hi: 443,
+								},
+							},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+			},
+			spec.Rules)
+	})
+
+	// the egress-only rule doesn't generate ingress or forward chains
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.IngressChainName)
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.ForwardChainName)
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgressBlockEssentials() {
+	u, err := url.Parse("https://cp.example.com:8443")
+	suite.Require().NoError(err)
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		defaultActionCfg,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	resolverStatus := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolverStatus.TypedSpec().DNSServers = []netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("2606:4700:4700::1111")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), resolverStatus))
+
+	timeServerStatus := network.NewTimeServerStatus(network.NamespaceName, network.TimeServerID)
+	timeServerStatus.TypedSpec().NTPServers = []string{"192.168.0.1"}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), timeServerStatus))
+
+	cpEndpoint := k8s.NewEndpoint(k8s.ControlPlaneNamespaceName, k8s.ControlPlaneKubernetesEndpointsID)
+	cpEndpoint.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr("10.5.0.100")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cpEndpoint))
+
+	discoveredEndpoint := k8s.NewEndpoint(k8s.ControlPlaneNamespaceName, k8s.ControlPlaneDiscoveredEndpointsID)
+	discoveredEndpoint.TypedSpec().Addresses = []netip.Addr{netip.MustParseAddr("10.5.0.2"), netip.MustParseAddr("10.5.0.3")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), discoveredEndpoint))
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		dnsServers := []netip.Prefix{
+			netip.MustParsePrefix("1.1.1.1/32"),
+			netip.MustParsePrefix("2606:4700:4700::1111/128"),
+		}
+
+		for _, protocol := range []nethelpers.Protocol{nethelpers.ProtocolUDP, nethelpers.ProtocolTCP} {
+			asrt.Contains(spec.Rules, network.NfTablesRule{
+				MatchDestinationAddress: &network.NfTablesAddressMatch{
+					IncludeSubnets: dnsServers,
+				},
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: protocol,
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: []network.PortRange{{Lo: 53, Hi: 53}},
+					},
+				},
+				AnonCounter: true,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			})
+		}
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchDestinationAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{netip.MustParsePrefix("192.168.0.1/32")},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 123, Hi: 123}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchDestinationAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("10.5.0.2/32"),
+					netip.MustParsePrefix("10.5.0.3/32"),
+					netip.MustParsePrefix("10.5.0.100/32"),
+				},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{
+						{Lo: constants.EtcdClientPort, Hi: constants.EtcdPeerPort},
+						{Lo: constants.DefaultControlPlanePort, Hi: constants.DefaultControlPlanePort},
+						{Lo: 8443, Hi: 8443},
+						{Lo: constants.TrustdPort, Hi: constants.TrustdPort},
+					},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	})
+
+	// time servers specified by the hostname allow NTP traffic to any destination
+	ctest.UpdateWithConflicts(suite, timeServerStatus, func(status *network.TimeServerStatus) error {
+		status.TypedSpec().NTPServers = []string{"time.cloudflare.com", "192.168.0.1"}
+
+		return nil
+	})
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		asrt.Contains(chain.TypedSpec().Rules, network.NfTablesRule{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 123, Hi: 123}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgressBlockEndpointHost() {
+	u, err := url.Parse("https://vip.example.com:6443")
+	suite.Require().NoError(err)
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				ClusterDiscoveryConfig: &v1alpha1.ClusterDiscoveryConfig{
+					DiscoveryEnabled: pointer.To(true),
+					DiscoveryRegistries: v1alpha1.DiscoveryRegistriesConfig{
+						RegistryService: v1alpha1.RegistryServiceConfig{
+							RegistryEndpoint: "https://discovery.example.com/",
+						},
+					},
+				},
+			},
+		},
+		defaultActionCfg,
+	)
+	suite.Require().NoError(err)
+
+	// no control plane endpoints are known yet, e.g. on the first boot of a worker node
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchDestinationAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("10.5.0.50/32"),
+					netip.MustParsePrefix("fd00::50/128"),
+				},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{
+						{Lo: 6443, Hi: 6443},
+						{Lo: constants.TrustdPort, Hi: constants.TrustdPort},
+					},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchDestinationAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{netip.MustParsePrefix("10.6.0.1/32")},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 443, Hi: 443}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgressBlockEncryptedDNS() {
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	dotUpstreamCfg := networkcfg.NewDNSUpstreamConfigV1Alpha1()
+	dotUpstreamCfg.MetaName = "cloudflare"
+	dotUpstreamCfg.UpstreamURL = "tls://1.1.1.1"
+
+	dohUpstreamCfg := networkcfg.NewDNSUpstreamConfigV1Alpha1()
+	dohUpstreamCfg.MetaName = "quad9"
+	dohUpstreamCfg.UpstreamURL = "https://[2620:fe::fe]/dns-query"
+
+	cfg, err := container.New(defaultActionCfg, dotUpstreamCfg, dohUpstreamCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		for _, upstream := range []netip.AddrPort{
+			netip.MustParseAddrPort("1.1.1.1:853"),
+			netip.MustParseAddrPort("[2620:fe::fe]:443"),
+		} {
+			asrt.Contains(spec.Rules, network.NfTablesRule{
+				MatchDestinationAddress: &network.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{netip.PrefixFrom(upstream.Addr(), upstream.Addr().BitLen())},
+				},
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolTCP,
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: []network.PortRange{{Lo: upstream.Port(), Hi: upstream.Port()}},
+					},
+				},
+				AnonCounter: true,
+				Verdict:     pointer.To(nethelpers.VerdictAccept),
+			})
+		}
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgressBlockDHCPKubeSpan() {
+	u, err := url.Parse("https://10.5.0.1:6443")
+	suite.Require().NoError(err)
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	cfg, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkKubeSpan: &v1alpha1.NetworkKubeSpan{
+						KubeSpanEnabled: pointer.To(true),
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		defaultActionCfg,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	for _, operator := range []struct {
+		id       string
+		operator network.Operator
+		link     string
+	}{
+		{id: "dhcp4/eth0", operator: network.OperatorDHCP4, link: "eth0"},
+		{id: "dhcp4/eth1", operator: network.OperatorDHCP4, link: "eth1"},
+		{id: "dhcp6/eth0", operator: network.OperatorDHCP6, link: "eth0"},
+		{id: "vip/eth0", operator: network.OperatorVIP, link: "eth0"},
+	} {
+		operatorSpec := network.NewOperatorSpec(network.NamespaceName, operator.id)
+		operatorSpec.TypedSpec().Operator = operator.operator
+		operatorSpec.TypedSpec().LinkName = operator.link
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), operatorSpec))
+	}
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{"eth0", "eth1"},
+				Operator:       nethelpers.OperatorEqual,
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 67, Hi: 67}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{"eth0"},
+				Operator:       nethelpers.OperatorEqual,
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 547, Hi: 547}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+
+		asrt.Contains(spec.Rules, network.NfTablesRule{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchSourcePort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: constants.KubeSpanDefaultPort, Hi: constants.KubeSpanDefaultPort}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		})
+	})
+
+	// DHCP traffic is no longer allowed once the operators are stopped
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), network.NewOperatorSpec(network.NamespaceName, "dhcp6/eth0").Metadata()))
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		for _, rule := range chain.TypedSpec().Rules {
+			if rule.MatchLayer4 != nil && rule.MatchLayer4.MatchDestinationPort != nil {
+				asrt.NotEqual([]network.PortRange{{Lo: 547, Hi: 547}}, rule.MatchLayer4.MatchDestinationPort.Ranges)
+			}
+		}
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestLogDrops() {
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Forward = nethelpers.DefaultActionBlock
+	defaultActionCfg.LogDroppedPackets = true
+
+	cfg, err := container.New(defaultActionCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, netctrl.ForwardChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainHookForward, spec.Hook)
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchIIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{
+							constants.SideroLinkName,
+							constants.KubeSpanLinkName,
+						},
+						Operator: nethelpers.OperatorEqual,
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateEstablished,
+							nethelpers.ConntrackStateRelated,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					MatchLimit: &network.NfTablesLimitMatch{
+						PacketRatePerSecond: constants.NfTablesLogRate,
+					},
+					Log: &network.NfTablesLog{
+						Prefix: netctrl.ForwardChainName,
+						Group:  constants.NfTablesLogGroup,
+					},
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     pointer.To(nethelpers.VerdictDrop),
+				},
+				{
+					MatchLimit: &network.NfTablesLimitMatch{
+						PacketRatePerSecond: constants.NfTablesLogRate,
+					},
+					Log: &network.NfTablesLog{
+						Prefix: netctrl.ForwardChainName,
+						Group:  constants.NfTablesLogGroup,
+					},
+				},
+			},
+			spec.Rules)
+	})
+
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.IngressChainName)
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.EgressChainName)
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestNAT() {
+	masqueradeCfg := networkcfg.NewNATConfigV1Alpha1()
+	masqueradeCfg.MetaName = "masquerade"
+	masqueradeCfg.NATOutLink = "eth0"
+	masqueradeCfg.NATSource = networkcfg.Prefix{Prefix: netip.MustParsePrefix("10.10.0.0/24")}
+	masqueradeCfg.NATMasquerade = true
+
+	dnatCfg := networkcfg.NewNATConfigV1Alpha1()
+	dnatCfg.MetaName = "web"
+	dnatCfg.NATInLink = "eth0"
+	dnatCfg.NATPortSelector = &networkcfg.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports: networkcfg.PortRanges{
+			{Lo: 443, Hi: 443},
+		},
+	}
+	dnatCfg.NATDNAT = &networkcfg.NATTarget{
+		NATAddress: networkcfg.Addr{Addr: netip.MustParseAddr("10.10.0.5")},
+		NATPort:    8443,
+	}
+
+	cfg, err := container.New(masqueradeCfg, dnatCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, netctrl.SNATChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeNAT, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityNATSource, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookPostrouting, spec.Hook)
+		asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchOIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{"eth0"},
+						Operator:       nethelpers.OperatorEqual,
+					},
+					MatchSourceAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.10.0.0/24"),
+						},
+					},
+					AnonCounter: true,
+					Masquerade:  true,
+				},
+			},
+			spec.Rules)
+	})
+
+	ctest.AssertResource(suite, netctrl.DNATChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeNAT, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityNATDest, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookPrerouting, spec.Hook)
+		asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchIIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{"eth0"},
+						Operator:       nethelpers.OperatorEqual,
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolTCP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{
+								{
+									Lo: 443,
+									Hi: 443,
+								},
+							},
+						},
+					},
+					AnonCounter: true,
+					DNAT: &network.NfTablesNATTarget{
+						Address: netip.MustParseAddr("10.10.0.5"),
+						Port:    8443,
+					},
+				},
+			},
+			spec.Rules)
+	})
+}
+
+func lookupNetIP(hosts map[string][]netip.Addr) func(context.Context, string, string) ([]netip.Addr, error) {
+	return func(_ context.Context, _, host string) ([]netip.Addr, error) {
+		addrs, ok := hosts[host]
+		if !ok {
+			return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		}
+
+		return addrs, nil
+	}
+}
+
 func TestNfTablesChainConfig(t *testing.T) {
 	suite.Run(t, &NfTablesChainConfigTestSuite{
 		DefaultSuite: ctest.DefaultSuite{
 			Timeout: 5 * time.Second,
 			AfterSetup: func(s *ctest.DefaultSuite) {
-				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainConfigController{}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainConfigController{
+					LookupNetIP: lookupNetIP(map[string][]netip.Addr{
+						"vip.example.com":       {netip.MustParseAddr("fd00::50"), netip.MustParseAddr("10.5.0.50")},
+						"discovery.example.com": {netip.MustParseAddr("10.6.0.1")},
+					}),
+				}))
 			},
 		},
 	})
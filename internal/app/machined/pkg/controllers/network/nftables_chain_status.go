@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"go.uber.org/zap"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DefaultNfTablesChainStatusUpdateInterval is the default interval for refreshing nftables chain counters.
+const DefaultNfTablesChainStatusUpdateInterval = 10 * time.Second
+
+// NfTablesChainStatusController reports the counters of the nftables chains applied to the kernel.
+type NfTablesChainStatusController struct {
+	TableName string
+	Interval  time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesChainStatusController) Name() string {
+	return "network.NfTablesChainStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesChainStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NfTablesChainType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesChainStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.NfTablesChainStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NfTablesChainStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	if ctrl.TableName == "" {
+		ctrl.TableName = constants.DefaultNfTablesTableName
+	}
+
+	interval := ctrl.Interval
+	if interval == 0 {
+		interval = DefaultNfTablesChainStatusUpdateInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		list, err := safe.ReaderListAll[*network.NfTablesChain](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing nftables chains: %w", err)
+		}
+
+		var conn nftables.Conn
+
+		existingChains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+		if err != nil {
+			return fmt.Errorf("error listing existing nftables chains: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for chain := range list.All() {
+			idx := slices.IndexFunc(existingChains, func(c *nftables.Chain) bool {
+				return c.Table.Name == ctrl.TableName && c.Name == chain.Metadata().ID()
+			})
+			if idx == -1 {
+				// the chain is not applied yet
+				continue
+			}
+
+			kernelRules, err := conn.GetRules(existingChains[idx].Table, existingChains[idx])
+			if err != nil {
+				return fmt.Errorf("error listing nftables rules for chain %s: %w", chain.Metadata().ID(), err)
+			}
+
+			rules, err := nfTablesRuleStatuses(chain.TypedSpec().Rules, kernelRules)
+			if err != nil {
+				return fmt.Errorf("error building nftables rule status for chain %s: %w", chain.Metadata().ID(), err)
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChainStatus(network.NamespaceName, chain.Metadata().ID()),
+				func(res *network.NfTablesChainStatus) error {
+					spec := res.TypedSpec()
+
+					spec.Type = chain.TypedSpec().Type
+					spec.Hook = chain.TypedSpec().Hook
+					spec.Priority = chain.TypedSpec().Priority
+					spec.Policy = chain.TypedSpec().Policy
+					spec.Rules = rules
+
+					return nil
+				}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.NfTablesChainStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// nfTablesRuleStatuses matches the kernel rules to the spec rules and collects the counters.
+//
+// Each spec rule is compiled to zero or more kernel rules, so the kernel rules are matched in order
+// by the number of the compiled rules. If the kernel rules don't match the spec (e.g. the chain is being updated),
+// the counters are not reported.
+func nfTablesRuleStatuses(specRules []network.NfTablesRule, kernelRules []*nftables.Rule) ([]network.NfTablesRuleStatus, error) {
+	statuses := make([]network.NfTablesRuleStatus, len(specRules))
+	compiledCounts := make([]int, len(specRules))
+	totalCompiled := 0
+
+	for i := range specRules {
+		statuses[i].Rule = specRules[i]
+
+		compiled, err := networkadapter.NfTablesRule(&specRules[i]).Compile()
+		if err != nil {
+			return nil, err
+		}
+
+		compiledCounts[i] = len(compiled.Rules)
+		totalCompiled += compiledCounts[i]
+	}
+
+	if totalCompiled != len(kernelRules) {
+		return statuses, nil
+	}
+
+	for i, count := range compiledCounts {
+		for _, kernelRule := range kernelRules[:count] {
+			for _, e := range kernelRule.Exprs {
+				if counter, ok := e.(*expr.Counter); ok {
+					statuses[i].Packets += counter.Packets
+					statuses[i].Bytes += counter.Bytes
+				}
+			}
+		}
+
+		kernelRules = kernelRules[count:]
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type NfTablesChainStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (s *NfTablesChainStatusSuite) TestCounters() {
+	chain := network.NewNfTablesChain(network.NamespaceName, "test-output")
+	chain.TypedSpec().Type = nethelpers.ChainTypeFilter
+	chain.TypedSpec().Hook = nethelpers.ChainHookOutput
+	chain.TypedSpec().Priority = nethelpers.ChainPriorityFilter
+	chain.TypedSpec().Policy = nethelpers.VerdictAccept
+	chain.TypedSpec().Rules = []network.NfTablesRule{
+		{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{"lo"},
+				Operator:       nethelpers.OperatorEqual,
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolUDP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{{Lo: 9999, Hi: 9999}},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     pointer.To(nethelpers.VerdictAccept),
+		},
+	}
+
+	s.Require().NoError(s.State().Create(s.Ctx(), chain))
+
+	ctest.AssertResource(s, "test-output", func(status *network.NfTablesChainStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.ChainHookOutput, status.TypedSpec().Hook)
+		asrt.Len(status.TypedSpec().Rules, 1)
+	})
+
+	conn, err := net.Dial("udp", "127.0.0.1:9999")
+	s.Require().NoError(err)
+
+	_, err = conn.Write([]byte("ping"))
+	s.Require().NoError(err)
+
+	s.Require().NoError(conn.Close())
+
+	ctest.AssertResource(s, "test-output", func(status *network.NfTablesChainStatus, asrt *assert.Assertions) {
+		if !asrt.Len(status.TypedSpec().Rules, 1) {
+			return
+		}
+
+		asrt.Equal(chain.TypedSpec().Rules[0], status.TypedSpec().Rules[0].Rule)
+		asrt.EqualValues(1, status.TypedSpec().Rules[0].Packets)
+		asrt.NotZero(status.TypedSpec().Rules[0].Bytes)
+	})
+
+	s.Require().NoError(s.State().Destroy(s.Ctx(), chain.Metadata()))
+
+	ctest.AssertNoResource[*network.NfTablesChainStatus](s, "test-output")
+}
+
+func TestNfTablesChainStatusSuite(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	suite.Run(t, &NfTablesChainStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainController{TableName: "talos-status-test"}))
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.NfTablesChainStatusController{
+					TableName: "talos-status-test",
+					Interval:  100 * time.Millisecond,
+				}))
+			},
+			AfterTearDown: func(s *ctest.DefaultSuite) {
+				var conn nftables.Conn
+
+				conn.DelTable(&nftables.Table{
+					Family: nftables.TableFamilyINet,
+					Name:   "talos-status-test",
+				})
+
+				s.Require().NoError(conn.Flush())
+			},
+		},
+	})
+}
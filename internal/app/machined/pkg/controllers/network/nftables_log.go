@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/nflog"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// NfTablesLogController logs the packets dropped by the nftables chains.
+//
+// The packets are sent to the netfilter log group by the rules generated by the NfTablesChainConfigController.
+type NfTablesLogController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NfTablesLogController) Name() string {
+	return "network.NfTablesLogController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NfTablesLogController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NfTablesLogController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NfTablesLogController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		conn *nflog.Conn
+		wg   sync.WaitGroup
+	)
+
+	stop := func() {
+		if conn == nil {
+			return
+		}
+
+		conn.Close() //nolint:errcheck
+		wg.Wait()
+
+		conn = nil
+	}
+
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		enabled := cfg != nil && cfg.Config().NetworkRules().LogDrops()
+
+		switch {
+		case enabled && conn == nil:
+			conn, err = nflog.Dial(constants.NfTablesLogGroup)
+			if err != nil {
+				return err
+			}
+
+			wg.Add(1)
+
+			go func(conn *nflog.Conn) {
+				defer wg.Done()
+
+				for {
+					packets, err := conn.Receive()
+					if err != nil {
+						// the connection is closed
+						return
+					}
+
+					for _, packet := range packets {
+						logDroppedPacket(logger, packet)
+					}
+				}
+			}(conn)
+		case !enabled && conn != nil:
+			stop()
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func logDroppedPacket(logger *zap.Logger, packet nflog.Packet) {
+	fields := []zap.Field{
+		zap.String("chain", packet.Prefix),
+		zap.Stringer("protocol", packet.Protocol),
+		zap.Stringer("source", packet.Source),
+		zap.Stringer("destination", packet.Destination),
+	}
+
+	if packet.SourcePort != 0 || packet.DestinationPort != 0 {
+		fields = append(fields,
+			zap.Uint16("source_port", packet.SourcePort),
+			zap.Uint16("destination_port", packet.DestinationPort),
+		)
+	}
+
+	for _, link := range []struct {
+		key     string
+		ifIndex uint32
+	}{
+		{"in", packet.InIfIndex},
+		{"out", packet.OutIfIndex},
+	} {
+		if link.ifIndex == 0 {
+			continue
+		}
+
+		if iface, err := net.InterfaceByIndex(int(link.ifIndex)); err == nil {
+			fields = append(fields, zap.String(link.key, iface.Name))
+		}
+	}
+
+	logger.Info("packet dropped", fields...)
+}
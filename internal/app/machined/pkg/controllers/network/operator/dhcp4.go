@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -33,10 +34,16 @@ type DHCP4 struct {
 	logger *zap.Logger
 	state  state.State
 
-	linkName            string
-	routeMetric         uint32
-	skipHostnameRequest bool
-	requestMTU          bool
+	linkName                string
+	routeMetric             uint32
+	routeProbe              string
+	routeProbeFailureMetric uint32
+	skipHostnameRequest     bool
+	requestMTU              bool
+	clientIdentifier        string
+	vendorClass             string
+	userClass               []string
+	requestedOptions        []uint8
 
 	lease *nclient4.Lease
 
@@ -52,11 +59,17 @@ type DHCP4 struct {
 // NewDHCP4 creates DHCPv4 operator.
 func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorSpec, platform runtime.Platform, state state.State) *DHCP4 {
 	return &DHCP4{
-		logger:              logger,
-		state:               state,
-		linkName:            linkName,
-		routeMetric:         config.RouteMetric,
-		skipHostnameRequest: config.SkipHostnameRequest,
+		logger:                  logger,
+		state:                   state,
+		linkName:                linkName,
+		routeMetric:             config.RouteMetric,
+		routeProbe:              config.RouteProbe,
+		routeProbeFailureMetric: config.RouteProbeFailureMetric,
+		skipHostnameRequest:     config.SkipHostnameRequest,
+		clientIdentifier:        config.ClientIdentifier,
+		vendorClass:             config.VendorClass,
+		userClass:               config.UserClass,
+		requestedOptions:        config.RequestedOptions,
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
@@ -294,6 +307,11 @@ func (d *DHCP4) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP4) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	return nil
+}
+
 //nolint:gocyclo
 func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool) {
 	d.mu.Lock()
@@ -350,6 +368,9 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 				Type:        nethelpers.TypeUnicast,
 				Protocol:    nethelpers.ProtocolBoot,
 				ConfigLayer: network.ConfigOperator,
+
+				Probe:              d.routeProbe,
+				ProbeFailureMetric: d.routeProbeFailureMetric,
 			})
 		}
 	} else {
@@ -367,6 +388,9 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 				Type:        nethelpers.TypeUnicast,
 				Protocol:    nethelpers.ProtocolBoot,
 				ConfigLayer: network.ConfigOperator,
+
+				Probe:              d.routeProbe,
+				ProbeFailureMetric: d.routeProbeFailureMetric,
 			})
 
 			if !addr.Contains(gw) {
@@ -411,7 +435,13 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 		}
 	}
 
-	if len(ack.DNS()) > 0 {
+	var searchDomains []string
+
+	if ack.DomainSearch() != nil {
+		searchDomains = ack.DomainSearch().Labels
+	}
+
+	if len(ack.DNS()) > 0 || len(searchDomains) > 0 {
 		convertIP := func(ip net.IP) netip.Addr {
 			result, _ := netipx.FromStdIP(ip)
 
@@ -420,8 +450,9 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 
 		d.resolvers = []network.ResolverSpecSpec{
 			{
-				DNSServers:  xslices.Map(ack.DNS(), convertIP),
-				ConfigLayer: network.ConfigOperator,
+				DNSServers:    xslices.Map(ack.DNS(), convertIP),
+				SearchDomains: searchDomains,
+				ConfigLayer:   network.ConfigOperator,
 			},
 		}
 	} else {
@@ -477,7 +508,6 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	opts := []dhcpv4.OptionCode{
 		dhcpv4.OptionClasslessStaticRoute,
 		dhcpv4.OptionDomainNameServer,
-		// TODO(twelho): This is unused until network.ResolverSpec supports search domains
 		dhcpv4.OptionDNSDomainSearchList,
 		dhcpv4.OptionNTPServers,
 	}
@@ -510,8 +540,19 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 		opts = append(opts, dhcpv4.OptionHostName, dhcpv4.OptionDomainName)
 	}
 
+	for _, code := range d.requestedOptions {
+		opts = append(opts, dhcpv4.GenericOptionCode(code))
+	}
+
 	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...)}
 
+	clientMods, err := d.clientModifiers()
+	if err != nil {
+		return 0, err
+	}
+
+	mods = append(mods, clientMods...)
+
 	if !sendHostnameRequest {
 		// If the node has a hostname, always send it to the DHCP
 		// server with option 12 during lease acquisition and renewal
@@ -558,6 +599,40 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 	return d.lease.ACK.IPAddressLeaseTime(time.Minute * 30), nil
 }
 
+// clientModifiers returns the modifiers which identify the client to the DHCP server.
+func (d *DHCP4) clientModifiers() ([]dhcpv4.Modifier, error) {
+	var mods []dhcpv4.Modifier
+
+	switch d.clientIdentifier {
+	case "":
+	case "mac":
+		iface, err := net.InterfaceByName(d.linkName)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up link for the client identifier: %w", err)
+		}
+
+		// RFC 2132, section 9.14: hardware type (Ethernet) followed by the hardware address
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(append([]byte{1}, iface.HardwareAddr...))))
+	default:
+		clientID, err := hex.DecodeString(strings.ReplaceAll(d.clientIdentifier, ":", ""))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client identifier: %w", err)
+		}
+
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClientIdentifier(clientID)))
+	}
+
+	if d.vendorClass != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClassIdentifier(d.vendorClass)))
+	}
+
+	if len(d.userClass) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptRFC3004UserClass(d.userClass)))
+	}
+
+	return mods, nil
+}
+
 func collapseSummary(summary string) string {
 	lines := strings.Split(summary, "\n")[1:]
 
@@ -6,6 +6,7 @@ package operator
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/dhcpv6/nclient6"
+	"github.com/insomniacslk/dhcp/iana"
 	"github.com/jsimonetti/rtnetlink/v2"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-retry/retry"
@@ -35,12 +37,16 @@ type DHCP6 struct {
 	linkName            string
 	duid                []byte
 	skipHostnameRequest bool
-
-	mu          sync.Mutex
-	addresses   []network.AddressSpecSpec
-	hostname    []network.HostnameSpecSpec
-	resolvers   []network.ResolverSpecSpec
-	timeservers []network.TimeServerSpecSpec
+	prefixDelegation    bool
+	prefixLength        int
+	prefixAssignments   []network.DHCP6PrefixAssignment
+
+	mu                sync.Mutex
+	addresses         []network.AddressSpecSpec
+	hostname          []network.HostnameSpecSpec
+	resolvers         []network.ResolverSpecSpec
+	timeservers       []network.TimeServerSpecSpec
+	delegatedPrefixes []network.DelegatedPrefixSpec
 }
 
 // NewDHCP6 creates DHCPv6 operator.
@@ -52,6 +58,9 @@ func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorS
 		linkName:            linkName,
 		duid:                duidBin,
 		skipHostnameRequest: config.SkipHostnameRequest,
+		prefixDelegation:    config.PrefixDelegation,
+		prefixLength:        config.PrefixLength,
+		prefixAssignments:   config.PrefixAssignments,
 	}
 }
 
@@ -147,6 +156,15 @@ func (d *DHCP6) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP6) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.delegatedPrefixes
+}
+
+//nolint:gocyclo,cyclop
 func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -173,7 +191,34 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 		d.addresses = nil
 	}
 
-	if len(reply.Options.DNS()) > 0 {
+	d.delegatedPrefixes = nil
+
+	if d.prefixDelegation && reply.Options.OneIAPD() != nil {
+		for _, iaPrefix := range reply.Options.OneIAPD().Options.Prefixes() {
+			if iaPrefix.Prefix == nil || iaPrefix.ValidLifetime == 0 {
+				continue
+			}
+
+			prefix, ok := netipx.FromStdIPNet(iaPrefix.Prefix)
+			if !ok {
+				continue
+			}
+
+			d.delegatedPrefixes = append(d.delegatedPrefixes, d.assignDelegatedPrefix(prefix.Masked(), iaPrefix.PreferredLifetime, iaPrefix.ValidLifetime))
+
+			if leaseTime == 0 || iaPrefix.ValidLifetime < leaseTime {
+				leaseTime = iaPrefix.ValidLifetime
+			}
+		}
+	}
+
+	var searchDomains []string
+
+	if reply.Options.DomainSearchList() != nil {
+		searchDomains = reply.Options.DomainSearchList().Labels
+	}
+
+	if len(reply.Options.DNS()) > 0 || len(searchDomains) > 0 {
 		convertIP := func(ip net.IP) netip.Addr {
 			result, _ := netipx.FromStdIP(ip)
 
@@ -182,8 +227,9 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 
 		d.resolvers = []network.ResolverSpecSpec{
 			{
-				DNSServers:  xslices.Map(reply.Options.DNS(), convertIP),
-				ConfigLayer: network.ConfigOperator,
+				DNSServers:    xslices.Map(reply.Options.DNS(), convertIP),
+				SearchDomains: searchDomains,
+				ConfigLayer:   network.ConfigOperator,
 			},
 		}
 	} else {
@@ -222,6 +268,77 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	return leaseTime
 }
 
+// assignDelegatedPrefix carves the configured subnets out of the delegated prefix.
+//
+// The first address of each subnet assigned to a link is added to the link (must be called with d.mu held).
+func (d *DHCP6) assignDelegatedPrefix(prefix netip.Prefix, preferredLifetime, validLifetime time.Duration) network.DelegatedPrefixSpec {
+	spec := network.DelegatedPrefixSpec{
+		LinkName:          d.linkName,
+		Prefix:            prefix,
+		PreferredLifetime: preferredLifetime,
+		ValidLifetime:     validLifetime,
+	}
+
+	for _, assignment := range d.prefixAssignments {
+		subnet, err := subnetPrefix(prefix, assignment.SubnetID, assignment.PrefixLength)
+		if err != nil {
+			d.logger.Warn("failed to assign delegated prefix", zap.Error(err), zap.String("link", d.linkName), zap.Stringer("prefix", prefix))
+
+			continue
+		}
+
+		spec.Assignments = append(spec.Assignments, network.DelegatedPrefixAssignment{
+			Prefix:         subnet,
+			LinkName:       assignment.LinkName,
+			NodeAnnotation: assignment.NodeAnnotation,
+		})
+
+		if assignment.LinkName == "" {
+			continue
+		}
+
+		d.addresses = append(d.addresses, network.AddressSpecSpec{
+			Address:     netip.PrefixFrom(subnet.Addr().Next(), subnet.Bits()),
+			LinkName:    assignment.LinkName,
+			Family:      nethelpers.FamilyInet6,
+			Scope:       nethelpers.ScopeGlobal,
+			Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+			ConfigLayer: network.ConfigOperator,
+		})
+	}
+
+	return spec
+}
+
+// subnetPrefix returns the subnet with the specified ID and length within the prefix.
+func subnetPrefix(prefix netip.Prefix, subnetID uint64, bits int) (netip.Prefix, error) {
+	if bits < prefix.Bits() || bits > 128 {
+		return netip.Prefix{}, fmt.Errorf("subnet length /%d doesn't fit into %s", bits, prefix)
+	}
+
+	if subnetBits := bits - prefix.Bits(); subnetBits < 64 && subnetID >= 1<<subnetBits {
+		return netip.Prefix{}, fmt.Errorf("subnet ID %d doesn't fit into %s with subnet length /%d", subnetID, prefix, bits)
+	}
+
+	addr := prefix.Masked().Addr().As16()
+
+	hi := binary.BigEndian.Uint64(addr[:8])
+	lo := binary.BigEndian.Uint64(addr[8:])
+
+	if shift := 128 - bits; shift >= 64 {
+		hi |= subnetID << (shift - 64)
+	} else {
+		lo |= subnetID << shift
+		hi |= subnetID >> (64 - shift)
+	}
+
+	binary.BigEndian.PutUint64(addr[:8], hi)
+	binary.BigEndian.PutUint64(addr[8:], lo)
+
+	return netip.PrefixFrom(netip.AddrFrom16(addr), bits), nil
+}
+
+//nolint:gocyclo
 func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 	cli, err := nclient6.New(d.linkName)
 	if err != nil {
@@ -241,6 +358,41 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 		}
 	}
 
+	if d.prefixDelegation {
+		iface, ierr := net.InterfaceByName(d.linkName)
+		if ierr != nil {
+			return 0, fmt.Errorf("error looking up link: %w", ierr)
+		}
+
+		if len(modifiers) == 0 {
+			// the delegated prefix is bound to the DUID, so use a stable one (the default DUID-LLT changes on every request)
+			modifiers = append(modifiers, dhcpv6.WithClientID(&dhcpv6.DUIDLL{
+				HWType:        iana.HWTypeEthernet,
+				LinkLayerAddr: iface.HardwareAddr,
+			}))
+		}
+
+		// use the same IAID as the IA_NA (last 4 bytes of the hardware address)
+		var iaid [4]byte
+
+		if len(iface.HardwareAddr) >= 4 {
+			copy(iaid[:], iface.HardwareAddr[len(iface.HardwareAddr)-4:])
+		}
+
+		var hints []*dhcpv6.OptIAPrefix
+
+		if d.prefixLength > 0 {
+			hints = append(hints, &dhcpv6.OptIAPrefix{
+				Prefix: &net.IPNet{
+					IP:   net.IPv6zero,
+					Mask: net.CIDRMask(d.prefixLength, 128),
+				},
+			})
+		}
+
+		modifiers = append(modifiers, dhcpv6.WithIAPD(iaid, hints...))
+	}
+
 	reply, err := cli.RapidSolicit(ctx, modifiers...)
 	if err != nil {
 		return 0, err
@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/rfc1035label"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestSubnetPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		prefix   string
+		subnetID uint64
+		bits     int
+
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "first",
+			prefix:   "2001:db8:0:100::/56",
+			subnetID: 0,
+			bits:     64,
+			expected: "2001:db8:0:100::/64",
+		},
+		{
+			name:     "last",
+			prefix:   "2001:db8:0:100::/56",
+			subnetID: 255,
+			bits:     64,
+			expected: "2001:db8:0:1ff::/64",
+		},
+		{
+			name:     "low half",
+			prefix:   "2001:db8::/48",
+			subnetID: 0x10001,
+			bits:     80,
+			expected: "2001:db8:0:1:1::/80",
+		},
+		{
+			name:     "single address",
+			prefix:   "2001:db8::/64",
+			subnetID: 5,
+			bits:     128,
+			expected: "2001:db8::5/128",
+		},
+		{
+			name:          "subnet ID too big",
+			prefix:        "2001:db8:0:100::/60",
+			subnetID:      16,
+			bits:          64,
+			expectedError: "subnet ID 16 doesn't fit into 2001:db8:0:100::/60 with subnet length /64",
+		},
+		{
+			name:          "subnet too short",
+			prefix:        "2001:db8:0:100::/60",
+			bits:          56,
+			expectedError: "subnet length /56 doesn't fit into 2001:db8:0:100::/60",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			subnet, err := operator.SubnetPrefix(netip.MustParsePrefix(test.prefix), test.subnetID, test.bits)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, subnet.String())
+		})
+	}
+}
+
+func TestDHCP6ParseReplyPrefixDelegation(t *testing.T) {
+	t.Parallel()
+
+	d := operator.NewDHCP6(zaptest.NewLogger(t), "eth0", network.DHCP6OperatorSpec{
+		PrefixDelegation: true,
+		PrefixLength:     56,
+		PrefixAssignments: []network.DHCP6PrefixAssignment{
+			{
+				LinkName:     "eth1",
+				SubnetID:     1,
+				PrefixLength: 64,
+			},
+			{
+				NodeAnnotation: "example.com/pod-cidr",
+				SubnetID:       2,
+				PrefixLength:   64,
+			},
+			{
+				// doesn't fit into the delegated prefix, skipped
+				LinkName:     "eth2",
+				SubnetID:     256,
+				PrefixLength: 64,
+			},
+		},
+	})
+
+	reply, err := dhcpv6.NewMessage()
+	require.NoError(t, err)
+
+	reply.MessageType = dhcpv6.MessageTypeReply
+
+	reply.AddOption(&dhcpv6.OptIANA{
+		Options: dhcpv6.IdentityOptions{
+			Options: dhcpv6.Options{
+				&dhcpv6.OptIAAddress{
+					IPv6Addr:          net.ParseIP("2001:db8::10"),
+					PreferredLifetime: time.Hour,
+					ValidLifetime:     2 * time.Hour,
+				},
+			},
+		},
+	})
+	reply.AddOption(&dhcpv6.OptIAPD{
+		Options: dhcpv6.PDOptions{
+			Options: dhcpv6.Options{
+				&dhcpv6.OptIAPrefix{
+					PreferredLifetime: 30 * time.Minute,
+					ValidLifetime:     time.Hour,
+					Prefix: &net.IPNet{
+						IP:   net.ParseIP("2001:db8:0:100::"),
+						Mask: net.CIDRMask(56, 128),
+					},
+				},
+			},
+		},
+	})
+	reply.AddOption(dhcpv6.OptDNS(net.ParseIP("2001:db8::53")))
+	reply.AddOption(dhcpv6.OptDomainSearchList(&rfc1035label.Labels{Labels: []string{"example.com"}}))
+
+	// the shortest lifetime wins
+	assert.Equal(t, time.Hour, d.ParseReply(reply))
+
+	assert.Equal(t,
+		[]netip.Prefix{
+			netip.MustParsePrefix("2001:db8::10/128"),
+			netip.MustParsePrefix("2001:db8:0:101::1/64"),
+		},
+		[]netip.Prefix{d.AddressSpecs()[0].Address, d.AddressSpecs()[1].Address},
+	)
+	assert.Equal(t, "eth0", d.AddressSpecs()[0].LinkName)
+	assert.Equal(t, "eth1", d.AddressSpecs()[1].LinkName)
+
+	assert.Equal(t, []network.DelegatedPrefixSpec{
+		{
+			LinkName:          "eth0",
+			Prefix:            netip.MustParsePrefix("2001:db8:0:100::/56"),
+			PreferredLifetime: 30 * time.Minute,
+			ValidLifetime:     time.Hour,
+			Assignments: []network.DelegatedPrefixAssignment{
+				{
+					Prefix:   netip.MustParsePrefix("2001:db8:0:101::/64"),
+					LinkName: "eth1",
+				},
+				{
+					Prefix:         netip.MustParsePrefix("2001:db8:0:102::/64"),
+					NodeAnnotation: "example.com/pod-cidr",
+				},
+			},
+		},
+	}, d.DelegatedPrefixes())
+
+	require.Len(t, d.ResolverSpecs(), 1)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("2001:db8::53")}, d.ResolverSpecs()[0].DNSServers)
+	assert.Equal(t, []string{"example.com"}, d.ResolverSpecs()[0].SearchDomains)
+}
@@ -0,0 +1,22 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operator
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+)
+
+// SubnetPrefix is exported for testing.
+func SubnetPrefix(prefix netip.Prefix, subnetID uint64, bits int) (netip.Prefix, error) {
+	return subnetPrefix(prefix, subnetID, bits)
+}
+
+// ParseReply is exported for testing.
+func (d *DHCP6) ParseReply(reply *dhcpv6.Message) time.Duration {
+	return d.parseReply(reply)
+}
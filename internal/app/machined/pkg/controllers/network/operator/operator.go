@@ -24,4 +24,6 @@ type Operator interface {
 	HostnameSpecs() []network.HostnameSpecSpec
 	ResolverSpecs() []network.ResolverSpecSpec
 	TimeServerSpecs() []network.TimeServerSpecSpec
+
+	DelegatedPrefixes() []network.DelegatedPrefixSpec
 }
@@ -7,18 +7,21 @@ package network
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -35,6 +38,12 @@ func (ctrl *OperatorConfigController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *OperatorConfigController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.DeviceConfigSpecType,
@@ -145,7 +154,9 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						LinkName:  device.Interface(),
 						RequireUp: true,
 						DHCP4: network.DHCP4OperatorSpec{
-							RouteMetric: routeMetric,
+							RouteMetric:             routeMetric,
+							RouteProbe:              device.DHCPOptions().Probe(),
+							RouteProbeFailureMetric: device.DHCPOptions().ProbeFailureMetric(),
 						},
 						ConfigLayer: network.ConfigMachineConfiguration,
 					})
@@ -181,7 +192,9 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 							LinkName:  nethelpers.VLANLinkName(device.Interface(), vlan.ID()),
 							RequireUp: true,
 							DHCP4: network.DHCP4OperatorSpec{
-								RouteMetric: routeMetric,
+								RouteMetric:             routeMetric,
+								RouteProbe:              vlan.DHCPOptions().Probe(),
+								RouteProbeFailureMetric: vlan.DHCPOptions().ProbeFailureMetric(),
 							},
 							ConfigLayer: network.ConfigMachineConfiguration,
 						})
@@ -208,6 +221,29 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
+		// operators from the DHCP config documents, applied after the devices, so that they take precedence
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg != nil && (len(cfg.Config().NetworkDHCPv4Configs()) > 0 || len(cfg.Config().NetworkDHCPv6Configs()) > 0) {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			for _, spec := range ctrl.processDHCPConfigs(cfg.Config(), resolver) {
+				if _, ignore := ignoredInterfaces[spec.LinkName]; ignore {
+					continue
+				}
+
+				specs = append(specs, spec)
+			}
+		}
+
 		// build configuredInterfaces from linkSpecs in `network-config` namespace
 		// any link which has any configuration derived from the machine configuration or platform configuration should be ignored
 		configuredInterfaces := map[string]struct{}{}
@@ -297,6 +333,88 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 	}
 }
 
+// processDHCPConfigs converts the DHCP config documents to operator specs.
+func (ctrl *OperatorConfigController) processDHCPConfigs(cfg talosconfig.Config, resolver *linkResolver) (specs []network.OperatorSpecSpec) {
+	for _, dhcpConfig := range cfg.NetworkDHCPv4Configs() {
+		linkName, ok := resolver.Resolve(dhcpConfig.Name())
+		if !ok {
+			continue
+		}
+
+		routeMetric := dhcpConfig.RouteMetric()
+		if routeMetric == 0 {
+			routeMetric = network.DefaultRouteMetric
+		}
+
+		specs = append(specs, network.OperatorSpecSpec{
+			Operator:  network.OperatorDHCP4,
+			LinkName:  linkName,
+			RequireUp: true,
+			DHCP4: network.DHCP4OperatorSpec{
+				RouteMetric:         routeMetric,
+				SkipHostnameRequest: dhcpConfig.IgnoreHostname(),
+				ClientIdentifier:    dhcpConfig.ClientIdentifier(),
+				VendorClass:         dhcpConfig.VendorClass(),
+				UserClass:           dhcpConfig.UserClass(),
+				RequestedOptions:    dhcpConfig.RequestedOptions(),
+
+				RouteProbe:              dhcpConfig.Probe().ValueOrZero(),
+				RouteProbeFailureMetric: dhcpConfig.ProbeFailureMetric().ValueOrZero(),
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		})
+	}
+
+	for _, dhcpConfig := range cfg.NetworkDHCPv6Configs() {
+		linkName, ok := resolver.Resolve(dhcpConfig.Name())
+		if !ok {
+			continue
+		}
+
+		routeMetric := dhcpConfig.RouteMetric()
+		if routeMetric == 0 {
+			routeMetric = network.DefaultRouteMetric
+		}
+
+		spec := network.OperatorSpecSpec{
+			Operator:  network.OperatorDHCP6,
+			LinkName:  linkName,
+			RequireUp: true,
+			DHCP6: network.DHCP6OperatorSpec{
+				DUID:                strings.ReplaceAll(dhcpConfig.DUID(), ":", ""),
+				RouteMetric:         routeMetric,
+				SkipHostnameRequest: dhcpConfig.IgnoreHostname(),
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}
+
+		if pd, ok := dhcpConfig.PrefixDelegation().Get(); ok {
+			spec.DHCP6.PrefixDelegation = true
+			spec.DHCP6.PrefixLength = pd.PrefixLength()
+
+			for _, assignment := range pd.Assignments() {
+				prefixAssignment := network.DHCP6PrefixAssignment{
+					NodeAnnotation: assignment.NodeAnnotation(),
+					SubnetID:       assignment.SubnetID(),
+					PrefixLength:   assignment.PrefixLength(),
+				}
+
+				if assignment.Link() != "" {
+					if prefixAssignment.LinkName, ok = resolver.Resolve(assignment.Link()); !ok {
+						continue
+					}
+				}
+
+				spec.DHCP6.PrefixAssignments = append(spec.DHCP6.PrefixAssignments, prefixAssignment)
+			}
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
 //nolint:dupl
 func (ctrl *OperatorConfigController) apply(ctx context.Context, r controller.Runtime, specs []network.OperatorSpecSpec) ([]resource.ID, error) {
 	ids := make([]string, 0, len(specs))
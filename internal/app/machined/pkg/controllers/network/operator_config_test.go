@@ -27,6 +27,7 @@ import (
 
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -422,6 +423,122 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationDHCP6() {
 	)
 }
 
+func (suite *OperatorConfigSuite) TestMachineConfigurationDHCPDocuments() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.OperatorConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	dhcp4Cfg := networkcfg.NewDHCPv4ConfigV1Alpha1()
+	dhcp4Cfg.MetaName = "eth1"
+	dhcp4Cfg.DHCPClientIdentifier = networkcfg.ClientIdentifierMAC
+	dhcp4Cfg.DHCPVendorClass = "talos"
+	dhcp4Cfg.DHCPUserClass = []string{"edge"}
+	dhcp4Cfg.DHCPRequestedOptions = []uint8{42}
+	dhcp4Cfg.DHCPProbe = "uplink"
+	dhcp4Cfg.DHCPProbeFailureMetric = 4096
+
+	dhcp6Cfg := networkcfg.NewDHCPv6ConfigV1Alpha1()
+	dhcp6Cfg.MetaName = "eth2"
+	dhcp6Cfg.DHCPRouteMetric = 512
+	dhcp6Cfg.DHCPDUID = "00:03:00:01:52:54:00:12:34:56"
+	dhcp6Cfg.DHCPPrefixDelegation = &networkcfg.DHCPv6PrefixDelegationConfig{
+		PDPrefixLength: 56,
+		PDAssignments: []networkcfg.DHCPv6PrefixAssignmentConfig{
+			{
+				AssignmentLink:     "eth3",
+				AssignmentSubnetID: 1,
+			},
+			{
+				AssignmentNodeAnnotation: "example.com/pod-cidr",
+				AssignmentSubnetID:       2,
+				AssignmentPrefixLength:   80,
+			},
+		},
+	}
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkInterfaces: []*v1alpha1.Device{
+						{
+							DeviceInterface: "eth1",
+							DeviceDHCP:      pointer.To(true),
+							DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+								DHCPRouteMetric: 256,
+							},
+						},
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+		dhcp4Cfg,
+		dhcp6Cfg,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	suite.assertOperators(
+		[]string{
+			"configuration/dhcp4/eth1",
+			"configuration/dhcp6/eth2",
+		}, func(r *network.OperatorSpec, asrt *assert.Assertions) {
+			asrt.True(r.TypedSpec().RequireUp)
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+
+			switch r.Metadata().ID() {
+			case "configuration/dhcp4/eth1":
+				// the document takes precedence over the v1alpha1 device config
+				asrt.Equal(network.OperatorDHCP4, r.TypedSpec().Operator)
+				asrt.Equal("eth1", r.TypedSpec().LinkName)
+				asrt.Equal(network.DHCP4OperatorSpec{
+					RouteMetric:      network.DefaultRouteMetric,
+					ClientIdentifier: "mac",
+					VendorClass:      "talos",
+					UserClass:        []string{"edge"},
+					RequestedOptions: []uint8{42},
+
+					RouteProbe:              "uplink",
+					RouteProbeFailureMetric: 4096,
+				}, r.TypedSpec().DHCP4)
+			case "configuration/dhcp6/eth2":
+				asrt.Equal(network.OperatorDHCP6, r.TypedSpec().Operator)
+				asrt.Equal("eth2", r.TypedSpec().LinkName)
+				asrt.Equal(network.DHCP6OperatorSpec{
+					DUID:             "00030001525400123456",
+					RouteMetric:      512,
+					PrefixDelegation: true,
+					PrefixLength:     56,
+					PrefixAssignments: []network.DHCP6PrefixAssignment{
+						{
+							LinkName:     "eth3",
+							SubnetID:     1,
+							PrefixLength: 64,
+						},
+						{
+							NodeAnnotation: "example.com/pod-cidr",
+							SubnetID:       2,
+							PrefixLength:   80,
+						},
+					},
+				}, r.TypedSpec().DHCP6)
+			}
+		},
+	)
+}
+
 func (suite *OperatorConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -7,6 +7,7 @@ package network_test
 
 import (
 	"context"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
@@ -287,7 +288,7 @@ func (suite *OperatorMergeSuite) TestMergeFlapping() {
 							return retry.ExpectedErrorf("resource phase is %s", r.Metadata().Phase())
 						}
 
-						if *override.TypedSpec() != *r.TypedSpec() {
+						if !reflect.DeepEqual(*override.TypedSpec(), *r.TypedSpec()) {
 							// using retry here, as it might not be reconciled immediately
 							return retry.ExpectedErrorf("not equal yet")
 						}
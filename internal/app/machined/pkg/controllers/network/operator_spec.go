@@ -81,6 +81,10 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.DelegatedPrefixType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -196,7 +200,12 @@ func (ctrl *OperatorSpecController) reconcileOperators(ctx context.Context, r co
 	for _, item := range list.Items {
 		linkStatus := item.(*network.LinkStatus) //nolint:errcheck,forcetypeassert
 
-		linkStatuses[linkStatus.Metadata().ID()] = linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUnknown || linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUp
+		operUp := linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUnknown || linkStatus.TypedSpec().OperationalState == nethelpers.OperStateUp
+
+		// with 802.1X enabled, the link is considered up only once the port is authorized
+		authorized := linkStatus.TypedSpec().Dot1XState == nethelpers.Dot1XStateDisabled || linkStatus.TypedSpec().Dot1XState == nethelpers.Dot1XStateAuthenticated
+
+		linkStatuses[linkStatus.Metadata().ID()] = operUp && authorized
 	}
 
 	// list operator specs
@@ -366,18 +375,36 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		for _, delegatedPrefix := range op.Operator.DelegatedPrefixes() {
+			if err := apply(
+				network.NewDelegatedPrefix(
+					network.NamespaceName,
+					network.AddressID(delegatedPrefix.LinkName, delegatedPrefix.Prefix),
+				),
+				func(r resource.Resource) {
+					*r.(*network.DelegatedPrefix).TypedSpec() = delegatedPrefix
+				},
+			); err != nil {
+				return fmt.Errorf("error applying delegated prefix: %w", err)
+			}
+		}
 	}
 
 	// clean up not touched specs
-	for _, resourceType := range []resource.Type{
-		network.AddressSpecType,
-		network.LinkSpecType,
-		network.RouteSpecType,
-		network.HostnameSpecType,
-		network.ResolverSpecType,
-		network.TimeServerSpecType,
+	for _, output := range []struct {
+		namespace    resource.Namespace
+		resourceType resource.Type
+	}{
+		{network.ConfigNamespaceName, network.AddressSpecType},
+		{network.ConfigNamespaceName, network.LinkSpecType},
+		{network.ConfigNamespaceName, network.RouteSpecType},
+		{network.ConfigNamespaceName, network.HostnameSpecType},
+		{network.ConfigNamespaceName, network.ResolverSpecType},
+		{network.ConfigNamespaceName, network.TimeServerSpecType},
+		{network.NamespaceName, network.DelegatedPrefixType},
 	} {
-		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, resourceType, "", resource.VersionUndefined))
+		list, err := r.List(ctx, resource.NewMetadata(output.namespace, output.resourceType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing specs: %w", err)
 		}
@@ -389,8 +416,8 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 
 			touched := false
 
-			if touchedIDs[resourceType] != nil {
-				if _, exists := touchedIDs[resourceType][item.Metadata().ID()]; exists {
+			if touchedIDs[output.resourceType] != nil {
+				if _, exists := touchedIDs[output.resourceType][item.Metadata().ID()]; exists {
 					touched = true
 				}
 			}
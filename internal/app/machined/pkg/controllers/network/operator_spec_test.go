@@ -18,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -57,6 +58,7 @@ type mockOperator struct {
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	prefixes    []network.DelegatedPrefixSpec
 }
 
 var (
@@ -141,6 +143,13 @@ func (mock *mockOperator) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return mock.timeservers
 }
 
+func (mock *mockOperator) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.prefixes
+}
+
 func (suite *OperatorSpecSuite) newOperator(_ *zap.Logger, spec *network.OperatorSpecSpec) operator.Operator {
 	return &mockOperator{
 		spec: *spec,
@@ -380,6 +389,75 @@ func (suite *OperatorSpecSuite) TestScheduling() {
 	)
 }
 
+func (suite *OperatorSpecSuite) TestDot1XAuthorization() {
+	specDHCP := network.NewOperatorSpec(network.NamespaceName, "dhcp4/eth0")
+	*specDHCP.TypedSpec() = network.OperatorSpecSpec{
+		Operator:  network.OperatorDHCP4,
+		LinkName:  "eth0",
+		RequireUp: true,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, specDHCP))
+
+	linkState := network.NewLinkStatus(network.NamespaceName, "eth0")
+	*linkState.TypedSpec() = network.LinkStatusSpec{
+		OperationalState: nethelpers.OperStateUp,
+		Dot1XState:       nethelpers.Dot1XStateAuthenticating,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, linkState))
+
+	// operator shouldn't be running, as the port is not authorized yet
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(
+					nil, func(op *mockOperator) error {
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	ctest.UpdateWithConflicts(suite, linkState, func(r *network.LinkStatus) error {
+		r.TypedSpec().Dot1XState = nethelpers.Dot1XStateAuthenticated
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(
+					[]string{"dhcp4/eth0"}, func(op *mockOperator) error {
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// authentication failure stops the operator
+	ctest.UpdateWithConflicts(suite, linkState, func(r *network.LinkStatus) error {
+		r.TypedSpec().Dot1XState = nethelpers.Dot1XStateFailed
+
+		return nil
+	})
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRunning(
+					nil, func(op *mockOperator) error {
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *OperatorSpecSuite) TestPanic() {
 	specPanic := network.NewOperatorSpec(network.NamespaceName, "dhcp6/eth0")
 	*specPanic.TypedSpec() = network.OperatorSpecSpec{
@@ -539,6 +617,43 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 			},
 		),
 	)
+
+	// delegated prefixes
+	dhcpMock.mu.Lock()
+	dhcpMock.prefixes = []network.DelegatedPrefixSpec{
+		{
+			LinkName:      "eth0",
+			Prefix:        netip.MustParsePrefix("2001:db8:0:100::/56"),
+			ValidLifetime: time.Hour,
+			Assignments: []network.DelegatedPrefixAssignment{
+				{
+					Prefix:         netip.MustParsePrefix("2001:db8:0:102::/64"),
+					NodeAnnotation: "example.com/pod-cidr",
+				},
+			},
+		},
+	}
+	dhcpMock.mu.Unlock()
+
+	dhcpMock.notify()
+
+	ctest.AssertResource(suite, "eth0/2001:db8:0:100::/56", func(r *network.DelegatedPrefix, asrt *assert.Assertions) {
+		asrt.Equal(time.Hour, r.TypedSpec().ValidLifetime)
+		asrt.Equal([]network.DelegatedPrefixAssignment{
+			{
+				Prefix:         netip.MustParsePrefix("2001:db8:0:102::/64"),
+				NodeAnnotation: "example.com/pod-cidr",
+			},
+		}, r.TypedSpec().Assignments)
+	})
+
+	dhcpMock.mu.Lock()
+	dhcpMock.prefixes = nil
+	dhcpMock.mu.Unlock()
+
+	dhcpMock.notify()
+
+	ctest.AssertNoResource[*network.DelegatedPrefix](suite, "eth0/2001:db8:0:100::/56")
 }
 
 func (suite *OperatorSpecSuite) TearDownTest() {
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// ProbeConfigController generates network.ProbeSpec based on machine configuration.
+type ProbeConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Name() string {
+	return "network.ProbeConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.ProbeSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ProbeConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil && len(cfg.Config().NetworkProbeConfigs()) > 0 {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			for _, probeConfig := range cfg.Config().NetworkProbeConfigs() {
+				spec := probeSpec(probeConfig)
+
+				if link, ok := probeConfig.Link().Get(); ok {
+					if spec.LinkName, ok = resolver.Resolve(link); !ok {
+						continue
+					}
+				}
+
+				if err = safe.WriterModify(ctx, r, network.NewProbeSpec(network.NamespaceName, probeConfig.Name()),
+					func(probe *network.ProbeSpec) error {
+						*probe.TypedSpec() = spec
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("error updating probe spec: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.ProbeSpec](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func probeSpec(probeConfig talosconfig.NetworkProbeConfig) network.ProbeSpecSpec {
+	spec := network.ProbeSpecSpec{
+		Interval:         probeConfig.Interval(),
+		FailureThreshold: probeConfig.FailureThreshold(),
+		ConfigLayer:      network.ConfigMachineConfiguration,
+	}
+
+	if tcp, ok := probeConfig.TCP().Get(); ok {
+		spec.TCP = network.TCPProbeSpec{
+			Endpoint: tcp.Endpoint(),
+			Timeout:  tcp.Timeout(),
+		}
+	}
+
+	if icmp, ok := probeConfig.ICMP().Get(); ok {
+		spec.ICMP = network.ICMPProbeSpec{
+			Address: icmp.Address(),
+			Timeout: icmp.Timeout(),
+		}
+	}
+
+	if http, ok := probeConfig.HTTP().Get(); ok {
+		spec.HTTP = network.HTTPProbeSpec{
+			URL:                http.URL(),
+			ExpectedStatusCode: http.ExpectedStatusCode(),
+			Timeout:            http.Timeout(),
+		}
+	}
+
+	if dns, ok := probeConfig.DNS().Get(); ok {
+		spec.DNS = network.DNSProbeSpec{
+			Server:  dns.Server(),
+			Name:    dns.Query(),
+			Timeout: dns.Timeout(),
+		}
+	}
+
+	return spec
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type ProbeConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ProbeConfigSuite) TestReconcile() {
+	icmpProbe := networkcfg.NewProbeConfigV1Alpha1()
+	icmpProbe.MetaName = "gateway"
+	icmpProbe.ProbeICMP = &networkcfg.ICMPProbeConfig{
+		ProbeAddress: networkcfg.Addr{Addr: netip.MustParseAddr("192.168.1.1")},
+	}
+	icmpProbe.ProbeLink = "eth0"
+
+	httpProbe := networkcfg.NewProbeConfigV1Alpha1()
+	httpProbe.MetaName = "upstream"
+	httpProbe.ProbeInterval = 10 * time.Second
+	httpProbe.ProbeFailureThreshold = pointer.To(1)
+	httpProbe.ProbeHTTP = &networkcfg.HTTPProbeConfig{
+		ProbeURL:     "https://example.com/healthz",
+		ProbeTimeout: 5 * time.Second,
+	}
+
+	dnsProbe := networkcfg.NewProbeConfigV1Alpha1()
+	dnsProbe.MetaName = "resolver"
+	dnsProbe.ProbeDNS = &networkcfg.DNSProbeConfig{
+		ProbeServer: "1.1.1.1",
+		ProbeQuery:  "example.com",
+	}
+
+	ctr, err := container.New(icmpProbe, httpProbe, dnsProbe)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "gateway", func(probe *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.ProbeSpecSpec{
+			Interval:         networkcfg.DefaultProbeInterval,
+			FailureThreshold: networkcfg.DefaultProbeFailureThreshold,
+			ICMP: network.ICMPProbeSpec{
+				Address: netip.MustParseAddr("192.168.1.1"),
+				Timeout: networkcfg.DefaultProbeTimeout,
+			},
+			LinkName:    "eth0",
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *probe.TypedSpec())
+	})
+
+	ctest.AssertResource(suite, "upstream", func(probe *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.ProbeSpecSpec{
+			Interval:         10 * time.Second,
+			FailureThreshold: 1,
+			HTTP: network.HTTPProbeSpec{
+				URL:                "https://example.com/healthz",
+				ExpectedStatusCode: 200,
+				Timeout:            5 * time.Second,
+			},
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *probe.TypedSpec())
+	})
+
+	ctest.AssertResource(suite, "resolver", func(probe *network.ProbeSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.DNSProbeSpec{
+			Server:  "1.1.1.1:53",
+			Name:    "example.com",
+			Timeout: networkcfg.DefaultProbeTimeout,
+		}, probe.TypedSpec().DNS)
+	})
+
+	// remove some probes
+	ctr, err = container.New(icmpProbe)
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	ctest.AssertResource(suite, "gateway", func(*network.ProbeSpec, *assert.Assertions) {})
+	ctest.AssertNoResource[*network.ProbeSpec](suite, "upstream")
+	ctest.AssertNoResource[*network.ProbeSpec](suite, "resolver")
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), newCfg.Metadata()))
+
+	ctest.AssertNoResource[*network.ProbeSpec](suite, "gateway")
+}
+
+func TestProbeConfigSuite(t *testing.T) {
+	suite.Run(t, &ProbeConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.ProbeConfigController{}))
+			},
+		},
+	})
+}
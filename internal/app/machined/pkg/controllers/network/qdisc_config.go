@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// QdiscConfigController generates network.QdiscSpec based on machine configuration.
+type QdiscConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *QdiscConfigController) Name() string {
+	return "network.QdiscConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *QdiscConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *QdiscConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.QdiscSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *QdiscConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil && len(cfg.Config().NetworkQdiscConfigs()) > 0 {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			for _, qdiscConfig := range cfg.Config().NetworkQdiscConfigs() {
+				linkName, ok := resolver.Resolve(qdiscConfig.Name())
+				if !ok {
+					continue
+				}
+
+				if err = safe.WriterModify(ctx, r, network.NewQdiscSpec(network.NamespaceName, linkName),
+					func(qdisc *network.QdiscSpec) error {
+						*qdisc.TypedSpec() = qdiscSpec(linkName, qdiscConfig)
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("error updating qdisc spec: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.QdiscSpec](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func qdiscSpec(linkName string, qdiscConfig talosconfig.NetworkQdiscConfig) network.QdiscSpecSpec {
+	spec := network.QdiscSpecSpec{
+		LinkName: linkName,
+	}
+
+	if fq, ok := qdiscConfig.FQ().Get(); ok {
+		spec.Kind = network.QdiscKindFQ
+		spec.FQ = network.FQQdiscSpec{
+			FlowLimit: fq.FlowLimit(),
+			MaxRate:   fq.MaxRate(),
+		}
+	}
+
+	if fqCodel, ok := qdiscConfig.FQCodel().Get(); ok {
+		spec.Kind = network.QdiscKindFQCodel
+		spec.FQCodel = network.FQCodelQdiscSpec{
+			Limit:    fqCodel.Limit(),
+			Flows:    fqCodel.Flows(),
+			Target:   fqCodel.Target(),
+			Interval: fqCodel.Interval(),
+			ECN:      fqCodel.ECN().ValueOr(true),
+		}
+	}
+
+	if cake, ok := qdiscConfig.CAKE().Get(); ok {
+		spec.Kind = network.QdiscKindCAKE
+		spec.CAKE = network.CAKEQdiscSpec{
+			Bandwidth: cake.Bandwidth(),
+			RTT:       cake.RTT(),
+		}
+	}
+
+	if tbf, ok := qdiscConfig.TBF().Get(); ok {
+		spec.Kind = network.QdiscKindTBF
+		spec.TBF = network.TBFQdiscSpec{
+			Rate:    tbf.Rate(),
+			Burst:   tbf.Burst(),
+			Latency: tbf.Latency(),
+		}
+	}
+
+	if htb, ok := qdiscConfig.HTB().Get(); ok {
+		spec.Kind = network.QdiscKindHTB
+		spec.HTB = network.HTBQdiscSpec{
+			Rate:         htb.Rate(),
+			DefaultClass: htb.DefaultClass(),
+		}
+
+		for _, class := range htb.Classes() {
+			spec.HTB.Classes = append(spec.HTB.Classes, network.HTBClassSpec{
+				ID:           class.ID(),
+				Rate:         class.Rate(),
+				Ceil:         class.Ceil(),
+				Priority:     class.Priority(),
+				FirewallMark: class.FirewallMark(),
+			})
+		}
+	}
+
+	if netem, ok := qdiscConfig.Netem().Get(); ok {
+		spec.Kind = network.QdiscKindNetem
+		spec.Netem = network.NetemQdiscSpec{
+			Delay:     netem.Delay(),
+			Jitter:    netem.Jitter(),
+			Loss:      netem.Loss(),
+			Duplicate: netem.Duplicate(),
+			Corrupt:   netem.Corrupt(),
+			Reorder:   netem.Reorder(),
+			Rate:      netem.Rate(),
+			Limit:     netem.Limit(),
+		}
+	}
+
+	return spec
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type QdiscConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *QdiscConfigSuite) TestReconcile() {
+	htbQdisc := networkcfg.NewQdiscConfigV1Alpha1()
+	htbQdisc.MetaName = "eth0"
+	htbQdisc.QdiscHTB = &networkcfg.HTBQdiscConfig{
+		HTBRate:         networkcfg.MustBandwidth("10Gbit"),
+		HTBDefaultClass: 20,
+		HTBClasses: []networkcfg.HTBClassConfig{
+			{
+				HTBClassID:           10,
+				HTBClassRate:         networkcfg.MustBandwidth("200Mbit"),
+				HTBClassCeil:         networkcfg.MustBandwidth("1Gbit"),
+				HTBClassPriority:     1,
+				HTBClassFirewallMark: 0x10,
+			},
+			{
+				HTBClassID:   20,
+				HTBClassRate: networkcfg.MustBandwidth("9Gbit"),
+			},
+		},
+	}
+
+	fqCodelQdisc := networkcfg.NewQdiscConfigV1Alpha1()
+	fqCodelQdisc.MetaName = "eth1"
+	fqCodelQdisc.QdiscFQCodel = &networkcfg.FQCodelQdiscConfig{
+		FQCodelTarget: 10 * time.Millisecond,
+	}
+
+	netemQdisc := networkcfg.NewQdiscConfigV1Alpha1()
+	netemQdisc.MetaName = "eth2"
+	netemQdisc.QdiscNetem = &networkcfg.NetemQdiscConfig{
+		NetemDelay: 100 * time.Millisecond,
+		NetemLoss:  0.5,
+		NetemRate:  networkcfg.MustBandwidth("50Mbit"),
+	}
+
+	ctr, err := container.New(htbQdisc, fqCodelQdisc, netemQdisc)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "eth0", func(qdisc *network.QdiscSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.QdiscSpecSpec{
+			LinkName: "eth0",
+			Kind:     network.QdiscKindHTB,
+			HTB: network.HTBQdiscSpec{
+				Rate:         10_000_000_000,
+				DefaultClass: 20,
+				Classes: []network.HTBClassSpec{
+					{
+						ID:           10,
+						Rate:         200_000_000,
+						Ceil:         1_000_000_000,
+						Priority:     1,
+						FirewallMark: 0x10,
+					},
+					{
+						ID:   20,
+						Rate: 9_000_000_000,
+					},
+				},
+			},
+		}, *qdisc.TypedSpec())
+	})
+
+	ctest.AssertResource(suite, "eth1", func(qdisc *network.QdiscSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.QdiscKindFQCodel, qdisc.TypedSpec().Kind)
+		asrt.Equal(network.FQCodelQdiscSpec{
+			Target: 10 * time.Millisecond,
+			ECN:    true,
+		}, qdisc.TypedSpec().FQCodel)
+	})
+
+	ctest.AssertResource(suite, "eth2", func(qdisc *network.QdiscSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.QdiscKindNetem, qdisc.TypedSpec().Kind)
+		asrt.Equal(network.NetemQdiscSpec{
+			Delay: 100 * time.Millisecond,
+			Loss:  0.5,
+			Rate:  50_000_000,
+		}, qdisc.TypedSpec().Netem)
+	})
+
+	// disable ECN and remove some qdiscs
+	fqCodelQdisc.QdiscFQCodel.FQCodelECN = pointer.To(false)
+
+	ctr, err = container.New(fqCodelQdisc)
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	ctest.AssertResource(suite, "eth1", func(qdisc *network.QdiscSpec, asrt *assert.Assertions) {
+		asrt.False(qdisc.TypedSpec().FQCodel.ECN)
+	})
+	ctest.AssertNoResource[*network.QdiscSpec](suite, "eth0")
+	ctest.AssertNoResource[*network.QdiscSpec](suite, "eth2")
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), newCfg.Metadata()))
+
+	ctest.AssertNoResource[*network.QdiscSpec](suite, "eth1")
+}
+
+func TestQdiscConfigSuite(t *testing.T) {
+	suite.Run(t, &QdiscConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.QdiscConfigController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/florianl/go-tc"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/qdisc"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// QdiscSpecController applies network.QdiscSpec to the root qdiscs of the links.
+type QdiscSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *QdiscSpecController) Name() string {
+	return "network.QdiscSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *QdiscSpecController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *QdiscSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// appliedQdisc tracks the version of the spec which was applied to the link.
+type appliedQdisc struct {
+	version   resource.Version
+	linkIndex uint32
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *QdiscSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// wait for udevd to be healthy, which implies that all link renames are done
+	if err := runtime.WaitForDevicesReady(ctx, r,
+		[]controller.Input{
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.QdiscSpecType,
+				Kind:      controller.InputStrong,
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	// watch link and qdisc changes, as the qdisc should be re-applied if the link is re-created or the qdisc is replaced
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_LINK|unix.RTMGRP_TC)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	tcnl, err := tc.Open(&tc.Config{})
+	if err != nil {
+		return fmt.Errorf("error dialing traffic control socket: %w", err)
+	}
+
+	defer tcnl.Close() //nolint:errcheck
+
+	applied := map[string]appliedQdisc{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		list, err := safe.ReaderListAll[*network.QdiscSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing qdisc specs: %w", err)
+		}
+
+		// add finalizers for all live resources
+		for res := range list.All() {
+			if res.Metadata().Phase() != resource.PhaseRunning {
+				continue
+			}
+
+			if err = r.AddFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+				return fmt.Errorf("error adding finalizer: %w", err)
+			}
+		}
+
+		links, err := conn.Link.List()
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		for res := range list.All() {
+			if err = ctrl.syncQdisc(ctx, r, logger, tcnl, links, applied, res); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *QdiscSpecController) syncQdisc(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	tcnl *tc.Tc,
+	links []rtnetlink.LinkMessage,
+	applied map[string]appliedQdisc,
+	res *network.QdiscSpec,
+) error {
+	spec := res.TypedSpec()
+	link := findLink(links, spec.LinkName)
+
+	logger = logger.With(zap.String("link", spec.LinkName))
+
+	switch res.Metadata().Phase() {
+	case resource.PhaseTearingDown:
+		if link != nil {
+			if err := qdisc.DeleteRoot(tcnl, link.Index); err != nil {
+				return fmt.Errorf("error removing qdisc of link %q: %w", spec.LinkName, err)
+			}
+
+			logger.Info("removed qdisc")
+		}
+
+		delete(applied, spec.LinkName)
+
+		if err := r.RemoveFinalizer(ctx, res.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("error removing finalizer: %w", err)
+		}
+	case resource.PhaseRunning:
+		if link == nil {
+			// the qdisc is applied once the link appears
+			delete(applied, spec.LinkName)
+
+			return nil
+		}
+
+		root, err := qdisc.Root(tcnl, link.Index)
+		if err != nil {
+			return fmt.Errorf("error getting root qdisc of link %q: %w", spec.LinkName, err)
+		}
+
+		// skip the link if the spec is unchanged and the qdisc is still in place
+		if prev, ok := applied[spec.LinkName]; ok &&
+			prev.version.Equal(res.Metadata().Version()) &&
+			prev.linkIndex == link.Index &&
+			root != nil && root.Handle == qdisc.RootHandle && root.Kind == spec.Kind {
+			return nil
+		}
+
+		h, err := qdisc.Build(link.Index, spec)
+		if err != nil {
+			return fmt.Errorf("error building qdisc of link %q: %w", spec.LinkName, err)
+		}
+
+		if err = qdisc.Apply(tcnl, h); err != nil {
+			return fmt.Errorf("error applying qdisc to link %q: %w", spec.LinkName, err)
+		}
+
+		logger.Info("applied qdisc", zap.String("kind", spec.Kind))
+
+		applied[spec.LinkName] = appliedQdisc{
+			version:   res.Metadata().Version(),
+			linkIndex: link.Index,
+		}
+	}
+
+	return nil
+}
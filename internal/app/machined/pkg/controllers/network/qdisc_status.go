@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/florianl/go-tc"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/qdisc"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DefaultQdiscStatusUpdateInterval is the default interval for refreshing qdisc statistics.
+const DefaultQdiscStatusUpdateInterval = 10 * time.Second
+
+// QdiscStatusController reports the root qdiscs of the links and their statistics.
+type QdiscStatusController struct {
+	Interval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *QdiscStatusController) Name() string {
+	return "network.QdiscStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *QdiscStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *QdiscStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.QdiscStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *QdiscStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	interval := ctrl.Interval
+	if interval == 0 {
+		interval = DefaultQdiscStatusUpdateInterval
+	}
+
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_LINK|unix.RTMGRP_TC)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	tcnl, err := tc.Open(&tc.Config{})
+	if err != nil {
+		return fmt.Errorf("error dialing traffic control socket: %w", err)
+	}
+
+	defer tcnl.Close() //nolint:errcheck
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		links, err := conn.Link.List()
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		qdiscs, err := tcnl.Qdisc().Get()
+		if err != nil {
+			return fmt.Errorf("error listing qdiscs: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for _, link := range links {
+			var root *tc.Object
+
+			for i := range qdiscs {
+				if qdiscs[i].Ifindex == link.Index && qdiscs[i].Parent == tc.HandleRoot {
+					root = &qdiscs[i]
+
+					break
+				}
+			}
+
+			if root == nil {
+				continue
+			}
+
+			classes, err := tcnl.Class().Get(&tc.Msg{
+				Family:  unix.AF_UNSPEC,
+				Ifindex: link.Index,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing classes of link %q: %w", link.Attributes.Name, err)
+			}
+
+			if err = safe.WriterModify(ctx, r, network.NewQdiscStatus(network.NamespaceName, link.Attributes.Name),
+				func(res *network.QdiscStatus) error {
+					spec := res.TypedSpec()
+
+					spec.LinkName = link.Attributes.Name
+					spec.Kind = root.Kind
+					spec.Handle = qdisc.FormatHandle(root.Handle)
+
+					stats := qdiscStats(root)
+
+					spec.Bytes = stats.Bytes
+					spec.Packets = stats.Packets
+					spec.Drops = stats.Drops
+					spec.Overlimits = stats.Overlimits
+					spec.Requeues = stats.Requeues
+					spec.Backlog = stats.Backlog
+					spec.Qlen = stats.Qlen
+
+					spec.Classes = make([]network.QdiscClassStatus, 0, len(classes))
+
+					for _, class := range classes {
+						classStats := qdiscStats(&class)
+
+						classStatus := network.QdiscClassStatus{
+							Handle:     qdisc.FormatHandle(class.Handle),
+							Parent:     qdisc.FormatHandle(class.Parent),
+							Bytes:      classStats.Bytes,
+							Packets:    classStats.Packets,
+							Drops:      classStats.Drops,
+							Overlimits: classStats.Overlimits,
+						}
+
+						if class.Kind == network.QdiscKindHTB {
+							classStatus.Rate, classStatus.Ceil = qdisc.Rate(class.Htb)
+						}
+
+						spec.Classes = append(spec.Classes, classStatus)
+					}
+
+					return nil
+				}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.QdiscStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// qdiscStats returns the statistics of the qdisc or class, preferring the newer TCA_STATS2 attribute.
+func qdiscStats(obj *tc.Object) tc.Stats2 {
+	switch {
+	case obj.Stats2 != nil:
+		return *obj.Stats2
+	case obj.Stats != nil:
+		return tc.Stats2{
+			Bytes:      obj.Stats.Bytes,
+			Packets:    obj.Stats.Packets,
+			Qlen:       obj.Stats.Qlen,
+			Backlog:    obj.Stats.Backlog,
+			Drops:      obj.Stats.Drops,
+			Overlimits: obj.Stats.Overlimits,
+		}
+	default:
+		return tc.Stats2{}
+	}
+}
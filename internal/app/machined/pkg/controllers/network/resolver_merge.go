@@ -84,12 +84,21 @@ func (ctrl *ResolverMergeController) Run(ctx context.Context, r controller.Runti
 			spec := res.TypedSpec()
 
 			if spec.ConfigLayer == final.ConfigLayer {
-				// simply append server lists on the same layer
+				// simply append server and search domain lists on the same layer
 				final.DNSServers = append(final.DNSServers, spec.DNSServers...)
+				final.SearchDomains = append(final.SearchDomains, spec.SearchDomains...)
 			} else {
 				// otherwise, do a smart merge across IPv4/IPv6
 				final.ConfigLayer = spec.ConfigLayer
-				mergeDNSServers(&final.DNSServers, spec.DNSServers)
+
+				if len(spec.DNSServers) > 0 {
+					mergeDNSServers(&final.DNSServers, spec.DNSServers)
+				}
+
+				// search domains are overridden only if set
+				if len(spec.SearchDomains) > 0 {
+					final.SearchDomains = spec.SearchDomains
+				}
 			}
 		}
 
@@ -155,6 +155,50 @@ func (suite *ResolverMergeSuite) TestMergeIPv46() {
 	)
 }
 
+func (suite *ResolverMergeSuite) TestMergeSearchDomains() {
+	def := network.NewResolverSpec(network.ConfigNamespaceName, "default/resolvers")
+	*def.TypedSpec() = network.ResolverSpecSpec{
+		DNSServers: []netip.Addr{
+			netip.MustParseAddr(constants.DefaultPrimaryResolver),
+			netip.MustParseAddr(constants.DefaultSecondaryResolver),
+		},
+		ConfigLayer: network.ConfigDefault,
+	}
+
+	dhcp1 := network.NewResolverSpec(network.ConfigNamespaceName, "dhcp/eth0")
+	*dhcp1.TypedSpec() = network.ResolverSpecSpec{
+		DNSServers:    []netip.Addr{netip.MustParseAddr("1.1.2.0")},
+		SearchDomains: []string{"example.com"},
+		ConfigLayer:   network.ConfigOperator,
+	}
+
+	dhcp2 := network.NewResolverSpec(network.ConfigNamespaceName, "dhcp/eth1")
+	*dhcp2.TypedSpec() = network.ResolverSpecSpec{
+		SearchDomains: []string{"example.org"},
+		ConfigLayer:   network.ConfigOperator,
+	}
+
+	static := network.NewResolverSpec(network.ConfigNamespaceName, "configuration/resolvers")
+	*static.TypedSpec() = network.ResolverSpecSpec{
+		DNSServers:  []netip.Addr{netip.MustParseAddr("2.2.2.2")},
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	for _, res := range []resource.Resource{def, dhcp1, dhcp2, static} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	// search domains are kept, as the higher layer doesn't set them
+	suite.assertResolvers(
+		[]string{
+			"resolvers",
+		}, func(r *network.ResolverSpec, asrt *assert.Assertions) {
+			asrt.Equal([]netip.Addr{netip.MustParseAddr("2.2.2.2")}, r.TypedSpec().DNSServers)
+			asrt.Equal([]string{"example.com", "example.org"}, r.TypedSpec().SearchDomains)
+		},
+	)
+}
+
 func (suite *ResolverMergeSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -13,12 +13,14 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/value"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -35,11 +37,22 @@ func (ctrl *RouteConfigController) Name() string {
 // Inputs implements controller.Controller interface.
 func (ctrl *RouteConfigController) Inputs() []controller.Input {
 	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.DeviceConfigSpecType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -128,6 +141,30 @@ func (ctrl *RouteConfigController) Run(ctx context.Context, r controller.Runtime
 			}
 		}
 
+		// parse network configuration documents for static routes
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg != nil && len(cfg.Config().NetworkRouteConfigs()) > 0 {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			ids, err := ctrl.apply(ctx, r, ctrl.processRouteConfigs(cfg.Config(), resolver))
+			if err != nil {
+				return fmt.Errorf("error applying static route configuration: %w", err)
+			}
+
+			for _, id := range ids {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
 		// list routes for cleanup
 		list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.RouteSpecType, "", resource.VersionUndefined))
 		if err != nil {
@@ -259,36 +296,10 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 			}
 		}
 
-		normalizedFamily := route.Normalize()
-
-		route.Priority = in.Metric()
-		if route.Priority == 0 {
-			route.Priority = network.DefaultRouteMetric
-		}
-
-		route.MTU = in.MTU()
-
-		switch {
-		case !value.IsZero(route.Gateway) && route.Gateway.Is6():
-			route.Family = nethelpers.FamilyInet6
-		case !value.IsZero(route.Destination) && route.Destination.Addr().Is6():
-			route.Family = nethelpers.FamilyInet6
-		case normalizedFamily != 0:
-			route.Family = normalizedFamily
-		default:
-			route.Family = nethelpers.FamilyInet4
-		}
-
-		route.Table = nethelpers.TableMain
-		route.Protocol = nethelpers.ProtocolStatic
-		route.OutLinkName = linkName
-		route.ConfigLayer = network.ConfigMachineConfiguration
-
-		route.Type = nethelpers.TypeUnicast
+		fillStaticRoute(&route, linkName, in.Metric(), in.MTU())
 
-		if route.Destination.Addr().IsMulticast() {
-			route.Type = nethelpers.TypeMulticast
-		}
+		route.Probe = in.Probe()
+		route.ProbeFailureMetric = in.ProbeFailureMetric()
 
 		return route, nil
 	}
@@ -327,3 +338,63 @@ func (ctrl *RouteConfigController) processDevicesConfiguration(logger *zap.Logge
 
 	return routes
 }
+
+// processRouteConfigs converts the route config documents to route specs.
+func (ctrl *RouteConfigController) processRouteConfigs(cfg talosconfig.Config, resolver *linkResolver) (routes []network.RouteSpecSpec) {
+	for _, routeConfig := range cfg.NetworkRouteConfigs() {
+		linkName, ok := resolver.Resolve(routeConfig.Link())
+		if !ok {
+			continue
+		}
+
+		var route network.RouteSpecSpec
+
+		route.Destination = routeConfig.Destination().ValueOrZero()
+		route.Gateway = routeConfig.Gateway().ValueOrZero()
+		route.Source = routeConfig.Source().ValueOrZero()
+
+		fillStaticRoute(&route, linkName, routeConfig.Metric(), routeConfig.MTU())
+
+		route.Table = routeConfig.Table()
+		route.Probe = routeConfig.Probe().ValueOrZero()
+		route.ProbeFailureMetric = routeConfig.ProbeFailureMetric().ValueOrZero()
+
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+// fillStaticRoute fills in the static route defaults.
+func fillStaticRoute(route *network.RouteSpecSpec, linkName string, metric, mtu uint32) {
+	normalizedFamily := route.Normalize()
+
+	route.Priority = metric
+	if route.Priority == 0 {
+		route.Priority = network.DefaultRouteMetric
+	}
+
+	route.MTU = mtu
+
+	switch {
+	case !value.IsZero(route.Gateway) && route.Gateway.Is6():
+		route.Family = nethelpers.FamilyInet6
+	case !value.IsZero(route.Destination) && route.Destination.Addr().Is6():
+		route.Family = nethelpers.FamilyInet6
+	case normalizedFamily != 0:
+		route.Family = normalizedFamily
+	default:
+		route.Family = nethelpers.FamilyInet4
+	}
+
+	route.Table = nethelpers.TableMain
+	route.Protocol = nethelpers.ProtocolStatic
+	route.OutLinkName = linkName
+	route.ConfigLayer = network.ConfigMachineConfiguration
+
+	route.Type = nethelpers.TypeUnicast
+
+	if route.Destination.Addr().IsMulticast() {
+		route.Type = nethelpers.TypeMulticast
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -205,9 +206,11 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 								DeviceInterface: "eth1",
 								DeviceRoutes: []*v1alpha1.Route{
 									{
-										RouteNetwork: "192.244.0.0/24",
-										RouteGateway: "192.244.0.1",
-										RouteSource:  "192.244.0.10",
+										RouteNetwork:            "192.244.0.0/24",
+										RouteGateway:            "192.244.0.1",
+										RouteSource:             "192.244.0.10",
+										RouteProbe:              "uplink",
+										RouteProbeFailureMetric: 4096,
 									},
 								},
 							},
@@ -253,6 +256,8 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().Priority)
 				asrt.EqualValues(netip.MustParseAddr("192.244.0.10"), r.TypedSpec().Source)
+				asrt.Equal("uplink", r.TypedSpec().Probe)
+				asrt.EqualValues(4096, r.TypedSpec().ProbeFailureMetric)
 			case "configuration/inet4//169.254.254.254/32/1024":
 				asrt.Equal("eth3", r.TypedSpec().OutLinkName)
 				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
@@ -266,6 +271,130 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 	)
 }
 
+func (suite *RouteConfigSuite) TestNetworkDocuments() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RouteConfigController{}))
+
+	suite.startRuntime()
+
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "enp0s2")
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+	linkStatus.TypedSpec().Driver = "i40e"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, linkStatus))
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: LinkConfig
+name: uplink
+selector:
+  match: link.driver == "i40e"
+---
+apiVersion: v1alpha1
+kind: RouteConfig
+name: default
+link: uplink
+gateway: 192.168.1.1
+---
+apiVersion: v1alpha1
+kind: RouteConfig
+name: default6
+link: uplink
+gateway: fe80::1
+---
+apiVersion: v1alpha1
+kind: RouteConfig
+name: internal
+link: eth5
+destination: 10.0.0.0/8
+gateway: 10.5.0.1
+metric: 100
+mtu: 1400
+---
+apiVersion: v1alpha1
+kind: RouteConfig
+name: storage
+link: eth6
+gateway: 10.10.0.1
+table: 100
+`))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(provider)))
+
+	suite.assertRoutes(
+		[]string{
+			"configuration/inet4/192.168.1.1//1024",
+			"configuration/enp0s2/inet6/fe80::1//1024",
+			"configuration/inet4/10.5.0.1/10.0.0.0/8/100",
+			"configuration/RoutingTable(100)/inet4/10.10.0.1//1024",
+		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "configuration/inet4/192.168.1.1//1024":
+				asrt.Equal("enp0s2", r.TypedSpec().OutLinkName)
+				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
+			case "configuration/enp0s2/inet6/fe80::1//1024":
+				asrt.Equal("enp0s2", r.TypedSpec().OutLinkName)
+				asrt.Equal(nethelpers.FamilyInet6, r.TypedSpec().Family)
+			case "configuration/inet4/10.5.0.1/10.0.0.0/8/100":
+				asrt.Equal("eth5", r.TypedSpec().OutLinkName)
+				asrt.EqualValues(1400, r.TypedSpec().MTU)
+			case "configuration/RoutingTable(100)/inet4/10.10.0.1//1024":
+				asrt.Equal("eth6", r.TypedSpec().OutLinkName)
+				asrt.EqualValues(100, r.TypedSpec().Table)
+
+				return
+			}
+
+			asrt.Equal(nethelpers.TableMain, r.TypedSpec().Table)
+			asrt.Equal(nethelpers.ProtocolStatic, r.TypedSpec().Protocol)
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+		},
+	)
+}
+
+func (suite *RouteConfigSuite) TestProbe() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.RouteConfigController{}))
+
+	suite.startRuntime()
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: RouteConfig
+name: primary
+link: eth0
+gateway: 192.168.1.1
+metric: 100
+probe: primary-gateway
+---
+apiVersion: v1alpha1
+kind: RouteConfig
+name: backup
+link: eth1
+gateway: 192.168.2.1
+metric: 200
+probe: backup-gateway
+probeFailureMetric: 4096
+`))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(provider)))
+
+	// the probe is evaluated by the RouteMergeController, the route spec carries the reference
+	suite.assertRoutes(
+		[]string{
+			"configuration/inet4/192.168.1.1//100",
+			"configuration/inet4/192.168.2.1//200",
+		}, func(r *network.RouteSpec, asrt *assert.Assertions) {
+			switch r.Metadata().ID() {
+			case "configuration/inet4/192.168.1.1//100":
+				asrt.Equal("primary-gateway", r.TypedSpec().Probe)
+				asrt.Zero(r.TypedSpec().ProbeFailureMetric)
+			case "configuration/inet4/192.168.2.1//200":
+				asrt.Equal("backup-gateway", r.TypedSpec().Probe)
+				asrt.EqualValues(4096, r.TypedSpec().ProbeFailureMetric)
+			}
+		},
+	)
+}
+
 func (suite *RouteConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -19,6 +19,8 @@ import (
 )
 
 // RouteMergeController merges network.RouteSpec in network.ConfigNamespace and produces final network.RouteSpec in network.Namespace.
+//
+// Routes gated by a failed probe are either withdrawn or get the probe failure metric.
 type RouteMergeController struct{}
 
 // Name implements controller.Controller interface.
@@ -39,6 +41,11 @@ func (ctrl *RouteMergeController) Inputs() []controller.Input {
 			Type:      network.RouteSpecType,
 			Kind:      controller.InputDestroyReady,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -54,8 +61,8 @@ func (ctrl *RouteMergeController) Outputs() []controller.Output {
 
 // Run implements controller.Controller interface.
 //
-//nolint:gocyclo
-func (ctrl *RouteMergeController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+//nolint:gocyclo,cyclop
+func (ctrl *RouteMergeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -69,11 +76,36 @@ func (ctrl *RouteMergeController) Run(ctx context.Context, r controller.Runtime,
 			return fmt.Errorf("error listing source network routes: %w", err)
 		}
 
+		probeStatuses, err := safe.ReaderListAll[*network.ProbeStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing probe statuses: %w", err)
+		}
+
+		failedProbes := map[string]struct{}{}
+
+		for probeStatus := range probeStatuses.All() {
+			if !probeStatus.TypedSpec().Success {
+				failedProbes[probeStatus.Metadata().ID()] = struct{}{}
+			}
+		}
+
 		// route is allowed as long as it's not duplicate, for duplicate higher layer takes precedence
 		routes := map[string]*network.RouteSpec{}
 
 		for _, res := range list.Items {
 			route := res.(*network.RouteSpec) //nolint:errcheck,forcetypeassert
+
+			if _, failed := failedProbes[route.TypedSpec().Probe]; failed {
+				if route.TypedSpec().ProbeFailureMetric == 0 {
+					logger.Info("withdrawing route, probe failed", zap.String("route", route.Metadata().ID()), zap.String("probe", route.TypedSpec().Probe))
+
+					continue
+				}
+
+				route = route.DeepCopy().(*network.RouteSpec) //nolint:forcetypeassert
+				route.TypedSpec().Priority = route.TypedSpec().ProbeFailureMetric
+			}
+
 			id := network.RouteID(route.TypedSpec().Table, route.TypedSpec().Family, route.TypedSpec().Destination, route.TypedSpec().Gateway, route.TypedSpec().Priority, route.TypedSpec().OutLinkName)
 
 			existing, ok := routes[id]
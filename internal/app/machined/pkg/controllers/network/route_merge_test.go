@@ -343,6 +343,193 @@ func (suite *RouteMergeSuite) TestMergeFlapping() {
 	)
 }
 
+func (suite *RouteMergeSuite) TestProbeFailover() {
+	// two DHCP uplinks, both get the failure metric on probe failure, so the primary one is preferred as long as it's healthy
+	primary := network.NewRouteSpec(network.ConfigNamespaceName, "dhcp4/eth0/inet4/192.168.1.1//1024")
+	*primary.TypedSpec() = network.RouteSpecSpec{
+		Gateway:            netip.MustParseAddr("192.168.1.1"),
+		OutLinkName:        "eth0",
+		Family:             nethelpers.FamilyInet4,
+		Scope:              nethelpers.ScopeGlobal,
+		Type:               nethelpers.TypeUnicast,
+		Table:              nethelpers.TableMain,
+		Priority:           1024,
+		ConfigLayer:        network.ConfigOperator,
+		Probe:              "uplink0",
+		ProbeFailureMetric: 3072,
+	}
+
+	backup := network.NewRouteSpec(network.ConfigNamespaceName, "dhcp4/eth1/inet4/192.168.2.1//2048")
+	*backup.TypedSpec() = network.RouteSpecSpec{
+		Gateway:            netip.MustParseAddr("192.168.2.1"),
+		OutLinkName:        "eth1",
+		Family:             nethelpers.FamilyInet4,
+		Scope:              nethelpers.ScopeGlobal,
+		Type:               nethelpers.TypeUnicast,
+		Table:              nethelpers.TableMain,
+		Priority:           2048,
+		ConfigLayer:        network.ConfigOperator,
+		Probe:              "uplink1",
+		ProbeFailureMetric: 4096,
+	}
+
+	for _, res := range []resource.Resource{primary, backup} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, res), "%v", res.Spec())
+	}
+
+	// no probe results yet, routes are considered healthy
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertRoutes(
+					[]string{
+						"inet4/192.168.1.1//1024",
+						"inet4/192.168.2.1//2048",
+					}, func(r *network.RouteSpec) error {
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	primaryStatus := network.NewProbeStatus(network.NamespaceName, "uplink0")
+	primaryStatus.TypedSpec().Success = false
+	suite.Require().NoError(suite.state.Create(suite.ctx, primaryStatus))
+
+	backupStatus := network.NewProbeStatus(network.NamespaceName, "uplink1")
+	backupStatus.TypedSpec().Success = false
+	suite.Require().NoError(suite.state.Create(suite.ctx, backupStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				for _, id := range []string{"inet4/192.168.1.1//1024", "inet4/192.168.2.1//2048"} {
+					if err := suite.assertNoRoute(id); err != nil {
+						return err
+					}
+				}
+
+				return suite.assertRoutes(
+					[]string{
+						"inet4/192.168.1.1//3072",
+						"inet4/192.168.2.1//4096",
+					}, func(r *network.RouteSpec) error {
+						switch r.TypedSpec().OutLinkName {
+						case "eth0":
+							suite.Assert().EqualValues(3072, r.TypedSpec().Priority)
+						case "eth1":
+							suite.Assert().EqualValues(4096, r.TypedSpec().Priority)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	// probes recover
+	primaryStatus.TypedSpec().Success = true
+	suite.Require().NoError(suite.state.Update(suite.ctx, primaryStatus))
+
+	backupStatus.TypedSpec().Success = true
+	suite.Require().NoError(suite.state.Update(suite.ctx, backupStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				for _, id := range []string{"inet4/192.168.1.1//3072", "inet4/192.168.2.1//4096"} {
+					if err := suite.assertNoRoute(id); err != nil {
+						return err
+					}
+				}
+
+				return suite.assertRoutes(
+					[]string{
+						"inet4/192.168.1.1//1024",
+						"inet4/192.168.2.1//2048",
+					}, func(r *network.RouteSpec) error {
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
+func (suite *RouteMergeSuite) TestProbeWithdraw() {
+	// the link route without the failure metric is withdrawn on probe failure, and restored on recovery
+	route := network.NewRouteSpec(network.ConfigNamespaceName, "configuration/inet4//10.0.10.0/24/1024")
+	*route.TypedSpec() = network.RouteSpecSpec{
+		Destination: netip.MustParsePrefix("10.0.10.0/24"),
+		OutLinkName: "eth2",
+		Family:      nethelpers.FamilyInet4,
+		Scope:       nethelpers.ScopeLink,
+		Type:        nethelpers.TypeUnicast,
+		Table:       nethelpers.TableMain,
+		Priority:    1024,
+		ConfigLayer: network.ConfigMachineConfiguration,
+		Probe:       "storage",
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, route))
+
+	assertRoute := func() {
+		suite.Assert().NoError(
+			retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				func() error {
+					return suite.assertRoutes(
+						[]string{
+							"inet4//10.0.10.0/24/1024",
+						}, func(r *network.RouteSpec) error {
+							suite.Assert().Equal("eth2", r.TypedSpec().OutLinkName)
+
+							return nil
+						},
+					)
+				},
+			),
+		)
+	}
+
+	assertRoute()
+
+	probeStatus := network.NewProbeStatus(network.NamespaceName, "storage")
+	probeStatus.TypedSpec().Success = false
+	suite.Require().NoError(suite.state.Create(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRoute("inet4//10.0.10.0/24/1024")
+			},
+		),
+	)
+
+	probeStatus.TypedSpec().Success = true
+	suite.Require().NoError(suite.state.Update(suite.ctx, probeStatus))
+
+	assertRoute()
+
+	// the route flaps together with the probe
+	probeStatus.TypedSpec().Success = false
+	suite.Require().NoError(suite.state.Update(suite.ctx, probeStatus))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRoute("inet4//10.0.10.0/24/1024")
+			},
+		),
+	)
+
+	probeStatus.TypedSpec().Success = true
+	suite.Require().NoError(suite.state.Update(suite.ctx, probeStatus))
+
+	assertRoute()
+}
+
 func (suite *RouteMergeSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleConfigController generates network.RoutingRuleSpec based on machine configuration.
+type RoutingRuleConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Name() string {
+	return "network.RoutingRuleConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleSpecType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil && len(cfg.Config().NetworkRoutingRuleConfigs()) > 0 {
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			for _, rule := range processRoutingRuleConfigs(cfg.Config(), resolver) {
+				if err = safe.WriterModify(ctx, r, network.NewRoutingRuleSpec(network.NamespaceName, network.RoutingRuleID(rule.Family, rule.Priority)),
+					func(res *network.RoutingRuleSpec) error {
+						*res.TypedSpec() = rule
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("error updating routing rule spec: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.RoutingRuleSpec](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// processRoutingRuleConfigs converts the routing rule config documents to routing rule specs.
+//
+// If the rule doesn't match on the source or destination prefix, it is created for both address families.
+func processRoutingRuleConfigs(cfg talosconfig.Config, resolver *linkResolver) (rules []network.RoutingRuleSpecSpec) {
+	for _, ruleConfig := range cfg.NetworkRoutingRuleConfigs() {
+		rule := network.RoutingRuleSpecSpec{
+			Source:      ruleConfig.Source().ValueOrZero(),
+			Destination: ruleConfig.Destination().ValueOrZero(),
+			FwMark:      ruleConfig.FwMark(),
+			FwMask:      ruleConfig.FwMask(),
+			Table:       ruleConfig.Table(),
+			Priority:    ruleConfig.Priority(),
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}
+
+		var ok bool
+
+		if iif, present := ruleConfig.InputLink().Get(); present {
+			if rule.IIFName, ok = resolver.Resolve(iif); !ok {
+				continue
+			}
+		}
+
+		if oif, present := ruleConfig.OutputLink().Get(); present {
+			if rule.OIFName, ok = resolver.Resolve(oif); !ok {
+				continue
+			}
+		}
+
+		var families []nethelpers.Family
+
+		switch {
+		case rule.Source.IsValid():
+			families = []nethelpers.Family{prefixFamily(rule.Source)}
+		case rule.Destination.IsValid():
+			families = []nethelpers.Family{prefixFamily(rule.Destination)}
+		default:
+			families = []nethelpers.Family{nethelpers.FamilyInet4, nethelpers.FamilyInet6}
+		}
+
+		for _, family := range families {
+			rule.Family = family
+
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+func prefixFamily(prefix netip.Prefix) nethelpers.Family {
+	if prefix.Addr().Is6() {
+		return nethelpers.FamilyInet6
+	}
+
+	return nethelpers.FamilyInet4
+}
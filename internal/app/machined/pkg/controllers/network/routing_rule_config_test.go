@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleConfigSuite) TestReconcile() {
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "enp0s2")
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+	linkStatus.TypedSpec().Driver = "mlx5_core"
+	suite.Create(linkStatus)
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: LinkConfig
+name: storage
+selector:
+  match: link.driver == "mlx5_core"
+---
+apiVersion: v1alpha1
+kind: RoutingRuleConfig
+name: storage
+priority: 1000
+source: 10.10.0.0/24
+table: 100
+---
+apiVersion: v1alpha1
+kind: RoutingRuleConfig
+name: storage-iif
+priority: 1001
+iif: storage
+table: 100
+---
+apiVersion: v1alpha1
+kind: RoutingRuleConfig
+name: marked
+priority: 2000
+destination: 2001:db8::/32
+fwMark: 256
+fwMask: 65280
+table: 200
+`))
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(provider)
+	suite.Create(cfg)
+
+	ctest.AssertResource(suite, "inet4/01000", func(rule *network.RoutingRuleSpec, asrt *assert.Assertions) {
+		asrt.Equal(network.RoutingRuleSpecSpec{
+			Family:      nethelpers.FamilyInet4,
+			Source:      netip.MustParsePrefix("10.10.0.0/24"),
+			Table:       100,
+			Priority:    1000,
+			ConfigLayer: network.ConfigMachineConfiguration,
+		}, *rule.TypedSpec())
+	})
+
+	for _, id := range []string{"inet4/01001", "inet6/01001"} {
+		ctest.AssertResource(suite, id, func(rule *network.RoutingRuleSpec, asrt *assert.Assertions) {
+			asrt.Equal("enp0s2", rule.TypedSpec().IIFName)
+			asrt.EqualValues(100, rule.TypedSpec().Table)
+		})
+	}
+
+	ctest.AssertResource(suite, "inet6/02000", func(rule *network.RoutingRuleSpec, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParsePrefix("2001:db8::/32"), rule.TypedSpec().Destination)
+		asrt.EqualValues(256, rule.TypedSpec().FwMark)
+		asrt.EqualValues(65280, rule.TypedSpec().FwMask)
+		asrt.EqualValues(200, rule.TypedSpec().Table)
+	})
+
+	ctest.AssertNoResource[*network.RoutingRuleSpec](suite, "inet4/02000")
+
+	// the link disappears, the rule referencing it is removed
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), linkStatus.Metadata()))
+
+	ctest.AssertNoResource[*network.RoutingRuleSpec](suite, "inet4/01001")
+	ctest.AssertNoResource[*network.RoutingRuleSpec](suite, "inet6/01001")
+	ctest.AssertResource(suite, "inet4/01000", func(*network.RoutingRuleSpec, *assert.Assertions) {})
+
+	// remove the config
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	ctest.AssertNoResource[*network.RoutingRuleSpec](suite, "inet4/01000")
+	ctest.AssertNoResource[*network.RoutingRuleSpec](suite, "inet6/02000")
+}
+
+func TestRoutingRuleConfigSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleConfigSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleConfigController{}))
+			},
+		},
+	})
+}
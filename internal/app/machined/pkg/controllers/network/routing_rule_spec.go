@@ -0,0 +1,243 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// rtmgrpIPv6Rule is the multicast group bit for the IPv6 routing rule notifications.
+const rtmgrpIPv6Rule = 1 << (unix.RTNLGRP_IPV6_RULE - 1)
+
+// RoutingRuleSpecController applies network.RoutingRuleSpec to the kernel routing rules.
+//
+// The rules installed by the controller are marked with the Talos-specific protocol, and only the rules
+// with this protocol which don't match any spec are removed.
+type RoutingRuleSpecController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Name() string {
+	return "network.RoutingRuleSpecController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.RoutingRuleSpecType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleSpecController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RoutingRuleSpecController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// watch rule changes to restore the rules removed externally
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		specs, err := safe.ReaderListAll[*network.RoutingRuleSpec](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing routing rule specs: %w", err)
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		var multiErr *multierror.Error
+
+		// remove the rules installed by the controller which are no longer in the specs
+		for _, rule := range rules {
+			if rule.Attributes == nil || pointer.SafeDeref(rule.Attributes.Protocol) != constants.RoutingRuleProtocol {
+				continue
+			}
+
+			matched := false
+
+			for spec := range specs.All() {
+				if routingRuleMatches(&rule, spec.TypedSpec()) {
+					matched = true
+
+					break
+				}
+			}
+
+			if matched {
+				continue
+			}
+
+			if err = conn.Rule.Delete(&rule); err != nil && !errors.Is(err, os.ErrNotExist) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("error removing routing rule %d: %w", pointer.SafeDeref(rule.Attributes.Priority), err))
+
+				continue
+			}
+
+			logger.Info("removed routing rule", zap.Uint32("priority", pointer.SafeDeref(rule.Attributes.Priority)))
+		}
+
+		// install the missing rules
+		for spec := range specs.All() {
+			matched := false
+
+			for _, rule := range rules {
+				if routingRuleMatches(&rule, spec.TypedSpec()) {
+					matched = true
+
+					break
+				}
+			}
+
+			if matched {
+				continue
+			}
+
+			if err = conn.Rule.Add(routingRuleMessage(spec.TypedSpec())); err != nil && !errors.Is(err, os.ErrExist) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("error adding routing rule %q: %w", spec.Metadata().ID(), err))
+
+				continue
+			}
+
+			logger.Info("added routing rule",
+				zap.String("id", spec.Metadata().ID()),
+				zap.Stringer("table", spec.TypedSpec().Table),
+				zap.Uint32("priority", spec.TypedSpec().Priority),
+			)
+		}
+
+		if err = multiErr.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// routingRuleTable returns the table of the rule, the attribute takes precedence as it supports tables above 255.
+func routingRuleTable(rule *rtnetlink.RuleMessage) nethelpers.RoutingTable {
+	if rule.Attributes != nil && rule.Attributes.Table != nil {
+		return nethelpers.RoutingTable(*rule.Attributes.Table)
+	}
+
+	return nethelpers.RoutingTable(rule.Table)
+}
+
+// routingRulePrefix converts the rule source or destination to the prefix.
+func routingRulePrefix(ip *net.IP, length uint8) netip.Prefix {
+	if ip == nil {
+		return netip.Prefix{}
+	}
+
+	addr, ok := netip.AddrFromSlice(*ip)
+	if !ok {
+		return netip.Prefix{}
+	}
+
+	return netip.PrefixFrom(addr.Unmap(), int(length))
+}
+
+func routingRuleMatches(rule *rtnetlink.RuleMessage, spec *network.RoutingRuleSpecSpec) bool {
+	if rule.Attributes == nil {
+		return false
+	}
+
+	return rule.Family == uint8(spec.Family) &&
+		rule.Action == unix.FR_ACT_TO_TBL &&
+		routingRuleTable(rule) == spec.Table &&
+		pointer.SafeDeref(rule.Attributes.Priority) == spec.Priority &&
+		routingRulePrefix(rule.Attributes.Src, rule.SrcLength) == spec.Source &&
+		routingRulePrefix(rule.Attributes.Dst, rule.DstLength) == spec.Destination &&
+		pointer.SafeDeref(rule.Attributes.IIFName) == spec.IIFName &&
+		pointer.SafeDeref(rule.Attributes.OIFName) == spec.OIFName &&
+		pointer.SafeDeref(rule.Attributes.FwMark) == spec.FwMark &&
+		(spec.FwMark == 0 || pointer.SafeDeref(rule.Attributes.FwMask) == spec.FwMask)
+}
+
+func routingRuleMessage(spec *network.RoutingRuleSpecSpec) *rtnetlink.RuleMessage {
+	msg := &rtnetlink.RuleMessage{
+		Family: uint8(spec.Family),
+		Table:  unix.RT_TABLE_COMPAT,
+		Action: unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: pointer.To(spec.Priority),
+			Table:    pointer.To(uint32(spec.Table)),
+			Protocol: pointer.To[uint8](constants.RoutingRuleProtocol),
+		},
+	}
+
+	if spec.Table < 256 {
+		msg.Table = uint8(spec.Table)
+	}
+
+	if spec.Source.IsValid() {
+		msg.SrcLength = uint8(spec.Source.Bits())
+		msg.Attributes.Src = pointer.To(net.IP(spec.Source.Addr().AsSlice()))
+	}
+
+	if spec.Destination.IsValid() {
+		msg.DstLength = uint8(spec.Destination.Bits())
+		msg.Attributes.Dst = pointer.To(net.IP(spec.Destination.Addr().AsSlice()))
+	}
+
+	if spec.IIFName != "" {
+		msg.Attributes.IIFName = pointer.To(spec.IIFName)
+	}
+
+	if spec.OIFName != "" {
+		msg.Attributes.OIFName = pointer.To(spec.OIFName)
+	}
+
+	if spec.FwMark != 0 {
+		msg.Attributes.FwMark = pointer.To(spec.FwMark)
+		msg.Attributes.FwMask = pointer.To(spec.FwMask)
+	}
+
+	return msg
+}
@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleSpecSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleSpecSuite) findRule(priority uint32) (*rtnetlink.RuleMessage, error) {
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	rules, err := conn.Rule.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.Family == unix.AF_INET && pointer.SafeDeref(rule.Attributes.Priority) == priority {
+			return &rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (suite *RoutingRuleSpecSuite) TestReconcile() {
+	const priority = 31337
+
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, network.RoutingRuleID(nethelpers.FamilyInet4, priority))
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Source:      netip.MustParsePrefix("10.250.0.0/24"),
+		FwMark:      0x100,
+		FwMask:      0xff00,
+		Table:       100,
+		Priority:    priority,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Create(rule)
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		kernelRule, err := suite.findRule(priority)
+		if err != nil {
+			return err
+		}
+
+		if kernelRule == nil {
+			return retry.ExpectedErrorf("rule is not installed")
+		}
+
+		suite.Assert().Equal(net.IP{10, 250, 0, 0}, pointer.SafeDeref(kernelRule.Attributes.Src).To4())
+		suite.Assert().EqualValues(24, kernelRule.SrcLength)
+		suite.Assert().EqualValues(0x100, pointer.SafeDeref(kernelRule.Attributes.FwMark))
+		suite.Assert().EqualValues(0xff00, pointer.SafeDeref(kernelRule.Attributes.FwMask))
+		suite.Assert().EqualValues(100, pointer.SafeDeref(kernelRule.Attributes.Table))
+		suite.Assert().EqualValues(constants.RoutingRuleProtocol, pointer.SafeDeref(kernelRule.Attributes.Protocol))
+
+		return nil
+	}))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		kernelRule, err := suite.findRule(priority)
+		if err != nil {
+			return err
+		}
+
+		if kernelRule != nil {
+			return retry.ExpectedErrorf("rule is still installed")
+		}
+
+		return nil
+	}))
+}
+
+func (suite *RoutingRuleSpecSuite) TestForeignRules() {
+	const (
+		priority        = 31337
+		foreignPriority = 31338
+	)
+
+	conn, err := rtnetlink.Dial(nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	// the rule installed by another tool with the 'static' protocol
+	foreignRule := &rtnetlink.RuleMessage{
+		Family: unix.AF_INET,
+		Table:  unix.RT_TABLE_COMPAT,
+		Action: unix.FR_ACT_TO_TBL,
+		Attributes: &rtnetlink.RuleAttributes{
+			Priority: pointer.To[uint32](foreignPriority),
+			Table:    pointer.To[uint32](101),
+			Protocol: pointer.To[uint8](unix.RTPROT_STATIC),
+		},
+	}
+
+	suite.Require().NoError(conn.Rule.Add(foreignRule))
+
+	defer conn.Rule.Delete(foreignRule) //nolint:errcheck
+
+	rule := network.NewRoutingRuleSpec(network.NamespaceName, network.RoutingRuleID(nethelpers.FamilyInet4, priority))
+	*rule.TypedSpec() = network.RoutingRuleSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Table:       100,
+		Priority:    priority,
+		ConfigLayer: network.ConfigMachineConfiguration,
+	}
+
+	suite.Create(rule)
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		kernelRule, err := suite.findRule(priority)
+		if err != nil {
+			return err
+		}
+
+		if kernelRule == nil {
+			return retry.ExpectedErrorf("rule is not installed")
+		}
+
+		return nil
+	}))
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), rule.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		kernelRule, err := suite.findRule(priority)
+		if err != nil {
+			return err
+		}
+
+		if kernelRule != nil {
+			return retry.ExpectedErrorf("rule is still installed")
+		}
+
+		return nil
+	}))
+
+	// the foreign rule is not removed by the controller
+	kernelRule, err := suite.findRule(foreignPriority)
+	suite.Require().NoError(err)
+	suite.Assert().NotNil(kernelRule)
+}
+
+func TestRoutingRuleSpecSuite(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	suite.Run(t, &RoutingRuleSpecSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleSpecController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// RoutingRuleStatusController reports the kernel routing rules as network.RoutingRuleStatus.
+type RoutingRuleStatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Name() string {
+	return "network.RoutingRuleStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.RoutingRuleStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RoutingRuleStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	watcher, err := watch.NewRtNetlink(watch.NewDefaultRateLimitedTrigger(ctx, r), unix.RTMGRP_IPV4_RULE|rtmgrpIPv6Rule)
+	if err != nil {
+		return err
+	}
+
+	defer watcher.Done()
+
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("error dialing rtnetlink socket: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		rules, err := conn.Rule.List()
+		if err != nil {
+			return fmt.Errorf("error listing routing rules: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		for _, rule := range rules {
+			if rule.Attributes == nil {
+				continue
+			}
+
+			id := network.RoutingRuleID(nethelpers.Family(rule.Family), pointer.SafeDeref(rule.Attributes.Priority))
+
+			if err = safe.WriterModify(ctx, r, network.NewRoutingRuleStatus(network.NamespaceName, id), func(res *network.RoutingRuleStatus) error {
+				status := res.TypedSpec()
+
+				status.Family = nethelpers.Family(rule.Family)
+				status.Source = routingRulePrefix(rule.Attributes.Src, rule.SrcLength)
+				status.Destination = routingRulePrefix(rule.Attributes.Dst, rule.DstLength)
+				status.IIFName = pointer.SafeDeref(rule.Attributes.IIFName)
+				status.OIFName = pointer.SafeDeref(rule.Attributes.OIFName)
+				status.FwMark = pointer.SafeDeref(rule.Attributes.FwMark)
+				status.FwMask = pointer.SafeDeref(rule.Attributes.FwMask)
+				status.Table = routingRuleTable(&rule)
+				status.Priority = pointer.SafeDeref(rule.Attributes.Priority)
+				status.Protocol = nethelpers.RouteProtocol(pointer.SafeDeref(rule.Attributes.Protocol))
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying resource: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.RoutingRuleStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
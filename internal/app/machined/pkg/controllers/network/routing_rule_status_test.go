@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type RoutingRuleStatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *RoutingRuleStatusSuite) TestDefaultRules() {
+	ctest.AssertResource(suite, "inet4/32766", func(rule *network.RoutingRuleStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.FamilyInet4, rule.TypedSpec().Family)
+		asrt.Equal(nethelpers.TableMain, rule.TypedSpec().Table)
+		asrt.EqualValues(32766, rule.TypedSpec().Priority)
+		asrt.False(rule.TypedSpec().Source.IsValid())
+	})
+
+	ctest.AssertResource(suite, "inet4/00000", func(rule *network.RoutingRuleStatus, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.TableLocal, rule.TypedSpec().Table)
+	})
+}
+
+func TestRoutingRuleStatusSuite(t *testing.T) {
+	suite.Run(t, &RoutingRuleStatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&netctrl.RoutingRuleStatusController{}))
+			},
+		},
+	})
+}
@@ -0,0 +1,310 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVController creates SR-IOV virtual functions based on machine configuration.
+type SRIOVController struct {
+	V1Alpha1Mode runtime.Mode
+
+	// SysfsPath is the path to the sysfs, defaults to /sys.
+	SysfsPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVController) Name() string {
+	return "network.SRIOVController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.SRIOVVFStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SRIOVController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// SR-IOV doesn't make sense inside a container, so skip the controller
+	if ctrl.V1Alpha1Mode == runtime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.SysfsPath == "" {
+		ctrl.SysfsPath = "/sys"
+	}
+
+	var client *sriov.Client
+
+	defer func() {
+		if client != nil {
+			client.Close() //nolint:errcheck
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil && len(cfg.Config().NetworkSRIOVConfigs()) > 0 {
+			if client == nil {
+				if client, err = sriov.New(); err != nil {
+					return err
+				}
+			}
+
+			linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+			if err != nil {
+				return fmt.Errorf("error listing link statuses: %w", err)
+			}
+
+			resolver := newLinkResolver(logger, cfg.Config(), linkStatuses)
+
+			for _, sriovConfig := range cfg.Config().NetworkSRIOVConfigs() {
+				pfName, ok := resolveSRIOVPhysicalFunction(logger, resolver, sriovConfig)
+				if !ok {
+					continue
+				}
+
+				pfLink, ok := linkStatuses.Find(func(link *network.LinkStatus) bool { return link.Metadata().ID() == pfName })
+				if !ok {
+					// link doesn't exist yet, wait for it to appear
+					continue
+				}
+
+				vfs, err := ctrl.syncPhysicalFunction(logger.With(zap.String("link", pfName)), client, pfName, pfLink.TypedSpec().Index, sriovConfig)
+				if err != nil {
+					logger.Warn("error configuring SR-IOV virtual functions", zap.String("link", pfName), zap.Error(err))
+				}
+
+				for _, vf := range vfs {
+					if err = safe.WriterModify(ctx, r, network.NewSRIOVVFStatus(network.NamespaceName, network.SRIOVVFID(pfName, vf.Index)),
+						func(res *network.SRIOVVFStatus) error {
+							*res.TypedSpec() = vf
+
+							return nil
+						},
+					); err != nil {
+						return fmt.Errorf("error updating SR-IOV virtual function status: %w", err)
+					}
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*network.SRIOVVFStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// resolveSRIOVPhysicalFunction returns the name of the physical function link for the SR-IOV config document.
+func resolveSRIOVPhysicalFunction(logger *zap.Logger, resolver *linkResolver, sriovConfig talosconfig.NetworkSRIOVConfig) (string, bool) {
+	selector, ok := sriovConfig.Selector().Get()
+	if !ok {
+		return resolver.Resolve(sriovConfig.Name())
+	}
+
+	matched := resolver.Select(selector)
+
+	switch len(matched) {
+	case 0:
+		logger.Warn("no links matched the SR-IOV physical function selector", zap.String("config", sriovConfig.Name()))
+
+		return "", false
+	case 1:
+	default:
+		logger.Warn("multiple links matched the SR-IOV physical function selector, using the first one",
+			zap.String("config", sriovConfig.Name()), zap.Strings("matched", matched))
+	}
+
+	return matched[0], true
+}
+
+// syncPhysicalFunction creates the virtual functions, applies the virtual function settings and returns the current state of the virtual functions.
+//
+//nolint:gocyclo
+func (ctrl *SRIOVController) syncPhysicalFunction(
+	logger *zap.Logger, client *sriov.Client, pfName string, pfIndex uint32, sriovConfig talosconfig.NetworkSRIOVConfig,
+) ([]network.SRIOVVFStatusSpec, error) {
+	pf := sriov.NewPhysicalFunction(ctrl.SysfsPath, pfName)
+
+	totalVFs, err := pf.TotalVFs()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errors.New("link doesn't support SR-IOV")
+		}
+
+		return nil, fmt.Errorf("error reading the number of supported virtual functions: %w", err)
+	}
+
+	numVFs := sriovConfig.NumVFs()
+
+	if numVFs > totalVFs {
+		logger.Warn("the number of virtual functions exceeds the supported maximum", zap.Uint32("num_vfs", numVFs), zap.Uint32("total_vfs", totalVFs))
+
+		numVFs = totalVFs
+	}
+
+	currentNumVFs, err := pf.NumVFs()
+	if err != nil {
+		return nil, fmt.Errorf("error reading the number of virtual functions: %w", err)
+	}
+
+	if currentNumVFs != numVFs {
+		logger.Info("changing the number of virtual functions", zap.Uint32("old", currentNumVFs), zap.Uint32("new", numVFs))
+
+		if err = pf.SetNumVFs(numVFs); err != nil {
+			return nil, fmt.Errorf("error setting the number of virtual functions: %w", err)
+		}
+	}
+
+	vfInfos, err := client.VFs(pfIndex)
+	if err != nil {
+		return nil, fmt.Errorf("error getting virtual function settings: %w", err)
+	}
+
+	var settingsChanged bool
+
+	for _, vfConfig := range sriovConfig.VFs() {
+		idx := slices.IndexFunc(vfInfos, func(info sriov.VFInfo) bool { return info.Index == vfConfig.Index() })
+		if idx == -1 {
+			continue
+		}
+
+		settings, changed := vfSettingsDiff(vfInfos[idx], vfConfig)
+		if !changed {
+			continue
+		}
+
+		logger.Info("updating virtual function settings", zap.Uint32("vf", vfConfig.Index()))
+
+		if err = client.SetVF(pfIndex, settings); err != nil {
+			logger.Warn("error updating virtual function settings", zap.Uint32("vf", vfConfig.Index()), zap.Error(err))
+		}
+
+		settingsChanged = true
+	}
+
+	if settingsChanged {
+		if vfInfos, err = client.VFs(pfIndex); err != nil {
+			return nil, fmt.Errorf("error getting virtual function settings: %w", err)
+		}
+	}
+
+	vfs := make([]network.SRIOVVFStatusSpec, 0, numVFs)
+
+	for index := range numVFs {
+		vf, err := pf.VirtualFunction(index)
+		if err != nil {
+			logger.Warn("error reading virtual function device", zap.Uint32("vf", index), zap.Error(err))
+
+			continue
+		}
+
+		status := network.SRIOVVFStatusSpec{
+			PhysicalFunction: pfName,
+			Index:            index,
+			PCIAddress:       vf.PCIAddress,
+			LinkName:         vf.LinkName,
+			Driver:           vf.Driver,
+		}
+
+		if idx := slices.IndexFunc(vfInfos, func(info sriov.VFInfo) bool { return info.Index == index }); idx != -1 {
+			status.HardwareAddr = nethelpers.HardwareAddr(vfInfos[idx].HardwareAddr)
+			status.VLAN = vfInfos[idx].VLAN
+			status.Trust = vfInfos[idx].Trust
+			status.SpoofCheck = vfInfos[idx].SpoofCheck
+		}
+
+		vfs = append(vfs, status)
+	}
+
+	return vfs, nil
+}
+
+// vfSettingsDiff returns the virtual function settings which should be changed.
+func vfSettingsDiff(current sriov.VFInfo, vfConfig talosconfig.NetworkSRIOVVFConfig) (sriov.VFSettings, bool) {
+	settings := sriov.VFSettings{
+		Index: current.Index,
+	}
+
+	var changed bool
+
+	if addr, ok := vfConfig.HardwareAddr().Get(); ok && !bytes.Equal(addr, current.HardwareAddr) {
+		settings.HardwareAddr = net.HardwareAddr(addr)
+		changed = true
+	}
+
+	if vlan := vfConfig.VLAN(); vlan != current.VLAN {
+		settings.VLAN = &vlan
+		changed = true
+	}
+
+	if trust, ok := vfConfig.Trust().Get(); ok && trust != current.Trust {
+		settings.Trust = &trust
+		changed = true
+	}
+
+	if spoofCheck, ok := vfConfig.SpoofCheck().Get(); ok && spoofCheck != current.SpoofCheck {
+		settings.SpoofCheck = &spoofCheck
+		changed = true
+	}
+
+	return settings, changed
+}
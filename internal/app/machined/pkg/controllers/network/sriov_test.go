@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type SRIOVSuite struct {
+	ctest.DefaultSuite
+
+	sysfsPath string
+}
+
+func (suite *SRIOVSuite) pfPath() string {
+	return filepath.Join(suite.sysfsPath, "bus", "pci", "devices", "0000:3b:00.0")
+}
+
+// setupSysfs builds a fake sysfs with a physical function link and two virtual functions.
+func (suite *SRIOVSuite) setupSysfs() {
+	pciDevices := filepath.Join(suite.sysfsPath, "bus", "pci", "devices")
+	driverPath := filepath.Join(suite.sysfsPath, "bus", "pci", "drivers", "iavf")
+
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.sysfsPath, "class", "net", "enp59s0f0"), 0o755))
+	suite.Require().NoError(os.MkdirAll(suite.pfPath(), 0o755))
+	suite.Require().NoError(os.MkdirAll(driverPath, 0o755))
+	suite.Require().NoError(os.Symlink(suite.pfPath(), filepath.Join(suite.sysfsPath, "class", "net", "enp59s0f0", "device")))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.pfPath(), "sriov_totalvfs"), []byte("64\n"), 0o644))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.pfPath(), "sriov_numvfs"), []byte("0\n"), 0o644))
+
+	for i := range 2 {
+		vfPath := filepath.Join(pciDevices, fmt.Sprintf("0000:3b:02.%d", i))
+
+		suite.Require().NoError(os.MkdirAll(filepath.Join(vfPath, "net", fmt.Sprintf("enp59s0f0v%d", i)), 0o755))
+		suite.Require().NoError(os.Symlink(vfPath, filepath.Join(suite.pfPath(), fmt.Sprintf("virtfn%d", i))))
+		suite.Require().NoError(os.Symlink(driverPath, filepath.Join(vfPath, "driver")))
+	}
+}
+
+func (suite *SRIOVSuite) TestReconcile() {
+	linkStatus := network.NewLinkStatus(network.NamespaceName, "enp59s0f0")
+	linkStatus.TypedSpec().Index = 1 // loopback, doesn't report any virtual functions
+	linkStatus.TypedSpec().Type = nethelpers.LinkEther
+	linkStatus.TypedSpec().BusPath = "0000:3b:00.0"
+	linkStatus.TypedSpec().Driver = "ice"
+	suite.Create(linkStatus)
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: SRIOVConfig
+name: pf0
+selector:
+  match: link.bus_path == "0000:3b:00.0"
+numVFs: 2
+`))
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(provider)
+	suite.Create(cfg)
+
+	for i := range 2 {
+		ctest.AssertResource(suite, fmt.Sprintf("enp59s0f0/vf%d", i), func(vf *network.SRIOVVFStatus, asrt *assert.Assertions) {
+			asrt.Equal(network.SRIOVVFStatusSpec{
+				PhysicalFunction: "enp59s0f0",
+				Index:            uint32(i),
+				PCIAddress:       fmt.Sprintf("0000:3b:02.%d", i),
+				LinkName:         fmt.Sprintf("enp59s0f0v%d", i),
+				Driver:           "iavf",
+			}, *vf.TypedSpec())
+		})
+	}
+
+	numVFs, err := os.ReadFile(filepath.Join(suite.pfPath(), "sriov_numvfs"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("2", string(numVFs))
+
+	// remove the config, virtual functions are no longer reported
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	ctest.AssertNoResource[*network.SRIOVVFStatus](suite, "enp59s0f0/vf0")
+	ctest.AssertNoResource[*network.SRIOVVFStatus](suite, "enp59s0f0/vf1")
+}
+
+func TestSRIOVSuite(t *testing.T) {
+	s := &SRIOVSuite{
+		sysfsPath: t.TempDir(),
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 5 * time.Second,
+		AfterSetup: func(*ctest.DefaultSuite) {
+			s.setupSysfs()
+
+			s.Require().NoError(s.Runtime().RegisterController(&netctrl.SRIOVController{
+				SysfsPath: s.sysfsPath,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -46,5 +46,10 @@ func Checks() []CheckDescription {
 			Hysteresis: 30 * time.Second,
 			Check:      KubeletCSRNotApprovedCheck,
 		},
+		{
+			ID:         "disk-health",
+			Hysteresis: 30 * time.Second,
+			Check:      DiskHealthCheck,
+		},
 	}
 }
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// Disk health thresholds.
+const (
+	DiskHealthTemperatureThreshold    = 70 // Celsius
+	DiskHealthPercentageUsedThreshold = 90
+
+	// DiskHealthReallocatedSectorsThreshold is high enough to ignore a few sectors remapped on healthy drives.
+	DiskHealthReallocatedSectorsThreshold = 100
+)
+
+// DiskHealthCheck checks the disk health information for failing or worn out disks.
+func DiskHealthCheck(ctx context.Context, r controller.Reader, logger *zap.Logger) (*runtime.DiagnosticSpec, error) {
+	disksHealth, err := safe.ReaderListAll[*block.DiskHealth](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading disk health: %w", err)
+	}
+
+	var details []string
+
+	for diskHealth := range disksHealth.All() {
+		spec := diskHealth.TypedSpec()
+
+		var problems []string
+
+		if !spec.Healthy {
+			if spec.CriticalWarning != 0 {
+				problems = append(problems, fmt.Sprintf("critical warning 0x%02x", spec.CriticalWarning))
+			} else {
+				problems = append(problems, "SMART health check failed")
+			}
+		}
+
+		if spec.Temperature >= DiskHealthTemperatureThreshold {
+			problems = append(problems, fmt.Sprintf("temperature %d°C", spec.Temperature))
+		}
+
+		if spec.PercentageUsed >= DiskHealthPercentageUsedThreshold {
+			problems = append(problems, fmt.Sprintf("%d%% of the rated endurance used", spec.PercentageUsed))
+		}
+
+		if spec.MediaErrors > 0 {
+			problems = append(problems, fmt.Sprintf("%d media errors", spec.MediaErrors))
+		}
+
+		if spec.ReallocatedSectors >= DiskHealthReallocatedSectorsThreshold {
+			problems = append(problems, fmt.Sprintf("%d reallocated sectors", spec.ReallocatedSectors))
+		}
+
+		if len(problems) > 0 {
+			details = append(details, fmt.Sprintf("%s: %s", diskHealth.Metadata().ID(), strings.Join(problems, ", ")))
+		}
+	}
+
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	return &runtime.DiagnosticSpec{
+		Message: "disk health problems detected",
+		Details: details,
+	}, nil
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/diagnostics"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+func TestDiskHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	for _, test := range []struct {
+		name string
+
+		disks []block.DiskHealthSpec
+
+		expectedWarning *runtime.DiagnosticSpec
+	}{
+		{
+			name: "no disks",
+		},
+		{
+			name: "healthy",
+
+			disks: []block.DiskHealthSpec{
+				{
+					Protocol:       "nvme",
+					Healthy:        true,
+					Temperature:    40,
+					PercentageUsed: 3,
+				},
+			},
+		},
+		{
+			name: "failing",
+
+			disks: []block.DiskHealthSpec{
+				{
+					Protocol:        "nvme",
+					Healthy:         false,
+					CriticalWarning: 0x04,
+					Temperature:     75,
+					PercentageUsed:  95,
+					MediaErrors:     2,
+				},
+				{
+					Protocol:           "ata",
+					Healthy:            true,
+					Temperature:        30,
+					ReallocatedSectors: 120,
+				},
+				{
+					Protocol:           "ata",
+					Healthy:            true,
+					ReallocatedSectors: 8,
+				},
+			},
+
+			expectedWarning: &runtime.DiagnosticSpec{
+				Message: "disk health problems detected",
+				Details: []string{
+					"disk0: critical warning 0x04, temperature 75°C, 95% of the rated endurance used, 2 media errors",
+					"disk1: 120 reallocated sectors",
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := zaptest.NewLogger(t)
+			st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+			for i, spec := range test.disks {
+				diskHealth := block.NewDiskHealth(block.NamespaceName, "disk"+strconv.Itoa(i))
+				*diskHealth.TypedSpec() = spec
+
+				require.NoError(t, st.Create(ctx, diskHealth))
+			}
+
+			spec, err := diagnostics.DiskHealthCheck(ctx, st, logger)
+			require.NoError(t, err)
+
+			if test.expectedWarning == nil {
+				require.Nil(t, spec)
+			} else {
+				require.Equal(t, test.expectedWarning, spec)
+			}
+		})
+	}
+}
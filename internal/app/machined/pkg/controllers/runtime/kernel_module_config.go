@@ -18,6 +18,9 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
+// zramModule is loaded when the zram swap is configured.
+const zramModule = "zram"
+
 // KernelModuleConfigController watches v1alpha1.Config, creates/updates/deletes kernel module specs.
 type KernelModuleConfigController struct{}
 
@@ -67,8 +70,12 @@ func (ctrl *KernelModuleConfigController) Run(ctx context.Context, r controller.
 
 		r.StartTrackingOutputs()
 
+		configuredModules := map[string]struct{}{}
+
 		if cfg != nil && cfg.Config().Machine() != nil {
 			for _, module := range cfg.Config().Machine().Kernel().Modules() {
+				configuredModules[module.Name()] = struct{}{}
+
 				item := runtime.NewKernelModuleSpec(runtime.NamespaceName, module.Name())
 
 				if err = safe.WriterModify(ctx, r, item, func(res *runtime.KernelModuleSpec) error {
@@ -82,6 +89,19 @@ func (ctrl *KernelModuleConfigController) Run(ctx context.Context, r controller.
 			}
 		}
 
+		// zram swap requires the zram module, unless it is explicitly configured
+		_, zramConfigured := configuredModules[zramModule]
+
+		if cfg != nil && cfg.Config().ZramSwap() != nil && !zramConfigured {
+			if err = safe.WriterModify(ctx, r, runtime.NewKernelModuleSpec(runtime.NamespaceName, zramModule), func(res *runtime.KernelModuleSpec) error {
+				res.TypedSpec().Name = zramModule
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
 		if err = safe.CleanupOutputs[*runtime.KernelModuleSpec](ctx, r); err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 
 	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	blockcfg "github.com/siderolabs/talos/pkg/machinery/config/types/block"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -99,6 +100,38 @@ func (suite *KernelModuleConfigSuite) TestReconcileConfig() {
 	))
 }
 
+func (suite *KernelModuleConfigSuite) TestReconcileZramSwap() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelModuleConfigController{}))
+
+	suite.startRuntime()
+
+	zramSwap := blockcfg.NewZramSwapConfigV1Alpha1()
+	zramSwap.ZramSize = blockcfg.MustByteSize("1GiB")
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{},
+		},
+		zramSwap,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	specMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelModuleSpecType, "zram", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			specMD,
+			func(res resource.Resource) bool {
+				return res.(*runtimeresource.KernelModuleSpec).TypedSpec().Name == "zram"
+			},
+		),
+	))
+}
+
 func TestKernelModuleConfigSuite(t *testing.T) {
 	suite.Run(t, new(KernelModuleConfigSuite))
 }
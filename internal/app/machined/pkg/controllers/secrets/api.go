@@ -9,6 +9,8 @@ import (
 	stdlibx509 "crypto/x509"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -18,6 +20,8 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 
 	"github.com/siderolabs/talos/pkg/grpc/gen"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
@@ -30,6 +34,9 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
+// nodeIdentityRetryInterval is the interval to retry the node identity request on worker nodes.
+const nodeIdentityRetryInterval = time.Minute
+
 // APIController manages secrets.API based on configuration to provide apid certificate.
 type APIController struct{}
 
@@ -182,12 +189,23 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 	refreshTicker := time.NewTicker(x509.DefaultCertificateValidityDuration / 2)
 	defer refreshTicker.Stop()
 
+	// worker nodes request the node identity separately from the API server certificate,
+	// and a failure to get the node identity is retried without re-issuing the API server certificate
+	var (
+		nodeIdentityRetryCh <-chan time.Time
+		trustdLocalAddr     netip.Addr
+	)
+
 	for {
+		retryNodeIdentity := false
+
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
 		case <-refreshTicker.C:
+		case <-nodeIdentityRetryCh:
+			retryNodeIdentity = true
 		}
 
 		machineTypeRes, err := safe.ReaderGet[*config.MachineType](ctx, r, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
@@ -269,8 +287,25 @@ func (ctrl *APIController) reconcile(ctx context.Context, r controller.Runtime,
 				return err
 			}
 		} else {
-			if err := ctrl.generateWorker(ctx, r, logger, rootSpec, endpointsStr, certSANs); err != nil {
-				return err
+			if !retryNodeIdentity {
+				localAddr, err := ctrl.generateWorker(ctx, r, logger, rootSpec, endpointsStr, certSANs)
+				if err != nil {
+					return err
+				}
+
+				if !localAddr.IsValid() {
+					continue
+				}
+
+				trustdLocalAddr = localAddr
+			}
+
+			nodeIdentityRetryCh = nil
+
+			if err := ctrl.generateWorkerNodeIdentity(ctx, r, logger, rootSpec, endpointsStr, trustdLocalAddr); err != nil {
+				logger.Warn("failed to get node identity, will retry", zap.Duration("interval", nodeIdentityRetryInterval), zap.Error(err))
+
+				nodeIdentityRetryCh = time.After(nodeIdentityRetryInterval)
 			}
 		}
 
@@ -311,6 +346,34 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 		return fmt.Errorf("failed to generate API client cert: %w", err)
 	}
 
+	var nodeIdentity, nodeIdentityCA *x509.PEMEncodedCertificateAndKey
+
+	// the node identity is issued by the node identity CA, which is not trusted by the Talos API
+	if rootSpec.NodeIdentityCA != nil {
+		nodeIdentityCA = rootSpec.NodeIdentityCA
+
+		ca, err := x509.NewCertificateAuthorityFromCertificateAndKey(nodeIdentityCA)
+		if err != nil {
+			return fmt.Errorf("failed to parse node identity CA certificate: %w", err)
+		}
+
+		nodeIdentityCert, err := x509.NewKeyPair(ca,
+			x509.IPAddresses(certSANs.StdIPs()),
+			x509.DNSNames(certSANs.DNSNames),
+			x509.CommonName(certSANs.FQDN),
+			x509.NotAfter(time.Now().Add(x509.DefaultCertificateValidityDuration)),
+			x509.KeyUsage(stdlibx509.KeyUsageDigitalSignature),
+			x509.ExtKeyUsage([]stdlibx509.ExtKeyUsage{
+				stdlibx509.ExtKeyUsageClientAuth,
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate node identity cert: %w", err)
+		}
+
+		nodeIdentity = x509.NewCertificateAndKeyFromKeyPair(nodeIdentityCert)
+	}
+
 	if err := safe.WriterModify(ctx, r, secrets.NewAPI(),
 		func(r *secrets.API) error {
 			apiSecrets := r.TypedSpec()
@@ -318,6 +381,14 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 			apiSecrets.AcceptedCAs = rootSpec.AcceptedCAs
 			apiSecrets.Server = x509.NewCertificateAndKeyFromKeyPair(serverCert)
 			apiSecrets.Client = x509.NewCertificateAndKeyFromKeyPair(clientCert)
+			apiSecrets.NodeIdentity = nodeIdentity
+			apiSecrets.NodeIdentityCA = nil
+
+			if nodeIdentityCA != nil {
+				apiSecrets.NodeIdentityCA = &x509.PEMEncodedCertificate{
+					Crt: nodeIdentityCA.Crt,
+				}
+			}
 
 			return nil
 		}); err != nil {
@@ -335,12 +406,16 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 	return nil
 }
 
+// generateWorker requests the API server certificate from trustd.
+//
+// It returns the local address of the connection to trustd, which is used for the node identity,
+// or an invalid address if the request was aborted or the local address is unknown.
 func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runtime, logger *zap.Logger,
 	rootSpec *secrets.OSRootSpec, endpointsStr []string, certSANs *secrets.CertSANSpec,
-) error {
+) (netip.Addr, error) {
 	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.AcceptedCAs)
 	if err != nil {
-		return fmt.Errorf("failed creating trustd client: %w", err)
+		return netip.Addr{}, fmt.Errorf("failed creating trustd client: %w", err)
 	}
 
 	defer remoteGen.Close() //nolint:errcheck
@@ -351,13 +426,124 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		x509.CommonName(certSANs.FQDN),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to generate API server CSR: %w", err)
+		return netip.Addr{}, fmt.Errorf("failed to generate API server CSR: %w", err)
 	}
 
 	logger.Debug("sending CSR", zap.Strings("endpoints", endpointsStr))
 
-	var ca []byte
+	var trustdPeer peer.Peer
+
+	ca, crt, err := ctrl.sign(ctx, r, remoteGen, serverCSR, grpc.Peer(&trustdPeer))
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("failed to sign API server CSR: %w", err)
+	}
+
+	if crt == nil {
+		return netip.Addr{}, nil
+	}
+
+	serverCert.Crt = crt
+
+	if err := safe.WriterModify(ctx, r, secrets.NewAPI(),
+		func(r *secrets.API) error {
+			apiSecrets := r.TypedSpec()
+
+			apiSecrets.AcceptedCAs = []*x509.PEMEncodedCertificate{
+				{
+					Crt: ca,
+				},
+			}
+			apiSecrets.Server = serverCert
+
+			return nil
+		}); err != nil {
+		return netip.Addr{}, fmt.Errorf("error modifying resource: %w", err)
+	}
+
+	serverFingerprint, _ := x509.SPKIFingerprintFromPEM(serverCert.Crt) //nolint:errcheck
+
+	logger.Debug("generated new certificates",
+		zap.Stringer("server", serverFingerprint),
+	)
+
+	if trustdPeer.LocalAddr == nil {
+		logger.Warn("local address of the trustd connection is unknown, skipping node identity")
+
+		return netip.Addr{}, nil
+	}
+
+	localAddr, err := netip.ParseAddrPort(trustdPeer.LocalAddr.String())
+	if err != nil {
+		logger.Warn("failed to parse local address of the trustd connection, skipping node identity", zap.Error(err))
+
+		return netip.Addr{}, nil
+	}
+
+	return localAddr.Addr().Unmap(), nil
+}
 
+// generateWorkerNodeIdentity requests the node identity from trustd.
+//
+// trustd issues the node identity only for the address the request comes from,
+// so the CSR requests the local address of the connection to trustd.
+func (ctrl *APIController) generateWorkerNodeIdentity(ctx context.Context, r controller.Runtime, logger *zap.Logger,
+	rootSpec *secrets.OSRootSpec, endpointsStr []string, localAddr netip.Addr,
+) error {
+	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.AcceptedCAs)
+	if err != nil {
+		return fmt.Errorf("failed creating trustd client: %w", err)
+	}
+
+	defer remoteGen.Close() //nolint:errcheck
+
+	nodeIdentityCSR, nodeIdentity, err := gen.NewClientIdentityCSR(
+		x509.IPAddresses([]net.IP{localAddr.AsSlice()}),
+		x509.CommonName(localAddr.String()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate node identity CSR: %w", err)
+	}
+
+	nodeIdentityCA, crt, err := ctrl.sign(ctx, r, remoteGen, nodeIdentityCSR)
+	if err != nil {
+		return fmt.Errorf("failed to sign node identity CSR: %w", err)
+	}
+
+	if crt == nil {
+		return nil
+	}
+
+	nodeIdentity.Crt = crt
+
+	if err := safe.WriterModify(ctx, r, secrets.NewAPI(),
+		func(r *secrets.API) error {
+			apiSecrets := r.TypedSpec()
+
+			apiSecrets.NodeIdentity = nodeIdentity
+			apiSecrets.NodeIdentityCA = &x509.PEMEncodedCertificate{
+				Crt: nodeIdentityCA,
+			}
+
+			return nil
+		}); err != nil {
+		return fmt.Errorf("error modifying resource: %w", err)
+	}
+
+	nodeIdentityFingerprint, _ := x509.SPKIFingerprintFromPEM(nodeIdentity.Crt) //nolint:errcheck
+
+	logger.Debug("generated new node identity",
+		zap.Stringer("node_identity", nodeIdentityFingerprint),
+	)
+
+	return nil
+}
+
+// sign sends the CSR to trustd.
+//
+// If the inputs change, the request is aborted, the reconcile is re-queued, and the returned certificate is nil.
+func (ctrl *APIController) sign(ctx context.Context, r controller.Runtime, remoteGen *gen.RemoteGenerator,
+	csr *x509.CertificateSigningRequest, opts ...grpc.CallOption,
+) (ca, crt []byte, err error) {
 	// run the CSR generation in a goroutine, so we can abort the request if the inputs change
 	errCh := make(chan error)
 
@@ -365,7 +551,10 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 	defer cancel()
 
 	go func() {
-		ca, serverCert.Crt, err = remoteGen.IdentityContext(ctx, serverCSR)
+		var err error
+
+		ca, crt, err = remoteGen.IdentityContext(ctx, csr, opts...)
+
 		errCh <- err
 	}()
 
@@ -380,37 +569,15 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		// wait for the goroutine to finish, ignoring the error (should be context.Canceled)
 		<-errCh
 
-		return nil
+		return nil, nil, nil
 	case err = <-errCh:
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to sign API server CSR: %w", err)
-	}
-
-	if err := safe.WriterModify(ctx, r, secrets.NewAPI(),
-		func(r *secrets.API) error {
-			apiSecrets := r.TypedSpec()
-
-			apiSecrets.AcceptedCAs = []*x509.PEMEncodedCertificate{
-				{
-					Crt: ca,
-				},
-			}
-			apiSecrets.Server = serverCert
-
-			return nil
-		}); err != nil {
-		return fmt.Errorf("error modifying resource: %w", err)
+		return nil, nil, err
 	}
 
-	serverFingerprint, _ := x509.SPKIFingerprintFromPEM(serverCert.Crt) //nolint:errcheck
-
-	logger.Debug("generated new certificates",
-		zap.Stringer("server", serverFingerprint),
-	)
-
-	return nil
+	return ca, crt, nil
 }
 
 func (ctrl *APIController) teardownAll(ctx context.Context, r controller.Runtime) error {
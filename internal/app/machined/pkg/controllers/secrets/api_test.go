@@ -57,6 +57,16 @@ func (suite *APISuite) TestReconcileControlPlane() {
 			Crt: talosCA.CrtPEM,
 		},
 	}
+
+	nodeIdentityCA, err := x509.NewSelfSignedCertificateAuthority(
+		x509.Organization("talos-node-identity"),
+	)
+	suite.Require().NoError(err)
+
+	rootSecrets.TypedSpec().NodeIdentityCA = &x509.PEMEncodedCertificateAndKey{
+		Crt: nodeIdentityCA.CrtPEM,
+		Key: nodeIdentityCA.KeyPEM,
+	}
 	rootSecrets.TypedSpec().CertSANDNSNames = []string{"example.com"}
 	rootSecrets.TypedSpec().CertSANIPs = []netip.Addr{netip.MustParseAddr("10.4.3.2"), netip.MustParseAddr("10.2.1.3")}
 	rootSecrets.TypedSpec().Token = "something"
@@ -143,6 +153,43 @@ func (suite *APISuite) TestReconcileControlPlane() {
 		)
 		suite.Assert().Equal([]stdlibx509.ExtKeyUsage{stdlibx509.ExtKeyUsageClientAuth}, clientCert.ExtKeyUsage)
 
+		nodeIdentity, err := apiCerts.NodeIdentity.GetCert()
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal([]string{"example.com", "foo", "foo.example.com"}, nodeIdentity.DNSNames)
+		suite.Assert().Equal("[10.2.1.3 10.4.3.2 172.16.0.1]", fmt.Sprintf("%v", nodeIdentity.IPAddresses))
+
+		suite.Assert().Equal("foo.example.com", nodeIdentity.Subject.CommonName)
+		suite.Assert().Empty(nodeIdentity.Subject.Organization)
+
+		suite.Assert().Equal(
+			stdlibx509.KeyUsageDigitalSignature,
+			nodeIdentity.KeyUsage,
+		)
+		suite.Assert().Equal([]stdlibx509.ExtKeyUsage{stdlibx509.ExtKeyUsageClientAuth}, nodeIdentity.ExtKeyUsage)
+
+		// the node identity is issued by the node identity CA, which is not accepted by the Talos API
+		suite.Require().NotNil(apiCerts.NodeIdentityCA)
+		suite.Assert().Equal(nodeIdentityCA.CrtPEM, apiCerts.NodeIdentityCA.Crt)
+
+		nodeIdentityCAPool := stdlibx509.NewCertPool()
+		suite.Require().True(nodeIdentityCAPool.AppendCertsFromPEM(apiCerts.NodeIdentityCA.Crt))
+
+		_, err = nodeIdentity.Verify(stdlibx509.VerifyOptions{
+			Roots:     nodeIdentityCAPool,
+			KeyUsages: []stdlibx509.ExtKeyUsage{stdlibx509.ExtKeyUsageClientAuth},
+		})
+		suite.Assert().NoError(err)
+
+		talosCAPool := stdlibx509.NewCertPool()
+		suite.Require().True(talosCAPool.AppendCertsFromPEM(talosCA.CrtPEM))
+
+		_, err = nodeIdentity.Verify(stdlibx509.VerifyOptions{
+			Roots:     talosCAPool,
+			KeyUsages: []stdlibx509.ExtKeyUsage{stdlibx509.ExtKeyUsageClientAuth},
+		})
+		suite.Assert().Error(err)
+
 		return nil
 	})
 }
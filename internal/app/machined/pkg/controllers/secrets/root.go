@@ -172,6 +172,13 @@ func NewRootOSController() *RootOSController {
 					}
 				}
 
+				osSecrets.NodeIdentityCA = cfgProvider.Machine().Security().NodeIdentityCA()
+
+				if osSecrets.NodeIdentityCA != nil && len(osSecrets.NodeIdentityCA.Key) == 0 {
+					// drop incomplete node identity CA, as it is used only to issue the node identities
+					osSecrets.NodeIdentityCA = nil
+				}
+
 				osSecrets.CertSANIPs = nil
 				osSecrets.CertSANDNSNames = nil
 
@@ -87,6 +87,8 @@ func (suite *RootSuite) TestReconcileControlPlane() {
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.OSRootID},
 		func(res *secrets.OSRoot, asrt *assert.Assertions) {
 			asrt.Equal(res.TypedSpec().IssuingCA, cfg.Machine().Security().IssuingCA())
+			asrt.NotNil(res.TypedSpec().NodeIdentityCA)
+			asrt.Equal(res.TypedSpec().NodeIdentityCA, cfg.Machine().Security().NodeIdentityCA())
 			asrt.Equal(
 				[]*x509.PEMEncodedCertificate{
 					{
@@ -105,6 +107,7 @@ func (suite *RootSuite) TestReconcileWorker() {
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.OSRootID},
 		func(res *secrets.OSRoot, asrt *assert.Assertions) {
 			asrt.Nil(res.TypedSpec().IssuingCA)
+			asrt.Nil(res.TypedSpec().NodeIdentityCA)
 			asrt.Equal(
 				[]*x509.PEMEncodedCertificate{
 					{
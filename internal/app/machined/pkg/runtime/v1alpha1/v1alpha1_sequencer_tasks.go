@@ -958,6 +958,9 @@ func setupVarRun(logger *log.Logger) error {
 }
 
 // MountUserDisks represents the MountUserDisks task.
+//
+// The user disks are mounted by the UserVolumeMountController, the task waits for the volumes to be mounted,
+// so that the services are started with the user disks in place.
 func MountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
 		// wait for user disk config to be ready
@@ -978,40 +981,82 @@ func MountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return err
 		}
 
-		if volumeConfigs.Len() == 0 {
-			// no user disks
-			return nil
-		}
+		for volumeConfig := range volumeConfigs.All() {
+			if volumeConfig.TypedSpec().Mount.TargetPath == "" {
+				continue
+			}
 
-		var mountpoints mountv2.Points
+			if name, ok := strings.CutPrefix(volumeConfig.Metadata().ID(), constants.UserVolumePrefix); ok {
+				timeout := r.Config().Volumes().ByName(name).MountTimeout().ValueOr(constants.UserVolumeMountTimeout)
 
-		// wait for volume statuses to be ready
-		for volumeConfig := range volumeConfigs.All() {
-			volumeStatus, err := safe.StateWatchFor[*blockres.VolumeStatus](ctx,
-				r.State().V1Alpha2().Resources(),
-				blockres.NewVolumeStatus(volumeConfig.Metadata().Namespace(), volumeConfig.Metadata().ID()).Metadata(),
-				state.WithEventTypes(state.Created, state.Updated),
-				state.WithCondition(func(r resource.Resource) (bool, error) {
-					return r.(*blockres.VolumeStatus).TypedSpec().Phase == blockres.VolumePhaseReady, nil
-				}),
-			)
-			if err != nil {
-				return fmt.Errorf("failed to watch for volume status %s: %w", volumeConfig.Metadata().ID(), err)
+				if err = waitForUserVolumeMount(ctx, logger, r, volumeConfig, timeout); err != nil {
+					return err
+				}
+
+				continue
 			}
 
-			mountpoints = append(mountpoints, mountv2.NewPoint(
-				volumeStatus.TypedSpec().MountLocation,
-				volumeConfig.TypedSpec().Mount.TargetPath,
-				volumeStatus.TypedSpec().Filesystem.String(),
-			))
-		}
+			// .machine.disks block the boot until the volume is ready
+			if _, err = waitForVolumeReady(ctx, r, volumeConfig.Metadata().ID()); err != nil {
+				return fmt.Errorf("failed to wait for volume %s: %w", volumeConfig.Metadata().ID(), err)
+			}
 
-		_, err = mountpoints.Mount(mountv2.WithMountPrinter(logger.Printf))
+			if err = waitForVolumeMount(ctx, r, volumeConfig.Metadata().ID()); err != nil {
+				return err
+			}
+		}
 
-		return err
+		return nil
 	}, "mountUserDisks"
 }
 
+// waitForUserVolumeMount waits for the user volume to be mounted up to the timeout.
+//
+// Missing, failed and slow to provision user volumes don't block the boot, they are mounted once they become ready.
+func waitForUserVolumeMount(ctx context.Context, logger *log.Logger, r runtime.Runtime, volumeConfig *blockres.VolumeConfig, timeout time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	volumeStatus, err := blockres.WaitForVolumePhase(waitCtx, r.State().V1Alpha2().Resources(), volumeConfig.Metadata().ID(),
+		blockres.VolumePhaseReady, blockres.VolumePhaseMissing, blockres.VolumePhaseFailed,
+	)
+	if err == nil {
+		switch volumeStatus.TypedSpec().Phase { //nolint:exhaustive
+		case blockres.VolumePhaseMissing:
+			logger.Printf("user volume %s is missing, skipping mount to %s", volumeConfig.Metadata().ID(), volumeConfig.TypedSpec().Mount.TargetPath)
+
+			return nil
+		case blockres.VolumePhaseFailed:
+			logger.Printf("user volume %s failed, skipping mount to %s: %s",
+				volumeConfig.Metadata().ID(), volumeConfig.TypedSpec().Mount.TargetPath, volumeStatus.TypedSpec().ErrorMessage)
+
+			return nil
+		}
+
+		err = waitForVolumeMount(waitCtx, r, volumeConfig.Metadata().ID())
+	}
+
+	if err != nil && ctx.Err() == nil && waitCtx.Err() != nil {
+		logger.Printf("user volume %s is not mounted to %s in %s, continuing the boot", volumeConfig.Metadata().ID(), volumeConfig.TypedSpec().Mount.TargetPath, timeout)
+
+		return nil
+	}
+
+	return err
+}
+
+func waitForVolumeMount(ctx context.Context, r runtime.Runtime, id resource.ID) error {
+	if _, err := safe.StateWatchFor[*resourceruntime.MountStatus](ctx,
+		r.State().V1Alpha2().Resources(),
+		resourceruntime.NewMountStatus(resourceruntime.NamespaceName, id).Metadata(),
+		state.WithEventTypes(state.Created, state.Updated),
+	); err != nil {
+		return fmt.Errorf("failed to wait for volume %s to be mounted: %w", id, err)
+	}
+
+	return nil
+}
+
 // WriteUserFiles represents the WriteUserFiles task.
 //
 //nolint:gocyclo,cyclop
@@ -1210,43 +1255,28 @@ func UnmountOverlayFilesystems(runtime.Sequence, any) (runtime.TaskExecutionFunc
 }
 
 // UnmountUserDisks represents the UnmountUserDisks task.
+//
+// The user disks are unmounted by the UserVolumeMountController once the EPHEMERAL mount status is torn down,
+// the task waits for the controller to release the EPHEMERAL mount status.
 func UnmountUserDisks(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		// fetch user disk volume configs
-		volumeConfigs, err := safe.StateListAll[*blockres.VolumeConfig](ctx, r.State().V1Alpha2().Resources(), state.WithLabelQuery(resource.LabelExists(blockres.UserDiskLabel)))
-		if err != nil {
-			return err
-		}
-
-		if volumeConfigs.Len() == 0 {
-			// no user disks
-			return nil
-		}
-
-		var mountpoints mountv2.Points
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
 
-		for volumeConfig := range volumeConfigs.All() {
-			volumeStatus, err := safe.StateGetByID[*blockres.VolumeStatus](
-				ctx,
-				r.State().V1Alpha2().Resources(),
-				volumeConfig.Metadata().ID(),
-			)
-			if err != nil {
-				continue
-			}
+		ephemeralMountStatus := resourceruntime.NewMountStatus(resourceruntime.NamespaceName, constants.EphemeralPartitionLabel).Metadata()
 
-			if volumeStatus.TypedSpec().Phase != blockres.VolumePhaseReady {
-				continue
+		_, err := r.State().V1Alpha2().Resources().Teardown(ctx, ephemeralMountStatus)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
 			}
 
-			mountpoints = append(mountpoints, mountv2.NewPoint(
-				volumeStatus.TypedSpec().MountLocation,
-				volumeConfig.TypedSpec().Mount.TargetPath,
-				volumeStatus.TypedSpec().Filesystem.String(),
-			))
+			return err
 		}
 
-		return mountpoints.Unmount()
+		_, err = r.State().V1Alpha2().Resources().WatchFor(ctx, ephemeralMountStatus, state.WithFinalizerEmpty())
+
+		return err
 	}, "unmountUserDisks"
 }
 
@@ -1986,12 +2016,17 @@ func UnmountStatePartition(runtime.Sequence, any) (runtime.TaskExecutionFunc, st
 // MountEphemeralPartition mounts the ephemeral partition.
 func MountEphemeralPartition(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		if _, err := waitForVolumeReady(ctx, r, constants.EphemeralPartitionLabel); err != nil {
+		volumeStatus, err := waitForVolumeReady(ctx, r, constants.EphemeralPartitionLabel)
+		if err != nil {
 			return err
 		}
 
+		// project quotas are required for the directory quotas, but they are only supported on XFS
+		projectQuota := r.Config().Machine().Features().DiskQuotaSupportEnabled() ||
+			(len(r.Config().ProjectQuotas()) > 0 && volumeStatus.TypedSpec().Filesystem == blockres.FilesystemTypeXFS)
+
 		return mount.SystemPartitionMount(ctx, r, logger, constants.EphemeralPartitionLabel,
-			mountv2.WithProjectQuota(r.Config().Machine().Features().DiskQuotaSupportEnabled()))
+			mountv2.WithProjectQuota(projectQuota))
 	}, "mountEphemeralPartition"
 }
 
@@ -92,10 +92,17 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
 		&block.DiscoveryController{},
+		&block.DiskHealthController{},
 		&block.DisksController{},
 		&block.LVMActivationController{},
+		&block.ProjectQuotaController{},
+		&block.SwapController{},
+		&block.SwapStatusController{},
 		&block.SystemDiskController{},
 		&block.UserDiskConfigController{},
+		&block.UserVolumeMountController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&block.VolumeConfigController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -213,6 +220,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Logger: dnsCacheLogger,
 		},
 		&network.DNSUpstreamController{},
+		&network.Dot1XController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&network.EtcFileController{
 			PodResolvConfPath: constants.PodResolvConfPath,
 			V1Alpha1Mode:      ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -226,6 +236,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.HostnameSpecController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&network.LLDPController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&network.LinkConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -234,6 +247,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkStatusController{},
 		&network.NfTablesChainConfigController{},
 		&network.NfTablesChainController{},
+		&network.NfTablesChainStatusController{},
+		&network.NfTablesLogController{},
 		&network.NodeAddressController{},
 		&network.OperatorConfigController{
 			Cmdline: procfs.ProcCmdline(),
@@ -250,7 +265,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1alpha1Platform: ctrl.v1alpha1Runtime.State().Platform(),
 			PlatformState:    ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 		},
+		&network.ProbeConfigController{},
 		&network.ProbeController{},
+		&network.QdiscConfigController{},
+		&network.QdiscSpecController{},
+		&network.QdiscStatusController{},
 		&network.ResolverConfigController{
 			Cmdline: procfs.ProcCmdline(),
 		},
@@ -262,6 +281,12 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteSpecController{},
 		&network.RouteStatusController{},
+		&network.RoutingRuleConfigController{},
+		&network.RoutingRuleSpecController{},
+		&network.RoutingRuleStatusController{},
+		&network.SRIOVController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&network.StatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -100,6 +100,10 @@ func NewState() (*State, error) {
 		&block.DiscoveryRefreshRequest{},
 		&block.DiscoveryRefreshStatus{},
 		&block.Disk{},
+		&block.DiskHealth{},
+		&block.KeySlotRefreshRequest{},
+		&block.ProjectQuotaStatus{},
+		&block.SwapStatus{},
 		&block.SystemDisk{},
 		&block.UserDiskConfigStatus{},
 		&block.VolumeConfig{},
@@ -160,26 +164,35 @@ func NewState() (*State, error) {
 		&kubespan.PeerStatus{},
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.DelegatedPrefix{},
 		&network.DeviceConfigSpec{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
+		&network.Dot1XStatus{},
 		&network.HardwareAddr{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
+		&network.LLDPNeighbor{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NfTablesChain{},
+		&network.NfTablesChainStatus{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
 		&network.ProbeSpec{},
 		&network.ProbeStatus{},
+		&network.QdiscSpec{},
+		&network.QdiscStatus{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleSpec{},
+		&network.RoutingRuleStatus{},
+		&network.SRIOVVFStatus{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
@@ -47,6 +47,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/DiskUsage":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/EncryptionKeySlot":           role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdAlarmList":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdAlarmDisarm":             role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdDefragment":              role.MakeSet(role.Admin, role.Operator),
@@ -99,7 +100,8 @@ var rules = map[string]role.Set{
 	"/cosi.resource.State/Update":  role.MakeSet(role.Admin),
 	"/cosi.resource.State/Watch":   role.MakeSet(role.Admin, role.Operator, role.Reader),
 
-	"/storage.StorageService/Disks": role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/storage.StorageService/BlockDeviceWipe": role.MakeSet(role.Admin),
+	"/storage.StorageService/Disks":           role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/time.TimeService/Time":      role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/time.TimeService/TimeCheck": role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -24,6 +24,9 @@ import (
 type Server struct {
 	storage.UnimplementedStorageServiceServer
 	Controller runtime.Controller
+
+	// MaintenanceMode disables the APIs which are not safe to be exposed in the maintenance mode.
+	MaintenanceMode bool
 }
 
 // Disks implements storage.StorageService.
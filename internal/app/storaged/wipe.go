@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/wipe"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// wipeProgressInterval is the minimum interval between the progress updates sent to the client.
+const wipeProgressInterval = time.Second
+
+var wipeMethods = map[storage.BlockDeviceWipeDescriptor_Method]wipe.Method{
+	storage.BlockDeviceWipeDescriptor_FAST:         wipe.MethodFast,
+	storage.BlockDeviceWipeDescriptor_ZEROES:       wipe.MethodZeroes,
+	storage.BlockDeviceWipeDescriptor_DISCARD:      wipe.MethodDiscard,
+	storage.BlockDeviceWipeDescriptor_CRYPTO_ERASE: wipe.MethodCryptoErase,
+}
+
+// BlockDeviceWipe implements storage.StorageService.
+func (s *Server) BlockDeviceWipe(in *storage.BlockDeviceWipeRequest, srv storage.StorageService_BlockDeviceWipeServer) error {
+	if s.MaintenanceMode {
+		return status.Error(codes.Unimplemented, "block device wipe is not supported in maintenance mode")
+	}
+
+	if len(in.GetDevices()) == 0 {
+		return status.Error(codes.InvalidArgument, "no devices to wipe")
+	}
+
+	ctx := srv.Context()
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	// validate all devices before wiping any of them
+	for _, device := range in.GetDevices() {
+		if _, ok := wipeMethods[device.GetMethod()]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unsupported wipe method %s", device.GetMethod())
+		}
+
+		if err := checkWipeTarget(ctx, st, device.GetDevice()); err != nil {
+			return err
+		}
+	}
+
+	for _, device := range in.GetDevices() {
+		if err := wipeDevice(ctx, srv, device); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkWipeTarget(ctx context.Context, st state.State, deviceName string) error {
+	id := strings.TrimPrefix(deviceName, "/dev/")
+
+	if id == "" || strings.Contains(id, "/") {
+		return status.Errorf(codes.InvalidArgument, "invalid device name %q", deviceName)
+	}
+
+	discoveredVolumes, err := safe.StateListAll[*block.DiscoveredVolume](ctx, st)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error listing discovered volumes: %s", err)
+	}
+
+	dv, found := discoveredVolumes.Find(func(dv *block.DiscoveredVolume) bool { return dv.Metadata().ID() == id })
+	if !found {
+		return status.Errorf(codes.NotFound, "device %q not found", deviceName)
+	}
+
+	diskID := id
+	if dv.TypedSpec().Parent != "" {
+		diskID = dv.TypedSpec().Parent
+	}
+
+	disk, err := safe.StateGetByID[*block.Disk](ctx, st, diskID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return status.Errorf(codes.Internal, "error getting disk %q: %s", diskID, err)
+	}
+
+	if disk != nil {
+		if disk.TypedSpec().Readonly {
+			return status.Errorf(codes.FailedPrecondition, "device %q is read-only", deviceName)
+		}
+
+		if disk.TypedSpec().CDROM {
+			return status.Errorf(codes.FailedPrecondition, "device %q is a CD-ROM", deviceName)
+		}
+	}
+
+	systemDisk, err := block.GetSystemDisk(ctx, st)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%s", err)
+	}
+
+	if systemDisk != nil && (id == systemDisk.DiskID || diskID == systemDisk.DiskID) {
+		return status.Errorf(codes.FailedPrecondition, "device %q is on the system disk", deviceName)
+	}
+
+	volumeStatuses, err := safe.StateListAll[*block.VolumeStatus](ctx, st)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error listing volume statuses: %s", err)
+	}
+
+	parents := map[string]string{}
+
+	for dv := range discoveredVolumes.All() {
+		if dv.TypedSpec().ParentDevPath != "" {
+			parents[dv.TypedSpec().DevPath] = dv.TypedSpec().ParentDevPath
+		}
+	}
+
+	if volumeIDs := volumesUsingDevice(deviceNameToPath(id), parents, safe.ToSlice(volumeStatuses, func(vs *block.VolumeStatus) *block.VolumeStatus { return vs })); len(volumeIDs) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "device %q is used by volumes: %s", deviceName, strings.Join(volumeIDs, ", "))
+	}
+
+	return nil
+}
+
+// volumesUsingDevice returns IDs of the volumes which use the device either directly,
+// via one of its partitions, or as a member of a RAID array or LVM volume group.
+//
+// Other partitions of the disk backing the volume are not considered to be in use.
+//
+// The parents map is the mapping of partition device paths to the whole disk device paths.
+func volumesUsingDevice(devPath string, parents map[string]string, volumeStatuses []*block.VolumeStatus) []string {
+	var volumeIDs []string
+
+	for _, vs := range volumeStatuses {
+		if vs.TypedSpec().ParentLocation == devPath {
+			volumeIDs = append(volumeIDs, vs.Metadata().ID())
+
+			continue
+		}
+
+		paths := []string{vs.TypedSpec().Location}
+
+		if vs.TypedSpec().RAID != nil {
+			paths = append(paths, vs.TypedSpec().RAID.Members...)
+		}
+
+		if vs.TypedSpec().LVM != nil {
+			paths = append(paths, vs.TypedSpec().LVM.PhysicalVolumes...)
+		}
+
+		paths = xslices.FilterInPlace(paths, func(path string) bool { return path != "" })
+
+		for _, path := range paths {
+			// the device itself, the partition of the device, or the whole disk the device is a partition of
+			if path == devPath || parents[path] == devPath || parents[devPath] == path {
+				volumeIDs = append(volumeIDs, vs.Metadata().ID())
+
+				break
+			}
+		}
+	}
+
+	return volumeIDs
+}
+
+func wipeDevice(ctx context.Context, srv storage.StorageService_BlockDeviceWipeServer, device *storage.BlockDeviceWipeDescriptor) error {
+	devPath := deviceNameToPath(device.GetDevice())
+	method := wipeMethods[device.GetMethod()]
+
+	log.Printf("wiping block device %q with method %s", devPath, method)
+
+	var (
+		lastProgress wipe.Progress
+		lastSent     time.Time
+		sendErr      error
+	)
+
+	err := wipe.Device(ctx, devPath, method, func(p wipe.Progress) {
+		lastProgress = p
+
+		if sendErr != nil || time.Since(lastSent) < wipeProgressInterval {
+			return
+		}
+
+		lastSent = time.Now()
+
+		sendErr = srv.Send(&storage.BlockDeviceWipeProgress{
+			Device:     device.GetDevice(),
+			Method:     device.GetMethod(),
+			WipedBytes: p.Wiped,
+			TotalBytes: p.Total,
+		})
+	})
+	if err != nil {
+		log.Printf("failed to wipe block device %q: %s", devPath, err)
+
+		if errors.Is(err, wipe.ErrNotSupported) {
+			return status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return status.Errorf(codes.Internal, "%s", err)
+	}
+
+	if sendErr != nil {
+		return sendErr
+	}
+
+	log.Printf("wiped block device %q with method %s", devPath, method)
+
+	return srv.Send(&storage.BlockDeviceWipeProgress{
+		Device:     device.GetDevice(),
+		Method:     device.GetMethod(),
+		WipedBytes: lastProgress.Wiped,
+		TotalBytes: lastProgress.Total,
+		Done:       true,
+	})
+}
+
+func deviceNameToPath(name string) string {
+	return filepath.Join("/dev", strings.TrimPrefix(name, "/dev/"))
+}
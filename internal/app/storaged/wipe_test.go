@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+func TestVolumesUsingDevice(t *testing.T) {
+	t.Parallel()
+
+	newVolumeStatus := func(id string, modify func(*block.VolumeStatusSpec)) *block.VolumeStatus {
+		vs := block.NewVolumeStatus(block.NamespaceName, id)
+		modify(vs.TypedSpec())
+
+		return vs
+	}
+
+	volumeStatuses := []*block.VolumeStatus{
+		newVolumeStatus("u-data", func(spec *block.VolumeStatusSpec) {
+			spec.Location = "/dev/sdb1"
+			spec.ParentLocation = "/dev/sdb"
+		}),
+		newVolumeStatus("u-whole", func(spec *block.VolumeStatusSpec) {
+			spec.Location = "/dev/sdc"
+		}),
+		newVolumeStatus("u-raid", func(spec *block.VolumeStatusSpec) {
+			spec.Location = "/dev/md127"
+			spec.RAID = &block.RAIDStatus{
+				Members: []string{"/dev/sdd1", "/dev/sde1"},
+			}
+		}),
+		newVolumeStatus("u-lvm", func(spec *block.VolumeStatusSpec) {
+			spec.Location = "/dev/dm-0"
+			spec.LVM = &block.LVMStatus{
+				PhysicalVolumes: []string{"/dev/sdf"},
+			}
+		}),
+		newVolumeStatus("u-waiting", func(spec *block.VolumeStatusSpec) {}),
+	}
+
+	parents := map[string]string{
+		"/dev/sdb1": "/dev/sdb",
+		"/dev/sdb2": "/dev/sdb",
+		"/dev/sdc1": "/dev/sdc",
+		"/dev/sdd1": "/dev/sdd",
+		"/dev/sde1": "/dev/sde",
+		"/dev/sdg1": "/dev/sdg",
+	}
+
+	for _, test := range []struct {
+		devPath  string
+		expected []string
+	}{
+		{devPath: "/dev/sdb", expected: []string{"u-data"}},
+		{devPath: "/dev/sdb1", expected: []string{"u-data"}},
+		{devPath: "/dev/sdb2"},
+		{devPath: "/dev/sdc", expected: []string{"u-whole"}},
+		{devPath: "/dev/sdc1", expected: []string{"u-whole"}},
+		{devPath: "/dev/sdd", expected: []string{"u-raid"}},
+		{devPath: "/dev/sde1", expected: []string{"u-raid"}},
+		{devPath: "/dev/sdf", expected: []string{"u-lvm"}},
+		{devPath: "/dev/sdg"},
+		{devPath: "/dev/sdg1"},
+	} {
+		t.Run(test.devPath, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, volumesUsingDevice(test.devPath, parents, volumeStatuses))
+		})
+	}
+}
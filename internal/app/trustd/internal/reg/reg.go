@@ -10,7 +10,9 @@ import (
 	stdx509 "crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"log"
+	"net/netip"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -22,6 +24,7 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/talos/pkg/grpc/gen"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -43,7 +46,10 @@ func (r *Registrator) Register(s *grpc.Server) {
 
 // Certificate implements the securityapi.SecurityServer interface.
 //
-// This API is called by Talos worker nodes to request a server certificate for apid running on the node.
+// This API is called by Talos worker nodes to request a server certificate for apid running on the node,
+// and a client certificate for the node identity (which is used to authenticate the node to external services).
+// The node identity is issued by the node identity CA for the peer address only, and the response contains the node identity CA
+// instead of the accepted CAs.
 // Control plane nodes generate certificates (client and server) directly from machine config PKI.
 func (r *Registrator) Certificate(ctx context.Context, in *securityapi.CertificateRequest) (resp *securityapi.CertificateResponse, err error) {
 	remotePeer, ok := peer.FromContext(ctx)
@@ -69,13 +75,11 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	log.Printf("received CSR signing request from %s: subject %s dns names %s addresses %s", remotePeer.Addr, request.Subject, request.DNSNames, request.IPAddresses)
 
-	// allow only server auth certificates
 	x509Opts := []x509.Option{
 		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
-		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth}),
 	}
 
-	// don't allow any certificates which can be used for client authentication
+	// don't allow any certificates which can be used for the Talos API client authentication
 	//
 	// we don't return an error here, as otherwise workers running old versions of Talos
 	// will fail to provision client certificate and will never launch apid
@@ -89,6 +93,14 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 		}))
 	}
 
+	// the node identity (client auth certificate) is issued by the node identity CA, which is not trusted by the Talos API,
+	// so it can't be used for the Talos API client authentication, while server auth certificates are issued by the OS CA
+	if gen.IsClientIdentityCSR(request) {
+		return r.nodeIdentity(remotePeer, osRoot, request, x509Opts)
+	}
+
+	x509Opts = append(x509Opts, x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth}))
+
 	// TODO: Verify that the request is coming from the IP address declared in
 	// the CSR.
 	signed, err := x509.NewCertificateFromCSRBytes(
@@ -116,3 +128,60 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	return resp, nil
 }
+
+// nodeIdentity signs the node identity CSR with the node identity CA.
+//
+// trustd can't verify the names requested by the node, so the node identity is bound to the peer address:
+// the CSR should request exactly the address the request comes from.
+func (r *Registrator) nodeIdentity(remotePeer *peer.Peer, osRoot *secrets.OSRoot, request *stdx509.CertificateRequest, x509Opts []x509.Option) (*securityapi.CertificateResponse, error) {
+	if osRoot.TypedSpec().NodeIdentityCA == nil {
+		return nil, status.Error(codes.FailedPrecondition, "node identity CA is not configured")
+	}
+
+	peerAddr, err := netip.ParseAddrPort(remotePeer.Addr.String())
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to parse peer address: %s", err)
+	}
+
+	if err = verifyNodeIdentityCSR(request, peerAddr.Addr().Unmap()); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "node identity CSR doesn't match the peer address: %s", err)
+	}
+
+	nodeIdentityCA, err := x509.NewCertificateAuthorityFromCertificateAndKey(osRoot.TypedSpec().NodeIdentityCA)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse node identity CA: %s", err)
+	}
+
+	x509Opts = append(x509Opts, x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageClientAuth}))
+
+	signed, err := x509.NewCertificateFromCSR(nodeIdentityCA.Crt, nodeIdentityCA.Key, request, x509Opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign CSR: %s", err)
+	}
+
+	return &securityapi.CertificateResponse{
+		Ca:  osRoot.TypedSpec().NodeIdentityCA.Crt,
+		Crt: signed.X509CertificatePEM,
+	}, nil
+}
+
+func verifyNodeIdentityCSR(request *stdx509.CertificateRequest, peerAddr netip.Addr) error {
+	if len(request.DNSNames) > 0 {
+		return fmt.Errorf("DNS names %q can't be verified", request.DNSNames)
+	}
+
+	if len(request.IPAddresses) != 1 {
+		return fmt.Errorf("expected exactly one IP address, got %q", request.IPAddresses)
+	}
+
+	addr, ok := netip.AddrFromSlice(request.IPAddresses[0])
+	if !ok || addr.Unmap() != peerAddr {
+		return fmt.Errorf("IP address %s doesn't match %s", request.IPAddresses[0], peerAddr)
+	}
+
+	if request.Subject.CommonName != peerAddr.String() {
+		return fmt.Errorf("common name %q doesn't match %s", request.Subject.CommonName, peerAddr)
+	}
+
+	return nil
+}
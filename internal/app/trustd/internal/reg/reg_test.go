@@ -7,6 +7,7 @@ package reg_test
 import (
 	"context"
 	stdx509 "crypto/x509"
+	"encoding/pem"
 	"net"
 	"net/netip"
 	"testing"
@@ -18,9 +19,12 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
+	"github.com/siderolabs/talos/pkg/grpc/gen"
 	"github.com/siderolabs/talos/pkg/machinery/api/security"
 	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
@@ -46,11 +50,19 @@ func TestCertificate(t *testing.T) {
 			Crt: ca.CrtPEM,
 		},
 	}
+
+	nodeIdentityCA, err := gensecrets.NewNodeIdentityCA(time.Now())
+	require.NoError(t, err)
+
+	osRoot.TypedSpec().NodeIdentityCA = &x509.PEMEncodedCertificateAndKey{
+		Crt: nodeIdentityCA.CrtPEM,
+		Key: nodeIdentityCA.KeyPEM,
+	}
 	require.NoError(t, resources.Create(ctx, osRoot))
 
 	ctx = peer.NewContext(ctx, &peer.Peer{
 		Addr: &net.TCPAddr{
-			IP:   netip.MustParseAddr("127.0.0.1").AsSlice(),
+			IP:   netip.MustParseAddr("10.5.0.4").AsSlice(),
 			Port: 30000,
 		},
 	})
@@ -62,6 +74,12 @@ func TestCertificate(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
 		csrSetters []x509.Option
+		newCSR     func(...x509.Option) (*x509.CertificateSigningRequest, *x509.PEMEncodedCertificateAndKey, error)
+
+		expectedCode        codes.Code
+		expectedCA          *stdx509.Certificate
+		expectedExtKeyUsage stdx509.ExtKeyUsage
+		expectedCommonName  string
 	}{
 		{
 			name: "server certificate",
@@ -70,6 +88,11 @@ func TestCertificate(t *testing.T) {
 				x509.DNSNames([]string{"talos-default-worker-1"}),
 				x509.CommonName("talos-default-worker-1"),
 			},
+			newCSR: x509.NewEd25519CSRAndIdentity,
+
+			expectedCA:          ca.Crt,
+			expectedExtKeyUsage: stdx509.ExtKeyUsageServerAuth,
+			expectedCommonName:  "talos-default-worker-1",
 		},
 		{
 			name: "attempt at client certificate",
@@ -77,18 +100,96 @@ func TestCertificate(t *testing.T) {
 				x509.CommonName("talos-default-worker-1"),
 				x509.Organization(string(role.Impersonator)),
 			},
+			newCSR: x509.NewEd25519CSRAndIdentity,
+
+			expectedCA:          ca.Crt,
+			expectedExtKeyUsage: stdx509.ExtKeyUsageServerAuth,
+			expectedCommonName:  "talos-default-worker-1",
+		},
+		{
+			name: "node identity",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+				x509.CommonName("10.5.0.4"),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCA:          nodeIdentityCA.Crt,
+			expectedExtKeyUsage: stdx509.ExtKeyUsageClientAuth,
+			expectedCommonName:  "10.5.0.4",
+		},
+		{
+			name: "attempt at node identity with roles",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+				x509.CommonName("10.5.0.4"),
+				x509.Organization(string(role.Admin)),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCA:          nodeIdentityCA.Crt,
+			expectedExtKeyUsage: stdx509.ExtKeyUsageClientAuth,
+			expectedCommonName:  "10.5.0.4",
+		},
+		{
+			name: "attempt at node identity for a foreign address",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.5").AsSlice()}),
+				x509.CommonName("10.5.0.5"),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "attempt at node identity for the peer and a foreign address",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice(), netip.MustParseAddr("10.5.0.5").AsSlice()}),
+				x509.CommonName("10.5.0.4"),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "attempt at node identity with DNS names",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+				x509.DNSNames([]string{"talos-default-worker-2"}),
+				x509.CommonName("10.5.0.4"),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "attempt at node identity with a foreign common name",
+			csrSetters: []x509.Option{
+				x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+				x509.CommonName("talos-default-worker-2"),
+			},
+			newCSR: gen.NewClientIdentityCSR,
+
+			expectedCode: codes.PermissionDenied,
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			serverCSR, serverCert, err := x509.NewEd25519CSRAndIdentity(tt.csrSetters...)
+			serverCSR, serverCert, err := tt.newCSR(tt.csrSetters...)
 			require.NoError(t, err)
 
 			resp, err := r.Certificate(ctx, &security.CertificateRequest{
 				Csr: serverCSR.X509CertificateRequestPEM,
 			})
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+
+				return
+			}
+
 			require.NoError(t, err)
 
-			assert.Equal(t, resp.Ca, ca.CrtPEM)
+			assert.Equal(t, pem.EncodeToMemory(&pem.Block{Type: x509.PEMTypeCertificate, Bytes: tt.expectedCA.Raw}), resp.Ca)
 
 			serverCert.Crt = resp.Crt
 
@@ -96,9 +197,26 @@ func TestCertificate(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Equal(t, stdx509.KeyUsageDigitalSignature, cert.KeyUsage)
-			assert.Equal(t, []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
-			assert.Equal(t, "talos-default-worker-1", cert.Subject.CommonName)
+			assert.Equal(t, []stdx509.ExtKeyUsage{tt.expectedExtKeyUsage}, cert.ExtKeyUsage)
+			assert.Equal(t, tt.expectedCommonName, cert.Subject.CommonName)
 			assert.Equal(t, []string(nil), cert.Subject.Organization)
+
+			// the certificate is issued by the expected CA only
+			for _, issuer := range []*stdx509.Certificate{ca.Crt, nodeIdentityCA.Crt} {
+				roots := stdx509.NewCertPool()
+				roots.AddCert(issuer)
+
+				_, err = cert.Verify(stdx509.VerifyOptions{
+					Roots:     roots,
+					KeyUsages: []stdx509.ExtKeyUsage{tt.expectedExtKeyUsage},
+				})
+
+				if issuer == tt.expectedCA {
+					assert.NoError(t, err)
+				} else {
+					assert.Error(t, err)
+				}
+			}
 		})
 	}
 }
@@ -6,6 +6,7 @@
 package dns
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -25,26 +26,69 @@ import (
 	"github.com/coredns/coredns/request"
 	"github.com/miekg/dns"
 	"github.com/siderolabs/gen/xiter"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
 )
 
 // Cache is a [dns.Handler] to [plugin.Handler] adapter.
 type Cache struct {
-	cache  *cache.Cache
+	next   plugin.Handler
+	cache  atomic.Pointer[cache.Cache]
 	logger *zap.Logger
+
+	mx   sync.Mutex
+	ttls CacheTTLs
+}
+
+// CacheTTLs configures the TTLs of the cached responses. Zero values mean the defaults.
+type CacheTTLs struct {
+	// Min is the minimum TTL of the positive responses, defaults to 5s.
+	Min time.Duration
+	// Max is the maximum TTL of the positive responses, defaults to 1h.
+	Max time.Duration
+	// Negative is the maximum TTL of the negative responses, defaults to 10s.
+	Negative time.Duration
 }
 
 // NewCache creates a new Cache.
 func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
+	c := &Cache{next: next, logger: l}
+	c.cache.Store(newCache(next, c.ttls))
+
+	return c
+}
+
+func newCache(next plugin.Handler, ttls CacheTTLs) *cache.Cache {
+	minTTL := cmp.Or(ttls.Min, dnsutil.MinimalDefaultTTL)
+	maxTTL := max(cmp.Or(ttls.Max, dnsutil.MaximumDefaulTTL), minTTL)
+
 	c := cache.NewCache(
 		"zones",
 		"view",
-		cache.WithNegativeTTL(10*time.Second, dnsutil.MinimalDefaultTTL),
+		cache.WithPositiveTTL(maxTTL, minTTL),
+		cache.WithNegativeTTL(cmp.Or(ttls.Negative, 10*time.Second), dnsutil.MinimalDefaultTTL),
 	)
 	c.Next = next
 
-	return &Cache{cache: c, logger: l}
+	return c
+}
+
+// SetTTLs updates the TTLs of the cached responses. The cache is flushed if the TTLs are changed.
+//
+// It returns true if the TTLs were updated, false otherwise.
+func (c *Cache) SetTTLs(ttls CacheTTLs) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.ttls == ttls {
+		return false
+	}
+
+	c.ttls = ttls
+	c.cache.Store(newCache(c.next, ttls))
+
+	return true
 }
 
 // ServeDNS implements [dns.Handler].
@@ -54,7 +98,7 @@ func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
 	ctx, cancel := context.WithTimeout(context.Background(), 4500*time.Millisecond)
 	defer cancel()
 
-	code, err := c.cache.ServeDNS(ctx, wr, msg)
+	code, err := c.cache.Load().ServeDNS(ctx, wr, msg)
 	if err != nil {
 		// we should probably call newProxy.Healthcheck() if there are too many errors
 		c.logger.Warn("error serving dns request", zap.Error(err))
@@ -90,15 +134,22 @@ func clientWrite(rcode int) bool {
 // Handler is a dns proxy selector.
 type Handler struct {
 	mx     sync.RWMutex
-	dests  iter.Seq[*proxy.Proxy]
+	dests  iter.Seq[Upstream]
+	zones  []Zone
 	logger *zap.Logger
 }
 
+// Zone is a domain which is forwarded to the specific upstreams instead of the default ones.
+type Zone struct {
+	Domain    string
+	Upstreams iter.Seq[Upstream]
+}
+
 // NewHandler creates a new Handler.
 func NewHandler(logger *zap.Logger) *Handler {
 	return &Handler{
 		logger: logger,
-		dests:  xiter.Empty[*proxy.Proxy],
+		dests:  xiter.Empty[Upstream],
 	}
 }
 
@@ -124,7 +175,18 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 		err    error
 	)
 
-	for ups := range h.dests {
+	dests := h.dests
+
+	// zones are sorted from the most specific to the least specific one
+	for _, zone := range h.zones {
+		if dns.IsSubDomain(zone.Domain, req.Name()) {
+			dests = zone.Upstreams
+
+			break
+		}
+	}
+
+	for ups := range dests {
 		called = true
 		opts := proxy.Options{}
 
@@ -182,7 +244,7 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 }
 
 // SetProxy sets destination dns proxy servers.
-func (h *Handler) SetProxy(prxs iter.Seq[*proxy.Proxy]) bool {
+func (h *Handler) SetProxy(prxs iter.Seq[Upstream]) bool {
 	h.mx.Lock()
 	defer h.mx.Unlock()
 
@@ -195,8 +257,33 @@ func (h *Handler) SetProxy(prxs iter.Seq[*proxy.Proxy]) bool {
 	return true
 }
 
+// SetZones sets the conditional forwarding zones.
+func (h *Handler) SetZones(zones []Zone) bool {
+	zones = xslices.Map(zones, func(zone Zone) Zone {
+		return Zone{Domain: dns.CanonicalName(zone.Domain), Upstreams: zone.Upstreams}
+	})
+
+	slices.SortStableFunc(zones, func(a, b Zone) int { return cmp.Compare(dns.CountLabel(b.Domain), dns.CountLabel(a.Domain)) })
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if slices.EqualFunc(h.zones, zones, func(a, b Zone) bool {
+		return a.Domain == b.Domain && xiter.Equal(a.Upstreams, b.Upstreams)
+	}) {
+		return false
+	}
+
+	h.zones = zones
+
+	return true
+}
+
 // Stop stops and clears dns proxy selector.
-func (h *Handler) Stop() { h.SetProxy(xiter.Empty) }
+func (h *Handler) Stop() {
+	h.SetProxy(xiter.Empty)
+	h.SetZones(nil)
+}
 
 // NewNodeHandler creates a new NodeHandler.
 func NewNodeHandler(next plugin.Handler, hostMapper HostMapper, logger *zap.Logger) *NodeHandler {
@@ -241,7 +328,7 @@ func (h *NodeHandler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg
 		return h.next.ServeDNS(ctx, wrt, msg)
 	}
 
-	answers := mapAnswers(result, req.Name())
+	answers := mapAnswers(result, req.Name(), nodeDNSResponseTTL)
 	if len(answers) == 0 {
 		return h.next.ServeDNS(ctx, wrt, msg)
 	}
@@ -259,7 +346,7 @@ func (h *NodeHandler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg
 	return dns.RcodeSuccess, nil
 }
 
-func mapAnswers(addrs iter.Seq[netip.Addr], name string) []dns.RR {
+func mapAnswers(addrs iter.Seq[netip.Addr], name string, ttl uint32) []dns.RR {
 	var result []dns.RR
 
 	for addr := range addrs {
@@ -270,7 +357,7 @@ func mapAnswers(addrs iter.Seq[netip.Addr], name string) []dns.RR {
 					Name:   name,
 					Rrtype: dns.TypeA,
 					Class:  dns.ClassINET,
-					Ttl:    nodeDNSResponseTTL,
+					Ttl:    ttl,
 				},
 				A: addr.AsSlice(),
 			})
@@ -281,7 +368,7 @@ func mapAnswers(addrs iter.Seq[netip.Addr], name string) []dns.RR {
 					Name:   name,
 					Rrtype: dns.TypeAAAA,
 					Class:  dns.ClassINET,
-					Ttl:    nodeDNSResponseTTL,
+					Ttl:    ttl,
 				},
 				AAAA: addr.AsSlice(),
 			})
@@ -118,6 +118,125 @@ func TestDNSEmptyDestinations(t *testing.T) {
 	stop()
 }
 
+func TestDNSStaticRecordsAndZones(t *testing.T) {
+	zoneServer := &dnssrv.Server{
+		Addr: "127.0.0.1:10753",
+		Net:  "udp",
+		Handler: dnssrv.HandlerFunc(func(w dnssrv.ResponseWriter, req *dnssrv.Msg) {
+			resp := new(dnssrv.Msg).SetReply(req)
+			resp.Answer = append(resp.Answer, &dnssrv.A{
+				Hdr: dnssrv.RR_Header{Name: req.Question[0].Name, Rrtype: dnssrv.TypeA, Class: dnssrv.ClassINET, Ttl: 60},
+				A:   net.ParseIP("192.0.2.53"),
+			})
+
+			w.WriteMsg(resp) //nolint:errcheck
+		}),
+	}
+
+	started := make(chan struct{})
+	zoneServer.NotifyStartedFunc = func() { close(started) }
+
+	go zoneServer.ListenAndServe() //nolint:errcheck
+
+	t.Cleanup(func() { zoneServer.Shutdown() }) //nolint:errcheck
+
+	<-started
+
+	m := dns.NewManager(&testReader{}, func(e suture.Event) { t.Log("dns-runners event:", e) }, zaptest.NewLogger(t))
+
+	t.Cleanup(func() { require.NoError(t, m.ClearAll(false)) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	m.ServeBackground(ctx)
+
+	for _, err := range m.RunAll(slices.Values([]dns.AddressPair{
+		{Network: "udp", Addr: netip.MustParseAddrPort("127.0.0.1:10702")},
+	}), false) {
+		require.NoError(t, err)
+	}
+
+	zoneUpstream := proxy.NewProxy("zone", "127.0.0.1:10753", "dns")
+	zoneUpstream.Start(500 * time.Millisecond)
+
+	t.Cleanup(zoneUpstream.Stop)
+
+	require.True(t, m.SetZones([]dns.Zone{{Domain: "Corp.Example", Upstreams: slices.Values([]dns.Upstream{zoneUpstream})}}))
+	require.False(t, m.SetZones([]dns.Zone{{Domain: "corp.example.", Upstreams: slices.Values([]dns.Upstream{zoneUpstream})}}))
+
+	m.SetStaticRecords([]dns.StaticRecord{
+		{Name: "registry.corp.example", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.10")}, TTL: time.Minute},
+		{Name: "mirror.corp.example.", CNAME: "registry.corp.example", TTL: time.Minute},
+		{Name: "app.corp.example", CNAME: "app-1.corp.example", TTL: time.Minute},
+	})
+
+	require.True(t, m.SetCacheTTLs(dns.CacheTTLs{Max: 10 * time.Minute}))
+	require.False(t, m.SetCacheTTLs(dns.CacheTTLs{Max: 10 * time.Minute}))
+
+	time.Sleep(10 * time.Millisecond)
+
+	for _, test := range []struct {
+		name  string
+		qtype uint16
+
+		expectedCode    int
+		expectedAnswers []string
+	}{
+		{
+			name:  "registry.corp.example",
+			qtype: dnssrv.TypeA,
+
+			expectedAnswers: []string{"registry.corp.example.\t60\tIN\tA\t10.0.0.10"},
+		},
+		{
+			name:  "registry.corp.example",
+			qtype: dnssrv.TypeAAAA,
+		},
+		{
+			name:  "mirror.corp.example",
+			qtype: dnssrv.TypeA,
+
+			expectedAnswers: []string{
+				"mirror.corp.example.\t60\tIN\tCNAME\tregistry.corp.example.",
+				"registry.corp.example.\t60\tIN\tA\t10.0.0.10",
+			},
+		},
+		{
+			name:  "app.corp.example",
+			qtype: dnssrv.TypeA,
+
+			expectedAnswers: []string{
+				"app.corp.example.\t60\tIN\tCNAME\tapp-1.corp.example.",
+				"app-1.corp.example.\t60\tIN\tA\t192.0.2.53",
+			},
+		},
+		{
+			name:  "other.corp.example",
+			qtype: dnssrv.TypeA,
+
+			expectedAnswers: []string{"other.corp.example.\t60\tIN\tA\t192.0.2.53"},
+		},
+		{
+			name:  "example.com",
+			qtype: dnssrv.TypeA,
+
+			expectedCode: dnssrv.RcodeServerFailure,
+		},
+	} {
+		t.Run(test.name+"/"+dnssrv.TypeToString[test.qtype], func(t *testing.T) {
+			query := createQuery(test.name)
+			query.Question[0].Qtype = test.qtype
+
+			r, err := dnssrv.Exchange(query, "127.0.0.1:10702")
+			require.NoError(t, err)
+
+			require.Equal(t, test.expectedCode, r.Rcode, r)
+			require.Equal(t, test.expectedAnswers, xslices.Map(r.Answer, dnssrv.RR.String), r)
+		})
+	}
+}
+
 func TestGC_NOGC(t *testing.T) {
 	tests := map[string]bool{
 		"ClearAll":    false,
@@ -163,7 +282,7 @@ func newManager(t *testing.T, nameservers ...string) func() {
 		}
 	})
 
-	pxs := xslices.Map(nameservers, func(ns string) *proxy.Proxy {
+	pxs := xslices.Map(nameservers, func(ns string) dns.Upstream {
 		p := proxy.NewProxy(ns, net.JoinHostPort(ns, "53"), "dns")
 		p.Start(500 * time.Millisecond)
 
@@ -15,7 +15,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/hashicorp/go-multierror"
 	dnssrv "github.com/miekg/dns"
 	"github.com/siderolabs/gen/xiter"
@@ -33,6 +32,7 @@ type Manager struct {
 	originalCtx  context.Context //nolint:containedctx
 	handler      *Handler
 	nodeHandler  *NodeHandler
+	static       *StaticHandler
 	rootHandler  *Cache
 	s            *suture.Supervisor
 	supervisorCh <-chan error
@@ -44,11 +44,13 @@ type Manager struct {
 func NewManager(mr MemberReader, hook suture.EventHook, logger *zap.Logger) *Manager {
 	handler := NewHandler(logger)
 	nodeHandler := NewNodeHandler(handler, &addrResolver{mr: mr}, logger)
-	rootHandler := NewCache(nodeHandler, logger)
+	static := NewStaticHandler(nodeHandler, logger)
+	rootHandler := NewCache(static, logger)
 
 	m := &Manager{
 		handler:     handler,
 		nodeHandler: nodeHandler,
+		static:      static,
 		rootHandler: rootHandler,
 		s:           suture.New("dns-resolve-cache-runners", suture.Spec{EventHook: hook}),
 		logger:      logger,
@@ -139,7 +141,16 @@ func makeResult(cfg AddressPair, s Status) RunResult { return RunResult{AddressP
 func (m *Manager) AllowNodeResolving(enabled bool) { m.nodeHandler.SetEnabled(enabled) }
 
 // SetUpstreams sets the upstreams for the DNS handler. It returns true if the upstreams were updated, false otherwise.
-func (m *Manager) SetUpstreams(prxs iter.Seq[*proxy.Proxy]) bool { return m.handler.SetProxy(prxs) }
+func (m *Manager) SetUpstreams(prxs iter.Seq[Upstream]) bool { return m.handler.SetProxy(prxs) }
+
+// SetZones sets the conditional forwarding zones. It returns true if the zones were updated, false otherwise.
+func (m *Manager) SetZones(zones []Zone) bool { return m.handler.SetZones(zones) }
+
+// SetStaticRecords sets the static records served by the DNS handler.
+func (m *Manager) SetStaticRecords(records []StaticRecord) { m.static.SetRecords(records) }
+
+// SetCacheTTLs sets the TTLs of the cached responses. It returns true if the TTLs were updated, false otherwise.
+func (m *Manager) SetCacheTTLs(ttls CacheTTLs) bool { return m.rootHandler.SetTTLs(ttls) }
 
 // ClearAll stops and removes all runners. It returns an iterator which yields the address pairs that were removed
 // and/or errors that occurred during the removal process. It's mandatory to range over the iterator to ensure all
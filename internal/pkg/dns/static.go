@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/coredns/coredns/plugin"
+	"github.com/coredns/coredns/plugin/pkg/nonwriter"
+	"github.com/coredns/coredns/request"
+	"github.com/miekg/dns"
+	"github.com/siderolabs/gen/xiter"
+	"go.uber.org/zap"
+)
+
+// StaticRecord is a static A/AAAA or CNAME record.
+type StaticRecord struct {
+	Name      string
+	Addresses []netip.Addr
+	CNAME     string
+	TTL       time.Duration
+}
+
+// maxCNAMEChain is the maximum number of static CNAME records followed in a single response.
+const maxCNAMEChain = 8
+
+// NewStaticHandler creates a new StaticHandler.
+func NewStaticHandler(next plugin.Handler, logger *zap.Logger) *StaticHandler {
+	return &StaticHandler{next: next, logger: logger}
+}
+
+// StaticHandler answers the requests for the static records. If the record is not found, it moves to the next handler.
+//
+// CNAME records are followed: if the target is a static record as well, it's resolved locally, otherwise
+// the target is resolved by the next handler.
+type StaticHandler struct {
+	next   plugin.Handler
+	logger *zap.Logger
+
+	records atomic.Pointer[map[string]StaticRecord]
+}
+
+// Name implements plugin.Handler.
+func (h *StaticHandler) Name() string {
+	return "StaticHandler"
+}
+
+// SetRecords replaces the static records.
+func (h *StaticHandler) SetRecords(records []StaticRecord) {
+	recordsMap := make(map[string]StaticRecord, len(records))
+
+	for _, record := range records {
+		recordsMap[dns.CanonicalName(record.Name)] = record
+	}
+
+	h.records.Store(&recordsMap)
+}
+
+// ServeDNS implements plugin.Handler.
+func (h *StaticHandler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns.Msg) (int, error) {
+	records := h.records.Load()
+	if records == nil || len(*records) == 0 || len(msg.Question) == 0 {
+		return h.next.ServeDNS(ctx, wrt, msg)
+	}
+
+	req := request.Request{W: wrt, Req: msg}
+
+	owner := msg.Question[0].Name
+
+	if _, ok := (*records)[dns.CanonicalName(owner)]; !ok {
+		return h.next.ServeDNS(ctx, wrt, msg)
+	}
+
+	resp := new(dns.Msg).SetReply(msg)
+	resp.Authoritative = true
+
+	for range maxCNAMEChain {
+		record, ok := (*records)[dns.CanonicalName(owner)]
+		if !ok {
+			// resolve the CNAME target with the next handler
+			targetResp, code, err := h.resolveTarget(ctx, wrt, msg, owner)
+			if targetResp == nil {
+				return code, err
+			}
+
+			resp.Answer = append(resp.Answer, targetResp.Answer...)
+			resp.Rcode = targetResp.Rcode
+
+			break
+		}
+
+		ttl := uint32(record.TTL / time.Second)
+
+		if record.CNAME == "" {
+			resp.Answer = append(resp.Answer, mapAnswers(
+				xiter.Filter(
+					func(addr netip.Addr) bool {
+						return (req.QType() == dns.TypeA && addr.Is4()) || (req.QType() == dns.TypeAAAA && addr.Is6())
+					},
+					slices.Values(record.Addresses),
+				),
+				owner,
+				ttl,
+			)...)
+
+			break
+		}
+
+		resp.Answer = append(resp.Answer, &dns.CNAME{
+			Hdr: dns.RR_Header{
+				Name:   owner,
+				Rrtype: dns.TypeCNAME,
+				Class:  dns.ClassINET,
+				Ttl:    ttl,
+			},
+			Target: dns.Fqdn(record.CNAME),
+		})
+
+		if req.QType() == dns.TypeCNAME {
+			break
+		}
+
+		owner = dns.Fqdn(record.CNAME)
+	}
+
+	err := wrt.WriteMsg(resp)
+	if err != nil {
+		// We can't do much here, but at least log the error.
+		h.logger.Warn("error writing dns response in static handler", zap.Error(err))
+	}
+
+	return dns.RcodeSuccess, nil
+}
+
+func (h *StaticHandler) resolveTarget(ctx context.Context, wrt dns.ResponseWriter, msg *dns.Msg, target string) (*dns.Msg, int, error) {
+	targetMsg := msg.Copy()
+	targetMsg.Question[0].Name = target
+
+	nw := nonwriter.New(wrt)
+
+	code, err := h.next.ServeDNS(ctx, nw, targetMsg)
+
+	return nw.Msg, code, err
+}
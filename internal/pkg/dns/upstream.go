@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/coredns/coredns/plugin/pkg/proxy"
+	"github.com/coredns/coredns/plugin/pkg/transport"
+	"github.com/coredns/coredns/plugin/pkg/up"
+	"github.com/coredns/coredns/request"
+	"github.com/miekg/dns"
+)
+
+// Upstream is a DNS server the requests are forwarded to.
+//
+// [proxy.Proxy] implements it for the plain DNS and DNS-over-TLS upstreams.
+type Upstream interface {
+	Addr() string
+	Fails() uint32
+	Healthcheck()
+	Start(time.Duration)
+	Stop()
+	Connect(ctx context.Context, state request.Request, opts proxy.Options) (*dns.Msg, error)
+}
+
+// NewEncryptedUpstream creates a DNS-over-TLS (tls://) or DNS-over-HTTPS (https://) upstream.
+//
+// The server name defaults to the IP address of the upstream. If the CA certificates are not set,
+// the system trusted roots are used to verify the upstream certificate.
+func NewEncryptedUpstream(rawURL, serverName, caCertificates string) (Upstream, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing upstream url: %w", err)
+	}
+
+	addrPort, err := EncryptedUpstreamAddrPort(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverName == "" {
+		serverName = addrPort.Addr().String()
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caCertificates != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(caCertificates)) {
+			return nil, errors.New("no valid CA certificates found")
+		}
+	}
+
+	switch u.Scheme {
+	case "tls":
+		p := proxy.NewProxy(rawURL, addrPort.String(), transport.TLS)
+		p.SetTLSConfig(tlsConfig)
+
+		return p, nil
+	case "https":
+		if u.Path == "" {
+			u.Path = "/dns-query"
+		}
+
+		return newDoHUpstream(u.String(), tlsConfig), nil
+	default:
+		return nil, fmt.Errorf("unsupported upstream url scheme %q", u.Scheme)
+	}
+}
+
+// EncryptedUpstreamAddrPort returns the address and the port the DNS-over-TLS (tls://) or DNS-over-HTTPS (https://) upstream
+// is reached at.
+//
+// The port defaults to 853 for DNS-over-TLS and 443 for DNS-over-HTTPS.
+func EncryptedUpstreamAddrPort(rawURL string) (netip.AddrPort, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("error parsing upstream url: %w", err)
+	}
+
+	addr, err := netip.ParseAddr(u.Hostname())
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("upstream host should be an IP address: %w", err)
+	}
+
+	var port uint16
+
+	switch u.Scheme {
+	case "tls":
+		port = 853
+	case "https":
+		port = 443
+	default:
+		return netip.AddrPort{}, fmt.Errorf("unsupported upstream url scheme %q", u.Scheme)
+	}
+
+	if u.Port() != "" {
+		p, err := strconv.ParseUint(u.Port(), 10, 16)
+		if err != nil {
+			return netip.AddrPort{}, fmt.Errorf("error parsing upstream port: %w", err)
+		}
+
+		port = uint16(p)
+	}
+
+	return netip.AddrPortFrom(addr.Unmap(), port), nil
+}
+
+// dohMediaType is the media type of DNS-over-HTTPS messages (RFC 8484).
+const dohMediaType = "application/dns-message"
+
+// dohUpstream is a DNS-over-HTTPS upstream.
+type dohUpstream struct {
+	url    string
+	client *http.Client
+	probe  *up.Probe
+	fails  atomic.Uint32
+}
+
+func newDoHUpstream(url string, tlsConfig *tls.Config) *dohUpstream {
+	return &dohUpstream{
+		url: url,
+		client: &http.Client{
+			Transport: &http.Transport{
+				// never send DNS queries through the HTTP proxy
+				Proxy: nil,
+				DialContext: (&net.Dialer{
+					Timeout:   5 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).DialContext,
+				TLSClientConfig:     tlsConfig,
+				TLSHandshakeTimeout: 5 * time.Second,
+				ForceAttemptHTTP2:   true,
+				MaxIdleConnsPerHost: 4,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			Timeout: 5 * time.Second,
+		},
+		probe: up.New(),
+	}
+}
+
+// Addr implements Upstream.
+func (u *dohUpstream) Addr() string { return u.url }
+
+// Fails implements Upstream.
+func (u *dohUpstream) Fails() uint32 { return u.fails.Load() }
+
+// Start implements Upstream.
+func (u *dohUpstream) Start(interval time.Duration) { u.probe.Start(interval) }
+
+// Stop implements Upstream.
+func (u *dohUpstream) Stop() {
+	u.probe.Stop()
+	u.client.CloseIdleConnections()
+}
+
+// Healthcheck implements Upstream.
+func (u *dohUpstream) Healthcheck() {
+	u.probe.Do(func() error {
+		ping := new(dns.Msg)
+		ping.SetQuestion(".", dns.TypeNS)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		if _, err := u.exchange(ctx, ping); err != nil {
+			u.fails.Add(1)
+
+			return err
+		}
+
+		u.fails.Store(0)
+
+		return nil
+	})
+}
+
+// Connect implements Upstream.
+func (u *dohUpstream) Connect(ctx context.Context, state request.Request, _ proxy.Options) (*dns.Msg, error) {
+	resp, err := u.exchange(ctx, state.Req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Id = state.Req.Id
+
+	return resp, nil
+}
+
+func (u *dohUpstream) exchange(ctx context.Context, msg *dns.Msg) (*dns.Msg, error) {
+	// RFC 8484 recommends using zero ID for the better cacheability
+	req := msg.Copy()
+	req.Id = 0
+
+	packed, err := req.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", dohMediaType)
+	httpReq.Header.Set("Accept", dohMediaType)
+
+	httpResp, err := u.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpResp.Body.Close() //nolint:errcheck
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", httpResp.StatusCode, u.url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(dns.Msg)
+
+	if err = resp.Unpack(body); err != nil {
+		return nil, fmt.Errorf("error unpacking response from %s: %w", u.url, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,275 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/coredns/coredns/plugin/pkg/proxy"
+	"github.com/coredns/coredns/request"
+	dnssrv "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+func answer(req *dnssrv.Msg) *dnssrv.Msg {
+	resp := new(dnssrv.Msg).SetReply(req)
+	resp.Answer = append(resp.Answer, &dnssrv.A{
+		Hdr: dnssrv.RR_Header{
+			Name:   req.Question[0].Name,
+			Rrtype: dnssrv.TypeA,
+			Class:  dnssrv.ClassINET,
+			Ttl:    60,
+		},
+		A: netip.MustParseAddr("192.0.2.1").AsSlice(),
+	})
+
+	return resp
+}
+
+func exchange(t *testing.T, upstream dns.Upstream) *dnssrv.Msg {
+	t.Helper()
+
+	upstream.Start(time.Minute)
+	t.Cleanup(upstream.Stop)
+
+	req := new(dnssrv.Msg).SetQuestion("example.com.", dnssrv.TypeA)
+	req.Id = 1234
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	resp, err := upstream.Connect(ctx, request.Request{Req: req, W: &testWriter{}}, proxy.Options{})
+	require.NoError(t, err)
+
+	return resp
+}
+
+func newTLSServer(t *testing.T, handler http.Handler) (*httptest.Server, string) {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	return srv, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+}
+
+func TestDoHUpstream(t *testing.T) {
+	t.Parallel()
+
+	srv, ca := newTLSServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/dns-query" || r.Header.Get("Content-Type") != "application/dns-message" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		req := new(dnssrv.Msg)
+
+		if err = req.Unpack(body); err != nil || req.Id != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		packed, err := answer(req).Pack()
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/dns-message")
+		w.Write(packed) //nolint:errcheck
+	}))
+
+	upstream, err := dns.NewEncryptedUpstream("https://"+srv.Listener.Addr().String(), "", ca)
+	require.NoError(t, err)
+
+	resp := exchange(t, upstream)
+
+	assert.EqualValues(t, 1234, resp.Id)
+	require.Len(t, resp.Answer, 1)
+	assert.Equal(t, "192.0.2.1", resp.Answer[0].(*dnssrv.A).A.String())
+
+	// the certificate is not issued for this name
+	upstream, err = dns.NewEncryptedUpstream("https://"+srv.Listener.Addr().String(), "dns.example.org", ca)
+	require.NoError(t, err)
+
+	upstream.Start(time.Minute)
+	t.Cleanup(upstream.Stop)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	_, err = upstream.Connect(ctx, request.Request{Req: new(dnssrv.Msg).SetQuestion("example.com.", dnssrv.TypeA)}, proxy.Options{})
+	require.Error(t, err)
+}
+
+func TestDoTUpstream(t *testing.T) {
+	t.Parallel()
+
+	srv, ca := newTLSServer(t, http.NotFoundHandler())
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", srv.TLS)
+	require.NoError(t, err)
+
+	dnsSrv := &dnssrv.Server{
+		Listener: l,
+		Net:      "tcp-tls",
+		Handler: dnssrv.HandlerFunc(func(w dnssrv.ResponseWriter, req *dnssrv.Msg) {
+			w.WriteMsg(answer(req)) //nolint:errcheck
+		}),
+	}
+
+	go dnsSrv.ActivateAndServe() //nolint:errcheck
+
+	t.Cleanup(func() { dnsSrv.Shutdown() }) //nolint:errcheck
+
+	upstream, err := dns.NewEncryptedUpstream("tls://"+l.Addr().String(), "", ca)
+	require.NoError(t, err)
+
+	resp := exchange(t, upstream)
+
+	assert.EqualValues(t, 1234, resp.Id)
+	require.Len(t, resp.Answer, 1)
+	assert.Equal(t, "192.0.2.1", resp.Answer[0].(*dnssrv.A).A.String())
+}
+
+func TestNewEncryptedUpstream(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		url  string
+		ca   string
+
+		expectedAddr  string
+		expectedError string
+	}{
+		{
+			name: "tls default port",
+			url:  "tls://1.1.1.1",
+
+			expectedAddr: "1.1.1.1:853",
+		},
+		{
+			name: "https default path",
+			url:  "https://[2620:fe::fe]",
+
+			expectedAddr: "https://[2620:fe::fe]/dns-query",
+		},
+		{
+			name: "hostname",
+			url:  "tls://dns.example.org",
+
+			expectedError: "upstream host should be an IP address",
+		},
+		{
+			name: "plain",
+			url:  "udp://1.1.1.1",
+
+			expectedError: "unsupported upstream url scheme \"udp\"",
+		},
+		{
+			name: "invalid ca",
+			url:  "tls://1.1.1.1",
+			ca:   "not a certificate",
+
+			expectedError: "no valid CA certificates found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			upstream, err := dns.NewEncryptedUpstream(test.url, "", test.ca)
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedAddr, upstream.Addr())
+		})
+	}
+}
+
+func TestEncryptedUpstreamAddrPort(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		url  string
+
+		expected      netip.AddrPort
+		expectedError string
+	}{
+		{
+			name: "tls default port",
+			url:  "tls://1.1.1.1",
+
+			expected: netip.MustParseAddrPort("1.1.1.1:853"),
+		},
+		{
+			name: "https default port",
+			url:  "https://[2620:fe::fe]/dns-query",
+
+			expected: netip.MustParseAddrPort("[2620:fe::fe]:443"),
+		},
+		{
+			name: "custom port",
+			url:  "https://9.9.9.9:8443",
+
+			expected: netip.MustParseAddrPort("9.9.9.9:8443"),
+		},
+		{
+			name: "hostname",
+			url:  "https://dns.example.org",
+
+			expectedError: "upstream host should be an IP address",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			addrPort, err := dns.EncryptedUpstreamAddrPort(test.url)
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, addrPort)
+		})
+	}
+}
+
+type testWriter struct {
+	dnssrv.ResponseWriter
+}
+
+func (*testWriter) RemoteAddr() net.Addr { return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 53} }
+
+func (*testWriter) LocalAddr() net.Addr { return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 53} }
@@ -6,10 +6,12 @@
 package encryption
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"time"
@@ -28,7 +30,10 @@ import (
 const keyHandlerTimeout = time.Second * 10
 
 // NewHandler creates new Handler.
-func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSystemInformation helpers.SystemInformationGetter) (*Handler, error) {
+func NewHandler(
+	encryptionConfig block.EncryptionSpec, volumeID string,
+	getSystemInformation helpers.SystemInformationGetter, getNodeIdentity helpers.NodeIdentityGetter,
+) (*Handler, error) {
 	cipher, err := luks.ParseCipherKind(encryptionConfig.Cipher)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse cipher kind: %w", err)
@@ -57,7 +62,11 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 	keyHandlers := make([]keys.Handler, 0, len(encryptionConfig.Keys))
 
 	for _, cfg := range encryptionConfig.Keys {
-		handler, err := keys.NewHandler(cfg, keys.WithVolumeID(volumeID), keys.WithSystemInformationGetter(getSystemInformation))
+		handler, err := keys.NewHandler(cfg,
+			keys.WithVolumeID(volumeID),
+			keys.WithSystemInformationGetter(getSystemInformation),
+			keys.WithNodeIdentityGetter(getNodeIdentity),
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -79,6 +88,38 @@ func NewHandler(encryptionConfig block.EncryptionSpec, volumeID string, getSyste
 	}, nil
 }
 
+// ReadKeySlots returns the key slot layout of the encrypted volume.
+func ReadKeySlots(path string, encryptionConfig block.EncryptionSpec) ([]block.EncryptionKeySlotStatus, error) {
+	keyslots, err := luks.New(luks.AESXTSPlain64Cipher).ReadKeyslots(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyTypes := make(map[int]string, len(encryptionConfig.Keys))
+
+	for _, key := range encryptionConfig.Keys {
+		keyTypes[key.Slot] = key.Type.String()
+	}
+
+	slots := make([]block.EncryptionKeySlotStatus, 0, len(keyslots.Keyslots))
+
+	for id := range keyslots.Keyslots {
+		slot, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, block.EncryptionKeySlotStatus{
+			Slot: slot,
+			Type: keyTypes[slot],
+		})
+	}
+
+	slices.SortFunc(slots, func(a, b block.EncryptionKeySlotStatus) int { return cmp.Compare(a.Slot, b.Slot) })
+
+	return slots, nil
+}
+
 // Handler reads encryption config, creates appropriate
 // encryption provider, handles encrypted partition open and close.
 type Handler struct {
@@ -175,6 +216,197 @@ func (h *Handler) FormatAndEncrypt(ctx context.Context, logger *zap.Logger, path
 	return nil
 }
 
+// AddKeySlot adds the configured key at the specified slot to the encrypted volume.
+//
+// The key slot should be present in the configuration, and it should not be in use.
+func (h *Handler) AddKeySlot(ctx context.Context, logger *zap.Logger, path string, slot int) error {
+	handler, err := h.keyHandler(slot)
+	if err != nil {
+		return err
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; ok {
+		return fmt.Errorf("key slot %d is already in use", slot)
+	}
+
+	existingKey, err := h.unlockKey(ctx, logger, path, keyslots, slot)
+	if err != nil {
+		return err
+	}
+
+	if err = h.addKey(ctx, path, existingKey, handler); err != nil {
+		return fmt.Errorf("error adding key slot %d %T: %w", slot, handler, err)
+	}
+
+	logger.Info("added encryption key", zap.Int("slot", slot))
+
+	return nil
+}
+
+// RemoveKeySlot removes the key slot from the encrypted volume.
+//
+// The key slot should not be present in the configuration, and it can't be the last key slot in use.
+func (h *Handler) RemoveKeySlot(ctx context.Context, logger *zap.Logger, path string, slot int) error {
+	if _, err := h.keyHandler(slot); err == nil {
+		return fmt.Errorf("key slot %d is still present in the configuration", slot)
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; !ok {
+		return fmt.Errorf("key slot %d is not in use", slot)
+	}
+
+	existingKey, err := h.unlockKey(ctx, logger, path, keyslots, slot)
+	if err != nil {
+		return err
+	}
+
+	if err = h.encryptionProvider.RemoveKey(ctx, path, slot, existingKey); err != nil {
+		return fmt.Errorf("error removing key slot %d: %w", slot, err)
+	}
+
+	logger.Info("removed encryption key", zap.Int("slot", slot))
+
+	return nil
+}
+
+// RotateKeySlot replaces the key at the specified slot with a new key generated by the configured key handler.
+func (h *Handler) RotateKeySlot(ctx context.Context, logger *zap.Logger, path string, slot int) error {
+	handler, err := h.keyHandler(slot)
+	if err != nil {
+		return err
+	}
+
+	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := keyslots.Keyslots[strconv.Itoa(slot)]; !ok {
+		return fmt.Errorf("key slot %d is not in use", slot)
+	}
+
+	currentKey, err := h.slotKey(ctx, path, handler)
+	if err != nil {
+		return err
+	}
+
+	if currentKey != nil {
+		// the key slot can be unlocked with its own key, so change it in place
+		newKey, token, err := handler.NewKey(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = h.encryptionProvider.SetKey(ctx, path, currentKey, newKey); err != nil {
+			return fmt.Errorf("error changing key slot %d %T: %w", slot, handler, err)
+		}
+
+		// the token is replaced only after the key is changed, as the slot with the token for another key can't be unlocked
+		if token != nil {
+			if err = h.encryptionProvider.SetToken(ctx, path, slot, token); err != nil {
+				// restore the old key which matches the old token
+				if rollbackErr := h.encryptionProvider.SetKey(ctx, path, newKey, currentKey); rollbackErr != nil {
+					return fmt.Errorf("error setting token for key slot %d: %w (failed to restore the old key: %w)", slot, err, rollbackErr)
+				}
+
+				return fmt.Errorf("error setting token for key slot %d: %w", slot, err)
+			}
+		}
+	} else {
+		// the configuration of the key slot has changed, so re-create it with the key from another slot
+		existingKey, err := h.unlockKey(ctx, logger, path, keyslots, slot)
+		if err != nil {
+			return err
+		}
+
+		if err = h.updateKey(ctx, path, existingKey, handler); err != nil {
+			return fmt.Errorf("error updating key slot %d %T: %w", slot, handler, err)
+		}
+	}
+
+	logger.Info("rotated encryption key", zap.Int("slot", slot))
+
+	return nil
+}
+
+// keyHandler returns the configured key handler for the slot.
+func (h *Handler) keyHandler(slot int) (keys.Handler, error) {
+	for _, handler := range h.keyHandlers {
+		if handler.Slot() == slot {
+			return handler, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key slot %d is not present in the configuration", slot)
+}
+
+// slotKey returns the key for the slot from the configured key handler, if the key is accepted by the volume.
+func (h *Handler) slotKey(ctx context.Context, path string, handler keys.Handler) (*encryption.Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, keyHandlerTimeout)
+	defer cancel()
+
+	slotToken, err := h.readToken(ctx, path, handler.Slot())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := handler.GetKey(ctx, slotToken)
+	if err != nil {
+		if errors.Is(err, keys.ErrTokenInvalid) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	valid, err := h.encryptionProvider.CheckKey(ctx, path, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		return nil, nil //nolint:nilnil
+	}
+
+	return key, nil
+}
+
+// unlockKey returns a key which unlocks the volume, skipping the specified key slot.
+func (h *Handler) unlockKey(ctx context.Context, logger *zap.Logger, path string, keyslots *encryption.Keyslots, skipSlot int) (*encryption.Key, error) {
+	for _, handler := range h.keyHandlers {
+		if handler.Slot() == skipSlot {
+			continue
+		}
+
+		if _, ok := keyslots.Keyslots[strconv.Itoa(handler.Slot())]; !ok {
+			continue
+		}
+
+		key, err := h.slotKey(ctx, path, handler)
+		if err != nil {
+			logger.Warn("failed to call key handler", zap.Int("slot", handler.Slot()), zap.Error(err))
+
+			continue
+		}
+
+		if key != nil {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no other configured key slot can unlock the volume")
+}
+
 //nolint:gocyclo
 func (h *Handler) syncKeys(ctx context.Context, logger *zap.Logger, path string, k *encryption.Key) error {
 	keyslots, err := h.encryptionProvider.ReadKeyslots(path)
@@ -363,6 +595,17 @@ func (h *Handler) readToken(ctx context.Context, path string, id int) (token.Tok
 			Type:     token.Type,
 			UserData: tpmData,
 		}, nil
+	case keys.TokenTypeVault:
+		vaultData := &keys.VaultToken{}
+
+		if err = json.Unmarshal(token.UserData, &vaultData); err != nil {
+			return nil, err
+		}
+
+		return &luks.Token[*keys.VaultToken]{
+			Type:     token.Type,
+			UserData: vaultData,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown token type %s", token.Type)
 	}
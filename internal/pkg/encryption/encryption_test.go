@@ -5,12 +5,74 @@
 package encryption_test
 
 import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+// writeLUKS2Header writes a minimal LUKS2 header with the specified JSON metadata.
+func writeLUKS2Header(t *testing.T, metadata string) string {
+	t.Helper()
+
+	const binaryHeaderSize = 4096
+
+	header := make([]byte, 4*binaryHeaderSize)
+
+	copy(header, "LUKS\xba\xbe")
+	binary.BigEndian.PutUint16(header[6:], 2)
+	binary.BigEndian.PutUint64(header[8:], uint64(len(header)))
+
+	copy(header[binaryHeaderSize:], metadata)
+
+	path := filepath.Join(t.TempDir(), "luks.img")
+
+	require.NoError(t, os.WriteFile(path, header, 0o600))
+
+	return path
+}
+
+func TestReadKeySlots(t *testing.T) {
+	t.Parallel()
+
+	path := writeLUKS2Header(t, `{"keyslots":{"0":{"type":"luks2","key_size":64},"2":{"type":"luks2","key_size":64},"1":{"type":"luks2","key_size":64}}}`)
+
+	slots, err := encryption.ReadKeySlots(path, block.EncryptionSpec{
+		Provider: block.EncryptionProviderLUKS2,
+		Keys: []block.EncryptionKey{
+			{
+				Slot: 0,
+				Type: block.EncryptionKeyNodeID,
+			},
+			{
+				Slot: 1,
+				Type: block.EncryptionKeyTPM,
+			},
+			{
+				Slot: 3,
+				Type: block.EncryptionKeyKMS,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []block.EncryptionKeySlotStatus{
+		{
+			Slot: 0,
+			Type: "nodeID",
+		},
+		{
+			Slot: 1,
+			Type: "tpm",
+		},
+		{
+			Slot: 2,
+		},
+	}, slots)
 }
@@ -8,8 +8,13 @@ package helpers
 import (
 	"context"
 
+	"github.com/siderolabs/crypto/x509"
+
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 )
 
 // SystemInformationGetter defines the closure which can be used in key handlers to get the node UUID.
 type SystemInformationGetter func(context.Context) (*hardware.SystemInformation, error)
+
+// NodeIdentityGetter defines the closure which can be used in key handlers to get the node identity certificate and key.
+type NodeIdentityGetter func(context.Context) (*x509.PEMEncodedCertificateAndKey, error)
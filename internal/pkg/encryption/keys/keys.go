@@ -49,6 +49,12 @@ func NewHandler(cfg block.EncryptionKey, options ...KeyOption) (Handler, error)
 		return NewKMSKeyHandler(key, cfg.KMSEndpoint, opts.GetSystemInformation)
 	case block.EncryptionKeyTPM:
 		return NewTPMKeyHandler(key, cfg.TPMCheckSecurebootStatusOnEnroll)
+	case block.EncryptionKeyVault:
+		if opts.GetSystemInformation == nil {
+			return nil, fmt.Errorf("failed to create Vault key handler at slot %d: %w", cfg.Slot, errNoSystemInfoGetter)
+		}
+
+		return NewVaultKeyHandler(key, cfg.Vault, opts.GetSystemInformation, opts.GetNodeIdentity)
 	default:
 		return nil, fmt.Errorf("unsupported key type: %s", cfg.Type)
 	}
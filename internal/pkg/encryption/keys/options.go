@@ -13,6 +13,7 @@ type KeyOption func(o *KeyOptions) error
 type KeyOptions struct {
 	VolumeID             string
 	GetSystemInformation helpers.SystemInformationGetter
+	GetNodeIdentity      helpers.NodeIdentityGetter
 }
 
 // WithVolumeID passes the partition label to the key handler.
@@ -33,6 +34,15 @@ func WithSystemInformationGetter(getter helpers.SystemInformationGetter) KeyOpti
 	}
 }
 
+// WithNodeIdentityGetter passes the node identity to the key handler.
+func WithNodeIdentityGetter(getter helpers.NodeIdentityGetter) KeyOption {
+	return func(o *KeyOptions) error {
+		o.GetNodeIdentity = getter
+
+		return nil
+	}
+}
+
 // NewDefaultOptions creates new KeyOptions.
 func NewDefaultOptions(options []KeyOption) (*KeyOptions, error) {
 	var opts KeyOptions
@@ -9,4 +9,6 @@ const (
 	TokenTypeKMS = "sideroKMS"
 	// TokenTypeTPM is TPM assisted encryption token.
 	TokenTypeTPM = "talos-tpm2"
+	// TokenTypeVault is Vault Transit assisted encryption token.
+	TokenTypeVault = "talos-vault"
 )
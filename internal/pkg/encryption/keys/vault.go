@@ -0,0 +1,373 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/token"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/helpers"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// VaultToken is the userdata stored in the partition token metadata.
+type VaultToken struct {
+	Ciphertext string `json:"ciphertext"`
+}
+
+// VaultKeyHandler seals token using Vault Transit secrets engine.
+type VaultKeyHandler struct {
+	KeyHandler
+	spec            block.EncryptionKeyVaultSpec
+	getSystemInfo   helpers.SystemInformationGetter
+	getNodeIdentity helpers.NodeIdentityGetter
+}
+
+// NewVaultKeyHandler creates new VaultKeyHandler.
+func NewVaultKeyHandler(
+	key KeyHandler, spec block.EncryptionKeyVaultSpec,
+	getSystemInfo helpers.SystemInformationGetter, getNodeIdentity helpers.NodeIdentityGetter,
+) (*VaultKeyHandler, error) {
+	if spec.Address == "" || spec.TransitKey == "" {
+		return nil, errors.New("vault address and transit key must be set")
+	}
+
+	return &VaultKeyHandler{
+		KeyHandler:      key,
+		spec:            spec,
+		getSystemInfo:   getSystemInfo,
+		getNodeIdentity: getNodeIdentity,
+	}, nil
+}
+
+// NewKey implements Handler interface.
+func (h *VaultKeyHandler) NewKey(ctx context.Context) (*encryption.Key, token.Token, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, err
+	}
+
+	client, err := h.login(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer client.close(ctx) //nolint:errcheck
+
+	derivationContext, err := h.derivationContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+
+	if err = client.call(ctx, "encrypt/"+url.PathEscape(h.spec.TransitKey), map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(key),
+		"context":   derivationContext,
+	}, &resp); err != nil {
+		return nil, nil, fmt.Errorf("failed to seal Vault passphrase, slot %d: %w", h.Slot(), err)
+	}
+
+	token := &luks.Token[*VaultToken]{
+		Type: TokenTypeVault,
+		UserData: &VaultToken{
+			Ciphertext: resp.Ciphertext,
+		},
+	}
+
+	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), token, nil
+}
+
+// GetKey implements Handler interface.
+func (h *VaultKeyHandler) GetKey(ctx context.Context, t token.Token) (*encryption.Key, error) {
+	token, ok := t.(*luks.Token[*VaultToken])
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := h.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.close(ctx) //nolint:errcheck
+
+	derivationContext, err := h.derivationContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+
+	if err = client.call(ctx, "decrypt/"+url.PathEscape(h.spec.TransitKey), map[string]string{
+		"ciphertext": token.UserData.Ciphertext,
+		"context":    derivationContext,
+	}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unseal Vault passphrase, slot %d: %w", h.Slot(), err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Vault plaintext, slot %d: %w", h.Slot(), err)
+	}
+
+	return encryption.NewKey(h.slot, []byte(base64.StdEncoding.EncodeToString(key))), nil
+}
+
+// derivationContext binds the sealed key to the node UUID.
+//
+// The context is only used by Vault if the Transit key is created with key derivation enabled.
+func (h *VaultKeyHandler) derivationContext(ctx context.Context) (string, error) {
+	systemInformation, err := h.getSystemInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(systemInformation.TypedSpec().UUID)), nil
+}
+
+// clientCertificate returns the client certificate for the TLS certificates auth method.
+//
+// If the client certificate is not configured, the node identity issued by the node identity CA is used.
+func (h *VaultKeyHandler) clientCertificate(ctx context.Context) (*tls.Certificate, error) {
+	if h.spec.CertMount == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	identity := &x509.PEMEncodedCertificateAndKey{
+		Crt: h.spec.CertClientCert,
+		Key: h.spec.CertClientKey,
+	}
+
+	if identity.Crt == nil {
+		if h.getNodeIdentity == nil {
+			return nil, errors.New("node identity is not available")
+		}
+
+		var err error
+
+		identity, err = h.getNodeIdentity(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node identity: %w", err)
+		}
+	}
+
+	cert, err := tls.X509KeyPair(identity.Crt, identity.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Vault client certificate: %w", err)
+	}
+
+	// Vault verifies the client certificate for the client authentication usage
+	if len(cert.Leaf.ExtKeyUsage) > 0 &&
+		!slices.Contains(cert.Leaf.ExtKeyUsage, stdx509.ExtKeyUsageClientAuth) &&
+		!slices.Contains(cert.Leaf.ExtKeyUsage, stdx509.ExtKeyUsageAny) {
+		return nil, errors.New("vault client certificate doesn't allow client authentication")
+	}
+
+	return &cert, nil
+}
+
+// login authenticates to Vault using the configured auth method.
+func (h *VaultKeyHandler) login(ctx context.Context) (*vaultClient, error) {
+	clientCert, err := h.clientCertificate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newVaultClient(h.spec, clientCert)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mount string
+		req   map[string]string
+	)
+
+	switch {
+	case h.spec.AppRoleRoleID != "":
+		mount = h.spec.AppRoleMount
+		req = map[string]string{
+			"role_id": h.spec.AppRoleRoleID,
+		}
+
+		if h.spec.AppRoleSecretID != "" {
+			req["secret_id"] = h.spec.AppRoleSecretID
+		}
+	case clientCert != nil:
+		mount = h.spec.CertMount
+		req = map[string]string{}
+
+		if h.spec.CertRole != "" {
+			req["name"] = h.spec.CertRole
+		}
+	default:
+		return nil, errors.New("vault auth method is not configured")
+	}
+
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+
+	if err = client.do(ctx, "auth/"+strings.Trim(mount, "/")+"/login", req, &resp); err != nil {
+		client.close(ctx) //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to login to Vault at %q: %w", h.spec.Address, err)
+	}
+
+	if resp.Auth.ClientToken == "" {
+		client.close(ctx) //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to login to Vault at %q: no client token returned", h.spec.Address)
+	}
+
+	client.token = resp.Auth.ClientToken
+
+	return client, nil
+}
+
+type vaultClient struct {
+	httpClient   *http.Client
+	address      string
+	transitMount string
+	token        string
+}
+
+func newVaultClient(spec block.EncryptionKeyVaultSpec, clientCert *tls.Certificate) (*vaultClient, error) {
+	tlsConfig := &tls.Config{
+		RootCAs: httpdefaults.RootCAs(),
+	}
+
+	if spec.CACert != nil {
+		tlsConfig.RootCAs = stdx509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(spec.CACert) {
+			return nil, errors.New("failed to parse Vault CA certificate")
+		}
+	}
+
+	if clientCert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	transport := httpdefaults.PatchTransport(http.DefaultTransport.(*http.Transport).Clone()) //nolint:forcetypeassert
+	transport.TLSClientConfig = tlsConfig
+
+	return &vaultClient{
+		httpClient:   &http.Client{Transport: transport},
+		address:      strings.TrimRight(spec.Address, "/"),
+		transitMount: strings.Trim(spec.TransitMount, "/"),
+	}, nil
+}
+
+// close revokes the client token and closes the idle connections.
+//
+// The token is used only for a single seal or unseal operation, so it is revoked instead of waiting for the token TTL.
+func (c *vaultClient) close(ctx context.Context) error {
+	defer c.httpClient.CloseIdleConnections()
+
+	if c.token == "" {
+		return nil
+	}
+
+	err := c.do(ctx, "auth/token/revoke-self", nil, nil)
+
+	c.token = ""
+
+	return err
+}
+
+// call invokes the Transit secrets engine endpoint and decodes the response data.
+func (c *vaultClient) call(ctx context.Context, path string, req, data any) error {
+	resp := struct {
+		Data any `json:"data"`
+	}{
+		Data: data,
+	}
+
+	return c.do(ctx, c.transitMount+"/"+path, req, &resp)
+}
+
+func (c *vaultClient) do(ctx context.Context, path string, req, resp any) error {
+	var body io.Reader
+
+	if req != nil {
+		reqBody, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(reqBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.address+"/v1/"+path, body)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		httpReq.Header.Set("X-Vault-Token", c.token)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+
+	defer httpResp.Body.Close() //nolint:errcheck
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+
+		if json.NewDecoder(httpResp.Body).Decode(&errResp) == nil && len(errResp.Errors) > 0 {
+			return fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode, strings.Join(errResp.Errors, "; "))
+		}
+
+		return fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+	}
+
+	if resp == nil || httpResp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(httpResp.Body).Decode(resp)
+}
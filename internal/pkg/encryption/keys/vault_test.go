@@ -0,0 +1,619 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-blockdevice/v2/encryption/luks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/encryption/keys"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+const (
+	vaultRoleID   = "role-id"
+	vaultSecretID = "secret-id"
+	vaultNodeName = "node-1.example.com"
+)
+
+// fakeVault emulates AppRole and TLS certificates login, token revocation and Transit encrypt/decrypt endpoints of the Vault server.
+//
+// The returned function reports the number of the issued tokens which were not revoked.
+func fakeVault(t *testing.T) (*httptest.Server, func() int) {
+	t.Helper()
+
+	var (
+		tokensMu     sync.Mutex
+		tokensIssued int
+		activeTokens = map[string]struct{}{}
+	)
+
+	issueToken := func() string {
+		tokensMu.Lock()
+		defer tokensMu.Unlock()
+
+		tokensIssued++
+
+		token := fmt.Sprintf("s.token-%d", tokensIssued)
+		activeTokens[token] = struct{}{}
+
+		return token
+	}
+
+	revokeToken := func(token string) bool {
+		tokensMu.Lock()
+		defer tokensMu.Unlock()
+
+		_, ok := activeTokens[token]
+		delete(activeTokens, token)
+
+		return ok
+	}
+
+	isActiveToken := func(token string) bool {
+		tokensMu.Lock()
+		defer tokensMu.Unlock()
+
+		_, ok := activeTokens[token]
+
+		return ok
+	}
+
+	writeJSON := func(w http.ResponseWriter, status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /v1/auth/approle/login", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req["role_id"] != vaultRoleID || req["secret_id"] != vaultSecretID {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"invalid role or secret ID"}})
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"auth": map[string]any{"client_token": issueToken()}})
+	})
+
+	mux.HandleFunc("POST /v1/auth/cert/login", func(w http.ResponseWriter, r *http.Request) {
+		// Vault accepts only the certificates allowing client authentication
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != vaultNodeName ||
+			!slices.Contains(r.TLS.PeerCertificates[0].ExtKeyUsage, stdx509.ExtKeyUsageClientAuth) {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"invalid certificate or no client certificate supplied"}})
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{"auth": map[string]any{"client_token": issueToken()}})
+	})
+
+	mux.HandleFunc("POST /v1/auth/token/revoke-self", func(w http.ResponseWriter, r *http.Request) {
+		if !revokeToken(r.Header.Get("X-Vault-Token")) {
+			writeJSON(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /v1/transit/{op}/talos", func(w http.ResponseWriter, r *http.Request) {
+		if !isActiveToken(r.Header.Get("X-Vault-Token")) {
+			writeJSON(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+
+			return
+		}
+
+		var req map[string]string
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		// "seal" the data by prefixing it with the context
+		switch r.PathValue("op") {
+		case "encrypt":
+			writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"ciphertext": "vault:v1:" + req["context"] + ":" + req["plaintext"]}})
+		case "decrypt":
+			plaintext, ok := strings.CutPrefix(req["ciphertext"], "vault:v1:"+req["context"]+":")
+			if !ok {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"cipher: message authentication failed"}})
+
+				return
+			}
+
+			writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"plaintext": plaintext}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	srv := httptest.NewUnstartedServer(mux)
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequestClientCert,
+	}
+
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv, func() int {
+		tokensMu.Lock()
+		defer tokensMu.Unlock()
+
+		return len(activeTokens)
+	}
+}
+
+func serverCACert(srv *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+}
+
+// issueClientCertificate issues the client certificate for the TLS certificates auth method.
+func issueClientCertificate(t *testing.T, commonName string) (caCert []byte, identity *x509.PEMEncodedCertificateAndKey) {
+	t.Helper()
+
+	return issueCertificate(t, commonName, stdx509.ExtKeyUsageClientAuth)
+}
+
+func issueCertificate(t *testing.T, commonName string, extKeyUsage stdx509.ExtKeyUsage) (caCert []byte, identity *x509.PEMEncodedCertificateAndKey) {
+	t.Helper()
+
+	ca, err := secrets.NewTalosCA(time.Now())
+	require.NoError(t, err)
+
+	caIdentity, err := x509.NewCertificateAuthorityFromCertificateAndKey(&x509.PEMEncodedCertificateAndKey{
+		Crt: ca.CrtPEM,
+		Key: ca.KeyPEM,
+	})
+	require.NoError(t, err)
+
+	keyPair, err := x509.NewKeyPair(caIdentity,
+		x509.CommonName(commonName),
+		x509.NotAfter(time.Now().Add(time.Hour)),
+		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
+		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{
+			extKeyUsage,
+		}),
+	)
+	require.NoError(t, err)
+
+	return ca.CrtPEM, x509.NewCertificateAndKeyFromKeyPair(keyPair)
+}
+
+func nodeIdentityGetter(identity *x509.PEMEncodedCertificateAndKey) func(context.Context) (*x509.PEMEncodedCertificateAndKey, error) {
+	return func(context.Context) (*x509.PEMEncodedCertificateAndKey, error) {
+		return identity, nil
+	}
+}
+
+func systemInformationGetter(uuid string) func(context.Context) (*hardware.SystemInformation, error) {
+	return func(context.Context) (*hardware.SystemInformation, error) {
+		systemInformation := hardware.NewSystemInformation(hardware.SystemInformationID)
+		systemInformation.TypedSpec().UUID = uuid
+
+		return systemInformation, nil
+	}
+}
+
+func TestVaultKeyHandler(t *testing.T) {
+	t.Parallel()
+
+	srv, activeTokens := fakeVault(t)
+
+	spec := block.EncryptionKeyVaultSpec{
+		Address:         srv.URL,
+		CACert:          serverCACert(srv),
+		TransitMount:    "transit",
+		TransitKey:      "talos",
+		AppRoleMount:    "approle",
+		AppRoleRoleID:   vaultRoleID,
+		AppRoleSecretID: vaultSecretID,
+	}
+
+	handler, err := keys.NewHandler(block.EncryptionKey{
+		Slot:  1,
+		Type:  block.EncryptionKeyVault,
+		Vault: spec,
+	}, keys.WithSystemInformationGetter(systemInformationGetter("node-1")))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	key, token, err := handler.NewKey(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, key.Slot)
+
+	vaultToken, ok := token.(*luks.Token[*keys.VaultToken])
+	require.True(t, ok)
+
+	assert.Equal(t, keys.TokenTypeVault, vaultToken.Type)
+	assert.True(t, strings.HasPrefix(vaultToken.UserData.Ciphertext, "vault:v1:"+base64.StdEncoding.EncodeToString([]byte("node-1"))))
+
+	unsealedKey, err := handler.GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	// the sealed key is bound to the node UUID
+	otherHandler, err := keys.NewHandler(block.EncryptionKey{
+		Slot:  1,
+		Type:  block.EncryptionKeyVault,
+		Vault: spec,
+	}, keys.WithSystemInformationGetter(systemInformationGetter("node-2")))
+	require.NoError(t, err)
+
+	_, err = otherHandler.GetKey(ctx, token)
+	require.ErrorContains(t, err, "message authentication failed")
+
+	// invalid credentials
+	spec.AppRoleSecretID = "wrong"
+
+	badHandler, err := keys.NewHandler(block.EncryptionKey{
+		Slot:  1,
+		Type:  block.EncryptionKeyVault,
+		Vault: spec,
+	}, keys.WithSystemInformationGetter(systemInformationGetter("node-1")))
+	require.NoError(t, err)
+
+	_, _, err = badHandler.NewKey(ctx)
+	require.ErrorContains(t, err, "invalid role or secret ID")
+
+	// the tokens are revoked after each operation
+	assert.Zero(t, activeTokens())
+}
+
+func TestVaultKeyHandlerCert(t *testing.T) {
+	t.Parallel()
+
+	srv, activeTokens := fakeVault(t)
+
+	_, identity := issueClientCertificate(t, vaultNodeName)
+
+	spec := block.EncryptionKeyVaultSpec{
+		Address:        srv.URL,
+		CACert:         serverCACert(srv),
+		TransitMount:   "transit",
+		TransitKey:     "talos",
+		CertMount:      "cert",
+		CertClientCert: identity.Crt,
+		CertClientKey:  identity.Key,
+	}
+
+	newHandler := func(spec block.EncryptionKeyVaultSpec, opts ...keys.KeyOption) keys.Handler {
+		handler, err := keys.NewHandler(block.EncryptionKey{
+			Slot:  1,
+			Type:  block.EncryptionKeyVault,
+			Vault: spec,
+		}, append([]keys.KeyOption{keys.WithSystemInformationGetter(systemInformationGetter("node-1"))}, opts...)...)
+		require.NoError(t, err)
+
+		return handler
+	}
+
+	ctx := context.Background()
+
+	key, token, err := newHandler(spec).NewKey(ctx)
+	require.NoError(t, err)
+
+	unsealedKey, err := newHandler(spec).GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	// the client certificate is not configured, so the node identity is used
+	nodeIdentitySpec := spec
+	nodeIdentitySpec.CertClientCert = nil
+	nodeIdentitySpec.CertClientKey = nil
+
+	unsealedKey, err = newHandler(nodeIdentitySpec, keys.WithNodeIdentityGetter(nodeIdentityGetter(identity))).GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	_, err = newHandler(nodeIdentitySpec).GetKey(ctx, token)
+	require.ErrorContains(t, err, "node identity is not available")
+
+	// the certificate issued for another name is rejected
+	_, otherIdentity := issueClientCertificate(t, "other.example.com")
+
+	otherSpec := spec
+	otherSpec.CertClientCert = otherIdentity.Crt
+	otherSpec.CertClientKey = otherIdentity.Key
+
+	_, err = newHandler(otherSpec).GetKey(ctx, token)
+	require.ErrorContains(t, err, "invalid certificate")
+
+	// the server certificate can't be used for the client authentication
+	_, serverIdentity := issueCertificate(t, vaultNodeName, stdx509.ExtKeyUsageServerAuth)
+
+	serverSpec := spec
+	serverSpec.CertClientCert = serverIdentity.Crt
+	serverSpec.CertClientKey = serverIdentity.Key
+
+	_, err = newHandler(serverSpec).GetKey(ctx, token)
+	require.ErrorContains(t, err, "vault client certificate doesn't allow client authentication")
+
+	_, err = newHandler(nodeIdentitySpec, keys.WithNodeIdentityGetter(nodeIdentityGetter(serverIdentity))).GetKey(ctx, token)
+	require.ErrorContains(t, err, "vault client certificate doesn't allow client authentication")
+
+	// the tokens are revoked after each operation
+	assert.Zero(t, activeTokens())
+}
+
+const vaultRootToken = "root"
+
+// vaultDevServer starts the Vault server in the dev mode with TLS enabled.
+func vaultDevServer(t *testing.T, vault string) (address string, caCert []byte) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	listenAddress := listener.Addr().String()
+
+	require.NoError(t, listener.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	output, err := os.Create(filepath.Join(dir, "vault.log"))
+	require.NoError(t, err)
+
+	cmd := exec.CommandContext(ctx, vault, "server",
+		"-dev",
+		"-dev-tls",
+		"-dev-tls-cert-dir="+dir,
+		"-dev-root-token-id="+vaultRootToken,
+		"-dev-listen-address="+listenAddress,
+	)
+	cmd.Env = append(os.Environ(), "HOME="+dir)
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	require.NoError(t, cmd.Start())
+
+	t.Cleanup(func() {
+		cmd.Process.Kill() //nolint:errcheck
+		cmd.Wait()         //nolint:errcheck
+
+		output.Close() //nolint:errcheck
+
+		if t.Failed() {
+			logs, _ := os.ReadFile(output.Name()) //nolint:errcheck
+
+			t.Log(string(logs))
+		}
+	})
+
+	address = "https://" + listenAddress
+
+	require.Eventually(t, func() bool {
+		caCert, err = os.ReadFile(filepath.Join(dir, "vault-ca.pem"))
+		if err != nil {
+			return false
+		}
+
+		_, err = vaultRequest(t, address, caCert, http.MethodGet, "sys/health", nil)
+
+		return err == nil
+	}, 30*time.Second, 100*time.Millisecond)
+
+	return address, caCert
+}
+
+// vaultRequest calls the Vault API with the root token.
+func vaultRequest(t *testing.T, address string, caCert []byte, method, path string, req any) (map[string]any, error) {
+	t.Helper()
+
+	rootCAs := stdx509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(caCert))
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: rootCAs,
+			},
+		},
+	}
+
+	defer client.CloseIdleConnections()
+
+	var body io.Reader
+
+	if req != nil {
+		encoded, err := json.Marshal(req)
+		require.NoError(t, err)
+
+		body = bytes.NewReader(encoded)
+	}
+
+	httpReq, err := http.NewRequest(method, address+"/v1/"+path, body)
+	require.NoError(t, err)
+
+	httpReq.Header.Set("X-Vault-Token", vaultRootToken)
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+
+	var data struct {
+		Data map[string]any `json:"data"`
+	}
+
+	if len(respBody) > 0 {
+		if err = json.Unmarshal(respBody, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	return data.Data, nil
+}
+
+// TestVaultKeyHandlerDevServer seals and unseals the keys with the Transit secrets engine of the Vault dev server.
+func TestVaultKeyHandlerDevServer(t *testing.T) {
+	t.Parallel()
+
+	vault, err := exec.LookPath("vault")
+	if err != nil {
+		// the vault binary is installed in the unit-tests image, so the test should never be skipped in CI
+		if os.Getenv("CI") != "" {
+			t.Fatalf("vault is not installed: %s", err)
+		}
+
+		t.Skip("vault is not installed")
+	}
+
+	address, caCert := vaultDevServer(t, vault)
+
+	clientCACert, identity := issueClientCertificate(t, vaultNodeName)
+
+	mustRequest := func(method, path string, req any) map[string]any {
+		data, reqErr := vaultRequest(t, address, caCert, method, path, req)
+		require.NoError(t, reqErr)
+
+		return data
+	}
+
+	// the Transit key with key derivation, so the sealed key is bound to the context
+	mustRequest(http.MethodPost, "sys/mounts/transit", map[string]any{"type": "transit"})
+	mustRequest(http.MethodPost, "transit/keys/talos", map[string]any{"derived": true})
+	mustRequest(http.MethodPut, "sys/policies/acl/talos", map[string]any{
+		"policy": `
+path "transit/encrypt/talos" {
+  capabilities = ["update"]
+}
+
+path "transit/decrypt/talos" {
+  capabilities = ["update"]
+}
+`,
+	})
+
+	mustRequest(http.MethodPost, "sys/auth/approle", map[string]any{"type": "approle"})
+	mustRequest(http.MethodPost, "auth/approle/role/talos", map[string]any{"token_policies": []string{"talos"}})
+
+	roleID := mustRequest(http.MethodGet, "auth/approle/role/talos/role-id", nil)["role_id"].(string)        //nolint:forcetypeassert,errcheck
+	secretID := mustRequest(http.MethodPost, "auth/approle/role/talos/secret-id", nil)["secret_id"].(string) //nolint:forcetypeassert,errcheck
+
+	// the TLS certificates auth method trusts the client CA
+	mustRequest(http.MethodPost, "sys/auth/cert", map[string]any{"type": "cert"})
+	mustRequest(http.MethodPost, "auth/cert/certs/talos", map[string]any{
+		"certificate":    string(clientCACert),
+		"token_policies": []string{"talos"},
+	})
+
+	newHandler := func(spec block.EncryptionKeyVaultSpec, uuid string, clientIdentity *x509.PEMEncodedCertificateAndKey) keys.Handler {
+		spec.Address = address
+		spec.CACert = caCert
+		spec.TransitMount = "transit"
+		spec.TransitKey = "talos"
+
+		// the client identity is not configured, so the node identity is used
+		handler, handlerErr := keys.NewHandler(block.EncryptionKey{
+			Slot:  1,
+			Type:  block.EncryptionKeyVault,
+			Vault: spec,
+		},
+			keys.WithSystemInformationGetter(systemInformationGetter(uuid)),
+			keys.WithNodeIdentityGetter(nodeIdentityGetter(clientIdentity)),
+		)
+		require.NoError(t, handlerErr)
+
+		return handler
+	}
+
+	appRoleSpec := block.EncryptionKeyVaultSpec{
+		AppRoleMount:    "approle",
+		AppRoleRoleID:   roleID,
+		AppRoleSecretID: secretID,
+	}
+
+	certSpec := block.EncryptionKeyVaultSpec{
+		CertMount: "cert",
+		CertRole:  "talos",
+	}
+
+	ctx := context.Background()
+
+	key, token, err := newHandler(appRoleSpec, "node-1", identity).NewKey(ctx)
+	require.NoError(t, err)
+
+	vaultToken, ok := token.(*luks.Token[*keys.VaultToken])
+	require.True(t, ok)
+
+	assert.True(t, strings.HasPrefix(vaultToken.UserData.Ciphertext, "vault:v1:"))
+
+	unsealedKey, err := newHandler(appRoleSpec, "node-1", identity).GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	// the node identity is accepted by the TLS certificates auth method
+	unsealedKey, err = newHandler(certSpec, "node-1", identity).GetKey(ctx, token)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Value, unsealedKey.Value)
+
+	// the sealed key is bound to the node UUID
+	_, err = newHandler(appRoleSpec, "node-2", identity).GetKey(ctx, token)
+	require.Error(t, err)
+
+	// the client certificate issued by another CA is rejected
+	_, otherIdentity := issueClientCertificate(t, vaultNodeName)
+
+	_, err = newHandler(certSpec, "node-1", otherIdentity).GetKey(ctx, token)
+	require.ErrorContains(t, err, "failed to login to Vault")
+
+	// invalid AppRole credentials
+	appRoleSpec.AppRoleSecretID = "wrong"
+
+	_, _, err = newHandler(appRoleSpec, "node-1", identity).NewKey(ctx)
+	require.ErrorContains(t, err, "failed to login to Vault")
+}
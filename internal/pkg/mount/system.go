@@ -26,6 +26,17 @@ var (
 	mountpointsMutex sync.RWMutex
 )
 
+// VolumeMountOptions returns mount options derived from the volume filesystem configuration.
+func VolumeMountOptions(spec *block.VolumeConfigSpec) []mountv2.NewPointOption {
+	var opts []mountv2.NewPointOption
+
+	if btrfsSpec := spec.Provisioning.FilesystemSpec.BTRFS; btrfsSpec != nil && btrfsSpec.Compression != "" {
+		opts = append(opts, mountv2.WithData("compress="+btrfsSpec.Compression))
+	}
+
+	return opts
+}
+
 // SystemPartitionMount mounts a system partition by the label.
 func SystemPartitionMount(ctx context.Context, r runtime.Runtime, logger *log.Logger, label string, opts ...mountv2.NewPointOption) (err error) {
 	volumeStatus, err := safe.StateGetByID[*block.VolumeStatus](ctx, r.State().V1Alpha2().Resources(), label)
@@ -46,7 +57,7 @@ func SystemPartitionMount(ctx context.Context, r runtime.Runtime, logger *log.Lo
 		volumeStatus.TypedSpec().MountLocation,
 		volumeConfig.TypedSpec().Mount.TargetPath,
 		volumeStatus.TypedSpec().Filesystem.String(),
-		opts...,
+		append(VolumeMountOptions(volumeConfig.TypedSpec()), opts...)...,
 	)
 
 	unmounter, err := mountpoint.Mount(mountv2.WithMountPrinter(logger.Printf))
@@ -59,6 +70,7 @@ func SystemPartitionMount(ctx context.Context, r runtime.Runtime, logger *log.Lo
 	mountStatus.TypedSpec().Source = volumeStatus.TypedSpec().MountLocation
 	mountStatus.TypedSpec().Target = volumeConfig.TypedSpec().Mount.TargetPath
 	mountStatus.TypedSpec().FilesystemType = volumeStatus.TypedSpec().Filesystem.String()
+	mountStatus.TypedSpec().Options = mountpoint.Options()
 	mountStatus.TypedSpec().Encrypted = volumeStatus.TypedSpec().EncryptionProvider != block.EncryptionProviderNone
 
 	if mountStatus.TypedSpec().Encrypted {
@@ -99,6 +99,15 @@ func NewPoint(source, target, fstype string, opts ...NewPointOption) *Point {
 	return p
 }
 
+// Options returns the filesystem-specific mount options.
+func (p *Point) Options() []string {
+	if p.data == "" {
+		return nil
+	}
+
+	return strings.Split(p.data, ",")
+}
+
 // NewReadonlyOverlay creates a new read-only overlay mount point.
 func NewReadonlyOverlay(sources []string, target string, opts ...NewPointOption) *Point {
 	opts = append(opts, WithReadonly(), WithData("lowerdir="+strings.Join(sources, ":")))
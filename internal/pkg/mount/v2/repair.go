@@ -14,8 +14,24 @@ import (
 func (p *Point) repair(printerOptions PrinterOptions) error {
 	printerOptions.Printf("filesystem on %s needs cleaning, running repair", p.source)
 
-	if err := makefs.XFSRepair(p.source, p.fstype); err != nil {
-		return fmt.Errorf("xfs_repair: %w", err)
+	switch p.fstype {
+	case makefs.FilesystemTypeEXT4:
+		modified, err := makefs.Ext4Repair(p.source, p.fstype)
+		if err != nil {
+			return fmt.Errorf("e2fsck: %w", err)
+		}
+
+		if modified {
+			printerOptions.Printf("filesystem errors on %s were corrected", p.source)
+		}
+	case makefs.FilesystemTypeBTRFS:
+		if err := makefs.BtrfsRepair(p.source, p.fstype); err != nil {
+			return fmt.Errorf("btrfs rescue: %w", err)
+		}
+	default:
+		if err := makefs.XFSRepair(p.source, p.fstype); err != nil {
+			return fmt.Errorf("xfs_repair: %w", err)
+		}
 	}
 
 	printerOptions.Printf("filesystem successfully repaired on %s", p.source)
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nvme implements NVMe admin commands via the Linux passthrough ioctl.
+package nvme
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	nvmeIoctlID       = 0x4E40     // _IO('N', 0x40)
+	nvmeIoctlAdminCmd = 0xC0484E41 // _IOWR('N', 0x41, struct nvme_admin_cmd)
+
+	// NSIDAll is the broadcast namespace ID.
+	NSIDAll = 0xffffffff
+
+	// IdentifySize is the size of the Identify data structure.
+	IdentifySize = 4096
+
+	defaultTimeout = 5 * time.Second
+)
+
+// Admin command opcodes.
+const (
+	OpcodeGetLogPage = 0x02
+	OpcodeIdentify   = 0x06
+	OpcodeFormatNVM  = 0x80
+)
+
+// Identify CNS values.
+const (
+	IdentifyNamespace           = 0x00
+	IdentifyController          = 0x01
+	IdentifyActiveNamespaceList = 0x02
+)
+
+// passthruCmd is struct nvme_passthru_cmd from linux/nvme_ioctl.h.
+type passthruCmd struct {
+	Opcode      uint8
+	Flags       uint8
+	Rsvd1       uint16
+	NSID        uint32
+	Cdw2        uint32
+	Cdw3        uint32
+	Metadata    uint64
+	Addr        uint64
+	MetadataLen uint32
+	DataLen     uint32
+	Cdw10       uint32
+	Cdw11       uint32
+	Cdw12       uint32
+	Cdw13       uint32
+	Cdw14       uint32
+	Cdw15       uint32
+	TimeoutMS   uint32
+	Result      uint32
+}
+
+// AdminCommand is an NVMe admin command.
+type AdminCommand struct {
+	Opcode uint8
+	NSID   uint32
+	Cdw10  uint32
+	Cdw11  uint32
+
+	// Data is the buffer for the data transferred from the controller (if any).
+	Data []byte
+
+	// Timeout for the command, if not set, the default timeout of 5 seconds is used.
+	Timeout time.Duration
+}
+
+// Admin executes the admin command on the NVMe device (controller or namespace).
+func Admin(f *os.File, cmd AdminCommand) error {
+	timeout := cmd.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	raw := passthruCmd{
+		Opcode:    cmd.Opcode,
+		NSID:      cmd.NSID,
+		Cdw10:     cmd.Cdw10,
+		Cdw11:     cmd.Cdw11,
+		TimeoutMS: uint32(timeout.Milliseconds()),
+	}
+
+	if len(cmd.Data) > 0 {
+		raw.Addr = uint64(uintptr(unsafe.Pointer(&cmd.Data[0])))
+		raw.DataLen = uint32(len(cmd.Data))
+	}
+
+	r, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlAdminCmd, uintptr(unsafe.Pointer(&raw)))
+	if errno != 0 {
+		return errno
+	}
+
+	// positive return value is the NVMe status code
+	if r != 0 {
+		return fmt.Errorf("NVMe command 0x%02x failed with status 0x%x", cmd.Opcode, r)
+	}
+
+	return nil
+}
+
+// NamespaceID returns the namespace ID of the NVMe namespace block device.
+func NamespaceID(f *os.File) (uint32, error) {
+	r, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nvmeIoctlID, 0)
+	if errno != 0 {
+		return 0, errno
+	}
+
+	return uint32(r), nil
+}
+
+// Identify reads the Identify data structure.
+func Identify(f *os.File, cns uint8, nsid uint32) ([]byte, error) {
+	buf := make([]byte, IdentifySize)
+
+	if err := Admin(f, AdminCommand{
+		Opcode: OpcodeIdentify,
+		NSID:   nsid,
+		Cdw10:  uint32(cns),
+		Data:   buf,
+	}); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package projectquota manages XFS project quotas.
+package projectquota
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Project IDs are allocated in the range which doesn't overlap with the range used by the kubelet
+// for the emptyDir quota monitoring (starting at 1048577).
+const (
+	MinProjectID = 0x10000
+	MaxProjectID = 0xfffff
+)
+
+// ProjectID returns the default project ID for the path.
+//
+// The project ID is derived from the path, so it is stable across reboots and configuration changes.
+func ProjectID(path string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(path)) //nolint:errcheck
+
+	return MinProjectID + h.Sum32()%(MaxProjectID-MinProjectID+1)
+}
+
+const (
+	fsIocFSGetXAttr = 0x801c581f // _IOR('X', 31, struct fsxattr)
+	fsIocFSSetXAttr = 0x401c5820 // _IOW('X', 32, struct fsxattr)
+
+	fsXFlagProjInherit = 0x00000200
+)
+
+// fsxattr is struct fsxattr from linux/fs.h.
+type fsxattr struct {
+	XFlags     uint32
+	ExtSize    uint32
+	NExtents   uint32
+	ProjID     uint32
+	CowExtSize uint32
+	Pad        [8]byte
+}
+
+// SetProjectID assigns the project ID to the directory and all its contents.
+//
+// Directories get the project inheritance flag, so that new files inherit the project ID.
+// The directory should exist, it is not created, as the directory owner should pick the permissions.
+// If the directory already has the project ID with the inheritance flag, the contents are not walked.
+// The skip function is called for each subdirectory, and if it returns true, the subdirectory is skipped.
+func SetProjectID(root string, projectID uint32, skip func(path string) bool) error {
+	st, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+
+	if !st.IsDir() {
+		return fmt.Errorf("%q is not a directory", root)
+	}
+
+	applied, err := hasProjectID(root, projectID)
+	if err != nil {
+		return fmt.Errorf("error getting project ID for %q: %w", root, err)
+	}
+
+	if applied {
+		return nil
+	}
+
+	if err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// the root directory is tagged after the contents, so that an interrupted walk is retried
+		if path == root {
+			return nil
+		}
+
+		if d.IsDir() && skip != nil && skip(path) {
+			return filepath.SkipDir
+		}
+
+		// project ID can only be set on regular files and directories
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		if err = setProjectID(path, projectID, d.IsDir()); err != nil {
+			// the file might have been removed while walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return fmt.Errorf("error setting project ID for %q: %w", path, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err = setProjectID(root, projectID, true); err != nil {
+		return fmt.Errorf("error setting project ID for %q: %w", root, err)
+	}
+
+	return nil
+}
+
+// hasProjectID checks whether the directory has the project ID with the inheritance flag.
+func hasProjectID(path string, projectID uint32) (bool, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var attr fsxattr
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFSGetXAttr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return false, errno
+	}
+
+	return attr.ProjID == projectID && attr.XFlags&fsXFlagProjInherit != 0, nil
+}
+
+func setProjectID(path string, projectID uint32, inherit bool) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_NOFOLLOW|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	var attr fsxattr
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFSGetXAttr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errno
+	}
+
+	if attr.ProjID == projectID && (!inherit || attr.XFlags&fsXFlagProjInherit != 0) {
+		return nil
+	}
+
+	attr.ProjID = projectID
+
+	if inherit {
+		attr.XFlags |= fsXFlagProjInherit
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), fsIocFSSetXAttr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package projectquota
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructSizes(t *testing.T) {
+	t.Parallel()
+
+	assert.EqualValues(t, 28, unsafe.Sizeof(fsxattr{}))
+	assert.EqualValues(t, 112, unsafe.Sizeof(fsDiskQuota{}))
+}
+
+func TestProjectID(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"/var/log", "/var/lib/containerd", "/var/lib/etcd"} {
+		id := ProjectID(path)
+
+		assert.GreaterOrEqual(t, id, uint32(MinProjectID))
+		assert.LessOrEqual(t, id, uint32(MaxProjectID))
+		assert.Equal(t, id, ProjectID(path))
+	}
+
+	assert.NotEqual(t, ProjectID("/var/log"), ProjectID("/var/lib/etcd"))
+}
+
+func TestSetProjectIDMissingDirectory(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "missing")
+
+	require.ErrorIs(t, SetProjectID(root, MinProjectID, nil), fs.ErrNotExist)
+
+	// the directory is not created
+	_, err := os.Stat(root)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	require.ErrorContains(t, SetProjectID(file, MinProjectID, nil), "is not a directory")
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package projectquota
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	qXGetQuota = 0x5803 // XQM_CMD(3)
+	qXSetQLim  = 0x5804 // XQM_CMD(4)
+
+	prjQuota = 2
+
+	fsDQuotVersion = 1
+	fsProjQuota    = 2
+
+	fsDQBSoft = 1 << 2
+	fsDQBHard = 1 << 3
+
+	// XFS quota block counts are in 512-byte basic blocks.
+	basicBlockSize = 512
+)
+
+// fsDiskQuota is struct fs_disk_quota from linux/dqblk_xfs.h.
+type fsDiskQuota struct {
+	Version      int8
+	Flags        int8
+	FieldMask    uint16
+	ID           uint32
+	BlkHardLimit uint64
+	BlkSoftLimit uint64
+	InoHardLimit uint64
+	InoSoftLimit uint64
+	BCount       uint64
+	ICount       uint64
+	ITimer       int32
+	BTimer       int32
+	IWarns       uint16
+	BWarns       uint16
+	ITimerHi     int8
+	BTimerHi     int8
+	RtBTimerHi   int8
+	Padding2     int8
+	RtBHardLimit uint64
+	RtBSoftLimit uint64
+	RtBCount     uint64
+	RtBTimer     int32
+	RtBWarns     uint16
+	Padding3     int16
+	Padding4     [8]byte
+}
+
+// Quota is the project quota limits and usage.
+type Quota struct {
+	HardLimit uint64
+	SoftLimit uint64
+
+	UsedBytes  uint64
+	UsedInodes uint64
+}
+
+func quotactl(mountPoint string, cmd int, projectID uint32, dq *fsDiskQuota) error {
+	f, err := os.Open(mountPoint)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if _, _, errno := unix.Syscall6(unix.SYS_QUOTACTL_FD, f.Fd(), uintptr(cmd<<8|prjQuota), uintptr(projectID), uintptr(unsafe.Pointer(dq)), 0, 0); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+// SetLimits sets the block limits (in bytes) of the project on the filesystem mounted at the mount point.
+//
+// Zero limit removes the limit.
+func SetLimits(mountPoint string, projectID uint32, softLimit, hardLimit uint64) error {
+	dq := fsDiskQuota{
+		Version:      fsDQuotVersion,
+		Flags:        fsProjQuota,
+		FieldMask:    fsDQBSoft | fsDQBHard,
+		ID:           projectID,
+		BlkHardLimit: hardLimit / basicBlockSize,
+		BlkSoftLimit: softLimit / basicBlockSize,
+	}
+
+	return quotactl(mountPoint, qXSetQLim, projectID, &dq)
+}
+
+// Get returns the limits and usage of the project on the filesystem mounted at the mount point.
+func Get(mountPoint string, projectID uint32) (Quota, error) {
+	var dq fsDiskQuota
+
+	if err := quotactl(mountPoint, qXGetQuota, projectID, &dq); err != nil {
+		return Quota{}, err
+	}
+
+	return Quota{
+		HardLimit:  dq.BlkHardLimit * basicBlockSize,
+		SoftLimit:  dq.BlkSoftLimit * basicBlockSize,
+		UsedBytes:  dq.BCount * basicBlockSize,
+		UsedInodes: dq.ICount,
+	}, nil
+}
@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	sgIO            = 0x2285
+	sgInterfaceID   = 'S'
+	sgDxferNone     = -1
+	sgDxferFromDev  = -3
+	sgIOTimeoutMS   = 5000
+	sgSenseDataSize = 32
+
+	scsiStatusCheckCondition = 0x02
+	sgDriverSense            = 0x08
+
+	ataStatusError = 0x01
+
+	ataPassThrough16 = 0x85
+	ataSMART         = 0xb0
+	ataSMARTReadData = 0xd0
+	ataSMARTStatus   = 0xda
+	ataSMARTLBAMid   = 0x4f
+	ataSMARTLBAHigh  = 0xc2
+
+	ataSMARTDataSize      = 512
+	ataSMARTAttributes    = 30
+	ataSMARTAttributeSize = 12
+)
+
+// ATA SMART attribute IDs.
+const (
+	ataAttrReallocatedSectors = 5
+	ataAttrPowerOnHours       = 9
+	ataAttrPowerCycles        = 12
+	ataAttrReportedUncorrect  = 187
+	ataAttrAirflowTemperature = 190
+	ataAttrTemperature        = 194
+)
+
+// sgIOHdr is struct sg_io_hdr from scsi/sg.h.
+type sgIOHdr struct {
+	InterfaceID    int32
+	DxferDirection int32
+	CmdLen         uint8
+	MxSbLen        uint8
+	IovecCount     uint16
+	DxferLen       uint32
+	Dxferp         unsafe.Pointer
+	Cmdp           unsafe.Pointer
+	Sbp            unsafe.Pointer
+	Timeout        uint32
+	Flags          uint32
+	PackID         int32
+	UsrPtr         unsafe.Pointer
+	Status         uint8
+	MaskedStatus   uint8
+	MsgStatus      uint8
+	SbLenWr        uint8
+	HostStatus     uint16
+	DriverStatus   uint16
+	Resid          int32
+	Duration       uint32
+	Info           uint32
+}
+
+// sgExecute executes the SCSI command.
+//
+// If checkCondition is set, the ATA registers are expected to be returned as the sense data with CHECK CONDITION.
+func sgExecute(f *os.File, cdb []byte, data []byte, sense []byte, checkCondition bool) error {
+	hdr := sgIOHdr{
+		InterfaceID:    sgInterfaceID,
+		DxferDirection: sgDxferNone,
+		CmdLen:         uint8(len(cdb)),
+		MxSbLen:        uint8(len(sense)),
+		Cmdp:           unsafe.Pointer(&cdb[0]),
+		Sbp:            unsafe.Pointer(&sense[0]),
+		Timeout:        sgIOTimeoutMS,
+	}
+
+	if len(data) > 0 {
+		hdr.DxferDirection = sgDxferFromDev
+		hdr.DxferLen = uint32(len(data))
+		hdr.Dxferp = unsafe.Pointer(&data[0])
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sgIO, uintptr(unsafe.Pointer(&hdr))); errno != 0 {
+		return errno
+	}
+
+	return CheckSGStatus(hdr.Status, hdr.HostStatus, hdr.DriverStatus, sense[:hdr.SbLenWr], checkCondition)
+}
+
+// CheckSGStatus checks the result of the SCSI command.
+//
+// The CHECK CONDITION status is accepted only if checkCondition is set, and the sense data contains
+// the ATA Status Return descriptor without the error bit set.
+func CheckSGStatus(status uint8, hostStatus, driverStatus uint16, sense []byte, checkCondition bool) error {
+	if hostStatus != 0 {
+		return fmt.Errorf("SCSI host status 0x%02x", hostStatus)
+	}
+
+	if status == 0 && driverStatus == 0 {
+		return nil
+	}
+
+	if checkCondition && status == scsiStatusCheckCondition && driverStatus&^sgDriverSense == 0 &&
+		hasATAStatusReturn(sense) && sense[8+13]&ataStatusError == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("SCSI status 0x%02x, driver status 0x%02x, sense data %x", status, driverStatus, sense)
+}
+
+// hasATAStatusReturn checks that the sense data is in the descriptor format with the ATA Status Return descriptor.
+func hasATAStatusReturn(sense []byte) bool {
+	return len(sense) >= 22 && sense[0]&0x7f == 0x72 && sense[8] == 0x09
+}
+
+// ataSMARTCommand builds ATA PASS-THROUGH (16) CDB for the SMART command.
+func ataSMARTCommand(feature byte, dataIn bool) []byte {
+	cdb := make([]byte, 16)
+
+	cdb[0] = ataPassThrough16
+
+	if dataIn {
+		cdb[1] = 4 << 1 // PIO Data-In
+		cdb[2] = 0x0e   // T_DIR=1, BYT_BLOK=1, T_LENGTH=sector count
+	} else {
+		cdb[1] = 3 << 1 // Non-data
+		cdb[2] = 0x20   // CK_COND=1, return the ATA registers in the sense data
+	}
+
+	cdb[4] = feature
+	cdb[6] = 1 // sector count
+	cdb[10] = ataSMARTLBAMid
+	cdb[12] = ataSMARTLBAHigh
+	cdb[14] = ataSMART
+
+	return cdb
+}
+
+func readATASMARTData(f *os.File) ([]byte, error) {
+	data := make([]byte, ataSMARTDataSize)
+	sense := make([]byte, sgSenseDataSize)
+
+	if err := sgExecute(f, ataSMARTCommand(ataSMARTReadData, true), data, sense, false); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func readATASMARTStatus(f *os.File) (bool, error) {
+	sense := make([]byte, sgSenseDataSize)
+
+	if err := sgExecute(f, ataSMARTCommand(ataSMARTStatus, false), nil, sense, true); err != nil {
+		return false, err
+	}
+
+	return ParseATASMARTStatus(sense)
+}
+
+// ParseATASMARTStatus parses the result of SMART RETURN STATUS command from the descriptor format sense data.
+//
+// It returns false if the drive reports that the SMART threshold is exceeded.
+func ParseATASMARTStatus(sense []byte) (bool, error) {
+	// descriptor format sense data with the ATA Status Return descriptor
+	if !hasATAStatusReturn(sense) {
+		return false, errors.New("no ATA status return descriptor in the sense data")
+	}
+
+	lbaMid, lbaHigh := sense[8+9], sense[8+11]
+
+	switch {
+	case lbaMid == ataSMARTLBAMid && lbaHigh == ataSMARTLBAHigh:
+		return true, nil
+	case lbaMid == 0xf4 && lbaHigh == 0x2c:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected SMART status registers 0x%02x 0x%02x", lbaMid, lbaHigh)
+	}
+}
+
+// ParseATASMARTData parses the SMART attributes from ATA SMART READ DATA response.
+//
+// The overall health is not part of the SMART data, so Healthy should be set from the SMART RETURN STATUS result.
+func ParseATASMARTData(data []byte) (*Health, error) {
+	if len(data) < ataSMARTDataSize {
+		return nil, fmt.Errorf("ATA SMART data is too short: %d bytes", len(data))
+	}
+
+	health := &Health{
+		Protocol: ProtocolATA,
+	}
+
+	for i := range ataSMARTAttributes {
+		attr := data[2+i*ataSMARTAttributeSize : 2+(i+1)*ataSMARTAttributeSize]
+
+		id := attr[0]
+		if id == 0 {
+			continue
+		}
+
+		// 48-bit raw value
+		raw := binary.LittleEndian.Uint64(append(attr[5:11:11], 0, 0))
+
+		switch id {
+		case ataAttrReallocatedSectors:
+			health.ReallocatedSectors = raw
+		case ataAttrPowerOnHours:
+			health.PowerOnHours = raw & 0xffffffff
+		case ataAttrPowerCycles:
+			health.PowerCycles = raw
+		case ataAttrReportedUncorrect:
+			health.MediaErrors = raw
+		case ataAttrTemperature:
+			health.Temperature = int(raw & 0xff)
+		case ataAttrAirflowTemperature:
+			if health.Temperature == 0 {
+				health.Temperature = int(raw & 0xff)
+			}
+		}
+	}
+
+	return health, nil
+}
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/siderolabs/talos/internal/pkg/nvme"
+)
+
+const (
+	nvmeLogSMARTHealth = 0x02
+	nvmeHealthLogSize  = 512
+)
+
+func readNVMeHealthLog(f *os.File) ([]byte, error) {
+	buf := make([]byte, nvmeHealthLogSize)
+
+	if err := nvme.Admin(f, nvme.AdminCommand{
+		Opcode: nvme.OpcodeGetLogPage,
+		NSID:   nvme.NSIDAll,
+		Cdw10:  nvmeLogSMARTHealth | (uint32(len(buf)/4-1) << 16),
+		Data:   buf,
+	}); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// ParseNVMeHealthLog parses the NVMe SMART / Health Information log page (log identifier 02h).
+func ParseNVMeHealthLog(log []byte) (*Health, error) {
+	if len(log) < nvmeHealthLogSize {
+		return nil, fmt.Errorf("NVMe SMART/health log is too short: %d bytes", len(log))
+	}
+
+	// 128-bit counters are truncated to the lower 64 bits
+	le64 := func(offset int) uint64 {
+		return binary.LittleEndian.Uint64(log[offset : offset+8])
+	}
+
+	health := &Health{
+		Protocol:        ProtocolNVMe,
+		CriticalWarning: log[0],
+		PercentageUsed:  log[5],
+		PowerCycles:     le64(112),
+		PowerOnHours:    le64(128),
+		MediaErrors:     le64(160),
+	}
+
+	health.Healthy = health.CriticalWarning == 0
+
+	if kelvin := binary.LittleEndian.Uint16(log[1:3]); kelvin != 0 {
+		health.Temperature = int(kelvin) - 273
+	}
+
+	return health, nil
+}
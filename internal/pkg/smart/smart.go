@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package smart reads the health information of NVMe and ATA drives.
+package smart
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Protocol is the protocol used to read the drive health information.
+type Protocol string
+
+// Supported protocols.
+const (
+	ProtocolNVMe Protocol = "nvme"
+	ProtocolATA  Protocol = "ata"
+)
+
+// ErrNotSupported is returned when the drive health can't be read for the transport.
+var ErrNotSupported = errors.New("drive health is not supported")
+
+// Health is the drive health information.
+//
+// Fields which are not reported by the drive are left zero.
+type Health struct {
+	Protocol Protocol
+
+	// Healthy is the overall drive health assessment:
+	// NVMe critical warning is not set, or ATA SMART status is passed.
+	Healthy bool
+
+	// NVMe critical warning bitmask.
+	CriticalWarning uint8
+
+	Temperature    int // Celsius
+	PercentageUsed uint8
+
+	MediaErrors        uint64
+	ReallocatedSectors uint64
+	PowerOnHours       uint64
+	PowerCycles        uint64
+}
+
+// ProtocolForTransport returns the protocol to read the health information for the block device transport.
+func ProtocolForTransport(transport string) (Protocol, error) {
+	switch transport {
+	case "nvme":
+		return ProtocolNVMe, nil
+	case "sata", "ata":
+		return ProtocolATA, nil
+	default:
+		return "", fmt.Errorf("%w for transport %q", ErrNotSupported, transport)
+	}
+}
+
+// Read reads the health information of the drive at the specified path.
+func Read(path string, protocol Protocol) (*Health, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	switch protocol {
+	case ProtocolNVMe:
+		log, err := readNVMeHealthLog(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading NVMe SMART/health log: %w", err)
+		}
+
+		return ParseNVMeHealthLog(log)
+	case ProtocolATA:
+		data, err := readATASMARTData(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ATA SMART data: %w", err)
+		}
+
+		passed, err := readATASMARTStatus(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ATA SMART status: %w", err)
+		}
+
+		health, err := ParseATASMARTData(data)
+		if err != nil {
+			return nil, err
+		}
+
+		health.Healthy = passed
+
+		return health, nil
+	default:
+		return nil, fmt.Errorf("%w for protocol %q", ErrNotSupported, protocol)
+	}
+}
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package smart_test
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/smart"
+)
+
+func TestParseNVMeHealthLog(t *testing.T) {
+	t.Parallel()
+
+	log := make([]byte, 512)
+	log[0] = 0x04                                  // reliability degraded
+	binary.LittleEndian.PutUint16(log[1:], 273+45) // composite temperature
+	log[5] = 12                                    // percentage used
+	binary.LittleEndian.PutUint64(log[112:], 100)  // power cycles
+	binary.LittleEndian.PutUint64(log[128:], 8760) // power on hours
+	binary.LittleEndian.PutUint64(log[160:], 3)    // media errors
+
+	health, err := smart.ParseNVMeHealthLog(log)
+	require.NoError(t, err)
+
+	assert.Equal(t, &smart.Health{
+		Protocol:        smart.ProtocolNVMe,
+		Healthy:         false,
+		CriticalWarning: 0x04,
+		Temperature:     45,
+		PercentageUsed:  12,
+		MediaErrors:     3,
+		PowerOnHours:    8760,
+		PowerCycles:     100,
+	}, health)
+
+	_, err = smart.ParseNVMeHealthLog(log[:100])
+	require.Error(t, err)
+}
+
+func TestParseATASMARTData(t *testing.T) {
+	t.Parallel()
+
+	data := make([]byte, 512)
+
+	setAttribute := func(idx int, id byte, raw uint64) {
+		attr := data[2+idx*12 : 2+(idx+1)*12]
+		attr[0] = id
+
+		var rawBytes [8]byte
+
+		binary.LittleEndian.PutUint64(rawBytes[:], raw)
+		copy(attr[5:11], rawBytes[:6])
+	}
+
+	setAttribute(0, 5, 8)                  // reallocated sectors
+	setAttribute(1, 9, 0x0001_0000_2238)   // power on hours, upper bytes are vendor-specific
+	setAttribute(2, 12, 42)                // power cycles
+	setAttribute(3, 187, 1)                // reported uncorrectable errors
+	setAttribute(4, 190, 30)               // airflow temperature
+	setAttribute(5, 194, 0x0014_0037_0021) // temperature 33C, min/max in upper bytes
+
+	health, err := smart.ParseATASMARTData(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, &smart.Health{
+		Protocol:           smart.ProtocolATA,
+		Temperature:        33,
+		MediaErrors:        1,
+		ReallocatedSectors: 8,
+		PowerOnHours:       8760,
+		PowerCycles:        42,
+	}, health)
+}
+
+func TestParseATASMARTStatus(t *testing.T) {
+	t.Parallel()
+
+	sense := func(lbaMid, lbaHigh byte) []byte {
+		b := make([]byte, 32)
+		b[0] = 0x72
+		b[7] = 14
+		b[8] = 0x09
+		b[9] = 0x0c
+		b[8+9] = lbaMid
+		b[8+11] = lbaHigh
+
+		return b
+	}
+
+	passed, err := smart.ParseATASMARTStatus(sense(0x4f, 0xc2))
+	require.NoError(t, err)
+	assert.True(t, passed)
+
+	passed, err = smart.ParseATASMARTStatus(sense(0xf4, 0x2c))
+	require.NoError(t, err)
+	assert.False(t, passed)
+
+	_, err = smart.ParseATASMARTStatus(make([]byte, 32))
+	require.Error(t, err)
+}
+
+func TestCheckSGStatus(t *testing.T) {
+	t.Parallel()
+
+	ataStatusReturn := make([]byte, 22)
+	ataStatusReturn[0] = 0x72
+	ataStatusReturn[7] = 14
+	ataStatusReturn[8] = 0x09
+	ataStatusReturn[9] = 0x0c
+	ataStatusReturn[8+13] = 0x50 // DRDY, DSC
+
+	ataError := slices.Clone(ataStatusReturn)
+	ataError[8+13] = 0x51 // DRDY, DSC, ERR
+
+	// fixed format sense data, ILLEGAL REQUEST
+	illegalRequest := make([]byte, 18)
+	illegalRequest[0] = 0x70
+	illegalRequest[2] = 0x05
+
+	require.NoError(t, smart.CheckSGStatus(0, 0, 0, nil, false))
+	require.NoError(t, smart.CheckSGStatus(0x02, 0, 0x08, ataStatusReturn, true))
+
+	require.ErrorContains(t, smart.CheckSGStatus(0, 0x07, 0, nil, false), "SCSI host status 0x07")
+	require.ErrorContains(t, smart.CheckSGStatus(0x02, 0, 0x08, ataStatusReturn, false), "SCSI status 0x02")
+	require.ErrorContains(t, smart.CheckSGStatus(0x02, 0, 0x08, ataError, true), "SCSI status 0x02")
+	require.ErrorContains(t, smart.CheckSGStatus(0x02, 0, 0x08, illegalRequest, true), "SCSI status 0x02")
+	require.ErrorContains(t, smart.CheckSGStatus(0, 0, 0x06, nil, false), "driver status 0x06")
+}
+
+func TestProtocolForTransport(t *testing.T) {
+	t.Parallel()
+
+	protocol, err := smart.ProtocolForTransport("nvme")
+	require.NoError(t, err)
+	assert.Equal(t, smart.ProtocolNVMe, protocol)
+
+	protocol, err = smart.ProtocolForTransport("sata")
+	require.NoError(t, err)
+	assert.Equal(t, smart.ProtocolATA, protocol)
+
+	_, err = smart.ProtocolForTransport("virtio")
+	require.ErrorIs(t, err, smart.ErrNotSupported)
+}
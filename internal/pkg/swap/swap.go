@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package swap provides functions to activate, deactivate and list swap devices.
+package swap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// ProcSwaps is the path to the list of active swap devices.
+const ProcSwaps = "/proc/swaps"
+
+// Swap flags, see include/linux/swap.h.
+const (
+	flagPrefer   = 0x8000
+	flagPrioMask = 0x7fff
+)
+
+// On activates the swap device.
+//
+// If the priority is negative, the kernel assigns the priority automatically.
+func On(device string, priority int) error {
+	path, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	var flags uintptr
+
+	if priority >= 0 {
+		flags = flagPrefer | uintptr(priority)&flagPrioMask
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPON, uintptr(unsafe.Pointer(path)), flags, 0); errno != 0 {
+		return fmt.Errorf("error activating swap on %q: %w", device, errno)
+	}
+
+	return nil
+}
+
+// Off deactivates the swap device.
+func Off(device string) error {
+	path, err := unix.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPOFF, uintptr(unsafe.Pointer(path)), 0, 0); errno != 0 {
+		return fmt.Errorf("error deactivating swap on %q: %w", device, errno)
+	}
+
+	return nil
+}
+
+// Device describes an active swap device.
+type Device struct {
+	Name      string
+	Type      string
+	SizeBytes uint64
+	UsedBytes uint64
+	Priority  int32
+}
+
+// List returns the active swap devices.
+func List() ([]Device, error) {
+	f, err := os.Open(ProcSwaps)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return Parse(f)
+}
+
+// Parse parses the /proc/swaps contents.
+func Parse(r io.Reader) ([]Device, error) {
+	var devices []Device
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		// skip the header and malformed lines
+		if len(fields) != 5 || fields[0] == "Filename" {
+			continue
+		}
+
+		size, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap size %q: %w", fields[2], err)
+		}
+
+		used, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap usage %q: %w", fields[3], err)
+		}
+
+		priority, err := strconv.ParseInt(fields[4], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing swap priority %q: %w", fields[4], err)
+		}
+
+		devices = append(devices, Device{
+			// spaces in the file names are escaped as octal sequences
+			Name: strings.ReplaceAll(fields[0], `\040`, " "),
+			Type: fields[1],
+			// sizes are reported in KiB
+			SizeBytes: size * 1024,
+			UsedBytes: used * 1024,
+			Priority:  int32(priority),
+		})
+	}
+
+	return devices, scanner.Err()
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package swap_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/swap"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	devices, err := swap.Parse(strings.NewReader(`Filename				Type		Size		Used		Priority
+/dev/dm-0                               partition	4194300		1024		-2
+/dev/zram0                              partition	2097148		0		100
+/var/swap\040file                       file		1024		0		-3
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []swap.Device{
+		{
+			Name:      "/dev/dm-0",
+			Type:      "partition",
+			SizeBytes: 4194300 * 1024,
+			UsedBytes: 1024 * 1024,
+			Priority:  -2,
+		},
+		{
+			Name:      "/dev/zram0",
+			Type:      "partition",
+			SizeBytes: 2097148 * 1024,
+			Priority:  100,
+		},
+		{
+			Name:      "/var/swap file",
+			Type:      "file",
+			SizeBytes: 1024 * 1024,
+			Priority:  -3,
+		},
+	}, devices)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := swap.Parse(strings.NewReader(`Filename				Type		Size		Used		Priority
+/dev/dm-0                               partition	abc		0		-2
+`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package wipe
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/siderolabs/talos/internal/pkg/nvme"
+)
+
+const (
+	nvmeFormatSESCryptoErase = 2
+	nvmeFormatTimeout        = 10 * time.Minute
+
+	nvmeIdentifyControllerNN    = 516
+	nvmeIdentifyControllerFNA   = 524
+	nvmeFNAFormatAllNamespaces  = 1 << 0
+	nvmeFNAEraseAllNamespaces   = 1 << 1
+	nvmeFNACryptoEraseSupported = 1 << 2
+
+	nvmeIdentifyNamespaceFLBAS = 26
+	nvmeIdentifyNamespaceDPS   = 29
+)
+
+// NVMeFormatCryptoEraseCdw10 builds the Format NVM command dword 10 for the cryptographic erase,
+// preserving the current LBA format and protection information settings of the namespace.
+func NVMeFormatCryptoEraseCdw10(identifyNamespace []byte) (uint32, error) {
+	if len(identifyNamespace) < nvme.IdentifySize {
+		return 0, fmt.Errorf("NVMe identify namespace data is too short: %d bytes", len(identifyNamespace))
+	}
+
+	flbas := uint32(identifyNamespace[nvmeIdentifyNamespaceFLBAS])
+	dps := uint32(identifyNamespace[nvmeIdentifyNamespaceDPS])
+
+	lbaf := flbas & 0x0f
+	mset := (flbas >> 4) & 0x01
+	lbafu := (flbas >> 5) & 0x03
+	pi := dps & 0x07
+	pil := (dps >> 3) & 0x01
+
+	return lbaf | mset<<4 | pi<<5 | pil<<8 | nvmeFormatSESCryptoErase<<9 | lbafu<<12, nil
+}
+
+// NVMeCheckCryptoErase checks whether the controller supports the cryptographic erase of a single namespace.
+//
+// If the Format NVM or the secure erase applies to all namespaces of the controller, erasing one namespace
+// would destroy the data in other namespaces as well, so the crypto-erase is refused unless the controller
+// has a single namespace.
+//
+// The number of namespaces is taken from the active namespace list; if the list is nil, the number of namespaces
+// supported by the controller (NN) is used instead.
+func NVMeCheckCryptoErase(identifyController, activeNamespaces []byte) error {
+	if len(identifyController) < nvme.IdentifySize {
+		return fmt.Errorf("NVMe identify controller data is too short: %d bytes", len(identifyController))
+	}
+
+	fna := identifyController[nvmeIdentifyControllerFNA]
+
+	if fna&nvmeFNACryptoEraseSupported == 0 {
+		return fmt.Errorf("%w: NVMe controller doesn't support cryptographic erase", ErrNotSupported)
+	}
+
+	if fna&(nvmeFNAFormatAllNamespaces|nvmeFNAEraseAllNamespaces) == 0 {
+		return nil
+	}
+
+	namespaces := binary.LittleEndian.Uint32(identifyController[nvmeIdentifyControllerNN:])
+
+	if activeNamespaces != nil {
+		namespaces = nvmeCountNamespaces(activeNamespaces)
+	}
+
+	if namespaces > 1 {
+		return fmt.Errorf("%w: NVMe controller applies cryptographic erase to all %d namespaces", ErrNotSupported, namespaces)
+	}
+
+	return nil
+}
+
+// nvmeCountNamespaces counts the entries of the zero-terminated active namespace list.
+func nvmeCountNamespaces(activeNamespaces []byte) uint32 {
+	var count uint32
+
+	for i := 0; i+4 <= len(activeNamespaces); i += 4 {
+		if binary.LittleEndian.Uint32(activeNamespaces[i:]) == 0 {
+			break
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func nvmeCryptoErase(f *os.File) error {
+	nsid, err := nvme.NamespaceID(f)
+	if err != nil {
+		return fmt.Errorf("%w: crypto-erase is only supported for NVMe drives", ErrNotSupported)
+	}
+
+	identifyController, err := nvme.Identify(f, nvme.IdentifyController, 0)
+	if err != nil {
+		return fmt.Errorf("error identifying NVMe controller: %w", err)
+	}
+
+	activeNamespaces, err := nvme.Identify(f, nvme.IdentifyActiveNamespaceList, 0)
+	if err != nil {
+		// the active namespace list is optional before NVMe 1.1, fall back to the number of namespaces
+		activeNamespaces = nil
+	}
+
+	if err = NVMeCheckCryptoErase(identifyController, activeNamespaces); err != nil {
+		return err
+	}
+
+	identifyNamespace, err := nvme.Identify(f, nvme.IdentifyNamespace, nsid)
+	if err != nil {
+		return fmt.Errorf("error identifying NVMe namespace: %w", err)
+	}
+
+	cdw10, err := NVMeFormatCryptoEraseCdw10(identifyNamespace)
+	if err != nil {
+		return err
+	}
+
+	return nvme.Admin(f, nvme.AdminCommand{
+		Opcode:  nvme.OpcodeFormatNVM,
+		NSID:    nsid,
+		Cdw10:   cdw10,
+		Timeout: nvmeFormatTimeout,
+	})
+}
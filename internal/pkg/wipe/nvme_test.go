@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package wipe_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/nvme"
+	"github.com/siderolabs/talos/internal/pkg/wipe"
+)
+
+func TestNVMeFormatCryptoEraseCdw10(t *testing.T) {
+	t.Parallel()
+
+	identify := make([]byte, nvme.IdentifySize)
+
+	cdw10, err := wipe.NVMeFormatCryptoEraseCdw10(identify)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0x400), cdw10)
+
+	identify[26] = 0x31 // LBA format 17 (lower 1, upper 1), extended metadata
+	identify[29] = 0x09 // PI type 1, first bytes of metadata
+
+	cdw10, err = wipe.NVMeFormatCryptoEraseCdw10(identify)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0x1000|0x400|0x100|0x20|0x10|0x01), cdw10)
+
+	_, err = wipe.NVMeFormatCryptoEraseCdw10(identify[:512])
+	require.Error(t, err)
+}
+
+func TestNVMeCheckCryptoErase(t *testing.T) {
+	t.Parallel()
+
+	identify := make([]byte, nvme.IdentifySize)
+	binary.LittleEndian.PutUint32(identify[516:], 32) // up to 32 namespaces
+
+	activeNamespaces := make([]byte, nvme.IdentifySize)
+	binary.LittleEndian.PutUint32(activeNamespaces[0:], 1)
+	binary.LittleEndian.PutUint32(activeNamespaces[4:], 2)
+
+	require.ErrorIs(t, wipe.NVMeCheckCryptoErase(identify, activeNamespaces), wipe.ErrNotSupported)
+
+	identify[524] = 0x04 // crypto erase supported
+
+	require.NoError(t, wipe.NVMeCheckCryptoErase(identify, activeNamespaces))
+
+	identify[524] = 0x05 // crypto erase supported, format applies to all namespaces
+
+	require.ErrorIs(t, wipe.NVMeCheckCryptoErase(identify, activeNamespaces), wipe.ErrNotSupported)
+
+	identify[524] = 0x06 // crypto erase supported, secure erase applies to all namespaces
+
+	require.ErrorIs(t, wipe.NVMeCheckCryptoErase(identify, activeNamespaces), wipe.ErrNotSupported)
+
+	// no active namespace list, falls back to the number of namespaces
+	require.ErrorIs(t, wipe.NVMeCheckCryptoErase(identify, nil), wipe.ErrNotSupported)
+
+	require.Error(t, wipe.NVMeCheckCryptoErase(identify[:512], activeNamespaces))
+}
+
+func TestNVMeCheckCryptoEraseSingleNamespace(t *testing.T) {
+	t.Parallel()
+
+	identify := make([]byte, nvme.IdentifySize)
+	binary.LittleEndian.PutUint32(identify[516:], 32) // up to 32 namespaces
+	identify[524] = 0x07                              // crypto erase supported, format and secure erase apply to all namespaces
+
+	activeNamespaces := make([]byte, nvme.IdentifySize)
+	binary.LittleEndian.PutUint32(activeNamespaces[0:], 1)
+
+	require.NoError(t, wipe.NVMeCheckCryptoErase(identify, activeNamespaces))
+
+	// controller supports a single namespace, no active namespace list
+	binary.LittleEndian.PutUint32(identify[516:], 1)
+
+	require.NoError(t, wipe.NVMeCheckCryptoErase(identify, nil))
+}
@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package wipe implements sanitization of the block devices.
+package wipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/siderolabs/go-blockdevice/v2/block"
+	"golang.org/x/sys/unix"
+)
+
+// Method is the wipe method.
+type Method string
+
+// Supported wipe methods.
+const (
+	// MethodFast wipes the partition table and filesystem signatures, and discards the rest of the device (if supported).
+	MethodFast Method = "fast"
+	// MethodZeroes overwrites the whole device with zeroes.
+	MethodZeroes Method = "zeroes"
+	// MethodDiscard discards (TRIMs) all blocks of the device.
+	MethodDiscard Method = "discard"
+	// MethodCryptoErase erases the media encryption key of the drive (NVMe only).
+	MethodCryptoErase Method = "crypto-erase"
+)
+
+// ChunkSize is the size of the range wiped at once, progress is reported after each chunk.
+const ChunkSize = 64 * 1024 * 1024
+
+// zeroesBufferSize is the size of the buffer used to write zeroes from the userland.
+const zeroesBufferSize = 1024 * 1024
+
+// ErrNotSupported is returned when the wipe method is not supported by the device.
+var ErrNotSupported = errors.New("wipe method is not supported by the device")
+
+// Progress of the wipe operation.
+type Progress struct {
+	Wiped uint64
+	Total uint64
+}
+
+// ProgressFunc is called to report the wipe progress.
+type ProgressFunc func(Progress)
+
+// Device wipes the block device using the specified method.
+//
+// The device (or the whole disk for partitions) is locked exclusively during the wipe.
+func Device(ctx context.Context, devPath string, method Method, progress ProgressFunc) error {
+	if progress == nil {
+		progress = func(Progress) {}
+	}
+
+	bd, err := block.NewFromPath(devPath, block.OpenForWrite())
+	if err != nil {
+		return fmt.Errorf("error opening block device %q: %w", devPath, err)
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	isWholeDisk, err := bd.IsWholeDisk()
+	if err != nil {
+		return fmt.Errorf("error checking block device %q: %w", devPath, err)
+	}
+
+	lockBd := bd
+
+	if !isWholeDisk {
+		if lockBd, err = bd.GetWholeDisk(); err != nil {
+			return fmt.Errorf("error getting whole disk for %q: %w", devPath, err)
+		}
+
+		defer lockBd.Close() //nolint:errcheck
+	}
+
+	if err = lockBd.RetryLockWithTimeout(ctx, true, time.Minute); err != nil {
+		return fmt.Errorf("error locking block device %q: %w", devPath, err)
+	}
+
+	defer lockBd.Unlock() //nolint:errcheck
+
+	size, err := bd.GetSize()
+	if err != nil {
+		return fmt.Errorf("error getting size of %q: %w", devPath, err)
+	}
+
+	progress(Progress{Total: size})
+
+	switch method {
+	case MethodFast:
+		err = bd.FastWipe()
+	case MethodZeroes:
+		zeroes := make([]byte, zeroesBufferSize)
+
+		err = wipeRanges(ctx, size, progress, func(start, length uint64) error {
+			return zeroOut(bd.File(), zeroes, start, length)
+		})
+	case MethodDiscard:
+		err = wipeRanges(ctx, size, progress, func(start, length uint64) error {
+			return ioctlRange(bd.File(), unix.BLKDISCARD, start, length)
+		})
+	case MethodCryptoErase:
+		if !isWholeDisk {
+			return fmt.Errorf("%w: crypto-erase can only be performed on the whole disk", ErrNotSupported)
+		}
+
+		err = nvmeCryptoErase(bd.File())
+	default:
+		return fmt.Errorf("unknown wipe method %q", method)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error wiping block device %q with method %s: %w", devPath, method, err)
+	}
+
+	progress(Progress{Wiped: size, Total: size})
+
+	return nil
+}
+
+func wipeRanges(ctx context.Context, size uint64, progress ProgressFunc, wipe func(start, length uint64) error) error {
+	for start := uint64(0); start < size; start += ChunkSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		length := min(ChunkSize, size-start)
+
+		if err := wipe(start, length); err != nil {
+			return err
+		}
+
+		progress(Progress{Wiped: start + length, Total: size})
+	}
+
+	return nil
+}
+
+func ioctlRange(f *os.File, req uint, start, length uint64) error {
+	r := [2]uint64{start, length}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), uintptr(req), uintptr(unsafe.Pointer(&r[0]))); errno != 0 {
+		if errno == unix.EOPNOTSUPP {
+			return ErrNotSupported
+		}
+
+		return errno
+	}
+
+	return nil
+}
+
+// zeroOut writes zeroes to the range, using BLKZEROOUT if supported, or writing zeroes from the userland otherwise.
+//
+// The zeroes buffer is reused for all ranges.
+func zeroOut(f *os.File, zeroes []byte, start, length uint64) error {
+	err := ioctlRange(f, unix.BLKZEROOUT, start, length)
+	if errors.Is(err, ErrNotSupported) {
+		err = writeZeroes(f, zeroes, start, length)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// make sure the zeroes are on the media before reporting the progress
+	return f.Sync()
+}
+
+func writeZeroes(f *os.File, zeroes []byte, start, length uint64) error {
+	for length > 0 {
+		n := min(length, uint64(len(zeroes)))
+
+		if _, err := f.WriteAt(zeroes[:n], int64(start)); err != nil {
+			return err
+		}
+
+		start += n
+		length -= n
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package gen
+
+import (
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"slices"
+
+	"github.com/siderolabs/crypto/x509"
+)
+
+var (
+	oidExtensionExtendedKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 37}
+	oidExtKeyUsageClientAuth     = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 2}
+)
+
+// NewClientIdentityCSR generates an Ed25519 key and a CSR which requests the client authentication usage.
+//
+// The organization (Talos API roles) is not included into the CSR.
+func NewClientIdentityCSR(setters ...x509.Option) (*x509.CertificateSigningRequest, *x509.PEMEncodedCertificateAndKey, error) {
+	opts := x509.NewDefaultOptions(setters...)
+
+	key, err := x509.NewEd25519Key()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extKeyUsage, err := asn1.Marshal([]asn1.ObjectIdentifier{oidExtKeyUsageClientAuth})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &stdx509.CertificateRequest{
+		IPAddresses: opts.IPAddresses,
+		DNSNames:    opts.DNSNames,
+		Subject: pkix.Name{
+			CommonName: opts.CommonName,
+		},
+		SignatureAlgorithm: stdx509.PureEd25519,
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:    oidExtensionExtendedKeyUsage,
+				Value: extKeyUsage,
+			},
+		},
+	}
+
+	csrBytes, err := stdx509.CreateCertificateRequest(rand.Reader, template, key.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := stdx509.ParseCertificateRequest(csrBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identity := &x509.PEMEncodedCertificateAndKey{
+		Key: key.PrivateKeyPEM,
+	}
+
+	return &x509.CertificateSigningRequest{
+		X509CertificateRequest: csr,
+		X509CertificateRequestPEM: pem.EncodeToMemory(&pem.Block{
+			Type:  x509.PEMTypeCertificateRequest,
+			Bytes: csrBytes,
+		}),
+	}, identity, nil
+}
+
+// IsClientIdentityCSR checks whether the CSR requests the client authentication usage.
+func IsClientIdentityCSR(csr *stdx509.CertificateRequest) bool {
+	for _, ext := range csr.Extensions {
+		if !ext.Id.Equal(oidExtensionExtendedKeyUsage) {
+			continue
+		}
+
+		var usages []asn1.ObjectIdentifier
+
+		if _, err := asn1.Unmarshal(ext.Value, &usages); err != nil {
+			return false
+		}
+
+		return slices.ContainsFunc(usages, oidExtKeyUsageClientAuth.Equal)
+	}
+
+	return false
+}
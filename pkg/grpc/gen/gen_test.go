@@ -4,11 +4,37 @@
 
 package gen_test
 
-import "testing"
+import (
+	"net"
+	"net/netip"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/grpc/gen"
+)
+
+func TestClientIdentityCSR(t *testing.T) {
+	t.Parallel()
+
+	csr, identity, err := gen.NewClientIdentityCSR(
+		x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+		x509.DNSNames([]string{"talos-default-worker-1"}),
+		x509.CommonName("talos-default-worker-1"),
+	)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, identity.Key)
+	assert.True(t, gen.IsClientIdentityCSR(csr.X509CertificateRequest))
+
+	assert.Equal(t, "talos-default-worker-1", csr.X509CertificateRequest.Subject.CommonName)
+	assert.Empty(t, csr.X509CertificateRequest.Subject.Organization)
+	assert.Equal(t, []string{"talos-default-worker-1"}, csr.X509CertificateRequest.DNSNames)
+
+	serverCSR, _, err := x509.NewEd25519CSRAndIdentity(x509.CommonName("talos-default-worker-1"))
+	require.NoError(t, err)
+
+	assert.False(t, gen.IsClientIdentityCSR(serverCSR.X509CertificateRequest))
 }
@@ -54,7 +54,7 @@ func (g *RemoteGenerator) Identity(csr *x509.CertificateSigningRequest) (ca, crt
 }
 
 // IdentityContext creates an identity certificate via the security API.
-func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.CertificateSigningRequest) (ca, crt []byte, err error) {
+func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.CertificateSigningRequest, opts ...grpc.CallOption) (ca, crt []byte, err error) {
 	req := &securityapi.CertificateRequest{
 		Csr: csr.X509CertificateRequestPEM,
 	}
@@ -69,7 +69,7 @@ func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.Certifi
 	).RetryWithContext(ctx, func(ctx context.Context) error {
 		var resp *securityapi.CertificateResponse
 
-		resp, err = g.client.Certificate(ctx, req)
+		resp, err = g.client.Certificate(ctx, req, opts...)
 		if err != nil {
 			return retry.ExpectedError(err)
 		}
@@ -832,6 +832,58 @@ func (ConnectRecord_TimerActive) EnumDescriptor() ([]byte, []int) {
 	return file_machine_machine_proto_rawDescGZIP(), []int{152, 1}
 }
 
+type EncryptionKeySlotRequest_Operation int32
+
+const (
+	// Add the configured key to the key slot.
+	EncryptionKeySlotRequest_ADD EncryptionKeySlotRequest_Operation = 0
+	// Remove the key slot which is no longer present in the configuration.
+	EncryptionKeySlotRequest_REMOVE EncryptionKeySlotRequest_Operation = 1
+	// Replace the key in the key slot with a newly generated one.
+	EncryptionKeySlotRequest_ROTATE EncryptionKeySlotRequest_Operation = 2
+)
+
+// Enum value maps for EncryptionKeySlotRequest_Operation.
+var (
+	EncryptionKeySlotRequest_Operation_name = map[int32]string{
+		0: "ADD",
+		1: "REMOVE",
+		2: "ROTATE",
+	}
+	EncryptionKeySlotRequest_Operation_value = map[string]int32{
+		"ADD":    0,
+		"REMOVE": 1,
+		"ROTATE": 2,
+	}
+)
+
+func (x EncryptionKeySlotRequest_Operation) Enum() *EncryptionKeySlotRequest_Operation {
+	p := new(EncryptionKeySlotRequest_Operation)
+	*p = x
+	return p
+}
+
+func (x EncryptionKeySlotRequest_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionKeySlotRequest_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_machine_proto_enumTypes[15].Descriptor()
+}
+
+func (EncryptionKeySlotRequest_Operation) Type() protoreflect.EnumType {
+	return &file_machine_machine_proto_enumTypes[15]
+}
+
+func (x EncryptionKeySlotRequest_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionKeySlotRequest_Operation.Descriptor instead.
+func (EncryptionKeySlotRequest_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{166, 0}
+}
+
 // rpc applyConfiguration
 // ApplyConfiguration describes a request to assert a new configuration upon a
 // node.
@@ -11172,6 +11224,168 @@ func (x *ImagePullResponse) GetMessages() []*ImagePull {
 	return nil
 }
 
+type EncryptionKeySlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume ID (e.g. `STATE`, `EPHEMERAL`).
+	VolumeId  string                             `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Operation EncryptionKeySlotRequest_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=machine.EncryptionKeySlotRequest_Operation" json:"operation,omitempty"`
+	// Key slot number.
+	Slot int32 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *EncryptionKeySlotRequest) Reset() {
+	*x = EncryptionKeySlotRequest{}
+	mi := &file_machine_machine_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKeySlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKeySlotRequest) ProtoMessage() {}
+
+func (x *EncryptionKeySlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKeySlotRequest.ProtoReflect.Descriptor instead.
+func (*EncryptionKeySlotRequest) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *EncryptionKeySlotRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *EncryptionKeySlotRequest) GetOperation() EncryptionKeySlotRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return EncryptionKeySlotRequest_ADD
+}
+
+func (x *EncryptionKeySlotRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type EncryptionKeySlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Key slots in use after the operation.
+	Slots []int32 `protobuf:"varint,2,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *EncryptionKeySlot) Reset() {
+	*x = EncryptionKeySlot{}
+	mi := &file_machine_machine_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKeySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKeySlot) ProtoMessage() {}
+
+func (x *EncryptionKeySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKeySlot.ProtoReflect.Descriptor instead.
+func (*EncryptionKeySlot) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *EncryptionKeySlot) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *EncryptionKeySlot) GetSlots() []int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type EncryptionKeySlotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*EncryptionKeySlot `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *EncryptionKeySlotResponse) Reset() {
+	*x = EncryptionKeySlotResponse{}
+	mi := &file_machine_machine_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKeySlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKeySlotResponse) ProtoMessage() {}
+
+func (x *EncryptionKeySlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_machine_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKeySlotResponse.ProtoReflect.Descriptor instead.
+func (*EncryptionKeySlotResponse) Descriptor() ([]byte, []int) {
+	return file_machine_machine_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *EncryptionKeySlotResponse) GetMessages() []*EncryptionKeySlot {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
 type MachineStatusEvent_MachineStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -11183,7 +11397,7 @@ type MachineStatusEvent_MachineStatus struct {
 
 func (x *MachineStatusEvent_MachineStatus) Reset() {
 	*x = MachineStatusEvent_MachineStatus{}
-	mi := &file_machine_machine_proto_msgTypes[166]
+	mi := &file_machine_machine_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11195,7 +11409,7 @@ func (x *MachineStatusEvent_MachineStatus) String() string {
 func (*MachineStatusEvent_MachineStatus) ProtoMessage() {}
 
 func (x *MachineStatusEvent_MachineStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[166]
+	mi := &file_machine_machine_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11236,7 +11450,7 @@ type MachineStatusEvent_MachineStatus_UnmetCondition struct {
 
 func (x *MachineStatusEvent_MachineStatus_UnmetCondition) Reset() {
 	*x = MachineStatusEvent_MachineStatus_UnmetCondition{}
-	mi := &file_machine_machine_proto_msgTypes[167]
+	mi := &file_machine_machine_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11248,7 +11462,7 @@ func (x *MachineStatusEvent_MachineStatus_UnmetCondition) String() string {
 func (*MachineStatusEvent_MachineStatus_UnmetCondition) ProtoMessage() {}
 
 func (x *MachineStatusEvent_MachineStatus_UnmetCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[167]
+	mi := &file_machine_machine_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11288,7 +11502,7 @@ type NetstatRequest_Feature struct {
 
 func (x *NetstatRequest_Feature) Reset() {
 	*x = NetstatRequest_Feature{}
-	mi := &file_machine_machine_proto_msgTypes[168]
+	mi := &file_machine_machine_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11300,7 +11514,7 @@ func (x *NetstatRequest_Feature) String() string {
 func (*NetstatRequest_Feature) ProtoMessage() {}
 
 func (x *NetstatRequest_Feature) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[168]
+	mi := &file_machine_machine_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11340,7 +11554,7 @@ type NetstatRequest_L4Proto struct {
 
 func (x *NetstatRequest_L4Proto) Reset() {
 	*x = NetstatRequest_L4Proto{}
-	mi := &file_machine_machine_proto_msgTypes[169]
+	mi := &file_machine_machine_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11352,7 +11566,7 @@ func (x *NetstatRequest_L4Proto) String() string {
 func (*NetstatRequest_L4Proto) ProtoMessage() {}
 
 func (x *NetstatRequest_L4Proto) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[169]
+	mi := &file_machine_machine_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11436,7 +11650,7 @@ type NetstatRequest_NetNS struct {
 
 func (x *NetstatRequest_NetNS) Reset() {
 	*x = NetstatRequest_NetNS{}
-	mi := &file_machine_machine_proto_msgTypes[170]
+	mi := &file_machine_machine_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11448,7 +11662,7 @@ func (x *NetstatRequest_NetNS) String() string {
 func (*NetstatRequest_NetNS) ProtoMessage() {}
 
 func (x *NetstatRequest_NetNS) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[170]
+	mi := &file_machine_machine_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11496,7 +11710,7 @@ type ConnectRecord_Process struct {
 
 func (x *ConnectRecord_Process) Reset() {
 	*x = ConnectRecord_Process{}
-	mi := &file_machine_machine_proto_msgTypes[171]
+	mi := &file_machine_machine_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11508,7 +11722,7 @@ func (x *ConnectRecord_Process) String() string {
 func (*ConnectRecord_Process) ProtoMessage() {}
 
 func (x *ConnectRecord_Process) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_machine_proto_msgTypes[171]
+	mi := &file_machine_machine_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12975,237 +13189,266 @@ var file_machine_machine_proto_rawDesc = []byte{
 	0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6d, 0x61,
 	0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x32, 0x8e, 0x1c, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12,
-	0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x04, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x43,
-	0x50, 0x55, 0x46, 0x72, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x50,
-	0x55, 0x46, 0x72, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44,
-	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2e, 0x0a, 0x05, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xc4, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x2c, 0x0a, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x4f, 0x54, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0x57, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x22, 0x53, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x32, 0xea, 0x1c, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01,
-	0x12, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x45, 0x74, 0x63, 0x64, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12,
-	0x24, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x12, 0x45, 0x0a, 0x0c, 0x43, 0x50, 0x55, 0x46, 0x72, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x43, 0x50, 0x55, 0x46, 0x72, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x12, 0x15,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x6d, 0x65, 0x73, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x45, 0x74, 0x63,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14,
 	0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10,
+	0x42, 0x79, 0x49, 0x44, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45,
+	0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x10, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
 	0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63,
-	0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72,
-	0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x25,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72,
-	0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x0b, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x45, 0x74,
-	0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x45, 0x74, 0x63, 0x64,
-	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x45, 0x74,
+	0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74,
+	0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x3c, 0x0a, 0x0c, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x47, 0x0a,
+	0x0d, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c,
+	0x61, 0x72, 0x6d, 0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64,
-	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44, 0x69,
-	0x73, 0x61, 0x72, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d,
-	0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
-	0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x79, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64,
+	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x45, 0x74, 0x63, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45,
+	0x74, 0x63, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x31,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30,
+	0x01, 0x12, 0x40, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x49,
+	0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
 	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x44, 0x65, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x45, 0x74, 0x63,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x09,
-	0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x44,
-	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x3b,
-	0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
-	0x41, 0x76, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x4c,
-	0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0e, 0x4c, 0x6f, 0x67,
-	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4c, 0x6f,
-	0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16,
+	0x6e, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x06, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30,
+	0x01, 0x12, 0x39, 0x0a, 0x06, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65,
+	0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x06,
-	0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
-	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x6f, 0x70, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x68,
-	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x55, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x3c,
-	0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74,
-	0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09,
-	0x4d, 0x65, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1a,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a,
-	0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63,
+	0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x07, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1b, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x17,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x12,
+	0x19, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x4e, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x21, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x4e, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5a, 0x35, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -13220,8 +13463,8 @@ func file_machine_machine_proto_rawDescGZIP() []byte {
 	return file_machine_machine_proto_rawDescData
 }
 
-var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
-var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 172)
+var file_machine_machine_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_machine_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 175)
 var file_machine_machine_proto_goTypes = []any{
 	(ApplyConfigurationRequest_Mode)(0),                     // 0: machine.ApplyConfigurationRequest.Mode
 	(RebootRequest_Mode)(0),                                 // 1: machine.RebootRequest.Mode
@@ -13238,462 +13481,471 @@ var file_machine_machine_proto_goTypes = []any{
 	(NetstatRequest_Filter)(0),                              // 12: machine.NetstatRequest.Filter
 	(ConnectRecord_State)(0),                                // 13: machine.ConnectRecord.State
 	(ConnectRecord_TimerActive)(0),                          // 14: machine.ConnectRecord.TimerActive
-	(*ApplyConfigurationRequest)(nil),                       // 15: machine.ApplyConfigurationRequest
-	(*ApplyConfiguration)(nil),                              // 16: machine.ApplyConfiguration
-	(*ApplyConfigurationResponse)(nil),                      // 17: machine.ApplyConfigurationResponse
-	(*RebootRequest)(nil),                                   // 18: machine.RebootRequest
-	(*Reboot)(nil),                                          // 19: machine.Reboot
-	(*RebootResponse)(nil),                                  // 20: machine.RebootResponse
-	(*BootstrapRequest)(nil),                                // 21: machine.BootstrapRequest
-	(*Bootstrap)(nil),                                       // 22: machine.Bootstrap
-	(*BootstrapResponse)(nil),                               // 23: machine.BootstrapResponse
-	(*SequenceEvent)(nil),                                   // 24: machine.SequenceEvent
-	(*PhaseEvent)(nil),                                      // 25: machine.PhaseEvent
-	(*TaskEvent)(nil),                                       // 26: machine.TaskEvent
-	(*ServiceStateEvent)(nil),                               // 27: machine.ServiceStateEvent
-	(*RestartEvent)(nil),                                    // 28: machine.RestartEvent
-	(*ConfigLoadErrorEvent)(nil),                            // 29: machine.ConfigLoadErrorEvent
-	(*ConfigValidationErrorEvent)(nil),                      // 30: machine.ConfigValidationErrorEvent
-	(*AddressEvent)(nil),                                    // 31: machine.AddressEvent
-	(*MachineStatusEvent)(nil),                              // 32: machine.MachineStatusEvent
-	(*EventsRequest)(nil),                                   // 33: machine.EventsRequest
-	(*Event)(nil),                                           // 34: machine.Event
-	(*ResetPartitionSpec)(nil),                              // 35: machine.ResetPartitionSpec
-	(*ResetRequest)(nil),                                    // 36: machine.ResetRequest
-	(*Reset)(nil),                                           // 37: machine.Reset
-	(*ResetResponse)(nil),                                   // 38: machine.ResetResponse
-	(*Shutdown)(nil),                                        // 39: machine.Shutdown
-	(*ShutdownRequest)(nil),                                 // 40: machine.ShutdownRequest
-	(*ShutdownResponse)(nil),                                // 41: machine.ShutdownResponse
-	(*UpgradeRequest)(nil),                                  // 42: machine.UpgradeRequest
-	(*Upgrade)(nil),                                         // 43: machine.Upgrade
-	(*UpgradeResponse)(nil),                                 // 44: machine.UpgradeResponse
-	(*ServiceList)(nil),                                     // 45: machine.ServiceList
-	(*ServiceListResponse)(nil),                             // 46: machine.ServiceListResponse
-	(*ServiceInfo)(nil),                                     // 47: machine.ServiceInfo
-	(*ServiceEvents)(nil),                                   // 48: machine.ServiceEvents
-	(*ServiceEvent)(nil),                                    // 49: machine.ServiceEvent
-	(*ServiceHealth)(nil),                                   // 50: machine.ServiceHealth
-	(*ServiceStartRequest)(nil),                             // 51: machine.ServiceStartRequest
-	(*ServiceStart)(nil),                                    // 52: machine.ServiceStart
-	(*ServiceStartResponse)(nil),                            // 53: machine.ServiceStartResponse
-	(*ServiceStopRequest)(nil),                              // 54: machine.ServiceStopRequest
-	(*ServiceStop)(nil),                                     // 55: machine.ServiceStop
-	(*ServiceStopResponse)(nil),                             // 56: machine.ServiceStopResponse
-	(*ServiceRestartRequest)(nil),                           // 57: machine.ServiceRestartRequest
-	(*ServiceRestart)(nil),                                  // 58: machine.ServiceRestart
-	(*ServiceRestartResponse)(nil),                          // 59: machine.ServiceRestartResponse
-	(*CopyRequest)(nil),                                     // 60: machine.CopyRequest
-	(*ListRequest)(nil),                                     // 61: machine.ListRequest
-	(*DiskUsageRequest)(nil),                                // 62: machine.DiskUsageRequest
-	(*FileInfo)(nil),                                        // 63: machine.FileInfo
-	(*Xattr)(nil),                                           // 64: machine.Xattr
-	(*DiskUsageInfo)(nil),                                   // 65: machine.DiskUsageInfo
-	(*Mounts)(nil),                                          // 66: machine.Mounts
-	(*MountsResponse)(nil),                                  // 67: machine.MountsResponse
-	(*MountStat)(nil),                                       // 68: machine.MountStat
-	(*Version)(nil),                                         // 69: machine.Version
-	(*VersionResponse)(nil),                                 // 70: machine.VersionResponse
-	(*VersionInfo)(nil),                                     // 71: machine.VersionInfo
-	(*PlatformInfo)(nil),                                    // 72: machine.PlatformInfo
-	(*FeaturesInfo)(nil),                                    // 73: machine.FeaturesInfo
-	(*LogsRequest)(nil),                                     // 74: machine.LogsRequest
-	(*ReadRequest)(nil),                                     // 75: machine.ReadRequest
-	(*LogsContainer)(nil),                                   // 76: machine.LogsContainer
-	(*LogsContainersResponse)(nil),                          // 77: machine.LogsContainersResponse
-	(*RollbackRequest)(nil),                                 // 78: machine.RollbackRequest
-	(*Rollback)(nil),                                        // 79: machine.Rollback
-	(*RollbackResponse)(nil),                                // 80: machine.RollbackResponse
-	(*ContainersRequest)(nil),                               // 81: machine.ContainersRequest
-	(*ContainerInfo)(nil),                                   // 82: machine.ContainerInfo
-	(*Container)(nil),                                       // 83: machine.Container
-	(*ContainersResponse)(nil),                              // 84: machine.ContainersResponse
-	(*DmesgRequest)(nil),                                    // 85: machine.DmesgRequest
-	(*ProcessesResponse)(nil),                               // 86: machine.ProcessesResponse
-	(*Process)(nil),                                         // 87: machine.Process
-	(*ProcessInfo)(nil),                                     // 88: machine.ProcessInfo
-	(*RestartRequest)(nil),                                  // 89: machine.RestartRequest
-	(*Restart)(nil),                                         // 90: machine.Restart
-	(*RestartResponse)(nil),                                 // 91: machine.RestartResponse
-	(*StatsRequest)(nil),                                    // 92: machine.StatsRequest
-	(*Stats)(nil),                                           // 93: machine.Stats
-	(*StatsResponse)(nil),                                   // 94: machine.StatsResponse
-	(*Stat)(nil),                                            // 95: machine.Stat
-	(*Memory)(nil),                                          // 96: machine.Memory
-	(*MemoryResponse)(nil),                                  // 97: machine.MemoryResponse
-	(*MemInfo)(nil),                                         // 98: machine.MemInfo
-	(*HostnameResponse)(nil),                                // 99: machine.HostnameResponse
-	(*Hostname)(nil),                                        // 100: machine.Hostname
-	(*LoadAvgResponse)(nil),                                 // 101: machine.LoadAvgResponse
-	(*LoadAvg)(nil),                                         // 102: machine.LoadAvg
-	(*SystemStatResponse)(nil),                              // 103: machine.SystemStatResponse
-	(*SystemStat)(nil),                                      // 104: machine.SystemStat
-	(*CPUStat)(nil),                                         // 105: machine.CPUStat
-	(*SoftIRQStat)(nil),                                     // 106: machine.SoftIRQStat
-	(*CPUFreqStatsResponse)(nil),                            // 107: machine.CPUFreqStatsResponse
-	(*CPUsFreqStats)(nil),                                   // 108: machine.CPUsFreqStats
-	(*CPUFreqStats)(nil),                                    // 109: machine.CPUFreqStats
-	(*CPUInfoResponse)(nil),                                 // 110: machine.CPUInfoResponse
-	(*CPUsInfo)(nil),                                        // 111: machine.CPUsInfo
-	(*CPUInfo)(nil),                                         // 112: machine.CPUInfo
-	(*NetworkDeviceStatsResponse)(nil),                      // 113: machine.NetworkDeviceStatsResponse
-	(*NetworkDeviceStats)(nil),                              // 114: machine.NetworkDeviceStats
-	(*NetDev)(nil),                                          // 115: machine.NetDev
-	(*DiskStatsResponse)(nil),                               // 116: machine.DiskStatsResponse
-	(*DiskStats)(nil),                                       // 117: machine.DiskStats
-	(*DiskStat)(nil),                                        // 118: machine.DiskStat
-	(*EtcdLeaveClusterRequest)(nil),                         // 119: machine.EtcdLeaveClusterRequest
-	(*EtcdLeaveCluster)(nil),                                // 120: machine.EtcdLeaveCluster
-	(*EtcdLeaveClusterResponse)(nil),                        // 121: machine.EtcdLeaveClusterResponse
-	(*EtcdRemoveMemberRequest)(nil),                         // 122: machine.EtcdRemoveMemberRequest
-	(*EtcdRemoveMember)(nil),                                // 123: machine.EtcdRemoveMember
-	(*EtcdRemoveMemberResponse)(nil),                        // 124: machine.EtcdRemoveMemberResponse
-	(*EtcdRemoveMemberByIDRequest)(nil),                     // 125: machine.EtcdRemoveMemberByIDRequest
-	(*EtcdRemoveMemberByID)(nil),                            // 126: machine.EtcdRemoveMemberByID
-	(*EtcdRemoveMemberByIDResponse)(nil),                    // 127: machine.EtcdRemoveMemberByIDResponse
-	(*EtcdForfeitLeadershipRequest)(nil),                    // 128: machine.EtcdForfeitLeadershipRequest
-	(*EtcdForfeitLeadership)(nil),                           // 129: machine.EtcdForfeitLeadership
-	(*EtcdForfeitLeadershipResponse)(nil),                   // 130: machine.EtcdForfeitLeadershipResponse
-	(*EtcdMemberListRequest)(nil),                           // 131: machine.EtcdMemberListRequest
-	(*EtcdMember)(nil),                                      // 132: machine.EtcdMember
-	(*EtcdMembers)(nil),                                     // 133: machine.EtcdMembers
-	(*EtcdMemberListResponse)(nil),                          // 134: machine.EtcdMemberListResponse
-	(*EtcdSnapshotRequest)(nil),                             // 135: machine.EtcdSnapshotRequest
-	(*EtcdRecover)(nil),                                     // 136: machine.EtcdRecover
-	(*EtcdRecoverResponse)(nil),                             // 137: machine.EtcdRecoverResponse
-	(*EtcdAlarmListResponse)(nil),                           // 138: machine.EtcdAlarmListResponse
-	(*EtcdAlarm)(nil),                                       // 139: machine.EtcdAlarm
-	(*EtcdMemberAlarm)(nil),                                 // 140: machine.EtcdMemberAlarm
-	(*EtcdAlarmDisarmResponse)(nil),                         // 141: machine.EtcdAlarmDisarmResponse
-	(*EtcdAlarmDisarm)(nil),                                 // 142: machine.EtcdAlarmDisarm
-	(*EtcdDefragmentResponse)(nil),                          // 143: machine.EtcdDefragmentResponse
-	(*EtcdDefragment)(nil),                                  // 144: machine.EtcdDefragment
-	(*EtcdStatusResponse)(nil),                              // 145: machine.EtcdStatusResponse
-	(*EtcdStatus)(nil),                                      // 146: machine.EtcdStatus
-	(*EtcdMemberStatus)(nil),                                // 147: machine.EtcdMemberStatus
-	(*RouteConfig)(nil),                                     // 148: machine.RouteConfig
-	(*DHCPOptionsConfig)(nil),                               // 149: machine.DHCPOptionsConfig
-	(*NetworkDeviceConfig)(nil),                             // 150: machine.NetworkDeviceConfig
-	(*NetworkConfig)(nil),                                   // 151: machine.NetworkConfig
-	(*InstallConfig)(nil),                                   // 152: machine.InstallConfig
-	(*MachineConfig)(nil),                                   // 153: machine.MachineConfig
-	(*ControlPlaneConfig)(nil),                              // 154: machine.ControlPlaneConfig
-	(*CNIConfig)(nil),                                       // 155: machine.CNIConfig
-	(*ClusterNetworkConfig)(nil),                            // 156: machine.ClusterNetworkConfig
-	(*ClusterConfig)(nil),                                   // 157: machine.ClusterConfig
-	(*GenerateConfigurationRequest)(nil),                    // 158: machine.GenerateConfigurationRequest
-	(*GenerateConfiguration)(nil),                           // 159: machine.GenerateConfiguration
-	(*GenerateConfigurationResponse)(nil),                   // 160: machine.GenerateConfigurationResponse
-	(*GenerateClientConfigurationRequest)(nil),              // 161: machine.GenerateClientConfigurationRequest
-	(*GenerateClientConfiguration)(nil),                     // 162: machine.GenerateClientConfiguration
-	(*GenerateClientConfigurationResponse)(nil),             // 163: machine.GenerateClientConfigurationResponse
-	(*PacketCaptureRequest)(nil),                            // 164: machine.PacketCaptureRequest
-	(*BPFInstruction)(nil),                                  // 165: machine.BPFInstruction
-	(*NetstatRequest)(nil),                                  // 166: machine.NetstatRequest
-	(*ConnectRecord)(nil),                                   // 167: machine.ConnectRecord
-	(*Netstat)(nil),                                         // 168: machine.Netstat
-	(*NetstatResponse)(nil),                                 // 169: machine.NetstatResponse
-	(*MetaWriteRequest)(nil),                                // 170: machine.MetaWriteRequest
-	(*MetaWrite)(nil),                                       // 171: machine.MetaWrite
-	(*MetaWriteResponse)(nil),                               // 172: machine.MetaWriteResponse
-	(*MetaDeleteRequest)(nil),                               // 173: machine.MetaDeleteRequest
-	(*MetaDelete)(nil),                                      // 174: machine.MetaDelete
-	(*MetaDeleteResponse)(nil),                              // 175: machine.MetaDeleteResponse
-	(*ImageListRequest)(nil),                                // 176: machine.ImageListRequest
-	(*ImageListResponse)(nil),                               // 177: machine.ImageListResponse
-	(*ImagePullRequest)(nil),                                // 178: machine.ImagePullRequest
-	(*ImagePull)(nil),                                       // 179: machine.ImagePull
-	(*ImagePullResponse)(nil),                               // 180: machine.ImagePullResponse
-	(*MachineStatusEvent_MachineStatus)(nil),                // 181: machine.MachineStatusEvent.MachineStatus
-	(*MachineStatusEvent_MachineStatus_UnmetCondition)(nil), // 182: machine.MachineStatusEvent.MachineStatus.UnmetCondition
-	(*NetstatRequest_Feature)(nil),                          // 183: machine.NetstatRequest.Feature
-	(*NetstatRequest_L4Proto)(nil),                          // 184: machine.NetstatRequest.L4proto
-	(*NetstatRequest_NetNS)(nil),                            // 185: machine.NetstatRequest.NetNS
-	(*ConnectRecord_Process)(nil),                           // 186: machine.ConnectRecord.Process
-	(*durationpb.Duration)(nil),                             // 187: google.protobuf.Duration
-	(*common.Metadata)(nil),                                 // 188: common.Metadata
-	(*common.Error)(nil),                                    // 189: common.Error
-	(*anypb.Any)(nil),                                       // 190: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),                           // 191: google.protobuf.Timestamp
-	(common.ContainerDriver)(0),                             // 192: common.ContainerDriver
-	(common.ContainerdNamespace)(0),                         // 193: common.ContainerdNamespace
-	(*emptypb.Empty)(nil),                                   // 194: google.protobuf.Empty
-	(*common.Data)(nil),                                     // 195: common.Data
+	(EncryptionKeySlotRequest_Operation)(0),                 // 15: machine.EncryptionKeySlotRequest.Operation
+	(*ApplyConfigurationRequest)(nil),                       // 16: machine.ApplyConfigurationRequest
+	(*ApplyConfiguration)(nil),                              // 17: machine.ApplyConfiguration
+	(*ApplyConfigurationResponse)(nil),                      // 18: machine.ApplyConfigurationResponse
+	(*RebootRequest)(nil),                                   // 19: machine.RebootRequest
+	(*Reboot)(nil),                                          // 20: machine.Reboot
+	(*RebootResponse)(nil),                                  // 21: machine.RebootResponse
+	(*BootstrapRequest)(nil),                                // 22: machine.BootstrapRequest
+	(*Bootstrap)(nil),                                       // 23: machine.Bootstrap
+	(*BootstrapResponse)(nil),                               // 24: machine.BootstrapResponse
+	(*SequenceEvent)(nil),                                   // 25: machine.SequenceEvent
+	(*PhaseEvent)(nil),                                      // 26: machine.PhaseEvent
+	(*TaskEvent)(nil),                                       // 27: machine.TaskEvent
+	(*ServiceStateEvent)(nil),                               // 28: machine.ServiceStateEvent
+	(*RestartEvent)(nil),                                    // 29: machine.RestartEvent
+	(*ConfigLoadErrorEvent)(nil),                            // 30: machine.ConfigLoadErrorEvent
+	(*ConfigValidationErrorEvent)(nil),                      // 31: machine.ConfigValidationErrorEvent
+	(*AddressEvent)(nil),                                    // 32: machine.AddressEvent
+	(*MachineStatusEvent)(nil),                              // 33: machine.MachineStatusEvent
+	(*EventsRequest)(nil),                                   // 34: machine.EventsRequest
+	(*Event)(nil),                                           // 35: machine.Event
+	(*ResetPartitionSpec)(nil),                              // 36: machine.ResetPartitionSpec
+	(*ResetRequest)(nil),                                    // 37: machine.ResetRequest
+	(*Reset)(nil),                                           // 38: machine.Reset
+	(*ResetResponse)(nil),                                   // 39: machine.ResetResponse
+	(*Shutdown)(nil),                                        // 40: machine.Shutdown
+	(*ShutdownRequest)(nil),                                 // 41: machine.ShutdownRequest
+	(*ShutdownResponse)(nil),                                // 42: machine.ShutdownResponse
+	(*UpgradeRequest)(nil),                                  // 43: machine.UpgradeRequest
+	(*Upgrade)(nil),                                         // 44: machine.Upgrade
+	(*UpgradeResponse)(nil),                                 // 45: machine.UpgradeResponse
+	(*ServiceList)(nil),                                     // 46: machine.ServiceList
+	(*ServiceListResponse)(nil),                             // 47: machine.ServiceListResponse
+	(*ServiceInfo)(nil),                                     // 48: machine.ServiceInfo
+	(*ServiceEvents)(nil),                                   // 49: machine.ServiceEvents
+	(*ServiceEvent)(nil),                                    // 50: machine.ServiceEvent
+	(*ServiceHealth)(nil),                                   // 51: machine.ServiceHealth
+	(*ServiceStartRequest)(nil),                             // 52: machine.ServiceStartRequest
+	(*ServiceStart)(nil),                                    // 53: machine.ServiceStart
+	(*ServiceStartResponse)(nil),                            // 54: machine.ServiceStartResponse
+	(*ServiceStopRequest)(nil),                              // 55: machine.ServiceStopRequest
+	(*ServiceStop)(nil),                                     // 56: machine.ServiceStop
+	(*ServiceStopResponse)(nil),                             // 57: machine.ServiceStopResponse
+	(*ServiceRestartRequest)(nil),                           // 58: machine.ServiceRestartRequest
+	(*ServiceRestart)(nil),                                  // 59: machine.ServiceRestart
+	(*ServiceRestartResponse)(nil),                          // 60: machine.ServiceRestartResponse
+	(*CopyRequest)(nil),                                     // 61: machine.CopyRequest
+	(*ListRequest)(nil),                                     // 62: machine.ListRequest
+	(*DiskUsageRequest)(nil),                                // 63: machine.DiskUsageRequest
+	(*FileInfo)(nil),                                        // 64: machine.FileInfo
+	(*Xattr)(nil),                                           // 65: machine.Xattr
+	(*DiskUsageInfo)(nil),                                   // 66: machine.DiskUsageInfo
+	(*Mounts)(nil),                                          // 67: machine.Mounts
+	(*MountsResponse)(nil),                                  // 68: machine.MountsResponse
+	(*MountStat)(nil),                                       // 69: machine.MountStat
+	(*Version)(nil),                                         // 70: machine.Version
+	(*VersionResponse)(nil),                                 // 71: machine.VersionResponse
+	(*VersionInfo)(nil),                                     // 72: machine.VersionInfo
+	(*PlatformInfo)(nil),                                    // 73: machine.PlatformInfo
+	(*FeaturesInfo)(nil),                                    // 74: machine.FeaturesInfo
+	(*LogsRequest)(nil),                                     // 75: machine.LogsRequest
+	(*ReadRequest)(nil),                                     // 76: machine.ReadRequest
+	(*LogsContainer)(nil),                                   // 77: machine.LogsContainer
+	(*LogsContainersResponse)(nil),                          // 78: machine.LogsContainersResponse
+	(*RollbackRequest)(nil),                                 // 79: machine.RollbackRequest
+	(*Rollback)(nil),                                        // 80: machine.Rollback
+	(*RollbackResponse)(nil),                                // 81: machine.RollbackResponse
+	(*ContainersRequest)(nil),                               // 82: machine.ContainersRequest
+	(*ContainerInfo)(nil),                                   // 83: machine.ContainerInfo
+	(*Container)(nil),                                       // 84: machine.Container
+	(*ContainersResponse)(nil),                              // 85: machine.ContainersResponse
+	(*DmesgRequest)(nil),                                    // 86: machine.DmesgRequest
+	(*ProcessesResponse)(nil),                               // 87: machine.ProcessesResponse
+	(*Process)(nil),                                         // 88: machine.Process
+	(*ProcessInfo)(nil),                                     // 89: machine.ProcessInfo
+	(*RestartRequest)(nil),                                  // 90: machine.RestartRequest
+	(*Restart)(nil),                                         // 91: machine.Restart
+	(*RestartResponse)(nil),                                 // 92: machine.RestartResponse
+	(*StatsRequest)(nil),                                    // 93: machine.StatsRequest
+	(*Stats)(nil),                                           // 94: machine.Stats
+	(*StatsResponse)(nil),                                   // 95: machine.StatsResponse
+	(*Stat)(nil),                                            // 96: machine.Stat
+	(*Memory)(nil),                                          // 97: machine.Memory
+	(*MemoryResponse)(nil),                                  // 98: machine.MemoryResponse
+	(*MemInfo)(nil),                                         // 99: machine.MemInfo
+	(*HostnameResponse)(nil),                                // 100: machine.HostnameResponse
+	(*Hostname)(nil),                                        // 101: machine.Hostname
+	(*LoadAvgResponse)(nil),                                 // 102: machine.LoadAvgResponse
+	(*LoadAvg)(nil),                                         // 103: machine.LoadAvg
+	(*SystemStatResponse)(nil),                              // 104: machine.SystemStatResponse
+	(*SystemStat)(nil),                                      // 105: machine.SystemStat
+	(*CPUStat)(nil),                                         // 106: machine.CPUStat
+	(*SoftIRQStat)(nil),                                     // 107: machine.SoftIRQStat
+	(*CPUFreqStatsResponse)(nil),                            // 108: machine.CPUFreqStatsResponse
+	(*CPUsFreqStats)(nil),                                   // 109: machine.CPUsFreqStats
+	(*CPUFreqStats)(nil),                                    // 110: machine.CPUFreqStats
+	(*CPUInfoResponse)(nil),                                 // 111: machine.CPUInfoResponse
+	(*CPUsInfo)(nil),                                        // 112: machine.CPUsInfo
+	(*CPUInfo)(nil),                                         // 113: machine.CPUInfo
+	(*NetworkDeviceStatsResponse)(nil),                      // 114: machine.NetworkDeviceStatsResponse
+	(*NetworkDeviceStats)(nil),                              // 115: machine.NetworkDeviceStats
+	(*NetDev)(nil),                                          // 116: machine.NetDev
+	(*DiskStatsResponse)(nil),                               // 117: machine.DiskStatsResponse
+	(*DiskStats)(nil),                                       // 118: machine.DiskStats
+	(*DiskStat)(nil),                                        // 119: machine.DiskStat
+	(*EtcdLeaveClusterRequest)(nil),                         // 120: machine.EtcdLeaveClusterRequest
+	(*EtcdLeaveCluster)(nil),                                // 121: machine.EtcdLeaveCluster
+	(*EtcdLeaveClusterResponse)(nil),                        // 122: machine.EtcdLeaveClusterResponse
+	(*EtcdRemoveMemberRequest)(nil),                         // 123: machine.EtcdRemoveMemberRequest
+	(*EtcdRemoveMember)(nil),                                // 124: machine.EtcdRemoveMember
+	(*EtcdRemoveMemberResponse)(nil),                        // 125: machine.EtcdRemoveMemberResponse
+	(*EtcdRemoveMemberByIDRequest)(nil),                     // 126: machine.EtcdRemoveMemberByIDRequest
+	(*EtcdRemoveMemberByID)(nil),                            // 127: machine.EtcdRemoveMemberByID
+	(*EtcdRemoveMemberByIDResponse)(nil),                    // 128: machine.EtcdRemoveMemberByIDResponse
+	(*EtcdForfeitLeadershipRequest)(nil),                    // 129: machine.EtcdForfeitLeadershipRequest
+	(*EtcdForfeitLeadership)(nil),                           // 130: machine.EtcdForfeitLeadership
+	(*EtcdForfeitLeadershipResponse)(nil),                   // 131: machine.EtcdForfeitLeadershipResponse
+	(*EtcdMemberListRequest)(nil),                           // 132: machine.EtcdMemberListRequest
+	(*EtcdMember)(nil),                                      // 133: machine.EtcdMember
+	(*EtcdMembers)(nil),                                     // 134: machine.EtcdMembers
+	(*EtcdMemberListResponse)(nil),                          // 135: machine.EtcdMemberListResponse
+	(*EtcdSnapshotRequest)(nil),                             // 136: machine.EtcdSnapshotRequest
+	(*EtcdRecover)(nil),                                     // 137: machine.EtcdRecover
+	(*EtcdRecoverResponse)(nil),                             // 138: machine.EtcdRecoverResponse
+	(*EtcdAlarmListResponse)(nil),                           // 139: machine.EtcdAlarmListResponse
+	(*EtcdAlarm)(nil),                                       // 140: machine.EtcdAlarm
+	(*EtcdMemberAlarm)(nil),                                 // 141: machine.EtcdMemberAlarm
+	(*EtcdAlarmDisarmResponse)(nil),                         // 142: machine.EtcdAlarmDisarmResponse
+	(*EtcdAlarmDisarm)(nil),                                 // 143: machine.EtcdAlarmDisarm
+	(*EtcdDefragmentResponse)(nil),                          // 144: machine.EtcdDefragmentResponse
+	(*EtcdDefragment)(nil),                                  // 145: machine.EtcdDefragment
+	(*EtcdStatusResponse)(nil),                              // 146: machine.EtcdStatusResponse
+	(*EtcdStatus)(nil),                                      // 147: machine.EtcdStatus
+	(*EtcdMemberStatus)(nil),                                // 148: machine.EtcdMemberStatus
+	(*RouteConfig)(nil),                                     // 149: machine.RouteConfig
+	(*DHCPOptionsConfig)(nil),                               // 150: machine.DHCPOptionsConfig
+	(*NetworkDeviceConfig)(nil),                             // 151: machine.NetworkDeviceConfig
+	(*NetworkConfig)(nil),                                   // 152: machine.NetworkConfig
+	(*InstallConfig)(nil),                                   // 153: machine.InstallConfig
+	(*MachineConfig)(nil),                                   // 154: machine.MachineConfig
+	(*ControlPlaneConfig)(nil),                              // 155: machine.ControlPlaneConfig
+	(*CNIConfig)(nil),                                       // 156: machine.CNIConfig
+	(*ClusterNetworkConfig)(nil),                            // 157: machine.ClusterNetworkConfig
+	(*ClusterConfig)(nil),                                   // 158: machine.ClusterConfig
+	(*GenerateConfigurationRequest)(nil),                    // 159: machine.GenerateConfigurationRequest
+	(*GenerateConfiguration)(nil),                           // 160: machine.GenerateConfiguration
+	(*GenerateConfigurationResponse)(nil),                   // 161: machine.GenerateConfigurationResponse
+	(*GenerateClientConfigurationRequest)(nil),              // 162: machine.GenerateClientConfigurationRequest
+	(*GenerateClientConfiguration)(nil),                     // 163: machine.GenerateClientConfiguration
+	(*GenerateClientConfigurationResponse)(nil),             // 164: machine.GenerateClientConfigurationResponse
+	(*PacketCaptureRequest)(nil),                            // 165: machine.PacketCaptureRequest
+	(*BPFInstruction)(nil),                                  // 166: machine.BPFInstruction
+	(*NetstatRequest)(nil),                                  // 167: machine.NetstatRequest
+	(*ConnectRecord)(nil),                                   // 168: machine.ConnectRecord
+	(*Netstat)(nil),                                         // 169: machine.Netstat
+	(*NetstatResponse)(nil),                                 // 170: machine.NetstatResponse
+	(*MetaWriteRequest)(nil),                                // 171: machine.MetaWriteRequest
+	(*MetaWrite)(nil),                                       // 172: machine.MetaWrite
+	(*MetaWriteResponse)(nil),                               // 173: machine.MetaWriteResponse
+	(*MetaDeleteRequest)(nil),                               // 174: machine.MetaDeleteRequest
+	(*MetaDelete)(nil),                                      // 175: machine.MetaDelete
+	(*MetaDeleteResponse)(nil),                              // 176: machine.MetaDeleteResponse
+	(*ImageListRequest)(nil),                                // 177: machine.ImageListRequest
+	(*ImageListResponse)(nil),                               // 178: machine.ImageListResponse
+	(*ImagePullRequest)(nil),                                // 179: machine.ImagePullRequest
+	(*ImagePull)(nil),                                       // 180: machine.ImagePull
+	(*ImagePullResponse)(nil),                               // 181: machine.ImagePullResponse
+	(*EncryptionKeySlotRequest)(nil),                        // 182: machine.EncryptionKeySlotRequest
+	(*EncryptionKeySlot)(nil),                               // 183: machine.EncryptionKeySlot
+	(*EncryptionKeySlotResponse)(nil),                       // 184: machine.EncryptionKeySlotResponse
+	(*MachineStatusEvent_MachineStatus)(nil),                // 185: machine.MachineStatusEvent.MachineStatus
+	(*MachineStatusEvent_MachineStatus_UnmetCondition)(nil), // 186: machine.MachineStatusEvent.MachineStatus.UnmetCondition
+	(*NetstatRequest_Feature)(nil),                          // 187: machine.NetstatRequest.Feature
+	(*NetstatRequest_L4Proto)(nil),                          // 188: machine.NetstatRequest.L4proto
+	(*NetstatRequest_NetNS)(nil),                            // 189: machine.NetstatRequest.NetNS
+	(*ConnectRecord_Process)(nil),                           // 190: machine.ConnectRecord.Process
+	(*durationpb.Duration)(nil),                             // 191: google.protobuf.Duration
+	(*common.Metadata)(nil),                                 // 192: common.Metadata
+	(*common.Error)(nil),                                    // 193: common.Error
+	(*anypb.Any)(nil),                                       // 194: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),                           // 195: google.protobuf.Timestamp
+	(common.ContainerDriver)(0),                             // 196: common.ContainerDriver
+	(common.ContainerdNamespace)(0),                         // 197: common.ContainerdNamespace
+	(*emptypb.Empty)(nil),                                   // 198: google.protobuf.Empty
+	(*common.Data)(nil),                                     // 199: common.Data
 }
 var file_machine_machine_proto_depIdxs = []int32{
 	0,   // 0: machine.ApplyConfigurationRequest.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	187, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
-	188, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
+	191, // 1: machine.ApplyConfigurationRequest.try_mode_timeout:type_name -> google.protobuf.Duration
+	192, // 2: machine.ApplyConfiguration.metadata:type_name -> common.Metadata
 	0,   // 3: machine.ApplyConfiguration.mode:type_name -> machine.ApplyConfigurationRequest.Mode
-	16,  // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
+	17,  // 4: machine.ApplyConfigurationResponse.messages:type_name -> machine.ApplyConfiguration
 	1,   // 5: machine.RebootRequest.mode:type_name -> machine.RebootRequest.Mode
-	188, // 6: machine.Reboot.metadata:type_name -> common.Metadata
-	19,  // 7: machine.RebootResponse.messages:type_name -> machine.Reboot
-	188, // 8: machine.Bootstrap.metadata:type_name -> common.Metadata
-	22,  // 9: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
+	192, // 6: machine.Reboot.metadata:type_name -> common.Metadata
+	20,  // 7: machine.RebootResponse.messages:type_name -> machine.Reboot
+	192, // 8: machine.Bootstrap.metadata:type_name -> common.Metadata
+	23,  // 9: machine.BootstrapResponse.messages:type_name -> machine.Bootstrap
 	2,   // 10: machine.SequenceEvent.action:type_name -> machine.SequenceEvent.Action
-	189, // 11: machine.SequenceEvent.error:type_name -> common.Error
+	193, // 11: machine.SequenceEvent.error:type_name -> common.Error
 	3,   // 12: machine.PhaseEvent.action:type_name -> machine.PhaseEvent.Action
 	4,   // 13: machine.TaskEvent.action:type_name -> machine.TaskEvent.Action
 	5,   // 14: machine.ServiceStateEvent.action:type_name -> machine.ServiceStateEvent.Action
-	50,  // 15: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
+	51,  // 15: machine.ServiceStateEvent.health:type_name -> machine.ServiceHealth
 	6,   // 16: machine.MachineStatusEvent.stage:type_name -> machine.MachineStatusEvent.MachineStage
-	181, // 17: machine.MachineStatusEvent.status:type_name -> machine.MachineStatusEvent.MachineStatus
-	188, // 18: machine.Event.metadata:type_name -> common.Metadata
-	190, // 19: machine.Event.data:type_name -> google.protobuf.Any
-	35,  // 20: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
+	185, // 17: machine.MachineStatusEvent.status:type_name -> machine.MachineStatusEvent.MachineStatus
+	192, // 18: machine.Event.metadata:type_name -> common.Metadata
+	194, // 19: machine.Event.data:type_name -> google.protobuf.Any
+	36,  // 20: machine.ResetRequest.system_partitions_to_wipe:type_name -> machine.ResetPartitionSpec
 	7,   // 21: machine.ResetRequest.mode:type_name -> machine.ResetRequest.WipeMode
-	188, // 22: machine.Reset.metadata:type_name -> common.Metadata
-	37,  // 23: machine.ResetResponse.messages:type_name -> machine.Reset
-	188, // 24: machine.Shutdown.metadata:type_name -> common.Metadata
-	39,  // 25: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
+	192, // 22: machine.Reset.metadata:type_name -> common.Metadata
+	38,  // 23: machine.ResetResponse.messages:type_name -> machine.Reset
+	192, // 24: machine.Shutdown.metadata:type_name -> common.Metadata
+	40,  // 25: machine.ShutdownResponse.messages:type_name -> machine.Shutdown
 	8,   // 26: machine.UpgradeRequest.reboot_mode:type_name -> machine.UpgradeRequest.RebootMode
-	188, // 27: machine.Upgrade.metadata:type_name -> common.Metadata
-	43,  // 28: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
-	188, // 29: machine.ServiceList.metadata:type_name -> common.Metadata
-	47,  // 30: machine.ServiceList.services:type_name -> machine.ServiceInfo
-	45,  // 31: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
-	48,  // 32: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
-	50,  // 33: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
-	49,  // 34: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
-	191, // 35: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
-	191, // 36: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
-	188, // 37: machine.ServiceStart.metadata:type_name -> common.Metadata
-	52,  // 38: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
-	188, // 39: machine.ServiceStop.metadata:type_name -> common.Metadata
-	55,  // 40: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
-	188, // 41: machine.ServiceRestart.metadata:type_name -> common.Metadata
-	58,  // 42: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
+	192, // 27: machine.Upgrade.metadata:type_name -> common.Metadata
+	44,  // 28: machine.UpgradeResponse.messages:type_name -> machine.Upgrade
+	192, // 29: machine.ServiceList.metadata:type_name -> common.Metadata
+	48,  // 30: machine.ServiceList.services:type_name -> machine.ServiceInfo
+	46,  // 31: machine.ServiceListResponse.messages:type_name -> machine.ServiceList
+	49,  // 32: machine.ServiceInfo.events:type_name -> machine.ServiceEvents
+	51,  // 33: machine.ServiceInfo.health:type_name -> machine.ServiceHealth
+	50,  // 34: machine.ServiceEvents.events:type_name -> machine.ServiceEvent
+	195, // 35: machine.ServiceEvent.ts:type_name -> google.protobuf.Timestamp
+	195, // 36: machine.ServiceHealth.last_change:type_name -> google.protobuf.Timestamp
+	192, // 37: machine.ServiceStart.metadata:type_name -> common.Metadata
+	53,  // 38: machine.ServiceStartResponse.messages:type_name -> machine.ServiceStart
+	192, // 39: machine.ServiceStop.metadata:type_name -> common.Metadata
+	56,  // 40: machine.ServiceStopResponse.messages:type_name -> machine.ServiceStop
+	192, // 41: machine.ServiceRestart.metadata:type_name -> common.Metadata
+	59,  // 42: machine.ServiceRestartResponse.messages:type_name -> machine.ServiceRestart
 	9,   // 43: machine.ListRequest.types:type_name -> machine.ListRequest.Type
-	188, // 44: machine.FileInfo.metadata:type_name -> common.Metadata
-	64,  // 45: machine.FileInfo.xattrs:type_name -> machine.Xattr
-	188, // 46: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
-	188, // 47: machine.Mounts.metadata:type_name -> common.Metadata
-	68,  // 48: machine.Mounts.stats:type_name -> machine.MountStat
-	66,  // 49: machine.MountsResponse.messages:type_name -> machine.Mounts
-	188, // 50: machine.Version.metadata:type_name -> common.Metadata
-	71,  // 51: machine.Version.version:type_name -> machine.VersionInfo
-	72,  // 52: machine.Version.platform:type_name -> machine.PlatformInfo
-	73,  // 53: machine.Version.features:type_name -> machine.FeaturesInfo
-	69,  // 54: machine.VersionResponse.messages:type_name -> machine.Version
-	192, // 55: machine.LogsRequest.driver:type_name -> common.ContainerDriver
-	188, // 56: machine.LogsContainer.metadata:type_name -> common.Metadata
-	76,  // 57: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
-	188, // 58: machine.Rollback.metadata:type_name -> common.Metadata
-	79,  // 59: machine.RollbackResponse.messages:type_name -> machine.Rollback
-	192, // 60: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
-	188, // 61: machine.Container.metadata:type_name -> common.Metadata
-	82,  // 62: machine.Container.containers:type_name -> machine.ContainerInfo
-	83,  // 63: machine.ContainersResponse.messages:type_name -> machine.Container
-	87,  // 64: machine.ProcessesResponse.messages:type_name -> machine.Process
-	188, // 65: machine.Process.metadata:type_name -> common.Metadata
-	88,  // 66: machine.Process.processes:type_name -> machine.ProcessInfo
-	192, // 67: machine.RestartRequest.driver:type_name -> common.ContainerDriver
-	188, // 68: machine.Restart.metadata:type_name -> common.Metadata
-	90,  // 69: machine.RestartResponse.messages:type_name -> machine.Restart
-	192, // 70: machine.StatsRequest.driver:type_name -> common.ContainerDriver
-	188, // 71: machine.Stats.metadata:type_name -> common.Metadata
-	95,  // 72: machine.Stats.stats:type_name -> machine.Stat
-	93,  // 73: machine.StatsResponse.messages:type_name -> machine.Stats
-	188, // 74: machine.Memory.metadata:type_name -> common.Metadata
-	98,  // 75: machine.Memory.meminfo:type_name -> machine.MemInfo
-	96,  // 76: machine.MemoryResponse.messages:type_name -> machine.Memory
-	100, // 77: machine.HostnameResponse.messages:type_name -> machine.Hostname
-	188, // 78: machine.Hostname.metadata:type_name -> common.Metadata
-	102, // 79: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
-	188, // 80: machine.LoadAvg.metadata:type_name -> common.Metadata
-	104, // 81: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
-	188, // 82: machine.SystemStat.metadata:type_name -> common.Metadata
-	105, // 83: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
-	105, // 84: machine.SystemStat.cpu:type_name -> machine.CPUStat
-	106, // 85: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
-	108, // 86: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
-	188, // 87: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
-	109, // 88: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
-	111, // 89: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
-	188, // 90: machine.CPUsInfo.metadata:type_name -> common.Metadata
-	112, // 91: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
-	114, // 92: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
-	188, // 93: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
-	115, // 94: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
-	115, // 95: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
-	117, // 96: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
-	188, // 97: machine.DiskStats.metadata:type_name -> common.Metadata
-	118, // 98: machine.DiskStats.total:type_name -> machine.DiskStat
-	118, // 99: machine.DiskStats.devices:type_name -> machine.DiskStat
-	188, // 100: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
-	120, // 101: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
-	188, // 102: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
-	123, // 103: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
-	188, // 104: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
-	126, // 105: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
-	188, // 106: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
-	129, // 107: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
-	188, // 108: machine.EtcdMembers.metadata:type_name -> common.Metadata
-	132, // 109: machine.EtcdMembers.members:type_name -> machine.EtcdMember
-	133, // 110: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
-	188, // 111: machine.EtcdRecover.metadata:type_name -> common.Metadata
-	136, // 112: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
-	139, // 113: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
-	188, // 114: machine.EtcdAlarm.metadata:type_name -> common.Metadata
-	140, // 115: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	192, // 44: machine.FileInfo.metadata:type_name -> common.Metadata
+	65,  // 45: machine.FileInfo.xattrs:type_name -> machine.Xattr
+	192, // 46: machine.DiskUsageInfo.metadata:type_name -> common.Metadata
+	192, // 47: machine.Mounts.metadata:type_name -> common.Metadata
+	69,  // 48: machine.Mounts.stats:type_name -> machine.MountStat
+	67,  // 49: machine.MountsResponse.messages:type_name -> machine.Mounts
+	192, // 50: machine.Version.metadata:type_name -> common.Metadata
+	72,  // 51: machine.Version.version:type_name -> machine.VersionInfo
+	73,  // 52: machine.Version.platform:type_name -> machine.PlatformInfo
+	74,  // 53: machine.Version.features:type_name -> machine.FeaturesInfo
+	70,  // 54: machine.VersionResponse.messages:type_name -> machine.Version
+	196, // 55: machine.LogsRequest.driver:type_name -> common.ContainerDriver
+	192, // 56: machine.LogsContainer.metadata:type_name -> common.Metadata
+	77,  // 57: machine.LogsContainersResponse.messages:type_name -> machine.LogsContainer
+	192, // 58: machine.Rollback.metadata:type_name -> common.Metadata
+	80,  // 59: machine.RollbackResponse.messages:type_name -> machine.Rollback
+	196, // 60: machine.ContainersRequest.driver:type_name -> common.ContainerDriver
+	192, // 61: machine.Container.metadata:type_name -> common.Metadata
+	83,  // 62: machine.Container.containers:type_name -> machine.ContainerInfo
+	84,  // 63: machine.ContainersResponse.messages:type_name -> machine.Container
+	88,  // 64: machine.ProcessesResponse.messages:type_name -> machine.Process
+	192, // 65: machine.Process.metadata:type_name -> common.Metadata
+	89,  // 66: machine.Process.processes:type_name -> machine.ProcessInfo
+	196, // 67: machine.RestartRequest.driver:type_name -> common.ContainerDriver
+	192, // 68: machine.Restart.metadata:type_name -> common.Metadata
+	91,  // 69: machine.RestartResponse.messages:type_name -> machine.Restart
+	196, // 70: machine.StatsRequest.driver:type_name -> common.ContainerDriver
+	192, // 71: machine.Stats.metadata:type_name -> common.Metadata
+	96,  // 72: machine.Stats.stats:type_name -> machine.Stat
+	94,  // 73: machine.StatsResponse.messages:type_name -> machine.Stats
+	192, // 74: machine.Memory.metadata:type_name -> common.Metadata
+	99,  // 75: machine.Memory.meminfo:type_name -> machine.MemInfo
+	97,  // 76: machine.MemoryResponse.messages:type_name -> machine.Memory
+	101, // 77: machine.HostnameResponse.messages:type_name -> machine.Hostname
+	192, // 78: machine.Hostname.metadata:type_name -> common.Metadata
+	103, // 79: machine.LoadAvgResponse.messages:type_name -> machine.LoadAvg
+	192, // 80: machine.LoadAvg.metadata:type_name -> common.Metadata
+	105, // 81: machine.SystemStatResponse.messages:type_name -> machine.SystemStat
+	192, // 82: machine.SystemStat.metadata:type_name -> common.Metadata
+	106, // 83: machine.SystemStat.cpu_total:type_name -> machine.CPUStat
+	106, // 84: machine.SystemStat.cpu:type_name -> machine.CPUStat
+	107, // 85: machine.SystemStat.soft_irq:type_name -> machine.SoftIRQStat
+	109, // 86: machine.CPUFreqStatsResponse.messages:type_name -> machine.CPUsFreqStats
+	192, // 87: machine.CPUsFreqStats.metadata:type_name -> common.Metadata
+	110, // 88: machine.CPUsFreqStats.cpu_freq_stats:type_name -> machine.CPUFreqStats
+	112, // 89: machine.CPUInfoResponse.messages:type_name -> machine.CPUsInfo
+	192, // 90: machine.CPUsInfo.metadata:type_name -> common.Metadata
+	113, // 91: machine.CPUsInfo.cpu_info:type_name -> machine.CPUInfo
+	115, // 92: machine.NetworkDeviceStatsResponse.messages:type_name -> machine.NetworkDeviceStats
+	192, // 93: machine.NetworkDeviceStats.metadata:type_name -> common.Metadata
+	116, // 94: machine.NetworkDeviceStats.total:type_name -> machine.NetDev
+	116, // 95: machine.NetworkDeviceStats.devices:type_name -> machine.NetDev
+	118, // 96: machine.DiskStatsResponse.messages:type_name -> machine.DiskStats
+	192, // 97: machine.DiskStats.metadata:type_name -> common.Metadata
+	119, // 98: machine.DiskStats.total:type_name -> machine.DiskStat
+	119, // 99: machine.DiskStats.devices:type_name -> machine.DiskStat
+	192, // 100: machine.EtcdLeaveCluster.metadata:type_name -> common.Metadata
+	121, // 101: machine.EtcdLeaveClusterResponse.messages:type_name -> machine.EtcdLeaveCluster
+	192, // 102: machine.EtcdRemoveMember.metadata:type_name -> common.Metadata
+	124, // 103: machine.EtcdRemoveMemberResponse.messages:type_name -> machine.EtcdRemoveMember
+	192, // 104: machine.EtcdRemoveMemberByID.metadata:type_name -> common.Metadata
+	127, // 105: machine.EtcdRemoveMemberByIDResponse.messages:type_name -> machine.EtcdRemoveMemberByID
+	192, // 106: machine.EtcdForfeitLeadership.metadata:type_name -> common.Metadata
+	130, // 107: machine.EtcdForfeitLeadershipResponse.messages:type_name -> machine.EtcdForfeitLeadership
+	192, // 108: machine.EtcdMembers.metadata:type_name -> common.Metadata
+	133, // 109: machine.EtcdMembers.members:type_name -> machine.EtcdMember
+	134, // 110: machine.EtcdMemberListResponse.messages:type_name -> machine.EtcdMembers
+	192, // 111: machine.EtcdRecover.metadata:type_name -> common.Metadata
+	137, // 112: machine.EtcdRecoverResponse.messages:type_name -> machine.EtcdRecover
+	140, // 113: machine.EtcdAlarmListResponse.messages:type_name -> machine.EtcdAlarm
+	192, // 114: machine.EtcdAlarm.metadata:type_name -> common.Metadata
+	141, // 115: machine.EtcdAlarm.member_alarms:type_name -> machine.EtcdMemberAlarm
 	10,  // 116: machine.EtcdMemberAlarm.alarm:type_name -> machine.EtcdMemberAlarm.AlarmType
-	142, // 117: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
-	188, // 118: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
-	140, // 119: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
-	144, // 120: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
-	188, // 121: machine.EtcdDefragment.metadata:type_name -> common.Metadata
-	146, // 122: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
-	188, // 123: machine.EtcdStatus.metadata:type_name -> common.Metadata
-	147, // 124: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
-	149, // 125: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
-	148, // 126: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
-	150, // 127: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
+	143, // 117: machine.EtcdAlarmDisarmResponse.messages:type_name -> machine.EtcdAlarmDisarm
+	192, // 118: machine.EtcdAlarmDisarm.metadata:type_name -> common.Metadata
+	141, // 119: machine.EtcdAlarmDisarm.member_alarms:type_name -> machine.EtcdMemberAlarm
+	145, // 120: machine.EtcdDefragmentResponse.messages:type_name -> machine.EtcdDefragment
+	192, // 121: machine.EtcdDefragment.metadata:type_name -> common.Metadata
+	147, // 122: machine.EtcdStatusResponse.messages:type_name -> machine.EtcdStatus
+	192, // 123: machine.EtcdStatus.metadata:type_name -> common.Metadata
+	148, // 124: machine.EtcdStatus.member_status:type_name -> machine.EtcdMemberStatus
+	150, // 125: machine.NetworkDeviceConfig.dhcp_options:type_name -> machine.DHCPOptionsConfig
+	149, // 126: machine.NetworkDeviceConfig.routes:type_name -> machine.RouteConfig
+	151, // 127: machine.NetworkConfig.interfaces:type_name -> machine.NetworkDeviceConfig
 	11,  // 128: machine.MachineConfig.type:type_name -> machine.MachineConfig.MachineType
-	152, // 129: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
-	151, // 130: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
-	155, // 131: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
-	154, // 132: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
-	156, // 133: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
-	157, // 134: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
-	153, // 135: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
-	191, // 136: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
-	188, // 137: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
-	159, // 138: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
-	187, // 139: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
-	188, // 140: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
-	162, // 141: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
-	165, // 142: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
+	153, // 129: machine.MachineConfig.install_config:type_name -> machine.InstallConfig
+	152, // 130: machine.MachineConfig.network_config:type_name -> machine.NetworkConfig
+	156, // 131: machine.ClusterNetworkConfig.cni_config:type_name -> machine.CNIConfig
+	155, // 132: machine.ClusterConfig.control_plane:type_name -> machine.ControlPlaneConfig
+	157, // 133: machine.ClusterConfig.cluster_network:type_name -> machine.ClusterNetworkConfig
+	158, // 134: machine.GenerateConfigurationRequest.cluster_config:type_name -> machine.ClusterConfig
+	154, // 135: machine.GenerateConfigurationRequest.machine_config:type_name -> machine.MachineConfig
+	195, // 136: machine.GenerateConfigurationRequest.override_time:type_name -> google.protobuf.Timestamp
+	192, // 137: machine.GenerateConfiguration.metadata:type_name -> common.Metadata
+	160, // 138: machine.GenerateConfigurationResponse.messages:type_name -> machine.GenerateConfiguration
+	191, // 139: machine.GenerateClientConfigurationRequest.crt_ttl:type_name -> google.protobuf.Duration
+	192, // 140: machine.GenerateClientConfiguration.metadata:type_name -> common.Metadata
+	163, // 141: machine.GenerateClientConfigurationResponse.messages:type_name -> machine.GenerateClientConfiguration
+	166, // 142: machine.PacketCaptureRequest.bpf_filter:type_name -> machine.BPFInstruction
 	12,  // 143: machine.NetstatRequest.filter:type_name -> machine.NetstatRequest.Filter
-	183, // 144: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
-	184, // 145: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
-	185, // 146: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
+	187, // 144: machine.NetstatRequest.feature:type_name -> machine.NetstatRequest.Feature
+	188, // 145: machine.NetstatRequest.l4proto:type_name -> machine.NetstatRequest.L4proto
+	189, // 146: machine.NetstatRequest.netns:type_name -> machine.NetstatRequest.NetNS
 	13,  // 147: machine.ConnectRecord.state:type_name -> machine.ConnectRecord.State
 	14,  // 148: machine.ConnectRecord.tr:type_name -> machine.ConnectRecord.TimerActive
-	186, // 149: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
-	188, // 150: machine.Netstat.metadata:type_name -> common.Metadata
-	167, // 151: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
-	168, // 152: machine.NetstatResponse.messages:type_name -> machine.Netstat
-	188, // 153: machine.MetaWrite.metadata:type_name -> common.Metadata
-	171, // 154: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
-	188, // 155: machine.MetaDelete.metadata:type_name -> common.Metadata
-	174, // 156: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
-	193, // 157: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
-	188, // 158: machine.ImageListResponse.metadata:type_name -> common.Metadata
-	191, // 159: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
-	193, // 160: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
-	188, // 161: machine.ImagePull.metadata:type_name -> common.Metadata
-	179, // 162: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
-	182, // 163: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
-	15,  // 164: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
-	21,  // 165: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
-	81,  // 166: machine.MachineService.Containers:input_type -> machine.ContainersRequest
-	60,  // 167: machine.MachineService.Copy:input_type -> machine.CopyRequest
-	194, // 168: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
-	194, // 169: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
-	194, // 170: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
-	85,  // 171: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
-	33,  // 172: machine.MachineService.Events:input_type -> machine.EventsRequest
-	131, // 173: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
-	125, // 174: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
-	119, // 175: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
-	128, // 176: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
-	195, // 177: machine.MachineService.EtcdRecover:input_type -> common.Data
-	135, // 178: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
-	194, // 179: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
-	194, // 180: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
-	194, // 181: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
-	194, // 182: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
-	158, // 183: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
-	194, // 184: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
-	194, // 185: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
-	61,  // 186: machine.MachineService.List:input_type -> machine.ListRequest
-	62,  // 187: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
-	194, // 188: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
-	74,  // 189: machine.MachineService.Logs:input_type -> machine.LogsRequest
-	194, // 190: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
-	194, // 191: machine.MachineService.Memory:input_type -> google.protobuf.Empty
-	194, // 192: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
-	194, // 193: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
-	194, // 194: machine.MachineService.Processes:input_type -> google.protobuf.Empty
-	75,  // 195: machine.MachineService.Read:input_type -> machine.ReadRequest
-	18,  // 196: machine.MachineService.Reboot:input_type -> machine.RebootRequest
-	89,  // 197: machine.MachineService.Restart:input_type -> machine.RestartRequest
-	78,  // 198: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
-	36,  // 199: machine.MachineService.Reset:input_type -> machine.ResetRequest
-	194, // 200: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
-	57,  // 201: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
-	51,  // 202: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
-	54,  // 203: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
-	40,  // 204: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
-	92,  // 205: machine.MachineService.Stats:input_type -> machine.StatsRequest
-	194, // 206: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
-	42,  // 207: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
-	194, // 208: machine.MachineService.Version:input_type -> google.protobuf.Empty
-	161, // 209: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
-	164, // 210: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
-	166, // 211: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
-	170, // 212: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
-	173, // 213: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
-	176, // 214: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
-	178, // 215: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
-	17,  // 216: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
-	23,  // 217: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
-	84,  // 218: machine.MachineService.Containers:output_type -> machine.ContainersResponse
-	195, // 219: machine.MachineService.Copy:output_type -> common.Data
-	107, // 220: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
-	110, // 221: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
-	116, // 222: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
-	195, // 223: machine.MachineService.Dmesg:output_type -> common.Data
-	34,  // 224: machine.MachineService.Events:output_type -> machine.Event
-	134, // 225: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
-	127, // 226: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
-	121, // 227: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
-	130, // 228: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
-	137, // 229: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
-	195, // 230: machine.MachineService.EtcdSnapshot:output_type -> common.Data
-	138, // 231: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
-	141, // 232: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
-	143, // 233: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
-	145, // 234: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
-	160, // 235: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
-	99,  // 236: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
-	195, // 237: machine.MachineService.Kubeconfig:output_type -> common.Data
-	63,  // 238: machine.MachineService.List:output_type -> machine.FileInfo
-	65,  // 239: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
-	101, // 240: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
-	195, // 241: machine.MachineService.Logs:output_type -> common.Data
-	77,  // 242: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
-	97,  // 243: machine.MachineService.Memory:output_type -> machine.MemoryResponse
-	67,  // 244: machine.MachineService.Mounts:output_type -> machine.MountsResponse
-	113, // 245: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
-	86,  // 246: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
-	195, // 247: machine.MachineService.Read:output_type -> common.Data
-	20,  // 248: machine.MachineService.Reboot:output_type -> machine.RebootResponse
-	91,  // 249: machine.MachineService.Restart:output_type -> machine.RestartResponse
-	80,  // 250: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
-	38,  // 251: machine.MachineService.Reset:output_type -> machine.ResetResponse
-	46,  // 252: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
-	59,  // 253: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
-	53,  // 254: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
-	56,  // 255: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
-	41,  // 256: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
-	94,  // 257: machine.MachineService.Stats:output_type -> machine.StatsResponse
-	103, // 258: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
-	44,  // 259: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
-	70,  // 260: machine.MachineService.Version:output_type -> machine.VersionResponse
-	163, // 261: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
-	195, // 262: machine.MachineService.PacketCapture:output_type -> common.Data
-	169, // 263: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
-	172, // 264: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
-	175, // 265: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
-	177, // 266: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
-	180, // 267: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
-	216, // [216:268] is the sub-list for method output_type
-	164, // [164:216] is the sub-list for method input_type
-	164, // [164:164] is the sub-list for extension type_name
-	164, // [164:164] is the sub-list for extension extendee
-	0,   // [0:164] is the sub-list for field type_name
+	190, // 149: machine.ConnectRecord.process:type_name -> machine.ConnectRecord.Process
+	192, // 150: machine.Netstat.metadata:type_name -> common.Metadata
+	168, // 151: machine.Netstat.connectrecord:type_name -> machine.ConnectRecord
+	169, // 152: machine.NetstatResponse.messages:type_name -> machine.Netstat
+	192, // 153: machine.MetaWrite.metadata:type_name -> common.Metadata
+	172, // 154: machine.MetaWriteResponse.messages:type_name -> machine.MetaWrite
+	192, // 155: machine.MetaDelete.metadata:type_name -> common.Metadata
+	175, // 156: machine.MetaDeleteResponse.messages:type_name -> machine.MetaDelete
+	197, // 157: machine.ImageListRequest.namespace:type_name -> common.ContainerdNamespace
+	192, // 158: machine.ImageListResponse.metadata:type_name -> common.Metadata
+	195, // 159: machine.ImageListResponse.created_at:type_name -> google.protobuf.Timestamp
+	197, // 160: machine.ImagePullRequest.namespace:type_name -> common.ContainerdNamespace
+	192, // 161: machine.ImagePull.metadata:type_name -> common.Metadata
+	180, // 162: machine.ImagePullResponse.messages:type_name -> machine.ImagePull
+	15,  // 163: machine.EncryptionKeySlotRequest.operation:type_name -> machine.EncryptionKeySlotRequest.Operation
+	192, // 164: machine.EncryptionKeySlot.metadata:type_name -> common.Metadata
+	183, // 165: machine.EncryptionKeySlotResponse.messages:type_name -> machine.EncryptionKeySlot
+	186, // 166: machine.MachineStatusEvent.MachineStatus.unmet_conditions:type_name -> machine.MachineStatusEvent.MachineStatus.UnmetCondition
+	16,  // 167: machine.MachineService.ApplyConfiguration:input_type -> machine.ApplyConfigurationRequest
+	22,  // 168: machine.MachineService.Bootstrap:input_type -> machine.BootstrapRequest
+	82,  // 169: machine.MachineService.Containers:input_type -> machine.ContainersRequest
+	61,  // 170: machine.MachineService.Copy:input_type -> machine.CopyRequest
+	198, // 171: machine.MachineService.CPUFreqStats:input_type -> google.protobuf.Empty
+	198, // 172: machine.MachineService.CPUInfo:input_type -> google.protobuf.Empty
+	198, // 173: machine.MachineService.DiskStats:input_type -> google.protobuf.Empty
+	86,  // 174: machine.MachineService.Dmesg:input_type -> machine.DmesgRequest
+	34,  // 175: machine.MachineService.Events:input_type -> machine.EventsRequest
+	132, // 176: machine.MachineService.EtcdMemberList:input_type -> machine.EtcdMemberListRequest
+	126, // 177: machine.MachineService.EtcdRemoveMemberByID:input_type -> machine.EtcdRemoveMemberByIDRequest
+	120, // 178: machine.MachineService.EtcdLeaveCluster:input_type -> machine.EtcdLeaveClusterRequest
+	129, // 179: machine.MachineService.EtcdForfeitLeadership:input_type -> machine.EtcdForfeitLeadershipRequest
+	199, // 180: machine.MachineService.EtcdRecover:input_type -> common.Data
+	136, // 181: machine.MachineService.EtcdSnapshot:input_type -> machine.EtcdSnapshotRequest
+	198, // 182: machine.MachineService.EtcdAlarmList:input_type -> google.protobuf.Empty
+	198, // 183: machine.MachineService.EtcdAlarmDisarm:input_type -> google.protobuf.Empty
+	198, // 184: machine.MachineService.EtcdDefragment:input_type -> google.protobuf.Empty
+	198, // 185: machine.MachineService.EtcdStatus:input_type -> google.protobuf.Empty
+	159, // 186: machine.MachineService.GenerateConfiguration:input_type -> machine.GenerateConfigurationRequest
+	198, // 187: machine.MachineService.Hostname:input_type -> google.protobuf.Empty
+	198, // 188: machine.MachineService.Kubeconfig:input_type -> google.protobuf.Empty
+	62,  // 189: machine.MachineService.List:input_type -> machine.ListRequest
+	63,  // 190: machine.MachineService.DiskUsage:input_type -> machine.DiskUsageRequest
+	198, // 191: machine.MachineService.LoadAvg:input_type -> google.protobuf.Empty
+	75,  // 192: machine.MachineService.Logs:input_type -> machine.LogsRequest
+	198, // 193: machine.MachineService.LogsContainers:input_type -> google.protobuf.Empty
+	198, // 194: machine.MachineService.Memory:input_type -> google.protobuf.Empty
+	198, // 195: machine.MachineService.Mounts:input_type -> google.protobuf.Empty
+	198, // 196: machine.MachineService.NetworkDeviceStats:input_type -> google.protobuf.Empty
+	198, // 197: machine.MachineService.Processes:input_type -> google.protobuf.Empty
+	76,  // 198: machine.MachineService.Read:input_type -> machine.ReadRequest
+	19,  // 199: machine.MachineService.Reboot:input_type -> machine.RebootRequest
+	90,  // 200: machine.MachineService.Restart:input_type -> machine.RestartRequest
+	79,  // 201: machine.MachineService.Rollback:input_type -> machine.RollbackRequest
+	37,  // 202: machine.MachineService.Reset:input_type -> machine.ResetRequest
+	198, // 203: machine.MachineService.ServiceList:input_type -> google.protobuf.Empty
+	58,  // 204: machine.MachineService.ServiceRestart:input_type -> machine.ServiceRestartRequest
+	52,  // 205: machine.MachineService.ServiceStart:input_type -> machine.ServiceStartRequest
+	55,  // 206: machine.MachineService.ServiceStop:input_type -> machine.ServiceStopRequest
+	41,  // 207: machine.MachineService.Shutdown:input_type -> machine.ShutdownRequest
+	93,  // 208: machine.MachineService.Stats:input_type -> machine.StatsRequest
+	198, // 209: machine.MachineService.SystemStat:input_type -> google.protobuf.Empty
+	43,  // 210: machine.MachineService.Upgrade:input_type -> machine.UpgradeRequest
+	198, // 211: machine.MachineService.Version:input_type -> google.protobuf.Empty
+	162, // 212: machine.MachineService.GenerateClientConfiguration:input_type -> machine.GenerateClientConfigurationRequest
+	165, // 213: machine.MachineService.PacketCapture:input_type -> machine.PacketCaptureRequest
+	167, // 214: machine.MachineService.Netstat:input_type -> machine.NetstatRequest
+	171, // 215: machine.MachineService.MetaWrite:input_type -> machine.MetaWriteRequest
+	174, // 216: machine.MachineService.MetaDelete:input_type -> machine.MetaDeleteRequest
+	177, // 217: machine.MachineService.ImageList:input_type -> machine.ImageListRequest
+	179, // 218: machine.MachineService.ImagePull:input_type -> machine.ImagePullRequest
+	182, // 219: machine.MachineService.EncryptionKeySlot:input_type -> machine.EncryptionKeySlotRequest
+	18,  // 220: machine.MachineService.ApplyConfiguration:output_type -> machine.ApplyConfigurationResponse
+	24,  // 221: machine.MachineService.Bootstrap:output_type -> machine.BootstrapResponse
+	85,  // 222: machine.MachineService.Containers:output_type -> machine.ContainersResponse
+	199, // 223: machine.MachineService.Copy:output_type -> common.Data
+	108, // 224: machine.MachineService.CPUFreqStats:output_type -> machine.CPUFreqStatsResponse
+	111, // 225: machine.MachineService.CPUInfo:output_type -> machine.CPUInfoResponse
+	117, // 226: machine.MachineService.DiskStats:output_type -> machine.DiskStatsResponse
+	199, // 227: machine.MachineService.Dmesg:output_type -> common.Data
+	35,  // 228: machine.MachineService.Events:output_type -> machine.Event
+	135, // 229: machine.MachineService.EtcdMemberList:output_type -> machine.EtcdMemberListResponse
+	128, // 230: machine.MachineService.EtcdRemoveMemberByID:output_type -> machine.EtcdRemoveMemberByIDResponse
+	122, // 231: machine.MachineService.EtcdLeaveCluster:output_type -> machine.EtcdLeaveClusterResponse
+	131, // 232: machine.MachineService.EtcdForfeitLeadership:output_type -> machine.EtcdForfeitLeadershipResponse
+	138, // 233: machine.MachineService.EtcdRecover:output_type -> machine.EtcdRecoverResponse
+	199, // 234: machine.MachineService.EtcdSnapshot:output_type -> common.Data
+	139, // 235: machine.MachineService.EtcdAlarmList:output_type -> machine.EtcdAlarmListResponse
+	142, // 236: machine.MachineService.EtcdAlarmDisarm:output_type -> machine.EtcdAlarmDisarmResponse
+	144, // 237: machine.MachineService.EtcdDefragment:output_type -> machine.EtcdDefragmentResponse
+	146, // 238: machine.MachineService.EtcdStatus:output_type -> machine.EtcdStatusResponse
+	161, // 239: machine.MachineService.GenerateConfiguration:output_type -> machine.GenerateConfigurationResponse
+	100, // 240: machine.MachineService.Hostname:output_type -> machine.HostnameResponse
+	199, // 241: machine.MachineService.Kubeconfig:output_type -> common.Data
+	64,  // 242: machine.MachineService.List:output_type -> machine.FileInfo
+	66,  // 243: machine.MachineService.DiskUsage:output_type -> machine.DiskUsageInfo
+	102, // 244: machine.MachineService.LoadAvg:output_type -> machine.LoadAvgResponse
+	199, // 245: machine.MachineService.Logs:output_type -> common.Data
+	78,  // 246: machine.MachineService.LogsContainers:output_type -> machine.LogsContainersResponse
+	98,  // 247: machine.MachineService.Memory:output_type -> machine.MemoryResponse
+	68,  // 248: machine.MachineService.Mounts:output_type -> machine.MountsResponse
+	114, // 249: machine.MachineService.NetworkDeviceStats:output_type -> machine.NetworkDeviceStatsResponse
+	87,  // 250: machine.MachineService.Processes:output_type -> machine.ProcessesResponse
+	199, // 251: machine.MachineService.Read:output_type -> common.Data
+	21,  // 252: machine.MachineService.Reboot:output_type -> machine.RebootResponse
+	92,  // 253: machine.MachineService.Restart:output_type -> machine.RestartResponse
+	81,  // 254: machine.MachineService.Rollback:output_type -> machine.RollbackResponse
+	39,  // 255: machine.MachineService.Reset:output_type -> machine.ResetResponse
+	47,  // 256: machine.MachineService.ServiceList:output_type -> machine.ServiceListResponse
+	60,  // 257: machine.MachineService.ServiceRestart:output_type -> machine.ServiceRestartResponse
+	54,  // 258: machine.MachineService.ServiceStart:output_type -> machine.ServiceStartResponse
+	57,  // 259: machine.MachineService.ServiceStop:output_type -> machine.ServiceStopResponse
+	42,  // 260: machine.MachineService.Shutdown:output_type -> machine.ShutdownResponse
+	95,  // 261: machine.MachineService.Stats:output_type -> machine.StatsResponse
+	104, // 262: machine.MachineService.SystemStat:output_type -> machine.SystemStatResponse
+	45,  // 263: machine.MachineService.Upgrade:output_type -> machine.UpgradeResponse
+	71,  // 264: machine.MachineService.Version:output_type -> machine.VersionResponse
+	164, // 265: machine.MachineService.GenerateClientConfiguration:output_type -> machine.GenerateClientConfigurationResponse
+	199, // 266: machine.MachineService.PacketCapture:output_type -> common.Data
+	170, // 267: machine.MachineService.Netstat:output_type -> machine.NetstatResponse
+	173, // 268: machine.MachineService.MetaWrite:output_type -> machine.MetaWriteResponse
+	176, // 269: machine.MachineService.MetaDelete:output_type -> machine.MetaDeleteResponse
+	178, // 270: machine.MachineService.ImageList:output_type -> machine.ImageListResponse
+	181, // 271: machine.MachineService.ImagePull:output_type -> machine.ImagePullResponse
+	184, // 272: machine.MachineService.EncryptionKeySlot:output_type -> machine.EncryptionKeySlotResponse
+	220, // [220:273] is the sub-list for method output_type
+	167, // [167:220] is the sub-list for method input_type
+	167, // [167:167] is the sub-list for extension type_name
+	167, // [167:167] is the sub-list for extension extendee
+	0,   // [0:167] is the sub-list for field type_name
 }
 
 func init() { file_machine_machine_proto_init() }
@@ -13706,8 +13958,8 @@ func file_machine_machine_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_machine_machine_proto_rawDesc,
-			NumEnums:      15,
-			NumMessages:   172,
+			NumEnums:      16,
+			NumMessages:   175,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
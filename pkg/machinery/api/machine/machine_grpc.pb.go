@@ -75,6 +75,7 @@ const (
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
 	MachineService_ImageList_FullMethodName                   = "/machine.MachineService/ImageList"
 	MachineService_ImagePull_FullMethodName                   = "/machine.MachineService/ImagePull"
+	MachineService_EncryptionKeySlot_FullMethodName           = "/machine.MachineService/EncryptionKeySlot"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -165,6 +166,8 @@ type MachineServiceClient interface {
 	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImageListResponse], error)
 	// ImagePull pulls an image into the CRI.
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
+	// EncryptionKeySlot manages the key slots of the encrypted volume.
+	EncryptionKeySlot(ctx context.Context, in *EncryptionKeySlotRequest, opts ...grpc.CallOption) (*EncryptionKeySlotResponse, error)
 }
 
 type machineServiceClient struct {
@@ -797,6 +800,16 @@ func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullReque
 	return out, nil
 }
 
+func (c *machineServiceClient) EncryptionKeySlot(ctx context.Context, in *EncryptionKeySlotRequest, opts ...grpc.CallOption) (*EncryptionKeySlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionKeySlotResponse)
+	err := c.cc.Invoke(ctx, MachineService_EncryptionKeySlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility.
@@ -885,6 +898,8 @@ type MachineServiceServer interface {
 	ImageList(*ImageListRequest, grpc.ServerStreamingServer[ImageListResponse]) error
 	// ImagePull pulls an image into the CRI.
 	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
+	// EncryptionKeySlot manages the key slots of the encrypted volume.
+	EncryptionKeySlot(context.Context, *EncryptionKeySlotRequest) (*EncryptionKeySlotResponse, error)
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -1051,6 +1066,9 @@ func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, grpc.Serve
 func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
 }
+func (UnimplementedMachineServiceServer) EncryptionKeySlot(context.Context, *EncryptionKeySlotRequest) (*EncryptionKeySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionKeySlot not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 func (UnimplementedMachineServiceServer) testEmbeddedByValue()                        {}
 
@@ -1920,6 +1938,24 @@ func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_EncryptionKeySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionKeySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EncryptionKeySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EncryptionKeySlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EncryptionKeySlot(ctx, req.(*EncryptionKeySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2087,6 +2123,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImagePull",
 			Handler:    _MachineService_ImagePull_Handler,
 		},
+		{
+			MethodName: "EncryptionKeySlot",
+			Handler:    _MachineService_EncryptionKeySlot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
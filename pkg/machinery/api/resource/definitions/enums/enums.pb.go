@@ -485,6 +485,62 @@ func (NethelpersConntrackState) EnumDescriptor() ([]byte, []int) {
 	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{7}
 }
 
+// NethelpersDot1XState is a 802.1X port authentication state.
+type NethelpersDot1XState int32
+
+const (
+	NethelpersDot1XState_DOT1_X_STATE_DISABLED       NethelpersDot1XState = 0
+	NethelpersDot1XState_DOT1_X_STATE_CONNECTING     NethelpersDot1XState = 1
+	NethelpersDot1XState_DOT1_X_STATE_AUTHENTICATING NethelpersDot1XState = 2
+	NethelpersDot1XState_DOT1_X_STATE_AUTHENTICATED  NethelpersDot1XState = 3
+	NethelpersDot1XState_DOT1_X_STATE_FAILED         NethelpersDot1XState = 4
+)
+
+// Enum value maps for NethelpersDot1XState.
+var (
+	NethelpersDot1XState_name = map[int32]string{
+		0: "DOT1_X_STATE_DISABLED",
+		1: "DOT1_X_STATE_CONNECTING",
+		2: "DOT1_X_STATE_AUTHENTICATING",
+		3: "DOT1_X_STATE_AUTHENTICATED",
+		4: "DOT1_X_STATE_FAILED",
+	}
+	NethelpersDot1XState_value = map[string]int32{
+		"DOT1_X_STATE_DISABLED":       0,
+		"DOT1_X_STATE_CONNECTING":     1,
+		"DOT1_X_STATE_AUTHENTICATING": 2,
+		"DOT1_X_STATE_AUTHENTICATED":  3,
+		"DOT1_X_STATE_FAILED":         4,
+	}
+)
+
+func (x NethelpersDot1XState) Enum() *NethelpersDot1XState {
+	p := new(NethelpersDot1XState)
+	*p = x
+	return p
+}
+
+func (x NethelpersDot1XState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersDot1XState) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[8].Descriptor()
+}
+
+func (NethelpersDot1XState) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[8]
+}
+
+func (x NethelpersDot1XState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersDot1XState.Descriptor instead.
+func (NethelpersDot1XState) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{8}
+}
+
 // NethelpersDuplex wraps ethtool.Duplex for YAML marshaling.
 type NethelpersDuplex int32
 
@@ -519,11 +575,11 @@ func (x NethelpersDuplex) String() string {
 }
 
 func (NethelpersDuplex) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[8].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[9].Descriptor()
 }
 
 func (NethelpersDuplex) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[8]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[9]
 }
 
 func (x NethelpersDuplex) Number() protoreflect.EnumNumber {
@@ -532,7 +588,7 @@ func (x NethelpersDuplex) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersDuplex.Descriptor instead.
 func (NethelpersDuplex) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{9}
 }
 
 // NethelpersFailOverMAC is a MAC failover mode.
@@ -569,11 +625,11 @@ func (x NethelpersFailOverMAC) String() string {
 }
 
 func (NethelpersFailOverMAC) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[9].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[10].Descriptor()
 }
 
 func (NethelpersFailOverMAC) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[9]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[10]
 }
 
 func (x NethelpersFailOverMAC) Number() protoreflect.EnumNumber {
@@ -582,7 +638,7 @@ func (x NethelpersFailOverMAC) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersFailOverMAC.Descriptor instead.
 func (NethelpersFailOverMAC) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{10}
 }
 
 // NethelpersFamily is a network family.
@@ -619,11 +675,11 @@ func (x NethelpersFamily) String() string {
 }
 
 func (NethelpersFamily) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[10].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[11].Descriptor()
 }
 
 func (NethelpersFamily) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[10]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[11]
 }
 
 func (x NethelpersFamily) Number() protoreflect.EnumNumber {
@@ -632,7 +688,57 @@ func (x NethelpersFamily) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersFamily.Descriptor instead.
 func (NethelpersFamily) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{11}
+}
+
+// NethelpersIPVLANMode is an ipvlan link mode.
+type NethelpersIPVLANMode int32
+
+const (
+	NethelpersIPVLANMode_IPVLAN_MODE_L2   NethelpersIPVLANMode = 0
+	NethelpersIPVLANMode_IPVLAN_MODE_L3   NethelpersIPVLANMode = 1
+	NethelpersIPVLANMode_IPVLAN_MODE_L3_S NethelpersIPVLANMode = 2
+)
+
+// Enum value maps for NethelpersIPVLANMode.
+var (
+	NethelpersIPVLANMode_name = map[int32]string{
+		0: "IPVLAN_MODE_L2",
+		1: "IPVLAN_MODE_L3",
+		2: "IPVLAN_MODE_L3_S",
+	}
+	NethelpersIPVLANMode_value = map[string]int32{
+		"IPVLAN_MODE_L2":   0,
+		"IPVLAN_MODE_L3":   1,
+		"IPVLAN_MODE_L3_S": 2,
+	}
+)
+
+func (x NethelpersIPVLANMode) Enum() *NethelpersIPVLANMode {
+	p := new(NethelpersIPVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersIPVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersIPVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[12].Descriptor()
+}
+
+func (NethelpersIPVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[12]
+}
+
+func (x NethelpersIPVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersIPVLANMode.Descriptor instead.
+func (NethelpersIPVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{12}
 }
 
 // NethelpersLACPRate is a LACP rate.
@@ -666,11 +772,11 @@ func (x NethelpersLACPRate) String() string {
 }
 
 func (NethelpersLACPRate) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[11].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[13].Descriptor()
 }
 
 func (NethelpersLACPRate) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[11]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[13]
 }
 
 func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
@@ -679,7 +785,7 @@ func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLACPRate.Descriptor instead.
 func (NethelpersLACPRate) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{13}
 }
 
 // NethelpersLinkType is a link type.
@@ -938,11 +1044,11 @@ func (x NethelpersLinkType) String() string {
 }
 
 func (NethelpersLinkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[12].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[14].Descriptor()
 }
 
 func (NethelpersLinkType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[12]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[14]
 }
 
 func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
@@ -951,7 +1057,66 @@ func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLinkType.Descriptor instead.
 func (NethelpersLinkType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{14}
+}
+
+// NethelpersMACVLANMode is a macvlan link mode.
+type NethelpersMACVLANMode int32
+
+const (
+	NethelpersMACVLANMode_NETHELPERS_MACVLANMODE_UNSPECIFIED NethelpersMACVLANMode = 0
+	NethelpersMACVLANMode_MACVLAN_MODE_PRIVATE               NethelpersMACVLANMode = 1
+	NethelpersMACVLANMode_MACVLAN_MODE_VEPA                  NethelpersMACVLANMode = 2
+	NethelpersMACVLANMode_MACVLAN_MODE_BRIDGE                NethelpersMACVLANMode = 4
+	NethelpersMACVLANMode_MACVLAN_MODE_PASSTHRU              NethelpersMACVLANMode = 8
+	NethelpersMACVLANMode_MACVLAN_MODE_SOURCE                NethelpersMACVLANMode = 16
+)
+
+// Enum value maps for NethelpersMACVLANMode.
+var (
+	NethelpersMACVLANMode_name = map[int32]string{
+		0:  "NETHELPERS_MACVLANMODE_UNSPECIFIED",
+		1:  "MACVLAN_MODE_PRIVATE",
+		2:  "MACVLAN_MODE_VEPA",
+		4:  "MACVLAN_MODE_BRIDGE",
+		8:  "MACVLAN_MODE_PASSTHRU",
+		16: "MACVLAN_MODE_SOURCE",
+	}
+	NethelpersMACVLANMode_value = map[string]int32{
+		"NETHELPERS_MACVLANMODE_UNSPECIFIED": 0,
+		"MACVLAN_MODE_PRIVATE":               1,
+		"MACVLAN_MODE_VEPA":                  2,
+		"MACVLAN_MODE_BRIDGE":                4,
+		"MACVLAN_MODE_PASSTHRU":              8,
+		"MACVLAN_MODE_SOURCE":                16,
+	}
+)
+
+func (x NethelpersMACVLANMode) Enum() *NethelpersMACVLANMode {
+	p := new(NethelpersMACVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersMACVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersMACVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[15].Descriptor()
+}
+
+func (NethelpersMACVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[15]
+}
+
+func (x NethelpersMACVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersMACVLANMode.Descriptor instead.
+func (NethelpersMACVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{15}
 }
 
 // NethelpersMatchOperator is a netfilter match operator.
@@ -985,11 +1150,11 @@ func (x NethelpersMatchOperator) String() string {
 }
 
 func (NethelpersMatchOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[13].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[16].Descriptor()
 }
 
 func (NethelpersMatchOperator) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[13]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[16]
 }
 
 func (x NethelpersMatchOperator) Number() protoreflect.EnumNumber {
@@ -998,7 +1163,7 @@ func (x NethelpersMatchOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersMatchOperator.Descriptor instead.
 func (NethelpersMatchOperator) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{16}
 }
 
 // NethelpersNfTablesChainHook wraps nftables.ChainHook for YAML marshaling.
@@ -1041,11 +1206,11 @@ func (x NethelpersNfTablesChainHook) String() string {
 }
 
 func (NethelpersNfTablesChainHook) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[14].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[17].Descriptor()
 }
 
 func (NethelpersNfTablesChainHook) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[14]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[17]
 }
 
 func (x NethelpersNfTablesChainHook) Number() protoreflect.EnumNumber {
@@ -1054,7 +1219,7 @@ func (x NethelpersNfTablesChainHook) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesChainHook.Descriptor instead.
 func (NethelpersNfTablesChainHook) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{17}
 }
 
 // NethelpersNfTablesChainPriority wraps nftables.ChainPriority for YAML marshaling.
@@ -1124,11 +1289,11 @@ func (x NethelpersNfTablesChainPriority) String() string {
 }
 
 func (NethelpersNfTablesChainPriority) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[15].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[18].Descriptor()
 }
 
 func (NethelpersNfTablesChainPriority) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[15]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[18]
 }
 
 func (x NethelpersNfTablesChainPriority) Number() protoreflect.EnumNumber {
@@ -1137,7 +1302,7 @@ func (x NethelpersNfTablesChainPriority) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesChainPriority.Descriptor instead.
 func (NethelpersNfTablesChainPriority) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{15}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{18}
 }
 
 // NethelpersNfTablesVerdict wraps nftables.Verdict for YAML marshaling.
@@ -1171,11 +1336,11 @@ func (x NethelpersNfTablesVerdict) String() string {
 }
 
 func (NethelpersNfTablesVerdict) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[16].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[19].Descriptor()
 }
 
 func (NethelpersNfTablesVerdict) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[16]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[19]
 }
 
 func (x NethelpersNfTablesVerdict) Number() protoreflect.EnumNumber {
@@ -1184,7 +1349,7 @@ func (x NethelpersNfTablesVerdict) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesVerdict.Descriptor instead.
 func (NethelpersNfTablesVerdict) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{16}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{19}
 }
 
 // NethelpersOperationalState wraps rtnetlink.OperationalState for YAML marshaling.
@@ -1233,11 +1398,11 @@ func (x NethelpersOperationalState) String() string {
 }
 
 func (NethelpersOperationalState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[17].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[20].Descriptor()
 }
 
 func (NethelpersOperationalState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[17]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[20]
 }
 
 func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
@@ -1246,7 +1411,7 @@ func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersOperationalState.Descriptor instead.
 func (NethelpersOperationalState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{17}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{20}
 }
 
 // NethelpersPort wraps ethtool.Port for YAML marshaling.
@@ -1298,11 +1463,11 @@ func (x NethelpersPort) String() string {
 }
 
 func (NethelpersPort) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[18].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[21].Descriptor()
 }
 
 func (NethelpersPort) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[18]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[21]
 }
 
 func (x NethelpersPort) Number() protoreflect.EnumNumber {
@@ -1311,7 +1476,7 @@ func (x NethelpersPort) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPort.Descriptor instead.
 func (NethelpersPort) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{18}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{21}
 }
 
 // NethelpersPrimaryReselect is an ARP targets mode.
@@ -1348,11 +1513,11 @@ func (x NethelpersPrimaryReselect) String() string {
 }
 
 func (NethelpersPrimaryReselect) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[19].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
 }
 
 func (NethelpersPrimaryReselect) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[19]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
 }
 
 func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
@@ -1361,7 +1526,7 @@ func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPrimaryReselect.Descriptor instead.
 func (NethelpersPrimaryReselect) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
 }
 
 // NethelpersProtocol is a inet protocol.
@@ -1404,11 +1569,11 @@ func (x NethelpersProtocol) String() string {
 }
 
 func (NethelpersProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[20].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[23].Descriptor()
 }
 
 func (NethelpersProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[20]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[23]
 }
 
 func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
@@ -1417,7 +1582,7 @@ func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersProtocol.Descriptor instead.
 func (NethelpersProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{20}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
 }
 
 // NethelpersRouteFlag wraps RTM_F_* constants.
@@ -1472,11 +1637,11 @@ func (x NethelpersRouteFlag) String() string {
 }
 
 func (NethelpersRouteFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[21].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
 }
 
 func (NethelpersRouteFlag) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[21]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
 }
 
 func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
@@ -1485,7 +1650,7 @@ func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteFlag.Descriptor instead.
 func (NethelpersRouteFlag) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{21}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
 }
 
 // NethelpersRouteProtocol is a routing protocol.
@@ -1579,11 +1744,11 @@ func (x NethelpersRouteProtocol) String() string {
 }
 
 func (NethelpersRouteProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[22].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
 }
 
 func (NethelpersRouteProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[22]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
 }
 
 func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
@@ -1592,7 +1757,7 @@ func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteProtocol.Descriptor instead.
 func (NethelpersRouteProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
 }
 
 // NethelpersRouteType is a route type.
@@ -1656,11 +1821,11 @@ func (x NethelpersRouteType) String() string {
 }
 
 func (NethelpersRouteType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[23].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
 }
 
 func (NethelpersRouteType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[23]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
 }
 
 func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
@@ -1669,7 +1834,7 @@ func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteType.Descriptor instead.
 func (NethelpersRouteType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
 }
 
 // NethelpersRoutingTable is a routing table ID.
@@ -1709,11 +1874,11 @@ func (x NethelpersRoutingTable) String() string {
 }
 
 func (NethelpersRoutingTable) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[27].Descriptor()
 }
 
 func (NethelpersRoutingTable) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[27]
 }
 
 func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
@@ -1722,7 +1887,7 @@ func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRoutingTable.Descriptor instead.
 func (NethelpersRoutingTable) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{27}
 }
 
 // NethelpersScope is an address scope.
@@ -1765,11 +1930,11 @@ func (x NethelpersScope) String() string {
 }
 
 func (NethelpersScope) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[28].Descriptor()
 }
 
 func (NethelpersScope) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[28]
 }
 
 func (x NethelpersScope) Number() protoreflect.EnumNumber {
@@ -1778,7 +1943,7 @@ func (x NethelpersScope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersScope.Descriptor instead.
 func (NethelpersScope) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{28}
 }
 
 // NethelpersVLANProtocol is a VLAN protocol.
@@ -1815,11 +1980,11 @@ func (x NethelpersVLANProtocol) String() string {
 }
 
 func (NethelpersVLANProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[29].Descriptor()
 }
 
 func (NethelpersVLANProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[29]
 }
 
 func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
@@ -1828,7 +1993,75 @@ func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersVLANProtocol.Descriptor instead.
 func (NethelpersVLANProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{29}
+}
+
+// NethelpersWOLMode wraps WAKE_* (Wake-on-LAN) constants.
+type NethelpersWOLMode int32
+
+const (
+	NethelpersWOLMode_NETHELPERS_WOLMODE_UNSPECIFIED NethelpersWOLMode = 0
+	NethelpersWOLMode_WOL_MODE_PHY                   NethelpersWOLMode = 1
+	NethelpersWOLMode_WOL_MODE_UNICAST               NethelpersWOLMode = 2
+	NethelpersWOLMode_WOL_MODE_MULTICAST             NethelpersWOLMode = 4
+	NethelpersWOLMode_WOL_MODE_BROADCAST             NethelpersWOLMode = 8
+	NethelpersWOLMode_WOL_MODE_ARP                   NethelpersWOLMode = 16
+	NethelpersWOLMode_WOL_MODE_MAGIC                 NethelpersWOLMode = 32
+	NethelpersWOLMode_WOL_MODE_MAGIC_SECURE          NethelpersWOLMode = 64
+	NethelpersWOLMode_WOL_MODE_FILTER                NethelpersWOLMode = 128
+)
+
+// Enum value maps for NethelpersWOLMode.
+var (
+	NethelpersWOLMode_name = map[int32]string{
+		0:   "NETHELPERS_WOLMODE_UNSPECIFIED",
+		1:   "WOL_MODE_PHY",
+		2:   "WOL_MODE_UNICAST",
+		4:   "WOL_MODE_MULTICAST",
+		8:   "WOL_MODE_BROADCAST",
+		16:  "WOL_MODE_ARP",
+		32:  "WOL_MODE_MAGIC",
+		64:  "WOL_MODE_MAGIC_SECURE",
+		128: "WOL_MODE_FILTER",
+	}
+	NethelpersWOLMode_value = map[string]int32{
+		"NETHELPERS_WOLMODE_UNSPECIFIED": 0,
+		"WOL_MODE_PHY":                   1,
+		"WOL_MODE_UNICAST":               2,
+		"WOL_MODE_MULTICAST":             4,
+		"WOL_MODE_BROADCAST":             8,
+		"WOL_MODE_ARP":                   16,
+		"WOL_MODE_MAGIC":                 32,
+		"WOL_MODE_MAGIC_SECURE":          64,
+		"WOL_MODE_FILTER":                128,
+	}
+)
+
+func (x NethelpersWOLMode) Enum() *NethelpersWOLMode {
+	p := new(NethelpersWOLMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersWOLMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersWOLMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[30].Descriptor()
+}
+
+func (NethelpersWOLMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[30]
+}
+
+func (x NethelpersWOLMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersWOLMode.Descriptor instead.
+func (NethelpersWOLMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{30}
 }
 
 // BlockEncryptionKeyType describes encryption key type.
@@ -1839,6 +2072,7 @@ const (
 	BlockEncryptionKeyType_ENCRYPTION_KEY_NODE_ID BlockEncryptionKeyType = 1
 	BlockEncryptionKeyType_ENCRYPTION_KEY_KMS     BlockEncryptionKeyType = 2
 	BlockEncryptionKeyType_ENCRYPTION_KEY_TPM     BlockEncryptionKeyType = 3
+	BlockEncryptionKeyType_ENCRYPTION_KEY_VAULT   BlockEncryptionKeyType = 4
 )
 
 // Enum value maps for BlockEncryptionKeyType.
@@ -1848,12 +2082,14 @@ var (
 		1: "ENCRYPTION_KEY_NODE_ID",
 		2: "ENCRYPTION_KEY_KMS",
 		3: "ENCRYPTION_KEY_TPM",
+		4: "ENCRYPTION_KEY_VAULT",
 	}
 	BlockEncryptionKeyType_value = map[string]int32{
 		"ENCRYPTION_KEY_STATIC":  0,
 		"ENCRYPTION_KEY_NODE_ID": 1,
 		"ENCRYPTION_KEY_KMS":     2,
 		"ENCRYPTION_KEY_TPM":     3,
+		"ENCRYPTION_KEY_VAULT":   4,
 	}
 )
 
@@ -1868,11 +2104,11 @@ func (x BlockEncryptionKeyType) String() string {
 }
 
 func (BlockEncryptionKeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[27].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[31].Descriptor()
 }
 
 func (BlockEncryptionKeyType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[27]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[31]
 }
 
 func (x BlockEncryptionKeyType) Number() protoreflect.EnumNumber {
@@ -1881,7 +2117,7 @@ func (x BlockEncryptionKeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockEncryptionKeyType.Descriptor instead.
 func (BlockEncryptionKeyType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{27}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{31}
 }
 
 // BlockEncryptionProviderType describes encryption provider type.
@@ -1915,11 +2151,11 @@ func (x BlockEncryptionProviderType) String() string {
 }
 
 func (BlockEncryptionProviderType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[28].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[32].Descriptor()
 }
 
 func (BlockEncryptionProviderType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[28]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[32]
 }
 
 func (x BlockEncryptionProviderType) Number() protoreflect.EnumNumber {
@@ -1928,7 +2164,7 @@ func (x BlockEncryptionProviderType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockEncryptionProviderType.Descriptor instead.
 func (BlockEncryptionProviderType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{28}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{32}
 }
 
 // BlockFilesystemType describes filesystem type.
@@ -1940,6 +2176,8 @@ const (
 	BlockFilesystemType_FILESYSTEM_TYPE_VFAT    BlockFilesystemType = 2
 	BlockFilesystemType_FILESYSTEM_TYPE_EXT4    BlockFilesystemType = 3
 	BlockFilesystemType_FILESYSTEM_TYPE_ISO9660 BlockFilesystemType = 4
+	BlockFilesystemType_FILESYSTEM_TYPE_BTRFS   BlockFilesystemType = 5
+	BlockFilesystemType_FILESYSTEM_TYPE_SWAP    BlockFilesystemType = 6
 )
 
 // Enum value maps for BlockFilesystemType.
@@ -1950,6 +2188,8 @@ var (
 		2: "FILESYSTEM_TYPE_VFAT",
 		3: "FILESYSTEM_TYPE_EXT4",
 		4: "FILESYSTEM_TYPE_ISO9660",
+		5: "FILESYSTEM_TYPE_BTRFS",
+		6: "FILESYSTEM_TYPE_SWAP",
 	}
 	BlockFilesystemType_value = map[string]int32{
 		"FILESYSTEM_TYPE_NONE":    0,
@@ -1957,6 +2197,8 @@ var (
 		"FILESYSTEM_TYPE_VFAT":    2,
 		"FILESYSTEM_TYPE_EXT4":    3,
 		"FILESYSTEM_TYPE_ISO9660": 4,
+		"FILESYSTEM_TYPE_BTRFS":   5,
+		"FILESYSTEM_TYPE_SWAP":    6,
 	}
 )
 
@@ -1971,11 +2213,11 @@ func (x BlockFilesystemType) String() string {
 }
 
 func (BlockFilesystemType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[29].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[33].Descriptor()
 }
 
 func (BlockFilesystemType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[29]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[33]
 }
 
 func (x BlockFilesystemType) Number() protoreflect.EnumNumber {
@@ -1984,7 +2226,7 @@ func (x BlockFilesystemType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockFilesystemType.Descriptor instead.
 func (BlockFilesystemType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{29}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{33}
 }
 
 // BlockVolumePhase describes volume phase.
@@ -2036,11 +2278,11 @@ func (x BlockVolumePhase) String() string {
 }
 
 func (BlockVolumePhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[30].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[34].Descriptor()
 }
 
 func (BlockVolumePhase) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[30]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[34]
 }
 
 func (x BlockVolumePhase) Number() protoreflect.EnumNumber {
@@ -2049,7 +2291,7 @@ func (x BlockVolumePhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockVolumePhase.Descriptor instead.
 func (BlockVolumePhase) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{30}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{34}
 }
 
 // BlockVolumeType describes volume type.
@@ -2059,6 +2301,8 @@ const (
 	BlockVolumeType_VOLUME_TYPE_PARTITION BlockVolumeType = 0
 	BlockVolumeType_VOLUME_TYPE_DISK      BlockVolumeType = 1
 	BlockVolumeType_VOLUME_TYPE_TMPFS     BlockVolumeType = 2
+	BlockVolumeType_VOLUME_TYPE_LVM       BlockVolumeType = 3
+	BlockVolumeType_VOLUME_TYPE_RAID      BlockVolumeType = 4
 )
 
 // Enum value maps for BlockVolumeType.
@@ -2067,11 +2311,15 @@ var (
 		0: "VOLUME_TYPE_PARTITION",
 		1: "VOLUME_TYPE_DISK",
 		2: "VOLUME_TYPE_TMPFS",
+		3: "VOLUME_TYPE_LVM",
+		4: "VOLUME_TYPE_RAID",
 	}
 	BlockVolumeType_value = map[string]int32{
 		"VOLUME_TYPE_PARTITION": 0,
 		"VOLUME_TYPE_DISK":      1,
 		"VOLUME_TYPE_TMPFS":     2,
+		"VOLUME_TYPE_LVM":       3,
+		"VOLUME_TYPE_RAID":      4,
 	}
 )
 
@@ -2086,11 +2334,11 @@ func (x BlockVolumeType) String() string {
 }
 
 func (BlockVolumeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[31].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[35].Descriptor()
 }
 
 func (BlockVolumeType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[31]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[35]
 }
 
 func (x BlockVolumeType) Number() protoreflect.EnumNumber {
@@ -2099,7 +2347,7 @@ func (x BlockVolumeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockVolumeType.Descriptor instead.
 func (BlockVolumeType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{31}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{35}
 }
 
 // KubespanPeerState is KubeSpan peer current state.
@@ -2136,11 +2384,11 @@ func (x KubespanPeerState) String() string {
 }
 
 func (KubespanPeerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[32].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[36].Descriptor()
 }
 
 func (KubespanPeerState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[32]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[36]
 }
 
 func (x KubespanPeerState) Number() protoreflect.EnumNumber {
@@ -2149,7 +2397,7 @@ func (x KubespanPeerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubespanPeerState.Descriptor instead.
 func (KubespanPeerState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{32}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{36}
 }
 
 // NetworkConfigLayer describes network configuration layers, with lowest priority first.
@@ -2192,11 +2440,11 @@ func (x NetworkConfigLayer) String() string {
 }
 
 func (NetworkConfigLayer) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[33].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[37].Descriptor()
 }
 
 func (NetworkConfigLayer) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[33]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[37]
 }
 
 func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
@@ -2205,7 +2453,7 @@ func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkConfigLayer.Descriptor instead.
 func (NetworkConfigLayer) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{33}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{37}
 }
 
 // NetworkOperator enumerates Talos network operators.
@@ -2242,11 +2490,11 @@ func (x NetworkOperator) String() string {
 }
 
 func (NetworkOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[34].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[38].Descriptor()
 }
 
 func (NetworkOperator) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[34]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[38]
 }
 
 func (x NetworkOperator) Number() protoreflect.EnumNumber {
@@ -2255,7 +2503,7 @@ func (x NetworkOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkOperator.Descriptor instead.
 func (NetworkOperator) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{34}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{38}
 }
 
 // RuntimeMachineStage describes the stage of the machine boot/run process.
@@ -2310,11 +2558,11 @@ func (x RuntimeMachineStage) String() string {
 }
 
 func (RuntimeMachineStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[35].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[39].Descriptor()
 }
 
 func (RuntimeMachineStage) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[35]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[39]
 }
 
 func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
@@ -2323,7 +2571,7 @@ func (x RuntimeMachineStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuntimeMachineStage.Descriptor instead.
 func (RuntimeMachineStage) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{35}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{39}
 }
 
 var File_resource_definitions_enums_enums_proto protoreflect.FileDescriptor
@@ -2414,371 +2662,422 @@ var file_resource_definitions_enums_enums_proto_rawDesc = []byte{
 	0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c,
 	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x4e, 0x54,
 	0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x10, 0x01, 0x2a, 0x34, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65,
-	0x72, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x4c, 0x46,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xff, 0x01, 0x2a, 0x63, 0x0a, 0x15, 0x4e, 0x65,
-	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x76, 0x65, 0x72,
-	0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52,
-	0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46,
-	0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56,
-	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a,
-	0x59, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x46, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52,
-	0x53, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59,
-	0x5f, 0x49, 0x4e, 0x45, 0x54, 0x34, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4d, 0x49,
-	0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x36, 0x10, 0x0a, 0x2a, 0x3c, 0x0a, 0x12, 0x4e, 0x65,
-	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x41, 0x43, 0x50, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c,
-	0x4f, 0x57, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54,
-	0x45, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x93, 0x0b, 0x0a, 0x12, 0x4e, 0x65, 0x74,
-	0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x52, 0x4f, 0x4d, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x54, 0x48, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x45, 0x54, 0x48, 0x45, 0x52, 0x10,
-	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x58, 0x32, 0x35, 0x10, 0x03,
-	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x4e, 0x45, 0x54, 0x10,
-	0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4f, 0x53, 0x10,
-	0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32,
-	0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x52, 0x43, 0x4e, 0x45,
-	0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x41, 0x4c,
-	0x4b, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x4c, 0x43, 0x49,
-	0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x4d, 0x10, 0x13,
-	0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x4f,
-	0x4d, 0x10, 0x17, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x45,
-	0x31, 0x33, 0x39, 0x34, 0x10, 0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45,
-	0x55, 0x49, 0x36, 0x34, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49,
-	0x4e, 0x46, 0x49, 0x4e, 0x49, 0x42, 0x41, 0x4e, 0x44, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x09, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x80, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x81, 0x02, 0x12, 0x0f, 0x0a, 0x0a,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x82, 0x02, 0x12, 0x10, 0x0a,
-	0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x83, 0x02, 0x12,
-	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x53, 0x52, 0x56, 0x44, 0x10, 0x84, 0x02,
-	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x10, 0x88,
-	0x02, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x53, 0x45, 0x10, 0x8e,
-	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x58, 0x32, 0x35, 0x10, 0x8f, 0x02,
-	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x57, 0x58, 0x32, 0x35, 0x10, 0x90,
-	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x4e, 0x10, 0x98, 0x02,
-	0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x50, 0x50, 0x10, 0x80, 0x04, 0x12,
-	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x81, 0x04,
-	0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x44, 0x4c, 0x43, 0x10, 0x81, 0x04,
-	0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x41, 0x50, 0x42, 0x10, 0x84, 0x04,
-	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x44, 0x43, 0x4d, 0x50, 0x10, 0x85,
-	0x04, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x41, 0x57, 0x48, 0x44, 0x4c,
-	0x43, 0x10, 0x86, 0x04, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x55, 0x4e,
-	0x4e, 0x45, 0x4c, 0x10, 0x80, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54,
-	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x36, 0x10, 0x81, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x46, 0x52, 0x41, 0x44, 0x10, 0x82, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x83, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x43, 0x4b, 0x10, 0x84, 0x06, 0x12, 0x12, 0x0a, 0x0d,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x54, 0x4c, 0x4b, 0x10, 0x85, 0x06,
-	0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x44, 0x44, 0x49, 0x10, 0x86, 0x06,
-	0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x87, 0x06, 0x12,
-	0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x49, 0x54, 0x10, 0x88, 0x06, 0x12, 0x0f,
-	0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x44, 0x44, 0x50, 0x10, 0x89, 0x06, 0x12,
-	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x47, 0x52, 0x45, 0x10, 0x8a, 0x06,
-	0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x49, 0x4d, 0x52, 0x45, 0x47, 0x10,
-	0x8b, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x49, 0x50, 0x50, 0x49,
-	0x10, 0x8c, 0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x53, 0x48, 0x10,
-	0x8d, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x43, 0x4f, 0x4e, 0x45,
-	0x54, 0x10, 0x8e, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x52, 0x44,
-	0x41, 0x10, 0x8f, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x50,
-	0x50, 0x10, 0x90, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x41,
-	0x4c, 0x10, 0x91, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x50,
-	0x4c, 0x10, 0x92, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46,
-	0x41, 0x42, 0x52, 0x49, 0x43, 0x10, 0x93, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x10, 0x94, 0x06, 0x12, 0x13, 0x0a,
-	0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x32, 0x10,
-	0x95, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42,
-	0x52, 0x49, 0x43, 0x33, 0x10, 0x96, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x34, 0x10, 0x97, 0x06, 0x12, 0x13, 0x0a, 0x0e,
-	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x35, 0x10, 0x98,
+	0x49, 0x44, 0x10, 0x01, 0x2a, 0xa8, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x44, 0x6f, 0x74, 0x31, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x44, 0x4f, 0x54, 0x31, 0x5f, 0x58, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x4f, 0x54, 0x31,
+	0x5f, 0x58, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4f, 0x54, 0x31, 0x5f, 0x58, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4f, 0x54, 0x31, 0x5f, 0x58,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x4f, 0x54, 0x31, 0x5f, 0x58,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x34, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x44, 0x75, 0x70,
+	0x6c, 0x65, 0x78, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x4c, 0x46, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0xff, 0x01, 0x2a, 0x63, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x4d, 0x41, 0x43, 0x12, 0x16,
+	0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f,
+	0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41,
+	0x43, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x59, 0x0a, 0x10, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x21,
+	0x0a, 0x1d, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x46, 0x41, 0x4d,
+	0x49, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e, 0x45, 0x54,
+	0x34, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x49, 0x4e,
+	0x45, 0x54, 0x36, 0x10, 0x0a, 0x2a, 0x54, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x73, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x32, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4c, 0x33, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x33, 0x5f, 0x53, 0x10, 0x02, 0x2a, 0x3c, 0x0a, 0x12, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x41, 0x43, 0x50, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x43, 0x50, 0x5f, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x93, 0x0b, 0x0a, 0x12, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x52, 0x4f, 0x4d, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x54, 0x48, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x45, 0x54, 0x48, 0x45, 0x52,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x58, 0x32, 0x35, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x4e, 0x45, 0x54,
+	0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x48, 0x41, 0x4f, 0x53,
+	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30,
+	0x32, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x52, 0x43, 0x4e,
+	0x45, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x41,
+	0x4c, 0x4b, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x4c, 0x43,
+	0x49, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x54, 0x4d, 0x10,
+	0x13, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x4f, 0x4d, 0x10, 0x17, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45,
+	0x45, 0x31, 0x33, 0x39, 0x34, 0x10, 0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x45, 0x55, 0x49, 0x36, 0x34, 0x10, 0x1b, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x49, 0x4e, 0x46, 0x49, 0x4e, 0x49, 0x42, 0x41, 0x4e, 0x44, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x09,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x80, 0x02, 0x12, 0x0f, 0x0a, 0x0a,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x10, 0x81, 0x02, 0x12, 0x0f, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x82, 0x02, 0x12, 0x10,
+	0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x53, 0x4c, 0x49, 0x50, 0x36, 0x10, 0x83, 0x02,
+	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x53, 0x52, 0x56, 0x44, 0x10, 0x84,
+	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x10,
+	0x88, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x4f, 0x53, 0x45, 0x10,
+	0x8e, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x58, 0x32, 0x35, 0x10, 0x8f,
+	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x57, 0x58, 0x32, 0x35, 0x10,
+	0x90, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x4e, 0x10, 0x98,
+	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x50, 0x50, 0x10, 0x80, 0x04,
+	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x49, 0x53, 0x43, 0x4f, 0x10, 0x81,
+	0x04, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x44, 0x4c, 0x43, 0x10, 0x81,
+	0x04, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x41, 0x50, 0x42, 0x10, 0x84,
+	0x04, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x44, 0x43, 0x4d, 0x50, 0x10,
+	0x85, 0x04, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x41, 0x57, 0x48, 0x44,
+	0x4c, 0x43, 0x10, 0x86, 0x04, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x80, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x36, 0x10, 0x81, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x46, 0x52, 0x41, 0x44, 0x10, 0x82, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x83, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x43, 0x4b, 0x10, 0x84, 0x06, 0x12, 0x12, 0x0a,
+	0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x54, 0x4c, 0x4b, 0x10, 0x85,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x44, 0x44, 0x49, 0x10, 0x86,
+	0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x87, 0x06,
+	0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x49, 0x54, 0x10, 0x88, 0x06, 0x12,
+	0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x44, 0x44, 0x50, 0x10, 0x89, 0x06,
+	0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x47, 0x52, 0x45, 0x10, 0x8a,
+	0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x49, 0x4d, 0x52, 0x45, 0x47,
+	0x10, 0x8b, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x48, 0x49, 0x50, 0x50,
+	0x49, 0x10, 0x8c, 0x06, 0x12, 0x0d, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x53, 0x48,
+	0x10, 0x8d, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x45, 0x43, 0x4f, 0x4e,
+	0x45, 0x54, 0x10, 0x8e, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x52,
+	0x44, 0x41, 0x10, 0x8f, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
+	0x50, 0x50, 0x10, 0x90, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
+	0x41, 0x4c, 0x10, 0x91, 0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
+	0x50, 0x4c, 0x10, 0x92, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
+	0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x10, 0x93, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x10, 0x94, 0x06, 0x12, 0x13,
+	0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x32,
+	0x10, 0x95, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41,
+	0x42, 0x52, 0x49, 0x43, 0x33, 0x10, 0x96, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x34, 0x10, 0x97, 0x06, 0x12, 0x13, 0x0a,
+	0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x35, 0x10,
+	0x98, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42,
+	0x52, 0x49, 0x43, 0x36, 0x10, 0x99, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
+	0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x37, 0x10, 0x9a, 0x06, 0x12, 0x13, 0x0a, 0x0e,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x38, 0x10, 0x9b,
 	0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52,
-	0x49, 0x43, 0x36, 0x10, 0x99, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46,
-	0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x37, 0x10, 0x9a, 0x06, 0x12, 0x13, 0x0a, 0x0e, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x38, 0x10, 0x9b, 0x06,
-	0x12, 0x13, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49,
-	0x43, 0x39, 0x10, 0x9c, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43,
-	0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x30, 0x10, 0x9d, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x31, 0x10, 0x9e,
-	0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52,
-	0x49, 0x43, 0x31, 0x32, 0x10, 0x9f, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x54, 0x52, 0x10, 0xa0, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x10, 0xa1, 0x06, 0x12,
-	0x17, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31,
-	0x50, 0x52, 0x49, 0x53, 0x4d, 0x10, 0xa2, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x5f, 0x52, 0x41, 0x44, 0x49, 0x4f, 0x54,
-	0x41, 0x50, 0x10, 0xa3, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45,
-	0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x10, 0xa4, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4c,
-	0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x4d, 0x4f,
-	0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0xa5, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0xb4, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49,
-	0x4e, 0x4b, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x50, 0x49, 0x50, 0x45, 0x10, 0xb5, 0x06,
-	0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x49, 0x46, 0x10, 0xb6, 0x06,
-	0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x36, 0x47, 0x52, 0x45, 0x10,
-	0xb7, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x4c, 0x49,
-	0x4e, 0x4b, 0x10, 0xb8, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x36, 0x5f, 0x4c,
-	0x4f, 0x57, 0x50, 0x41, 0x4e, 0x10, 0xb9, 0x06, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x56, 0x4f, 0x49, 0x44, 0x10, 0xff, 0xff, 0x03, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49, 0x4e,
-	0x4b, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xfe, 0xff, 0x03, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x45,
-	0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x16, 0x0a,
-	0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51,
-	0x55, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x99, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
-	0x70, 0x65, 0x72, 0x73, 0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48,
-	0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
-	0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x49,
-	0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
-	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x15,
-	0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x4f, 0x55, 0x54,
-	0x50, 0x55, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48,
-	0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x04, 0x2a, 0xa3, 0x04, 0x0a, 0x1f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
-	0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x2c, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50,
-	0x45, 0x52, 0x53, 0x5f, 0x4e, 0x46, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x43, 0x48, 0x41, 0x49,
-	0x4e, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x49, 0x4e,
-	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10,
-	0x80, 0x80, 0x80, 0x80, 0xf8, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2c, 0x0a, 0x1f, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x52, 0x41, 0x47, 0x10, 0xf0, 0xfc,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x1f, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49,
-	0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x57, 0x10, 0xd4,
-	0xfd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x48, 0x41,
-	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x5f, 0x4c,
-	0x49, 0x4e, 0x55, 0x58, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x9f, 0xfe, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x25, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50,
-	0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43,
-	0x4b, 0x10, 0xb8, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x22, 0x0a, 0x15,
-	0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d,
-	0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0xea, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
-	0x12, 0x24, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x10, 0x9c, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x32, 0x12, 0x1d,
-	0x0a, 0x19, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59,
-	0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x64, 0x12, 0x21, 0x0a,
-	0x1c, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f,
-	0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xe1, 0x01,
-	0x12, 0x24, 0x0a, 0x1f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x45, 0x4c,
-	0x50, 0x45, 0x52, 0x10, 0xac, 0x02, 0x12, 0x1b, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xff, 0xff,
-	0xff, 0xff, 0x07, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x68, 0x65,
-	0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72,
-	0x64, 0x69, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f,
-	0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43,
-	0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x2a, 0xc9, 0x01, 0x0a, 0x1a, 0x4e,
-	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45,
-	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a,
-	0x0f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e,
-	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x57,
-	0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4f,
-	0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x4d, 0x41, 0x4e,
-	0x54, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x50, 0x10, 0x06, 0x2a, 0x72, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
-	0x70, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57, 0x49, 0x53,
-	0x54, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x55,
-	0x49, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x49, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
-	0x46, 0x49, 0x42, 0x52, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x43, 0x10, 0x04,
-	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43,
-	0x48, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xef, 0x01, 0x12, 0x0a,
-	0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x73, 0x0a, 0x19, 0x4e, 0x65,
-	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d, 0x41,
-	0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x57, 0x41,
-	0x59, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f,
-	0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10,
-	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53,
-	0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a,
-	0x86, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c,
-	0x50, 0x45, 0x52, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x10,
-	0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x06,
-	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44, 0x50,
-	0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49,
-	0x43, 0x4d, 0x5f, 0x50, 0x56, 0x36, 0x10, 0x3a, 0x2a, 0xdf, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74,
-	0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x52,
-	0x4f, 0x55, 0x54, 0x45, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f,
-	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x80, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55,
-	0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x80, 0x04, 0x12, 0x13, 0x0a, 0x0e,
-	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x10, 0x80,
-	0x08, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49,
-	0x58, 0x10, 0x80, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4c, 0x4f,
-	0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x80, 0x20, 0x12, 0x14, 0x0a,
-	0x0f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
-	0x10, 0x80, 0x40, 0x12, 0x13, 0x0a, 0x0d, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46,
-	0x4c, 0x4f, 0x41, 0x44, 0x10, 0x80, 0x80, 0x01, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x4f, 0x55, 0x54,
-	0x45, 0x5f, 0x54, 0x52, 0x41, 0x50, 0x10, 0x80, 0x80, 0x02, 0x2a, 0xd2, 0x03, 0x0a, 0x17, 0x4e,
-	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
-	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b,
-	0x45, 0x52, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x04, 0x12,
-	0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x41, 0x10, 0x09,
-	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54,
-	0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x5a,
-	0x45, 0x42, 0x52, 0x41, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x42, 0x49, 0x52, 0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f,
-	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x4e, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x44, 0x10, 0x0d,
-	0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x58, 0x4f, 0x52,
-	0x50, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
-	0x4e, 0x54, 0x4b, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54, 0x44, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x41, 0x4c, 0x49, 0x56,
-	0x45, 0x44, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
-	0x5f, 0x42, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x2a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x52, 0x10, 0x63, 0x12, 0x11, 0x0a, 0x0c,
-	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x47, 0x50, 0x10, 0xba, 0x01, 0x12,
-	0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x53, 0x49, 0x53,
-	0x10, 0xbb, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
-	0x4f, 0x53, 0x50, 0x46, 0x10, 0xbc, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x49, 0x50, 0x10, 0xbd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x49, 0x47, 0x52, 0x50, 0x10, 0xc0, 0x01, 0x2a,
-	0xf1, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x10,
-	0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04,
-	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x41,
-	0x43, 0x4b, 0x48, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x11,
-	0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10,
-	0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x57, 0x10,
-	0x09, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x54, 0x10, 0x0a, 0x12,
-	0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
-	0x45, 0x10, 0x0b, 0x2a, 0x61, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
-	0x73, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a,
-	0x0c, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0d, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x49,
-	0x4e, 0x10, 0xfe, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c, 0x4f,
-	0x43, 0x41, 0x4c, 0x10, 0xff, 0x01, 0x2a, 0x6a, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c,
-	0x70, 0x65, 0x72, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43, 0x4f,
-	0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a, 0x53,
-	0x43, 0x4f, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x54, 0x45, 0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a,
-	0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a,
-	0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x12, 0x12,
-	0x0a, 0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10,
-	0xff, 0x01, 0x2a, 0x78, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73,
-	0x56, 0x4c, 0x41, 0x4e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x23,
-	0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x56, 0x4c, 0x41, 0x4e, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x13, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x51, 0x10, 0x80, 0x82, 0x02,
-	0x12, 0x1a, 0x0a, 0x14, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x41, 0x44, 0x10, 0xa8, 0x91, 0x02, 0x2a, 0x7f, 0x0a, 0x16,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
-	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a,
-	0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
-	0x4b, 0x4d, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x50, 0x4d, 0x10, 0x03, 0x2a, 0x5a, 0x0a,
-	0x1b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18,
-	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
-	0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e,
-	0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45,
-	0x52, 0x5f, 0x4c, 0x55, 0x4b, 0x53, 0x32, 0x10, 0x01, 0x2a, 0x99, 0x01, 0x0a, 0x13, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46,
-	0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58,
-	0x46, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54,
-	0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x46, 0x41, 0x54, 0x10, 0x02, 0x12, 0x18,
-	0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x45, 0x58, 0x54, 0x34, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x4c, 0x45,
-	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x4f, 0x39,
-	0x36, 0x36, 0x30, 0x10, 0x04, 0x2a, 0xe3, 0x01, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4f,
-	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50,
-	0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x49,
-	0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
-	0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53,
-	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x19, 0x0a, 0x15, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
-	0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f,
-	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
-	0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41,
-	0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x59, 0x0a, 0x0f, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
-	0x0a, 0x15, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x4f, 0x4c,
-	0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x10, 0x01, 0x12,
-	0x15, 0x0a, 0x11, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
-	0x4d, 0x50, 0x46, 0x53, 0x10, 0x02, 0x2a, 0x53, 0x0a, 0x11, 0x4b, 0x75, 0x62, 0x65, 0x73, 0x70,
-	0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x50,
-	0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x88, 0x01, 0x0a, 0x12,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x61, 0x79,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x43, 0x4d, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x02, 0x12,
-	0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
-	0x4f, 0x52, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d,
-	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x34, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x36, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x56, 0x49,
-	0x50, 0x10, 0x02, 0x2a, 0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4d,
-	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x47, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
-	0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45,
-	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x19,
-	0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
-	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43,
-	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x42, 0x4f, 0x4f,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49,
-	0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x08, 0x42, 0x74, 0x0a, 0x28, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x5a, 0x48, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x43, 0x39, 0x10, 0x9c, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46,
+	0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x30, 0x10, 0x9d, 0x06, 0x12, 0x14, 0x0a, 0x0f,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42, 0x52, 0x49, 0x43, 0x31, 0x31, 0x10,
+	0x9e, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x43, 0x46, 0x41, 0x42,
+	0x52, 0x49, 0x43, 0x31, 0x32, 0x10, 0x9f, 0x06, 0x12, 0x12, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x54, 0x52, 0x10, 0xa0, 0x06, 0x12, 0x12, 0x0a, 0x0d,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x10, 0xa1, 0x06,
+	0x12, 0x17, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31,
+	0x31, 0x50, 0x52, 0x49, 0x53, 0x4d, 0x10, 0xa2, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x5f, 0x52, 0x41, 0x44, 0x49, 0x4f,
+	0x54, 0x41, 0x50, 0x10, 0xa3, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49,
+	0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x10, 0xa4, 0x06, 0x12, 0x1b, 0x0a, 0x16,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x45, 0x45, 0x38, 0x30, 0x32, 0x31, 0x31, 0x35, 0x34, 0x4d,
+	0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0xa5, 0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0xb4, 0x06, 0x12, 0x14, 0x0a, 0x0f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x54, 0x50, 0x49, 0x50, 0x45, 0x10, 0xb5,
+	0x06, 0x12, 0x0e, 0x0a, 0x09, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x41, 0x49, 0x46, 0x10, 0xb6,
+	0x06, 0x12, 0x10, 0x0a, 0x0b, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x49, 0x50, 0x36, 0x47, 0x52, 0x45,
+	0x10, 0xb7, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x45, 0x54, 0x4c,
+	0x49, 0x4e, 0x4b, 0x10, 0xb8, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x36, 0x5f,
+	0x4c, 0x4f, 0x57, 0x50, 0x41, 0x4e, 0x10, 0xb9, 0x06, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x10, 0xff, 0xff, 0x03, 0x12, 0x0f, 0x0a, 0x09, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xfe, 0xff, 0x03, 0x1a, 0x02, 0x10, 0x01, 0x2a,
+	0xbd, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4d, 0x41,
+	0x43, 0x56, 0x4c, 0x41, 0x4e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x45, 0x54,
+	0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d,
+	0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x50, 0x41,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x4d,
+	0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x54, 0x48, 0x52, 0x55, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x10, 0x2a,
+	0x45, 0x0a, 0x17, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
+	0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x99, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x4f, 0x55,
+	0x54, 0x50, 0x55, 0x54, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x04, 0x2a, 0xa3, 0x04, 0x0a, 0x1f, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
+	0x73, 0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x2c, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c,
+	0x50, 0x45, 0x52, 0x53, 0x5f, 0x4e, 0x46, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x10, 0x80, 0x80, 0x80, 0x80, 0xf8, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2c, 0x0a, 0x1f, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x52, 0x41, 0x47, 0x10, 0xf0,
+	0xfc, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x1f, 0x0a, 0x12, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x57, 0x10,
+	0xd4, 0xfd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x55, 0x58, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x9f, 0xfe, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x25, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41,
+	0x43, 0x4b, 0x10, 0xb8, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x22, 0x0a,
+	0x15, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0xea, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x12, 0x24, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x10, 0x9c, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x32, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x64, 0x12, 0x21,
+	0x0a, 0x1c, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xe1,
+	0x01, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x45,
+	0x4c, 0x50, 0x45, 0x52, 0x10, 0xac, 0x02, 0x12, 0x1b, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0xff,
+	0xff, 0xff, 0xff, 0x07, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x68,
+	0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4e, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x56, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x45, 0x52, 0x44, 0x49,
+	0x43, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x2a, 0xc9, 0x01, 0x0a, 0x1a,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x4f,
+	0x57, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12,
+	0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x52, 0x4d, 0x41,
+	0x4e, 0x54, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x06, 0x2a, 0x72, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x57, 0x49,
+	0x53, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x55, 0x49, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x49, 0x49, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x46, 0x49, 0x42, 0x52, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x43, 0x10,
+	0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x41,
+	0x43, 0x48, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0xef, 0x01, 0x12,
+	0x0a, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x73, 0x0a, 0x19, 0x4e,
+	0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d,
+	0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x57,
+	0x41, 0x59, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59,
+	0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x2a, 0x86, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x45, 0x54, 0x48, 0x45,
+	0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10,
+	0x06, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44,
+	0x50, 0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x49, 0x43, 0x4d, 0x5f, 0x50, 0x56, 0x36, 0x10, 0x3a, 0x2a, 0xdf, 0x01, 0x0a, 0x13, 0x4e, 0x65,
+	0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x80, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x80, 0x04, 0x12, 0x13, 0x0a,
+	0x0e, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x10,
+	0x80, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x46,
+	0x49, 0x58, 0x10, 0x80, 0x10, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4c,
+	0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x80, 0x20, 0x12, 0x14,
+	0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x80, 0x40, 0x12, 0x13, 0x0a, 0x0d, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x4f, 0x46,
+	0x46, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x80, 0x80, 0x01, 0x12, 0x10, 0x0a, 0x0a, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x50, 0x10, 0x80, 0x80, 0x02, 0x2a, 0xd2, 0x03, 0x0a, 0x17,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x4b, 0x45, 0x52, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x04,
+	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x41, 0x10,
+	0x09, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52,
+	0x54, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x5a, 0x45, 0x42, 0x52, 0x41, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x49, 0x52, 0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x4e, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x44, 0x10,
+	0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x58, 0x4f,
+	0x52, 0x50, 0x10, 0x0e, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x4e, 0x54, 0x4b, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x44, 0x48, 0x43, 0x50, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4d, 0x52, 0x54, 0x44, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x41, 0x4c, 0x49,
+	0x56, 0x45, 0x44, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x42, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x2a, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x52, 0x10, 0x63, 0x12, 0x11, 0x0a,
+	0x0c, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x47, 0x50, 0x10, 0xba, 0x01,
+	0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x53, 0x49,
+	0x53, 0x10, 0xbb, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x4f, 0x53, 0x50, 0x46, 0x10, 0xbc, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x49, 0x50, 0x10, 0xbd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x45, 0x49, 0x47, 0x52, 0x50, 0x10, 0xc0, 0x01,
+	0x2a, 0xf1, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43,
+	0x41, 0x53, 0x54, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c,
+	0x41, 0x43, 0x4b, 0x48, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x12,
+	0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54,
+	0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x57,
+	0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x54, 0x10, 0x0a,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c,
+	0x56, 0x45, 0x10, 0x0b, 0x2a, 0x61, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65,
+	0x72, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0d, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4d, 0x41,
+	0x49, 0x4e, 0x10, 0xfe, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x10, 0xff, 0x01, 0x2a, 0x6a, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a,
+	0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x54, 0x45, 0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a,
+	0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f,
+	0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x12,
+	0x12, 0x0a, 0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x57, 0x48, 0x45, 0x52, 0x45,
+	0x10, 0xff, 0x01, 0x2a, 0x78, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72,
+	0x73, 0x56, 0x4c, 0x41, 0x4e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x27, 0x0a,
+	0x23, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52, 0x53, 0x5f, 0x56, 0x4c, 0x41, 0x4e,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x13, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x51, 0x10, 0x80, 0x82,
+	0x02, 0x12, 0x1a, 0x0a, 0x14, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x38, 0x30, 0x32, 0x31, 0x5f, 0x41, 0x44, 0x10, 0xa8, 0x91, 0x02, 0x2a, 0xe6, 0x01,
+	0x0a, 0x11, 0x4e, 0x65, 0x74, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x57, 0x4f, 0x4c, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x45, 0x54, 0x48, 0x45, 0x4c, 0x50, 0x45, 0x52,
+	0x53, 0x5f, 0x57, 0x4f, 0x4c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x4f, 0x4c, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x48, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x4f, 0x4c,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x57, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x4c, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x08, 0x12,
+	0x10, 0x0a, 0x0c, 0x57, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x52, 0x50, 0x10,
+	0x10, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x41,
+	0x47, 0x49, 0x43, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4d, 0x41, 0x47, 0x49, 0x43, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x10, 0x40,
+	0x12, 0x14, 0x0a, 0x0f, 0x57, 0x4f, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x49, 0x4c,
+	0x54, 0x45, 0x52, 0x10, 0x80, 0x01, 0x2a, 0x99, 0x01, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52,
+	0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4b, 0x4d, 0x53, 0x10, 0x02,
+	0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x54, 0x50, 0x4d, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x43, 0x52,
+	0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x04, 0x2a, 0x5a, 0x0a, 0x1b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x55, 0x4b, 0x53, 0x32, 0x10, 0x01, 0x2a, 0xce,
+	0x01, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59,
+	0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x58, 0x46, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c,
+	0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x46, 0x41,
+	0x54, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x34, 0x10, 0x03, 0x12, 0x1b, 0x0a,
+	0x17, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x49, 0x53, 0x4f, 0x39, 0x36, 0x36, 0x30, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x49,
+	0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x54,
+	0x52, 0x46, 0x53, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x10, 0x06, 0x2a,
+	0xe3, 0x01, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
+	0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x56,
+	0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x56,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x4f, 0x4c,
+	0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13,
+	0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x84, 0x01, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x4f, 0x4c,
+	0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4f,
+	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4d, 0x50, 0x46, 0x53, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x56, 0x4d, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x10, 0x04, 0x2a, 0x53, 0x0a, 0x11,
+	0x4b, 0x75, 0x62, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x45, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10,
+	0x02, 0x2a, 0x88, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x4d, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46,
+	0x4f, 0x52, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x0f,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x48, 0x43, 0x50,
+	0x34, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x44, 0x48, 0x43, 0x50, 0x36, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x56, 0x49, 0x50, 0x10, 0x02, 0x2a, 0x9b, 0x02, 0x0a, 0x13, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x4f,
+	0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x43, 0x48, 0x49,
+	0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e,
+	0x43, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x52, 0x45, 0x42, 0x4f, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x48,
+	0x55, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a,
+	0x17, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x50, 0x47, 0x52,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x42, 0x74, 0x0a, 0x28, 0x64, 0x65, 0x76, 0x2e, 0x74,
+	0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2793,7 +3092,7 @@ func file_resource_definitions_enums_enums_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_enums_enums_proto_rawDescData
 }
 
-var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 36)
+var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 40)
 var file_resource_definitions_enums_enums_proto_goTypes = []any{
 	(MachineType)(0),                     // 0: talos.resource.definitions.enums.MachineType
 	(NethelpersAddressFlag)(0),           // 1: talos.resource.definitions.enums.NethelpersAddressFlag
@@ -2803,34 +3102,38 @@ var file_resource_definitions_enums_enums_proto_goTypes = []any{
 	(NethelpersBondMode)(0),              // 5: talos.resource.definitions.enums.NethelpersBondMode
 	(NethelpersBondXmitHashPolicy)(0),    // 6: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
 	(NethelpersConntrackState)(0),        // 7: talos.resource.definitions.enums.NethelpersConntrackState
-	(NethelpersDuplex)(0),                // 8: talos.resource.definitions.enums.NethelpersDuplex
-	(NethelpersFailOverMAC)(0),           // 9: talos.resource.definitions.enums.NethelpersFailOverMAC
-	(NethelpersFamily)(0),                // 10: talos.resource.definitions.enums.NethelpersFamily
-	(NethelpersLACPRate)(0),              // 11: talos.resource.definitions.enums.NethelpersLACPRate
-	(NethelpersLinkType)(0),              // 12: talos.resource.definitions.enums.NethelpersLinkType
-	(NethelpersMatchOperator)(0),         // 13: talos.resource.definitions.enums.NethelpersMatchOperator
-	(NethelpersNfTablesChainHook)(0),     // 14: talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	(NethelpersNfTablesChainPriority)(0), // 15: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	(NethelpersNfTablesVerdict)(0),       // 16: talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	(NethelpersOperationalState)(0),      // 17: talos.resource.definitions.enums.NethelpersOperationalState
-	(NethelpersPort)(0),                  // 18: talos.resource.definitions.enums.NethelpersPort
-	(NethelpersPrimaryReselect)(0),       // 19: talos.resource.definitions.enums.NethelpersPrimaryReselect
-	(NethelpersProtocol)(0),              // 20: talos.resource.definitions.enums.NethelpersProtocol
-	(NethelpersRouteFlag)(0),             // 21: talos.resource.definitions.enums.NethelpersRouteFlag
-	(NethelpersRouteProtocol)(0),         // 22: talos.resource.definitions.enums.NethelpersRouteProtocol
-	(NethelpersRouteType)(0),             // 23: talos.resource.definitions.enums.NethelpersRouteType
-	(NethelpersRoutingTable)(0),          // 24: talos.resource.definitions.enums.NethelpersRoutingTable
-	(NethelpersScope)(0),                 // 25: talos.resource.definitions.enums.NethelpersScope
-	(NethelpersVLANProtocol)(0),          // 26: talos.resource.definitions.enums.NethelpersVLANProtocol
-	(BlockEncryptionKeyType)(0),          // 27: talos.resource.definitions.enums.BlockEncryptionKeyType
-	(BlockEncryptionProviderType)(0),     // 28: talos.resource.definitions.enums.BlockEncryptionProviderType
-	(BlockFilesystemType)(0),             // 29: talos.resource.definitions.enums.BlockFilesystemType
-	(BlockVolumePhase)(0),                // 30: talos.resource.definitions.enums.BlockVolumePhase
-	(BlockVolumeType)(0),                 // 31: talos.resource.definitions.enums.BlockVolumeType
-	(KubespanPeerState)(0),               // 32: talos.resource.definitions.enums.KubespanPeerState
-	(NetworkConfigLayer)(0),              // 33: talos.resource.definitions.enums.NetworkConfigLayer
-	(NetworkOperator)(0),                 // 34: talos.resource.definitions.enums.NetworkOperator
-	(RuntimeMachineStage)(0),             // 35: talos.resource.definitions.enums.RuntimeMachineStage
+	(NethelpersDot1XState)(0),            // 8: talos.resource.definitions.enums.NethelpersDot1XState
+	(NethelpersDuplex)(0),                // 9: talos.resource.definitions.enums.NethelpersDuplex
+	(NethelpersFailOverMAC)(0),           // 10: talos.resource.definitions.enums.NethelpersFailOverMAC
+	(NethelpersFamily)(0),                // 11: talos.resource.definitions.enums.NethelpersFamily
+	(NethelpersIPVLANMode)(0),            // 12: talos.resource.definitions.enums.NethelpersIPVLANMode
+	(NethelpersLACPRate)(0),              // 13: talos.resource.definitions.enums.NethelpersLACPRate
+	(NethelpersLinkType)(0),              // 14: talos.resource.definitions.enums.NethelpersLinkType
+	(NethelpersMACVLANMode)(0),           // 15: talos.resource.definitions.enums.NethelpersMACVLANMode
+	(NethelpersMatchOperator)(0),         // 16: talos.resource.definitions.enums.NethelpersMatchOperator
+	(NethelpersNfTablesChainHook)(0),     // 17: talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	(NethelpersNfTablesChainPriority)(0), // 18: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	(NethelpersNfTablesVerdict)(0),       // 19: talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	(NethelpersOperationalState)(0),      // 20: talos.resource.definitions.enums.NethelpersOperationalState
+	(NethelpersPort)(0),                  // 21: talos.resource.definitions.enums.NethelpersPort
+	(NethelpersPrimaryReselect)(0),       // 22: talos.resource.definitions.enums.NethelpersPrimaryReselect
+	(NethelpersProtocol)(0),              // 23: talos.resource.definitions.enums.NethelpersProtocol
+	(NethelpersRouteFlag)(0),             // 24: talos.resource.definitions.enums.NethelpersRouteFlag
+	(NethelpersRouteProtocol)(0),         // 25: talos.resource.definitions.enums.NethelpersRouteProtocol
+	(NethelpersRouteType)(0),             // 26: talos.resource.definitions.enums.NethelpersRouteType
+	(NethelpersRoutingTable)(0),          // 27: talos.resource.definitions.enums.NethelpersRoutingTable
+	(NethelpersScope)(0),                 // 28: talos.resource.definitions.enums.NethelpersScope
+	(NethelpersVLANProtocol)(0),          // 29: talos.resource.definitions.enums.NethelpersVLANProtocol
+	(NethelpersWOLMode)(0),               // 30: talos.resource.definitions.enums.NethelpersWOLMode
+	(BlockEncryptionKeyType)(0),          // 31: talos.resource.definitions.enums.BlockEncryptionKeyType
+	(BlockEncryptionProviderType)(0),     // 32: talos.resource.definitions.enums.BlockEncryptionProviderType
+	(BlockFilesystemType)(0),             // 33: talos.resource.definitions.enums.BlockFilesystemType
+	(BlockVolumePhase)(0),                // 34: talos.resource.definitions.enums.BlockVolumePhase
+	(BlockVolumeType)(0),                 // 35: talos.resource.definitions.enums.BlockVolumeType
+	(KubespanPeerState)(0),               // 36: talos.resource.definitions.enums.KubespanPeerState
+	(NetworkConfigLayer)(0),              // 37: talos.resource.definitions.enums.NetworkConfigLayer
+	(NetworkOperator)(0),                 // 38: talos.resource.definitions.enums.NetworkOperator
+	(RuntimeMachineStage)(0),             // 39: talos.resource.definitions.enums.RuntimeMachineStage
 }
 var file_resource_definitions_enums_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -2850,7 +3153,7 @@ func file_resource_definitions_enums_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_definitions_enums_enums_proto_rawDesc,
-			NumEnums:      36,
+			NumEnums:      40,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -1154,6 +1154,13 @@ func (m *KubeletConfigSpec) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.SwapBehavior) > 0 {
+		i -= len(m.SwapBehavior)
+		copy(dAtA[i:], m.SwapBehavior)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SwapBehavior)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.CredentialProviderConfig != nil {
 		size, err := (*structpb.Struct)(m.CredentialProviderConfig).MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -2818,6 +2825,10 @@ func (m *KubeletConfigSpec) SizeVT() (n int) {
 		l = (*structpb.Struct)(m.CredentialProviderConfig).SizeVT()
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	l = len(m.SwapBehavior)
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -6836,6 +6847,38 @@ func (m *KubeletConfigSpec) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBehavior", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapBehavior = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
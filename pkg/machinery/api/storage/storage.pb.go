@@ -82,6 +82,62 @@ func (Disk_DiskType) EnumDescriptor() ([]byte, []int) {
 	return file_storage_storage_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type BlockDeviceWipeDescriptor_Method int32
+
+const (
+	// Wipe the partition table and filesystem signatures, discard the rest of the device (if supported).
+	BlockDeviceWipeDescriptor_FAST BlockDeviceWipeDescriptor_Method = 0
+	// Overwrite the whole device with zeroes.
+	BlockDeviceWipeDescriptor_ZEROES BlockDeviceWipeDescriptor_Method = 1
+	// Discard (TRIM) all blocks of the device.
+	BlockDeviceWipeDescriptor_DISCARD BlockDeviceWipeDescriptor_Method = 2
+	// Cryptographically erase the device by changing the media encryption key (NVMe only).
+	BlockDeviceWipeDescriptor_CRYPTO_ERASE BlockDeviceWipeDescriptor_Method = 3
+)
+
+// Enum value maps for BlockDeviceWipeDescriptor_Method.
+var (
+	BlockDeviceWipeDescriptor_Method_name = map[int32]string{
+		0: "FAST",
+		1: "ZEROES",
+		2: "DISCARD",
+		3: "CRYPTO_ERASE",
+	}
+	BlockDeviceWipeDescriptor_Method_value = map[string]int32{
+		"FAST":         0,
+		"ZEROES":       1,
+		"DISCARD":      2,
+		"CRYPTO_ERASE": 3,
+	}
+)
+
+func (x BlockDeviceWipeDescriptor_Method) Enum() *BlockDeviceWipeDescriptor_Method {
+	p := new(BlockDeviceWipeDescriptor_Method)
+	*p = x
+	return p
+}
+
+func (x BlockDeviceWipeDescriptor_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockDeviceWipeDescriptor_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_storage_storage_proto_enumTypes[1].Descriptor()
+}
+
+func (BlockDeviceWipeDescriptor_Method) Type() protoreflect.EnumType {
+	return &file_storage_storage_proto_enumTypes[1]
+}
+
+func (x BlockDeviceWipeDescriptor_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockDeviceWipeDescriptor_Method.Descriptor instead.
+func (BlockDeviceWipeDescriptor_Method) EnumDescriptor() ([]byte, []int) {
+	return file_storage_storage_proto_rawDescGZIP(), []int{3, 0}
+}
+
 // Disk represents a disk.
 type Disk struct {
 	state         protoimpl.MessageState
@@ -336,6 +392,199 @@ func (x *DisksResponse) GetMessages() []*Disks {
 	return nil
 }
 
+// BlockDeviceWipeDescriptor describes a block device to wipe.
+type BlockDeviceWipeDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Device name to wipe (e.g. `sda` or `sda5`).
+	//
+	// The device should not be used by any volume.
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Wipe method to use.
+	Method BlockDeviceWipeDescriptor_Method `protobuf:"varint,2,opt,name=method,proto3,enum=storage.BlockDeviceWipeDescriptor_Method" json:"method,omitempty"`
+}
+
+func (x *BlockDeviceWipeDescriptor) Reset() {
+	*x = BlockDeviceWipeDescriptor{}
+	mi := &file_storage_storage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockDeviceWipeDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDeviceWipeDescriptor) ProtoMessage() {}
+
+func (x *BlockDeviceWipeDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_storage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDeviceWipeDescriptor.ProtoReflect.Descriptor instead.
+func (*BlockDeviceWipeDescriptor) Descriptor() ([]byte, []int) {
+	return file_storage_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockDeviceWipeDescriptor) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *BlockDeviceWipeDescriptor) GetMethod() BlockDeviceWipeDescriptor_Method {
+	if x != nil {
+		return x.Method
+	}
+	return BlockDeviceWipeDescriptor_FAST
+}
+
+type BlockDeviceWipeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*BlockDeviceWipeDescriptor `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *BlockDeviceWipeRequest) Reset() {
+	*x = BlockDeviceWipeRequest{}
+	mi := &file_storage_storage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockDeviceWipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDeviceWipeRequest) ProtoMessage() {}
+
+func (x *BlockDeviceWipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_storage_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDeviceWipeRequest.ProtoReflect.Descriptor instead.
+func (*BlockDeviceWipeRequest) Descriptor() ([]byte, []int) {
+	return file_storage_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BlockDeviceWipeRequest) GetDevices() []*BlockDeviceWipeDescriptor {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// BlockDeviceWipeProgress reports the progress of the wipe operation.
+type BlockDeviceWipeProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Device name being wiped.
+	Device string                           `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Method BlockDeviceWipeDescriptor_Method `protobuf:"varint,3,opt,name=method,proto3,enum=storage.BlockDeviceWipeDescriptor_Method" json:"method,omitempty"`
+	// Number of bytes wiped so far.
+	WipedBytes uint64 `protobuf:"varint,4,opt,name=wiped_bytes,json=wipedBytes,proto3" json:"wiped_bytes,omitempty"`
+	// Total size of the device in bytes.
+	TotalBytes uint64 `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Wipe of the device is complete.
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *BlockDeviceWipeProgress) Reset() {
+	*x = BlockDeviceWipeProgress{}
+	mi := &file_storage_storage_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockDeviceWipeProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDeviceWipeProgress) ProtoMessage() {}
+
+func (x *BlockDeviceWipeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_storage_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDeviceWipeProgress.ProtoReflect.Descriptor instead.
+func (*BlockDeviceWipeProgress) Descriptor() ([]byte, []int) {
+	return file_storage_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BlockDeviceWipeProgress) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BlockDeviceWipeProgress) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *BlockDeviceWipeProgress) GetMethod() BlockDeviceWipeDescriptor_Method {
+	if x != nil {
+		return x.Method
+	}
+	return BlockDeviceWipeDescriptor_FAST
+}
+
+func (x *BlockDeviceWipeProgress) GetWipedBytes() uint64 {
+	if x != nil {
+		return x.WipedBytes
+	}
+	return 0
+}
+
+func (x *BlockDeviceWipeProgress) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *BlockDeviceWipeProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
 var File_storage_storage_proto protoreflect.FileDescriptor
 
 var file_storage_storage_proto_rawDesc = []byte{
@@ -380,17 +629,55 @@ var file_storage_storage_proto_rawDesc = []byte{
 	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x08, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x32, 0x49, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x44, 0x69, 0x73, 0x6b,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x4e, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72, 0x6f, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x57, 0x69, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22,
+	0x3d, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x5a, 0x45, 0x52, 0x4f, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x43, 0x52, 0x59, 0x50, 0x54, 0x4f, 0x5f, 0x45, 0x52, 0x41, 0x53, 0x45, 0x10, 0x03, 0x22, 0x56,
+	0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57,
+	0x69, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69,
+	0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x69, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x77, 0x69, 0x70, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x32, 0xa1, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70, 0x65,
+	0x12, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x30, 0x01, 0x42, 0x4e, 0x0a, 0x15, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x61, 0x6c,
+	0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5a, 0x35,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x72,
+	0x6f, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x6c, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -405,28 +692,38 @@ func file_storage_storage_proto_rawDescGZIP() []byte {
 	return file_storage_storage_proto_rawDescData
 }
 
-var file_storage_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_storage_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_storage_storage_proto_goTypes = []any{
-	(Disk_DiskType)(0),      // 0: storage.Disk.DiskType
-	(*Disk)(nil),            // 1: storage.Disk
-	(*Disks)(nil),           // 2: storage.Disks
-	(*DisksResponse)(nil),   // 3: storage.DisksResponse
-	(*common.Metadata)(nil), // 4: common.Metadata
-	(*emptypb.Empty)(nil),   // 5: google.protobuf.Empty
+	(Disk_DiskType)(0),                    // 0: storage.Disk.DiskType
+	(BlockDeviceWipeDescriptor_Method)(0), // 1: storage.BlockDeviceWipeDescriptor.Method
+	(*Disk)(nil),                          // 2: storage.Disk
+	(*Disks)(nil),                         // 3: storage.Disks
+	(*DisksResponse)(nil),                 // 4: storage.DisksResponse
+	(*BlockDeviceWipeDescriptor)(nil),     // 5: storage.BlockDeviceWipeDescriptor
+	(*BlockDeviceWipeRequest)(nil),        // 6: storage.BlockDeviceWipeRequest
+	(*BlockDeviceWipeProgress)(nil),       // 7: storage.BlockDeviceWipeProgress
+	(*common.Metadata)(nil),               // 8: common.Metadata
+	(*emptypb.Empty)(nil),                 // 9: google.protobuf.Empty
 }
 var file_storage_storage_proto_depIdxs = []int32{
-	0, // 0: storage.Disk.type:type_name -> storage.Disk.DiskType
-	4, // 1: storage.Disks.metadata:type_name -> common.Metadata
-	1, // 2: storage.Disks.disks:type_name -> storage.Disk
-	2, // 3: storage.DisksResponse.messages:type_name -> storage.Disks
-	5, // 4: storage.StorageService.Disks:input_type -> google.protobuf.Empty
-	3, // 5: storage.StorageService.Disks:output_type -> storage.DisksResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: storage.Disk.type:type_name -> storage.Disk.DiskType
+	8,  // 1: storage.Disks.metadata:type_name -> common.Metadata
+	2,  // 2: storage.Disks.disks:type_name -> storage.Disk
+	3,  // 3: storage.DisksResponse.messages:type_name -> storage.Disks
+	1,  // 4: storage.BlockDeviceWipeDescriptor.method:type_name -> storage.BlockDeviceWipeDescriptor.Method
+	5,  // 5: storage.BlockDeviceWipeRequest.devices:type_name -> storage.BlockDeviceWipeDescriptor
+	8,  // 6: storage.BlockDeviceWipeProgress.metadata:type_name -> common.Metadata
+	1,  // 7: storage.BlockDeviceWipeProgress.method:type_name -> storage.BlockDeviceWipeDescriptor.Method
+	9,  // 8: storage.StorageService.Disks:input_type -> google.protobuf.Empty
+	6,  // 9: storage.StorageService.BlockDeviceWipe:input_type -> storage.BlockDeviceWipeRequest
+	4,  // 10: storage.StorageService.Disks:output_type -> storage.DisksResponse
+	7,  // 11: storage.StorageService.BlockDeviceWipe:output_type -> storage.BlockDeviceWipeProgress
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_storage_storage_proto_init() }
@@ -439,8 +736,8 @@ func file_storage_storage_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_storage_storage_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
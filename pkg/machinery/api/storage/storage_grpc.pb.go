@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StorageService_Disks_FullMethodName = "/storage.StorageService/Disks"
+	StorageService_Disks_FullMethodName           = "/storage.StorageService/Disks"
+	StorageService_BlockDeviceWipe_FullMethodName = "/storage.StorageService/BlockDeviceWipe"
 )
 
 // StorageServiceClient is the client API for StorageService service.
@@ -31,6 +32,10 @@ const (
 // StorageService represents the storage service.
 type StorageServiceClient interface {
 	Disks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DisksResponse, error)
+	// BlockDeviceWipe wipes the block devices (disks or partitions) which are not used by any volume.
+	//
+	// The progress of the wipe is streamed back to the client.
+	BlockDeviceWipe(ctx context.Context, in *BlockDeviceWipeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockDeviceWipeProgress], error)
 }
 
 type storageServiceClient struct {
@@ -51,6 +56,25 @@ func (c *storageServiceClient) Disks(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *storageServiceClient) BlockDeviceWipe(ctx context.Context, in *BlockDeviceWipeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockDeviceWipeProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageService_ServiceDesc.Streams[0], StorageService_BlockDeviceWipe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BlockDeviceWipeRequest, BlockDeviceWipeProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_BlockDeviceWipeClient = grpc.ServerStreamingClient[BlockDeviceWipeProgress]
+
 // StorageServiceServer is the server API for StorageService service.
 // All implementations must embed UnimplementedStorageServiceServer
 // for forward compatibility.
@@ -58,6 +82,10 @@ func (c *storageServiceClient) Disks(ctx context.Context, in *emptypb.Empty, opt
 // StorageService represents the storage service.
 type StorageServiceServer interface {
 	Disks(context.Context, *emptypb.Empty) (*DisksResponse, error)
+	// BlockDeviceWipe wipes the block devices (disks or partitions) which are not used by any volume.
+	//
+	// The progress of the wipe is streamed back to the client.
+	BlockDeviceWipe(*BlockDeviceWipeRequest, grpc.ServerStreamingServer[BlockDeviceWipeProgress]) error
 	mustEmbedUnimplementedStorageServiceServer()
 }
 
@@ -71,6 +99,9 @@ type UnimplementedStorageServiceServer struct{}
 func (UnimplementedStorageServiceServer) Disks(context.Context, *emptypb.Empty) (*DisksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disks not implemented")
 }
+func (UnimplementedStorageServiceServer) BlockDeviceWipe(*BlockDeviceWipeRequest, grpc.ServerStreamingServer[BlockDeviceWipeProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method BlockDeviceWipe not implemented")
+}
 func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
 func (UnimplementedStorageServiceServer) testEmbeddedByValue()                        {}
 
@@ -110,6 +141,17 @@ func _StorageService_Disks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_BlockDeviceWipe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockDeviceWipeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServiceServer).BlockDeviceWipe(m, &grpc.GenericServerStream[BlockDeviceWipeRequest, BlockDeviceWipeProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_BlockDeviceWipeServer = grpc.ServerStreamingServer[BlockDeviceWipeProgress]
+
 // StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -122,6 +164,12 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _StorageService_Disks_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BlockDeviceWipe",
+			Handler:       _StorageService_BlockDeviceWipe_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "storage/storage.proto",
 }
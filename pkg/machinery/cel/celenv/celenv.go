@@ -6,6 +6,7 @@
 package celenv
 
 import (
+	"net"
 	"slices"
 	"sync"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/block"
+	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/network"
 )
 
 // Empty is an empty CEL environment.
@@ -76,6 +78,35 @@ var VolumeLocator = sync.OnceValue(func() *cel.Env {
 	return env
 })
 
+// LinkLocator is a network link locator CEL environment.
+var LinkLocator = sync.OnceValue(func() *cel.Env {
+	var linkSpec network.LinkStatusSpec
+
+	env, err := cel.NewEnv(
+		cel.Types(&linkSpec),
+		cel.Variable("link", cel.ObjectType(string(linkSpec.ProtoReflect().Descriptor().FullName()))),
+		cel.Function("glob", // glob(pattern, string)
+			cel.Overload("glob_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(arg1, arg2 ref.Val) ref.Val {
+					return types.Bool(glob.Glob(string(arg1.(types.String)), string(arg2.(types.String))))
+				}),
+			),
+		),
+		cel.Function("mac", // mac(bytes)
+			cel.Overload("mac_bytes", []*cel.Type{cel.BytesType}, cel.StringType,
+				cel.UnaryBinding(func(arg ref.Val) ref.Val {
+					return types.String(net.HardwareAddr(arg.(types.Bytes)).String())
+				}),
+			),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return env
+})
+
 type unitMultiplier struct {
 	unit       string
 	multiplier uint64
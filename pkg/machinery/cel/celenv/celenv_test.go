@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/network"
 	"github.com/siderolabs/talos/pkg/machinery/cel"
 	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
 )
@@ -70,3 +71,63 @@ func TestVolumeLocator(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkLocator(t *testing.T) {
+	t.Parallel()
+
+	env := celenv.LinkLocator()
+
+	for _, test := range []struct {
+		name       string
+		expression string
+	}{
+		{
+			name:       "by driver",
+			expression: "link.driver == 'mlx5_core'",
+		},
+		{
+			name:       "by PCI ID",
+			expression: "link.pciid == '15B3:1017' && link.speed_megabits >= 25000",
+		},
+		{
+			name:       "by MAC",
+			expression: "mac(link.permanent_addr) == '00:1a:2b:3c:4d:5e'",
+		},
+		{
+			name:       "glob",
+			expression: "glob('enp*', link.bus_path) || glob('00:1a:*', mac(link.hardware_addr))",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cel.ParseBooleanExpression(test.expression, env)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLinkLocatorEval(t *testing.T) {
+	t.Parallel()
+
+	expr, err := cel.ParseBooleanExpression("mac(link.hardware_addr) == '00:1a:2b:3c:4d:5e' && link.driver == 'ixgbe'", celenv.LinkLocator())
+	require.NoError(t, err)
+
+	matches, err := expr.EvalBool(celenv.LinkLocator(), map[string]any{
+		"link": &network.LinkStatusSpec{
+			HardwareAddr: []byte{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e},
+			Driver:       "ixgbe",
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = expr.EvalBool(celenv.LinkLocator(), map[string]any{
+		"link": &network.LinkStatusSpec{
+			HardwareAddr: []byte{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5f},
+			Driver:       "ixgbe",
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, matches)
+}
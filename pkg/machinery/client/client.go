@@ -1002,3 +1002,15 @@ func (c *Client) ImagePull(ctx context.Context, namespace common.ContainerdNames
 
 	return err
 }
+
+// EncryptionKeySlot manages the key slots of the encrypted volume.
+func (c *Client) EncryptionKeySlot(ctx context.Context, req *machineapi.EncryptionKeySlotRequest, callOptions ...grpc.CallOption) (*machineapi.EncryptionKeySlotResponse, error) {
+	resp, err := c.MachineClient.EncryptionKeySlot(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// BlockDeviceWipe wipes the block devices and streams back the progress.
+func (c *Client) BlockDeviceWipe(ctx context.Context, req *storageapi.BlockDeviceWipeRequest, callOptions ...grpc.CallOption) (storageapi.StorageService_BlockDeviceWipeClient, error) {
+	return c.StorageClient.BlockDeviceWipe(ctx, req, callOptions...)
+}
@@ -14,7 +14,32 @@ type Config interface {
 	ExtensionServiceConfigs() []ExtensionServiceConfig
 	Runtime() RuntimeConfig
 	NetworkRules() NetworkRuleConfig
+	NetworkLinkConfigs() []NetworkLinkConfig
+	NetworkBondConfigs() []NetworkBondConfig
+	NetworkBridgeConfigs() []NetworkBridgeConfig
+	NetworkVLANConfigs() []NetworkVLANConfig
+	NetworkVXLANConfigs() []NetworkVXLANConfig
+	NetworkGENEVEConfigs() []NetworkGENEVEConfig
+	NetworkMACVLANConfigs() []NetworkMACVLANConfig
+	NetworkIPVLANConfigs() []NetworkIPVLANConfig
+	NetworkSRIOVConfigs() []NetworkSRIOVConfig
+	NetworkQdiscConfigs() []NetworkQdiscConfig
+	NetworkDot1XConfigs() []NetworkDot1XConfig
+	NetworkDHCPv4Configs() []NetworkDHCPv4Config
+	NetworkDHCPv6Configs() []NetworkDHCPv6Config
+	NetworkStaticAddressConfigs() []NetworkStaticAddressConfig
+	NetworkRouteConfigs() []NetworkRouteConfig
+	NetworkRoutingRuleConfigs() []NetworkRoutingRuleConfig
+	NetworkProbeConfigs() []NetworkProbeConfig
+	NetworkDNSUpstreamConfigs() []NetworkDNSUpstreamConfig
+	NetworkDNSForwardZoneConfigs() []NetworkDNSForwardZoneConfig
+	NetworkDNSStaticRecordConfigs() []NetworkDNSStaticRecordConfig
+	NetworkDNSCacheConfig() NetworkDNSCacheConfig
+	NetworkLLDPConfig() NetworkLLDPConfig
 	TrustedRoots() TrustedRootsConfig
 	Volumes() VolumesConfig
+	SwapVolumes() []SwapVolumeConfig
+	ZramSwap() ZramSwapConfig
+	ProjectQuotas() []ProjectQuotaConfig
 	KubespanConfig() KubespanConfig
 }
@@ -110,6 +110,7 @@ type Extension interface {
 type Security interface {
 	IssuingCA() *x509.PEMEncodedCertificateAndKey
 	AcceptedCAs() []*x509.PEMEncodedCertificate
+	NodeIdentityCA() *x509.PEMEncodedCertificateAndKey
 	Token() string
 	CertSANs() []string
 }
@@ -177,6 +178,8 @@ type DHCPOptions interface {
 	IPv4() bool
 	IPv6() bool
 	DUIDv6() string
+	Probe() string
+	ProbeFailureMetric() uint32
 }
 
 // VIPConfig contains settings for the Virtual (shared) IP setup.
@@ -287,6 +290,8 @@ type Route interface {
 	Source() string
 	Metric() uint32
 	MTU() uint32
+	Probe() string
+	ProbeFailureMetric() uint32
 }
 
 // KubeSpan configures KubeSpan feature.
@@ -337,6 +342,7 @@ type Kubelet interface {
 	NodeIP() KubeletNodeIP
 	SkipNodeRegistration() bool
 	DisableManifestsDirectory() bool
+	MemorySwap() KubeletMemorySwap
 }
 
 // KubeletNodeIP defines the way node IPs are selected for the kubelet.
@@ -344,6 +350,11 @@ type KubeletNodeIP interface {
 	ValidSubnets() []string
 }
 
+// KubeletMemorySwap defines the swap memory usage by the kubelet workloads.
+type KubeletMemorySwap interface {
+	SwapBehavior() string
+}
+
 // Registries defines the configuration for image fetching.
 type Registries interface {
 	// Mirror config by registry host (first part of image reference).
@@ -387,6 +398,7 @@ type EncryptionKey interface {
 	KMS() EncryptionKeyKMS
 	Slot() int
 	TPM() EncryptionKeyTPM
+	Vault() EncryptionKeyVault
 }
 
 // EncryptionKeyStatic ephemeral encryption key.
@@ -401,6 +413,31 @@ type EncryptionKeyKMS interface {
 	String() string
 }
 
+// EncryptionKeyVault encryption key sealed by the Vault Transit secrets engine.
+type EncryptionKeyVault interface {
+	Address() string
+	CA() []byte
+	TransitMount() string
+	TransitKey() string
+	AppRole() EncryptionKeyVaultAppRole
+	Cert() EncryptionKeyVaultCert
+	String() string
+}
+
+// EncryptionKeyVaultAppRole defines the Vault AppRole auth method settings.
+type EncryptionKeyVaultAppRole interface {
+	MountPath() string
+	RoleID() string
+	SecretID() string
+}
+
+// EncryptionKeyVaultCert defines the Vault TLS certificates auth method settings.
+type EncryptionKeyVaultCert interface {
+	MountPath() string
+	Name() string
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+}
+
 // EncryptionKeyNodeID deterministically generated encryption key.
 type EncryptionKeyNodeID interface {
 	String() string
@@ -6,7 +6,11 @@ package config
 
 import (
 	"net/netip"
+	"time"
 
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -14,16 +18,27 @@ import (
 type NetworkRuleConfig interface {
 	NetworkRuleConfigRules
 	NetworkRuleConfigDefaultAction
+	NetworkRuleConfigNAT
 }
 
 // NetworkRuleConfigRules defines the interface to access network firewall configuration.
 type NetworkRuleConfigRules interface {
 	Rules() []NetworkRule
+	EgressRules() []NetworkRule
+	ForwardRules() []NetworkRule
 }
 
 // NetworkRuleConfigDefaultAction defines the interface to access network firewall configuration.
 type NetworkRuleConfigDefaultAction interface {
 	DefaultAction() nethelpers.DefaultAction
+	EgressDefaultAction() nethelpers.DefaultAction
+	ForwardDefaultAction() nethelpers.DefaultAction
+	LogDrops() bool
+}
+
+// NetworkRuleConfigNAT defines the interface to access network address translation configuration.
+type NetworkRuleConfigNAT interface {
+	NATRules() []NetworkNATRule
 }
 
 // NetworkRuleConfigSignal is used to signal documents which implement either of the NetworkRuleConfig interfaces.
@@ -39,6 +54,21 @@ type NetworkRule interface {
 	ExceptSubnets() []netip.Prefix
 }
 
+// NetworkNATRule defines a network address translation rule.
+//
+// Exactly one of Masquerade, SNAT or DNAT is set.
+type NetworkNATRule interface {
+	InputLink() optional.Optional[string]
+	OutputLink() optional.Optional[string]
+	Source() optional.Optional[netip.Prefix]
+	Destination() optional.Optional[netip.Prefix]
+	Protocol() optional.Optional[nethelpers.Protocol]
+	PortRanges() [][2]uint16
+	Masquerade() bool
+	SNAT() optional.Optional[netip.AddrPort]
+	DNAT() optional.Optional[netip.AddrPort]
+}
+
 // WrapNetworkRuleConfigList wraps a list of NetworkConfig into a single NetworkConfig aggregating the results.
 func WrapNetworkRuleConfigList(configs ...NetworkRuleConfigSignal) NetworkRuleConfig {
 	return networkRuleConfigWrapper(configs)
@@ -56,6 +86,33 @@ func (w networkRuleConfigWrapper) DefaultAction() nethelpers.DefaultAction {
 	)
 }
 
+func (w networkRuleConfigWrapper) EgressDefaultAction() nethelpers.DefaultAction {
+	return findFirstValue(
+		filterDocuments[NetworkRuleConfigDefaultAction](w),
+		func(c NetworkRuleConfigDefaultAction) nethelpers.DefaultAction {
+			return c.EgressDefaultAction()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) ForwardDefaultAction() nethelpers.DefaultAction {
+	return findFirstValue(
+		filterDocuments[NetworkRuleConfigDefaultAction](w),
+		func(c NetworkRuleConfigDefaultAction) nethelpers.DefaultAction {
+			return c.ForwardDefaultAction()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) LogDrops() bool {
+	return findFirstValue(
+		filterDocuments[NetworkRuleConfigDefaultAction](w),
+		func(c NetworkRuleConfigDefaultAction) bool {
+			return c.LogDrops()
+		},
+	)
+}
+
 func (w networkRuleConfigWrapper) Rules() []NetworkRule {
 	return aggregateValues(
 		filterDocuments[NetworkRuleConfigRules](w),
@@ -64,3 +121,407 @@ func (w networkRuleConfigWrapper) Rules() []NetworkRule {
 		},
 	)
 }
+
+func (w networkRuleConfigWrapper) EgressRules() []NetworkRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigRules](w),
+		func(c NetworkRuleConfigRules) []NetworkRule {
+			return c.EgressRules()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) ForwardRules() []NetworkRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigRules](w),
+		func(c NetworkRuleConfigRules) []NetworkRule {
+			return c.ForwardRules()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) NATRules() []NetworkNATRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigNAT](w),
+		func(c NetworkRuleConfigNAT) []NetworkNATRule {
+			return c.NATRules()
+		},
+	)
+}
+
+// NetworkCommonLinkConfig defines the common settings of the network links.
+type NetworkCommonLinkConfig interface {
+	NamedDocument
+	Up() bool
+	MTU() optional.Optional[uint32]
+}
+
+// NetworkLinkConfig defines the interface to access physical link configuration.
+//
+// The link is either specified by name, or selected by the CEL expression against
+// the link status.
+type NetworkLinkConfig interface {
+	NetworkCommonLinkConfig
+	NetworkLinkConfigSignal()
+	Selector() optional.Optional[cel.Expression]
+	Ethtool() optional.Optional[NetworkEthtoolConfig]
+}
+
+// NetworkEthtoolConfig defines the interface to access ethtool settings of the link.
+//
+// Zero values keep the current settings of the link.
+type NetworkEthtoolConfig interface {
+	Features() map[string]bool
+	RXRingSize() uint32
+	TXRingSize() uint32
+	CombinedChannels() uint32
+	LinkMode() optional.Optional[NetworkEthtoolLinkModeConfig]
+	WakeOnLAN() optional.Optional[nethelpers.WOLModes]
+}
+
+// NetworkEthtoolLinkModeConfig defines the link speed, duplex and autonegotiation settings.
+type NetworkEthtoolLinkModeConfig interface {
+	Autonegotiation() bool
+	Speed() uint32
+	Duplex() nethelpers.Duplex
+}
+
+// NetworkBondConfig defines the interface to access bond link configuration.
+type NetworkBondConfig interface {
+	NetworkCommonLinkConfig
+	NetworkBondConfigSignal()
+	Links() []string
+	LinkSelector() optional.Optional[cel.Expression]
+	Mode() nethelpers.BondMode
+	HashPolicy() nethelpers.BondXmitHashPolicy
+	LACPRate() nethelpers.LACPRate
+	ADSelect() nethelpers.ADSelect
+	MIIMon() uint32
+	UpDelay() uint32
+	DownDelay() uint32
+	MinLinks() uint32
+}
+
+// NetworkBridgeConfig defines the interface to access bridge link configuration.
+type NetworkBridgeConfig interface {
+	NetworkCommonLinkConfig
+	NetworkBridgeConfigSignal()
+	Links() []string
+	LinkSelector() optional.Optional[cel.Expression]
+	STPEnabled() bool
+	VLANFilteringEnabled() bool
+}
+
+// NetworkVLANConfig defines the interface to access VLAN link configuration.
+type NetworkVLANConfig interface {
+	NetworkCommonLinkConfig
+	NetworkVLANConfigSignal()
+	ParentLink() string
+	VLANID() uint16
+	VLANMode() nethelpers.VLANProtocol
+}
+
+// NetworkVXLANConfig defines the interface to access VXLAN link configuration.
+type NetworkVXLANConfig interface {
+	NetworkCommonLinkConfig
+	NetworkVXLANConfigSignal()
+	ParentLink() optional.Optional[string]
+	VNI() uint32
+	Remote() netip.Addr
+	Local() netip.Addr
+	Port() uint16
+}
+
+// NetworkGENEVEConfig defines the interface to access GENEVE link configuration.
+type NetworkGENEVEConfig interface {
+	NetworkCommonLinkConfig
+	NetworkGENEVEConfigSignal()
+	VNI() uint32
+	Remote() netip.Addr
+	Port() uint16
+}
+
+// NetworkMACVLANConfig defines the interface to access macvlan link configuration.
+type NetworkMACVLANConfig interface {
+	NetworkCommonLinkConfig
+	NetworkMACVLANConfigSignal()
+	ParentLink() string
+	MACVLANMode() nethelpers.MACVLANMode
+}
+
+// NetworkIPVLANConfig defines the interface to access ipvlan link configuration.
+type NetworkIPVLANConfig interface {
+	NetworkCommonLinkConfig
+	NetworkIPVLANConfigSignal()
+	ParentLink() string
+	IPVLANMode() nethelpers.IPVLANMode
+}
+
+// NetworkSRIOVConfig defines the interface to access SR-IOV configuration of the physical function link.
+//
+// The physical function link is either specified by name, or selected by the CEL expression against
+// the link status.
+type NetworkSRIOVConfig interface {
+	NamedDocument
+	NetworkSRIOVConfigSignal()
+	Selector() optional.Optional[cel.Expression]
+	NumVFs() uint32
+	VFs() []NetworkSRIOVVFConfig
+}
+
+// NetworkSRIOVVFConfig defines the settings of a single SR-IOV virtual function.
+type NetworkSRIOVVFConfig interface {
+	Index() uint32
+	HardwareAddr() optional.Optional[nethelpers.HardwareAddr]
+	VLAN() uint16
+	Trust() optional.Optional[bool]
+	SpoofCheck() optional.Optional[bool]
+}
+
+// NetworkQdiscConfig defines the interface to access the root queueing discipline (qdisc) configuration of the link.
+//
+// Exactly one of the qdisc types (FQ, FQ-CoDel, CAKE, TBF, HTB, netem) is set.
+// Rates are in bits per second, probabilities are in percent, zero values mean the kernel defaults.
+type NetworkQdiscConfig interface {
+	NamedDocument
+	NetworkQdiscConfigSignal()
+	FQ() optional.Optional[NetworkFQQdiscConfig]
+	FQCodel() optional.Optional[NetworkFQCodelQdiscConfig]
+	CAKE() optional.Optional[NetworkCAKEQdiscConfig]
+	TBF() optional.Optional[NetworkTBFQdiscConfig]
+	HTB() optional.Optional[NetworkHTBQdiscConfig]
+	Netem() optional.Optional[NetworkNetemQdiscConfig]
+}
+
+// NetworkFQQdiscConfig defines the Fair Queue qdisc settings.
+type NetworkFQQdiscConfig interface {
+	FlowLimit() uint32
+	MaxRate() uint64
+}
+
+// NetworkFQCodelQdiscConfig defines the Fair Queuing Controlled Delay qdisc settings.
+type NetworkFQCodelQdiscConfig interface {
+	Limit() uint32
+	Flows() uint32
+	Target() time.Duration
+	Interval() time.Duration
+	ECN() optional.Optional[bool]
+}
+
+// NetworkCAKEQdiscConfig defines the Common Applications Kept Enhanced qdisc settings.
+type NetworkCAKEQdiscConfig interface {
+	Bandwidth() uint64
+	RTT() time.Duration
+}
+
+// NetworkTBFQdiscConfig defines the Token Bucket Filter qdisc settings.
+type NetworkTBFQdiscConfig interface {
+	Rate() uint64
+	Burst() uint32
+	Latency() time.Duration
+}
+
+// NetworkHTBQdiscConfig defines the Hierarchy Token Bucket qdisc settings.
+type NetworkHTBQdiscConfig interface {
+	Rate() uint64
+	DefaultClass() uint16
+	Classes() []NetworkHTBClassConfig
+}
+
+// NetworkHTBClassConfig defines a single HTB class.
+type NetworkHTBClassConfig interface {
+	ID() uint16
+	Rate() uint64
+	Ceil() uint64
+	Priority() uint32
+	FirewallMark() uint32
+}
+
+// NetworkNetemQdiscConfig defines the network emulator qdisc settings.
+type NetworkNetemQdiscConfig interface {
+	Delay() time.Duration
+	Jitter() time.Duration
+	Loss() float64
+	Duplicate() float64
+	Corrupt() float64
+	Reorder() float64
+	Rate() uint64
+	Limit() uint32
+}
+
+// NetworkDot1XConfig defines the interface to access 802.1X port authentication configuration of the link.
+//
+// The link is authenticated with EAP-TLS, the client certificate is either supplied in the configuration,
+// or the Talos API certificate of the node is used.
+type NetworkDot1XConfig interface {
+	NamedDocument
+	NetworkDot1XConfigSignal()
+	Identity() string
+	NodeIdentity() bool
+	Certificate() string
+	Key() string
+	CA() string
+	ServerName() string
+}
+
+// NetworkDHCPv4Config defines the interface to access DHCPv4 client configuration of the link.
+type NetworkDHCPv4Config interface {
+	NamedDocument
+	NetworkDHCPv4ConfigSignal()
+	RouteMetric() uint32
+	IgnoreHostname() bool
+	ClientIdentifier() string
+	VendorClass() string
+	UserClass() []string
+	RequestedOptions() []uint8
+	Probe() optional.Optional[string]
+	ProbeFailureMetric() optional.Optional[uint32]
+}
+
+// NetworkDHCPv6Config defines the interface to access DHCPv6 client configuration of the link.
+type NetworkDHCPv6Config interface {
+	NamedDocument
+	NetworkDHCPv6ConfigSignal()
+	RouteMetric() uint32
+	IgnoreHostname() bool
+	DUID() string
+	PrefixDelegation() optional.Optional[NetworkDHCPv6PrefixDelegationConfig]
+}
+
+// NetworkDHCPv6PrefixDelegationConfig defines the DHCPv6 prefix delegation settings.
+type NetworkDHCPv6PrefixDelegationConfig interface {
+	PrefixLength() int
+	Assignments() []NetworkDHCPv6PrefixAssignmentConfig
+}
+
+// NetworkDHCPv6PrefixAssignmentConfig defines the assignment of the subnet of the delegated prefix.
+//
+// The subnet is assigned either to the link, or published as the node annotation.
+type NetworkDHCPv6PrefixAssignmentConfig interface {
+	Link() string
+	NodeAnnotation() string
+	SubnetID() uint64
+	PrefixLength() int
+}
+
+// NetworkStaticAddressConfig defines the interface to access static address configuration.
+type NetworkStaticAddressConfig interface {
+	NamedDocument
+	NetworkStaticAddressConfigSignal()
+	Link() string
+	Addresses() []netip.Prefix
+}
+
+// NetworkRouteConfig defines the interface to access static route configuration.
+type NetworkRouteConfig interface {
+	NamedDocument
+	NetworkRouteConfigSignal()
+	Link() string
+	Destination() optional.Optional[netip.Prefix]
+	Gateway() optional.Optional[netip.Addr]
+	Source() optional.Optional[netip.Addr]
+	Metric() uint32
+	MTU() uint32
+	Table() nethelpers.RoutingTable
+	Probe() optional.Optional[string]
+	ProbeFailureMetric() optional.Optional[uint32]
+}
+
+// NetworkRoutingRuleConfig defines the interface to access routing rule (policy routing) configuration.
+type NetworkRoutingRuleConfig interface {
+	NamedDocument
+	NetworkRoutingRuleConfigSignal()
+	Priority() uint32
+	Source() optional.Optional[netip.Prefix]
+	Destination() optional.Optional[netip.Prefix]
+	InputLink() optional.Optional[string]
+	OutputLink() optional.Optional[string]
+	FwMark() uint32
+	FwMask() uint32
+	Table() nethelpers.RoutingTable
+}
+
+// NetworkDNSUpstreamConfig defines the interface to access encrypted DNS upstream configuration.
+type NetworkDNSUpstreamConfig interface {
+	NamedDocument
+	NetworkDNSUpstreamConfigSignal()
+	URL() string
+	ServerName() string
+	CACertificates() string
+}
+
+// NetworkDNSForwardZoneConfig defines the interface to access DNS conditional forwarding configuration.
+type NetworkDNSForwardZoneConfig interface {
+	NamedDocument
+	NetworkDNSForwardZoneConfigSignal()
+	Servers() []netip.AddrPort
+}
+
+// NetworkDNSStaticRecordConfig defines the interface to access static DNS record configuration.
+type NetworkDNSStaticRecordConfig interface {
+	NamedDocument
+	NetworkDNSStaticRecordConfigSignal()
+	Addresses() []netip.Addr
+	CNAME() string
+	TTL() time.Duration
+}
+
+// NetworkDNSCacheConfig defines the interface to access host DNS cache configuration.
+//
+// Zero values mean the defaults.
+type NetworkDNSCacheConfig interface {
+	NetworkDNSCacheConfigSignal()
+	MinTTL() time.Duration
+	MaxTTL() time.Duration
+	NegativeTTL() time.Duration
+}
+
+// NetworkLLDPConfig defines the interface to access LLDP configuration.
+//
+// Zero values mean the defaults.
+type NetworkLLDPConfig interface {
+	NetworkLLDPConfigSignal()
+	Transmit() bool
+	TransmitInterval() time.Duration
+}
+
+// NetworkProbeConfig defines the interface to access network probe configuration.
+//
+// Exactly one of the probe types (TCP, ICMP, HTTP, DNS) is set.
+type NetworkProbeConfig interface {
+	NamedDocument
+	NetworkProbeConfigSignal()
+	Interval() time.Duration
+	FailureThreshold() int
+	TCP() optional.Optional[NetworkTCPProbeConfig]
+	ICMP() optional.Optional[NetworkICMPProbeConfig]
+	HTTP() optional.Optional[NetworkHTTPProbeConfig]
+	DNS() optional.Optional[NetworkDNSProbeConfig]
+	Link() optional.Optional[string]
+}
+
+// NetworkTCPProbeConfig defines the TCP connection probe.
+type NetworkTCPProbeConfig interface {
+	Endpoint() string
+	Timeout() time.Duration
+}
+
+// NetworkICMPProbeConfig defines the ICMP echo probe.
+type NetworkICMPProbeConfig interface {
+	Address() netip.Addr
+	Timeout() time.Duration
+}
+
+// NetworkHTTPProbeConfig defines the HTTP(S) GET probe.
+type NetworkHTTPProbeConfig interface {
+	URL() string
+	ExpectedStatusCode() int
+	Timeout() time.Duration
+}
+
+// NetworkDNSProbeConfig defines the DNS query probe.
+type NetworkDNSProbeConfig interface {
+	Server() string
+	Query() string
+	Timeout() time.Duration
+}
@@ -5,23 +5,46 @@
 package config
 
 import (
+	"slices"
+	"time"
+
 	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
+// SystemVolumeNames is a list of volume names which are managed by Talos.
+//
+// VolumeConfig documents with these names override the system volume settings,
+// any other name declares a user volume.
+var SystemVolumeNames = []string{
+	constants.EFIPartitionLabel,
+	constants.BIOSGrubPartitionLabel,
+	constants.BootPartitionLabel,
+	constants.MetaPartitionLabel,
+	constants.StatePartitionLabel,
+	constants.EphemeralPartitionLabel,
+}
+
 // VolumesConfig defines the interface to access volume configuration.
 type VolumesConfig interface {
 	// ByName returns a volume config configuration by name.
 	//
 	// If the configuration is missing, the method a stub which returns implements 'nothing is set' stub.
 	ByName(name string) VolumeConfig
+
+	// UserVolumes returns volume configurations for user (non-system) volumes.
+	UserVolumes() []VolumeConfig
 }
 
 // VolumeConfig defines the interface to access volume configuration.
 type VolumeConfig interface {
 	NamedDocument
 	Provisioning() VolumeProvisioningConfig
+	Filesystem() VolumeFilesystemConfig
+	MountTimeout() optional.Optional[time.Duration]
 }
 
 // VolumeProvisioningConfig defines the interface to access volume provisioning configuration.
@@ -30,6 +53,51 @@ type VolumeProvisioningConfig interface {
 	Grow() optional.Optional[bool]
 	MinSize() optional.Optional[uint64]
 	MaxSize() optional.Optional[uint64]
+	LVM() VolumeLVMConfig
+	RAID() VolumeRAIDConfig
+}
+
+// VolumeLVMConfig defines the interface to access volume LVM provisioning configuration.
+type VolumeLVMConfig interface {
+	VolumeGroup() optional.Optional[string]
+	ThinPool() optional.Optional[string]
+	ThinPoolSize() optional.Optional[uint64]
+}
+
+// VolumeRAIDConfig defines the interface to access volume RAID provisioning configuration.
+type VolumeRAIDConfig interface {
+	Level() optional.Optional[string]
+	Devices() optional.Optional[int]
+}
+
+// VolumeFilesystemConfig defines the interface to access volume filesystem configuration.
+type VolumeFilesystemConfig interface {
+	Type() optional.Optional[block.FilesystemType]
+	EXT4ReservedBlocksPercentage() optional.Optional[uint32]
+	BTRFSCompression() optional.Optional[string]
+}
+
+// SwapVolumeConfig defines the interface to access swap volume configuration.
+type SwapVolumeConfig interface {
+	NamedDocument
+	SwapVolumeConfigSignal()
+	Provisioning() VolumeProvisioningConfig
+	Encryption() Encryption
+}
+
+// ZramSwapConfig defines the interface to access zram swap device configuration.
+type ZramSwapConfig interface {
+	Size() uint64
+	Algorithm() optional.Optional[string]
+}
+
+// ProjectQuotaConfig defines the interface to access directory project quota configuration.
+type ProjectQuotaConfig interface {
+	NamedDocument
+	ProjectQuotaConfigSignal()
+	Path() string
+	HardLimit() uint64
+	SoftLimit() optional.Optional[uint64]
 }
 
 // WrapVolumesConfigList wraps a list of VolumeConfig providing access by name.
@@ -49,6 +117,18 @@ func (w volumesConfigWrapper) ByName(name string) VolumeConfig {
 	return emptyVolumeConfig{}
 }
 
+func (w volumesConfigWrapper) UserVolumes() []VolumeConfig {
+	var result []VolumeConfig
+
+	for _, doc := range w {
+		if !slices.Contains(SystemVolumeNames, doc.Name()) {
+			result = append(result, doc)
+		}
+	}
+
+	return result
+}
+
 type emptyVolumeConfig struct{}
 
 func (emptyVolumeConfig) Name() string {
@@ -59,6 +139,14 @@ func (emptyVolumeConfig) Provisioning() VolumeProvisioningConfig {
 	return emptyVolumeConfig{}
 }
 
+func (emptyVolumeConfig) Filesystem() VolumeFilesystemConfig {
+	return emptyVolumeConfig{}
+}
+
+func (emptyVolumeConfig) MountTimeout() optional.Optional[time.Duration] {
+	return optional.None[time.Duration]()
+}
+
 func (emptyVolumeConfig) DiskSelector() optional.Optional[cel.Expression] {
 	return optional.None[cel.Expression]()
 }
@@ -74,3 +162,43 @@ func (emptyVolumeConfig) MinSize() optional.Optional[uint64] {
 func (emptyVolumeConfig) MaxSize() optional.Optional[uint64] {
 	return optional.None[uint64]()
 }
+
+func (emptyVolumeConfig) LVM() VolumeLVMConfig {
+	return emptyVolumeConfig{}
+}
+
+func (emptyVolumeConfig) VolumeGroup() optional.Optional[string] {
+	return optional.None[string]()
+}
+
+func (emptyVolumeConfig) ThinPool() optional.Optional[string] {
+	return optional.None[string]()
+}
+
+func (emptyVolumeConfig) ThinPoolSize() optional.Optional[uint64] {
+	return optional.None[uint64]()
+}
+
+func (emptyVolumeConfig) RAID() VolumeRAIDConfig {
+	return emptyVolumeConfig{}
+}
+
+func (emptyVolumeConfig) Level() optional.Optional[string] {
+	return optional.None[string]()
+}
+
+func (emptyVolumeConfig) Devices() optional.Optional[int] {
+	return optional.None[int]()
+}
+
+func (emptyVolumeConfig) Type() optional.Optional[block.FilesystemType] {
+	return optional.None[block.FilesystemType]()
+}
+
+func (emptyVolumeConfig) EXT4ReservedBlocksPercentage() optional.Optional[uint32] {
+	return optional.None[uint32]()
+}
+
+func (emptyVolumeConfig) BTRFSCompression() optional.Optional[string] {
+	return optional.None[string]()
+}
@@ -19,8 +19,10 @@ import (
 	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // Container wraps all configuration documents into a single container.
@@ -194,6 +196,126 @@ func (container *Container) NetworkRules() config.NetworkRuleConfig {
 	return config.WrapNetworkRuleConfigList(findMatchingDocs[config.NetworkRuleConfigSignal](container.documents)...)
 }
 
+// NetworkLinkConfigs implements config.Config interface.
+func (container *Container) NetworkLinkConfigs() []config.NetworkLinkConfig {
+	return findMatchingDocs[config.NetworkLinkConfig](container.documents)
+}
+
+// NetworkBondConfigs implements config.Config interface.
+func (container *Container) NetworkBondConfigs() []config.NetworkBondConfig {
+	return findMatchingDocs[config.NetworkBondConfig](container.documents)
+}
+
+// NetworkBridgeConfigs implements config.Config interface.
+func (container *Container) NetworkBridgeConfigs() []config.NetworkBridgeConfig {
+	return findMatchingDocs[config.NetworkBridgeConfig](container.documents)
+}
+
+// NetworkVLANConfigs implements config.Config interface.
+func (container *Container) NetworkVLANConfigs() []config.NetworkVLANConfig {
+	return findMatchingDocs[config.NetworkVLANConfig](container.documents)
+}
+
+// NetworkVXLANConfigs implements config.Config interface.
+func (container *Container) NetworkVXLANConfigs() []config.NetworkVXLANConfig {
+	return findMatchingDocs[config.NetworkVXLANConfig](container.documents)
+}
+
+// NetworkGENEVEConfigs implements config.Config interface.
+func (container *Container) NetworkGENEVEConfigs() []config.NetworkGENEVEConfig {
+	return findMatchingDocs[config.NetworkGENEVEConfig](container.documents)
+}
+
+// NetworkMACVLANConfigs implements config.Config interface.
+func (container *Container) NetworkMACVLANConfigs() []config.NetworkMACVLANConfig {
+	return findMatchingDocs[config.NetworkMACVLANConfig](container.documents)
+}
+
+// NetworkIPVLANConfigs implements config.Config interface.
+func (container *Container) NetworkIPVLANConfigs() []config.NetworkIPVLANConfig {
+	return findMatchingDocs[config.NetworkIPVLANConfig](container.documents)
+}
+
+// NetworkSRIOVConfigs implements config.Config interface.
+func (container *Container) NetworkSRIOVConfigs() []config.NetworkSRIOVConfig {
+	return findMatchingDocs[config.NetworkSRIOVConfig](container.documents)
+}
+
+// NetworkQdiscConfigs implements config.Config interface.
+func (container *Container) NetworkQdiscConfigs() []config.NetworkQdiscConfig {
+	return findMatchingDocs[config.NetworkQdiscConfig](container.documents)
+}
+
+// NetworkDot1XConfigs implements config.Config interface.
+func (container *Container) NetworkDot1XConfigs() []config.NetworkDot1XConfig {
+	return findMatchingDocs[config.NetworkDot1XConfig](container.documents)
+}
+
+// NetworkDHCPv4Configs implements config.Config interface.
+func (container *Container) NetworkDHCPv4Configs() []config.NetworkDHCPv4Config {
+	return findMatchingDocs[config.NetworkDHCPv4Config](container.documents)
+}
+
+// NetworkDHCPv6Configs implements config.Config interface.
+func (container *Container) NetworkDHCPv6Configs() []config.NetworkDHCPv6Config {
+	return findMatchingDocs[config.NetworkDHCPv6Config](container.documents)
+}
+
+// NetworkStaticAddressConfigs implements config.Config interface.
+func (container *Container) NetworkStaticAddressConfigs() []config.NetworkStaticAddressConfig {
+	return findMatchingDocs[config.NetworkStaticAddressConfig](container.documents)
+}
+
+// NetworkRouteConfigs implements config.Config interface.
+func (container *Container) NetworkRouteConfigs() []config.NetworkRouteConfig {
+	return findMatchingDocs[config.NetworkRouteConfig](container.documents)
+}
+
+// NetworkRoutingRuleConfigs implements config.Config interface.
+func (container *Container) NetworkRoutingRuleConfigs() []config.NetworkRoutingRuleConfig {
+	return findMatchingDocs[config.NetworkRoutingRuleConfig](container.documents)
+}
+
+// NetworkProbeConfigs implements config.Config interface.
+func (container *Container) NetworkProbeConfigs() []config.NetworkProbeConfig {
+	return findMatchingDocs[config.NetworkProbeConfig](container.documents)
+}
+
+// NetworkDNSUpstreamConfigs implements config.Config interface.
+func (container *Container) NetworkDNSUpstreamConfigs() []config.NetworkDNSUpstreamConfig {
+	return findMatchingDocs[config.NetworkDNSUpstreamConfig](container.documents)
+}
+
+// NetworkDNSForwardZoneConfigs implements config.Config interface.
+func (container *Container) NetworkDNSForwardZoneConfigs() []config.NetworkDNSForwardZoneConfig {
+	return findMatchingDocs[config.NetworkDNSForwardZoneConfig](container.documents)
+}
+
+// NetworkDNSStaticRecordConfigs implements config.Config interface.
+func (container *Container) NetworkDNSStaticRecordConfigs() []config.NetworkDNSStaticRecordConfig {
+	return findMatchingDocs[config.NetworkDNSStaticRecordConfig](container.documents)
+}
+
+// NetworkDNSCacheConfig implements config.Config interface.
+func (container *Container) NetworkDNSCacheConfig() config.NetworkDNSCacheConfig {
+	matching := findMatchingDocs[config.NetworkDNSCacheConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// NetworkLLDPConfig implements config.Config interface.
+func (container *Container) NetworkLLDPConfig() config.NetworkLLDPConfig {
+	matching := findMatchingDocs[config.NetworkLLDPConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // TrustedRoots implements config.Config interface.
 func (container *Container) TrustedRoots() config.TrustedRootsConfig {
 	return config.WrapTrustedRootsConfig(findMatchingDocs[config.TrustedRootsConfig](container.documents)...)
@@ -204,6 +326,26 @@ func (container *Container) Volumes() config.VolumesConfig {
 	return config.WrapVolumesConfigList(findMatchingDocs[config.VolumeConfig](container.documents)...)
 }
 
+// SwapVolumes implements config.Config interface.
+func (container *Container) SwapVolumes() []config.SwapVolumeConfig {
+	return findMatchingDocs[config.SwapVolumeConfig](container.documents)
+}
+
+// ZramSwap implements config.Config interface.
+func (container *Container) ZramSwap() config.ZramSwapConfig {
+	matching := findMatchingDocs[config.ZramSwapConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// ProjectQuotas implements config.Config interface.
+func (container *Container) ProjectQuotas() []config.ProjectQuotaConfig {
+	return findMatchingDocs[config.ProjectQuotaConfig](container.documents)
+}
+
 // KubespanConfig implements config.Config interface.
 func (container *Container) KubespanConfig() config.KubespanConfig {
 	return config.WrapKubespanConfig(findMatchingDocs[config.KubespanConfig](container.documents)...)
@@ -304,9 +446,161 @@ func (container *Container) Validate(mode validation.RuntimeMode, opt ...validat
 		}
 	}
 
+	multiErr = multierror.Append(multiErr, container.validateProbeReferences())
+	multiErr = multierror.Append(multiErr, container.validateLinkConflicts())
+
 	return warnings, multiErr.ErrorOrNil()
 }
 
+// validateProbeReferences checks that the probes referenced by the routes are defined.
+func (container *Container) validateProbeReferences() error {
+	probes := map[string]struct{}{}
+
+	for _, probeConfig := range container.NetworkProbeConfigs() {
+		probes[probeConfig.Name()] = struct{}{}
+	}
+
+	var multiErr *multierror.Error
+
+	checkProbe := func(probe, referencedBy string) {
+		if probe == "" {
+			return
+		}
+
+		if _, ok := probes[probe]; !ok {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s: probe %q is not defined", referencedBy, probe))
+		}
+	}
+
+	if container.Machine() != nil {
+		for _, device := range container.Machine().Network().Devices() {
+			for _, route := range device.Routes() {
+				checkProbe(route.Probe(), fmt.Sprintf("interface %q route %q", device.Interface(), route.Network()))
+			}
+
+			checkProbe(device.DHCPOptions().Probe(), fmt.Sprintf("interface %q DHCP options", device.Interface()))
+
+			for _, vlan := range device.Vlans() {
+				for _, route := range vlan.Routes() {
+					checkProbe(route.Probe(), fmt.Sprintf("interface %q VLAN %d route %q", device.Interface(), vlan.ID(), route.Network()))
+				}
+
+				checkProbe(vlan.DHCPOptions().Probe(), fmt.Sprintf("interface %q VLAN %d DHCP options", device.Interface(), vlan.ID()))
+			}
+		}
+	}
+
+	for _, routeConfig := range container.NetworkRouteConfigs() {
+		checkProbe(routeConfig.Probe().ValueOrZero(), fmt.Sprintf("%s %q", network.RouteConfigKind, routeConfig.Name()))
+	}
+
+	for _, dhcpConfig := range container.NetworkDHCPv4Configs() {
+		checkProbe(dhcpConfig.Probe().ValueOrZero(), fmt.Sprintf("%s %q", network.DHCPv4ConfigKind, dhcpConfig.Name()))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// validateLinkConflicts checks that the links are not configured both in the v1alpha1 config and in the network documents.
+//
+// Both configurations are emitted with the same layer and link ID, so one would silently overwrite the other.
+func (container *Container) validateLinkConflicts() error {
+	if container.Machine() == nil {
+		return nil
+	}
+
+	legacyLinks := map[string]struct{}{}
+
+	addLegacyLink := func(name string) {
+		if name != "" {
+			legacyLinks[name] = struct{}{}
+		}
+	}
+
+	for _, device := range container.Machine().Network().Devices() {
+		if device.Ignore() {
+			continue
+		}
+
+		addLegacyLink(device.Interface())
+
+		for _, vlan := range device.Vlans() {
+			addLegacyLink(nethelpers.VLANLinkName(device.Interface(), vlan.ID()))
+		}
+
+		if device.Bond() != nil {
+			for _, link := range device.Bond().Interfaces() {
+				addLegacyLink(link)
+			}
+		}
+
+		if device.Bridge() != nil {
+			for _, link := range device.Bridge().Interfaces() {
+				addLegacyLink(link)
+			}
+		}
+	}
+
+	if len(legacyLinks) == 0 {
+		return nil
+	}
+
+	var multiErr *multierror.Error
+
+	checkLink := func(link, kind, name string) {
+		if _, ok := legacyLinks[link]; ok {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%s %q: link %q is already configured in .machine.network.interfaces", kind, name, link))
+		}
+	}
+
+	for _, linkConfig := range container.NetworkLinkConfigs() {
+		// links matched with a selector are resolved at runtime
+		if linkConfig.Selector().IsPresent() {
+			continue
+		}
+
+		checkLink(linkConfig.Name(), network.LinkConfigKind, linkConfig.Name())
+	}
+
+	for _, bondConfig := range container.NetworkBondConfigs() {
+		checkLink(bondConfig.Name(), network.BondConfigKind, bondConfig.Name())
+
+		for _, link := range bondConfig.Links() {
+			checkLink(link, network.BondConfigKind, bondConfig.Name())
+		}
+	}
+
+	for _, bridgeConfig := range container.NetworkBridgeConfigs() {
+		checkLink(bridgeConfig.Name(), network.BridgeConfigKind, bridgeConfig.Name())
+
+		for _, link := range bridgeConfig.Links() {
+			checkLink(link, network.BridgeConfigKind, bridgeConfig.Name())
+		}
+	}
+
+	for _, vlanConfig := range container.NetworkVLANConfigs() {
+		checkLink(vlanConfig.Name(), network.VLANConfigKind, vlanConfig.Name())
+	}
+
+	for _, vxlanConfig := range container.NetworkVXLANConfigs() {
+		checkLink(vxlanConfig.Name(), network.VXLANConfigKind, vxlanConfig.Name())
+	}
+
+	for _, geneveConfig := range container.NetworkGENEVEConfigs() {
+		checkLink(geneveConfig.Name(), network.GENEVEConfigKind, geneveConfig.Name())
+	}
+
+	for _, macvlanConfig := range container.NetworkMACVLANConfigs() {
+		checkLink(macvlanConfig.Name(), network.MACVLANConfigKind, macvlanConfig.Name())
+	}
+
+	for _, ipvlanConfig := range container.NetworkIPVLANConfigs() {
+		checkLink(ipvlanConfig.Name(), network.IPVLANConfigKind, ipvlanConfig.Name())
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
 // RuntimeValidate validates the config in the runtime context.
 func (container *Container) RuntimeValidate(ctx context.Context, st state.State, mode validation.RuntimeMode, opt ...validation.Option) ([]string, error) {
 	var (
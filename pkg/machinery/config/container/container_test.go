@@ -5,6 +5,7 @@
 package container_test
 
 import (
+	"net/netip"
 	"net/url"
 	"testing"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/siderolink"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
@@ -203,6 +205,128 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateProbeReferences(t *testing.T) {
+	t.Parallel()
+
+	v1alpha1Cfg := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: must.Value(url.Parse("https://localhost:6443"))(t),
+				},
+			},
+		},
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineCA: &x509.PEMEncodedCertificateAndKey{
+				Crt: []byte("cert"),
+			},
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkInterfaces: []*v1alpha1.Device{
+					{
+						DeviceInterface: "eth0",
+						DeviceDHCP:      pointer.To(true),
+						DeviceDHCPOptions: &v1alpha1.DHCPOptions{
+							DHCPProbe:              "uplink0",
+							DHCPProbeFailureMetric: 4096,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	routeCfg := network.NewRouteConfigV1Alpha1()
+	routeCfg.MetaName = "backup"
+	routeCfg.RouteLink = "eth1"
+	routeCfg.RouteGateway = network.Addr{Addr: netip.MustParseAddr("192.168.2.1")}
+	routeCfg.RouteProbe = "uplink1"
+	routeCfg.RouteProbeFailureMetric = 4096
+
+	probe0Cfg := network.NewProbeConfigV1Alpha1()
+	probe0Cfg.MetaName = "uplink0"
+	probe0Cfg.ProbeICMP = &network.ICMPProbeConfig{ProbeAddress: network.Addr{Addr: netip.MustParseAddr("192.168.1.1")}}
+
+	probe1Cfg := network.NewProbeConfigV1Alpha1()
+	probe1Cfg.MetaName = "uplink1"
+	probe1Cfg.ProbeICMP = &network.ICMPProbeConfig{ProbeAddress: network.Addr{Addr: netip.MustParseAddr("192.168.2.1")}}
+
+	ctr, err := container.New(v1alpha1Cfg, routeCfg, probe0Cfg)
+	require.NoError(t, err)
+
+	_, err = ctr.Validate(validationMode{})
+	require.EqualError(t, err, "1 error occurred:\n\t* RouteConfig \"backup\": probe \"uplink1\" is not defined\n\n")
+
+	ctr, err = container.New(v1alpha1Cfg, routeCfg, probe1Cfg)
+	require.NoError(t, err)
+
+	_, err = ctr.Validate(validationMode{})
+	require.EqualError(t, err, "1 error occurred:\n\t* interface \"eth0\" DHCP options: probe \"uplink0\" is not defined\n\n")
+
+	ctr, err = container.New(v1alpha1Cfg, routeCfg, probe0Cfg, probe1Cfg)
+	require.NoError(t, err)
+
+	_, err = ctr.Validate(validationMode{})
+	require.NoError(t, err)
+}
+
+func TestValidateLinkConflicts(t *testing.T) {
+	t.Parallel()
+
+	v1alpha1Cfg := &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{
+					URL: must.Value(url.Parse("https://localhost:6443"))(t),
+				},
+			},
+		},
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineCA: &x509.PEMEncodedCertificateAndKey{
+				Crt: []byte("cert"),
+			},
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkInterfaces: v1alpha1.NetworkDeviceList{
+					{
+						DeviceInterface: "eth0",
+						DeviceDHCP:      pointer.To(true),
+						DeviceVlans: v1alpha1.VlanList{
+							{
+								VlanID:   10,
+								VlanDHCP: pointer.To(true),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	eth0Cfg := network.NewLinkConfigV1Alpha1()
+	eth0Cfg.MetaName = "eth0"
+
+	eth1Cfg := network.NewLinkConfigV1Alpha1()
+	eth1Cfg.MetaName = "eth1"
+
+	vlanCfg := network.NewVLANConfigV1Alpha1()
+	vlanCfg.MetaName = "eth0.10"
+	vlanCfg.VLANParent = "eth0"
+	vlanCfg.VLANIDConfig = 10
+
+	ctr, err := container.New(v1alpha1Cfg, eth0Cfg, eth1Cfg, vlanCfg)
+	require.NoError(t, err)
+
+	_, err = ctr.Validate(validationMode{})
+	require.EqualError(t, err, "2 errors occurred:\n\t* LinkConfig \"eth0\": link \"eth0\" is already configured in .machine.network.interfaces\n\t* VLANConfig \"eth0.10\": link \"eth0.10\" is already configured in .machine.network.interfaces\n\n")
+
+	ctr, err = container.New(v1alpha1Cfg, eth1Cfg)
+	require.NoError(t, err)
+
+	_, err = ctr.Validate(validationMode{})
+	require.NoError(t, err)
+}
+
 type validationMode struct{}
 
 func (validationMode) String() string {
@@ -169,3 +169,8 @@ func (contract *VersionContract) AddExcludeFromExternalLoadBalancer() bool {
 func (contract *VersionContract) SecureBootEnrollEnforcementSupported() bool {
 	return contract.Greater(TalosVersion1_7)
 }
+
+// NodeIdentityCASupported returns true if version of Talos supports the node identity CA.
+func (contract *VersionContract) NodeIdentityCASupported() bool {
+	return contract.Greater(TalosVersion1_8)
+}
@@ -37,10 +37,11 @@ func (in *Input) init() ([]config.Document, error) {
 		MachineKubelet: &v1alpha1.KubeletConfig{
 			KubeletImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion),
 		},
-		MachineNetwork:  networkConfig,
-		MachineCA:       in.Options.SecretsBundle.Certs.OS,
-		MachineCertSANs: in.AdditionalMachineCertSANs,
-		MachineToken:    in.Options.SecretsBundle.TrustdInfo.Token,
+		MachineNetwork:        networkConfig,
+		MachineCA:             in.Options.SecretsBundle.Certs.OS,
+		MachineNodeIdentityCA: in.Options.SecretsBundle.Certs.NodeIdentity,
+		MachineCertSANs:       in.AdditionalMachineCertSANs,
+		MachineToken:          in.Options.SecretsBundle.TrustdInfo.Token,
 		MachineInstall: &v1alpha1.InstallConfig{
 			InstallDisk:            in.Options.InstallDisk,
 			InstallImage:           in.Options.InstallImage,
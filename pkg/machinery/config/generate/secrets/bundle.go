@@ -156,6 +156,7 @@ func NewBundleFromConfig(clock Clock, c config.Config) *Bundle {
 		K8sServiceAccount: c.Cluster().ServiceAccount(),
 		Etcd:              c.Cluster().Etcd().CA(),
 		OS:                c.Machine().Security().IssuingCA(),
+		NodeIdentity:      c.Machine().Security().NodeIdentityCA(),
 	}
 
 	cluster := &Cluster{
@@ -270,6 +271,18 @@ func (bundle *Bundle) populate(versionContract *config.VersionContract) error {
 		}
 	}
 
+	if bundle.Certs.NodeIdentity == nil && versionContract.NodeIdentityCASupported() {
+		nodeIdentityCA, err := NewNodeIdentityCA(bundle.Clock.Now())
+		if err != nil {
+			return err
+		}
+
+		bundle.Certs.NodeIdentity = &x509.PEMEncodedCertificateAndKey{
+			Crt: nodeIdentityCA.CrtPEM,
+			Key: nodeIdentityCA.KeyPEM,
+		}
+	}
+
 	if bundle.Secrets == nil {
 		bundle.Secrets = &Secrets{}
 	}
@@ -62,6 +62,19 @@ func NewTalosCA(currentTime time.Time) (ca *x509.CertificateAuthority, err error
 	return x509.NewSelfSignedCertificateAuthority(opts...)
 }
 
+// NewNodeIdentityCA generates a CA for the node identities.
+//
+// The node identity CA is not trusted by the Talos API.
+func NewNodeIdentityCA(currentTime time.Time) (ca *x509.CertificateAuthority, err error) {
+	opts := []x509.Option{
+		x509.Organization("talos-node-identity"),
+		x509.NotAfter(currentTime.Add(CAValidityTime)),
+		x509.NotBefore(currentTime),
+	}
+
+	return x509.NewSelfSignedCertificateAuthority(opts...)
+}
+
 // NewAdminCertificateAndKey generates the admin Talos certificate and key.
 func NewAdminCertificateAndKey(currentTime time.Time, ca *x509.PEMEncodedCertificateAndKey, roles role.Set, ttl time.Duration) (p *x509.PEMEncodedCertificateAndKey, err error) {
 	opts := []x509.Option{
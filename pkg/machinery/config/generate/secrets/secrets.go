@@ -38,6 +38,8 @@ type Certs struct {
 	K8sServiceAccount *x509.PEMEncodedKey `json:"K8sServiceAccount"`
 	// OS is Talos API CA certificate and key.
 	OS *x509.PEMEncodedCertificateAndKey `json:"OS"`
+	// NodeIdentity is node identity CA certificate and key.
+	NodeIdentity *x509.PEMEncodedCertificateAndKey `json:"NodeIdentity,omitempty" yaml:",omitempty"`
 }
 
 // Cluster holds Talos cluster-wide secrets.
@@ -5,6 +5,6 @@
 // Package block provides block device and volume configuration documents.
 package block
 
-//go:generate docgen -output block_doc.go block.go volume_config.go
+//go:generate docgen -output block_doc.go block.go encryption.go project_quota_config.go swap_volume_config.go volume_config.go zram_swap_config.go
 
-//go:generate deep-copy -type VolumeConfigV1Alpha1  -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type ProjectQuotaConfigV1Alpha1 -type SwapVolumeConfigV1Alpha1 -type VolumeConfigV1Alpha1 -type ZramSwapConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
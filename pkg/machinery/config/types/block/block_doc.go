@@ -10,6 +10,453 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (EncryptionSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionSpec represents volume encryption settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionSpec represents volume encryption settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SwapVolumeConfigV1Alpha1",
+				FieldName: "encryption",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "provider",
+				Type:        "string",
+				Note:        "",
+				Description: "Encryption provider to use for the encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Encryption provider to use for the encryption." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"luks2",
+				},
+			},
+			{
+				Name:        "keys",
+				Type:        "[]EncryptionKey",
+				Note:        "",
+				Description: "Defines the encryption keys generation and storage method.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defines the encryption keys generation and storage method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cipher",
+				Type:        "string",
+				Note:        "",
+				Description: "Cipher to use for the encryption. Depends on the encryption provider.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Cipher to use for the encryption. Depends on the encryption provider." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"aes-xts-plain64",
+					"xchacha12,aes-adiantum-plain64",
+					"xchacha20,aes-adiantum-plain64",
+				},
+			},
+			{
+				Name:        "keySize",
+				Type:        "uint",
+				Note:        "",
+				Description: "Defines the encryption key length.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defines the encryption key length." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "blockSize",
+				Type:        "uint64",
+				Note:        "",
+				Description: "Defines the encryption sector size.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defines the encryption sector size." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "options",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Additional --perf parameters for the LUKS2 encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Additional --perf parameters for the LUKS2 encryption." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"no_read_workqueue",
+					"no_write_workqueue",
+					"same_cpu_crypt",
+				},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "luks2")
+	doc.Fields[2].AddExample("", "aes-xts-plain64")
+	doc.Fields[4].AddExample("", 4096)
+	doc.Fields[5].AddExample("", []string{"no_read_workqueue", "no_write_workqueue"})
+
+	return doc
+}
+
+func (EncryptionKey) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKey",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKey represents configuration for disk encryption key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKey represents configuration for disk encryption key.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionSpec",
+				FieldName: "keys",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "slot",
+				Type:        "int",
+				Note:        "",
+				Description: "Key slot number for LUKS2 encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Key slot number for LUKS2 encryption." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "static",
+				Type:        "EncryptionKeyStatic",
+				Note:        "",
+				Description: "Key which value is stored in the configuration file.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Key which value is stored in the configuration file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodeID",
+				Type:        "EncryptionKeyNodeID",
+				Note:        "",
+				Description: "Deterministically generated key from the node UUID and PartitionLabel.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Deterministically generated key from the node UUID and PartitionLabel." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "kms",
+				Type:        "EncryptionKeyKMS",
+				Note:        "",
+				Description: "KMS managed encryption key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "KMS managed encryption key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tpm",
+				Type:        "EncryptionKeyTPM",
+				Note:        "",
+				Description: "Enable TPM based disk encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable TPM based disk encryption." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vault",
+				Type:        "EncryptionKeyVault",
+				Note:        "",
+				Description: "Vault Transit secrets engine managed encryption key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vault Transit secrets engine managed encryption key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyStatic) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyStatic",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyStatic represents throw away key type." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyStatic represents throw away key type.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "static",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "passphrase",
+				Type:        "string",
+				Note:        "",
+				Description: "Defines the static passphrase value.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Defines the static passphrase value." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyKMS) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyKMS",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "kms",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "KMS endpoint to Seal/Unseal the key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "KMS endpoint to Seal/Unseal the key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyVault) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVault",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "vault",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "string",
+				Note:        "",
+				Description: "Vault server address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vault server address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "PEMEncodedCertificate",
+				Note:        "",
+				Description: "CA certificate to verify the Vault server certificate.\nIf not set, the system trusted CA certificates are used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the Vault server certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "transitMount",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the Transit secrets engine.\nDefaults to `transit`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the Transit secrets engine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "transitKey",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the Transit encryption key used to seal/unseal the volume key.\nThe volume key is sealed with the node UUID as the key derivation context, so the key might be created with `derived=true` to bind the sealed data to the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the Transit encryption key used to seal/unseal the volume key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "appRole",
+				Type:        "EncryptionKeyVaultAppRole",
+				Note:        "",
+				Description: "Authenticate to Vault using the AppRole auth method.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authenticate to Vault using the AppRole auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cert",
+				Type:        "EncryptionKeyVaultCert",
+				Note:        "",
+				Description: "Authenticate to Vault using the TLS certificates auth method.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authenticate to Vault using the TLS certificates auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "https://vault.example.com:8200")
+	doc.Fields[3].AddExample("", "talos")
+
+	return doc
+}
+
+func (EncryptionKeyVaultAppRole) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVaultAppRole",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVaultAppRole represents Vault AppRole auth method settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVaultAppRole represents Vault AppRole auth method settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKeyVault",
+				FieldName: "appRole",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the AppRole auth method.\nDefaults to `approle`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the AppRole auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "roleID",
+				Type:        "string",
+				Note:        "",
+				Description: "AppRole role ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AppRole role ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "secretID",
+				Type:        "string",
+				Note:        "",
+				Description: "AppRole secret ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AppRole secret ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyVaultCert) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVaultCert",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVaultCert represents Vault TLS certificates auth method settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVaultCert represents Vault TLS certificates auth method settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKeyVault",
+				FieldName: "cert",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the TLS certificates auth method.\nDefaults to `cert`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the TLS certificates auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the certificate role to authenticate against.\nIf not set, Vault tries all certificate roles.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the certificate role to authenticate against." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientIdentity",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "The client certificate and key to authenticate with.\nIf not set, the node identity is used: the client certificate issued by the node identity CA.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The client certificate and key to authenticate with." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyTPM) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyTPM",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "tpm",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "checkSecurebootStatusOnEnroll",
+				Type:        "bool",
+				Note:        "",
+				Description: "Check that Secureboot is enabled in the EFI firmware.\nIf Secureboot is not enabled, the enrollment of the key will fail.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Check that Secureboot is enabled in the EFI firmware." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyNodeID) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyNodeID",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "nodeID",
+			},
+		},
+		Fields: []encoder.Doc{},
+	}
+
+	return doc
+}
+
+func (ProjectQuotaConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ProjectQuotaConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ProjectQuotaConfig is a directory disk usage limit (XFS project quota) configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ProjectQuotaConfig is a directory disk usage limit (XFS project quota) configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Path of the directory to limit.\n\nThe directory should be on the EPHEMERAL volume (under `/var`), the quota is applied once the directory is created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Path of the directory to limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "hardLimit",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "The hard limit of the disk usage of the directory.\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The hard limit of the disk usage of the directory." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "softLimit",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "The soft limit of the disk usage of the directory.\n\nThe soft limit can be exceeded for the grace period, it should be less than the hard limit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The soft limit of the disk usage of the directory." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleProjectQuotaConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "/var/log")
+	doc.Fields[2].AddExample("", "10GiB")
+	doc.Fields[3].AddExample("", "8GiB")
+
+	return doc
+}
+
+func (SwapVolumeConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SwapVolumeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SwapVolumeConfig is a swap volume configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SwapVolumeConfig is a swap volume configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the swap volume.\n\nThe partition label of the volume is `s-<name>`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the swap volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "provisioning",
+				Type:        "ProvisioningSpec",
+				Note:        "",
+				Description: "The provisioning describes how the volume is provisioned.\n\nLVM and RAID provisioning is not supported for swap volumes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The provisioning describes how the volume is provisioned." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "encryption",
+				Type:        "EncryptionSpec",
+				Note:        "",
+				Description: "The encryption describes how the volume is encrypted.\n\nThe key providers are the same as for the system disk encryption.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The encryption describes how the volume is encrypted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSwapVolumeConfigV1Alpha1())
+
+	return doc
+}
+
 func (VolumeConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "VolumeConfig",
@@ -21,7 +468,7 @@ func (VolumeConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "name",
 				Type:        "string",
 				Note:        "",
-				Description: "Name of the volume.",
+				Description: "Name of the volume.\n\nName is either a system volume name (e.g. `EPHEMERAL`) or a user volume name.\nUser volumes are mounted at `/var/mnt/<name>`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -31,11 +478,31 @@ func (VolumeConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "The provisioning describes how the volume is provisioned.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The provisioning describes how the volume is provisioned." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "filesystem",
+				Type:        "FilesystemSpec",
+				Note:        "",
+				Description: "The filesystem describes how the volume is formatted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The filesystem describes how the volume is formatted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mountTimeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "How long the boot waits for the user volume to be provisioned and mounted.\n\nIf the user volume is not mounted in time, the boot continues,\nand the volume is mounted once it becomes ready.\nMissing and failed user volumes do not block the boot.\nDefaults to 5 minutes, only supported for user volumes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "How long the boot waits for the user volume to be provisioned and mounted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", exampleVolumeConfigEphemeralV1Alpha1())
 
+	doc.AddExample("", exampleVolumeConfigUserV1Alpha1())
+
+	doc.AddExample("", exampleVolumeConfigLVMV1Alpha1())
+
+	doc.AddExample("", exampleVolumeConfigRAIDV1Alpha1())
+
 	return doc
 }
 
@@ -45,6 +512,10 @@ func (ProvisioningSpec) Doc() *encoder.Doc {
 		Comments:    [3]string{"" /* encoder.HeadComment */, "ProvisioningSpec describes how the volume is provisioned." /* encoder.LineComment */, "" /* encoder.FootComment */},
 		Description: "ProvisioningSpec describes how the volume is provisioned.",
 		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SwapVolumeConfigV1Alpha1",
+				FieldName: "provisioning",
+			},
 			{
 				TypeName:  "VolumeConfigV1Alpha1",
 				FieldName: "provisioning",
@@ -79,6 +550,20 @@ func (ProvisioningSpec) Doc() *encoder.Doc {
 				Description: "The maximum size of the volume, if not specified the volume can grow to the size of the\ndisk.\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The maximum size of the volume, if not specified the volume can grow to the size of the" /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "lvm",
+				Type:        "LVMSpec",
+				Note:        "",
+				Description: "Provision the volume as an LVM logical volume.\n\nEmpty disks matched by the disk selector are added to the volume group\nuntil it has enough free space for the requested size (`maxSize`, or `minSize` if not set),\nthe logical volume is carved out of the volume group.\nLVM provisioning is only supported for user volumes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Provision the volume as an LVM logical volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "raid",
+				Type:        "RAIDSpec",
+				Note:        "",
+				Description: "Provision the volume on a software RAID (md) array.\n\nThe array is created on the empty disks matched by the disk selector,\nand it is assembled again on boot.\nRAID provisioning is supported for the EPHEMERAL and user volumes.\nRAID provisioning requires `mdadm`, which is not part of the Talos rootfs and should be installed as a system extension.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Provision the volume on a software RAID (md) array." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -88,6 +573,186 @@ func (ProvisioningSpec) Doc() *encoder.Doc {
 	return doc
 }
 
+func (RAIDSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RAIDSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RAIDSpec describes how the volume is provisioned on a software RAID array." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RAIDSpec describes how the volume is provisioned on a software RAID array.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProvisioningSpec",
+				FieldName: "raid",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "level",
+				Type:        "string",
+				Note:        "",
+				Description: "RAID level of the array.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "RAID level of the array." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"raid1",
+					"raid10",
+				},
+			},
+			{
+				Name:        "devices",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of member disks in the array.\n\nDefaults to 2 for raid1 and 4 for raid10.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of member disks in the array." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "raid1")
+	doc.Fields[1].AddExample("", 3)
+
+	return doc
+}
+
+func (LVMSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LVMSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LVMSpec describes how the volume is provisioned as an LVM logical volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LVMSpec describes how the volume is provisioned as an LVM logical volume.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProvisioningSpec",
+				FieldName: "lvm",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "volumeGroup",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the LVM volume group.\n\nThe volume group is created on the disks matched by the disk selector,\nseveral volumes might share the same volume group.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the LVM volume group." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "thinPool",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the LVM thin pool in the volume group.\n\nIf set, the logical volume is thin-provisioned in the pool, and the pool is created\nin the volume group if it doesn't exist yet.\nThe virtual size of the volume is set by the `maxSize`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the LVM thin pool in the volume group." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "thinPoolSize",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "Size of the LVM thin pool.\n\nThe size is only used when the pool is created, if not set the pool is created\nwith the size of the volume (`maxSize`).\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Size of the LVM thin pool." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "vg-data")
+	doc.Fields[1].AddExample("", "pool0")
+	doc.Fields[2].AddExample("", "500GiB")
+
+	return doc
+}
+
+func (FilesystemSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "FilesystemSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "FilesystemSpec configures the filesystem for the volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "FilesystemSpec configures the filesystem for the volume.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "VolumeConfigV1Alpha1",
+				FieldName: "filesystem",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "type",
+				Type:        "FilesystemType",
+				Note:        "",
+				Description: "Filesystem type, defaults to `xfs`.\n\nEPHEMERAL volume only supports `xfs`.\nThe `ext4` and `btrfs` filesystems require the `e2fsprogs` and `btrfs-progs` tools, which are not part of the Talos rootfs\nand should be installed as system extensions.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Filesystem type, defaults to `xfs`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"xfs",
+					"ext4",
+					"btrfs",
+				},
+			},
+			{
+				Name:        "ext4",
+				Type:        "EXT4Spec",
+				Note:        "",
+				Description: "ext4 specific filesystem options.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ext4 specific filesystem options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "btrfs",
+				Type:        "BTRFSSpec",
+				Note:        "",
+				Description: "btrfs specific filesystem options.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "btrfs specific filesystem options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EXT4Spec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EXT4Spec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EXT4Spec configures ext4 filesystem options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EXT4Spec configures ext4 filesystem options.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FilesystemSpec",
+				FieldName: "ext4",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "reservedBlocksPercentage",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Percentage of the filesystem blocks reserved for the super-user (mke2fs default is 5%).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Percentage of the filesystem blocks reserved for the super-user (mke2fs default is 5%)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 1)
+
+	return doc
+}
+
+func (BTRFSSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BTRFSSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BTRFSSpec configures btrfs filesystem options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BTRFSSpec configures btrfs filesystem options.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FilesystemSpec",
+				FieldName: "btrfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "compression",
+				Type:        "string",
+				Note:        "",
+				Description: "Transparent compression algorithm used when mounting the filesystem.\n\nSupported algorithms are `zlib`, `lzo` and `zstd`, the level can be appended after a colon.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Transparent compression algorithm used when mounting the filesystem." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "zstd:3")
+
+	return doc
+}
+
 func (DiskSelector) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "DiskSelector",
@@ -116,15 +781,72 @@ func (DiskSelector) Doc() *encoder.Doc {
 	return doc
 }
 
+func (ZramSwapConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ZramSwapConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ZramSwapConfig is a zram (compressed RAM block device) swap configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ZramSwapConfig is a zram (compressed RAM block device) swap configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "size",
+				Type:        "ByteSize",
+				Note:        "",
+				Description: "The size of the zram device (uncompressed).\n\nSize is specified in bytes, but can be expressed in human readable format, e.g. 100MB.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The size of the zram device (uncompressed)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "algorithm",
+				Type:        "string",
+				Note:        "",
+				Description: "The compression algorithm of the zram device, defaults to the kernel default.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The compression algorithm of the zram device, defaults to the kernel default." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"lzo",
+					"lzo-rle",
+					"lz4",
+					"lz4hc",
+					"zstd",
+					"842",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleZramSwapConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "2GiB")
+	doc.Fields[2].AddExample("", "zstd")
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file block_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
 		Name:        "block",
 		Description: "Package block provides block device and volume configuration documents.\n",
 		Structs: []*encoder.Doc{
+			EncryptionSpec{}.Doc(),
+			EncryptionKey{}.Doc(),
+			EncryptionKeyStatic{}.Doc(),
+			EncryptionKeyKMS{}.Doc(),
+			EncryptionKeyVault{}.Doc(),
+			EncryptionKeyVaultAppRole{}.Doc(),
+			EncryptionKeyVaultCert{}.Doc(),
+			EncryptionKeyTPM{}.Doc(),
+			EncryptionKeyNodeID{}.Doc(),
+			ProjectQuotaConfigV1Alpha1{}.Doc(),
+			SwapVolumeConfigV1Alpha1{}.Doc(),
 			VolumeConfigV1Alpha1{}.Doc(),
 			ProvisioningSpec{}.Doc(),
+			RAIDSpec{}.Doc(),
+			LVMSpec{}.Doc(),
+			FilesystemSpec{}.Doc(),
+			EXT4Spec{}.Doc(),
+			BTRFSSpec{}.Doc(),
 			DiskSelector{}.Doc(),
+			ZramSwapConfigV1Alpha1{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package blockhelpers
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// ValidateVaultKey validates the Vault encryption key configuration.
+func ValidateVaultKey(key config.EncryptionKeyVault) error {
+	var validationErrors error
+
+	if key.Address() == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("vault address is required"))
+	} else if u, err := url.Parse(key.Address()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("vault address %q should be an http(s) URL", key.Address()))
+	}
+
+	if key.TransitKey() == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("vault transit key name is required"))
+	}
+
+	switch {
+	case key.AppRole() != nil && key.Cert() != nil:
+		validationErrors = errors.Join(validationErrors, errors.New("only one of vault appRole and cert auth methods can be configured"))
+	case key.AppRole() != nil:
+		if key.AppRole().RoleID() == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("vault appRole role ID is required"))
+		}
+	case key.Cert() != nil:
+		// if the client identity is not set, the node identity is used
+		if identity := key.Cert().ClientIdentity(); identity != nil {
+			if len(identity.Crt) == 0 || len(identity.Key) == 0 {
+				validationErrors = errors.Join(validationErrors, errors.New("vault cert client identity should have both certificate and key"))
+			} else if _, err := tls.X509KeyPair(identity.Crt, identity.Key); err != nil {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("vault cert client identity is invalid: %w", err))
+			}
+		}
+	default:
+		validationErrors = errors.Join(validationErrors, errors.New("vault auth method (appRole or cert) is required"))
+	}
+
+	return validationErrors
+}
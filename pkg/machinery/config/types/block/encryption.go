@@ -0,0 +1,449 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-blockdevice/v2/encryption"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block/blockhelpers"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Check interfaces.
+var (
+	_ config.Encryption    = EncryptionSpec{}
+	_ config.EncryptionKey = EncryptionKey{}
+)
+
+// EncryptionSpec represents volume encryption settings.
+type EncryptionSpec struct {
+	//   description: >
+	//     Encryption provider to use for the encryption.
+	//   values:
+	//     - luks2
+	//   examples:
+	//     - value: '"luks2"'
+	EncryptionProvider string `yaml:"provider"`
+	//   description: >
+	//     Defines the encryption keys generation and storage method.
+	EncryptionKeys []EncryptionKey `yaml:"keys"`
+	//   description: >
+	//     Cipher to use for the encryption.
+	//     Depends on the encryption provider.
+	//   values:
+	//     - aes-xts-plain64
+	//     - xchacha12,aes-adiantum-plain64
+	//     - xchacha20,aes-adiantum-plain64
+	//   examples:
+	//     - value: '"aes-xts-plain64"'
+	EncryptionCipher string `yaml:"cipher,omitempty"`
+	//   description: >
+	//     Defines the encryption key length.
+	EncryptionKeySize uint `yaml:"keySize,omitempty"`
+	//   description: >
+	//     Defines the encryption sector size.
+	//   examples:
+	//     - value: '4096'
+	EncryptionBlockSize uint64 `yaml:"blockSize,omitempty"`
+	//   description: >
+	//     Additional --perf parameters for the LUKS2 encryption.
+	//   values:
+	//     - no_read_workqueue
+	//     - no_write_workqueue
+	//     - same_cpu_crypt
+	//   examples:
+	//     -  value: >
+	//          []string{"no_read_workqueue","no_write_workqueue"}
+	EncryptionPerfOptions []string `yaml:"options,omitempty"`
+}
+
+// EncryptionKey represents configuration for disk encryption key.
+type EncryptionKey struct {
+	//   description: >
+	//     Key slot number for LUKS2 encryption.
+	KeySlot int `yaml:"slot"`
+	//   description: >
+	//     Key which value is stored in the configuration file.
+	KeyStatic *EncryptionKeyStatic `yaml:"static,omitempty"`
+	//   description: >
+	//     Deterministically generated key from the node UUID and PartitionLabel.
+	KeyNodeID *EncryptionKeyNodeID `yaml:"nodeID,omitempty"`
+	//   description: >
+	//     KMS managed encryption key.
+	KeyKMS *EncryptionKeyKMS `yaml:"kms,omitempty"`
+	//   description: >
+	//     Enable TPM based disk encryption.
+	KeyTPM *EncryptionKeyTPM `yaml:"tpm,omitempty"`
+	//   description: >
+	//     Vault Transit secrets engine managed encryption key.
+	KeyVault *EncryptionKeyVault `yaml:"vault,omitempty"`
+}
+
+// EncryptionKeyStatic represents throw away key type.
+type EncryptionKeyStatic struct {
+	//   description: >
+	//     Defines the static passphrase value.
+	KeyData string `yaml:"passphrase,omitempty"`
+}
+
+// EncryptionKeyKMS represents a key that is generated and then sealed/unsealed by the KMS server.
+type EncryptionKeyKMS struct {
+	//   description: >
+	//     KMS endpoint to Seal/Unseal the key.
+	KMSEndpoint string `yaml:"endpoint"`
+}
+
+// EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine.
+type EncryptionKeyVault struct {
+	//   description: >
+	//     Vault server address.
+	//   examples:
+	//     - value: '"https://vault.example.com:8200"'
+	VaultAddress string `yaml:"address"`
+	//   description: >
+	//     CA certificate to verify the Vault server certificate.
+	//
+	//     If not set, the system trusted CA certificates are used.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	VaultCA *x509.PEMEncodedCertificate `yaml:"ca,omitempty"`
+	//   description: >
+	//     Mount path of the Transit secrets engine.
+	//
+	//     Defaults to `transit`.
+	VaultTransitMount string `yaml:"transitMount,omitempty"`
+	//   description: >
+	//     Name of the Transit encryption key used to seal/unseal the volume key.
+	//
+	//     The volume key is sealed with the node UUID as the key derivation context,
+	//     so the key might be created with `derived=true` to bind the sealed data to the node.
+	//   examples:
+	//     - value: '"talos"'
+	VaultTransitKey string `yaml:"transitKey"`
+	//   description: >
+	//     Authenticate to Vault using the AppRole auth method.
+	VaultAppRole *EncryptionKeyVaultAppRole `yaml:"appRole,omitempty"`
+	//   description: >
+	//     Authenticate to Vault using the TLS certificates auth method.
+	VaultCert *EncryptionKeyVaultCert `yaml:"cert,omitempty"`
+}
+
+// EncryptionKeyVaultAppRole represents Vault AppRole auth method settings.
+type EncryptionKeyVaultAppRole struct {
+	//   description: >
+	//     Mount path of the AppRole auth method.
+	//
+	//     Defaults to `approle`.
+	AppRoleMountPath string `yaml:"mountPath,omitempty"`
+	//   description: >
+	//     AppRole role ID.
+	AppRoleRoleID string `yaml:"roleID"`
+	//   description: >
+	//     AppRole secret ID.
+	AppRoleSecretID string `yaml:"secretID,omitempty"`
+}
+
+// EncryptionKeyVaultCert represents Vault TLS certificates auth method settings.
+type EncryptionKeyVaultCert struct {
+	//   description: >
+	//     Mount path of the TLS certificates auth method.
+	//
+	//     Defaults to `cert`.
+	CertMountPath string `yaml:"mountPath,omitempty"`
+	//   description: >
+	//     Name of the certificate role to authenticate against.
+	//
+	//     If not set, Vault tries all certificate roles.
+	CertName string `yaml:"name,omitempty"`
+	//   description: >
+	//     The client certificate and key to authenticate with.
+	//
+	//     If not set, the node identity is used: the client certificate issued by the node identity CA.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	CertClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+}
+
+// EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM.
+type EncryptionKeyTPM struct {
+	//   description: >
+	//     Check that Secureboot is enabled in the EFI firmware.
+	//
+	//     If Secureboot is not enabled, the enrollment of the key will fail.
+	TPMCheckSecurebootStatusOnEnroll *bool `yaml:"checkSecurebootStatusOnEnroll,omitempty"`
+}
+
+// EncryptionKeyNodeID represents deterministically generated key from the node UUID and PartitionLabel.
+type EncryptionKeyNodeID struct{}
+
+// IsZero checks if the encryption spec is empty.
+func (s EncryptionSpec) IsZero() bool {
+	return s.EncryptionProvider == "" && len(s.EncryptionKeys) == 0
+}
+
+// Provider implements config.Encryption interface.
+func (s EncryptionSpec) Provider() string {
+	if s.EncryptionProvider == "" {
+		return encryption.LUKS2
+	}
+
+	return s.EncryptionProvider
+}
+
+// Cipher implements config.Encryption interface.
+func (s EncryptionSpec) Cipher() string {
+	return s.EncryptionCipher
+}
+
+// KeySize implements config.Encryption interface.
+func (s EncryptionSpec) KeySize() uint {
+	return s.EncryptionKeySize
+}
+
+// BlockSize implements config.Encryption interface.
+func (s EncryptionSpec) BlockSize() uint64 {
+	return s.EncryptionBlockSize
+}
+
+// Options implements config.Encryption interface.
+func (s EncryptionSpec) Options() []string {
+	return s.EncryptionPerfOptions
+}
+
+// Keys implements config.Encryption interface.
+func (s EncryptionSpec) Keys() []config.EncryptionKey {
+	return xslices.Map(s.EncryptionKeys, func(k EncryptionKey) config.EncryptionKey { return k })
+}
+
+func (s EncryptionSpec) validate() error {
+	if s.IsZero() {
+		return nil
+	}
+
+	var validationErrors error
+
+	if s.Provider() != encryption.LUKS2 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported encryption provider %q", s.EncryptionProvider))
+	}
+
+	if len(s.EncryptionKeys) == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("no encryption keys provided"))
+	}
+
+	slotsInUse := map[int]struct{}{}
+
+	for _, key := range s.EncryptionKeys {
+		if _, inUse := slotsInUse[key.KeySlot]; inUse {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("encryption key slot %d is already in use", key.KeySlot))
+		}
+
+		slotsInUse[key.KeySlot] = struct{}{}
+
+		if key.KeyStatic == nil && key.KeyNodeID == nil && key.KeyKMS == nil && key.KeyTPM == nil && key.KeyVault == nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("encryption key at slot %d doesn't have the configuration parameters", key.KeySlot))
+		}
+
+		if key.KeyVault != nil {
+			if err := blockhelpers.ValidateVaultKey(key.KeyVault); err != nil {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("encryption key at slot %d: %w", key.KeySlot, err))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+// Slot implements config.EncryptionKey interface.
+func (k EncryptionKey) Slot() int {
+	return k.KeySlot
+}
+
+// Static implements config.EncryptionKey interface.
+func (k EncryptionKey) Static() config.EncryptionKeyStatic {
+	if k.KeyStatic == nil {
+		return nil
+	}
+
+	return k.KeyStatic
+}
+
+// NodeID implements config.EncryptionKey interface.
+func (k EncryptionKey) NodeID() config.EncryptionKeyNodeID {
+	if k.KeyNodeID == nil {
+		return nil
+	}
+
+	return k.KeyNodeID
+}
+
+// KMS implements config.EncryptionKey interface.
+func (k EncryptionKey) KMS() config.EncryptionKeyKMS {
+	if k.KeyKMS == nil {
+		return nil
+	}
+
+	return k.KeyKMS
+}
+
+// TPM implements config.EncryptionKey interface.
+func (k EncryptionKey) TPM() config.EncryptionKeyTPM {
+	if k.KeyTPM == nil {
+		return nil
+	}
+
+	return k.KeyTPM
+}
+
+// Vault implements config.EncryptionKey interface.
+func (k EncryptionKey) Vault() config.EncryptionKeyVault {
+	if k.KeyVault == nil {
+		return nil
+	}
+
+	return k.KeyVault
+}
+
+// Key implements config.EncryptionKeyStatic interface.
+func (k *EncryptionKeyStatic) Key() []byte {
+	return []byte(k.KeyData)
+}
+
+// String implements config.EncryptionKeyStatic interface.
+func (k *EncryptionKeyStatic) String() string {
+	return "static"
+}
+
+// String implements config.EncryptionKeyNodeID interface.
+func (k *EncryptionKeyNodeID) String() string {
+	return "nodeid"
+}
+
+// Endpoint implements config.EncryptionKeyKMS interface.
+func (k *EncryptionKeyKMS) Endpoint() string {
+	return k.KMSEndpoint
+}
+
+// String implements config.EncryptionKeyKMS interface.
+func (k *EncryptionKeyKMS) String() string {
+	return "kms"
+}
+
+// CheckSecurebootOnEnroll implements config.EncryptionKeyTPM interface.
+func (k *EncryptionKeyTPM) CheckSecurebootOnEnroll() bool {
+	return pointer.SafeDeref(k.TPMCheckSecurebootStatusOnEnroll)
+}
+
+// String implements config.EncryptionKeyTPM interface.
+func (k *EncryptionKeyTPM) String() string {
+	return "tpm"
+}
+
+// Address implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) Address() string {
+	return k.VaultAddress
+}
+
+// CA implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) CA() []byte {
+	if k.VaultCA == nil {
+		return nil
+	}
+
+	return k.VaultCA.Crt
+}
+
+// TransitMount implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) TransitMount() string {
+	if k.VaultTransitMount == "" {
+		return constants.DefaultVaultTransitMount
+	}
+
+	return k.VaultTransitMount
+}
+
+// TransitKey implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) TransitKey() string {
+	return k.VaultTransitKey
+}
+
+// AppRole implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) AppRole() config.EncryptionKeyVaultAppRole {
+	if k.VaultAppRole == nil {
+		return nil
+	}
+
+	return k.VaultAppRole
+}
+
+// Cert implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) Cert() config.EncryptionKeyVaultCert {
+	if k.VaultCert == nil {
+		return nil
+	}
+
+	return k.VaultCert
+}
+
+// String implements config.EncryptionKeyVault interface.
+func (k *EncryptionKeyVault) String() string {
+	return "vault"
+}
+
+// MountPath implements config.EncryptionKeyVaultAppRole interface.
+func (k *EncryptionKeyVaultAppRole) MountPath() string {
+	if k.AppRoleMountPath == "" {
+		return constants.DefaultVaultAppRoleMount
+	}
+
+	return k.AppRoleMountPath
+}
+
+// RoleID implements config.EncryptionKeyVaultAppRole interface.
+func (k *EncryptionKeyVaultAppRole) RoleID() string {
+	return k.AppRoleRoleID
+}
+
+// SecretID implements config.EncryptionKeyVaultAppRole interface.
+func (k *EncryptionKeyVaultAppRole) SecretID() string {
+	return k.AppRoleSecretID
+}
+
+// MountPath implements config.EncryptionKeyVaultCert interface.
+func (k *EncryptionKeyVaultCert) MountPath() string {
+	if k.CertMountPath == "" {
+		return constants.DefaultVaultCertMount
+	}
+
+	return k.CertMountPath
+}
+
+// Name implements config.EncryptionKeyVaultCert interface.
+func (k *EncryptionKeyVaultCert) Name() string {
+	return k.CertName
+}
+
+// ClientIdentity implements config.EncryptionKeyVaultCert interface.
+func (k *EncryptionKeyVaultCert) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return k.CertClientIdentity
+}
@@ -0,0 +1,15 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+// SetExecutableSearchPath overrides the search path for the required tools.
+func SetExecutableSearchPath(path string) (restore func()) {
+	oldPath := executableSearchPath
+	executableSearchPath = path
+
+	return func() {
+		executableSearchPath = oldPath
+	}
+}
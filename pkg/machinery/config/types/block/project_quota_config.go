@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ProjectQuotaConfigKind is a config document kind.
+const ProjectQuotaConfigKind = "ProjectQuotaConfig"
+
+func init() {
+	registry.Register(ProjectQuotaConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ProjectQuotaConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ProjectQuotaConfig = &ProjectQuotaConfigV1Alpha1{}
+	_ config.NamedDocument      = &ProjectQuotaConfigV1Alpha1{}
+	_ config.Validator          = &ProjectQuotaConfigV1Alpha1{}
+)
+
+// ProjectQuotaConfigV1Alpha1 is a directory disk usage limit (XFS project quota) configuration document.
+//
+//	examples:
+//	  - value: exampleProjectQuotaConfigV1Alpha1()
+//	alias: ProjectQuotaConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ProjectQuotaConfig
+type ProjectQuotaConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Path of the directory to limit.
+	//
+	//     The directory should be on the EPHEMERAL volume (under `/var`), the quota is applied once the directory is created.
+	//   examples:
+	//     - value: >
+	//         "/var/log"
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The hard limit of the disk usage of the directory.
+	//
+	//     Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//   examples:
+	//     - value: >
+	//         "10GiB"
+	//   schema:
+	//     type: string
+	QuotaHardLimit ByteSize `yaml:"hardLimit"`
+	//   description: |
+	//     The soft limit of the disk usage of the directory.
+	//
+	//     The soft limit can be exceeded for the grace period, it should be less than the hard limit.
+	//   examples:
+	//     - value: >
+	//         "8GiB"
+	//   schema:
+	//     type: string
+	QuotaSoftLimit ByteSize `yaml:"softLimit,omitempty"`
+}
+
+// NewProjectQuotaConfigV1Alpha1 creates a new project quota config document.
+func NewProjectQuotaConfigV1Alpha1() *ProjectQuotaConfigV1Alpha1 {
+	return &ProjectQuotaConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ProjectQuotaConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleProjectQuotaConfigV1Alpha1() *ProjectQuotaConfigV1Alpha1 {
+	cfg := NewProjectQuotaConfigV1Alpha1()
+	cfg.MetaName = "/var/log"
+	cfg.QuotaHardLimit = MustByteSize("10GiB")
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ProjectQuotaConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *ProjectQuotaConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// ProjectQuotaConfigSignal is a signal for project quota config.
+func (s *ProjectQuotaConfigV1Alpha1) ProjectQuotaConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *ProjectQuotaConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	switch {
+	case !filepath.IsAbs(s.MetaName) || filepath.Clean(s.MetaName) != s.MetaName:
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("project quota path %q should be an absolute clean path", s.MetaName))
+	case !strings.HasPrefix(s.MetaName, constants.EphemeralMountPoint+"/"):
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("project quota path %q should be under %s", s.MetaName, constants.EphemeralMountPoint))
+	}
+
+	if s.QuotaHardLimit.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("project quota hard limit is required"))
+	}
+
+	if !s.QuotaSoftLimit.IsZero() && !s.QuotaHardLimit.IsZero() && s.QuotaSoftLimit.Value() > s.QuotaHardLimit.Value() {
+		validationErrors = errors.Join(validationErrors, errors.New("project quota soft limit is greater than hard limit"))
+	}
+
+	return nil, validationErrors
+}
+
+// Path implements config.ProjectQuotaConfig interface.
+func (s *ProjectQuotaConfigV1Alpha1) Path() string {
+	return s.MetaName
+}
+
+// HardLimit implements config.ProjectQuotaConfig interface.
+func (s *ProjectQuotaConfigV1Alpha1) HardLimit() uint64 {
+	return s.QuotaHardLimit.Value()
+}
+
+// SoftLimit implements config.ProjectQuotaConfig interface.
+func (s *ProjectQuotaConfigV1Alpha1) SoftLimit() optional.Optional[uint64] {
+	if s.QuotaSoftLimit.IsZero() {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(s.QuotaSoftLimit.Value())
+}
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+)
+
+func TestProjectQuotaConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: ProjectQuotaConfig
+name: /var/log
+hardLimit: 10GiB
+softLimit: 8GiB
+---
+apiVersion: v1alpha1
+kind: ProjectQuotaConfig
+name: /var/lib/containerd
+hardLimit: 100GiB
+`))
+	require.NoError(t, err)
+
+	quotas := provider.ProjectQuotas()
+	require.Len(t, quotas, 2)
+
+	assert.Equal(t, "/var/log", quotas[0].Path())
+	assert.EqualValues(t, 10*1024*1024*1024, quotas[0].HardLimit())
+	assert.EqualValues(t, 8*1024*1024*1024, quotas[0].SoftLimit().ValueOr(0))
+
+	assert.Equal(t, "/var/lib/containerd", quotas[1].Path())
+	assert.EqualValues(t, 100*1024*1024*1024, quotas[1].HardLimit())
+	assert.False(t, quotas[1].SoftLimit().IsPresent())
+}
+
+func TestProjectQuotaConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *block.ProjectQuotaConfigV1Alpha1
+
+		expectedErrors string
+	}{
+		{
+			name: "empty",
+			cfg:  block.NewProjectQuotaConfigV1Alpha1,
+
+			expectedErrors: "project quota path \"\" should be an absolute clean path\nproject quota hard limit is required",
+		},
+		{
+			name: "not clean",
+			cfg: func() *block.ProjectQuotaConfigV1Alpha1 {
+				c := block.NewProjectQuotaConfigV1Alpha1()
+				c.MetaName = "/var/log/../lib"
+				c.QuotaHardLimit = block.MustByteSize("1GiB")
+
+				return c
+			},
+
+			expectedErrors: "project quota path \"/var/log/../lib\" should be an absolute clean path",
+		},
+		{
+			name: "not on ephemeral",
+			cfg: func() *block.ProjectQuotaConfigV1Alpha1 {
+				c := block.NewProjectQuotaConfigV1Alpha1()
+				c.MetaName = "/var"
+				c.QuotaHardLimit = block.MustByteSize("1GiB")
+
+				return c
+			},
+
+			expectedErrors: "project quota path \"/var\" should be under /var",
+		},
+		{
+			name: "soft limit too big",
+			cfg: func() *block.ProjectQuotaConfigV1Alpha1 {
+				c := block.NewProjectQuotaConfigV1Alpha1()
+				c.MetaName = "/var/lib/etcd"
+				c.QuotaHardLimit = block.MustByteSize("1GiB")
+				c.QuotaSoftLimit = block.MustByteSize("2GiB")
+
+				return c
+			},
+
+			expectedErrors: "project quota soft limit is greater than hard limit",
+		},
+		{
+			name: "valid",
+			cfg: func() *block.ProjectQuotaConfigV1Alpha1 {
+				c := block.NewProjectQuotaConfigV1Alpha1()
+				c.MetaName = "/var/lib/etcd"
+				c.QuotaHardLimit = block.MustByteSize("10GiB")
+				c.QuotaSoftLimit = block.MustByteSize("8GiB")
+
+				return c
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedErrors == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// requiredTool is a tool which is not part of the Talos rootfs, and should be provided by a system extension.
+type requiredTool struct {
+	feature  string
+	pkg      string
+	binaries []string
+}
+
+// executableSearchPath is the list of directories to look up the required tools in.
+var executableSearchPath = constants.PATH
+
+func executableAvailable(name string) bool {
+	for _, dir := range filepath.SplitList(executableSearchPath) {
+		st, err := os.Stat(filepath.Join(dir, name))
+		if err == nil && st.Mode().IsRegular() && st.Mode().Perm()&0o111 != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *VolumeConfigV1Alpha1) requiredTools() []requiredTool {
+	var tools []requiredTool
+
+	switch s.FilesystemSpec.FilesystemType { //nolint:exhaustive
+	case block.FilesystemTypeEXT4:
+		tools = append(tools, requiredTool{
+			feature:  "filesystem type ext4",
+			pkg:      "e2fsprogs",
+			binaries: []string{"mkfs.ext4", "e2fsck", "resize2fs"},
+		})
+	case block.FilesystemTypeBTRFS:
+		tools = append(tools, requiredTool{
+			feature:  "filesystem type btrfs",
+			pkg:      "btrfs-progs",
+			binaries: []string{"mkfs.btrfs", "btrfs"},
+		})
+	}
+
+	if s.ProvisioningSpec.RAIDSpec.RAIDLevel != "" {
+		tools = append(tools, requiredTool{
+			feature:  "RAID provisioning",
+			pkg:      "mdadm",
+			binaries: []string{"mdadm"},
+		})
+	}
+
+	return tools
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// SwapVolumeConfigKind is a config document kind.
+const SwapVolumeConfigKind = "SwapVolumeConfig"
+
+func init() {
+	registry.Register(SwapVolumeConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SwapVolumeConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SwapVolumeConfig = &SwapVolumeConfigV1Alpha1{}
+	_ config.NamedDocument    = &SwapVolumeConfigV1Alpha1{}
+	_ config.Validator        = &SwapVolumeConfigV1Alpha1{}
+)
+
+// SwapVolumeConfigV1Alpha1 is a swap volume configuration document.
+//
+//	examples:
+//	  - value: exampleSwapVolumeConfigV1Alpha1()
+//	alias: SwapVolumeConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SwapVolumeConfig
+type SwapVolumeConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the swap volume.
+	//
+	//     The partition label of the volume is `s-<name>`.
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The provisioning describes how the volume is provisioned.
+	//
+	//     LVM and RAID provisioning is not supported for swap volumes.
+	ProvisioningSpec ProvisioningSpec `yaml:"provisioning,omitempty"`
+	//   description: |
+	//     The encryption describes how the volume is encrypted.
+	//
+	//     The key providers are the same as for the system disk encryption.
+	EncryptionSpec EncryptionSpec `yaml:"encryption,omitempty"`
+}
+
+// NewSwapVolumeConfigV1Alpha1 creates a new swap volume config document.
+func NewSwapVolumeConfigV1Alpha1() *SwapVolumeConfigV1Alpha1 {
+	return &SwapVolumeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SwapVolumeConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSwapVolumeConfigV1Alpha1() *SwapVolumeConfigV1Alpha1 {
+	cfg := NewSwapVolumeConfigV1Alpha1()
+	cfg.MetaName = "swap1"
+	cfg.ProvisioningSpec = ProvisioningSpec{
+		DiskSelectorSpec: DiskSelector{
+			Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme" && !system_disk`, celenv.DiskLocator())),
+		},
+		ProvisioningMinSize: MustByteSize("4GiB"),
+		ProvisioningMaxSize: MustByteSize("4GiB"),
+	}
+	cfg.EncryptionSpec = EncryptionSpec{
+		EncryptionProvider: "luks2",
+		EncryptionKeys: []EncryptionKey{
+			{
+				KeySlot:   0,
+				KeyNodeID: &EncryptionKeyNodeID{},
+			},
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SwapVolumeConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *SwapVolumeConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// SwapVolumeConfigSignal is a signal for swap volume config.
+func (s *SwapVolumeConfigV1Alpha1) SwapVolumeConfigSignal() {}
+
+// maxSwapVolumeNameLength is the maximum length of the swap volume name.
+//
+// The name is prefixed with constants.SwapVolumePrefix and used as a GPT partition label.
+const maxSwapVolumeNameLength = 36 - len(constants.SwapVolumePrefix)
+
+// Validate implements config.Validator interface.
+func (s *SwapVolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if len(s.MetaName) > maxSwapVolumeNameLength {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("swap volume name is too long: %d > %d", len(s.MetaName), maxSwapVolumeNameLength))
+	}
+
+	if !userVolumeNameRegexp.MatchString(s.MetaName) {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("swap volume name %q is invalid, it should consist of lowercase alphanumeric characters and dashes", s.MetaName))
+	}
+
+	if s.ProvisioningSpec.DiskSelectorSpec.Match.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("disk selector is required for swap volumes"))
+	} else if err := s.ProvisioningSpec.DiskSelectorSpec.Match.ParseBool(celenv.DiskLocator()); err != nil {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("disk selector is invalid: %w", err))
+	}
+
+	if s.ProvisioningSpec.ProvisioningGrow != nil && *s.ProvisioningSpec.ProvisioningGrow {
+		validationErrors = errors.Join(validationErrors, errors.New("swap volumes can't be grown"))
+	}
+
+	if !s.ProvisioningSpec.LVMSpec.IsZero() || s.ProvisioningSpec.RAIDSpec != (RAIDSpec{}) {
+		validationErrors = errors.Join(validationErrors, errors.New("LVM and RAID provisioning is not supported for swap volumes"))
+	}
+
+	if !s.ProvisioningSpec.ProvisioningMinSize.IsZero() && !s.ProvisioningSpec.ProvisioningMaxSize.IsZero() {
+		if s.ProvisioningSpec.ProvisioningMinSize.Value() > s.ProvisioningSpec.ProvisioningMaxSize.Value() {
+			validationErrors = errors.Join(validationErrors, errors.New("min size is greater than max size"))
+		}
+	}
+
+	if err := s.EncryptionSpec.validate(); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	return nil, validationErrors
+}
+
+// Provisioning implements config.SwapVolumeConfig interface.
+func (s *SwapVolumeConfigV1Alpha1) Provisioning() config.VolumeProvisioningConfig {
+	return s.ProvisioningSpec
+}
+
+// Encryption implements config.SwapVolumeConfig interface.
+func (s *SwapVolumeConfigV1Alpha1) Encryption() config.Encryption {
+	if s.EncryptionSpec.IsZero() {
+		return nil
+	}
+
+	return s.EncryptionSpec
+}
@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+)
+
+func TestSwapVolumeConfigMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		filename string
+		cfg      func(t *testing.T) *block.SwapVolumeConfigV1Alpha1
+	}{
+		{
+			name:     "encrypted",
+			filename: "swapvolumeconfig_encrypted.yaml",
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "swap1"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.ProvisioningMinSize = block.MustByteSize("4GiB")
+				c.ProvisioningSpec.ProvisioningMaxSize = block.MustByteSize("4GiB")
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionProvider: "luks2",
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot:   0,
+							KeyNodeID: &block.EncryptionKeyNodeID{},
+						},
+						{
+							KeySlot: 1,
+							KeyTPM: &block.EncryptionKeyTPM{
+								TPMCheckSecurebootStatusOnEnroll: pointer.To(true),
+							},
+						},
+					},
+				}
+
+				return c
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := test.cfg(t)
+
+			marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+			require.NoError(t, err)
+
+			t.Log(string(marshaled))
+
+			expectedMarshaled, err := os.ReadFile(filepath.Join("testdata", test.filename))
+			require.NoError(t, err)
+
+			assert.Equal(t, string(expectedMarshaled), string(marshaled))
+
+			provider, err := configloader.NewFromBytes(expectedMarshaled)
+			require.NoError(t, err)
+
+			docs := provider.Documents()
+			require.Len(t, docs, 1)
+
+			assert.Equal(t, cfg, docs[0])
+		})
+	}
+}
+
+func TestSwapVolumeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		cfg func(t *testing.T) *block.SwapVolumeConfigV1Alpha1
+
+		expectedErrors string
+	}{
+		{
+			name: "invalid name",
+
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "Swap_1"
+
+				return c
+			},
+
+			expectedErrors: "swap volume name \"Swap_1\" is invalid, it should consist of lowercase alphanumeric characters and dashes\ndisk selector is required for swap volumes",
+		},
+		{
+			name: "unsupported provisioning",
+
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "swap1"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.ProvisioningGrow = pointer.To(true)
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "vg0"
+
+				return c
+			},
+
+			expectedErrors: "swap volumes can't be grown\nLVM and RAID provisioning is not supported for swap volumes",
+		},
+		{
+			name: "invalid encryption",
+
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "swap1"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionProvider: "luks1",
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot:   0,
+							KeyNodeID: &block.EncryptionKeyNodeID{},
+						},
+						{
+							KeySlot: 0,
+						},
+					},
+				}
+
+				return c
+			},
+
+			expectedErrors: "unsupported encryption provider \"luks1\"\nencryption key slot 0 is already in use\nencryption key at slot 0 doesn't have the configuration parameters",
+		},
+		{
+			name: "invalid vault key",
+
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "swap1"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot: 0,
+							KeyVault: &block.EncryptionKeyVault{
+								VaultAddress: "vault.example.com:8200",
+							},
+						},
+						{
+							KeySlot: 1,
+							KeyVault: &block.EncryptionKeyVault{
+								VaultAddress:    "https://vault.example.com:8200",
+								VaultTransitKey: "talos",
+								VaultCert: &block.EncryptionKeyVaultCert{
+									CertClientIdentity: &x509.PEMEncodedCertificateAndKey{
+										Crt: []byte("cert"),
+									},
+								},
+							},
+						},
+					},
+				}
+
+				return c
+			},
+
+			expectedErrors: "encryption key at slot 0: vault address \"vault.example.com:8200\" should be an http(s) URL\nvault transit key name is required\nvault auth method (appRole or cert) is required\nencryption key at slot 1: vault cert client identity should have both certificate and key", //nolint:lll
+		},
+		{
+			name: "valid",
+
+			cfg: func(t *testing.T) *block.SwapVolumeConfigV1Alpha1 {
+				c := block.NewSwapVolumeConfigV1Alpha1()
+				c.MetaName = "swap1"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`disk.size > 120u * GiB`)))
+				c.ProvisioningSpec.ProvisioningMinSize = block.MustByteSize("1GiB")
+				c.EncryptionSpec = block.EncryptionSpec{
+					EncryptionKeys: []block.EncryptionKey{
+						{
+							KeySlot: 0,
+							KeyStatic: &block.EncryptionKeyStatic{
+								KeyData: "secret",
+							},
+						},
+						{
+							KeySlot: 1,
+							KeyVault: &block.EncryptionKeyVault{
+								VaultAddress:    "https://vault.example.com:8200",
+								VaultTransitKey: "talos",
+								VaultAppRole: &block.EncryptionKeyVaultAppRole{
+									AppRoleRoleID: "role",
+								},
+							},
+						},
+						{
+							KeySlot: 2,
+							KeyVault: &block.EncryptionKeyVault{
+								VaultAddress:    "https://vault.example.com:8200",
+								VaultTransitKey: "talos",
+								VaultCert:       &block.EncryptionKeyVaultCert{},
+							},
+						},
+					},
+				}
+
+				return c
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := test.cfg(t)
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedErrors == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+
+				assert.EqualError(t, err, test.expectedErrors)
+			}
+		})
+	}
+}
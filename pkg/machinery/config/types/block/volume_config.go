@@ -7,9 +7,14 @@ package block
 //docgen:jsonschema
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/cel"
@@ -19,6 +24,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
 // VolumeConfigKind is a config document kind.
@@ -37,17 +43,21 @@ func init() {
 
 // Check interfaces.
 var (
-	_ config.VolumeConfig  = &VolumeConfigV1Alpha1{}
-	_ config.NamedDocument = &VolumeConfigV1Alpha1{}
-	_ config.Validator     = &VolumeConfigV1Alpha1{}
+	_ config.VolumeConfig     = &VolumeConfigV1Alpha1{}
+	_ config.NamedDocument    = &VolumeConfigV1Alpha1{}
+	_ config.Validator        = &VolumeConfigV1Alpha1{}
+	_ config.RuntimeValidator = &VolumeConfigV1Alpha1{}
 )
 
 // VolumeConfigV1Alpha1 is a volume configuration document.
 //
-// Note: at the moment, only EPHEMERAL volumes are supported.
+// Note: at the moment, only EPHEMERAL system volume and user volumes are supported.
 //
 //	examples:
 //	  - value: exampleVolumeConfigEphemeralV1Alpha1()
+//	  - value: exampleVolumeConfigUserV1Alpha1()
+//	  - value: exampleVolumeConfigLVMV1Alpha1()
+//	  - value: exampleVolumeConfigRAIDV1Alpha1()
 //	alias: VolumeConfig
 //	schemaRoot: true
 //	schemaMeta: v1alpha1/VolumeConfig
@@ -55,10 +65,27 @@ type VolumeConfigV1Alpha1 struct {
 	meta.Meta `yaml:",inline"`
 	//   description: |
 	//     Name of the volume.
+	//
+	//     Name is either a system volume name (e.g. `EPHEMERAL`) or a user volume name.
+	//     User volumes are mounted at `/var/mnt/<name>`.
 	MetaName string `yaml:"name"`
 	//   description: |
 	//     The provisioning describes how the volume is provisioned.
 	ProvisioningSpec ProvisioningSpec `yaml:"provisioning,omitempty"`
+	//   description: |
+	//     The filesystem describes how the volume is formatted.
+	FilesystemSpec FilesystemSpec `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     How long the boot waits for the user volume to be provisioned and mounted.
+	//
+	//     If the user volume is not mounted in time, the boot continues,
+	//     and the volume is mounted once it becomes ready.
+	//     Missing and failed user volumes do not block the boot.
+	//     Defaults to 5 minutes, only supported for user volumes.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	VolumeMountTimeout time.Duration `yaml:"mountTimeout,omitempty"`
 }
 
 // ProvisioningSpec describes how the volume is provisioned.
@@ -90,6 +117,120 @@ type ProvisioningSpec struct {
 	//  schema:
 	//    type: string
 	ProvisioningMaxSize ByteSize `yaml:"maxSize,omitempty"`
+	//  description: |
+	//    Provision the volume as an LVM logical volume.
+	//
+	//    Empty disks matched by the disk selector are added to the volume group
+	//    until it has enough free space for the requested size (`maxSize`, or `minSize` if not set),
+	//    the logical volume is carved out of the volume group.
+	//    LVM provisioning is only supported for user volumes.
+	LVMSpec LVMSpec `yaml:"lvm,omitempty"`
+	//  description: |
+	//    Provision the volume on a software RAID (md) array.
+	//
+	//    The array is created on the empty disks matched by the disk selector,
+	//    and it is assembled again on boot.
+	//    RAID provisioning is supported for the EPHEMERAL and user volumes.
+	//    RAID provisioning requires `mdadm`, which is not part of the Talos rootfs and should be installed as a system extension.
+	RAIDSpec RAIDSpec `yaml:"raid,omitempty"`
+}
+
+// RAIDSpec describes how the volume is provisioned on a software RAID array.
+type RAIDSpec struct {
+	//  description: |
+	//    RAID level of the array.
+	//  values:
+	//    - raid1
+	//    - raid10
+	//  examples:
+	//    - value: >
+	//        "raid1"
+	RAIDLevel string `yaml:"level,omitempty"`
+	//  description: |
+	//    Number of member disks in the array.
+	//
+	//    Defaults to 2 for raid1 and 4 for raid10.
+	//  examples:
+	//    - value: >
+	//        3
+	RAIDDevices int `yaml:"devices,omitempty"`
+}
+
+// LVMSpec describes how the volume is provisioned as an LVM logical volume.
+type LVMSpec struct {
+	//  description: |
+	//    Name of the LVM volume group.
+	//
+	//    The volume group is created on the disks matched by the disk selector,
+	//    several volumes might share the same volume group.
+	//  examples:
+	//    - value: >
+	//        "vg-data"
+	VolumeGroupName string `yaml:"volumeGroup,omitempty"`
+	//  description: |
+	//    Name of the LVM thin pool in the volume group.
+	//
+	//    If set, the logical volume is thin-provisioned in the pool, and the pool is created
+	//    in the volume group if it doesn't exist yet.
+	//    The virtual size of the volume is set by the `maxSize`.
+	//  examples:
+	//    - value: >
+	//        "pool0"
+	ThinPoolName string `yaml:"thinPool,omitempty"`
+	//  description: |
+	//    Size of the LVM thin pool.
+	//
+	//    The size is only used when the pool is created, if not set the pool is created
+	//    with the size of the volume (`maxSize`).
+	//    Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//  examples:
+	//    - value: >
+	//        "500GiB"
+	//  schema:
+	//    type: string
+	ThinPoolSizeSpec ByteSize `yaml:"thinPoolSize,omitempty"`
+}
+
+// FilesystemSpec configures the filesystem for the volume.
+type FilesystemSpec struct {
+	//   description: |
+	//     Filesystem type, defaults to `xfs`.
+	//
+	//     EPHEMERAL volume only supports `xfs`.
+	//     The `ext4` and `btrfs` filesystems require the `e2fsprogs` and `btrfs-progs` tools, which are not part of the Talos rootfs
+	//     and should be installed as system extensions.
+	//   values:
+	//     - "xfs"
+	//     - "ext4"
+	//     - "btrfs"
+	FilesystemType block.FilesystemType `yaml:"type,omitempty"`
+	//   description: |
+	//     ext4 specific filesystem options.
+	EXT4Spec EXT4Spec `yaml:"ext4,omitempty"`
+	//   description: |
+	//     btrfs specific filesystem options.
+	BTRFSSpec BTRFSSpec `yaml:"btrfs,omitempty"`
+}
+
+// EXT4Spec configures ext4 filesystem options.
+type EXT4Spec struct {
+	//   description: |
+	//     Percentage of the filesystem blocks reserved for the super-user (mke2fs default is 5%).
+	//   examples:
+	//     - value: 1
+	ReservedBlocksPercentage *uint32 `yaml:"reservedBlocksPercentage,omitempty"`
+}
+
+// BTRFSSpec configures btrfs filesystem options.
+type BTRFSSpec struct {
+	//   description: |
+	//     Transparent compression algorithm used when mounting the filesystem.
+	//
+	//     Supported algorithms are `zlib`, `lzo` and `zstd`, the level can be appended after a colon.
+	//   examples:
+	//     - value: >
+	//         "zstd:3"
+	Compression string `yaml:"compression,omitempty"`
 }
 
 // DiskSelector selects a disk for the volume.
@@ -131,6 +272,51 @@ func exampleVolumeConfigEphemeralV1Alpha1() *VolumeConfigV1Alpha1 {
 	return cfg
 }
 
+func exampleVolumeConfigUserV1Alpha1() *VolumeConfigV1Alpha1 {
+	cfg := NewVolumeConfigV1Alpha1()
+	cfg.MetaName = "local-data"
+	cfg.ProvisioningSpec = ProvisioningSpec{
+		DiskSelectorSpec: DiskSelector{
+			Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme" && !system_disk`, celenv.DiskLocator())),
+		},
+		ProvisioningMinSize: MustByteSize("10GiB"),
+		ProvisioningMaxSize: MustByteSize("100GiB"),
+	}
+
+	return cfg
+}
+
+func exampleVolumeConfigLVMV1Alpha1() *VolumeConfigV1Alpha1 {
+	cfg := NewVolumeConfigV1Alpha1()
+	cfg.MetaName = "pooled-data"
+	cfg.ProvisioningSpec = ProvisioningSpec{
+		DiskSelectorSpec: DiskSelector{
+			Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme" && !system_disk`, celenv.DiskLocator())),
+		},
+		ProvisioningMaxSize: MustByteSize("1TiB"),
+		LVMSpec: LVMSpec{
+			VolumeGroupName: "vg-data",
+		},
+	}
+
+	return cfg
+}
+
+func exampleVolumeConfigRAIDV1Alpha1() *VolumeConfigV1Alpha1 {
+	cfg := NewVolumeConfigV1Alpha1()
+	cfg.MetaName = constants.EphemeralPartitionLabel
+	cfg.ProvisioningSpec = ProvisioningSpec{
+		DiskSelectorSpec: DiskSelector{
+			Match: cel.MustExpression(cel.ParseBooleanExpression(`disk.transport == "nvme" && !system_disk`, celenv.DiskLocator())),
+		},
+		RAIDSpec: RAIDSpec{
+			RAIDLevel: "raid1",
+		},
+	}
+
+	return cfg
+}
+
 func exampleDiskSelector1() cel.Expression {
 	return cel.MustExpression(cel.ParseBooleanExpression(`disk.size > 120u * GB && disk.size < 1u * TB`, celenv.DiskLocator()))
 }
@@ -149,13 +335,57 @@ func (s *VolumeConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
 }
 
+// maxUserVolumeNameLength is the maximum length of the user volume name.
+//
+// The name is prefixed with constants.UserVolumePrefix and used as a GPT partition label
+// (which is limited to 36 characters).
+const maxUserVolumeNameLength = 36 - len(constants.UserVolumePrefix)
+
+var userVolumeNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
 // Validate implements config.Validator interface.
+//
+//nolint:gocyclo
 func (s *VolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
-	if s.MetaName != constants.EphemeralPartitionLabel {
-		return nil, errors.New("only EPHEMERAL volumes are supported")
+	var validationErrors error
+
+	switch {
+	case s.MetaName == constants.EphemeralPartitionLabel:
+		// system volume, supported
+		if s.VolumeMountTimeout != 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("mount timeout is only supported for user volumes"))
+		}
+	case slices.Contains(config.SystemVolumeNames, s.MetaName):
+		return nil, fmt.Errorf("system volume %q can't be configured", s.MetaName)
+	default:
+		if len(s.MetaName) > maxUserVolumeNameLength {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("user volume name is too long: %d > %d", len(s.MetaName), maxUserVolumeNameLength))
+		}
+
+		if !userVolumeNameRegexp.MatchString(s.MetaName) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("user volume name %q is invalid, it should consist of lowercase alphanumeric characters and dashes", s.MetaName))
+		}
+
+		if s.ProvisioningSpec.DiskSelectorSpec.Match.IsZero() {
+			validationErrors = errors.Join(validationErrors, errors.New("disk selector is required for user volumes"))
+		}
+
+		if s.VolumeMountTimeout < 0 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("mount timeout should be positive: %s", s.VolumeMountTimeout))
+		}
 	}
 
-	var validationErrors error
+	if err := s.ProvisioningSpec.LVMSpec.validate(s.MetaName, s.ProvisioningSpec); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if err := s.ProvisioningSpec.RAIDSpec.validate(s.ProvisioningSpec); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if err := s.FilesystemSpec.validate(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
 
 	if !s.ProvisioningSpec.DiskSelectorSpec.Match.IsZero() {
 		if err := s.ProvisioningSpec.DiskSelectorSpec.Match.ParseBool(celenv.DiskLocator()); err != nil {
@@ -172,11 +402,50 @@ func (s *VolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Op
 	return nil, validationErrors
 }
 
+// RuntimeValidate implements config.RuntimeValidator interface.
+//
+// The tools required to provision the volume which are not part of the Talos rootfs should be provided by the system extensions.
+func (s *VolumeConfigV1Alpha1) RuntimeValidate(_ context.Context, _ state.State, mode validation.RuntimeMode, _ ...validation.Option) ([]string, error) {
+	if mode.InContainer() {
+		return nil, nil
+	}
+
+	var validationErrors error
+
+	for _, tool := range s.requiredTools() {
+		for _, binary := range tool.binaries {
+			if !executableAvailable(binary) {
+				validationErrors = errors.Join(validationErrors,
+					fmt.Errorf("%s requires %s which is not available (%q not found), it should be installed as a system extension", tool.feature, tool.pkg, binary),
+				)
+
+				break
+			}
+		}
+	}
+
+	return nil, validationErrors
+}
+
 // Provisioning implements config.VolumeConfig interface.
 func (s *VolumeConfigV1Alpha1) Provisioning() config.VolumeProvisioningConfig {
 	return s.ProvisioningSpec
 }
 
+// Filesystem implements config.VolumeConfig interface.
+func (s *VolumeConfigV1Alpha1) Filesystem() config.VolumeFilesystemConfig {
+	return s.FilesystemSpec
+}
+
+// MountTimeout implements config.VolumeConfig interface.
+func (s *VolumeConfigV1Alpha1) MountTimeout() optional.Optional[time.Duration] {
+	if s.VolumeMountTimeout == 0 {
+		return optional.None[time.Duration]()
+	}
+
+	return optional.Some(s.VolumeMountTimeout)
+}
+
 // DiskSelector implements config.VolumeProvisioningConfig interface.
 func (s ProvisioningSpec) DiskSelector() optional.Optional[cel.Expression] {
 	if s.DiskSelectorSpec.Match.IsZero() {
@@ -212,3 +481,204 @@ func (s ProvisioningSpec) MaxSize() optional.Optional[uint64] {
 
 	return optional.Some(s.ProvisioningMaxSize.Value())
 }
+
+// LVM implements config.VolumeProvisioningConfig interface.
+func (s ProvisioningSpec) LVM() config.VolumeLVMConfig {
+	return s.LVMSpec
+}
+
+// VolumeGroup implements config.VolumeLVMConfig interface.
+func (s LVMSpec) VolumeGroup() optional.Optional[string] {
+	if s.VolumeGroupName == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.VolumeGroupName)
+}
+
+// ThinPool implements config.VolumeLVMConfig interface.
+func (s LVMSpec) ThinPool() optional.Optional[string] {
+	if s.ThinPoolName == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.ThinPoolName)
+}
+
+// IsZero checks if the LVM provisioning is not configured.
+func (s LVMSpec) IsZero() bool {
+	return s.VolumeGroupName == "" && s.ThinPoolName == "" && s.ThinPoolSizeSpec.IsZero()
+}
+
+// ThinPoolSize implements config.VolumeLVMConfig interface.
+func (s LVMSpec) ThinPoolSize() optional.Optional[uint64] {
+	if s.ThinPoolSizeSpec.IsZero() {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(s.ThinPoolSizeSpec.Value())
+}
+
+// RAID implements config.VolumeProvisioningConfig interface.
+func (s ProvisioningSpec) RAID() config.VolumeRAIDConfig {
+	return s.RAIDSpec
+}
+
+// Level implements config.VolumeRAIDConfig interface.
+func (s RAIDSpec) Level() optional.Optional[string] {
+	if s.RAIDLevel == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.RAIDLevel)
+}
+
+// Devices implements config.VolumeRAIDConfig interface.
+func (s RAIDSpec) Devices() optional.Optional[int] {
+	if s.RAIDDevices == 0 {
+		return optional.None[int]()
+	}
+
+	return optional.Some(s.RAIDDevices)
+}
+
+func (s RAIDSpec) validate(provisioning ProvisioningSpec) error {
+	if s.RAIDLevel == "" && s.RAIDDevices == 0 {
+		return nil
+	}
+
+	var validationErrors error
+
+	minDevices, ok := block.RAIDMinDevices(s.RAIDLevel)
+	if !ok {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported RAID level %q", s.RAIDLevel))
+	} else if s.RAIDDevices != 0 && s.RAIDDevices < minDevices {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("%s requires at least %d devices, got %d", s.RAIDLevel, minDevices, s.RAIDDevices))
+	}
+
+	if provisioning.DiskSelectorSpec.Match.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("disk selector is required for RAID provisioning"))
+	}
+
+	if provisioning.LVMSpec.VolumeGroupName != "" {
+		validationErrors = errors.Join(validationErrors, errors.New("RAID and LVM provisioning can't be used together"))
+	}
+
+	return validationErrors
+}
+
+// lvmNameRegexp matches valid LVM volume group and logical volume names.
+var lvmNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+
+// maxLVMNameLength is the maximum length of the LVM volume group or logical volume name.
+const maxLVMNameLength = 127
+
+func validateLVMName(kind, name string) error {
+	if len(name) > maxLVMNameLength || !lvmNameRegexp.MatchString(name) || name == "." || name == ".." {
+		return fmt.Errorf("%s name %q is invalid", kind, name)
+	}
+
+	return nil
+}
+
+func (s LVMSpec) validate(volumeName string, provisioning ProvisioningSpec) error {
+	if s.IsZero() {
+		return nil
+	}
+
+	if slices.Contains(config.SystemVolumeNames, volumeName) {
+		return errors.New("LVM provisioning is only supported for user volumes")
+	}
+
+	var validationErrors error
+
+	if s.VolumeGroupName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("volume group name is required for LVM provisioning"))
+	} else if err := validateLVMName("volume group", s.VolumeGroupName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.ThinPoolName != "" {
+		if err := validateLVMName("thin pool", s.ThinPoolName); err != nil {
+			validationErrors = errors.Join(validationErrors, err)
+		}
+
+		if s.ThinPoolName == volumeName {
+			validationErrors = errors.Join(validationErrors, errors.New("thin pool name should be different from the volume name"))
+		}
+
+		if provisioning.ProvisioningMaxSize.IsZero() {
+			validationErrors = errors.Join(validationErrors, errors.New("max size is required for thin-provisioned volumes"))
+		}
+	} else if !s.ThinPoolSizeSpec.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("thin pool size requires a thin pool name"))
+	}
+
+	return validationErrors
+}
+
+var btrfsCompressionRegexp = regexp.MustCompile(`^(zlib(:[1-9])?|lzo|zstd(:([1-9]|1[0-5]))?)$`)
+
+//nolint:gocyclo
+func (s FilesystemSpec) validate(volumeName string) error {
+	var validationErrors error
+
+	switch s.FilesystemType { //nolint:exhaustive
+	case block.FilesystemTypeNone, block.FilesystemTypeXFS:
+	case block.FilesystemTypeEXT4, block.FilesystemTypeBTRFS:
+		if volumeName == constants.EphemeralPartitionLabel {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("filesystem type %s is not supported for %s volume", s.FilesystemType, volumeName))
+		}
+	default:
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported filesystem type: %s", s.FilesystemType))
+	}
+
+	if s.EXT4Spec.ReservedBlocksPercentage != nil {
+		if s.FilesystemType != block.FilesystemTypeEXT4 {
+			validationErrors = errors.Join(validationErrors, errors.New("ext4 options can only be used with ext4 filesystem"))
+		}
+
+		if *s.EXT4Spec.ReservedBlocksPercentage > 50 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("reserved blocks percentage should be between 0 and 50: %d", *s.EXT4Spec.ReservedBlocksPercentage))
+		}
+	}
+
+	if s.BTRFSSpec.Compression != "" {
+		if s.FilesystemType != block.FilesystemTypeBTRFS {
+			validationErrors = errors.Join(validationErrors, errors.New("btrfs options can only be used with btrfs filesystem"))
+		}
+
+		if !btrfsCompressionRegexp.MatchString(s.BTRFSSpec.Compression) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported btrfs compression: %q", s.BTRFSSpec.Compression))
+		}
+	}
+
+	return validationErrors
+}
+
+// Type implements config.VolumeFilesystemConfig interface.
+func (s FilesystemSpec) Type() optional.Optional[block.FilesystemType] {
+	if s.FilesystemType == block.FilesystemTypeNone {
+		return optional.None[block.FilesystemType]()
+	}
+
+	return optional.Some(s.FilesystemType)
+}
+
+// EXT4ReservedBlocksPercentage implements config.VolumeFilesystemConfig interface.
+func (s FilesystemSpec) EXT4ReservedBlocksPercentage() optional.Optional[uint32] {
+	if s.EXT4Spec.ReservedBlocksPercentage == nil {
+		return optional.None[uint32]()
+	}
+
+	return optional.Some(*s.EXT4Spec.ReservedBlocksPercentage)
+}
+
+// BTRFSCompression implements config.VolumeFilesystemConfig interface.
+func (s FilesystemSpec) BTRFSCompression() optional.Optional[string] {
+	if s.BTRFSSpec.Compression == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.BTRFSSpec.Compression)
+}
@@ -5,17 +5,22 @@
 package block_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
 func TestVolumeConfigMarshalUnmarshal(t *testing.T) {
@@ -59,6 +64,34 @@ func TestVolumeConfigMarshalUnmarshal(t *testing.T) {
 				c.ProvisioningSpec.ProvisioningMaxSize = block.MustByteSize("2.5TiB")
 				c.ProvisioningSpec.ProvisioningMinSize = block.MustByteSize("10GiB")
 
+				return c
+			},
+		},
+		{
+			name:     "user volume",
+			filename: "volumeconfig_user.yaml",
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.ProvisioningMinSize = block.MustByteSize("10GiB")
+				c.VolumeMountTimeout = 10 * time.Minute
+
+				return c
+			},
+		},
+		{
+			name:     "filesystem",
+			filename: "volumeconfig_filesystem.yaml",
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeBTRFS
+				c.FilesystemSpec.BTRFSSpec.Compression = "zstd:3"
+
 				return c
 			},
 		},
@@ -100,16 +133,42 @@ func TestVolumeConfigValidate(t *testing.T) {
 		expectedErrors string
 	}{
 		{
-			name: "wrong name",
+			name: "system volume",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.StatePartitionLabel
+
+				return c
+			},
+
+			expectedErrors: "system volume \"STATE\" can't be configured",
+		},
+		{
+			name: "invalid user volume",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "Wrong_Name"
+
+				return c
+			},
+
+			expectedErrors: "user volume name \"Wrong_Name\" is invalid, it should consist of lowercase alphanumeric characters and dashes\ndisk selector is required for user volumes",
+		},
+		{
+			name: "user volume name too long",
 
 			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
 				c := block.NewVolumeConfigV1Alpha1()
-				c.MetaName = "wrong"
+				c.MetaName = "this-is-a-very-long-name-for-a-volume"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
 
 				return c
 			},
 
-			expectedErrors: "only EPHEMERAL volumes are supported",
+			expectedErrors: "user volume name is too long: 37 > 34",
 		},
 		{
 			name: "invalid disk selector",
@@ -140,6 +199,261 @@ func TestVolumeConfigValidate(t *testing.T) {
 
 			expectedErrors: "min size is greater than max size",
 		},
+		{
+			name: "unsupported filesystem for EPHEMERAL",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.EphemeralPartitionLabel
+
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeEXT4
+
+				return c
+			},
+
+			expectedErrors: "filesystem type ext4 is not supported for EPHEMERAL volume",
+		},
+		{
+			name: "filesystem options mismatch",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeXFS
+				c.FilesystemSpec.EXT4Spec.ReservedBlocksPercentage = pointer.To[uint32](60)
+				c.FilesystemSpec.BTRFSSpec.Compression = "zstd"
+
+				return c
+			},
+
+			expectedErrors: "ext4 options can only be used with ext4 filesystem\nreserved blocks percentage should be between 0 and 50: 60\nbtrfs options can only be used with btrfs filesystem",
+		},
+		{
+			name: "invalid btrfs compression",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeBTRFS
+				c.FilesystemSpec.BTRFSSpec.Compression = "zstd:20"
+
+				return c
+			},
+
+			expectedErrors: "unsupported btrfs compression: \"zstd:20\"",
+		},
+		{
+			name: "unsupported filesystem",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeVFAT
+
+				return c
+			},
+
+			expectedErrors: "unsupported filesystem type: vfat",
+		},
+		{
+			name: "mount timeout for system volume",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.EphemeralPartitionLabel
+
+				c.VolumeMountTimeout = time.Minute
+
+				return c
+			},
+
+			expectedErrors: "mount timeout is only supported for user volumes",
+		},
+		{
+			name: "negative mount timeout",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.VolumeMountTimeout = -time.Minute
+
+				return c
+			},
+
+			expectedErrors: "mount timeout should be positive: -1m0s",
+		},
+		{
+			name: "LVM for system volume",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.EphemeralPartitionLabel
+
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "vg-data"
+
+				return c
+			},
+
+			expectedErrors: "LVM provisioning is only supported for user volumes",
+		},
+		{
+			name: "invalid LVM",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "-vg"
+				c.ProvisioningSpec.LVMSpec.ThinPoolName = "local-data"
+
+				return c
+			},
+
+			expectedErrors: "volume group name \"-vg\" is invalid\nthin pool name should be different from the volume name\nmax size is required for thin-provisioned volumes",
+		},
+		{
+			name: "LVM thin pool without volume group",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.ProvisioningMaxSize = block.MustByteSize("100GiB")
+				c.ProvisioningSpec.LVMSpec.ThinPoolName = "pool0"
+
+				return c
+			},
+
+			expectedErrors: "volume group name is required for LVM provisioning",
+		},
+		{
+			name: "LVM thin pool size without thin pool",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "vg-data"
+				c.ProvisioningSpec.LVMSpec.ThinPoolSizeSpec = block.MustByteSize("500GiB")
+
+				return c
+			},
+
+			expectedErrors: "thin pool size requires a thin pool name",
+		},
+		{
+			name: "RAID without disk selector",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.EphemeralPartitionLabel
+
+				c.ProvisioningSpec.RAIDSpec.RAIDLevel = "raid1"
+
+				return c
+			},
+
+			expectedErrors: "disk selector is required for RAID provisioning",
+		},
+		{
+			name: "invalid RAID",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.RAIDSpec.RAIDLevel = "raid5"
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "vg-data"
+
+				return c
+			},
+
+			expectedErrors: "unsupported RAID level \"raid5\"\nRAID and LVM provisioning can't be used together",
+		},
+		{
+			name: "RAID not enough devices",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.ProvisioningSpec.RAIDSpec.RAIDLevel = "raid10"
+				c.ProvisioningSpec.RAIDSpec.RAIDDevices = 3
+
+				return c
+			},
+
+			expectedErrors: "raid10 requires at least 4 devices, got 3",
+		},
+		{
+			name: "valid EPHEMERAL RAID",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = constants.EphemeralPartitionLabel
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`disk.transport == "nvme" && !system_disk`)))
+				c.ProvisioningSpec.RAIDSpec.RAIDLevel = "raid1"
+				c.ProvisioningSpec.RAIDSpec.RAIDDevices = 3
+
+				return c
+			},
+		},
+		{
+			name: "valid user volume LVM",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`disk.transport == "nvme" && !system_disk`)))
+				c.ProvisioningSpec.ProvisioningMaxSize = block.MustByteSize("100GiB")
+				c.ProvisioningSpec.LVMSpec.VolumeGroupName = "vg-data"
+				c.ProvisioningSpec.LVMSpec.ThinPoolName = "pool0"
+
+				return c
+			},
+		},
+		{
+			name: "valid user volume btrfs",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`!system_disk`)))
+				c.FilesystemSpec.FilesystemType = blockres.FilesystemTypeBTRFS
+				c.FilesystemSpec.BTRFSSpec.Compression = "zstd:3"
+
+				return c
+			},
+		},
+		{
+			name: "valid user volume",
+
+			cfg: func(t *testing.T) *block.VolumeConfigV1Alpha1 {
+				c := block.NewVolumeConfigV1Alpha1()
+				c.MetaName = "local-data"
+
+				require.NoError(t, c.ProvisioningSpec.DiskSelectorSpec.Match.UnmarshalText([]byte(`disk.transport == "nvme" && !system_disk`)))
+				c.ProvisioningSpec.ProvisioningMinSize = block.MustByteSize("10GiB")
+
+				return c
+			},
+		},
 		{
 			name: "valid",
 
@@ -186,3 +500,84 @@ func (validationMode) RequiresInstall() bool {
 func (validationMode) InContainer() bool {
 	return false
 }
+
+type containerMode struct {
+	validationMode
+}
+
+func (containerMode) InContainer() bool {
+	return true
+}
+
+func TestVolumeConfigRuntimeValidate(t *testing.T) {
+	binDir := t.TempDir()
+
+	for _, binary := range []string{"mkfs.ext4", "e2fsck", "resize2fs"} {
+		require.NoError(t, os.WriteFile(filepath.Join(binDir, binary), nil, 0o755))
+	}
+
+	// not executable
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "mkfs.btrfs"), nil, 0o644))
+
+	t.Cleanup(block.SetExecutableSearchPath(binDir))
+
+	for _, test := range []struct {
+		name string
+
+		filesystemType blockres.FilesystemType
+		raidLevel      string
+		mode           validation.RuntimeMode
+
+		expectedErrors string
+	}{
+		{
+			name: "xfs",
+
+			filesystemType: blockres.FilesystemTypeXFS,
+			mode:           validationMode{},
+		},
+		{
+			name: "ext4",
+
+			filesystemType: blockres.FilesystemTypeEXT4,
+			mode:           validationMode{},
+		},
+		{
+			name: "btrfs",
+
+			filesystemType: blockres.FilesystemTypeBTRFS,
+			mode:           validationMode{},
+
+			expectedErrors: "filesystem type btrfs requires btrfs-progs which is not available (\"mkfs.btrfs\" not found), it should be installed as a system extension",
+		},
+		{
+			name: "raid",
+
+			raidLevel: "raid1",
+			mode:      validationMode{},
+
+			expectedErrors: "RAID provisioning requires mdadm which is not available (\"mdadm\" not found), it should be installed as a system extension",
+		},
+		{
+			name: "btrfs in container",
+
+			filesystemType: blockres.FilesystemTypeBTRFS,
+			mode:           containerMode{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			c := block.NewVolumeConfigV1Alpha1()
+			c.MetaName = "data"
+			c.FilesystemSpec.FilesystemType = test.filesystemType
+			c.ProvisioningSpec.RAIDSpec.RAIDLevel = test.raidLevel
+
+			_, err := c.RuntimeValidate(context.Background(), nil, test.mode)
+
+			if test.expectedErrors == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErrors)
+			}
+		})
+	}
+}
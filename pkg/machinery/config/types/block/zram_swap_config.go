@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ZramSwapConfigKind is a config document kind.
+const ZramSwapConfigKind = "ZramSwapConfig"
+
+func init() {
+	registry.Register(ZramSwapConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ZramSwapConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ZramSwapConfig = &ZramSwapConfigV1Alpha1{}
+	_ config.Validator      = &ZramSwapConfigV1Alpha1{}
+)
+
+// ZramCompressionAlgorithms is a list of supported zram compression algorithms.
+var ZramCompressionAlgorithms = []string{"lzo", "lzo-rle", "lz4", "lz4hc", "zstd", "842"}
+
+// ZramSwapConfigV1Alpha1 is a zram (compressed RAM block device) swap configuration document.
+//
+//	examples:
+//	  - value: exampleZramSwapConfigV1Alpha1()
+//	alias: ZramSwapConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ZramSwapConfig
+type ZramSwapConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//  description: |
+	//    The size of the zram device (uncompressed).
+	//
+	//    Size is specified in bytes, but can be expressed in human readable format, e.g. 100MB.
+	//  examples:
+	//    - value: >
+	//        "2GiB"
+	//  schema:
+	//    type: string
+	ZramSize ByteSize `yaml:"size"`
+	//  description: |
+	//    The compression algorithm of the zram device, defaults to the kernel default.
+	//  values:
+	//    - lzo
+	//    - lzo-rle
+	//    - lz4
+	//    - lz4hc
+	//    - zstd
+	//    - "842"
+	//  examples:
+	//    - value: >
+	//        "zstd"
+	ZramAlgorithm string `yaml:"algorithm,omitempty"`
+}
+
+// NewZramSwapConfigV1Alpha1 creates a new zram swap config document.
+func NewZramSwapConfigV1Alpha1() *ZramSwapConfigV1Alpha1 {
+	return &ZramSwapConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ZramSwapConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleZramSwapConfigV1Alpha1() *ZramSwapConfigV1Alpha1 {
+	cfg := NewZramSwapConfigV1Alpha1()
+	cfg.ZramSize = MustByteSize("2GiB")
+	cfg.ZramAlgorithm = "zstd"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ZramSwapConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *ZramSwapConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.ZramSize.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("zram device size is required"))
+	}
+
+	if s.ZramAlgorithm != "" && !slices.Contains(ZramCompressionAlgorithms, s.ZramAlgorithm) {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported zram compression algorithm %q", s.ZramAlgorithm))
+	}
+
+	return nil, validationErrors
+}
+
+// Size implements config.ZramSwapConfig interface.
+func (s *ZramSwapConfigV1Alpha1) Size() uint64 {
+	return s.ZramSize.Value()
+}
+
+// Algorithm implements config.ZramSwapConfig interface.
+func (s *ZramSwapConfigV1Alpha1) Algorithm() optional.Optional[string] {
+	if s.ZramAlgorithm == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.ZramAlgorithm)
+}
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+)
+
+func TestZramSwapConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes([]byte(`apiVersion: v1alpha1
+kind: ZramSwapConfig
+size: 2GiB
+algorithm: zstd
+`))
+	require.NoError(t, err)
+
+	zram := provider.ZramSwap()
+	require.NotNil(t, zram)
+
+	assert.EqualValues(t, 2*1024*1024*1024, zram.Size())
+	assert.Equal(t, "zstd", zram.Algorithm().ValueOr(""))
+}
+
+func TestZramSwapConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	c := block.NewZramSwapConfigV1Alpha1()
+	c.ZramAlgorithm = "lz5"
+
+	_, err := c.Validate(validationMode{})
+	assert.EqualError(t, err, "zram device size is required\nunsupported zram compression algorithm \"lz5\"")
+
+	c.ZramSize = block.MustByteSize("512MiB")
+	c.ZramAlgorithm = "lz4"
+
+	_, err = c.Validate(validationMode{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/go-pointer"
+	"gopkg.in/yaml.v3"
+)
+
+// Check interfaces.
+var (
+	_ encoding.TextMarshaler   = Bandwidth{}
+	_ encoding.TextUnmarshaler = (*Bandwidth)(nil)
+	_ yaml.IsZeroer            = Bandwidth{}
+)
+
+// bandwidthUnits are the units of the bandwidth (the same as used by `tc`), in the order of the suffix matching.
+var bandwidthUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"tbit", 1e12},
+	{"gbit", 1e9},
+	{"mbit", 1e6},
+	{"kbit", 1e3},
+	{"bit", 1},
+}
+
+// Bandwidth is a rate in bits per second which can be conveniently represented as a human readable string
+// with `tc` units, e.g. 100Mbit.
+//
+//docgen:nodoc
+type Bandwidth struct {
+	value *uint64
+	raw   []byte
+}
+
+// MustBandwidth returns a new Bandwidth with the given value.
+//
+// It panics if the value is invalid.
+func MustBandwidth(value string) Bandwidth {
+	var bw Bandwidth
+
+	if err := bw.UnmarshalText([]byte(value)); err != nil {
+		panic(err)
+	}
+
+	return bw
+}
+
+// Value returns the value in bits per second.
+func (bw Bandwidth) Value() uint64 {
+	return pointer.SafeDeref(bw.value)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (bw Bandwidth) MarshalText() ([]byte, error) {
+	if bw.raw != nil {
+		return bw.raw, nil
+	}
+
+	if bw.value != nil {
+		return []byte(strconv.FormatUint(*bw.value, 10)), nil
+	}
+
+	return nil, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (bw *Bandwidth) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+
+	value, err := parseBandwidth(string(text))
+	if err != nil {
+		return err
+	}
+
+	bw.value = pointer.To(value)
+	bw.raw = slices.Clone(text)
+
+	return nil
+}
+
+// IsZero implements yaml.IsZeroer.
+func (bw Bandwidth) IsZero() bool {
+	return bw.value == nil && bw.raw == nil
+}
+
+func parseBandwidth(s string) (uint64, error) {
+	number, multiplier := strings.ToLower(strings.TrimSpace(s)), 1.0
+
+	for _, unit := range bandwidthUnits {
+		if trimmed, ok := strings.CutSuffix(number, unit.suffix); ok {
+			number, multiplier = strings.TrimSpace(trimmed), unit.multiplier
+
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, fmt.Errorf("invalid bandwidth %q", s)
+	}
+
+	value *= multiplier
+
+	if value >= math.MaxUint64 {
+		return 0, fmt.Errorf("bandwidth %q is too large", s)
+	}
+
+	return uint64(value), nil
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+func TestBandwidthUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		in string
+
+		want uint64
+	}{
+		{in: "", want: 0},
+		{in: "1000000", want: 1000000},
+		{in: "800bit", want: 800},
+		{in: "500kbit", want: 500_000},
+		{in: "100Mbit", want: 100_000_000},
+		{in: "2.5Gbit", want: 2_500_000_000},
+		{in: "1tbit", want: 1_000_000_000_000},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			t.Parallel()
+
+			var bw network.Bandwidth
+
+			require.NoError(t, bw.UnmarshalText([]byte(test.in)))
+
+			assert.Equal(t, test.want, bw.Value())
+
+			out, err := bw.MarshalText()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.in, string(out))
+		})
+	}
+}
+
+func TestBandwidthUnmarshalError(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{"fast", "100MB", "-1Mbit", "Mbit"} {
+		t.Run(in, func(t *testing.T) {
+			t.Parallel()
+
+			var bw network.Bandwidth
+
+			assert.EqualError(t, bw.UnmarshalText([]byte(in)), `invalid bandwidth "`+in+`"`)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// BondConfigKind is a bond config document kind.
+const BondConfigKind = "BondConfig"
+
+func init() {
+	registry.Register(BondConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &BondConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkBondConfig = &BondConfigV1Alpha1{}
+	_ config.NamedDocument     = &BondConfigV1Alpha1{}
+	_ config.Validator         = &BondConfigV1Alpha1{}
+)
+
+// BondConfigV1Alpha1 is a bond network link configuration document.
+//
+//	examples:
+//	  - value: exampleBondConfigV1Alpha1()
+//	alias: BondConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/BondConfig
+type BondConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the bond link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "bond0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Names of the links to aggregate in the bond.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         []string{"enp1s0", "enp1s1"}
+	BondLinks []string `yaml:"links,omitempty"`
+	//   description: |
+	//     Selector picks the physical links to aggregate in the bond by matching the link status.
+	//
+	//     All matching links are added to the bond (in addition to the `links`).
+	BondLinkSelector LinkSelector `yaml:"linkSelector,omitempty"`
+	//   description: |
+	//     Bond mode.
+	//   values:
+	//     - "balance-rr"
+	//     - "active-backup"
+	//     - "balance-xor"
+	//     - "broadcast"
+	//     - "802.3ad"
+	//     - "balance-tlb"
+	//     - "balance-alb"
+	//   examples:
+	//     - value: >
+	//         "802.3ad"
+	BondMode nethelpers.BondMode `yaml:"bondMode"`
+	//   description: |
+	//     Transmit hash policy.
+	//   values:
+	//     - "layer2"
+	//     - "layer3+4"
+	//     - "layer2+3"
+	//     - "encap2+3"
+	//     - "encap3+4"
+	//   examples:
+	//     - value: >
+	//         "layer3+4"
+	BondXmitHashPolicy nethelpers.BondXmitHashPolicy `yaml:"xmitHashPolicy,omitempty"`
+	//   description: |
+	//     LACPDU frames periodic transmission rate (802.3ad mode).
+	//   values:
+	//     - "slow"
+	//     - "fast"
+	BondLACPRate nethelpers.LACPRate `yaml:"lacpRate,omitempty"`
+	//   description: |
+	//     Aggregation selection logic (802.3ad mode).
+	//   values:
+	//     - "stable"
+	//     - "bandwidth"
+	//     - "count"
+	BondADSelect nethelpers.ADSelect `yaml:"adSelect,omitempty"`
+	//   description: |
+	//     Link monitoring frequency in milliseconds.
+	//   examples:
+	//     - value: >
+	//         100
+	BondMIIMon uint32 `yaml:"miimon,omitempty"`
+	//   description: |
+	//     The time, in milliseconds, to wait before enabling a link after a link up event.
+	BondUpDelay uint32 `yaml:"updelay,omitempty"`
+	//   description: |
+	//     The time, in milliseconds, to wait before disabling a link after a link down event.
+	BondDownDelay uint32 `yaml:"downdelay,omitempty"`
+	//   description: |
+	//     The minimum number of active links required for the bond to be considered up.
+	BondMinLinks uint32 `yaml:"minLinks,omitempty"`
+	//   description: |
+	//     Bring the bond up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the bond MTU.
+	//   examples:
+	//     - value: >
+	//         9000
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewBondConfigV1Alpha1 creates a new BondConfig config document.
+func NewBondConfigV1Alpha1() *BondConfigV1Alpha1 {
+	return &BondConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       BondConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleBondConfigV1Alpha1() *BondConfigV1Alpha1 {
+	cfg := NewBondConfigV1Alpha1()
+	cfg.MetaName = "bond0"
+	cfg.BondLinkSelector.Match = exampleLinkSelector1()
+	cfg.BondMode = nethelpers.BondMode8023AD
+	cfg.BondXmitHashPolicy = nethelpers.BondXmitPolicyLayer34
+	cfg.BondLACPRate = nethelpers.LACPRateFast
+	cfg.BondMIIMon = 100
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *BondConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *BondConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkBondConfigSignal implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) NetworkBondConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *BondConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if len(s.BondLinks) == 0 && s.BondLinkSelector.Match.IsZero() {
+		validationErrors = errors.Join(validationErrors, errors.New("bond links or link selector should be specified"))
+	}
+
+	for _, link := range s.BondLinks {
+		if link == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("bond link name should not be empty"))
+		}
+	}
+
+	if !s.BondLinkSelector.Match.IsZero() {
+		if err := s.BondLinkSelector.Match.ParseBool(celenv.LinkLocator()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("bond link selector is invalid: %w", err))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// Links implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) Links() []string {
+	return s.BondLinks
+}
+
+// LinkSelector implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) LinkSelector() optional.Optional[cel.Expression] {
+	return linkSelector(s.BondLinkSelector)
+}
+
+// Mode implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) Mode() nethelpers.BondMode {
+	return s.BondMode
+}
+
+// HashPolicy implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) HashPolicy() nethelpers.BondXmitHashPolicy {
+	return s.BondXmitHashPolicy
+}
+
+// LACPRate implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) LACPRate() nethelpers.LACPRate {
+	return s.BondLACPRate
+}
+
+// ADSelect implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) ADSelect() nethelpers.ADSelect {
+	return s.BondADSelect
+}
+
+// MIIMon implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) MIIMon() uint32 {
+	return s.BondMIIMon
+}
+
+// UpDelay implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) UpDelay() uint32 {
+	return s.BondUpDelay
+}
+
+// DownDelay implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) DownDelay() uint32 {
+	return s.BondDownDelay
+}
+
+// MinLinks implements config.NetworkBondConfig interface.
+func (s *BondConfigV1Alpha1) MinLinks() uint32 {
+	return s.BondMinLinks
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *BondConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *BondConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
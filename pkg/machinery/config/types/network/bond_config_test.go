@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/bondconfig.yaml
+var expectedBondConfigDocument []byte
+
+func TestBondConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewBondConfigV1Alpha1()
+	cfg.MetaName = "bond0"
+	cfg.BondLinks = []string{"enp1s0", "uplink0"}
+	cfg.BondMode = nethelpers.BondMode8023AD
+	cfg.BondXmitHashPolicy = nethelpers.BondXmitPolicyLayer34
+	cfg.BondLACPRate = nethelpers.LACPRateFast
+	cfg.BondMIIMon = 100
+	cfg.LinkMTU = 9000
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedBondConfigDocument, marshaled)
+}
+
+func TestBondConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedBondConfigDocument)
+	require.NoError(t, err)
+
+	bonds := provider.NetworkBondConfigs()
+	require.Len(t, bonds, 1)
+
+	assert.Equal(t, "bond0", bonds[0].Name())
+	assert.Equal(t, []string{"enp1s0", "uplink0"}, bonds[0].Links())
+	assert.False(t, bonds[0].LinkSelector().IsPresent())
+	assert.Equal(t, nethelpers.BondMode8023AD, bonds[0].Mode())
+	assert.Equal(t, nethelpers.BondXmitPolicyLayer34, bonds[0].HashPolicy())
+	assert.Equal(t, nethelpers.LACPRateFast, bonds[0].LACPRate())
+	assert.Equal(t, uint32(100), bonds[0].MIIMon())
+	assert.True(t, bonds[0].Up())
+	assert.Equal(t, uint32(9000), bonds[0].MTU().ValueOrZero())
+}
+
+func TestBondConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.BondConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewBondConfigV1Alpha1,
+
+			expectedError: "link name is required\nbond links or link selector should be specified",
+		},
+		{
+			name: "empty link",
+			cfg: func() *network.BondConfigV1Alpha1 {
+				cfg := network.NewBondConfigV1Alpha1()
+				cfg.MetaName = "bond0"
+				cfg.BondLinks = []string{"eth0", ""}
+
+				return cfg
+			},
+
+			expectedError: "bond link name should not be empty",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.BondConfigV1Alpha1 {
+				cfg := network.NewBondConfigV1Alpha1()
+				cfg.MetaName = "bond0"
+				cfg.BondLinks = []string{"eth0", "eth1"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// BridgeConfigKind is a bridge config document kind.
+const BridgeConfigKind = "BridgeConfig"
+
+func init() {
+	registry.Register(BridgeConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &BridgeConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkBridgeConfig = &BridgeConfigV1Alpha1{}
+	_ config.NamedDocument       = &BridgeConfigV1Alpha1{}
+	_ config.Validator           = &BridgeConfigV1Alpha1{}
+)
+
+// BridgeConfigV1Alpha1 is a bridge network link configuration document.
+//
+//	examples:
+//	  - value: exampleBridgeConfigV1Alpha1()
+//	alias: BridgeConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/BridgeConfig
+type BridgeConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the bridge link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "br0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Names of the links to attach to the bridge.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         []string{"enp1s0", "bond0"}
+	BridgeLinks []string `yaml:"links,omitempty"`
+	//   description: |
+	//     Selector picks the physical links to attach to the bridge by matching the link status.
+	//
+	//     All matching links are attached to the bridge (in addition to the `links`).
+	BridgeLinkSelector LinkSelector `yaml:"linkSelector,omitempty"`
+	//   description: |
+	//     Spanning Tree Protocol (STP) settings of the bridge.
+	BridgeSTP BridgeSTPConfig `yaml:"stp,omitempty"`
+	//   description: |
+	//     VLAN settings of the bridge.
+	BridgeVLAN BridgeVLANConfig `yaml:"vlan,omitempty"`
+	//   description: |
+	//     Bring the bridge up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the bridge MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// BridgeSTPConfig is a bridge STP configuration.
+type BridgeSTPConfig struct {
+	//   description: |
+	//     Enable or disable the Spanning Tree Protocol.
+	BridgeSTPEnabled bool `yaml:"enabled,omitempty"`
+}
+
+// BridgeVLANConfig is a bridge VLAN configuration.
+type BridgeVLANConfig struct {
+	//   description: |
+	//     Enable or disable the VLAN filtering on the bridge.
+	BridgeVLANFiltering bool `yaml:"vlanFiltering,omitempty"`
+}
+
+// NewBridgeConfigV1Alpha1 creates a new BridgeConfig config document.
+func NewBridgeConfigV1Alpha1() *BridgeConfigV1Alpha1 {
+	return &BridgeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       BridgeConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleBridgeConfigV1Alpha1() *BridgeConfigV1Alpha1 {
+	cfg := NewBridgeConfigV1Alpha1()
+	cfg.MetaName = "br0"
+	cfg.BridgeLinks = []string{"enp1s0", "uplink0"}
+	cfg.BridgeSTP.BridgeSTPEnabled = true
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *BridgeConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *BridgeConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkBridgeConfigSignal implements config.NetworkBridgeConfig interface.
+func (s *BridgeConfigV1Alpha1) NetworkBridgeConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *BridgeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	for _, link := range s.BridgeLinks {
+		if link == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("bridge link name should not be empty"))
+		}
+	}
+
+	if !s.BridgeLinkSelector.Match.IsZero() {
+		if err := s.BridgeLinkSelector.Match.ParseBool(celenv.LinkLocator()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("bridge link selector is invalid: %w", err))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// Links implements config.NetworkBridgeConfig interface.
+func (s *BridgeConfigV1Alpha1) Links() []string {
+	return s.BridgeLinks
+}
+
+// LinkSelector implements config.NetworkBridgeConfig interface.
+func (s *BridgeConfigV1Alpha1) LinkSelector() optional.Optional[cel.Expression] {
+	return linkSelector(s.BridgeLinkSelector)
+}
+
+// STPEnabled implements config.NetworkBridgeConfig interface.
+func (s *BridgeConfigV1Alpha1) STPEnabled() bool {
+	return s.BridgeSTP.BridgeSTPEnabled
+}
+
+// VLANFilteringEnabled implements config.NetworkBridgeConfig interface.
+func (s *BridgeConfigV1Alpha1) VLANFilteringEnabled() bool {
+	return s.BridgeVLAN.BridgeVLANFiltering
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *BridgeConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *BridgeConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/bridgeconfig.yaml
+var expectedBridgeConfigDocument []byte
+
+func TestBridgeConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewBridgeConfigV1Alpha1()
+	cfg.MetaName = "br0"
+	cfg.BridgeLinkSelector.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "virtio_net"`, celenv.LinkLocator()))
+	cfg.BridgeSTP.BridgeSTPEnabled = true
+	cfg.BridgeVLAN.BridgeVLANFiltering = true
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedBridgeConfigDocument, marshaled)
+}
+
+func TestBridgeConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedBridgeConfigDocument)
+	require.NoError(t, err)
+
+	bridges := provider.NetworkBridgeConfigs()
+	require.Len(t, bridges, 1)
+
+	assert.Equal(t, "br0", bridges[0].Name())
+	assert.Empty(t, bridges[0].Links())
+	assert.True(t, bridges[0].LinkSelector().IsPresent())
+	assert.True(t, bridges[0].STPEnabled())
+	assert.True(t, bridges[0].VLANFilteringEnabled())
+	assert.False(t, bridges[0].MTU().IsPresent())
+}
+
+func TestBridgeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.BridgeConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewBridgeConfigV1Alpha1,
+
+			expectedError: "link name is required",
+		},
+		{
+			name: "invalid name",
+			cfg: func() *network.BridgeConfigV1Alpha1 {
+				cfg := network.NewBridgeConfigV1Alpha1()
+				cfg.MetaName = "br/0"
+
+				return cfg
+			},
+
+			expectedError: "link name \"br/0\" is invalid",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.BridgeConfigV1Alpha1 {
+				cfg := network.NewBridgeConfigV1Alpha1()
+				cfg.MetaName = "br0"
+				cfg.BridgeLinks = []string{"eth0"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
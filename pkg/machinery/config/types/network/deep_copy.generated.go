@@ -2,20 +2,135 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DNSCacheConfigV1Alpha1 -type DNSForwardZoneConfigV1Alpha1 -type DNSStaticRecordConfigV1Alpha1 -type DNSUpstreamConfigV1Alpha1 -type Dot1XConfigV1Alpha1 -type GENEVEConfigV1Alpha1 -type IPVLANConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type MACVLANConfigV1Alpha1 -type NATConfigV1Alpha1 -type ProbeConfigV1Alpha1 -type QdiscConfigV1Alpha1 -type RouteConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -type StaticAddressConfigV1Alpha1 -type VLANConfigV1Alpha1 -type VXLANConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
 import (
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"net/netip"
 )
 
+// DeepCopy generates a deep copy of *BondConfigV1Alpha1.
+func (o *BondConfigV1Alpha1) DeepCopy() *BondConfigV1Alpha1 {
+	var cp BondConfigV1Alpha1 = *o
+	if o.BondLinks != nil {
+		cp.BondLinks = make([]string, len(o.BondLinks))
+		copy(cp.BondLinks, o.BondLinks)
+	}
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *BridgeConfigV1Alpha1.
+func (o *BridgeConfigV1Alpha1) DeepCopy() *BridgeConfigV1Alpha1 {
+	var cp BridgeConfigV1Alpha1 = *o
+	if o.BridgeLinks != nil {
+		cp.BridgeLinks = make([]string, len(o.BridgeLinks))
+		copy(cp.BridgeLinks, o.BridgeLinks)
+	}
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *DefaultActionConfigV1Alpha1.
 func (o *DefaultActionConfigV1Alpha1) DeepCopy() *DefaultActionConfigV1Alpha1 {
 	var cp DefaultActionConfigV1Alpha1 = *o
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *DHCPv4ConfigV1Alpha1.
+func (o *DHCPv4ConfigV1Alpha1) DeepCopy() *DHCPv4ConfigV1Alpha1 {
+	var cp DHCPv4ConfigV1Alpha1 = *o
+	if o.DHCPUserClass != nil {
+		cp.DHCPUserClass = make([]string, len(o.DHCPUserClass))
+		copy(cp.DHCPUserClass, o.DHCPUserClass)
+	}
+	if o.DHCPRequestedOptions != nil {
+		cp.DHCPRequestedOptions = make([]uint8, len(o.DHCPRequestedOptions))
+		copy(cp.DHCPRequestedOptions, o.DHCPRequestedOptions)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DHCPv6ConfigV1Alpha1.
+func (o *DHCPv6ConfigV1Alpha1) DeepCopy() *DHCPv6ConfigV1Alpha1 {
+	var cp DHCPv6ConfigV1Alpha1 = *o
+	if o.DHCPPrefixDelegation != nil {
+		cp.DHCPPrefixDelegation = new(DHCPv6PrefixDelegationConfig)
+		*cp.DHCPPrefixDelegation = *o.DHCPPrefixDelegation
+		if o.DHCPPrefixDelegation.PDAssignments != nil {
+			cp.DHCPPrefixDelegation.PDAssignments = make([]DHCPv6PrefixAssignmentConfig, len(o.DHCPPrefixDelegation.PDAssignments))
+			copy(cp.DHCPPrefixDelegation.PDAssignments, o.DHCPPrefixDelegation.PDAssignments)
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DNSCacheConfigV1Alpha1.
+func (o *DNSCacheConfigV1Alpha1) DeepCopy() *DNSCacheConfigV1Alpha1 {
+	var cp DNSCacheConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DNSForwardZoneConfigV1Alpha1.
+func (o *DNSForwardZoneConfigV1Alpha1) DeepCopy() *DNSForwardZoneConfigV1Alpha1 {
+	var cp DNSForwardZoneConfigV1Alpha1 = *o
+	if o.ZoneServers != nil {
+		cp.ZoneServers = make([]string, len(o.ZoneServers))
+		copy(cp.ZoneServers, o.ZoneServers)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DNSStaticRecordConfigV1Alpha1.
+func (o *DNSStaticRecordConfigV1Alpha1) DeepCopy() *DNSStaticRecordConfigV1Alpha1 {
+	var cp DNSStaticRecordConfigV1Alpha1 = *o
+	if o.RecordAddresses != nil {
+		cp.RecordAddresses = make([]netip.Addr, len(o.RecordAddresses))
+		copy(cp.RecordAddresses, o.RecordAddresses)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DNSUpstreamConfigV1Alpha1.
+func (o *DNSUpstreamConfigV1Alpha1) DeepCopy() *DNSUpstreamConfigV1Alpha1 {
+	var cp DNSUpstreamConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *Dot1XConfigV1Alpha1.
+func (o *Dot1XConfigV1Alpha1) DeepCopy() *Dot1XConfigV1Alpha1 {
+	var cp Dot1XConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *GENEVEConfigV1Alpha1.
+func (o *GENEVEConfigV1Alpha1) DeepCopy() *GENEVEConfigV1Alpha1 {
+	var cp GENEVEConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *IPVLANConfigV1Alpha1.
+func (o *IPVLANConfigV1Alpha1) DeepCopy() *IPVLANConfigV1Alpha1 {
+	var cp IPVLANConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *KubespanEndpointsConfigV1Alpha1.
 func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1Alpha1 {
 	var cp KubespanEndpointsConfigV1Alpha1 = *o
@@ -26,6 +141,226 @@ func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1A
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *LinkConfigV1Alpha1.
+func (o *LinkConfigV1Alpha1) DeepCopy() *LinkConfigV1Alpha1 {
+	var cp LinkConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	if o.LinkEthtool != nil {
+		cp.LinkEthtool = new(EthtoolConfig)
+		*cp.LinkEthtool = *o.LinkEthtool
+		if o.LinkEthtool.EthtoolFeatures != nil {
+			cp.LinkEthtool.EthtoolFeatures = make(map[string]bool, len(o.LinkEthtool.EthtoolFeatures))
+			for k4, v4 := range o.LinkEthtool.EthtoolFeatures {
+				cp.LinkEthtool.EthtoolFeatures[k4] = v4
+			}
+		}
+		if o.LinkEthtool.EthtoolRings != nil {
+			cp.LinkEthtool.EthtoolRings = new(EthtoolRingsConfig)
+			*cp.LinkEthtool.EthtoolRings = *o.LinkEthtool.EthtoolRings
+		}
+		if o.LinkEthtool.EthtoolChannels != nil {
+			cp.LinkEthtool.EthtoolChannels = new(EthtoolChannelsConfig)
+			*cp.LinkEthtool.EthtoolChannels = *o.LinkEthtool.EthtoolChannels
+		}
+		if o.LinkEthtool.EthtoolLinkMode != nil {
+			cp.LinkEthtool.EthtoolLinkMode = new(EthtoolLinkModeConfig)
+			*cp.LinkEthtool.EthtoolLinkMode = *o.LinkEthtool.EthtoolLinkMode
+			if o.LinkEthtool.EthtoolLinkMode.LinkModeAutonegotiation != nil {
+				cp.LinkEthtool.EthtoolLinkMode.LinkModeAutonegotiation = new(bool)
+				*cp.LinkEthtool.EthtoolLinkMode.LinkModeAutonegotiation = *o.LinkEthtool.EthtoolLinkMode.LinkModeAutonegotiation
+			}
+			if o.LinkEthtool.EthtoolLinkMode.LinkModeDuplex != nil {
+				cp.LinkEthtool.EthtoolLinkMode.LinkModeDuplex = new(nethelpers.Duplex)
+				*cp.LinkEthtool.EthtoolLinkMode.LinkModeDuplex = *o.LinkEthtool.EthtoolLinkMode.LinkModeDuplex
+			}
+		}
+		if o.LinkEthtool.EthtoolWakeOnLAN != nil {
+			cp.LinkEthtool.EthtoolWakeOnLAN = new(EthtoolWakeOnLANConfig)
+			*cp.LinkEthtool.EthtoolWakeOnLAN = *o.LinkEthtool.EthtoolWakeOnLAN
+			if o.LinkEthtool.EthtoolWakeOnLAN.WakeOnLANModes != nil {
+				cp.LinkEthtool.EthtoolWakeOnLAN.WakeOnLANModes = make([]nethelpers.WOLMode, len(o.LinkEthtool.EthtoolWakeOnLAN.WakeOnLANModes))
+				copy(cp.LinkEthtool.EthtoolWakeOnLAN.WakeOnLANModes, o.LinkEthtool.EthtoolWakeOnLAN.WakeOnLANModes)
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *LLDPConfigV1Alpha1.
+func (o *LLDPConfigV1Alpha1) DeepCopy() *LLDPConfigV1Alpha1 {
+	var cp LLDPConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *MACVLANConfigV1Alpha1.
+func (o *MACVLANConfigV1Alpha1) DeepCopy() *MACVLANConfigV1Alpha1 {
+	var cp MACVLANConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *NATConfigV1Alpha1.
+func (o *NATConfigV1Alpha1) DeepCopy() *NATConfigV1Alpha1 {
+	var cp NATConfigV1Alpha1 = *o
+	if o.NATPortSelector != nil {
+		cp.NATPortSelector = new(RulePortSelector)
+		*cp.NATPortSelector = *o.NATPortSelector
+		if o.NATPortSelector.Ports != nil {
+			cp.NATPortSelector.Ports = make([]PortRange, len(o.NATPortSelector.Ports))
+			copy(cp.NATPortSelector.Ports, o.NATPortSelector.Ports)
+		}
+	}
+	if o.NATSNAT != nil {
+		cp.NATSNAT = new(NATTarget)
+		*cp.NATSNAT = *o.NATSNAT
+	}
+	if o.NATDNAT != nil {
+		cp.NATDNAT = new(NATTarget)
+		*cp.NATDNAT = *o.NATDNAT
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ProbeConfigV1Alpha1.
+func (o *ProbeConfigV1Alpha1) DeepCopy() *ProbeConfigV1Alpha1 {
+	var cp ProbeConfigV1Alpha1 = *o
+	if o.ProbeFailureThreshold != nil {
+		cp.ProbeFailureThreshold = new(int)
+		*cp.ProbeFailureThreshold = *o.ProbeFailureThreshold
+	}
+	if o.ProbeTCP != nil {
+		cp.ProbeTCP = new(TCPProbeConfig)
+		*cp.ProbeTCP = *o.ProbeTCP
+	}
+	if o.ProbeICMP != nil {
+		cp.ProbeICMP = new(ICMPProbeConfig)
+		*cp.ProbeICMP = *o.ProbeICMP
+	}
+	if o.ProbeHTTP != nil {
+		cp.ProbeHTTP = new(HTTPProbeConfig)
+		*cp.ProbeHTTP = *o.ProbeHTTP
+	}
+	if o.ProbeDNS != nil {
+		cp.ProbeDNS = new(DNSProbeConfig)
+		*cp.ProbeDNS = *o.ProbeDNS
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *QdiscConfigV1Alpha1.
+func (o *QdiscConfigV1Alpha1) DeepCopy() *QdiscConfigV1Alpha1 {
+	var cp QdiscConfigV1Alpha1 = *o
+	if o.QdiscFQ != nil {
+		cp.QdiscFQ = new(FQQdiscConfig)
+		*cp.QdiscFQ = *o.QdiscFQ
+		if o.QdiscFQ.FQMaxRate.value != nil {
+			cp.QdiscFQ.FQMaxRate.value = new(uint64)
+			*cp.QdiscFQ.FQMaxRate.value = *o.QdiscFQ.FQMaxRate.value
+		}
+		if o.QdiscFQ.FQMaxRate.raw != nil {
+			cp.QdiscFQ.FQMaxRate.raw = make([]byte, len(o.QdiscFQ.FQMaxRate.raw))
+			copy(cp.QdiscFQ.FQMaxRate.raw, o.QdiscFQ.FQMaxRate.raw)
+		}
+	}
+	if o.QdiscFQCodel != nil {
+		cp.QdiscFQCodel = new(FQCodelQdiscConfig)
+		*cp.QdiscFQCodel = *o.QdiscFQCodel
+		if o.QdiscFQCodel.FQCodelECN != nil {
+			cp.QdiscFQCodel.FQCodelECN = new(bool)
+			*cp.QdiscFQCodel.FQCodelECN = *o.QdiscFQCodel.FQCodelECN
+		}
+	}
+	if o.QdiscCAKE != nil {
+		cp.QdiscCAKE = new(CAKEQdiscConfig)
+		*cp.QdiscCAKE = *o.QdiscCAKE
+		if o.QdiscCAKE.CAKEBandwidth.value != nil {
+			cp.QdiscCAKE.CAKEBandwidth.value = new(uint64)
+			*cp.QdiscCAKE.CAKEBandwidth.value = *o.QdiscCAKE.CAKEBandwidth.value
+		}
+		if o.QdiscCAKE.CAKEBandwidth.raw != nil {
+			cp.QdiscCAKE.CAKEBandwidth.raw = make([]byte, len(o.QdiscCAKE.CAKEBandwidth.raw))
+			copy(cp.QdiscCAKE.CAKEBandwidth.raw, o.QdiscCAKE.CAKEBandwidth.raw)
+		}
+	}
+	if o.QdiscTBF != nil {
+		cp.QdiscTBF = new(TBFQdiscConfig)
+		*cp.QdiscTBF = *o.QdiscTBF
+		if o.QdiscTBF.TBFRate.value != nil {
+			cp.QdiscTBF.TBFRate.value = new(uint64)
+			*cp.QdiscTBF.TBFRate.value = *o.QdiscTBF.TBFRate.value
+		}
+		if o.QdiscTBF.TBFRate.raw != nil {
+			cp.QdiscTBF.TBFRate.raw = make([]byte, len(o.QdiscTBF.TBFRate.raw))
+			copy(cp.QdiscTBF.TBFRate.raw, o.QdiscTBF.TBFRate.raw)
+		}
+	}
+	if o.QdiscHTB != nil {
+		cp.QdiscHTB = new(HTBQdiscConfig)
+		*cp.QdiscHTB = *o.QdiscHTB
+		if o.QdiscHTB.HTBRate.value != nil {
+			cp.QdiscHTB.HTBRate.value = new(uint64)
+			*cp.QdiscHTB.HTBRate.value = *o.QdiscHTB.HTBRate.value
+		}
+		if o.QdiscHTB.HTBRate.raw != nil {
+			cp.QdiscHTB.HTBRate.raw = make([]byte, len(o.QdiscHTB.HTBRate.raw))
+			copy(cp.QdiscHTB.HTBRate.raw, o.QdiscHTB.HTBRate.raw)
+		}
+		if o.QdiscHTB.HTBClasses != nil {
+			cp.QdiscHTB.HTBClasses = make([]HTBClassConfig, len(o.QdiscHTB.HTBClasses))
+			copy(cp.QdiscHTB.HTBClasses, o.QdiscHTB.HTBClasses)
+			for i3 := range o.QdiscHTB.HTBClasses {
+				if o.QdiscHTB.HTBClasses[i3].HTBClassRate.value != nil {
+					cp.QdiscHTB.HTBClasses[i3].HTBClassRate.value = new(uint64)
+					*cp.QdiscHTB.HTBClasses[i3].HTBClassRate.value = *o.QdiscHTB.HTBClasses[i3].HTBClassRate.value
+				}
+				if o.QdiscHTB.HTBClasses[i3].HTBClassRate.raw != nil {
+					cp.QdiscHTB.HTBClasses[i3].HTBClassRate.raw = make([]byte, len(o.QdiscHTB.HTBClasses[i3].HTBClassRate.raw))
+					copy(cp.QdiscHTB.HTBClasses[i3].HTBClassRate.raw, o.QdiscHTB.HTBClasses[i3].HTBClassRate.raw)
+				}
+				if o.QdiscHTB.HTBClasses[i3].HTBClassCeil.value != nil {
+					cp.QdiscHTB.HTBClasses[i3].HTBClassCeil.value = new(uint64)
+					*cp.QdiscHTB.HTBClasses[i3].HTBClassCeil.value = *o.QdiscHTB.HTBClasses[i3].HTBClassCeil.value
+				}
+				if o.QdiscHTB.HTBClasses[i3].HTBClassCeil.raw != nil {
+					cp.QdiscHTB.HTBClasses[i3].HTBClassCeil.raw = make([]byte, len(o.QdiscHTB.HTBClasses[i3].HTBClassCeil.raw))
+					copy(cp.QdiscHTB.HTBClasses[i3].HTBClassCeil.raw, o.QdiscHTB.HTBClasses[i3].HTBClassCeil.raw)
+				}
+			}
+		}
+	}
+	if o.QdiscNetem != nil {
+		cp.QdiscNetem = new(NetemQdiscConfig)
+		*cp.QdiscNetem = *o.QdiscNetem
+		if o.QdiscNetem.NetemRate.value != nil {
+			cp.QdiscNetem.NetemRate.value = new(uint64)
+			*cp.QdiscNetem.NetemRate.value = *o.QdiscNetem.NetemRate.value
+		}
+		if o.QdiscNetem.NetemRate.raw != nil {
+			cp.QdiscNetem.NetemRate.raw = make([]byte, len(o.QdiscNetem.NetemRate.raw))
+			copy(cp.QdiscNetem.NetemRate.raw, o.QdiscNetem.NetemRate.raw)
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *RouteConfigV1Alpha1.
+func (o *RouteConfigV1Alpha1) DeepCopy() *RouteConfigV1Alpha1 {
+	var cp RouteConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *RoutingRuleConfigV1Alpha1.
+func (o *RoutingRuleConfigV1Alpha1) DeepCopy() *RoutingRuleConfigV1Alpha1 {
+	var cp RoutingRuleConfigV1Alpha1 = *o
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *RuleConfigV1Alpha1.
 func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	var cp RuleConfigV1Alpha1 = *o
@@ -37,5 +372,67 @@ func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 		cp.Ingress = make([]IngressRule, len(o.Ingress))
 		copy(cp.Ingress, o.Ingress)
 	}
+	if o.Egress != nil {
+		cp.Egress = make([]EgressRule, len(o.Egress))
+		copy(cp.Egress, o.Egress)
+	}
+	if o.Forward != nil {
+		cp.Forward = make([]IngressRule, len(o.Forward))
+		copy(cp.Forward, o.Forward)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SRIOVConfigV1Alpha1.
+func (o *SRIOVConfigV1Alpha1) DeepCopy() *SRIOVConfigV1Alpha1 {
+	var cp SRIOVConfigV1Alpha1 = *o
+	if o.SRIOVVFs != nil {
+		cp.SRIOVVFs = make([]SRIOVVFConfig, len(o.SRIOVVFs))
+		copy(cp.SRIOVVFs, o.SRIOVVFs)
+		for i2 := range o.SRIOVVFs {
+			if o.SRIOVVFs[i2].VFHardwareAddr != nil {
+				cp.SRIOVVFs[i2].VFHardwareAddr = make([]byte, len(o.SRIOVVFs[i2].VFHardwareAddr))
+				copy(cp.SRIOVVFs[i2].VFHardwareAddr, o.SRIOVVFs[i2].VFHardwareAddr)
+			}
+			if o.SRIOVVFs[i2].VFTrust != nil {
+				cp.SRIOVVFs[i2].VFTrust = new(bool)
+				*cp.SRIOVVFs[i2].VFTrust = *o.SRIOVVFs[i2].VFTrust
+			}
+			if o.SRIOVVFs[i2].VFSpoofCheck != nil {
+				cp.SRIOVVFs[i2].VFSpoofCheck = new(bool)
+				*cp.SRIOVVFs[i2].VFSpoofCheck = *o.SRIOVVFs[i2].VFSpoofCheck
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *StaticAddressConfigV1Alpha1.
+func (o *StaticAddressConfigV1Alpha1) DeepCopy() *StaticAddressConfigV1Alpha1 {
+	var cp StaticAddressConfigV1Alpha1 = *o
+	if o.AddressesConfig != nil {
+		cp.AddressesConfig = make([]netip.Prefix, len(o.AddressesConfig))
+		copy(cp.AddressesConfig, o.AddressesConfig)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *VLANConfigV1Alpha1.
+func (o *VLANConfigV1Alpha1) DeepCopy() *VLANConfigV1Alpha1 {
+	var cp VLANConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *VXLANConfigV1Alpha1.
+func (o *VXLANConfigV1Alpha1) DeepCopy() *VXLANConfigV1Alpha1 {
+	var cp VXLANConfigV1Alpha1 = *o
+	if o.LinkUp != nil {
+		cp.LinkUp = new(bool)
+		*cp.LinkUp = *o.LinkUp
+	}
 	return &cp
 }
@@ -33,7 +33,7 @@ var (
 	_ config.NetworkRuleConfigSignal        = &DefaultActionConfigV1Alpha1{}
 )
 
-// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+// DefaultActionConfigV1Alpha1 is a firewall default action configuration document.
 //
 //	examples:
 //	  - value: exampleDefaultActionConfigV1Alpha1()
@@ -48,6 +48,23 @@ type DefaultActionConfigV1Alpha1 struct {
 	//     - "accept"
 	//     - "block"
 	Ingress nethelpers.DefaultAction `yaml:"ingress"`
+	//   description: |
+	//     Default action for all not explicitly configured egress traffic (originating from the host): accept or block.
+	//   values:
+	//     - "accept"
+	//     - "block"
+	Egress nethelpers.DefaultAction `yaml:"egress,omitempty"`
+	//   description: |
+	//     Default action for all not explicitly configured forwarded traffic: accept or block.
+	//   values:
+	//     - "accept"
+	//     - "block"
+	Forward nethelpers.DefaultAction `yaml:"forward,omitempty"`
+	//   description: |
+	//     Log the dropped packets (rate-limited) to the machined log stream.
+	//
+	//     Packets dropped either by the explicit block rules or by the default action are logged.
+	LogDroppedPackets bool `yaml:"logDrops,omitempty"`
 }
 
 // NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
@@ -79,3 +96,18 @@ func (s *DefaultActionConfigV1Alpha1) NetworkRuleConfigSignal() {}
 func (s *DefaultActionConfigV1Alpha1) DefaultAction() nethelpers.DefaultAction {
 	return s.Ingress
 }
+
+// EgressDefaultAction implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) EgressDefaultAction() nethelpers.DefaultAction {
+	return s.Egress
+}
+
+// ForwardDefaultAction implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) ForwardDefaultAction() nethelpers.DefaultAction {
+	return s.Forward
+}
+
+// LogDrops implements config.NetworkRuleConfigDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) LogDrops() bool {
+	return s.LogDroppedPackets
+}
@@ -26,6 +26,8 @@ func TestDefaultActionConfigMarshalStability(t *testing.T) {
 
 	cfg := network.NewDefaultActionConfigV1Alpha1()
 	cfg.Ingress = nethelpers.DefaultActionBlock
+	cfg.Egress = nethelpers.DefaultActionBlock
+	cfg.LogDroppedPackets = true
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -49,6 +51,8 @@ func TestDefaultActionConfigUnmarshal(t *testing.T) {
 			MetaAPIVersion: "v1alpha1",
 			MetaKind:       network.DefaultActionConfig,
 		},
-		Ingress: nethelpers.DefaultActionBlock,
+		Ingress:           nethelpers.DefaultActionBlock,
+		Egress:            nethelpers.DefaultActionBlock,
+		LogDroppedPackets: true,
 	}, docs[0])
 }
@@ -0,0 +1,260 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DHCPv4ConfigKind is a DHCPv4 config document kind.
+const DHCPv4ConfigKind = "DHCPv4Config"
+
+func init() {
+	registry.Register(DHCPv4ConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DHCPv4ConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDHCPv4Config = &DHCPv4ConfigV1Alpha1{}
+	_ config.NamedDocument       = &DHCPv4ConfigV1Alpha1{}
+	_ config.Validator           = &DHCPv4ConfigV1Alpha1{}
+)
+
+// ClientIdentifierMAC is a special value of the client identifier which uses the link hardware address.
+const ClientIdentifierMAC = "mac"
+
+// DHCPv4ConfigV1Alpha1 is a DHCPv4 client configuration document.
+//
+//	examples:
+//	  - value: exampleDHCPv4ConfigV1Alpha1()
+//	alias: DHCPv4Config
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DHCPv4Config
+type DHCPv4ConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link (or the name of the `LinkConfig` document with the link selector).
+	//   examples:
+	//     - value: >
+	//         "enp1s0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The priority of all routes received via DHCP.
+	//
+	//     Defaults to `1024`.
+	//   examples:
+	//     - value: >
+	//         1024
+	DHCPRouteMetric uint32 `yaml:"routeMetric,omitempty"`
+	//   description: |
+	//     Don't request the hostname from the DHCP server.
+	DHCPIgnoreHostname bool `yaml:"ignoreHostname,omitempty"`
+	//   description: |
+	//     Client identifier (option 61) sent to the DHCP server.
+	//
+	//     Either a hex string (e.g. `01:52:54:00:12:34:56`), or `mac` to use the link hardware address
+	//     (with the Ethernet hardware type).
+	//     If not set, the client identifier is not sent, and the DHCP server identifies the client by the hardware address.
+	//   examples:
+	//     - value: >
+	//         "mac"
+	DHCPClientIdentifier string `yaml:"clientIdentifier,omitempty"`
+	//   description: |
+	//     Vendor class identifier (option 60) sent to the DHCP server.
+	//   examples:
+	//     - value: >
+	//         "talos"
+	DHCPVendorClass string `yaml:"vendorClass,omitempty"`
+	//   description: |
+	//     User classes (option 77, RFC 3004) sent to the DHCP server.
+	//   examples:
+	//     - value: >
+	//         []string{"edge"}
+	DHCPUserClass []string `yaml:"userClass,omitempty"`
+	//   description: |
+	//     Additional options to request from the DHCP server (option codes).
+	//
+	//     Talos always requests the options it uses: routes, DNS servers, domain search list, NTP servers,
+	//     hostname and MTU.
+	//   examples:
+	//     - value: >
+	//         []uint8{42, 119}
+	DHCPRequestedOptions []uint8 `yaml:"requestedOptions,omitempty"`
+	//   description: |
+	//     Name of the `NetworkProbeConfig` document which gates the routes received via DHCP.
+	//
+	//     When the probe fails, the metric of the routes via the DHCP gateway is raised to `probeFailureMetric`,
+	//     and the metric is restored when the probe recovers.
+	//   examples:
+	//     - value: >
+	//         "uplink0-gateway"
+	DHCPProbe string `yaml:"probe,omitempty"`
+	//   description: |
+	//     The metric (priority) of the routes received via DHCP to use when the probe fails.
+	//
+	//     Required if `probe` is set: the routes go via the DHCP gateway, so the probe bound to the link
+	//     might not reach its target if the routes were withdrawn.
+	//   examples:
+	//     - value: >
+	//         uint32(4096)
+	DHCPProbeFailureMetric uint32 `yaml:"probeFailureMetric,omitempty"`
+}
+
+// NewDHCPv4ConfigV1Alpha1 creates a new DHCPv4Config config document.
+func NewDHCPv4ConfigV1Alpha1() *DHCPv4ConfigV1Alpha1 {
+	return &DHCPv4ConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DHCPv4ConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDHCPv4ConfigV1Alpha1() *DHCPv4ConfigV1Alpha1 {
+	cfg := NewDHCPv4ConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.DHCPClientIdentifier = ClientIdentifierMAC
+	cfg.DHCPVendorClass = "talos"
+	cfg.DHCPUserClass = []string{"edge"}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DHCPv4ConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DHCPv4ConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDHCPv4ConfigSignal implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) NetworkDHCPv4ConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *DHCPv4ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	} else if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.DHCPClientIdentifier != "" && s.DHCPClientIdentifier != ClientIdentifierMAC {
+		clientID, err := parseHexString(s.DHCPClientIdentifier)
+
+		switch {
+		case err != nil:
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid client identifier %q: %w", s.DHCPClientIdentifier, err))
+		case len(clientID) < 2:
+			// RFC 2132, section 9.14: the minimum length is 2
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("client identifier %q is too short", s.DHCPClientIdentifier))
+		}
+	}
+
+	if len(s.DHCPVendorClass) > 255 {
+		validationErrors = errors.Join(validationErrors, errors.New("vendor class is too long"))
+	}
+
+	for _, userClass := range s.DHCPUserClass {
+		if userClass == "" || len(userClass) > 255 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid user class %q", userClass))
+		}
+	}
+
+	for _, code := range s.DHCPRequestedOptions {
+		// pad and end options can't be requested
+		if code == 0 || code == 255 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid requested option %d", code))
+		}
+	}
+
+	if s.DHCPProbeFailureMetric != 0 && s.DHCPProbe == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("probe failure metric requires probe to be set"))
+	}
+
+	// DHCP routes go via the gateway, so they can't be withdrawn without cutting off the probe
+	if s.DHCPProbe != "" && s.DHCPProbeFailureMetric == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("probe failure metric is required when probe is set"))
+	}
+
+	return nil, validationErrors
+}
+
+// parseHexString parses a hex string, optionally with the bytes separated by colons.
+func parseHexString(s string) ([]byte, error) {
+	return hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+}
+
+// RouteMetric implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) RouteMetric() uint32 {
+	return s.DHCPRouteMetric
+}
+
+// IgnoreHostname implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) IgnoreHostname() bool {
+	return s.DHCPIgnoreHostname
+}
+
+// ClientIdentifier implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) ClientIdentifier() string {
+	return s.DHCPClientIdentifier
+}
+
+// VendorClass implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) VendorClass() string {
+	return s.DHCPVendorClass
+}
+
+// UserClass implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) UserClass() []string {
+	return s.DHCPUserClass
+}
+
+// RequestedOptions implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) RequestedOptions() []uint8 {
+	return s.DHCPRequestedOptions
+}
+
+// Probe implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) Probe() optional.Optional[string] {
+	if s.DHCPProbe == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.DHCPProbe)
+}
+
+// ProbeFailureMetric implements config.NetworkDHCPv4Config interface.
+func (s *DHCPv4ConfigV1Alpha1) ProbeFailureMetric() optional.Optional[uint32] {
+	if s.DHCPProbeFailureMetric == 0 {
+		return optional.None[uint32]()
+	}
+
+	return optional.Some(s.DHCPProbeFailureMetric)
+}
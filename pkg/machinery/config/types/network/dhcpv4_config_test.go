@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dhcpv4config.yaml
+var expectedDHCPv4ConfigDocument []byte
+
+func TestDHCPv4ConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDHCPv4ConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.DHCPRouteMetric = 2048
+	cfg.DHCPClientIdentifier = "01:52:54:00:12:34:56"
+	cfg.DHCPVendorClass = "talos"
+	cfg.DHCPUserClass = []string{"edge", "rack1"}
+	cfg.DHCPRequestedOptions = []uint8{42, 119}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDHCPv4ConfigDocument, marshaled)
+}
+
+func TestDHCPv4ConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDHCPv4ConfigDocument)
+	require.NoError(t, err)
+
+	configs := provider.NetworkDHCPv4Configs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp1s0", configs[0].Name())
+	assert.EqualValues(t, 2048, configs[0].RouteMetric())
+	assert.False(t, configs[0].IgnoreHostname())
+	assert.Equal(t, "01:52:54:00:12:34:56", configs[0].ClientIdentifier())
+	assert.Equal(t, "talos", configs[0].VendorClass())
+	assert.Equal(t, []string{"edge", "rack1"}, configs[0].UserClass())
+	assert.Equal(t, []uint8{42, 119}, configs[0].RequestedOptions())
+}
+
+func TestDHCPv4ConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DHCPv4ConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDHCPv4ConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "invalid options",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				cfg := network.NewDHCPv4ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPClientIdentifier = "01:xx"
+				cfg.DHCPUserClass = []string{""}
+				cfg.DHCPRequestedOptions = []uint8{0, 42}
+
+				return cfg
+			},
+
+			expectedError: "invalid client identifier \"01:xx\": encoding/hex: invalid byte: U+0078 'x'\ninvalid user class \"\"\ninvalid requested option 0",
+		},
+		{
+			name: "short client identifier",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				cfg := network.NewDHCPv4ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPClientIdentifier = "01"
+
+				return cfg
+			},
+
+			expectedError: "client identifier \"01\" is too short",
+		},
+		{
+			name: "probe failure metric without probe",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				cfg := network.NewDHCPv4ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPProbeFailureMetric = 4096
+
+				return cfg
+			},
+
+			expectedError: "probe failure metric requires probe to be set",
+		},
+		{
+			name: "probe without failure metric",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				cfg := network.NewDHCPv4ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPProbe = "uplink0"
+
+				return cfg
+			},
+
+			expectedError: "probe failure metric is required when probe is set",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				cfg := network.NewDHCPv4ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPClientIdentifier = network.ClientIdentifierMAC
+				cfg.DHCPVendorClass = "talos"
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/labels"
+)
+
+// DHCPv6ConfigKind is a DHCPv6 config document kind.
+const DHCPv6ConfigKind = "DHCPv6Config"
+
+func init() {
+	registry.Register(DHCPv6ConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DHCPv6ConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDHCPv6Config                 = &DHCPv6ConfigV1Alpha1{}
+	_ config.NamedDocument                       = &DHCPv6ConfigV1Alpha1{}
+	_ config.Validator                           = &DHCPv6ConfigV1Alpha1{}
+	_ config.NetworkDHCPv6PrefixDelegationConfig = &DHCPv6PrefixDelegationConfig{}
+	_ config.NetworkDHCPv6PrefixAssignmentConfig = &DHCPv6PrefixAssignmentConfig{}
+)
+
+// DefaultPrefixAssignmentLength is the default length of the subnet assigned from the delegated prefix.
+const DefaultPrefixAssignmentLength = 64
+
+// DHCPv6ConfigV1Alpha1 is a DHCPv6 client configuration document.
+//
+//	examples:
+//	  - value: exampleDHCPv6ConfigV1Alpha1()
+//	alias: DHCPv6Config
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DHCPv6Config
+type DHCPv6ConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link (or the name of the `LinkConfig` document with the link selector).
+	//   examples:
+	//     - value: >
+	//         "enp1s0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The priority of all routes received via DHCP.
+	//
+	//     Defaults to `1024`.
+	//   examples:
+	//     - value: >
+	//         1024
+	DHCPRouteMetric uint32 `yaml:"routeMetric,omitempty"`
+	//   description: |
+	//     Don't use the hostname received from the DHCP server.
+	DHCPIgnoreHostname bool `yaml:"ignoreHostname,omitempty"`
+	//   description: |
+	//     Client DUID (hex string).
+	//
+	//     If not set, the DUID is generated from the link hardware address.
+	//   examples:
+	//     - value: >
+	//         "00:03:00:01:52:54:00:12:34:56"
+	DHCPDUID string `yaml:"duid,omitempty"`
+	//   description: |
+	//     Request a prefix delegation (IA_PD) from the DHCP server.
+	//
+	//     The subnets of the delegated prefix can be assigned to other links,
+	//     or published as Kubernetes node annotations to be used by the CNI.
+	DHCPPrefixDelegation *DHCPv6PrefixDelegationConfig `yaml:"prefixDelegation,omitempty"`
+}
+
+// DHCPv6PrefixDelegationConfig is a DHCPv6 prefix delegation configuration.
+type DHCPv6PrefixDelegationConfig struct {
+	//   description: |
+	//     Length of the prefix to request from the DHCP server (a hint, the server might delegate a different prefix).
+	//
+	//     If not set, the server picks the prefix length.
+	//   examples:
+	//     - value: >
+	//         56
+	PDPrefixLength int `yaml:"prefixLength,omitempty"`
+	//   description: |
+	//     Subnets of the delegated prefix to assign.
+	PDAssignments []DHCPv6PrefixAssignmentConfig `yaml:"assignments,omitempty"`
+}
+
+// DHCPv6PrefixAssignmentConfig is an assignment of the subnet of the delegated prefix.
+type DHCPv6PrefixAssignmentConfig struct {
+	//   description: |
+	//     Link (or the name of the `LinkConfig` document with the link selector) to assign the first address of the subnet to.
+	//
+	//     Mutually exclusive with `nodeAnnotation`.
+	//   examples:
+	//     - value: >
+	//         "enp2s0"
+	AssignmentLink string `yaml:"link,omitempty"`
+	//   description: |
+	//     Kubernetes node annotation to publish the subnet in, e.g. to hand the subnet to the CNI as the pod CIDR.
+	//
+	//     Mutually exclusive with `link`.
+	//   examples:
+	//     - value: >
+	//         "network.cilium.io/ipv6-pod-cidr"
+	AssignmentNodeAnnotation string `yaml:"nodeAnnotation,omitempty"`
+	//   description: |
+	//     Index of the subnet within the delegated prefix.
+	//
+	//     E.g. with the delegated prefix `2001:db8:0:100::/56` and the subnet length `64`,
+	//     the subnet ID `1` selects `2001:db8:0:101::/64`.
+	//   examples:
+	//     - value: >
+	//         1
+	AssignmentSubnetID uint64 `yaml:"subnetID"`
+	//   description: |
+	//     Length of the subnet.
+	//
+	//     Defaults to `64`.
+	//   examples:
+	//     - value: >
+	//         64
+	AssignmentPrefixLength int `yaml:"prefixLength,omitempty"`
+}
+
+// NewDHCPv6ConfigV1Alpha1 creates a new DHCPv6Config config document.
+func NewDHCPv6ConfigV1Alpha1() *DHCPv6ConfigV1Alpha1 {
+	return &DHCPv6ConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DHCPv6ConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDHCPv6ConfigV1Alpha1() *DHCPv6ConfigV1Alpha1 {
+	cfg := NewDHCPv6ConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.DHCPPrefixDelegation = &DHCPv6PrefixDelegationConfig{
+		PDPrefixLength: 56,
+		PDAssignments: []DHCPv6PrefixAssignmentConfig{
+			{
+				AssignmentLink:     "enp2s0",
+				AssignmentSubnetID: 1,
+			},
+			{
+				AssignmentNodeAnnotation: "network.cilium.io/ipv6-pod-cidr",
+				AssignmentSubnetID:       2,
+			},
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DHCPv6ConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DHCPv6ConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDHCPv6ConfigSignal implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) NetworkDHCPv6ConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *DHCPv6ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	} else if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.DHCPDUID != "" {
+		if _, err := parseHexString(s.DHCPDUID); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid duid %q: %w", s.DHCPDUID, err))
+		}
+	}
+
+	if s.DHCPPrefixDelegation != nil {
+		validationErrors = errors.Join(validationErrors, s.DHCPPrefixDelegation.validate(s.MetaName))
+	}
+
+	return nil, validationErrors
+}
+
+//nolint:gocyclo
+func (s *DHCPv6PrefixDelegationConfig) validate(linkName string) error {
+	var validationErrors error
+
+	if s.PDPrefixLength < 0 || s.PDPrefixLength > 64 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("prefix delegation length %d is out of range [1, 64]", s.PDPrefixLength))
+	}
+
+	for i, assignment := range s.PDAssignments {
+		switch {
+		case assignment.AssignmentLink == "" && assignment.AssignmentNodeAnnotation == "":
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: either link or nodeAnnotation is required", i))
+		case assignment.AssignmentLink != "" && assignment.AssignmentNodeAnnotation != "":
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: link and nodeAnnotation are mutually exclusive", i))
+		case assignment.AssignmentLink != "":
+			if err := validateLinkName(assignment.AssignmentLink); err != nil {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: %w", i, err))
+			} else if assignment.AssignmentLink == linkName {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: the delegated prefix can't be assigned to the link it was requested on", i))
+			}
+		default:
+			if err := labels.ValidateQualifiedName(assignment.AssignmentNodeAnnotation); err != nil {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: invalid node annotation: %w", i, err))
+			}
+		}
+
+		prefixLength := assignment.PrefixLength()
+
+		if prefixLength > 128 || (s.PDPrefixLength > 0 && prefixLength < s.PDPrefixLength) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: prefix length %d is out of range [%d, 128]", i, prefixLength, max(s.PDPrefixLength, 1)))
+
+			continue
+		}
+
+		if s.PDPrefixLength > 0 && prefixLength-s.PDPrefixLength < 64 && assignment.AssignmentSubnetID >= 1<<(prefixLength-s.PDPrefixLength) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("assignment %d: subnet ID %d doesn't fit into /%d prefix", i, assignment.AssignmentSubnetID, s.PDPrefixLength))
+		}
+	}
+
+	return validationErrors
+}
+
+// RouteMetric implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) RouteMetric() uint32 {
+	return s.DHCPRouteMetric
+}
+
+// IgnoreHostname implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) IgnoreHostname() bool {
+	return s.DHCPIgnoreHostname
+}
+
+// DUID implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) DUID() string {
+	return s.DHCPDUID
+}
+
+// PrefixDelegation implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) PrefixDelegation() optional.Optional[config.NetworkDHCPv6PrefixDelegationConfig] {
+	if s.DHCPPrefixDelegation == nil {
+		return optional.None[config.NetworkDHCPv6PrefixDelegationConfig]()
+	}
+
+	return optional.Some[config.NetworkDHCPv6PrefixDelegationConfig](s.DHCPPrefixDelegation)
+}
+
+// PrefixLength implements config.NetworkDHCPv6PrefixDelegationConfig interface.
+func (s *DHCPv6PrefixDelegationConfig) PrefixLength() int {
+	return s.PDPrefixLength
+}
+
+// Assignments implements config.NetworkDHCPv6PrefixDelegationConfig interface.
+func (s *DHCPv6PrefixDelegationConfig) Assignments() []config.NetworkDHCPv6PrefixAssignmentConfig {
+	assignments := make([]config.NetworkDHCPv6PrefixAssignmentConfig, 0, len(s.PDAssignments))
+
+	for i := range s.PDAssignments {
+		assignments = append(assignments, &s.PDAssignments[i])
+	}
+
+	return assignments
+}
+
+// Link implements config.NetworkDHCPv6PrefixAssignmentConfig interface.
+func (s *DHCPv6PrefixAssignmentConfig) Link() string {
+	return s.AssignmentLink
+}
+
+// NodeAnnotation implements config.NetworkDHCPv6PrefixAssignmentConfig interface.
+func (s *DHCPv6PrefixAssignmentConfig) NodeAnnotation() string {
+	return s.AssignmentNodeAnnotation
+}
+
+// SubnetID implements config.NetworkDHCPv6PrefixAssignmentConfig interface.
+func (s *DHCPv6PrefixAssignmentConfig) SubnetID() uint64 {
+	return s.AssignmentSubnetID
+}
+
+// PrefixLength implements config.NetworkDHCPv6PrefixAssignmentConfig interface.
+func (s *DHCPv6PrefixAssignmentConfig) PrefixLength() int {
+	if s.AssignmentPrefixLength == 0 {
+		return DefaultPrefixAssignmentLength
+	}
+
+	return s.AssignmentPrefixLength
+}
@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dhcpv6config.yaml
+var expectedDHCPv6ConfigDocument []byte
+
+func TestDHCPv6ConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDHCPv6ConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.DHCPDUID = "00:03:00:01:52:54:00:12:34:56"
+	cfg.DHCPPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+		PDPrefixLength: 56,
+		PDAssignments: []network.DHCPv6PrefixAssignmentConfig{
+			{
+				AssignmentLink:     "enp2s0",
+				AssignmentSubnetID: 1,
+			},
+			{
+				AssignmentNodeAnnotation: "network.cilium.io/ipv6-pod-cidr",
+				AssignmentSubnetID:       2,
+				AssignmentPrefixLength:   64,
+			},
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDHCPv6ConfigDocument, marshaled)
+}
+
+func TestDHCPv6ConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDHCPv6ConfigDocument)
+	require.NoError(t, err)
+
+	configs := provider.NetworkDHCPv6Configs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp1s0", configs[0].Name())
+	assert.Zero(t, configs[0].RouteMetric())
+	assert.Equal(t, "00:03:00:01:52:54:00:12:34:56", configs[0].DUID())
+
+	pd, ok := configs[0].PrefixDelegation().Get()
+	require.True(t, ok)
+
+	assert.Equal(t, 56, pd.PrefixLength())
+
+	assignments := pd.Assignments()
+	require.Len(t, assignments, 2)
+
+	assert.Equal(t, "enp2s0", assignments[0].Link())
+	assert.Empty(t, assignments[0].NodeAnnotation())
+	assert.EqualValues(t, 1, assignments[0].SubnetID())
+	assert.Equal(t, 64, assignments[0].PrefixLength())
+
+	assert.Empty(t, assignments[1].Link())
+	assert.Equal(t, "network.cilium.io/ipv6-pod-cidr", assignments[1].NodeAnnotation())
+	assert.EqualValues(t, 2, assignments[1].SubnetID())
+	assert.Equal(t, 64, assignments[1].PrefixLength())
+}
+
+func TestDHCPv6ConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DHCPv6ConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDHCPv6ConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "invalid duid",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				cfg := network.NewDHCPv6ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPDUID = "zz"
+
+				return cfg
+			},
+
+			expectedError: "invalid duid \"zz\": encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name: "invalid assignments",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				cfg := network.NewDHCPv6ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+					PDPrefixLength: 60,
+					PDAssignments: []network.DHCPv6PrefixAssignmentConfig{
+						{
+							AssignmentSubnetID: 1,
+						},
+						{
+							AssignmentLink:           "eth1",
+							AssignmentNodeAnnotation: "example.com/prefix",
+						},
+						{
+							AssignmentLink: "eth0",
+						},
+						{
+							AssignmentLink:     "eth1",
+							AssignmentSubnetID: 16,
+						},
+						{
+							AssignmentNodeAnnotation: "example.com/prefix",
+							AssignmentPrefixLength:   56,
+						},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "assignment 0: either link or nodeAnnotation is required\n" +
+				"assignment 1: link and nodeAnnotation are mutually exclusive\n" +
+				"assignment 2: the delegated prefix can't be assigned to the link it was requested on\n" +
+				"assignment 3: subnet ID 16 doesn't fit into /60 prefix\n" +
+				"assignment 4: prefix length 56 is out of range [60, 128]",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				cfg := network.NewDHCPv6ConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.DHCPPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+					PDPrefixLength: 60,
+					PDAssignments: []network.DHCPv6PrefixAssignmentConfig{
+						{
+							AssignmentLink:     "eth1",
+							AssignmentSubnetID: 15,
+						},
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
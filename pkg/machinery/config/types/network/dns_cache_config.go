@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DNSCacheConfigKind is a DNS cache config document kind.
+const DNSCacheConfigKind = "DNSCacheConfig"
+
+func init() {
+	registry.Register(DNSCacheConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DNSCacheConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDNSCacheConfig = &DNSCacheConfigV1Alpha1{}
+	_ config.Validator             = &DNSCacheConfigV1Alpha1{}
+)
+
+// DNS cache TTL limits.
+const (
+	minDNSCacheTTL         = 5 * time.Second
+	maxDNSCacheTTL         = time.Hour
+	maxDNSCacheNegativeTTL = 30 * time.Minute
+)
+
+// DNSCacheConfigV1Alpha1 is a host DNS server cache configuration document.
+//
+//	examples:
+//	  - value: exampleDNSCacheConfigV1Alpha1()
+//	alias: DNSCacheConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DNSCacheConfig
+type DNSCacheConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Minimum TTL of the cached positive responses.
+	//
+	//     Responses with lower TTL are cached for this duration. Defaults to `5s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	CacheMinTTL time.Duration `yaml:"minTTL,omitempty"`
+	//   description: |
+	//     Maximum TTL of the cached positive responses.
+	//
+	//     Responses with higher TTL are cached for this duration. Defaults to `1h`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	CacheMaxTTL time.Duration `yaml:"maxTTL,omitempty"`
+	//   description: |
+	//     Maximum TTL of the cached negative responses (NXDOMAIN, NODATA).
+	//
+	//     Defaults to `10s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	CacheNegativeTTL time.Duration `yaml:"negativeTTL,omitempty"`
+}
+
+// NewDNSCacheConfigV1Alpha1 creates a new DNSCacheConfig config document.
+func NewDNSCacheConfigV1Alpha1() *DNSCacheConfigV1Alpha1 {
+	return &DNSCacheConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DNSCacheConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDNSCacheConfigV1Alpha1() *DNSCacheConfigV1Alpha1 {
+	cfg := NewDNSCacheConfigV1Alpha1()
+	cfg.CacheMaxTTL = 10 * time.Minute
+	cfg.CacheNegativeTTL = 30 * time.Second
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *DNSCacheConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDNSCacheConfigSignal implements config.NetworkDNSCacheConfig interface.
+func (s *DNSCacheConfigV1Alpha1) NetworkDNSCacheConfigSignal() {}
+
+// MinTTL implements config.NetworkDNSCacheConfig interface.
+func (s *DNSCacheConfigV1Alpha1) MinTTL() time.Duration {
+	return s.CacheMinTTL
+}
+
+// MaxTTL implements config.NetworkDNSCacheConfig interface.
+func (s *DNSCacheConfigV1Alpha1) MaxTTL() time.Duration {
+	return s.CacheMaxTTL
+}
+
+// NegativeTTL implements config.NetworkDNSCacheConfig interface.
+func (s *DNSCacheConfigV1Alpha1) NegativeTTL() time.Duration {
+	return s.CacheNegativeTTL
+}
+
+// Validate implements config.Validator interface.
+func (s *DNSCacheConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	for _, ttl := range []struct {
+		name  string
+		value time.Duration
+		max   time.Duration
+	}{
+		{"minTTL", s.CacheMinTTL, maxDNSCacheTTL},
+		{"maxTTL", s.CacheMaxTTL, maxDNSCacheTTL},
+		{"negativeTTL", s.CacheNegativeTTL, maxDNSCacheNegativeTTL},
+	} {
+		if ttl.value != 0 && (ttl.value < minDNSCacheTTL || ttl.value > ttl.max) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("%s %s should be between %s and %s", ttl.name, ttl.value, minDNSCacheTTL, ttl.max))
+		}
+	}
+
+	if s.CacheMinTTL != 0 && s.CacheMaxTTL != 0 && s.CacheMinTTL > s.CacheMaxTTL {
+		validationErrors = errors.Join(validationErrors, errors.New("minTTL should be less than or equal to maxTTL"))
+	}
+
+	return nil, validationErrors
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dnscacheconfig.yaml
+var expectedDNSCacheConfigDocument []byte
+
+func TestDNSCacheConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDNSCacheConfigV1Alpha1()
+	cfg.CacheMaxTTL = 10 * time.Minute
+	cfg.CacheNegativeTTL = 30 * time.Second
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDNSCacheConfigDocument, marshaled)
+}
+
+func TestDNSCacheConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDNSCacheConfigDocument)
+	require.NoError(t, err)
+
+	cfg := provider.NetworkDNSCacheConfig()
+	require.NotNil(t, cfg)
+
+	assert.Zero(t, cfg.MinTTL())
+	assert.Equal(t, 10*time.Minute, cfg.MaxTTL())
+	assert.Equal(t, 30*time.Second, cfg.NegativeTTL())
+}
+
+func TestDNSCacheConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DNSCacheConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDNSCacheConfigV1Alpha1,
+		},
+		{
+			name: "out of range",
+			cfg: func() *network.DNSCacheConfigV1Alpha1 {
+				cfg := network.NewDNSCacheConfigV1Alpha1()
+				cfg.CacheMinTTL = time.Second
+				cfg.CacheMaxTTL = 2 * time.Hour
+				cfg.CacheNegativeTTL = time.Hour
+
+				return cfg
+			},
+
+			expectedError: "minTTL 1s should be between 5s and 1h0m0s\nmaxTTL 2h0m0s should be between 5s and 1h0m0s\nnegativeTTL 1h0m0s should be between 5s and 30m0s",
+		},
+		{
+			name: "min greater than max",
+			cfg: func() *network.DNSCacheConfigV1Alpha1 {
+				cfg := network.NewDNSCacheConfigV1Alpha1()
+				cfg.CacheMinTTL = time.Minute
+				cfg.CacheMaxTTL = 30 * time.Second
+
+				return cfg
+			},
+
+			expectedError: "minTTL should be less than or equal to maxTTL",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
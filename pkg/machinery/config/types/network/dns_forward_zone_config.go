@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DNSForwardZoneConfigKind is a DNS forward zone config document kind.
+const DNSForwardZoneConfigKind = "DNSForwardZoneConfig"
+
+func init() {
+	registry.Register(DNSForwardZoneConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DNSForwardZoneConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDNSForwardZoneConfig = &DNSForwardZoneConfigV1Alpha1{}
+	_ config.NamedDocument               = &DNSForwardZoneConfigV1Alpha1{}
+	_ config.Validator                   = &DNSForwardZoneConfigV1Alpha1{}
+)
+
+// DNSForwardZoneConfigV1Alpha1 is a conditional forwarding configuration document for the host DNS server.
+//
+//	examples:
+//	  - value: exampleDNSForwardZoneConfigV1Alpha1()
+//	alias: DNSForwardZoneConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DNSForwardZoneConfig
+type DNSForwardZoneConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Domain name of the zone.
+	//
+	//     Requests for the domain and all its subdomains are forwarded to the zone servers
+	//     instead of the default upstreams. The most specific zone wins.
+	//   examples:
+	//     - value: >
+	//         "corp.example"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     List of DNS servers to forward the zone requests to.
+	//
+	//     The servers are specified as IP addresses with an optional port (defaults to 53).
+	//   examples:
+	//     - value: >
+	//         []string{"10.0.0.53", "[2001:db8::53]:5353"}
+	//   schemaRequired: true
+	ZoneServers []string `yaml:"servers"`
+}
+
+// NewDNSForwardZoneConfigV1Alpha1 creates a new DNSForwardZoneConfig config document.
+func NewDNSForwardZoneConfigV1Alpha1() *DNSForwardZoneConfigV1Alpha1 {
+	return &DNSForwardZoneConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DNSForwardZoneConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDNSForwardZoneConfigV1Alpha1() *DNSForwardZoneConfigV1Alpha1 {
+	cfg := NewDNSForwardZoneConfigV1Alpha1()
+	cfg.MetaName = "corp.example"
+	cfg.ZoneServers = []string{"10.0.0.53", "10.0.1.53"}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DNSForwardZoneConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DNSForwardZoneConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDNSForwardZoneConfigSignal implements config.NetworkDNSForwardZoneConfig interface.
+func (s *DNSForwardZoneConfigV1Alpha1) NetworkDNSForwardZoneConfigSignal() {}
+
+// Servers implements config.NetworkDNSForwardZoneConfig interface.
+func (s *DNSForwardZoneConfigV1Alpha1) Servers() []netip.AddrPort {
+	servers := make([]netip.AddrPort, 0, len(s.ZoneServers))
+
+	for _, server := range s.ZoneServers {
+		addr, err := parseDNSServer(server)
+		if err != nil {
+			continue
+		}
+
+		servers = append(servers, addr)
+	}
+
+	return servers
+}
+
+// Validate implements config.Validator interface.
+func (s *DNSForwardZoneConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	} else if !isValidDNSName(s.MetaName) {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("name %q is not a valid domain name", s.MetaName))
+	}
+
+	if len(s.ZoneServers) == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("at least one server is required"))
+	}
+
+	for _, server := range s.ZoneServers {
+		if _, err := parseDNSServer(server); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid server %q: %w", server, err))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// parseDNSServer parses the DNS server address with an optional port.
+func parseDNSServer(server string) (netip.AddrPort, error) {
+	if addr, err := netip.ParseAddr(server); err == nil {
+		return netip.AddrPortFrom(addr, 53), nil
+	}
+
+	return netip.ParseAddrPort(server)
+}
+
+var rxDNSNameRegexp = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`^([a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62}){1}(\.[a-zA-Z0-9_]{1}[a-zA-Z0-9_-]{0,62})*\.?$`)
+})
+
+func isValidDNSName(name string) bool {
+	if name == "" || len(name)-strings.Count(name, ".") > 255 {
+		return false
+	}
+
+	return rxDNSNameRegexp().MatchString(name)
+}
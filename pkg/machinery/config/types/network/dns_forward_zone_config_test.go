@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dnsforwardzoneconfig.yaml
+var expectedDNSForwardZoneConfigDocument []byte
+
+func TestDNSForwardZoneConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDNSForwardZoneConfigV1Alpha1()
+	cfg.MetaName = "corp.example"
+	cfg.ZoneServers = []string{"10.0.0.53", "[2001:db8::53]:5353"}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDNSForwardZoneConfigDocument, marshaled)
+}
+
+func TestDNSForwardZoneConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDNSForwardZoneConfigDocument)
+	require.NoError(t, err)
+
+	zones := provider.NetworkDNSForwardZoneConfigs()
+	require.Len(t, zones, 1)
+
+	assert.Equal(t, "corp.example", zones[0].Name())
+	assert.Equal(t,
+		[]netip.AddrPort{
+			netip.MustParseAddrPort("10.0.0.53:53"),
+			netip.MustParseAddrPort("[2001:db8::53]:5353"),
+		},
+		zones[0].Servers(),
+	)
+}
+
+func TestDNSForwardZoneConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DNSForwardZoneConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDNSForwardZoneConfigV1Alpha1,
+
+			expectedError: "name is required\nat least one server is required",
+		},
+		{
+			name: "invalid",
+			cfg: func() *network.DNSForwardZoneConfigV1Alpha1 {
+				cfg := network.NewDNSForwardZoneConfigV1Alpha1()
+				cfg.MetaName = "corp..example"
+				cfg.ZoneServers = []string{"dns.corp.example"}
+
+				return cfg
+			},
+
+			expectedError: "name \"corp..example\" is not a valid domain name\ninvalid server \"dns.corp.example\": not an ip:port",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DNSForwardZoneConfigV1Alpha1 {
+				cfg := network.NewDNSForwardZoneConfigV1Alpha1()
+				cfg.MetaName = "10.in-addr.arpa."
+				cfg.ZoneServers = []string{"10.0.0.53:53"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DNSStaticRecordConfigKind is a DNS static record config document kind.
+const DNSStaticRecordConfigKind = "DNSStaticRecordConfig"
+
+func init() {
+	registry.Register(DNSStaticRecordConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DNSStaticRecordConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDNSStaticRecordConfig = &DNSStaticRecordConfigV1Alpha1{}
+	_ config.NamedDocument                = &DNSStaticRecordConfigV1Alpha1{}
+	_ config.Validator                    = &DNSStaticRecordConfigV1Alpha1{}
+)
+
+// DNSStaticRecordConfigV1Alpha1 is a static DNS record configuration document for the host DNS server.
+//
+//	examples:
+//	  - value: exampleDNSStaticRecordConfigV1Alpha1()
+//	  - value: exampleDNSStaticRecordConfigV1Alpha1CNAME()
+//	alias: DNSStaticRecordConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DNSStaticRecordConfig
+type DNSStaticRecordConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Fully qualified name of the record.
+	//   examples:
+	//     - value: >
+	//         "registry.corp.example"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     List of IP addresses the name resolves to (A and AAAA records).
+	//
+	//     Mutually exclusive with `cname`.
+	//   examples:
+	//     - value: >
+	//         []string{"10.0.0.10", "2001:db8::10"}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+$
+	RecordAddresses []netip.Addr `yaml:"addresses,omitempty"`
+	//   description: |
+	//     Canonical name the name is an alias for (CNAME record).
+	//
+	//     Mutually exclusive with `addresses`.
+	//   examples:
+	//     - value: >
+	//         "registry-1.corp.example"
+	RecordCNAME string `yaml:"cname,omitempty"`
+	//   description: |
+	//     TTL of the record.
+	//
+	//     Defaults to `1m`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	RecordTTL time.Duration `yaml:"ttl,omitempty"`
+}
+
+// DefaultDNSStaticRecordTTL is the default TTL of the static DNS records.
+const DefaultDNSStaticRecordTTL = time.Minute
+
+// NewDNSStaticRecordConfigV1Alpha1 creates a new DNSStaticRecordConfig config document.
+func NewDNSStaticRecordConfigV1Alpha1() *DNSStaticRecordConfigV1Alpha1 {
+	return &DNSStaticRecordConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DNSStaticRecordConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDNSStaticRecordConfigV1Alpha1() *DNSStaticRecordConfigV1Alpha1 {
+	cfg := NewDNSStaticRecordConfigV1Alpha1()
+	cfg.MetaName = "registry.corp.example"
+	cfg.RecordAddresses = []netip.Addr{netip.MustParseAddr("10.0.0.10")}
+
+	return cfg
+}
+
+func exampleDNSStaticRecordConfigV1Alpha1CNAME() *DNSStaticRecordConfigV1Alpha1 {
+	cfg := NewDNSStaticRecordConfigV1Alpha1()
+	cfg.MetaName = "mirror.corp.example"
+	cfg.RecordCNAME = "registry.corp.example"
+	cfg.RecordTTL = 5 * time.Minute
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DNSStaticRecordConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DNSStaticRecordConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDNSStaticRecordConfigSignal implements config.NetworkDNSStaticRecordConfig interface.
+func (s *DNSStaticRecordConfigV1Alpha1) NetworkDNSStaticRecordConfigSignal() {}
+
+// Addresses implements config.NetworkDNSStaticRecordConfig interface.
+func (s *DNSStaticRecordConfigV1Alpha1) Addresses() []netip.Addr {
+	return s.RecordAddresses
+}
+
+// CNAME implements config.NetworkDNSStaticRecordConfig interface.
+func (s *DNSStaticRecordConfigV1Alpha1) CNAME() string {
+	return s.RecordCNAME
+}
+
+// TTL implements config.NetworkDNSStaticRecordConfig interface.
+func (s *DNSStaticRecordConfigV1Alpha1) TTL() time.Duration {
+	if s.RecordTTL == 0 {
+		return DefaultDNSStaticRecordTTL
+	}
+
+	return s.RecordTTL
+}
+
+// Validate implements config.Validator interface.
+func (s *DNSStaticRecordConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	} else if !isValidDNSName(s.MetaName) {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("name %q is not a valid domain name", s.MetaName))
+	}
+
+	switch {
+	case len(s.RecordAddresses) == 0 && s.RecordCNAME == "":
+		validationErrors = errors.Join(validationErrors, errors.New("either addresses or cname should be specified"))
+	case len(s.RecordAddresses) > 0 && s.RecordCNAME != "":
+		validationErrors = errors.Join(validationErrors, errors.New("addresses and cname are mutually exclusive"))
+	case s.RecordCNAME != "" && !isValidDNSName(s.RecordCNAME):
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("cname %q is not a valid domain name", s.RecordCNAME))
+	}
+
+	for _, addr := range s.RecordAddresses {
+		if !addr.IsValid() {
+			validationErrors = errors.Join(validationErrors, errors.New("invalid address"))
+		}
+	}
+
+	if s.RecordTTL < 0 || s.RecordTTL > time.Hour {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("ttl %s should be between 0 and 1h", s.RecordTTL))
+	}
+
+	return nil, validationErrors
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dnsstaticrecordconfig.yaml
+var expectedDNSStaticRecordConfigDocument []byte
+
+func TestDNSStaticRecordConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDNSStaticRecordConfigV1Alpha1()
+	cfg.MetaName = "registry.corp.example"
+	cfg.RecordAddresses = []netip.Addr{netip.MustParseAddr("10.0.0.10"), netip.MustParseAddr("2001:db8::10")}
+	cfg.RecordTTL = 5 * time.Minute
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDNSStaticRecordConfigDocument, marshaled)
+}
+
+func TestDNSStaticRecordConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDNSStaticRecordConfigDocument)
+	require.NoError(t, err)
+
+	records := provider.NetworkDNSStaticRecordConfigs()
+	require.Len(t, records, 1)
+
+	assert.Equal(t, "registry.corp.example", records[0].Name())
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.10"), netip.MustParseAddr("2001:db8::10")}, records[0].Addresses())
+	assert.Empty(t, records[0].CNAME())
+	assert.Equal(t, 5*time.Minute, records[0].TTL())
+}
+
+func TestDNSStaticRecordConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DNSStaticRecordConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDNSStaticRecordConfigV1Alpha1,
+
+			expectedError: "name is required\neither addresses or cname should be specified",
+		},
+		{
+			name: "both",
+			cfg: func() *network.DNSStaticRecordConfigV1Alpha1 {
+				cfg := network.NewDNSStaticRecordConfigV1Alpha1()
+				cfg.MetaName = "registry.corp.example"
+				cfg.RecordAddresses = []netip.Addr{netip.MustParseAddr("10.0.0.10")}
+				cfg.RecordCNAME = "registry-1.corp.example"
+
+				return cfg
+			},
+
+			expectedError: "addresses and cname are mutually exclusive",
+		},
+		{
+			name: "invalid",
+			cfg: func() *network.DNSStaticRecordConfigV1Alpha1 {
+				cfg := network.NewDNSStaticRecordConfigV1Alpha1()
+				cfg.MetaName = "registry corp"
+				cfg.RecordCNAME = "-"
+				cfg.RecordTTL = 2 * time.Hour
+
+				return cfg
+			},
+
+			expectedError: "name \"registry corp\" is not a valid domain name\ncname \"-\" is not a valid domain name\nttl 2h0m0s should be between 0 and 1h",
+		},
+		{
+			name: "cname",
+			cfg: func() *network.DNSStaticRecordConfigV1Alpha1 {
+				cfg := network.NewDNSStaticRecordConfigV1Alpha1()
+				cfg.MetaName = "mirror.corp.example."
+				cfg.RecordCNAME = "registry.corp.example."
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
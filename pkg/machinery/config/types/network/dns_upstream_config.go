@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// DNSUpstreamConfigKind is a DNS upstream config document kind.
+const DNSUpstreamConfigKind = "DNSUpstreamConfig"
+
+func init() {
+	registry.Register(DNSUpstreamConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &DNSUpstreamConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkDNSUpstreamConfig = &DNSUpstreamConfigV1Alpha1{}
+	_ config.NamedDocument            = &DNSUpstreamConfigV1Alpha1{}
+	_ config.Validator                = &DNSUpstreamConfigV1Alpha1{}
+)
+
+// DNSUpstreamConfigV1Alpha1 is an encrypted DNS upstream configuration document for the host DNS forwarder.
+//
+//	examples:
+//	  - value: exampleDNSUpstreamConfigV1Alpha1()
+//	alias: DNSUpstreamConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/DNSUpstreamConfig
+type DNSUpstreamConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     URL of the upstream server.
+	//
+	//     Supported schemes are `tls://` (DNS-over-TLS, default port 853) and
+	//     `https://` (DNS-over-HTTPS, default port 443 and path `/dns-query`).
+	//     The host should be an IP address, as the upstream can't be resolved before it is available.
+	//
+	//     If any encrypted upstreams are configured, the host DNS forwarder (`machine.features.hostDNS`) only
+	//     uses them, and the nameservers from the machine configuration and the platform (e.g. DHCP) are ignored.
+	//   examples:
+	//     - value: >
+	//         "tls://1.1.1.1"
+	//     - value: >
+	//         "https://9.9.9.9/dns-query"
+	//   schemaRequired: true
+	UpstreamURL string `yaml:"url"`
+	//   description: |
+	//     The name of the server to verify the upstream certificate against, also sent as SNI.
+	//
+	//     Defaults to the IP address of the upstream.
+	//   examples:
+	//     - value: >
+	//         "cloudflare-dns.com"
+	UpstreamServerName string `yaml:"serverName,omitempty"`
+	//   description: |
+	//     PEM-encoded CA certificates to verify the upstream certificate.
+	//
+	//     If set, only these certificates are trusted, otherwise the system trusted roots are used.
+	UpstreamCACertificates string `yaml:"caCertificates,omitempty"`
+}
+
+// NewDNSUpstreamConfigV1Alpha1 creates a new DNSUpstreamConfig config document.
+func NewDNSUpstreamConfigV1Alpha1() *DNSUpstreamConfigV1Alpha1 {
+	return &DNSUpstreamConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DNSUpstreamConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDNSUpstreamConfigV1Alpha1() *DNSUpstreamConfigV1Alpha1 {
+	cfg := NewDNSUpstreamConfigV1Alpha1()
+	cfg.MetaName = "cloudflare"
+	cfg.UpstreamURL = "tls://1.1.1.1"
+	cfg.UpstreamServerName = "cloudflare-dns.com"
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DNSUpstreamConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DNSUpstreamConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkDNSUpstreamConfigSignal implements config.NetworkDNSUpstreamConfig interface.
+func (s *DNSUpstreamConfigV1Alpha1) NetworkDNSUpstreamConfigSignal() {}
+
+// URL implements config.NetworkDNSUpstreamConfig interface.
+func (s *DNSUpstreamConfigV1Alpha1) URL() string {
+	return s.UpstreamURL
+}
+
+// ServerName implements config.NetworkDNSUpstreamConfig interface.
+func (s *DNSUpstreamConfigV1Alpha1) ServerName() string {
+	return s.UpstreamServerName
+}
+
+// CACertificates implements config.NetworkDNSUpstreamConfig interface.
+func (s *DNSUpstreamConfigV1Alpha1) CACertificates() string {
+	return s.UpstreamCACertificates
+}
+
+// Validate implements config.Validator interface.
+func (s *DNSUpstreamConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if s.UpstreamURL == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("url is required"))
+	} else if u, err := url.Parse(s.UpstreamURL); err != nil {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid url: %w", err))
+	} else {
+		if u.Scheme != "tls" && u.Scheme != "https" {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("unsupported url scheme %q, expected tls or https", u.Scheme))
+		}
+
+		if _, err = netip.ParseAddr(u.Hostname()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("url host %q should be an IP address", u.Hostname()))
+		}
+	}
+
+	if s.UpstreamCACertificates != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(s.UpstreamCACertificates)) {
+		validationErrors = errors.Join(validationErrors, errors.New("caCertificates should contain PEM-encoded certificates"))
+	}
+
+	return nil, validationErrors
+}
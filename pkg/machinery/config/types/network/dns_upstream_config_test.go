@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dnsupstreamconfig.yaml
+var expectedDNSUpstreamConfigDocument []byte
+
+const testCACertificate = `-----BEGIN CERTIFICATE-----
+MIIBgzCCASmgAwIBAgIUYrEIp+RwRycwOIekDV6P4s8xwm8wCgYIKoZIzj0EAwIw
+FjEUMBIGA1UEAwwLVGVzdCBETlMgQ0EwIBcNMjYxMDE2MTU0OTU4WhgPMjEyNjA5
+MjIxNTQ5NThaMBYxFDASBgNVBAMMC1Rlc3QgRE5TIENBMFkwEwYHKoZIzj0CAQYI
+KoZIzj0DAQcDQgAESA5vX0RxBdjW/EvYOMY+Zj73ZYMasbn+ZGfN+wt8esaFFOsd
+XKtrJc4ZnTqskCs7iK4PSy/+K75DQx9YixdF76NTMFEwHQYDVR0OBBYEFFpO3Le4
+JIJxzYDYjYZutc553QngMB8GA1UdIwQYMBaAFFpO3Le4JIJxzYDYjYZutc553Qng
+MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIhANcwDb6HsyBbaXsy
+nnWlLHqRjkYXBQGJt+82gK5eGLGgAiAH+Esr0MSR+SSD3IenB7i20/P/7t9L4ClV
++UTI/WLIgg==
+-----END CERTIFICATE-----
+`
+
+func TestDNSUpstreamConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDNSUpstreamConfigV1Alpha1()
+	cfg.MetaName = "quad9"
+	cfg.UpstreamURL = "https://9.9.9.9/dns-query"
+	cfg.UpstreamServerName = "dns.quad9.net"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDNSUpstreamConfigDocument, marshaled)
+}
+
+func TestDNSUpstreamConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDNSUpstreamConfigDocument)
+	require.NoError(t, err)
+
+	upstreams := provider.NetworkDNSUpstreamConfigs()
+	require.Len(t, upstreams, 1)
+
+	assert.Equal(t, "quad9", upstreams[0].Name())
+	assert.Equal(t, "https://9.9.9.9/dns-query", upstreams[0].URL())
+	assert.Equal(t, "dns.quad9.net", upstreams[0].ServerName())
+	assert.Empty(t, upstreams[0].CACertificates())
+}
+
+func TestDNSUpstreamConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DNSUpstreamConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDNSUpstreamConfigV1Alpha1,
+
+			expectedError: "name is required\nurl is required",
+		},
+		{
+			name: "cleartext",
+			cfg: func() *network.DNSUpstreamConfigV1Alpha1 {
+				cfg := network.NewDNSUpstreamConfigV1Alpha1()
+				cfg.MetaName = "plain"
+				cfg.UpstreamURL = "udp://1.1.1.1:53"
+
+				return cfg
+			},
+
+			expectedError: "unsupported url scheme \"udp\", expected tls or https",
+		},
+		{
+			name: "hostname",
+			cfg: func() *network.DNSUpstreamConfigV1Alpha1 {
+				cfg := network.NewDNSUpstreamConfigV1Alpha1()
+				cfg.MetaName = "hostname"
+				cfg.UpstreamURL = "https://dns.google/dns-query"
+
+				return cfg
+			},
+
+			expectedError: "url host \"dns.google\" should be an IP address",
+		},
+		{
+			name: "invalid ca",
+			cfg: func() *network.DNSUpstreamConfigV1Alpha1 {
+				cfg := network.NewDNSUpstreamConfigV1Alpha1()
+				cfg.MetaName = "ca"
+				cfg.UpstreamURL = "tls://1.1.1.1"
+				cfg.UpstreamCACertificates = "not a certificate"
+
+				return cfg
+			},
+
+			expectedError: "caCertificates should contain PEM-encoded certificates",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DNSUpstreamConfigV1Alpha1 {
+				cfg := network.NewDNSUpstreamConfigV1Alpha1()
+				cfg.MetaName = "internal"
+				cfg.UpstreamURL = "tls://[2001:db8::53]:8853"
+				cfg.UpstreamServerName = "dns.example.com"
+				cfg.UpstreamCACertificates = testCACertificate
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
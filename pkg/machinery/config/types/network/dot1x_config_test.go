@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/dot1xconfig.yaml
+var expectedDot1XConfigDocument []byte
+
+const testDot1XCA = `-----BEGIN CERTIFICATE-----
+MIIBXjCCARCgAwIBAgIBATAFBgMrZXAwLjEQMA4GA1UECgwHRXhhbXBsZTEaMBgG
+A1UEAwwRRXhhbXBsZSBSQURJVVMgQ0EwHhcNMjYxMDE2MTg0NDUwWhcNMzYxMDEz
+MTg0NDUwWjAuMRAwDgYDVQQKDAdFeGFtcGxlMRowGAYDVQQDDBFFeGFtcGxlIFJB
+RElVUyBDQTAqMAUGAytlcAMhAHZ32Alq3O7g2FO/ZVHjPnmG1qedsUfWX7zt+fZu
+85fSo1MwUTAdBgNVHQ4EFgQUBIEv/2lVJ7ePKV/dAxs4Zs3ZvlUwHwYDVR0jBBgw
+FoAUBIEv/2lVJ7ePKV/dAxs4Zs3ZvlUwDwYDVR0TAQH/BAUwAwEB/zAFBgMrZXAD
+QQDj4/FEkSGiJsuJc8aFSURIuLwtWnN/Q7IdfyfI8KlOQAKW5vOFnvByJlL4IsPK
+vedFLl1epCrw7E4EGp1HuVgA
+-----END CERTIFICATE-----
+`
+
+func TestDot1XConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDot1XConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.Dot1XNodeIdentity = true
+	cfg.Dot1XCA = testDot1XCA
+	cfg.Dot1XServerName = "radius.example.com"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDot1XConfigDocument, marshaled)
+}
+
+func TestDot1XConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDot1XConfigDocument)
+	require.NoError(t, err)
+
+	configs := provider.NetworkDot1XConfigs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp1s0", configs[0].Name())
+	assert.True(t, configs[0].NodeIdentity())
+	assert.Empty(t, configs[0].Identity())
+	assert.Empty(t, configs[0].Certificate())
+	assert.Equal(t, testDot1XCA, configs[0].CA())
+	assert.Equal(t, "radius.example.com", configs[0].ServerName())
+}
+
+func TestDot1XConfigRedact(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDot1XConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.Dot1XCertificate = "certificate"
+	cfg.Dot1XKey = "key"
+
+	cfg.Redact("REDACTED")
+
+	assert.Equal(t, "certificate", cfg.Dot1XCertificate)
+	assert.Equal(t, "REDACTED", cfg.Dot1XKey)
+}
+
+func TestDot1XConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.CommonName("node01.example.com"))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.Dot1XConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDot1XConfigV1Alpha1,
+
+			expectedError:    "name is required\neither nodeIdentity or both certificate and key are required",
+			expectedWarnings: []string{"ca is not set, the authentication server certificate is not verified"},
+		},
+		{
+			name: "node identity with certificate",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				cfg := network.NewDot1XConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.Dot1XNodeIdentity = true
+				cfg.Dot1XCertificate = string(ca.CrtPEM)
+				cfg.Dot1XCA = testDot1XCA
+
+				return cfg
+			},
+
+			expectedError: "certificate and key can't be set with nodeIdentity",
+		},
+		{
+			name: "invalid key",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				cfg := network.NewDot1XConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.Dot1XCertificate = string(ca.CrtPEM)
+				cfg.Dot1XKey = "key"
+				cfg.Dot1XCA = "ca"
+
+				return cfg
+			},
+
+			expectedError: "invalid certificate or key: tls: failed to find any PEM data in key input\nno valid certificates found in ca",
+		},
+		{
+			name: "valid certificate",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				cfg := network.NewDot1XConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.Dot1XIdentity = "host/node01.example.com"
+				cfg.Dot1XCertificate = string(ca.CrtPEM)
+				cfg.Dot1XKey = string(ca.KeyPEM)
+				cfg.Dot1XCA = testDot1XCA
+
+				return cfg
+			},
+		},
+		{
+			name: "valid node identity",
+			cfg: func() *network.Dot1XConfigV1Alpha1 {
+				cfg := network.NewDot1XConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.Dot1XNodeIdentity = true
+
+				return cfg
+			},
+
+			expectedWarnings: []string{"ca is not set, the authentication server certificate is not verified"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
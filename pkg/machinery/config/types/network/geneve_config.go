@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// GENEVEConfigKind is a GENEVE config document kind.
+const GENEVEConfigKind = "GENEVEConfig"
+
+// DefaultGENEVEPort is the IANA-assigned GENEVE UDP port.
+const DefaultGENEVEPort = 6081
+
+func init() {
+	registry.Register(GENEVEConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &GENEVEConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkGENEVEConfig = &GENEVEConfigV1Alpha1{}
+	_ config.NamedDocument       = &GENEVEConfigV1Alpha1{}
+	_ config.Validator           = &GENEVEConfigV1Alpha1{}
+)
+
+// GENEVEConfigV1Alpha1 is a GENEVE network link configuration document.
+//
+//	examples:
+//	  - value: exampleGENEVEConfigV1Alpha1()
+//	alias: GENEVEConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/GENEVEConfig
+type GENEVEConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the GENEVE link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "geneve42"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     GENEVE virtual network identifier (VNI).
+	//   examples:
+	//     - value: >
+	//         42
+	//   schemaRequired: true
+	GENEVEVNI uint32 `yaml:"vni"`
+	//   description: |
+	//     Unicast address of the remote endpoint to send the encapsulated packets to.
+	//   examples:
+	//     - value: >
+	//         netip.MustParseAddr("192.168.100.2")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	//   schemaRequired: true
+	GENEVERemote Addr `yaml:"remote"`
+	//   description: |
+	//     Destination UDP port.
+	//
+	//     Defaults to 6081.
+	GENEVEPort uint16 `yaml:"port,omitempty"`
+	//   description: |
+	//     Bring the GENEVE link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the GENEVE link MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewGENEVEConfigV1Alpha1 creates a new GENEVEConfig config document.
+func NewGENEVEConfigV1Alpha1() *GENEVEConfigV1Alpha1 {
+	return &GENEVEConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       GENEVEConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleGENEVEConfigV1Alpha1() *GENEVEConfigV1Alpha1 {
+	cfg := NewGENEVEConfigV1Alpha1()
+	cfg.MetaName = "geneve42"
+	cfg.GENEVEVNI = 42
+	cfg.GENEVERemote = Addr{netip.MustParseAddr("192.168.100.2")}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *GENEVEConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *GENEVEConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkGENEVEConfigSignal implements config.NetworkGENEVEConfig interface.
+func (s *GENEVEConfigV1Alpha1) NetworkGENEVEConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *GENEVEConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.GENEVEVNI == 0 || s.GENEVEVNI > maxVNI {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid VNI %d, should be in range 1-%d", s.GENEVEVNI, maxVNI))
+	}
+
+	switch {
+	case !s.GENEVERemote.IsValid():
+		validationErrors = errors.Join(validationErrors, errors.New("remote address is required"))
+	case s.GENEVERemote.IsMulticast() || s.GENEVERemote.IsUnspecified():
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("remote address %s should be a unicast address", s.GENEVERemote))
+	}
+
+	return nil, validationErrors
+}
+
+// VNI implements config.NetworkGENEVEConfig interface.
+func (s *GENEVEConfigV1Alpha1) VNI() uint32 {
+	return s.GENEVEVNI
+}
+
+// Remote implements config.NetworkGENEVEConfig interface.
+func (s *GENEVEConfigV1Alpha1) Remote() netip.Addr {
+	return s.GENEVERemote.Addr
+}
+
+// Port implements config.NetworkGENEVEConfig interface.
+func (s *GENEVEConfigV1Alpha1) Port() uint16 {
+	if s.GENEVEPort == 0 {
+		return DefaultGENEVEPort
+	}
+
+	return s.GENEVEPort
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *GENEVEConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *GENEVEConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/geneveconfig.yaml
+var expectedGENEVEConfigDocument []byte
+
+func TestGENEVEConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewGENEVEConfigV1Alpha1()
+	cfg.MetaName = "geneve42"
+	cfg.GENEVEVNI = 42
+	cfg.GENEVERemote = network.Addr{Addr: netip.MustParseAddr("2001:db8::2")}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedGENEVEConfigDocument, marshaled)
+}
+
+func TestGENEVEConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedGENEVEConfigDocument)
+	require.NoError(t, err)
+
+	geneves := provider.NetworkGENEVEConfigs()
+	require.Len(t, geneves, 1)
+
+	assert.Equal(t, "geneve42", geneves[0].Name())
+	assert.Equal(t, uint32(42), geneves[0].VNI())
+	assert.Equal(t, netip.MustParseAddr("2001:db8::2"), geneves[0].Remote())
+	assert.Equal(t, uint16(network.DefaultGENEVEPort), geneves[0].Port())
+}
+
+func TestGENEVEConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.GENEVEConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewGENEVEConfigV1Alpha1,
+
+			expectedError: "link name is required\ninvalid VNI 0, should be in range 1-16777215\nremote address is required",
+		},
+		{
+			name: "multicast remote",
+			cfg: func() *network.GENEVEConfigV1Alpha1 {
+				cfg := network.NewGENEVEConfigV1Alpha1()
+				cfg.MetaName = "geneve42"
+				cfg.GENEVEVNI = 42
+				cfg.GENEVERemote = network.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+				return cfg
+			},
+
+			expectedError: "remote address 239.1.1.1 should be a unicast address",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.GENEVEConfigV1Alpha1 {
+				cfg := network.NewGENEVEConfigV1Alpha1()
+				cfg.MetaName = "geneve42"
+				cfg.GENEVEVNI = 42
+				cfg.GENEVERemote = network.Addr{Addr: netip.MustParseAddr("192.168.100.2")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// IPVLANConfigKind is an ipvlan config document kind.
+const IPVLANConfigKind = "IPVLANConfig"
+
+func init() {
+	registry.Register(IPVLANConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &IPVLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkIPVLANConfig = &IPVLANConfigV1Alpha1{}
+	_ config.NamedDocument       = &IPVLANConfigV1Alpha1{}
+	_ config.Validator           = &IPVLANConfigV1Alpha1{}
+)
+
+// IPVLANConfigV1Alpha1 is an ipvlan network link configuration document.
+//
+//	examples:
+//	  - value: exampleIPVLANConfigV1Alpha1()
+//	alias: IPVLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/IPVLANConfig
+type IPVLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the ipvlan link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "ipvlan0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the parent link.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	//   schemaRequired: true
+	IPVLANParent string `yaml:"parent"`
+	//   description: |
+	//     Mode of the ipvlan link.
+	//
+	//     Defaults to `l2`.
+	//   values:
+	//     - "l2"
+	//     - "l3"
+	//     - "l3s"
+	IPVLANModeConfig nethelpers.IPVLANMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     Bring the ipvlan link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the ipvlan link MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewIPVLANConfigV1Alpha1 creates a new IPVLANConfig config document.
+func NewIPVLANConfigV1Alpha1() *IPVLANConfigV1Alpha1 {
+	return &IPVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       IPVLANConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleIPVLANConfigV1Alpha1() *IPVLANConfigV1Alpha1 {
+	cfg := NewIPVLANConfigV1Alpha1()
+	cfg.MetaName = "ipvlan0"
+	cfg.IPVLANParent = "uplink0"
+	cfg.IPVLANModeConfig = nethelpers.IPVLANModeL3
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *IPVLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *IPVLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkIPVLANConfigSignal implements config.NetworkIPVLANConfig interface.
+func (s *IPVLANConfigV1Alpha1) NetworkIPVLANConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *IPVLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.IPVLANParent == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("parent link is required"))
+	}
+
+	switch s.IPVLANModeConfig {
+	case nethelpers.IPVLANModeL2, nethelpers.IPVLANModeL3, nethelpers.IPVLANModeL3S:
+	default:
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid ipvlan mode %s", s.IPVLANModeConfig))
+	}
+
+	return nil, validationErrors
+}
+
+// ParentLink implements config.NetworkIPVLANConfig interface.
+func (s *IPVLANConfigV1Alpha1) ParentLink() string {
+	return s.IPVLANParent
+}
+
+// IPVLANMode implements config.NetworkIPVLANConfig interface.
+func (s *IPVLANConfigV1Alpha1) IPVLANMode() nethelpers.IPVLANMode {
+	return s.IPVLANModeConfig
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *IPVLANConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *IPVLANConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/ipvlanconfig.yaml
+var expectedIPVLANConfigDocument []byte
+
+func TestIPVLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewIPVLANConfigV1Alpha1()
+	cfg.MetaName = "ipvlan0"
+	cfg.IPVLANParent = "uplink0"
+	cfg.IPVLANModeConfig = nethelpers.IPVLANModeL3S
+	cfg.LinkUp = pointer.To(false)
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedIPVLANConfigDocument, marshaled)
+}
+
+func TestIPVLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedIPVLANConfigDocument)
+	require.NoError(t, err)
+
+	ipvlans := provider.NetworkIPVLANConfigs()
+	require.Len(t, ipvlans, 1)
+
+	assert.Equal(t, "ipvlan0", ipvlans[0].Name())
+	assert.Equal(t, "uplink0", ipvlans[0].ParentLink())
+	assert.Equal(t, nethelpers.IPVLANModeL3S, ipvlans[0].IPVLANMode())
+	assert.False(t, ipvlans[0].Up())
+}
+
+func TestIPVLANConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.IPVLANConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewIPVLANConfigV1Alpha1,
+
+			expectedError: "link name is required\nparent link is required",
+		},
+		{
+			name: "invalid mode",
+			cfg: func() *network.IPVLANConfigV1Alpha1 {
+				cfg := network.NewIPVLANConfigV1Alpha1()
+				cfg.MetaName = "ipvlan0"
+				cfg.IPVLANParent = "eth0"
+				cfg.IPVLANModeConfig = 5
+
+				return cfg
+			},
+
+			expectedError: "invalid ipvlan mode IPVLANMode(5)",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.IPVLANConfigV1Alpha1 {
+				cfg := network.NewIPVLANConfigV1Alpha1()
+				cfg.MetaName = "ipvlan0"
+				cfg.IPVLANParent = "eth0"
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,468 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// LinkConfigKind is a link config document kind.
+const LinkConfigKind = "LinkConfig"
+
+func init() {
+	registry.Register(LinkConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LinkConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkLinkConfig = &LinkConfigV1Alpha1{}
+	_ config.NamedDocument     = &LinkConfigV1Alpha1{}
+	_ config.Validator         = &LinkConfigV1Alpha1{}
+)
+
+// LinkConfigV1Alpha1 is a physical network link configuration document.
+//
+//	examples:
+//	  - value: exampleLinkConfigV1Alpha1()
+//	alias: LinkConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LinkConfig
+type LinkConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link.
+	//
+	//     If the `selector` is not set, the name is the name of the link (interface) to configure.
+	//     If the `selector` is set, the name is an alias of the selected link,
+	//     which can be used to refer to the link in other documents (e.g. `BondConfig`, `VLANConfig`, `StaticAddressConfig`).
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	//     - value: >
+	//         "uplink0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Selector picks the link to configure by matching the link status.
+	//
+	//     If multiple links match, the first one (sorted by the link name) is used.
+	LinkSelectorSpec LinkSelector `yaml:"selector,omitempty"`
+	//   description: |
+	//     Bring the link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the link MTU.
+	//
+	//     If not set, the MTU is not changed.
+	//   examples:
+	//     - value: >
+	//         9000
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     Ethtool settings of the link: offloads, ring sizes, channels, link mode and Wake-on-LAN.
+	//
+	//     Settings which are not set are not changed.
+	//     Settings not supported by the link driver are ignored with a warning.
+	LinkEthtool *EthtoolConfig `yaml:"ethtool,omitempty"`
+}
+
+// EthtoolConfig is a set of ethtool settings of the link.
+type EthtoolConfig struct {
+	//   description: |
+	//     Features (offloads) to enable or disable.
+	//
+	//     The feature names are the ones reported by `ethtool --show-features`,
+	//     e.g. `rx-gro`, `tx-generic-segmentation`, `tx-tcp-segmentation`.
+	//   examples:
+	//     - value: >
+	//         map[string]bool{"rx-gro": false, "tx-tcp-segmentation": false}
+	EthtoolFeatures map[string]bool `yaml:"features,omitempty"`
+	//   description: |
+	//     Sizes of the RX/TX rings.
+	EthtoolRings *EthtoolRingsConfig `yaml:"rings,omitempty"`
+	//   description: |
+	//     Number of the channels (queues).
+	EthtoolChannels *EthtoolChannelsConfig `yaml:"channels,omitempty"`
+	//   description: |
+	//     Link speed, duplex and autonegotiation.
+	EthtoolLinkMode *EthtoolLinkModeConfig `yaml:"linkMode,omitempty"`
+	//   description: |
+	//     Wake-on-LAN settings.
+	EthtoolWakeOnLAN *EthtoolWakeOnLANConfig `yaml:"wakeOnLAN,omitempty"`
+}
+
+// EthtoolRingsConfig is a set of ring sizes.
+type EthtoolRingsConfig struct {
+	//   description: |
+	//     Number of descriptors in the RX ring.
+	//   examples:
+	//     - value: >
+	//         4096
+	RingsRX uint32 `yaml:"rx,omitempty"`
+	//   description: |
+	//     Number of descriptors in the TX ring.
+	//   examples:
+	//     - value: >
+	//         4096
+	RingsTX uint32 `yaml:"tx,omitempty"`
+}
+
+// EthtoolChannelsConfig is a set of channel counts.
+type EthtoolChannelsConfig struct {
+	//   description: |
+	//     Number of combined RX/TX channels.
+	//   examples:
+	//     - value: >
+	//         8
+	//   schemaRequired: true
+	ChannelsCombined uint32 `yaml:"combined"`
+}
+
+// EthtoolLinkModeConfig is a set of link mode settings.
+type EthtoolLinkModeConfig struct {
+	//   description: |
+	//     Enable autonegotiation.
+	//
+	//     If the speed is set, only the link modes matching the speed and duplex are advertised.
+	//     Defaults to `true`.
+	LinkModeAutonegotiation *bool `yaml:"autonegotiation,omitempty"`
+	//   description: |
+	//     Link speed in megabits per second.
+	//
+	//     Required if autonegotiation is disabled.
+	//   examples:
+	//     - value: >
+	//         10000
+	LinkModeSpeed uint32 `yaml:"speed,omitempty"`
+	//   description: |
+	//     Link duplex mode, only used with the speed set.
+	//
+	//     Defaults to `Full`.
+	//   values:
+	//     - "Half"
+	//     - "Full"
+	LinkModeDuplex *nethelpers.Duplex `yaml:"duplex,omitempty"`
+}
+
+// EthtoolWakeOnLANConfig is a set of Wake-on-LAN settings.
+type EthtoolWakeOnLANConfig struct {
+	//   description: |
+	//     Wake-on-LAN modes to enable, an empty list disables Wake-on-LAN.
+	//   examples:
+	//     - value: >
+	//         []nethelpers.WOLMode{nethelpers.WOLModeMagic}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       enum:
+	//         - phy
+	//         - unicast
+	//         - multicast
+	//         - broadcast
+	//         - arp
+	//         - magic
+	//         - magicsecure
+	//         - filter
+	WakeOnLANModes []nethelpers.WOLMode `yaml:"modes"`
+}
+
+// LinkSelector selects network links by matching the link status.
+type LinkSelector struct {
+	//   description: |
+	//     The Common Expression Language (CEL) expression to match the link.
+	//
+	//     The expression is evaluated against the link status (see `talosctl get linkstatuses -o yaml`),
+	//     the `mac()` function can be used to format the hardware addresses.
+	//   schema:
+	//     type: string
+	//   examples:
+	//    - value: >
+	//        exampleLinkSelector1()
+	//      name: match links by the kernel driver
+	//    - value: >
+	//        exampleLinkSelector2()
+	//      name: match links by the PCI ID and the permanent MAC address
+	Match cel.Expression `yaml:"match,omitempty"`
+}
+
+// NewLinkConfigV1Alpha1 creates a new LinkConfig config document.
+func NewLinkConfigV1Alpha1() *LinkConfigV1Alpha1 {
+	return &LinkConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LinkConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleLinkConfigV1Alpha1() *LinkConfigV1Alpha1 {
+	cfg := NewLinkConfigV1Alpha1()
+	cfg.MetaName = "uplink0"
+	cfg.LinkSelectorSpec.Match = exampleLinkSelector1()
+	cfg.LinkMTU = 9000
+	cfg.LinkEthtool = &EthtoolConfig{
+		EthtoolFeatures: map[string]bool{
+			"rx-gro": false,
+		},
+		EthtoolRings: &EthtoolRingsConfig{
+			RingsRX: 4096,
+			RingsTX: 4096,
+		},
+	}
+
+	return cfg
+}
+
+func exampleLinkSelector1() cel.Expression {
+	return cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "mlx5_core"`, celenv.LinkLocator()))
+}
+
+func exampleLinkSelector2() cel.Expression {
+	return cel.MustExpression(cel.ParseBooleanExpression(`link.pciid == "8086:1572" && mac(link.permanent_addr) == "3c:fd:fe:12:34:56"`, celenv.LinkLocator()))
+}
+
+// Name implements config.NamedDocument interface.
+func (s *LinkConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *LinkConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkLinkConfigSignal implements config.NetworkLinkConfig interface.
+func (s *LinkConfigV1Alpha1) NetworkLinkConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *LinkConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if s.LinkSelectorSpec.Match.IsZero() {
+		// name is the name of the link
+		if err := validateLinkName(s.MetaName); s.MetaName != "" && err != nil {
+			validationErrors = errors.Join(validationErrors, err)
+		}
+	} else {
+		if err := s.LinkSelectorSpec.Match.ParseBool(celenv.LinkLocator()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("link selector is invalid: %w", err))
+		}
+	}
+
+	if s.LinkEthtool != nil {
+		validationErrors = errors.Join(validationErrors, s.LinkEthtool.validate())
+	}
+
+	return nil, validationErrors
+}
+
+func (s *EthtoolConfig) validate() error {
+	var validationErrors error
+
+	for feature := range s.EthtoolFeatures {
+		if feature == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("ethtool feature name can't be empty"))
+		}
+	}
+
+	if s.EthtoolRings != nil && s.EthtoolRings.RingsRX == 0 && s.EthtoolRings.RingsTX == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("ethtool rings: at least one of rx or tx should be set"))
+	}
+
+	if s.EthtoolChannels != nil && s.EthtoolChannels.ChannelsCombined == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("ethtool channels: combined should be greater than zero"))
+	}
+
+	if s.EthtoolLinkMode != nil {
+		if !s.EthtoolLinkMode.Autonegotiation() && s.EthtoolLinkMode.LinkModeSpeed == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("ethtool link mode: speed is required when autonegotiation is disabled"))
+		}
+
+		if s.EthtoolLinkMode.LinkModeDuplex != nil {
+			if s.EthtoolLinkMode.LinkModeSpeed == 0 {
+				validationErrors = errors.Join(validationErrors, errors.New("ethtool link mode: duplex requires the speed to be set"))
+			}
+
+			switch *s.EthtoolLinkMode.LinkModeDuplex {
+			case nethelpers.Half, nethelpers.Full:
+			default:
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("ethtool link mode: invalid duplex %s", *s.EthtoolLinkMode.LinkModeDuplex))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+// Selector implements config.NetworkLinkConfig interface.
+func (s *LinkConfigV1Alpha1) Selector() optional.Optional[cel.Expression] {
+	return linkSelector(s.LinkSelectorSpec)
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *LinkConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *LinkConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
+
+// Ethtool implements config.NetworkLinkConfig interface.
+func (s *LinkConfigV1Alpha1) Ethtool() optional.Optional[config.NetworkEthtoolConfig] {
+	if s.LinkEthtool == nil {
+		return optional.None[config.NetworkEthtoolConfig]()
+	}
+
+	return optional.Some[config.NetworkEthtoolConfig](s.LinkEthtool)
+}
+
+// Features implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) Features() map[string]bool {
+	return s.EthtoolFeatures
+}
+
+// RXRingSize implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) RXRingSize() uint32 {
+	if s.EthtoolRings == nil {
+		return 0
+	}
+
+	return s.EthtoolRings.RingsRX
+}
+
+// TXRingSize implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) TXRingSize() uint32 {
+	if s.EthtoolRings == nil {
+		return 0
+	}
+
+	return s.EthtoolRings.RingsTX
+}
+
+// CombinedChannels implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) CombinedChannels() uint32 {
+	if s.EthtoolChannels == nil {
+		return 0
+	}
+
+	return s.EthtoolChannels.ChannelsCombined
+}
+
+// LinkMode implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) LinkMode() optional.Optional[config.NetworkEthtoolLinkModeConfig] {
+	if s.EthtoolLinkMode == nil {
+		return optional.None[config.NetworkEthtoolLinkModeConfig]()
+	}
+
+	return optional.Some[config.NetworkEthtoolLinkModeConfig](s.EthtoolLinkMode)
+}
+
+// WakeOnLAN implements config.NetworkEthtoolConfig interface.
+func (s *EthtoolConfig) WakeOnLAN() optional.Optional[nethelpers.WOLModes] {
+	if s.EthtoolWakeOnLAN == nil {
+		return optional.None[nethelpers.WOLModes]()
+	}
+
+	var modes nethelpers.WOLModes
+
+	for _, mode := range s.EthtoolWakeOnLAN.WakeOnLANModes {
+		modes |= nethelpers.WOLModes(mode)
+	}
+
+	return optional.Some(modes)
+}
+
+// Autonegotiation implements config.NetworkEthtoolLinkModeConfig interface.
+func (s *EthtoolLinkModeConfig) Autonegotiation() bool {
+	if s.LinkModeAutonegotiation == nil {
+		return true
+	}
+
+	return *s.LinkModeAutonegotiation
+}
+
+// Speed implements config.NetworkEthtoolLinkModeConfig interface.
+func (s *EthtoolLinkModeConfig) Speed() uint32 {
+	return s.LinkModeSpeed
+}
+
+// Duplex implements config.NetworkEthtoolLinkModeConfig interface.
+func (s *EthtoolLinkModeConfig) Duplex() nethelpers.Duplex {
+	if s.LinkModeDuplex == nil {
+		return nethelpers.Full
+	}
+
+	return *s.LinkModeDuplex
+}
+
+func linkSelector(selector LinkSelector) optional.Optional[cel.Expression] {
+	if selector.Match.IsZero() {
+		return optional.None[cel.Expression]()
+	}
+
+	return optional.Some(selector.Match)
+}
+
+func linkUp(up *bool) bool {
+	if up == nil {
+		return true
+	}
+
+	return *up
+}
+
+func linkMTU(mtu uint32) optional.Optional[uint32] {
+	if mtu == 0 {
+		return optional.None[uint32]()
+	}
+
+	return optional.Some(mtu)
+}
+
+// maxLinkNameLength is the maximum length of the link name (IFNAMSIZ - 1).
+const maxLinkNameLength = 15
+
+func validateLinkName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("link name is required")
+	case len(name) > maxLinkNameLength:
+		return fmt.Errorf("link name %q is too long, maximum length is %d", name, maxLinkNameLength)
+	case name == "." || name == "..", strings.ContainsAny(name, "/: \t\n"):
+		return fmt.Errorf("link name %q is invalid", name)
+	}
+
+	return nil
+}
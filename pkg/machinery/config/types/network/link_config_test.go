@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/linkconfig.yaml
+var expectedLinkConfigDocument []byte
+
+func TestLinkConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewLinkConfigV1Alpha1()
+	cfg.MetaName = "uplink0"
+	cfg.LinkSelectorSpec.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "ixgbe" && mac(link.permanent_addr) == "3c:fd:fe:12:34:56"`, celenv.LinkLocator()))
+	cfg.LinkUp = pointer.To(false)
+	cfg.LinkMTU = 9000
+	cfg.LinkEthtool = &network.EthtoolConfig{
+		EthtoolFeatures: map[string]bool{
+			"rx-gro":              false,
+			"tx-tcp-segmentation": true,
+		},
+		EthtoolRings: &network.EthtoolRingsConfig{
+			RingsRX: 4096,
+			RingsTX: 2048,
+		},
+		EthtoolChannels: &network.EthtoolChannelsConfig{
+			ChannelsCombined: 8,
+		},
+		EthtoolLinkMode: &network.EthtoolLinkModeConfig{
+			LinkModeAutonegotiation: pointer.To(false),
+			LinkModeSpeed:           10000,
+		},
+		EthtoolWakeOnLAN: &network.EthtoolWakeOnLANConfig{
+			WakeOnLANModes: []nethelpers.WOLMode{nethelpers.WOLModeMagic, nethelpers.WOLModeBroadcast},
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLinkConfigDocument, marshaled)
+}
+
+func TestLinkConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedLinkConfigDocument)
+	require.NoError(t, err)
+
+	links := provider.NetworkLinkConfigs()
+	require.Len(t, links, 1)
+
+	assert.Equal(t, "uplink0", links[0].Name())
+	assert.False(t, links[0].Up())
+	assert.Equal(t, uint32(9000), links[0].MTU().ValueOrZero())
+	assert.True(t, links[0].Selector().IsPresent())
+
+	ethtool, ok := links[0].Ethtool().Get()
+	require.True(t, ok)
+
+	assert.Equal(t, map[string]bool{"rx-gro": false, "tx-tcp-segmentation": true}, ethtool.Features())
+	assert.Equal(t, uint32(4096), ethtool.RXRingSize())
+	assert.Equal(t, uint32(2048), ethtool.TXRingSize())
+	assert.Equal(t, uint32(8), ethtool.CombinedChannels())
+
+	linkMode, ok := ethtool.LinkMode().Get()
+	require.True(t, ok)
+
+	assert.False(t, linkMode.Autonegotiation())
+	assert.Equal(t, uint32(10000), linkMode.Speed())
+	assert.Equal(t, nethelpers.Full, linkMode.Duplex())
+
+	assert.Equal(t, nethelpers.WOLModes(nethelpers.WOLModeMagic|nethelpers.WOLModeBroadcast), ethtool.WakeOnLAN().ValueOrZero())
+}
+
+func TestLinkConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.LinkConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewLinkConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "long name",
+			cfg: func() *network.LinkConfigV1Alpha1 {
+				cfg := network.NewLinkConfigV1Alpha1()
+				cfg.MetaName = "enp0s1f1np0vlan1000"
+
+				return cfg
+			},
+
+			expectedError: "link name \"enp0s1f1np0vlan1000\" is too long, maximum length is 15",
+		},
+		{
+			name: "long alias",
+			cfg: func() *network.LinkConfigV1Alpha1 {
+				cfg := network.NewLinkConfigV1Alpha1()
+				cfg.MetaName = "storage-uplink-primary"
+				cfg.LinkSelectorSpec.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "ixgbe"`, celenv.LinkLocator()))
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid ethtool",
+			cfg: func() *network.LinkConfigV1Alpha1 {
+				cfg := network.NewLinkConfigV1Alpha1()
+				cfg.MetaName = "enp0s2"
+				cfg.LinkEthtool = &network.EthtoolConfig{
+					EthtoolRings:    &network.EthtoolRingsConfig{},
+					EthtoolChannels: &network.EthtoolChannelsConfig{},
+					EthtoolLinkMode: &network.EthtoolLinkModeConfig{
+						LinkModeAutonegotiation: pointer.To(false),
+						LinkModeDuplex:          pointer.To(nethelpers.Unknown),
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "ethtool rings: at least one of rx or tx should be set\n" +
+				"ethtool channels: combined should be greater than zero\n" +
+				"ethtool link mode: speed is required when autonegotiation is disabled\n" +
+				"ethtool link mode: duplex requires the speed to be set\n" +
+				"ethtool link mode: invalid duplex Unknown",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.LinkConfigV1Alpha1 {
+				cfg := network.NewLinkConfigV1Alpha1()
+				cfg.MetaName = "enp0s2"
+				cfg.LinkMTU = 1400
+				cfg.LinkEthtool = &network.EthtoolConfig{
+					EthtoolFeatures: map[string]bool{"rx-gro": false},
+					EthtoolLinkMode: &network.EthtoolLinkModeConfig{
+						LinkModeSpeed:  1000,
+						LinkModeDuplex: pointer.To(nethelpers.Half),
+					},
+					EthtoolWakeOnLAN: &network.EthtoolWakeOnLANConfig{},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LLDPConfigKind is a LLDP config document kind.
+const LLDPConfigKind = "LLDPConfig"
+
+func init() {
+	registry.Register(LLDPConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LLDPConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkLLDPConfig = &LLDPConfigV1Alpha1{}
+	_ config.Validator         = &LLDPConfigV1Alpha1{}
+)
+
+// LLDP transmit interval limits (see IEEE 802.1AB msgTxInterval).
+const (
+	minLLDPTransmitInterval = time.Second
+	maxLLDPTransmitInterval = time.Hour
+)
+
+// LLDPConfigV1Alpha1 is a LLDP (Link Layer Discovery Protocol) configuration document.
+//
+//	examples:
+//	  - value: exampleLLDPConfigV1Alpha1()
+//	alias: LLDPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LLDPConfig
+type LLDPConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Transmit LLDP frames on the physical links.
+	//
+	//     LLDP neighbors are always discovered on the physical links, this setting enables advertising the node to the neighbors.
+	//     The frames advertise the link MAC address as the chassis ID, the link name as the port ID,
+	//     the node hostname and the Talos version.
+	LLDPTransmit bool `yaml:"transmit,omitempty"`
+	//   description: |
+	//     Interval between the transmitted LLDP frames.
+	//
+	//     The neighbors expire the advertisement after 4 intervals. Defaults to `30s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	LLDPTransmitInterval time.Duration `yaml:"transmitInterval,omitempty"`
+}
+
+// NewLLDPConfigV1Alpha1 creates a new LLDPConfig config document.
+func NewLLDPConfigV1Alpha1() *LLDPConfigV1Alpha1 {
+	return &LLDPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LLDPConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleLLDPConfigV1Alpha1() *LLDPConfigV1Alpha1 {
+	cfg := NewLLDPConfigV1Alpha1()
+	cfg.LLDPTransmit = true
+	cfg.LLDPTransmitInterval = time.Minute
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *LLDPConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkLLDPConfigSignal implements config.NetworkLLDPConfig interface.
+func (s *LLDPConfigV1Alpha1) NetworkLLDPConfigSignal() {}
+
+// Transmit implements config.NetworkLLDPConfig interface.
+func (s *LLDPConfigV1Alpha1) Transmit() bool {
+	return s.LLDPTransmit
+}
+
+// TransmitInterval implements config.NetworkLLDPConfig interface.
+func (s *LLDPConfigV1Alpha1) TransmitInterval() time.Duration {
+	return s.LLDPTransmitInterval
+}
+
+// Validate implements config.Validator interface.
+func (s *LLDPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.LLDPTransmitInterval != 0 && (s.LLDPTransmitInterval < minLLDPTransmitInterval || s.LLDPTransmitInterval > maxLLDPTransmitInterval) {
+		return nil, fmt.Errorf("transmitInterval %s should be between %s and %s", s.LLDPTransmitInterval, minLLDPTransmitInterval, maxLLDPTransmitInterval)
+	}
+
+	var warnings []string
+
+	if s.LLDPTransmitInterval != 0 && !s.LLDPTransmit {
+		warnings = append(warnings, "transmitInterval has no effect when transmit is disabled")
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/lldpconfig.yaml
+var expectedLLDPConfigDocument []byte
+
+func TestLLDPConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewLLDPConfigV1Alpha1()
+	cfg.LLDPTransmit = true
+	cfg.LLDPTransmitInterval = time.Minute
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLLDPConfigDocument, marshaled)
+}
+
+func TestLLDPConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedLLDPConfigDocument)
+	require.NoError(t, err)
+
+	cfg := provider.NetworkLLDPConfig()
+	require.NotNil(t, cfg)
+
+	assert.True(t, cfg.Transmit())
+	assert.Equal(t, time.Minute, cfg.TransmitInterval())
+}
+
+func TestLLDPConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.LLDPConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewLLDPConfigV1Alpha1,
+		},
+		{
+			name: "out of range",
+			cfg: func() *network.LLDPConfigV1Alpha1 {
+				cfg := network.NewLLDPConfigV1Alpha1()
+				cfg.LLDPTransmit = true
+				cfg.LLDPTransmitInterval = 100 * time.Millisecond
+
+				return cfg
+			},
+
+			expectedError: "transmitInterval 100ms should be between 1s and 1h0m0s",
+		},
+		{
+			name: "interval without transmit",
+			cfg: func() *network.LLDPConfigV1Alpha1 {
+				cfg := network.NewLLDPConfigV1Alpha1()
+				cfg.LLDPTransmitInterval = time.Minute
+
+				return cfg
+			},
+
+			expectedWarnings: []string{"transmitInterval has no effect when transmit is disabled"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// MACVLANConfigKind is a macvlan config document kind.
+const MACVLANConfigKind = "MACVLANConfig"
+
+func init() {
+	registry.Register(MACVLANConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &MACVLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkMACVLANConfig = &MACVLANConfigV1Alpha1{}
+	_ config.NamedDocument        = &MACVLANConfigV1Alpha1{}
+	_ config.Validator            = &MACVLANConfigV1Alpha1{}
+)
+
+// MACVLANConfigV1Alpha1 is a macvlan network link configuration document.
+//
+//	examples:
+//	  - value: exampleMACVLANConfigV1Alpha1()
+//	alias: MACVLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/MACVLANConfig
+type MACVLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the macvlan link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "macvlan0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the parent link.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	//   schemaRequired: true
+	MACVLANParent string `yaml:"parent"`
+	//   description: |
+	//     Mode of the macvlan link, which defines how the traffic between the links sharing the parent is handled.
+	//
+	//     Defaults to `bridge`.
+	//   values:
+	//     - "private"
+	//     - "vepa"
+	//     - "bridge"
+	//     - "passthru"
+	MACVLANModeConfig nethelpers.MACVLANMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     Bring the macvlan link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the macvlan link MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewMACVLANConfigV1Alpha1 creates a new MACVLANConfig config document.
+func NewMACVLANConfigV1Alpha1() *MACVLANConfigV1Alpha1 {
+	return &MACVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       MACVLANConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleMACVLANConfigV1Alpha1() *MACVLANConfigV1Alpha1 {
+	cfg := NewMACVLANConfigV1Alpha1()
+	cfg.MetaName = "macvlan0"
+	cfg.MACVLANParent = "uplink0"
+	cfg.MACVLANModeConfig = nethelpers.MACVLANModeBridge
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *MACVLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *MACVLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkMACVLANConfigSignal implements config.NetworkMACVLANConfig interface.
+func (s *MACVLANConfigV1Alpha1) NetworkMACVLANConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *MACVLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.MACVLANParent == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("parent link is required"))
+	}
+
+	switch s.MACVLANModeConfig {
+	case 0, nethelpers.MACVLANModePrivate, nethelpers.MACVLANModeVEPA, nethelpers.MACVLANModeBridge, nethelpers.MACVLANModePassthru:
+	default:
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid macvlan mode %s", s.MACVLANModeConfig))
+	}
+
+	return nil, validationErrors
+}
+
+// ParentLink implements config.NetworkMACVLANConfig interface.
+func (s *MACVLANConfigV1Alpha1) ParentLink() string {
+	return s.MACVLANParent
+}
+
+// MACVLANMode implements config.NetworkMACVLANConfig interface.
+func (s *MACVLANConfigV1Alpha1) MACVLANMode() nethelpers.MACVLANMode {
+	if s.MACVLANModeConfig == 0 {
+		return nethelpers.MACVLANModeBridge
+	}
+
+	return s.MACVLANModeConfig
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *MACVLANConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *MACVLANConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
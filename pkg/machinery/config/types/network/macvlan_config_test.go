@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/macvlanconfig.yaml
+var expectedMACVLANConfigDocument []byte
+
+func TestMACVLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewMACVLANConfigV1Alpha1()
+	cfg.MetaName = "macvlan0"
+	cfg.MACVLANParent = "uplink0"
+	cfg.MACVLANModeConfig = nethelpers.MACVLANModeVEPA
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedMACVLANConfigDocument, marshaled)
+}
+
+func TestMACVLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedMACVLANConfigDocument)
+	require.NoError(t, err)
+
+	macvlans := provider.NetworkMACVLANConfigs()
+	require.Len(t, macvlans, 1)
+
+	assert.Equal(t, "macvlan0", macvlans[0].Name())
+	assert.Equal(t, "uplink0", macvlans[0].ParentLink())
+	assert.Equal(t, nethelpers.MACVLANModeVEPA, macvlans[0].MACVLANMode())
+}
+
+func TestMACVLANConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.MACVLANConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewMACVLANConfigV1Alpha1,
+
+			expectedError: "link name is required\nparent link is required",
+		},
+		{
+			name: "unsupported mode",
+			cfg: func() *network.MACVLANConfigV1Alpha1 {
+				cfg := network.NewMACVLANConfigV1Alpha1()
+				cfg.MetaName = "macvlan0"
+				cfg.MACVLANParent = "eth0"
+				cfg.MACVLANModeConfig = nethelpers.MACVLANModeSource
+
+				return cfg
+			},
+
+			expectedError: "invalid macvlan mode source",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.MACVLANConfigV1Alpha1 {
+				cfg := network.NewMACVLANConfigV1Alpha1()
+				cfg.MetaName = "macvlan0"
+				cfg.MACVLANParent = "eth0"
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
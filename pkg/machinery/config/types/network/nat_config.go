@@ -0,0 +1,326 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// NATConfigKind is a NAT config document kind.
+const NATConfigKind = "NetworkNATConfig"
+
+func init() {
+	registry.Register(NATConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &NATConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRuleConfigNAT    = &NATConfigV1Alpha1{}
+	_ config.NetworkRuleConfigSignal = &NATConfigV1Alpha1{}
+	_ config.NetworkNATRule          = &NATConfigV1Alpha1{}
+	_ config.NamedDocument           = &NATConfigV1Alpha1{}
+	_ config.Validator               = &NATConfigV1Alpha1{}
+)
+
+// NATConfigV1Alpha1 is a network address translation (NAT) configuration document.
+//
+//	examples:
+//	  - value: exampleNATConfigV1Alpha1()
+//	alias: NetworkNATConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkNATConfig
+type NATConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the link the traffic is received on.
+	//   examples:
+	//     - value: >
+	//         "eth1"
+	NATInLink string `yaml:"inLink,omitempty"`
+	//   description: |
+	//     Name of the link the traffic is sent out through.
+	//
+	//     Not supported with `dnat`.
+	//   examples:
+	//     - value: >
+	//         "eth0"
+	NATOutLink string `yaml:"outLink,omitempty"`
+	//   description: |
+	//     The source prefix to match.
+	//   examples:
+	//     - value: >
+	//         netip.MustParsePrefix("10.10.0.0/24")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	NATSource Prefix `yaml:"source,omitempty"`
+	//   description: |
+	//     The destination prefix to match.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	NATDestination Prefix `yaml:"destination,omitempty"`
+	//   description: |
+	//     Port selector defines which destination ports and protocols are matched.
+	//
+	//     If not set, the rule matches all protocols.
+	NATPortSelector *RulePortSelector `yaml:"portSelector,omitempty"`
+	//   description: |
+	//     Masquerade (source NAT to the address of the outgoing link) the matched traffic.
+	NATMasquerade bool `yaml:"masquerade,omitempty"`
+	//   description: |
+	//     Source NAT the matched traffic to the specified address (and port).
+	NATSNAT *NATTarget `yaml:"snat,omitempty"`
+	//   description: |
+	//     Destination NAT the matched traffic to the specified address (and port).
+	//
+	//     Destination NAT is applied to the traffic received by the host, and it is not applied to the traffic
+	//     originating from the host itself.
+	NATDNAT *NATTarget `yaml:"dnat,omitempty"`
+}
+
+// NATTarget is a NAT translation target.
+type NATTarget struct {
+	//   description: |
+	//     The address to translate to.
+	//   examples:
+	//     - value: >
+	//         netip.MustParseAddr("10.10.0.5")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	//   schemaRequired: true
+	NATAddress Addr `yaml:"address"`
+	//   description: |
+	//     The port to translate to.
+	//
+	//     If not set, the port is not changed.
+	//     The port requires the `portSelector` with the `tcp` or `udp` protocol.
+	NATPort uint16 `yaml:"port,omitempty"`
+}
+
+// NewNATConfigV1Alpha1 creates a new NATConfig config document.
+func NewNATConfigV1Alpha1() *NATConfigV1Alpha1 {
+	return &NATConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       NATConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleNATConfigV1Alpha1() *NATConfigV1Alpha1 {
+	cfg := NewNATConfigV1Alpha1()
+	cfg.MetaName = "masquerade-uplink"
+	cfg.NATOutLink = "eth0"
+	cfg.NATSource = Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+	cfg.NATMasquerade = true
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *NATConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *NATConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
+func (s *NATConfigV1Alpha1) NetworkRuleConfigSignal() {}
+
+// NATRules implements config.NetworkRuleConfigNAT interface.
+func (s *NATConfigV1Alpha1) NATRules() []config.NetworkNATRule {
+	return []config.NetworkNATRule{s}
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo,cyclop
+func (s *NATConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	actions := 0
+
+	if s.NATMasquerade {
+		actions++
+	}
+
+	if s.NATSNAT != nil {
+		actions++
+	}
+
+	if s.NATDNAT != nil {
+		actions++
+	}
+
+	if actions != 1 {
+		validationErrors = errors.Join(validationErrors, errors.New("exactly one of masquerade, snat or dnat should be set"))
+	}
+
+	if s.NATSource.IsValid() && s.NATDestination.IsValid() && s.NATSource.Addr().Is4() != s.NATDestination.Addr().Is4() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("source %s and destination %s address families don't match", s.NATSource, s.NATDestination))
+	}
+
+	if s.NATDNAT != nil && s.NATOutLink != "" {
+		validationErrors = errors.Join(validationErrors, errors.New("dnat doesn't support outLink"))
+	}
+
+	if s.NATPortSelector != nil {
+		if len(s.NATPortSelector.Ports) == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("portSelector.ports is required"))
+		}
+
+		if err := s.NATPortSelector.Ports.Validate(); err != nil {
+			validationErrors = errors.Join(validationErrors, err)
+		}
+	}
+
+	for _, nat := range []struct {
+		label  string
+		target *NATTarget
+	}{
+		{"snat", s.NATSNAT},
+		{"dnat", s.NATDNAT},
+	} {
+		label, target := nat.label, nat.target
+
+		if target == nil {
+			continue
+		}
+
+		if !target.NATAddress.IsValid() {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("%s address is required", label))
+
+			continue
+		}
+
+		for _, prefix := range []Prefix{s.NATSource, s.NATDestination} {
+			if prefix.IsValid() && prefix.Addr().Is4() != target.NATAddress.Is4() {
+				validationErrors = errors.Join(validationErrors,
+					fmt.Errorf("%s address %s and prefix %s address families don't match", label, target.NATAddress, prefix))
+			}
+		}
+
+		if target.NATPort != 0 &&
+			(s.NATPortSelector == nil ||
+				(s.NATPortSelector.Protocol != nethelpers.ProtocolTCP && s.NATPortSelector.Protocol != nethelpers.ProtocolUDP)) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("%s port requires portSelector with tcp or udp protocol", label))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// InputLink implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) InputLink() optional.Optional[string] {
+	if s.NATInLink == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.NATInLink)
+}
+
+// OutputLink implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) OutputLink() optional.Optional[string] {
+	if s.NATOutLink == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.NATOutLink)
+}
+
+// Source implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) Source() optional.Optional[netip.Prefix] {
+	if !s.NATSource.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(s.NATSource.Prefix)
+}
+
+// Destination implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) Destination() optional.Optional[netip.Prefix] {
+	if !s.NATDestination.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(s.NATDestination.Prefix)
+}
+
+// Protocol implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) Protocol() optional.Optional[nethelpers.Protocol] {
+	if s.NATPortSelector == nil {
+		return optional.None[nethelpers.Protocol]()
+	}
+
+	return optional.Some(s.NATPortSelector.Protocol)
+}
+
+// PortRanges implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) PortRanges() [][2]uint16 {
+	if s.NATPortSelector == nil {
+		return nil
+	}
+
+	return xslices.Map(s.NATPortSelector.Ports, func(pr PortRange) [2]uint16 {
+		return [2]uint16{pr.Lo, pr.Hi}
+	})
+}
+
+// Masquerade implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) Masquerade() bool {
+	return s.NATMasquerade
+}
+
+// SNAT implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) SNAT() optional.Optional[netip.AddrPort] {
+	return s.NATSNAT.addrPort()
+}
+
+// DNAT implements config.NetworkNATRule interface.
+func (s *NATConfigV1Alpha1) DNAT() optional.Optional[netip.AddrPort] {
+	return s.NATDNAT.addrPort()
+}
+
+func (t *NATTarget) addrPort() optional.Optional[netip.AddrPort] {
+	if t == nil {
+		return optional.None[netip.AddrPort]()
+	}
+
+	return optional.Some(netip.AddrPortFrom(t.NATAddress.Addr, t.NATPort))
+}
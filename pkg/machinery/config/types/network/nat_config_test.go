@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/networknatconfig.yaml
+var expectedNATConfigDocument []byte
+
+func TestNATConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewNATConfigV1Alpha1()
+	cfg.MetaName = "web"
+	cfg.NATInLink = "eth0"
+	cfg.NATDestination = network.Prefix{netip.MustParsePrefix("203.0.113.10/32")}
+	cfg.NATPortSelector = &network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports: network.PortRanges{
+			{Lo: 443, Hi: 443},
+		},
+	}
+	cfg.NATDNAT = &network.NATTarget{
+		NATAddress: network.Addr{netip.MustParseAddr("10.10.0.5")},
+		NATPort:    8443,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedNATConfigDocument, marshaled)
+}
+
+func TestNATConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedNATConfigDocument)
+	require.NoError(t, err)
+
+	rules := provider.NetworkRules().NATRules()
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, "eth0", rules[0].InputLink().ValueOrZero())
+	assert.False(t, rules[0].OutputLink().IsPresent())
+	assert.False(t, rules[0].Source().IsPresent())
+	assert.Equal(t, netip.MustParsePrefix("203.0.113.10/32"), rules[0].Destination().ValueOrZero())
+	assert.Equal(t, nethelpers.ProtocolTCP, rules[0].Protocol().ValueOrZero())
+	assert.Equal(t, [][2]uint16{{443, 443}}, rules[0].PortRanges())
+	assert.False(t, rules[0].Masquerade())
+	assert.False(t, rules[0].SNAT().IsPresent())
+	assert.Equal(t, netip.MustParseAddrPort("10.10.0.5:8443"), rules[0].DNAT().ValueOrZero())
+}
+
+func TestNATConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.NATConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewNATConfigV1Alpha1,
+
+			expectedError: "name is required\nexactly one of masquerade, snat or dnat should be set",
+		},
+		{
+			name: "multiple actions",
+			cfg: func() *network.NATConfigV1Alpha1 {
+				cfg := network.NewNATConfigV1Alpha1()
+				cfg.MetaName = "nat"
+				cfg.NATMasquerade = true
+				cfg.NATSNAT = &network.NATTarget{
+					NATAddress: network.Addr{netip.MustParseAddr("192.168.1.1")},
+				}
+
+				return cfg
+			},
+
+			expectedError: "exactly one of masquerade, snat or dnat should be set",
+		},
+		{
+			name: "family mismatch",
+			cfg: func() *network.NATConfigV1Alpha1 {
+				cfg := network.NewNATConfigV1Alpha1()
+				cfg.MetaName = "nat"
+				cfg.NATSource = network.Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+				cfg.NATSNAT = &network.NATTarget{
+					NATAddress: network.Addr{netip.MustParseAddr("2001:db8::1")},
+				}
+
+				return cfg
+			},
+
+			expectedError: "snat address 2001:db8::1 and prefix 10.10.0.0/24 address families don't match",
+		},
+		{
+			name: "dnat port without protocol",
+			cfg: func() *network.NATConfigV1Alpha1 {
+				cfg := network.NewNATConfigV1Alpha1()
+				cfg.MetaName = "nat"
+				cfg.NATOutLink = "eth0"
+				cfg.NATDNAT = &network.NATTarget{
+					NATAddress: network.Addr{netip.MustParseAddr("10.10.0.5")},
+					NATPort:    8080,
+				}
+
+				return cfg
+			},
+
+			expectedError: "dnat doesn't support outLink\ndnat port requires portSelector with tcp or udp protocol",
+		},
+		{
+			name: "missing address",
+			cfg: func() *network.NATConfigV1Alpha1 {
+				cfg := network.NewNATConfigV1Alpha1()
+				cfg.MetaName = "nat"
+				cfg.NATSNAT = &network.NATTarget{}
+
+				return cfg
+			},
+
+			expectedError: "snat address is required",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.NATConfigV1Alpha1 {
+				cfg := network.NewNATConfigV1Alpha1()
+				cfg.MetaName = "masquerade"
+				cfg.NATOutLink = "eth0"
+				cfg.NATSource = network.Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+				cfg.NATMasquerade = true
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
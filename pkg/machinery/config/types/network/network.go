@@ -5,6 +5,6 @@
 // Package network provides network machine configuration documents.
 package network
 
-//go:generate docgen -output network_doc.go network.go default_action_config.go kubespan_endpoints.go port_range.go rule_config.go
+//go:generate docgen -output network_doc.go network.go bandwidth.go bond_config.go bridge_config.go default_action_config.go dhcpv4_config.go dhcpv6_config.go dns_cache_config.go dns_forward_zone_config.go dns_static_record_config.go dns_upstream_config.go dot1x_config.go geneve_config.go ipvlan_config.go kubespan_endpoints.go link_config.go lldp_config.go macvlan_config.go port_range.go probe_config.go nat_config.go qdisc_config.go route_config.go routing_rule_config.go rule_config.go sriov_config.go static_address_config.go vlan_config.go vxlan_config.go
 
-//go:generate deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DNSCacheConfigV1Alpha1 -type DNSForwardZoneConfigV1Alpha1 -type DNSStaticRecordConfigV1Alpha1 -type DNSUpstreamConfigV1Alpha1 -type Dot1XConfigV1Alpha1 -type GENEVEConfigV1Alpha1 -type IPVLANConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type MACVLANConfigV1Alpha1 -type NATConfigV1Alpha1 -type ProbeConfigV1Alpha1 -type QdiscConfigV1Alpha1 -type RouteConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -type StaticAddressConfigV1Alpha1 -type VLANConfigV1Alpha1 -type VXLANConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
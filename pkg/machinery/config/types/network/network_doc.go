@@ -10,50 +10,2210 @@ import (
 	"net/netip"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
+func (BondConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BondConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BondConfig is a bond network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BondConfig is a bond network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the bond link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the bond link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "links",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Names of the links to aggregate in the bond.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Names of the links to aggregate in the bond." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "linkSelector",
+				Type:        "LinkSelector",
+				Note:        "",
+				Description: "Selector picks the physical links to aggregate in the bond by matching the link status.\n\nAll matching links are added to the bond (in addition to the `links`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector picks the physical links to aggregate in the bond by matching the link status." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "bondMode",
+				Type:        "BondMode",
+				Note:        "",
+				Description: "Bond mode.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bond mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"balance-rr",
+					"active-backup",
+					"balance-xor",
+					"broadcast",
+					"802.3ad",
+					"balance-tlb",
+					"balance-alb",
+				},
+			},
+			{
+				Name:        "xmitHashPolicy",
+				Type:        "BondXmitHashPolicy",
+				Note:        "",
+				Description: "Transmit hash policy.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Transmit hash policy." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"layer2",
+					"layer3+4",
+					"layer2+3",
+					"encap2+3",
+					"encap3+4",
+				},
+			},
+			{
+				Name:        "lacpRate",
+				Type:        "LACPRate",
+				Note:        "",
+				Description: "LACPDU frames periodic transmission rate (802.3ad mode).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "LACPDU frames periodic transmission rate (802.3ad mode)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"slow",
+					"fast",
+				},
+			},
+			{
+				Name:        "adSelect",
+				Type:        "ADSelect",
+				Note:        "",
+				Description: "Aggregation selection logic (802.3ad mode).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Aggregation selection logic (802.3ad mode)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"stable",
+					"bandwidth",
+					"count",
+				},
+			},
+			{
+				Name:        "miimon",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Link monitoring frequency in milliseconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Link monitoring frequency in milliseconds." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "updelay",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The time, in milliseconds, to wait before enabling a link after a link up event.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time, in milliseconds, to wait before enabling a link after a link up event." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "downdelay",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The time, in milliseconds, to wait before disabling a link after a link down event.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time, in milliseconds, to wait before disabling a link after a link down event." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "minLinks",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The minimum number of active links required for the bond to be considered up.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The minimum number of active links required for the bond to be considered up." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the bond up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the bond up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the bond MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the bond MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleBondConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "bond0")
+	doc.Fields[2].AddExample("", []string{"enp1s0", "enp1s1"})
+	doc.Fields[4].AddExample("", "802.3ad")
+	doc.Fields[5].AddExample("", "layer3+4")
+	doc.Fields[8].AddExample("", 100)
+	doc.Fields[13].AddExample("", 9000)
+
+	return doc
+}
+
+func (BridgeConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BridgeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BridgeConfig is a bridge network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BridgeConfig is a bridge network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the bridge link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the bridge link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "links",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Names of the links to attach to the bridge.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Names of the links to attach to the bridge." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "linkSelector",
+				Type:        "LinkSelector",
+				Note:        "",
+				Description: "Selector picks the physical links to attach to the bridge by matching the link status.\n\nAll matching links are attached to the bridge (in addition to the `links`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector picks the physical links to attach to the bridge by matching the link status." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "stp",
+				Type:        "BridgeSTPConfig",
+				Note:        "",
+				Description: "Spanning Tree Protocol (STP) settings of the bridge.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Spanning Tree Protocol (STP) settings of the bridge." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlan",
+				Type:        "BridgeVLANConfig",
+				Note:        "",
+				Description: "VLAN settings of the bridge.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN settings of the bridge." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the bridge up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the bridge up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the bridge MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the bridge MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleBridgeConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "br0")
+	doc.Fields[2].AddExample("", []string{"enp1s0", "bond0"})
+
+	return doc
+}
+
+func (BridgeSTPConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BridgeSTPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BridgeSTPConfig is a bridge STP configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BridgeSTPConfig is a bridge STP configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BridgeConfigV1Alpha1",
+				FieldName: "stp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable or disable the Spanning Tree Protocol.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable or disable the Spanning Tree Protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (BridgeVLANConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BridgeVLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BridgeVLANConfig is a bridge VLAN configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BridgeVLANConfig is a bridge VLAN configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BridgeConfigV1Alpha1",
+				FieldName: "vlan",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "vlanFiltering",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable or disable the VLAN filtering on the bridge.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable or disable the VLAN filtering on the bridge." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkDefaultActionConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a ingress firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "NetworkDefaultActionConfig is a ingress firewall default action configuration document.",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkDefaultActionConfig is a firewall default action configuration document.",
 		Fields: []encoder.Doc{
-			{}, {
+			{},
+			{
 				Name:        "ingress",
 				Type:        "DefaultAction",
 				Note:        "",
-				Description: "Default action for all not explicitly configured ingress traffic: accept or block.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured ingress traffic: accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
-				Values: []string{
-					"accept",
-					"block",
-				},
+				Description: "Default action for all not explicitly configured ingress traffic: accept or block.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured ingress traffic: accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"accept",
+					"block",
+				},
+			},
+			{
+				Name:        "egress",
+				Type:        "DefaultAction",
+				Note:        "",
+				Description: "Default action for all not explicitly configured egress traffic (originating from the host): accept or block.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured egress traffic (originating from the host): accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"accept",
+					"block",
+				},
+			},
+			{
+				Name:        "forward",
+				Type:        "DefaultAction",
+				Note:        "",
+				Description: "Default action for all not explicitly configured forwarded traffic: accept or block.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured forwarded traffic: accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"accept",
+					"block",
+				},
+			},
+			{
+				Name:        "logDrops",
+				Type:        "bool",
+				Note:        "",
+				Description: "Log the dropped packets (rate-limited) to the machined log stream.\n\nPackets dropped either by the explicit block rules or by the default action are logged.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Log the dropped packets (rate-limited) to the machined log stream." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDefaultActionConfigV1Alpha1())
+
+	return doc
+}
+
+func (DHCPv4ConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv4Config",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv4Config is a DHCPv4 client configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv4Config is a DHCPv4 client configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (or the name of the `LinkConfig` document with the link selector).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (or the name of the `LinkConfig` document with the link selector)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routeMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The priority of all routes received via DHCP.\n\nDefaults to `1024`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The priority of all routes received via DHCP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ignoreHostname",
+				Type:        "bool",
+				Note:        "",
+				Description: "Don't request the hostname from the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Don't request the hostname from the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientIdentifier",
+				Type:        "string",
+				Note:        "",
+				Description: "Client identifier (option 61) sent to the DHCP server.\n\nEither a hex string (e.g. `01:52:54:00:12:34:56`), or `mac` to use the link hardware address\n(with the Ethernet hardware type).\nIf not set, the client identifier is not sent, and the DHCP server identifies the client by the hardware address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client identifier (option 61) sent to the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vendorClass",
+				Type:        "string",
+				Note:        "",
+				Description: "Vendor class identifier (option 60) sent to the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vendor class identifier (option 60) sent to the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "userClass",
+				Type:        "[]string",
+				Note:        "",
+				Description: "User classes (option 77, RFC 3004) sent to the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "User classes (option 77, RFC 3004) sent to the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requestedOptions",
+				Type:        "[]uint8",
+				Note:        "",
+				Description: "Additional options to request from the DHCP server (option codes).\n\nTalos always requests the options it uses: routes, DNS servers, domain search list, NTP servers,\nhostname and MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Additional options to request from the DHCP server (option codes)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the `NetworkProbeConfig` document which gates the routes received via DHCP.\n\nWhen the probe fails, the metric of the routes via the DHCP gateway is raised to `probeFailureMetric`,\nand the metric is restored when the probe recovers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the `NetworkProbeConfig` document which gates the routes received via DHCP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeFailureMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The metric (priority) of the routes received via DHCP to use when the probe fails.\n\nRequired if `probe` is set: the routes go via the DHCP gateway, so the probe bound to the link\nmight not reach its target if the routes were withdrawn.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The metric (priority) of the routes received via DHCP to use when the probe fails." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDHCPv4ConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp1s0")
+	doc.Fields[2].AddExample("", 1024)
+	doc.Fields[4].AddExample("", "mac")
+	doc.Fields[5].AddExample("", "talos")
+	doc.Fields[6].AddExample("", []string{"edge"})
+	doc.Fields[7].AddExample("", []uint8{42, 119})
+	doc.Fields[8].AddExample("", "uplink0-gateway")
+	doc.Fields[9].AddExample("", uint32(4096))
+
+	return doc
+}
+
+func (DHCPv6ConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6Config",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6Config is a DHCPv6 client configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6Config is a DHCPv6 client configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (or the name of the `LinkConfig` document with the link selector).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (or the name of the `LinkConfig` document with the link selector)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routeMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The priority of all routes received via DHCP.\n\nDefaults to `1024`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The priority of all routes received via DHCP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ignoreHostname",
+				Type:        "bool",
+				Note:        "",
+				Description: "Don't use the hostname received from the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Don't use the hostname received from the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "duid",
+				Type:        "string",
+				Note:        "",
+				Description: "Client DUID (hex string).\n\nIf not set, the DUID is generated from the link hardware address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client DUID (hex string)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "prefixDelegation",
+				Type:        "DHCPv6PrefixDelegationConfig",
+				Note:        "",
+				Description: "Request a prefix delegation (IA_PD) from the DHCP server.\n\nThe subnets of the delegated prefix can be assigned to other links,\nor published as Kubernetes node annotations to be used by the CNI.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Request a prefix delegation (IA_PD) from the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDHCPv6ConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp1s0")
+	doc.Fields[2].AddExample("", 1024)
+	doc.Fields[4].AddExample("", "00:03:00:01:52:54:00:12:34:56")
+
+	return doc
+}
+
+func (DHCPv6PrefixDelegationConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6PrefixDelegationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixDelegationConfig is a DHCPv6 prefix delegation configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixDelegationConfig is a DHCPv6 prefix delegation configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6ConfigV1Alpha1",
+				FieldName: "prefixDelegation",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "prefixLength",
+				Type:        "int",
+				Note:        "",
+				Description: "Length of the prefix to request from the DHCP server (a hint, the server might delegate a different prefix).\n\nIf not set, the server picks the prefix length.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Length of the prefix to request from the DHCP server (a hint, the server might delegate a different prefix)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "assignments",
+				Type:        "[]DHCPv6PrefixAssignmentConfig",
+				Note:        "",
+				Description: "Subnets of the delegated prefix to assign.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnets of the delegated prefix to assign." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 56)
+
+	return doc
+}
+
+func (DHCPv6PrefixAssignmentConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6PrefixAssignmentConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixAssignmentConfig is an assignment of the subnet of the delegated prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixAssignmentConfig is an assignment of the subnet of the delegated prefix.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6PrefixDelegationConfig",
+				FieldName: "assignments",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Link (or the name of the `LinkConfig` document with the link selector) to assign the first address of the subnet to.\n\nMutually exclusive with `nodeAnnotation`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Link (or the name of the `LinkConfig` document with the link selector) to assign the first address of the subnet to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodeAnnotation",
+				Type:        "string",
+				Note:        "",
+				Description: "Kubernetes node annotation to publish the subnet in, e.g. to hand the subnet to the CNI as the pod CIDR.\n\nMutually exclusive with `link`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Kubernetes node annotation to publish the subnet in, e.g. to hand the subnet to the CNI as the pod CIDR." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subnetID",
+				Type:        "uint64",
+				Note:        "",
+				Description: "Index of the subnet within the delegated prefix.\n\nE.g. with the delegated prefix `2001:db8:0:100::/56` and the subnet length `64`,\nthe subnet ID `1` selects `2001:db8:0:101::/64`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the subnet within the delegated prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "prefixLength",
+				Type:        "int",
+				Note:        "",
+				Description: "Length of the subnet.\n\nDefaults to `64`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Length of the subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "enp2s0")
+	doc.Fields[1].AddExample("", "network.cilium.io/ipv6-pod-cidr")
+	doc.Fields[2].AddExample("", 1)
+	doc.Fields[3].AddExample("", 64)
+
+	return doc
+}
+
+func (DNSCacheConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DNSCacheConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DNSCacheConfig is a host DNS server cache configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DNSCacheConfig is a host DNS server cache configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "minTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Minimum TTL of the cached positive responses.\n\nResponses with lower TTL are cached for this duration. Defaults to `5s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Minimum TTL of the cached positive responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum TTL of the cached positive responses.\n\nResponses with higher TTL are cached for this duration. Defaults to `1h`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum TTL of the cached positive responses." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "negativeTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum TTL of the cached negative responses (NXDOMAIN, NODATA).\n\nDefaults to `10s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum TTL of the cached negative responses (NXDOMAIN, NODATA)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDNSCacheConfigV1Alpha1())
+
+	return doc
+}
+
+func (DNSForwardZoneConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DNSForwardZoneConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DNSForwardZoneConfig is a conditional forwarding configuration document for the host DNS server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DNSForwardZoneConfig is a conditional forwarding configuration document for the host DNS server.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Domain name of the zone.\n\nRequests for the domain and all its subdomains are forwarded to the zone servers\ninstead of the default upstreams. The most specific zone wins.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Domain name of the zone." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "servers",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of DNS servers to forward the zone requests to.\n\nThe servers are specified as IP addresses with an optional port (defaults to 53).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of DNS servers to forward the zone requests to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDNSForwardZoneConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "corp.example")
+	doc.Fields[2].AddExample("", []string{"10.0.0.53", "[2001:db8::53]:5353"})
+
+	return doc
+}
+
+func (DNSStaticRecordConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DNSStaticRecordConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DNSStaticRecordConfig is a static DNS record configuration document for the host DNS server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DNSStaticRecordConfig is a static DNS record configuration document for the host DNS server.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Fully qualified name of the record.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fully qualified name of the record." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "addresses",
+				Type:        "[]Addr",
+				Note:        "",
+				Description: "List of IP addresses the name resolves to (A and AAAA records).\n\nMutually exclusive with `cname`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of IP addresses the name resolves to (A and AAAA records)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cname",
+				Type:        "string",
+				Note:        "",
+				Description: "Canonical name the name is an alias for (CNAME record).\n\nMutually exclusive with `addresses`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Canonical name the name is an alias for (CNAME record)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ttl",
+				Type:        "Duration",
+				Note:        "",
+				Description: "TTL of the record.\n\nDefaults to `1m`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TTL of the record." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDNSStaticRecordConfigV1Alpha1())
+
+	doc.AddExample("", exampleDNSStaticRecordConfigV1Alpha1CNAME())
+
+	doc.Fields[1].AddExample("", "registry.corp.example")
+	doc.Fields[2].AddExample("", []string{"10.0.0.10", "2001:db8::10"})
+	doc.Fields[3].AddExample("", "registry-1.corp.example")
+
+	return doc
+}
+
+func (DNSUpstreamConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DNSUpstreamConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DNSUpstreamConfig is an encrypted DNS upstream configuration document for the host DNS forwarder." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DNSUpstreamConfig is an encrypted DNS upstream configuration document for the host DNS forwarder.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "url",
+				Type:        "string",
+				Note:        "",
+				Description: "URL of the upstream server.\n\nSupported schemes are `tls://` (DNS-over-TLS, default port 853) and\n`https://` (DNS-over-HTTPS, default port 443 and path `/dns-query`).\nThe host should be an IP address, as the upstream can't be resolved before it is available.\n\nIf any encrypted upstreams are configured, the host DNS forwarder (`machine.features.hostDNS`) only\nuses them, and the nameservers from the machine configuration and the platform (e.g. DHCP) are ignored.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL of the upstream server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serverName",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the server to verify the upstream certificate against, also sent as SNI.\n\nDefaults to the IP address of the upstream.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the server to verify the upstream certificate against, also sent as SNI." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "caCertificates",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded CA certificates to verify the upstream certificate.\n\nIf set, only these certificates are trusted, otherwise the system trusted roots are used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded CA certificates to verify the upstream certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDNSUpstreamConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", "tls://1.1.1.1")
+	doc.Fields[2].AddExample("", "https://9.9.9.9/dns-query")
+	doc.Fields[3].AddExample("", "cloudflare-dns.com")
+
+	return doc
+}
+
+func (Dot1XConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "Dot1XConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "Dot1XConfig is a 802.1X (EAP-TLS) port authentication configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "Dot1XConfig is a 802.1X (EAP-TLS) port authentication configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (or the name of the `LinkConfig` document with the link selector).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (or the name of the `LinkConfig` document with the link selector)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "identity",
+				Type:        "string",
+				Note:        "",
+				Description: "EAP identity sent to the authenticator.\n\nDefaults to the common name of the client certificate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "EAP identity sent to the authenticator." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodeIdentity",
+				Type:        "bool",
+				Note:        "",
+				Description: "Use the Talos API certificate of the node (issued by the Talos machine CA) as the client certificate.\n\nOn worker nodes the certificate is issued by the control plane, so the control plane should be reachable\nbefore the link is authenticated.\nMutually exclusive with `certificate` and `key`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Use the Talos API certificate of the node (issued by the Talos machine CA) as the client certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "certificate",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded client certificate (and optionally the intermediate certificates).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded client certificate (and optionally the intermediate certificates)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "key",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded private key of the client certificate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded private key of the client certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "string",
+				Note:        "",
+				Description: "PEM-encoded CA certificates to verify the authentication server certificate.\n\nIf not set, the authentication server certificate is not verified.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PEM-encoded CA certificates to verify the authentication server certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serverName",
+				Type:        "string",
+				Note:        "",
+				Description: "Expected name in the authentication server certificate.\n\nIf not set, the name is not verified.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Expected name in the authentication server certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDot1XConfigV1Alpha1())
+
+	doc.AddExample("", exampleDot1XConfigV1Alpha1Certificate())
+
+	doc.Fields[1].AddExample("", "enp1s0")
+	doc.Fields[2].AddExample("", "host/node01.example.com")
+	doc.Fields[7].AddExample("", "radius.example.com")
+
+	return doc
+}
+
+func (GENEVEConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "GENEVEConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "GENEVEConfig is a GENEVE network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "GENEVEConfig is a GENEVE network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the GENEVE link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the GENEVE link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vni",
+				Type:        "uint32",
+				Note:        "",
+				Description: "GENEVE virtual network identifier (VNI).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "GENEVE virtual network identifier (VNI)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "remote",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Unicast address of the remote endpoint to send the encapsulated packets to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Unicast address of the remote endpoint to send the encapsulated packets to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Destination UDP port.\n\nDefaults to 6081.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination UDP port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the GENEVE link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the GENEVE link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the GENEVE link MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the GENEVE link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleGENEVEConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "geneve42")
+	doc.Fields[2].AddExample("", 42)
+	doc.Fields[3].AddExample("", netip.MustParseAddr("192.168.100.2"))
+
+	return doc
+}
+
+func (IPVLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "IPVLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "IPVLANConfig is an ipvlan network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "IPVLANConfig is an ipvlan network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the ipvlan link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the ipvlan link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the parent link.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the parent link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mode",
+				Type:        "IPVLANMode",
+				Note:        "",
+				Description: "Mode of the ipvlan link.\n\nDefaults to `l2`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mode of the ipvlan link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"l2",
+					"l3",
+					"l3s",
+				},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the ipvlan link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the ipvlan link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the ipvlan link MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the ipvlan link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleIPVLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "ipvlan0")
+	doc.Fields[2].AddExample("", "enp0s2")
+
+	return doc
+}
+
+func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeSpanEndpointsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpanEndpointsConfig is a config document to configure KubeSpan endpoints." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeSpanEndpointsConfig is a config document to configure KubeSpan endpoints.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "extraAnnouncedEndpoints",
+				Type:        "[]AddrPort",
+				Note:        "",
+				Description: "A list of extra Wireguard endpoints to announce from this machine.\n\nTalos automatically adds endpoints based on machine addresses, public IP, etc.\nThis field allows to add extra endpoints which are managed outside of Talos, e.g. NAT mapping.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "A list of extra Wireguard endpoints to announce from this machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleKubespanEndpointsV1Alpha1())
+
+	return doc
+}
+
+func (LinkConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LinkConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkConfig is a physical network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LinkConfig is a physical network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link.\n\nIf the `selector` is not set, the name is the name of the link (interface) to configure.\nIf the `selector` is set, the name is an alias of the selected link,\nwhich can be used to refer to the link in other documents (e.g. `BondConfig`, `VLANConfig`, `StaticAddressConfig`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "selector",
+				Type:        "LinkSelector",
+				Note:        "",
+				Description: "Selector picks the link to configure by matching the link status.\n\nIf multiple links match, the first one (sorted by the link name) is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector picks the link to configure by matching the link status." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the link MTU.\n\nIf not set, the MTU is not changed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ethtool",
+				Type:        "EthtoolConfig",
+				Note:        "",
+				Description: "Ethtool settings of the link: offloads, ring sizes, channels, link mode and Wake-on-LAN.\n\nSettings which are not set are not changed.\nSettings not supported by the link driver are ignored with a warning.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Ethtool settings of the link: offloads, ring sizes, channels, link mode and Wake-on-LAN." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLinkConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp0s2")
+	doc.Fields[1].AddExample("", "uplink0")
+	doc.Fields[4].AddExample("", 9000)
+
+	return doc
+}
+
+func (EthtoolConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthtoolConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthtoolConfig is a set of ethtool settings of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthtoolConfig is a set of ethtool settings of the link.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "LinkConfigV1Alpha1",
+				FieldName: "ethtool",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "features",
+				Type:        "map[string]bool",
+				Note:        "",
+				Description: "Features (offloads) to enable or disable.\n\nThe feature names are the ones reported by `ethtool --show-features`,\ne.g. `rx-gro`, `tx-generic-segmentation`, `tx-tcp-segmentation`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Features (offloads) to enable or disable." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rings",
+				Type:        "EthtoolRingsConfig",
+				Note:        "",
+				Description: "Sizes of the RX/TX rings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Sizes of the RX/TX rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "channels",
+				Type:        "EthtoolChannelsConfig",
+				Note:        "",
+				Description: "Number of the channels (queues).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the channels (queues)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "linkMode",
+				Type:        "EthtoolLinkModeConfig",
+				Note:        "",
+				Description: "Link speed, duplex and autonegotiation.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Link speed, duplex and autonegotiation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "wakeOnLAN",
+				Type:        "EthtoolWakeOnLANConfig",
+				Note:        "",
+				Description: "Wake-on-LAN settings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Wake-on-LAN settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", map[string]bool{"rx-gro": false, "tx-tcp-segmentation": false})
+
+	return doc
+}
+
+func (EthtoolRingsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthtoolRingsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthtoolRingsConfig is a set of ring sizes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthtoolRingsConfig is a set of ring sizes.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthtoolConfig",
+				FieldName: "rings",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of descriptors in the RX ring.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of descriptors in the RX ring." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of descriptors in the TX ring.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of descriptors in the TX ring." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 4096)
+	doc.Fields[1].AddExample("", 4096)
+
+	return doc
+}
+
+func (EthtoolChannelsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthtoolChannelsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthtoolChannelsConfig is a set of channel counts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthtoolChannelsConfig is a set of channel counts.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthtoolConfig",
+				FieldName: "channels",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "combined",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of combined RX/TX channels.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of combined RX/TX channels." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 8)
+
+	return doc
+}
+
+func (EthtoolLinkModeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthtoolLinkModeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthtoolLinkModeConfig is a set of link mode settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthtoolLinkModeConfig is a set of link mode settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthtoolConfig",
+				FieldName: "linkMode",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "autonegotiation",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable autonegotiation.\n\nIf the speed is set, only the link modes matching the speed and duplex are advertised.\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable autonegotiation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "speed",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Link speed in megabits per second.\n\nRequired if autonegotiation is disabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Link speed in megabits per second." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "duplex",
+				Type:        "Duplex",
+				Note:        "",
+				Description: "Link duplex mode, only used with the speed set.\n\nDefaults to `Full`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Link duplex mode, only used with the speed set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"Half",
+					"Full",
+				},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", 10000)
+
+	return doc
+}
+
+func (EthtoolWakeOnLANConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthtoolWakeOnLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthtoolWakeOnLANConfig is a set of Wake-on-LAN settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthtoolWakeOnLANConfig is a set of Wake-on-LAN settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthtoolConfig",
+				FieldName: "wakeOnLAN",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "modes",
+				Type:        "[]WOLMode",
+				Note:        "",
+				Description: "Wake-on-LAN modes to enable, an empty list disables Wake-on-LAN.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Wake-on-LAN modes to enable, an empty list disables Wake-on-LAN." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", []nethelpers.WOLMode{nethelpers.WOLModeMagic})
+
+	return doc
+}
+
+func (LinkSelector) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LinkSelector",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkSelector selects network links by matching the link status." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LinkSelector selects network links by matching the link status.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BondConfigV1Alpha1",
+				FieldName: "linkSelector",
+			},
+			{
+				TypeName:  "BridgeConfigV1Alpha1",
+				FieldName: "linkSelector",
+			},
+			{
+				TypeName:  "LinkConfigV1Alpha1",
+				FieldName: "selector",
+			},
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "selector",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "match",
+				Type:        "Expression",
+				Note:        "",
+				Description: "The Common Expression Language (CEL) expression to match the link.\n\nThe expression is evaluated against the link status (see `talosctl get linkstatuses -o yaml`),\nthe `mac()` function can be used to format the hardware addresses.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The Common Expression Language (CEL) expression to match the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("match links by the kernel driver", exampleLinkSelector1())
+	doc.Fields[0].AddExample("match links by the PCI ID and the permanent MAC address", exampleLinkSelector2())
+
+	return doc
+}
+
+func (LLDPConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LLDPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LLDPConfig is a LLDP (Link Layer Discovery Protocol) configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LLDPConfig is a LLDP (Link Layer Discovery Protocol) configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "transmit",
+				Type:        "bool",
+				Note:        "",
+				Description: "Transmit LLDP frames on the physical links.\n\nLLDP neighbors are always discovered on the physical links, this setting enables advertising the node to the neighbors.\nThe frames advertise the link MAC address as the chassis ID, the link name as the port ID,\nthe node hostname and the Talos version.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Transmit LLDP frames on the physical links." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "transmitInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the transmitted LLDP frames.\n\nThe neighbors expire the advertisement after 4 intervals. Defaults to `30s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the transmitted LLDP frames." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLLDPConfigV1Alpha1())
+
+	return doc
+}
+
+func (MACVLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MACVLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MACVLANConfig is a macvlan network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MACVLANConfig is a macvlan network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the macvlan link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the macvlan link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the parent link.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the parent link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mode",
+				Type:        "MACVLANMode",
+				Note:        "",
+				Description: "Mode of the macvlan link, which defines how the traffic between the links sharing the parent is handled.\n\nDefaults to `bridge`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mode of the macvlan link, which defines how the traffic between the links sharing the parent is handled." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"private",
+					"vepa",
+					"bridge",
+					"passthru",
+				},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the macvlan link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the macvlan link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the macvlan link MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the macvlan link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleMACVLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "macvlan0")
+	doc.Fields[2].AddExample("", "enp0s2")
+
+	return doc
+}
+
+func (ProbeConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkProbeConfig is a network probe configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkProbeConfig is a network probe configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe.\n\nThe name is used as the ID of the `ProbeStatus` resource.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the probes.\n\nDefaults to `1s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the probes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "failureThreshold",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of consecutive failures for the probe to be considered failed after having succeeded.\n\nDefaults to `3`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of consecutive failures for the probe to be considered failed after having succeeded." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tcp",
+				Type:        "TCPProbeConfig",
+				Note:        "",
+				Description: "TCP connection probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP connection probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "icmp",
+				Type:        "ICMPProbeConfig",
+				Note:        "",
+				Description: "ICMP echo probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ICMP echo probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "http",
+				Type:        "HTTPProbeConfig",
+				Note:        "",
+				Description: "HTTP(S) GET probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "HTTP(S) GET probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "dns",
+				Type:        "DNSProbeConfig",
+				Note:        "",
+				Description: "DNS query probe.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "DNS query probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (or the name of the `LinkConfig` document with the link selector) to send the probe through.\n\nThe probe socket is bound to the link, so that each uplink can be probed independently of the current default route.\nIf the probe gates a route on the same link, use `probeFailureMetric` on the route instead of withdrawing it,\nas the probe needs a route via the link to recover.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (or the name of the `LinkConfig` document with the link selector) to send the probe through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleProbeConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "uplink-gateway")
+	doc.Fields[8].AddExample("", "enp1s0")
+
+	return doc
+}
+
+func (TCPProbeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TCPProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TCPProbeConfig is a TCP connection probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TCPProbeConfig is a TCP connection probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "tcp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "Endpoint to connect to: `host:port`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Endpoint to connect to: `host:port`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the connection.\n\nDefaults to `1s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the connection." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "10.0.0.1:443")
+
+	return doc
+}
+
+func (ICMPProbeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ICMPProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ICMPProbeConfig is an ICMP echo probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ICMPProbeConfig is an ICMP echo probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "icmp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Address to send the ICMP echo request to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to send the ICMP echo request to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout waiting for the ICMP echo reply.\n\nDefaults to `1s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout waiting for the ICMP echo reply." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParseAddr("192.168.1.1"))
+
+	return doc
+}
+
+func (HTTPProbeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HTTPProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HTTPProbeConfig is an HTTP(S) GET probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HTTPProbeConfig is an HTTP(S) GET probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "http",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "url",
+				Type:        "string",
+				Note:        "",
+				Description: "URL to send the GET request to.\n\nBoth `http` and `https` schemes are supported.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL to send the GET request to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "expectedStatusCode",
+				Type:        "int",
+				Note:        "",
+				Description: "Expected HTTP status code of the response.\n\nDefaults to `200`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Expected HTTP status code of the response." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the request.\n\nDefaults to `1s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the request." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "https://example.com/healthz")
+
+	return doc
+}
+
+func (DNSProbeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DNSProbeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DNSProbeConfig is a DNS query probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DNSProbeConfig is a DNS query probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ProbeConfigV1Alpha1",
+				FieldName: "dns",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "server",
+				Type:        "string",
+				Note:        "",
+				Description: "DNS server to send the query to: `host` or `host:port`.\n\nThe port defaults to `53`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "DNS server to send the query to: `host` or `host:port`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "query",
+				Type:        "string",
+				Note:        "",
+				Description: "Name to query (`A` record).\n\nThe probe succeeds if the server responds without an error.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name to query (`A` record)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the query.\n\nDefaults to `1s`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the query." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "1.1.1.1")
+	doc.Fields[1].AddExample("", "example.com")
+
+	return doc
+}
+
+func (NATConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkNATConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkNATConfig is a network address translation (NAT) configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkNATConfig is a network address translation (NAT) configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "inLink",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link the traffic is received on.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link the traffic is received on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "outLink",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link the traffic is sent out through.\n\nNot supported with `dnat`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link the traffic is sent out through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "source",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "The source prefix to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The source prefix to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "destination",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "The destination prefix to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The destination prefix to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "portSelector",
+				Type:        "RulePortSelector",
+				Note:        "",
+				Description: "Port selector defines which destination ports and protocols are matched.\n\nIf not set, the rule matches all protocols.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which destination ports and protocols are matched." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "masquerade",
+				Type:        "bool",
+				Note:        "",
+				Description: "Masquerade (source NAT to the address of the outgoing link) the matched traffic.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Masquerade (source NAT to the address of the outgoing link) the matched traffic." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "snat",
+				Type:        "NATTarget",
+				Note:        "",
+				Description: "Source NAT the matched traffic to the specified address (and port).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Source NAT the matched traffic to the specified address (and port)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "dnat",
+				Type:        "NATTarget",
+				Note:        "",
+				Description: "Destination NAT the matched traffic to the specified address (and port).\n\nDestination NAT is applied to the traffic received by the host, and it is not applied to the traffic\noriginating from the host itself.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination NAT the matched traffic to the specified address (and port)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleNATConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", "eth1")
+	doc.Fields[3].AddExample("", "eth0")
+	doc.Fields[4].AddExample("", netip.MustParsePrefix("10.10.0.0/24"))
+
+	return doc
+}
+
+func (NATTarget) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NATTarget",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NATTarget is a NAT translation target." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NATTarget is a NAT translation target.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NATConfigV1Alpha1",
+				FieldName: "snat",
+			},
+			{
+				TypeName:  "NATConfigV1Alpha1",
+				FieldName: "dnat",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "Addr",
+				Note:        "",
+				Description: "The address to translate to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The address to translate to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "The port to translate to.\n\nIf not set, the port is not changed.\nThe port requires the `portSelector` with the `tcp` or `udp` protocol.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The port to translate to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParseAddr("10.10.0.5"))
+
+	return doc
+}
+
+func (QdiscConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "QdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "QdiscConfig is a root queueing discipline (qdisc) configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "QdiscConfig is a root queueing discipline (qdisc) configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (or the name of the `LinkConfig` document with the link selector).\n\nThe qdisc is attached as the root (egress) qdisc of the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (or the name of the `LinkConfig` document with the link selector)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fq",
+				Type:        "FQQdiscConfig",
+				Note:        "",
+				Description: "Fair Queue (fq) qdisc: per-flow pacing and fairness.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fair Queue (fq) qdisc: per-flow pacing and fairness." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fqCodel",
+				Type:        "FQCodelQdiscConfig",
+				Note:        "",
+				Description: "Fair Queuing Controlled Delay (fq_codel) qdisc: per-flow fairness with active queue management.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fair Queuing Controlled Delay (fq_codel) qdisc: per-flow fairness with active queue management." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cake",
+				Type:        "CAKEQdiscConfig",
+				Note:        "",
+				Description: "Common Applications Kept Enhanced (cake) qdisc: shaper with active queue management and flow isolation.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Common Applications Kept Enhanced (cake) qdisc: shaper with active queue management and flow isolation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tbf",
+				Type:        "TBFQdiscConfig",
+				Note:        "",
+				Description: "Token Bucket Filter (tbf) qdisc: limits the egress rate of the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Token Bucket Filter (tbf) qdisc: limits the egress rate of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "htb",
+				Type:        "HTBQdiscConfig",
+				Note:        "",
+				Description: "Hierarchy Token Bucket (htb) qdisc: splits the egress rate of the link between the classes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hierarchy Token Bucket (htb) qdisc: splits the egress rate of the link between the classes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "netem",
+				Type:        "NetemQdiscConfig",
+				Note:        "",
+				Description: "Network emulator (netem) qdisc: adds delay, packet loss, duplication, corruption and reordering.\n\nShould only be used in test environments.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Network emulator (netem) qdisc: adds delay, packet loss, duplication, corruption and reordering." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleQdiscConfigV1Alpha1())
+
+	doc.AddExample("", exampleQdiscConfigV1Alpha1Netem())
+
+	doc.Fields[1].AddExample("", "enp1s0")
+
+	return doc
+}
+
+func (FQQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "FQQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "FQQdiscConfig is a Fair Queue qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "FQQdiscConfig is a Fair Queue qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "fq",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "flowLimit",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum number of packets queued per flow.\n\nDefaults to `100`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of packets queued per flow." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxRate",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Maximum sending rate of a single flow.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.\nDefaults to unlimited.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum sending rate of a single flow." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", "1Gbit")
+
+	return doc
+}
+
+func (FQCodelQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "FQCodelQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "FQCodelQdiscConfig is a Fair Queuing Controlled Delay qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "FQCodelQdiscConfig is a Fair Queuing Controlled Delay qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "fqCodel",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "limit",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum number of packets in the queue.\n\nDefaults to `10240`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of packets in the queue." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "flows",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of flow buckets.\n\nDefaults to `1024`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of flow buckets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "target",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Acceptable minimum standing queue delay.\n\nDefaults to `5ms`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Acceptable minimum standing queue delay." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "interval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval to measure the minimum delay, should be set to the worst-case RTT.\n\nDefaults to `100ms`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval to measure the minimum delay, should be set to the worst-case RTT." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ecn",
+				Type:        "bool",
+				Note:        "",
+				Description: "Mark the packets with ECN instead of dropping them.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mark the packets with ECN instead of dropping them." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (CAKEQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CAKEQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CAKEQdiscConfig is a Common Applications Kept Enhanced qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CAKEQdiscConfig is a Common Applications Kept Enhanced qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "cake",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "bandwidth",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Bandwidth to shape the egress traffic to.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.\nDefaults to unlimited.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bandwidth to shape the egress traffic to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rtt",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Expected round-trip time of the traffic.\n\nDefaults to `100ms`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Expected round-trip time of the traffic." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "500Mbit")
+
+	return doc
+}
+
+func (TBFQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TBFQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TBFQdiscConfig is a Token Bucket Filter qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TBFQdiscConfig is a Token Bucket Filter qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "tbf",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rate",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Egress rate limit.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Egress rate limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "burst",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Size of the bucket in bytes, the maximum amount of data sent at once at the link speed.\n\nDefaults to the amount of data sent at the rate in 10ms (but at least 16KiB).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Size of the bucket in bytes, the maximum amount of data sent at once at the link speed." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "latency",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum time a packet can wait in the queue.\n\nDefaults to `50ms`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum time a packet can wait in the queue." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "100Mbit")
+
+	return doc
+}
+
+func (HTBQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HTBQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HTBQdiscConfig is a Hierarchy Token Bucket qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HTBQdiscConfig is a Hierarchy Token Bucket qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "htb",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rate",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Total egress rate shared by the classes.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Total egress rate shared by the classes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "defaultClass",
+				Type:        "uint16",
+				Note:        "",
+				Description: "ID of the class for the traffic which doesn't match any class.\n\nIf not set, the traffic which doesn't match any class is not shaped.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the class for the traffic which doesn't match any class." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "classes",
+				Type:        "[]HTBClassConfig",
+				Note:        "",
+				Description: "List of the classes.\n\nEach class gets a `fq_codel` qdisc attached.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the classes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "10Gbit")
+
+	return doc
+}
+
+func (HTBClassConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HTBClassConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HTBClassConfig is a single HTB class configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HTBClassConfig is a single HTB class configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "HTBQdiscConfig",
+				FieldName: "classes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "id",
+				Type:        "uint16",
+				Note:        "",
+				Description: "ID of the class (the minor part of the class handle, between 2 and 65535).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the class (the minor part of the class handle, between 2 and 65535)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rate",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Guaranteed rate of the class.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Guaranteed rate of the class." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ceil",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Maximum rate of the class, borrowing the unused rate of the other classes.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.\nDefaults to the total rate of the qdisc.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum rate of the class, borrowing the unused rate of the other classes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "priority",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Priority of the class when borrowing the unused rate, lower values are served first.\n\nDefaults to `0`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Priority of the class when borrowing the unused rate, lower values are served first." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "firewallMark",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Firewall mark of the packets which are sent to the class.\n\nThe firewall mark can be set with the `NetworkRuleConfig` or by the workloads (e.g. `SO_MARK`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Firewall mark of the packets which are sent to the class." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 10)
+	doc.Fields[1].AddExample("", "200Mbit")
+	doc.Fields[2].AddExample("", "1Gbit")
+	doc.Fields[4].AddExample("", 0x10)
+
+	return doc
+}
+
+func (NetemQdiscConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetemQdiscConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetemQdiscConfig is a network emulator qdisc configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetemQdiscConfig is a network emulator qdisc configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "QdiscConfigV1Alpha1",
+				FieldName: "netem",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "delay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Delay added to the egress packets.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Delay added to the egress packets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "jitter",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Random variation of the delay.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Random variation of the delay." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "loss",
+				Type:        "float64",
+				Note:        "",
+				Description: "Percentage of the packets to drop.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Percentage of the packets to drop." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "duplicate",
+				Type:        "float64",
+				Note:        "",
+				Description: "Percentage of the packets to duplicate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Percentage of the packets to duplicate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "corrupt",
+				Type:        "float64",
+				Note:        "",
+				Description: "Percentage of the packets to corrupt (a random bit is flipped).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Percentage of the packets to corrupt (a random bit is flipped)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "reorder",
+				Type:        "float64",
+				Note:        "",
+				Description: "Percentage of the packets to send immediately (out of order), requires the delay to be set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Percentage of the packets to send immediately (out of order), requires the delay to be set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rate",
+				Type:        "Bandwidth",
+				Note:        "",
+				Description: "Egress rate limit.\n\nRate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.\nDefaults to unlimited.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Egress rate limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "limit",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum number of packets in the queue.\n\nDefaults to `1000`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of packets in the queue." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[2].AddExample("", 0.5)
+
+	return doc
+}
+
+func (RouteConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RouteConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RouteConfig is a static network route configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RouteConfig is a static network route configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link to route the traffic through.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to route the traffic through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "destination",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "The route destination as an IP prefix.\n\nIf not set, the route is a default route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route destination as an IP prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "gateway",
+				Type:        "Addr",
+				Note:        "",
+				Description: "The route gateway.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route gateway." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "source",
+				Type:        "Addr",
+				Note:        "",
+				Description: "The route source address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route source address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "metric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The route metric (priority).\n\nDefaults to 1024.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route metric (priority)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The route MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "table",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The routing table to add the route to.\n\nDefaults to the main routing table.\nCustom routing tables are used with the `RoutingRuleConfig` documents (policy routing).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to add the route to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the `NetworkProbeConfig` document which gates the route.\n\nWhen the probe fails, the route is withdrawn (or its metric is raised, see `probeFailureMetric`),\nand the route is restored when the probe recovers.\nUntil the probe reports the first result, the route is considered healthy.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the `NetworkProbeConfig` document which gates the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeFailureMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The route metric (priority) to use when the probe fails.\n\nIf not set, the route is withdrawn when the probe fails.\nRequired for routes via a gateway, as the probe bound to the link might not reach its target\nonce the route is withdrawn.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route metric (priority) to use when the probe fails." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.AddExample("", exampleDefaultActionConfigV1Alpha1())
+	doc.AddExample("", exampleRouteConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", "uplink0")
+	doc.Fields[3].AddExample("", netip.MustParsePrefix("10.0.0.0/8"))
+	doc.Fields[4].AddExample("", netip.MustParseAddr("10.0.0.1"))
+	doc.Fields[8].AddExample("", uint32(100))
+	doc.Fields[9].AddExample("", "uplink0-gateway")
+	doc.Fields[10].AddExample("", uint32(4096))
 
 	return doc
 }
 
-func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
+func (RoutingRuleConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "KubeSpanEndpointsConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpanEndpointsConfig is a config document to configure KubeSpan endpoints." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "KubeSpanEndpointsConfig is a config document to configure KubeSpan endpoints.",
+		Type:        "RoutingRuleConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RoutingRuleConfig is a routing rule (policy routing) configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RoutingRuleConfig is a routing rule (policy routing) configuration document.",
 		Fields: []encoder.Doc{
-			{}, {
-				Name:        "extraAnnouncedEndpoints",
-				Type:        "[]AddrPort",
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
 				Note:        "",
-				Description: "A list of extra Wireguard endpoints to announce from this machine.\n\nTalos automatically adds endpoints based on machine addresses, public IP, etc.\nThis field allows to add extra endpoints which are managed outside of Talos, e.g. NAT mapping.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "A list of extra Wireguard endpoints to announce from this machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "priority",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The rule priority.\n\nRules are evaluated in the order of the priority (lower value first).\nThe priority should be unique for each address family, and be in the range 1-32765.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The rule priority." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "source",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "The source prefix to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The source prefix to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "destination",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "The destination prefix to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The destination prefix to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "iif",
+				Type:        "string",
+				Note:        "",
+				Description: "The input link name to match.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The input link name to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "oif",
+				Type:        "string",
+				Note:        "",
+				Description: "The output link name to match.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The output link name to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fwMark",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The firewall mark to match.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The firewall mark to match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "fwMask",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The firewall mark mask.\n\nDefaults to matching all bits of the mark.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The firewall mark mask." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "table",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The routing table to look up the route in, if the rule matches.\n\nThe routes can be added to the table with the `table` field of the `RouteConfig` document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to look up the route in, if the rule matches." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.AddExample("", exampleKubespanEndpointsV1Alpha1())
+	doc.AddExample("", exampleRoutingRuleConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", uint32(1000))
+	doc.Fields[3].AddExample("", netip.MustParsePrefix("10.10.0.0/24"))
+	doc.Fields[9].AddExample("", uint32(100))
 
 	return doc
 }
@@ -76,8 +2236,8 @@ func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "portSelector",
 				Type:        "RulePortSelector",
 				Note:        "",
-				Description: "Port selector defines which ports and protocols on the host are affected by the rule.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which ports and protocols on the host are affected by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Port selector defines which ports and protocols are affected by the rule.\n\nFor the ingress rules, these are the host ports, for the egress and forward rules, these are the destination ports.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which ports and protocols are affected by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "ingress",
@@ -86,6 +2246,20 @@ func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "egress",
+				Type:        "[]EgressRule",
+				Note:        "",
+				Description: "Egress defines which destination subnets the host is allowed to access on the ports/protocols defined by the `portSelector`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Egress defines which destination subnets the host is allowed to access on the ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "forward",
+				Type:        "[]IngressRule",
+				Note:        "",
+				Description: "Forward defines which source subnets are allowed to be forwarded by the host to the ports/protocols defined by the `portSelector`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Forward defines which source subnets are allowed to be forwarded by the host to the ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -100,6 +2274,10 @@ func (RulePortSelector) Doc() *encoder.Doc {
 		Comments:    [3]string{"" /* encoder.HeadComment */, "RulePortSelector is a port selector for the network rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 		Description: "RulePortSelector is a port selector for the network rule.",
 		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "NATConfigV1Alpha1",
+				FieldName: "portSelector",
+			},
 			{
 				TypeName:  "RuleConfigV1Alpha1",
 				FieldName: "portSelector",
@@ -145,6 +2323,10 @@ func (IngressRule) Doc() *encoder.Doc {
 				TypeName:  "RuleConfigV1Alpha1",
 				FieldName: "ingress",
 			},
+			{
+				TypeName:  "RuleConfigV1Alpha1",
+				FieldName: "forward",
+			},
 		},
 		Fields: []encoder.Doc{
 			{
@@ -171,17 +2353,374 @@ func (IngressRule) Doc() *encoder.Doc {
 	return doc
 }
 
+func (EgressRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EgressRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EgressRule is a egress rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EgressRule is a egress rule.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "RuleConfigV1Alpha1",
+				FieldName: "egress",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "subnet",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Subnet defines a destination subnet.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnet defines a destination subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "except",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("10.3.4.0/24"))
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("2001:db8::/32"))
+
+	return doc
+}
+
+func (SRIOVConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVConfig is a SR-IOV physical function configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVConfig is a SR-IOV physical function configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the physical function link.\n\nIf the `selector` is not set, the name is the name of the link (or the name of the `LinkConfig` document with the link selector).\nIf the `selector` is set, the name is only used to identify the document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the physical function link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "selector",
+				Type:        "LinkSelector",
+				Note:        "",
+				Description: "Selector picks the physical function link by matching the link status,\ne.g. by the driver (`link.driver`) or the PCI address (`link.bus_path`).\n\nIf multiple links match, the first one (sorted by the link name) is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector picks the physical function link by matching the link status," /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "numVFs",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of virtual functions to create.\n\nZero removes all virtual functions of the physical function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of virtual functions to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vfs",
+				Type:        "[]SRIOVVFConfig",
+				Note:        "",
+				Description: "Settings of the virtual functions.\n\nThe virtual functions which are not listed keep the default settings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Settings of the virtual functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSRIOVConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "enp59s0f0")
+	doc.Fields[3].AddExample("", 8)
+
+	return doc
+}
+
+func (SRIOVVFConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVVFConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVVFConfig is the configuration of a single virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVVFConfig is the configuration of a single virtual function.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "vfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "index",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Index of the virtual function (starting with zero).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the virtual function (starting with zero)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mac",
+				Type:        "HardwareAddr",
+				Note:        "",
+				Description: "MAC address of the virtual function.\n\nIf not set, the MAC address is not changed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "MAC address of the virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlan",
+				Type:        "uint16",
+				Note:        "",
+				Description: "VLAN ID which is transparently tagged by the physical function.\n\nIf not set, the VLAN tagging is disabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN ID which is transparently tagged by the physical function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "trust",
+				Type:        "bool",
+				Note:        "",
+				Description: "Allow the virtual function to change its MAC address and to enter the promiscuous mode.\n\nIf not set, the setting is not changed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Allow the virtual function to change its MAC address and to enter the promiscuous mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoofCheck",
+				Type:        "bool",
+				Note:        "",
+				Description: "Drop the packets sent by the virtual function with a spoofed source MAC address.\n\nIf not set, the setting is not changed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Drop the packets sent by the virtual function with a spoofed source MAC address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01})
+	doc.Fields[2].AddExample("", 100)
+
+	return doc
+}
+
+func (StaticAddressConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "StaticAddressConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "StaticAddressConfig is a static network address configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "StaticAddressConfig is a static network address configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link to assign the addresses to.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to assign the addresses to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "addresses",
+				Type:        "[]Prefix",
+				Note:        "",
+				Description: "List of addresses (in CIDR notation) to assign to the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of addresses (in CIDR notation) to assign to the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleStaticAddressConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "uplink0")
+	doc.Fields[2].AddExample("", []netip.Prefix{netip.MustParsePrefix("192.168.1.10/24"), netip.MustParsePrefix("2001:db8::10/64")})
+
+	return doc
+}
+
+func (VLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "VLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "VLANConfig is a VLAN network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "VLANConfig is a VLAN network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the VLAN link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the VLAN link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the parent link.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the parent link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlanID",
+				Type:        "uint16",
+				Note:        "",
+				Description: "VLAN ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlanMode",
+				Type:        "VLANProtocol",
+				Note:        "",
+				Description: "VLAN protocol.\n\nDefaults to `802.1q`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"802.1q",
+					"802.1ad",
+				},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the VLAN link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the VLAN link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the VLAN link MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the VLAN link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleVLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "uplink0.100")
+	doc.Fields[2].AddExample("", "enp0s2")
+	doc.Fields[3].AddExample("", 100)
+
+	return doc
+}
+
+func (VXLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "VXLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "VXLANConfig is a VXLAN network link configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "VXLANConfig is a VXLAN network link configuration document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the VXLAN link (interface) to create.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the VXLAN link (interface) to create." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the underlying link to send the encapsulated packets through.\n\nThe name might be either the link name, or the name of the `LinkConfig` document with the link selector.\nRequired if the remote address is a multicast group.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the underlying link to send the encapsulated packets through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vni",
+				Type:        "uint32",
+				Note:        "",
+				Description: "VXLAN network identifier (VNI).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VXLAN network identifier (VNI)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "remote",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Remote address to send the encapsulated packets to.\n\nEither a unicast address of the remote endpoint, or a multicast group address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Remote address to send the encapsulated packets to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "local",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Source address of the encapsulated packets.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Source address of the encapsulated packets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Destination UDP port.\n\nDefaults to 4789.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination UDP port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "up",
+				Type:        "bool",
+				Note:        "",
+				Description: "Bring the VXLAN link up or keep it down.\n\nDefaults to `true`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Bring the VXLAN link up or keep it down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Configure the VXLAN link MTU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the VXLAN link MTU." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleVXLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "vxlan100")
+	doc.Fields[2].AddExample("", "enp0s2")
+	doc.Fields[3].AddExample("", 100)
+	doc.Fields[4].AddExample("", netip.MustParseAddr("239.1.1.1"))
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file network_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
 		Name:        "network",
 		Description: "Package network provides network machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			BondConfigV1Alpha1{}.Doc(),
+			BridgeConfigV1Alpha1{}.Doc(),
+			BridgeSTPConfig{}.Doc(),
+			BridgeVLANConfig{}.Doc(),
 			DefaultActionConfigV1Alpha1{}.Doc(),
+			DHCPv4ConfigV1Alpha1{}.Doc(),
+			DHCPv6ConfigV1Alpha1{}.Doc(),
+			DHCPv6PrefixDelegationConfig{}.Doc(),
+			DHCPv6PrefixAssignmentConfig{}.Doc(),
+			DNSCacheConfigV1Alpha1{}.Doc(),
+			DNSForwardZoneConfigV1Alpha1{}.Doc(),
+			DNSStaticRecordConfigV1Alpha1{}.Doc(),
+			DNSUpstreamConfigV1Alpha1{}.Doc(),
+			Dot1XConfigV1Alpha1{}.Doc(),
+			GENEVEConfigV1Alpha1{}.Doc(),
+			IPVLANConfigV1Alpha1{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
+			LinkConfigV1Alpha1{}.Doc(),
+			EthtoolConfig{}.Doc(),
+			EthtoolRingsConfig{}.Doc(),
+			EthtoolChannelsConfig{}.Doc(),
+			EthtoolLinkModeConfig{}.Doc(),
+			EthtoolWakeOnLANConfig{}.Doc(),
+			LinkSelector{}.Doc(),
+			LLDPConfigV1Alpha1{}.Doc(),
+			MACVLANConfigV1Alpha1{}.Doc(),
+			ProbeConfigV1Alpha1{}.Doc(),
+			TCPProbeConfig{}.Doc(),
+			ICMPProbeConfig{}.Doc(),
+			HTTPProbeConfig{}.Doc(),
+			DNSProbeConfig{}.Doc(),
+			NATConfigV1Alpha1{}.Doc(),
+			NATTarget{}.Doc(),
+			QdiscConfigV1Alpha1{}.Doc(),
+			FQQdiscConfig{}.Doc(),
+			FQCodelQdiscConfig{}.Doc(),
+			CAKEQdiscConfig{}.Doc(),
+			TBFQdiscConfig{}.Doc(),
+			HTBQdiscConfig{}.Doc(),
+			HTBClassConfig{}.Doc(),
+			NetemQdiscConfig{}.Doc(),
+			RouteConfigV1Alpha1{}.Doc(),
+			RoutingRuleConfigV1Alpha1{}.Doc(),
 			RuleConfigV1Alpha1{}.Doc(),
 			RulePortSelector{}.Doc(),
 			IngressRule{}.Doc(),
+			EgressRule{}.Doc(),
+			SRIOVConfigV1Alpha1{}.Doc(),
+			SRIOVVFConfig{}.Doc(),
+			StaticAddressConfigV1Alpha1{}.Doc(),
+			VLANConfigV1Alpha1{}.Doc(),
+			VXLANConfigV1Alpha1{}.Doc(),
 		},
 	}
 }
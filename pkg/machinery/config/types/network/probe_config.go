@@ -0,0 +1,478 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ProbeConfigKind is a network probe config document kind.
+const ProbeConfigKind = "NetworkProbeConfig"
+
+const (
+	// DefaultProbeInterval is the default interval between the probes.
+	DefaultProbeInterval = time.Second
+	// DefaultProbeTimeout is the default timeout of a single probe.
+	DefaultProbeTimeout = time.Second
+	// DefaultProbeFailureThreshold is the default number of consecutive failures for the probe to be considered failed.
+	DefaultProbeFailureThreshold = 3
+	// DefaultHTTPProbeStatusCode is the default expected status code of the HTTP probe.
+	DefaultHTTPProbeStatusCode = 200
+	// DefaultDNSProbePort is the default port of the DNS server for the DNS probe.
+	DefaultDNSProbePort = "53"
+)
+
+func init() {
+	registry.Register(ProbeConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ProbeConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkProbeConfig     = &ProbeConfigV1Alpha1{}
+	_ config.NamedDocument          = &ProbeConfigV1Alpha1{}
+	_ config.Validator              = &ProbeConfigV1Alpha1{}
+	_ config.NetworkTCPProbeConfig  = &TCPProbeConfig{}
+	_ config.NetworkICMPProbeConfig = &ICMPProbeConfig{}
+	_ config.NetworkHTTPProbeConfig = &HTTPProbeConfig{}
+	_ config.NetworkDNSProbeConfig  = &DNSProbeConfig{}
+)
+
+// ProbeConfigV1Alpha1 is a network probe configuration document.
+//
+//	examples:
+//	  - value: exampleProbeConfigV1Alpha1()
+//	alias: NetworkProbeConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkProbeConfig
+type ProbeConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the probe.
+	//
+	//     The name is used as the ID of the `ProbeStatus` resource.
+	//   examples:
+	//     - value: >
+	//         "uplink-gateway"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Interval between the probes.
+	//
+	//     Defaults to `1s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Number of consecutive failures for the probe to be considered failed after having succeeded.
+	//
+	//     Defaults to `3`.
+	ProbeFailureThreshold *int `yaml:"failureThreshold,omitempty"`
+	//   description: |
+	//     TCP connection probe.
+	ProbeTCP *TCPProbeConfig `yaml:"tcp,omitempty"`
+	//   description: |
+	//     ICMP echo probe.
+	ProbeICMP *ICMPProbeConfig `yaml:"icmp,omitempty"`
+	//   description: |
+	//     HTTP(S) GET probe.
+	ProbeHTTP *HTTPProbeConfig `yaml:"http,omitempty"`
+	//   description: |
+	//     DNS query probe.
+	ProbeDNS *DNSProbeConfig `yaml:"dns,omitempty"`
+	//   description: |
+	//     Name of the link (or the name of the `LinkConfig` document with the link selector) to send the probe through.
+	//
+	//     The probe socket is bound to the link, so that each uplink can be probed independently of the current default route.
+	//     If the probe gates a route on the same link, use `probeFailureMetric` on the route instead of withdrawing it,
+	//     as the probe needs a route via the link to recover.
+	//   examples:
+	//     - value: >
+	//         "enp1s0"
+	ProbeLink string `yaml:"link,omitempty"`
+}
+
+// TCPProbeConfig is a TCP connection probe.
+type TCPProbeConfig struct {
+	//   description: |
+	//     Endpoint to connect to: `host:port`.
+	//   examples:
+	//     - value: >
+	//         "10.0.0.1:443"
+	//   schemaRequired: true
+	ProbeEndpoint string `yaml:"endpoint"`
+	//   description: |
+	//     Timeout for the connection.
+	//
+	//     Defaults to `1s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// ICMPProbeConfig is an ICMP echo probe.
+type ICMPProbeConfig struct {
+	//   description: |
+	//     Address to send the ICMP echo request to.
+	//   examples:
+	//     - value: >
+	//         netip.MustParseAddr("192.168.1.1")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	//   schemaRequired: true
+	ProbeAddress Addr `yaml:"address"`
+	//   description: |
+	//     Timeout waiting for the ICMP echo reply.
+	//
+	//     Defaults to `1s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// HTTPProbeConfig is an HTTP(S) GET probe.
+type HTTPProbeConfig struct {
+	//   description: |
+	//     URL to send the GET request to.
+	//
+	//     Both `http` and `https` schemes are supported.
+	//   examples:
+	//     - value: >
+	//         "https://example.com/healthz"
+	//   schemaRequired: true
+	ProbeURL string `yaml:"url"`
+	//   description: |
+	//     Expected HTTP status code of the response.
+	//
+	//     Defaults to `200`.
+	ProbeExpectedStatusCode int `yaml:"expectedStatusCode,omitempty"`
+	//   description: |
+	//     Timeout for the request.
+	//
+	//     Defaults to `1s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// DNSProbeConfig is a DNS query probe.
+type DNSProbeConfig struct {
+	//   description: |
+	//     DNS server to send the query to: `host` or `host:port`.
+	//
+	//     The port defaults to `53`.
+	//   examples:
+	//     - value: >
+	//         "1.1.1.1"
+	//   schemaRequired: true
+	ProbeServer string `yaml:"server"`
+	//   description: |
+	//     Name to query (`A` record).
+	//
+	//     The probe succeeds if the server responds without an error.
+	//   examples:
+	//     - value: >
+	//         "example.com"
+	//   schemaRequired: true
+	ProbeQuery string `yaml:"query"`
+	//   description: |
+	//     Timeout for the query.
+	//
+	//     Defaults to `1s`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	ProbeTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NewProbeConfigV1Alpha1 creates a new NetworkProbeConfig config document.
+func NewProbeConfigV1Alpha1() *ProbeConfigV1Alpha1 {
+	return &ProbeConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ProbeConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleProbeConfigV1Alpha1() *ProbeConfigV1Alpha1 {
+	cfg := NewProbeConfigV1Alpha1()
+	cfg.MetaName = "uplink-gateway"
+	cfg.ProbeInterval = 5 * time.Second
+	cfg.ProbeICMP = &ICMPProbeConfig{
+		ProbeAddress: Addr{netip.MustParseAddr("192.168.1.1")},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ProbeConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *ProbeConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkProbeConfigSignal implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) NetworkProbeConfigSignal() {}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo,cyclop
+func (s *ProbeConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	switch {
+	case s.MetaName == "":
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	case strings.ContainsAny(s.MetaName, ":/"):
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("name %q should not contain ':' or '/'", s.MetaName))
+	}
+
+	if s.ProbeInterval < 0 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid interval %s", s.ProbeInterval))
+	}
+
+	if s.ProbeFailureThreshold != nil && *s.ProbeFailureThreshold < 0 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid failure threshold %d", *s.ProbeFailureThreshold))
+	}
+
+	probeTypes := 0
+
+	if s.ProbeTCP != nil {
+		probeTypes++
+
+		if _, _, err := net.SplitHostPort(s.ProbeTCP.ProbeEndpoint); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid TCP probe endpoint %q: %w", s.ProbeTCP.ProbeEndpoint, err))
+		}
+
+		validationErrors = errors.Join(validationErrors, validateProbeTimeout(s.ProbeTCP.ProbeTimeout))
+	}
+
+	if s.ProbeICMP != nil {
+		probeTypes++
+
+		if !s.ProbeICMP.ProbeAddress.IsValid() {
+			validationErrors = errors.Join(validationErrors, errors.New("ICMP probe address is required"))
+		}
+
+		validationErrors = errors.Join(validationErrors, validateProbeTimeout(s.ProbeICMP.ProbeTimeout))
+	}
+
+	if s.ProbeHTTP != nil {
+		probeTypes++
+
+		u, err := url.Parse(s.ProbeHTTP.ProbeURL)
+
+		switch {
+		case err != nil:
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid HTTP probe URL %q: %w", s.ProbeHTTP.ProbeURL, err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid HTTP probe URL %q: scheme should be http or https", s.ProbeHTTP.ProbeURL))
+		case u.Host == "":
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid HTTP probe URL %q: host is required", s.ProbeHTTP.ProbeURL))
+		}
+
+		if s.ProbeHTTP.ProbeExpectedStatusCode != 0 && (s.ProbeHTTP.ProbeExpectedStatusCode < 100 || s.ProbeHTTP.ProbeExpectedStatusCode > 599) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid HTTP probe expected status code %d", s.ProbeHTTP.ProbeExpectedStatusCode))
+		}
+
+		validationErrors = errors.Join(validationErrors, validateProbeTimeout(s.ProbeHTTP.ProbeTimeout))
+	}
+
+	if s.ProbeDNS != nil {
+		probeTypes++
+
+		if s.ProbeDNS.ProbeServer == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("DNS probe server is required"))
+		} else if _, _, err := net.SplitHostPort(s.ProbeDNS.Server()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid DNS probe server %q: %w", s.ProbeDNS.ProbeServer, err))
+		}
+
+		if s.ProbeDNS.ProbeQuery == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("DNS probe query is required"))
+		}
+
+		validationErrors = errors.Join(validationErrors, validateProbeTimeout(s.ProbeDNS.ProbeTimeout))
+	}
+
+	if probeTypes != 1 {
+		validationErrors = errors.Join(validationErrors, errors.New("exactly one of tcp, icmp, http or dns probes should be specified"))
+	}
+
+	if s.ProbeLink != "" {
+		validationErrors = errors.Join(validationErrors, validateLinkName(s.ProbeLink))
+	}
+
+	return nil, validationErrors
+}
+
+func validateProbeTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return fmt.Errorf("invalid probe timeout %s", timeout)
+	}
+
+	return nil
+}
+
+func probeTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return DefaultProbeTimeout
+	}
+
+	return timeout
+}
+
+// Interval implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) Interval() time.Duration {
+	if s.ProbeInterval == 0 {
+		return DefaultProbeInterval
+	}
+
+	return s.ProbeInterval
+}
+
+// FailureThreshold implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) FailureThreshold() int {
+	if s.ProbeFailureThreshold == nil {
+		return DefaultProbeFailureThreshold
+	}
+
+	return *s.ProbeFailureThreshold
+}
+
+// TCP implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) TCP() optional.Optional[config.NetworkTCPProbeConfig] {
+	if s.ProbeTCP == nil {
+		return optional.None[config.NetworkTCPProbeConfig]()
+	}
+
+	return optional.Some[config.NetworkTCPProbeConfig](s.ProbeTCP)
+}
+
+// ICMP implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) ICMP() optional.Optional[config.NetworkICMPProbeConfig] {
+	if s.ProbeICMP == nil {
+		return optional.None[config.NetworkICMPProbeConfig]()
+	}
+
+	return optional.Some[config.NetworkICMPProbeConfig](s.ProbeICMP)
+}
+
+// HTTP implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) HTTP() optional.Optional[config.NetworkHTTPProbeConfig] {
+	if s.ProbeHTTP == nil {
+		return optional.None[config.NetworkHTTPProbeConfig]()
+	}
+
+	return optional.Some[config.NetworkHTTPProbeConfig](s.ProbeHTTP)
+}
+
+// DNS implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) DNS() optional.Optional[config.NetworkDNSProbeConfig] {
+	if s.ProbeDNS == nil {
+		return optional.None[config.NetworkDNSProbeConfig]()
+	}
+
+	return optional.Some[config.NetworkDNSProbeConfig](s.ProbeDNS)
+}
+
+// Link implements config.NetworkProbeConfig interface.
+func (s *ProbeConfigV1Alpha1) Link() optional.Optional[string] {
+	if s.ProbeLink == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.ProbeLink)
+}
+
+// Endpoint implements config.NetworkTCPProbeConfig interface.
+func (s *TCPProbeConfig) Endpoint() string {
+	return s.ProbeEndpoint
+}
+
+// Timeout implements config.NetworkTCPProbeConfig interface.
+func (s *TCPProbeConfig) Timeout() time.Duration {
+	return probeTimeout(s.ProbeTimeout)
+}
+
+// Address implements config.NetworkICMPProbeConfig interface.
+func (s *ICMPProbeConfig) Address() netip.Addr {
+	return s.ProbeAddress.Addr
+}
+
+// Timeout implements config.NetworkICMPProbeConfig interface.
+func (s *ICMPProbeConfig) Timeout() time.Duration {
+	return probeTimeout(s.ProbeTimeout)
+}
+
+// URL implements config.NetworkHTTPProbeConfig interface.
+func (s *HTTPProbeConfig) URL() string {
+	return s.ProbeURL
+}
+
+// ExpectedStatusCode implements config.NetworkHTTPProbeConfig interface.
+func (s *HTTPProbeConfig) ExpectedStatusCode() int {
+	if s.ProbeExpectedStatusCode == 0 {
+		return DefaultHTTPProbeStatusCode
+	}
+
+	return s.ProbeExpectedStatusCode
+}
+
+// Timeout implements config.NetworkHTTPProbeConfig interface.
+func (s *HTTPProbeConfig) Timeout() time.Duration {
+	return probeTimeout(s.ProbeTimeout)
+}
+
+// Server implements config.NetworkDNSProbeConfig interface.
+//
+// If the port is not specified, the default DNS port is used.
+func (s *DNSProbeConfig) Server() string {
+	if _, _, err := net.SplitHostPort(s.ProbeServer); err == nil {
+		return s.ProbeServer
+	}
+
+	return net.JoinHostPort(strings.Trim(s.ProbeServer, "[]"), DefaultDNSProbePort)
+}
+
+// Query implements config.NetworkDNSProbeConfig interface.
+func (s *DNSProbeConfig) Query() string {
+	return s.ProbeQuery
+}
+
+// Timeout implements config.NetworkDNSProbeConfig interface.
+func (s *DNSProbeConfig) Timeout() time.Duration {
+	return probeTimeout(s.ProbeTimeout)
+}
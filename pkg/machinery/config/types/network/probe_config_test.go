@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/networkprobeconfig.yaml
+var expectedProbeConfigDocument []byte
+
+func TestProbeConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewProbeConfigV1Alpha1()
+	cfg.MetaName = "uplink-http"
+	cfg.ProbeInterval = 5 * time.Second
+	cfg.ProbeFailureThreshold = pointer.To(2)
+	cfg.ProbeHTTP = &network.HTTPProbeConfig{
+		ProbeURL:                "https://example.com/healthz",
+		ProbeExpectedStatusCode: 204,
+		ProbeTimeout:            3 * time.Second,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedProbeConfigDocument, marshaled)
+}
+
+func TestProbeConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedProbeConfigDocument)
+	require.NoError(t, err)
+
+	probes := provider.NetworkProbeConfigs()
+	require.Len(t, probes, 1)
+
+	assert.Equal(t, "uplink-http", probes[0].Name())
+	assert.Equal(t, 5*time.Second, probes[0].Interval())
+	assert.Equal(t, 2, probes[0].FailureThreshold())
+	assert.False(t, probes[0].TCP().IsPresent())
+	assert.False(t, probes[0].ICMP().IsPresent())
+	assert.False(t, probes[0].DNS().IsPresent())
+
+	httpProbe, ok := probes[0].HTTP().Get()
+	require.True(t, ok)
+
+	assert.Equal(t, "https://example.com/healthz", httpProbe.URL())
+	assert.Equal(t, 204, httpProbe.ExpectedStatusCode())
+	assert.Equal(t, 3*time.Second, httpProbe.Timeout())
+}
+
+func TestProbeConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewProbeConfigV1Alpha1()
+	cfg.MetaName = "dns"
+	cfg.ProbeDNS = &network.DNSProbeConfig{
+		ProbeServer: "2001:db8::53",
+		ProbeQuery:  "example.com",
+	}
+
+	assert.Equal(t, network.DefaultProbeInterval, cfg.Interval())
+	assert.Equal(t, network.DefaultProbeFailureThreshold, cfg.FailureThreshold())
+
+	dnsProbe, ok := cfg.DNS().Get()
+	require.True(t, ok)
+
+	assert.Equal(t, "[2001:db8::53]:53", dnsProbe.Server())
+	assert.Equal(t, network.DefaultProbeTimeout, dnsProbe.Timeout())
+
+	cfg.ProbeDNS.ProbeServer = "10.0.0.53:5353"
+	assert.Equal(t, "10.0.0.53:5353", dnsProbe.Server())
+}
+
+func TestProbeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.ProbeConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewProbeConfigV1Alpha1,
+
+			expectedError: "name is required\nexactly one of tcp, icmp, http or dns probes should be specified",
+		},
+		{
+			name: "multiple probes",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeTCP = &network.TCPProbeConfig{ProbeEndpoint: "10.0.0.1:443"}
+				cfg.ProbeICMP = &network.ICMPProbeConfig{ProbeAddress: network.Addr{Addr: netip.MustParseAddr("10.0.0.1")}}
+
+				return cfg
+			},
+
+			expectedError: "exactly one of tcp, icmp, http or dns probes should be specified",
+		},
+		{
+			name: "invalid name",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "tcp:10.0.0.1:443"
+				cfg.ProbeTCP = &network.TCPProbeConfig{ProbeEndpoint: "10.0.0.1:443"}
+
+				return cfg
+			},
+
+			expectedError: "name \"tcp:10.0.0.1:443\" should not contain ':' or '/'",
+		},
+		{
+			name: "invalid tcp",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeTCP = &network.TCPProbeConfig{ProbeEndpoint: "10.0.0.1", ProbeTimeout: -time.Second}
+
+				return cfg
+			},
+
+			expectedError: "invalid TCP probe endpoint \"10.0.0.1\": address 10.0.0.1: missing port in address\ninvalid probe timeout -1s",
+		},
+		{
+			name: "invalid icmp",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeICMP = &network.ICMPProbeConfig{}
+				cfg.ProbeFailureThreshold = pointer.To(-1)
+
+				return cfg
+			},
+
+			expectedError: "invalid failure threshold -1\nICMP probe address is required",
+		},
+		{
+			name: "invalid http",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeHTTP = &network.HTTPProbeConfig{ProbeURL: "ftp://example.com", ProbeExpectedStatusCode: 1000}
+
+				return cfg
+			},
+
+			expectedError: "invalid HTTP probe URL \"ftp://example.com\": scheme should be http or https\ninvalid HTTP probe expected status code 1000",
+		},
+		{
+			name: "invalid dns",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeDNS = &network.DNSProbeConfig{}
+
+				return cfg
+			},
+
+			expectedError: "DNS probe server is required\nDNS probe query is required",
+		},
+		{
+			name: "invalid link",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeDNS = &network.DNSProbeConfig{ProbeServer: "1.1.1.1", ProbeQuery: "example.com"}
+				cfg.ProbeLink = "eth0/1"
+
+				return cfg
+			},
+
+			expectedError: "link name \"eth0/1\" is invalid",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.ProbeConfigV1Alpha1 {
+				cfg := network.NewProbeConfigV1Alpha1()
+				cfg.MetaName = "probe"
+				cfg.ProbeDNS = &network.DNSProbeConfig{ProbeServer: "1.1.1.1", ProbeQuery: "example.com"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,772 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// QdiscConfigKind is a qdisc config document kind.
+const QdiscConfigKind = "QdiscConfig"
+
+func init() {
+	registry.Register(QdiscConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &QdiscConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkQdiscConfig        = &QdiscConfigV1Alpha1{}
+	_ config.NamedDocument             = &QdiscConfigV1Alpha1{}
+	_ config.Validator                 = &QdiscConfigV1Alpha1{}
+	_ config.NetworkFQQdiscConfig      = &FQQdiscConfig{}
+	_ config.NetworkFQCodelQdiscConfig = &FQCodelQdiscConfig{}
+	_ config.NetworkCAKEQdiscConfig    = &CAKEQdiscConfig{}
+	_ config.NetworkTBFQdiscConfig     = &TBFQdiscConfig{}
+	_ config.NetworkHTBQdiscConfig     = &HTBQdiscConfig{}
+	_ config.NetworkHTBClassConfig     = &HTBClassConfig{}
+	_ config.NetworkNetemQdiscConfig   = &NetemQdiscConfig{}
+)
+
+const (
+	// maxRate32 is the maximum rate (in bits per second) of the qdiscs which keep the rate in bytes per second as 32-bit integer.
+	maxRate32 = math.MaxUint32 * 8
+
+	// maxHTBClassID is the maximum HTB class ID (minor part of the class handle).
+	maxHTBClassID = 0xffff
+
+	// htbParentClassID is the class ID of the HTB parent class which holds the total rate.
+	htbParentClassID = 1
+)
+
+// QdiscConfigV1Alpha1 is a root queueing discipline (qdisc) configuration document.
+//
+//	examples:
+//	  - value: exampleQdiscConfigV1Alpha1()
+//	  - value: exampleQdiscConfigV1Alpha1Netem()
+//	alias: QdiscConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/QdiscConfig
+type QdiscConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link (or the name of the `LinkConfig` document with the link selector).
+	//
+	//     The qdisc is attached as the root (egress) qdisc of the link.
+	//   examples:
+	//     - value: >
+	//         "enp1s0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Fair Queue (fq) qdisc: per-flow pacing and fairness.
+	QdiscFQ *FQQdiscConfig `yaml:"fq,omitempty"`
+	//   description: |
+	//     Fair Queuing Controlled Delay (fq_codel) qdisc: per-flow fairness with active queue management.
+	QdiscFQCodel *FQCodelQdiscConfig `yaml:"fqCodel,omitempty"`
+	//   description: |
+	//     Common Applications Kept Enhanced (cake) qdisc: shaper with active queue management and flow isolation.
+	QdiscCAKE *CAKEQdiscConfig `yaml:"cake,omitempty"`
+	//   description: |
+	//     Token Bucket Filter (tbf) qdisc: limits the egress rate of the link.
+	QdiscTBF *TBFQdiscConfig `yaml:"tbf,omitempty"`
+	//   description: |
+	//     Hierarchy Token Bucket (htb) qdisc: splits the egress rate of the link between the classes.
+	QdiscHTB *HTBQdiscConfig `yaml:"htb,omitempty"`
+	//   description: |
+	//     Network emulator (netem) qdisc: adds delay, packet loss, duplication, corruption and reordering.
+	//
+	//     Should only be used in test environments.
+	QdiscNetem *NetemQdiscConfig `yaml:"netem,omitempty"`
+}
+
+// FQQdiscConfig is a Fair Queue qdisc configuration.
+type FQQdiscConfig struct {
+	//   description: |
+	//     Maximum number of packets queued per flow.
+	//
+	//     Defaults to `100`.
+	FQFlowLimit uint32 `yaml:"flowLimit,omitempty"`
+	//   description: |
+	//     Maximum sending rate of a single flow.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//     Defaults to unlimited.
+	//   examples:
+	//     - value: >
+	//         "1Gbit"
+	//   schema:
+	//     type: string
+	FQMaxRate Bandwidth `yaml:"maxRate,omitempty"`
+}
+
+// FQCodelQdiscConfig is a Fair Queuing Controlled Delay qdisc configuration.
+type FQCodelQdiscConfig struct {
+	//   description: |
+	//     Maximum number of packets in the queue.
+	//
+	//     Defaults to `10240`.
+	FQCodelLimit uint32 `yaml:"limit,omitempty"`
+	//   description: |
+	//     Number of flow buckets.
+	//
+	//     Defaults to `1024`.
+	FQCodelFlows uint32 `yaml:"flows,omitempty"`
+	//   description: |
+	//     Acceptable minimum standing queue delay.
+	//
+	//     Defaults to `5ms`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	FQCodelTarget time.Duration `yaml:"target,omitempty"`
+	//   description: |
+	//     Interval to measure the minimum delay, should be set to the worst-case RTT.
+	//
+	//     Defaults to `100ms`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	FQCodelInterval time.Duration `yaml:"interval,omitempty"`
+	//   description: |
+	//     Mark the packets with ECN instead of dropping them.
+	//
+	//     Defaults to `true`.
+	FQCodelECN *bool `yaml:"ecn,omitempty"`
+}
+
+// CAKEQdiscConfig is a Common Applications Kept Enhanced qdisc configuration.
+type CAKEQdiscConfig struct {
+	//   description: |
+	//     Bandwidth to shape the egress traffic to.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//     Defaults to unlimited.
+	//   examples:
+	//     - value: >
+	//         "500Mbit"
+	//   schema:
+	//     type: string
+	CAKEBandwidth Bandwidth `yaml:"bandwidth,omitempty"`
+	//   description: |
+	//     Expected round-trip time of the traffic.
+	//
+	//     Defaults to `100ms`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	CAKERTT time.Duration `yaml:"rtt,omitempty"`
+}
+
+// TBFQdiscConfig is a Token Bucket Filter qdisc configuration.
+type TBFQdiscConfig struct {
+	//   description: |
+	//     Egress rate limit.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//   examples:
+	//     - value: >
+	//         "100Mbit"
+	//   schema:
+	//     type: string
+	//   schemaRequired: true
+	TBFRate Bandwidth `yaml:"rate"`
+	//   description: |
+	//     Size of the bucket in bytes, the maximum amount of data sent at once at the link speed.
+	//
+	//     Defaults to the amount of data sent at the rate in 10ms (but at least 16KiB).
+	TBFBurst uint32 `yaml:"burst,omitempty"`
+	//   description: |
+	//     Maximum time a packet can wait in the queue.
+	//
+	//     Defaults to `50ms`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	TBFLatency time.Duration `yaml:"latency,omitempty"`
+}
+
+// HTBQdiscConfig is a Hierarchy Token Bucket qdisc configuration.
+type HTBQdiscConfig struct {
+	//   description: |
+	//     Total egress rate shared by the classes.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//   examples:
+	//     - value: >
+	//         "10Gbit"
+	//   schema:
+	//     type: string
+	//   schemaRequired: true
+	HTBRate Bandwidth `yaml:"rate"`
+	//   description: |
+	//     ID of the class for the traffic which doesn't match any class.
+	//
+	//     If not set, the traffic which doesn't match any class is not shaped.
+	HTBDefaultClass uint16 `yaml:"defaultClass,omitempty"`
+	//   description: |
+	//     List of the classes.
+	//
+	//     Each class gets a `fq_codel` qdisc attached.
+	//   schemaRequired: true
+	HTBClasses []HTBClassConfig `yaml:"classes"`
+}
+
+// HTBClassConfig is a single HTB class configuration.
+type HTBClassConfig struct {
+	//   description: |
+	//     ID of the class (the minor part of the class handle, between 2 and 65535).
+	//   examples:
+	//     - value: >
+	//         10
+	//   schemaRequired: true
+	HTBClassID uint16 `yaml:"id"`
+	//   description: |
+	//     Guaranteed rate of the class.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//   examples:
+	//     - value: >
+	//         "200Mbit"
+	//   schema:
+	//     type: string
+	//   schemaRequired: true
+	HTBClassRate Bandwidth `yaml:"rate"`
+	//   description: |
+	//     Maximum rate of the class, borrowing the unused rate of the other classes.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//     Defaults to the total rate of the qdisc.
+	//   examples:
+	//     - value: >
+	//         "1Gbit"
+	//   schema:
+	//     type: string
+	HTBClassCeil Bandwidth `yaml:"ceil,omitempty"`
+	//   description: |
+	//     Priority of the class when borrowing the unused rate, lower values are served first.
+	//
+	//     Defaults to `0`.
+	HTBClassPriority uint32 `yaml:"priority,omitempty"`
+	//   description: |
+	//     Firewall mark of the packets which are sent to the class.
+	//
+	//     The firewall mark can be set with the `NetworkRuleConfig` or by the workloads (e.g. `SO_MARK`).
+	//   examples:
+	//     - value: >
+	//         0x10
+	HTBClassFirewallMark uint32 `yaml:"firewallMark,omitempty"`
+}
+
+// NetemQdiscConfig is a network emulator qdisc configuration.
+type NetemQdiscConfig struct {
+	//   description: |
+	//     Delay added to the egress packets.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	NetemDelay time.Duration `yaml:"delay,omitempty"`
+	//   description: |
+	//     Random variation of the delay.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	NetemJitter time.Duration `yaml:"jitter,omitempty"`
+	//   description: |
+	//     Percentage of the packets to drop.
+	//   examples:
+	//     - value: >
+	//         0.5
+	NetemLoss float64 `yaml:"loss,omitempty"`
+	//   description: |
+	//     Percentage of the packets to duplicate.
+	NetemDuplicate float64 `yaml:"duplicate,omitempty"`
+	//   description: |
+	//     Percentage of the packets to corrupt (a random bit is flipped).
+	NetemCorrupt float64 `yaml:"corrupt,omitempty"`
+	//   description: |
+	//     Percentage of the packets to send immediately (out of order), requires the delay to be set.
+	NetemReorder float64 `yaml:"reorder,omitempty"`
+	//   description: |
+	//     Egress rate limit.
+	//
+	//     Rate is specified in bits per second, but can be expressed with units, e.g. 100Mbit.
+	//     Defaults to unlimited.
+	//   schema:
+	//     type: string
+	NetemRate Bandwidth `yaml:"rate,omitempty"`
+	//   description: |
+	//     Maximum number of packets in the queue.
+	//
+	//     Defaults to `1000`.
+	NetemLimit uint32 `yaml:"limit,omitempty"`
+}
+
+// NewQdiscConfigV1Alpha1 creates a new QdiscConfig config document.
+func NewQdiscConfigV1Alpha1() *QdiscConfigV1Alpha1 {
+	return &QdiscConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       QdiscConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleQdiscConfigV1Alpha1() *QdiscConfigV1Alpha1 {
+	cfg := NewQdiscConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.QdiscHTB = &HTBQdiscConfig{
+		HTBRate:         MustBandwidth("10Gbit"),
+		HTBDefaultClass: 20,
+		HTBClasses: []HTBClassConfig{
+			{
+				HTBClassID:           10,
+				HTBClassRate:         MustBandwidth("200Mbit"),
+				HTBClassCeil:         MustBandwidth("1Gbit"),
+				HTBClassPriority:     1,
+				HTBClassFirewallMark: 0x10,
+			},
+			{
+				HTBClassID:   20,
+				HTBClassRate: MustBandwidth("9Gbit"),
+			},
+		},
+	}
+
+	return cfg
+}
+
+func exampleQdiscConfigV1Alpha1Netem() *QdiscConfigV1Alpha1 {
+	cfg := NewQdiscConfigV1Alpha1()
+	cfg.MetaName = "enp2s0"
+	cfg.QdiscNetem = &NetemQdiscConfig{
+		NetemDelay:  100 * time.Millisecond,
+		NetemJitter: 10 * time.Millisecond,
+		NetemLoss:   0.5,
+		NetemRate:   MustBandwidth("50Mbit"),
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *QdiscConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *QdiscConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkQdiscConfigSignal implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) NetworkQdiscConfigSignal() {}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo,cyclop
+func (s *QdiscConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		validationErrors error
+		warnings         []string
+	)
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	} else if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	qdiscTypes := 0
+
+	if s.QdiscFQ != nil {
+		qdiscTypes++
+
+		validationErrors = errors.Join(validationErrors, validateRate32("fq maxRate", s.QdiscFQ.FQMaxRate))
+	}
+
+	if s.QdiscFQCodel != nil {
+		qdiscTypes++
+
+		validationErrors = errors.Join(validationErrors,
+			validateQdiscDuration("fqCodel target", s.QdiscFQCodel.FQCodelTarget),
+			validateQdiscDuration("fqCodel interval", s.QdiscFQCodel.FQCodelInterval),
+		)
+	}
+
+	if s.QdiscCAKE != nil {
+		qdiscTypes++
+
+		validationErrors = errors.Join(validationErrors, validateQdiscDuration("cake rtt", s.QdiscCAKE.CAKERTT))
+	}
+
+	if s.QdiscTBF != nil {
+		qdiscTypes++
+
+		if s.QdiscTBF.TBFRate.Value() == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("tbf rate is required"))
+		}
+
+		validationErrors = errors.Join(validationErrors,
+			validateRate32("tbf rate", s.QdiscTBF.TBFRate),
+			validateQdiscDuration("tbf latency", s.QdiscTBF.TBFLatency),
+		)
+	}
+
+	if s.QdiscHTB != nil {
+		qdiscTypes++
+
+		validationErrors = errors.Join(validationErrors, s.QdiscHTB.validate())
+	}
+
+	if s.QdiscNetem != nil {
+		qdiscTypes++
+
+		netemWarnings, err := s.QdiscNetem.validate()
+		validationErrors = errors.Join(validationErrors, err)
+
+		warnings = append(warnings, netemWarnings...)
+	}
+
+	if qdiscTypes != 1 {
+		validationErrors = errors.Join(validationErrors, errors.New("exactly one of fq, fqCodel, cake, tbf, htb or netem should be specified"))
+	}
+
+	return warnings, validationErrors
+}
+
+//nolint:gocyclo
+func (s *HTBQdiscConfig) validate() error {
+	var validationErrors error
+
+	if s.HTBRate.Value() == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("htb rate is required"))
+	}
+
+	if len(s.HTBClasses) == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("htb classes are required"))
+	}
+
+	var (
+		seenIDs   = map[uint16]struct{}{}
+		seenMarks = map[uint32]struct{}{}
+	)
+
+	for _, class := range s.HTBClasses {
+		if class.HTBClassID <= htbParentClassID {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: id should be between %d and %d", class.HTBClassID, htbParentClassID+1, maxHTBClassID))
+		}
+
+		if _, seen := seenIDs[class.HTBClassID]; seen {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: duplicate id", class.HTBClassID))
+		}
+
+		seenIDs[class.HTBClassID] = struct{}{}
+
+		if class.HTBClassRate.Value() == 0 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: rate is required", class.HTBClassID))
+		}
+
+		if class.HTBClassRate.Value() > s.HTBRate.Value() {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: rate should not exceed the total rate", class.HTBClassID))
+		}
+
+		if ceil := class.HTBClassCeil.Value(); ceil != 0 && (ceil < class.HTBClassRate.Value() || ceil > s.HTBRate.Value()) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: ceil should be between the class rate and the total rate", class.HTBClassID))
+		}
+
+		if class.HTBClassPriority > 7 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: priority should be between 0 and 7", class.HTBClassID))
+		}
+
+		if class.HTBClassFirewallMark != 0 {
+			if _, seen := seenMarks[class.HTBClassFirewallMark]; seen {
+				validationErrors = errors.Join(validationErrors, fmt.Errorf("htb class %d: duplicate firewall mark 0x%x", class.HTBClassID, class.HTBClassFirewallMark))
+			}
+
+			seenMarks[class.HTBClassFirewallMark] = struct{}{}
+		}
+	}
+
+	if _, exists := seenIDs[s.HTBDefaultClass]; s.HTBDefaultClass != 0 && !exists {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("htb default class %d is not defined", s.HTBDefaultClass))
+	}
+
+	return validationErrors
+}
+
+func (s *NetemQdiscConfig) validate() ([]string, error) {
+	var (
+		validationErrors error
+		warnings         []string
+	)
+
+	validationErrors = errors.Join(validationErrors,
+		validateQdiscDuration("netem delay", s.NetemDelay),
+		validateQdiscDuration("netem jitter", s.NetemJitter),
+	)
+
+	for _, probability := range []struct {
+		name  string
+		value float64
+	}{
+		{"loss", s.NetemLoss},
+		{"duplicate", s.NetemDuplicate},
+		{"corrupt", s.NetemCorrupt},
+		{"reorder", s.NetemReorder},
+	} {
+		if probability.value < 0 || probability.value > 100 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("netem %s should be between 0 and 100", probability.name))
+		}
+	}
+
+	if s.NetemJitter > s.NetemDelay {
+		validationErrors = errors.Join(validationErrors, errors.New("netem jitter should not exceed the delay"))
+	}
+
+	if s.NetemReorder > 0 && s.NetemDelay == 0 {
+		warnings = append(warnings, "netem reorder has no effect without the delay")
+	}
+
+	return warnings, validationErrors
+}
+
+func validateRate32(name string, rate Bandwidth) error {
+	if rate.Value() > maxRate32 {
+		return fmt.Errorf("%s should not exceed %d bit/s", name, uint64(maxRate32))
+	}
+
+	return nil
+}
+
+func validateQdiscDuration(name string, d time.Duration) error {
+	if d < 0 || d > time.Hour {
+		return fmt.Errorf("%s %s should be between 0 and %s", name, d, time.Hour)
+	}
+
+	return nil
+}
+
+// FQ implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) FQ() optional.Optional[config.NetworkFQQdiscConfig] {
+	if s.QdiscFQ == nil {
+		return optional.None[config.NetworkFQQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkFQQdiscConfig](s.QdiscFQ)
+}
+
+// FQCodel implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) FQCodel() optional.Optional[config.NetworkFQCodelQdiscConfig] {
+	if s.QdiscFQCodel == nil {
+		return optional.None[config.NetworkFQCodelQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkFQCodelQdiscConfig](s.QdiscFQCodel)
+}
+
+// CAKE implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) CAKE() optional.Optional[config.NetworkCAKEQdiscConfig] {
+	if s.QdiscCAKE == nil {
+		return optional.None[config.NetworkCAKEQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkCAKEQdiscConfig](s.QdiscCAKE)
+}
+
+// TBF implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) TBF() optional.Optional[config.NetworkTBFQdiscConfig] {
+	if s.QdiscTBF == nil {
+		return optional.None[config.NetworkTBFQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkTBFQdiscConfig](s.QdiscTBF)
+}
+
+// HTB implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) HTB() optional.Optional[config.NetworkHTBQdiscConfig] {
+	if s.QdiscHTB == nil {
+		return optional.None[config.NetworkHTBQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkHTBQdiscConfig](s.QdiscHTB)
+}
+
+// Netem implements config.NetworkQdiscConfig interface.
+func (s *QdiscConfigV1Alpha1) Netem() optional.Optional[config.NetworkNetemQdiscConfig] {
+	if s.QdiscNetem == nil {
+		return optional.None[config.NetworkNetemQdiscConfig]()
+	}
+
+	return optional.Some[config.NetworkNetemQdiscConfig](s.QdiscNetem)
+}
+
+// FlowLimit implements config.NetworkFQQdiscConfig interface.
+func (s *FQQdiscConfig) FlowLimit() uint32 {
+	return s.FQFlowLimit
+}
+
+// MaxRate implements config.NetworkFQQdiscConfig interface.
+func (s *FQQdiscConfig) MaxRate() uint64 {
+	return s.FQMaxRate.Value()
+}
+
+// Limit implements config.NetworkFQCodelQdiscConfig interface.
+func (s *FQCodelQdiscConfig) Limit() uint32 {
+	return s.FQCodelLimit
+}
+
+// Flows implements config.NetworkFQCodelQdiscConfig interface.
+func (s *FQCodelQdiscConfig) Flows() uint32 {
+	return s.FQCodelFlows
+}
+
+// Target implements config.NetworkFQCodelQdiscConfig interface.
+func (s *FQCodelQdiscConfig) Target() time.Duration {
+	return s.FQCodelTarget
+}
+
+// Interval implements config.NetworkFQCodelQdiscConfig interface.
+func (s *FQCodelQdiscConfig) Interval() time.Duration {
+	return s.FQCodelInterval
+}
+
+// ECN implements config.NetworkFQCodelQdiscConfig interface.
+func (s *FQCodelQdiscConfig) ECN() optional.Optional[bool] {
+	if s.FQCodelECN == nil {
+		return optional.None[bool]()
+	}
+
+	return optional.Some(*s.FQCodelECN)
+}
+
+// Bandwidth implements config.NetworkCAKEQdiscConfig interface.
+func (s *CAKEQdiscConfig) Bandwidth() uint64 {
+	return s.CAKEBandwidth.Value()
+}
+
+// RTT implements config.NetworkCAKEQdiscConfig interface.
+func (s *CAKEQdiscConfig) RTT() time.Duration {
+	return s.CAKERTT
+}
+
+// Rate implements config.NetworkTBFQdiscConfig interface.
+func (s *TBFQdiscConfig) Rate() uint64 {
+	return s.TBFRate.Value()
+}
+
+// Burst implements config.NetworkTBFQdiscConfig interface.
+func (s *TBFQdiscConfig) Burst() uint32 {
+	return s.TBFBurst
+}
+
+// Latency implements config.NetworkTBFQdiscConfig interface.
+func (s *TBFQdiscConfig) Latency() time.Duration {
+	return s.TBFLatency
+}
+
+// Rate implements config.NetworkHTBQdiscConfig interface.
+func (s *HTBQdiscConfig) Rate() uint64 {
+	return s.HTBRate.Value()
+}
+
+// DefaultClass implements config.NetworkHTBQdiscConfig interface.
+func (s *HTBQdiscConfig) DefaultClass() uint16 {
+	return s.HTBDefaultClass
+}
+
+// Classes implements config.NetworkHTBQdiscConfig interface.
+func (s *HTBQdiscConfig) Classes() []config.NetworkHTBClassConfig {
+	classes := make([]config.NetworkHTBClassConfig, 0, len(s.HTBClasses))
+
+	for i := range s.HTBClasses {
+		classes = append(classes, &s.HTBClasses[i])
+	}
+
+	return classes
+}
+
+// ID implements config.NetworkHTBClassConfig interface.
+func (s *HTBClassConfig) ID() uint16 {
+	return s.HTBClassID
+}
+
+// Rate implements config.NetworkHTBClassConfig interface.
+func (s *HTBClassConfig) Rate() uint64 {
+	return s.HTBClassRate.Value()
+}
+
+// Ceil implements config.NetworkHTBClassConfig interface.
+func (s *HTBClassConfig) Ceil() uint64 {
+	return s.HTBClassCeil.Value()
+}
+
+// Priority implements config.NetworkHTBClassConfig interface.
+func (s *HTBClassConfig) Priority() uint32 {
+	return s.HTBClassPriority
+}
+
+// FirewallMark implements config.NetworkHTBClassConfig interface.
+func (s *HTBClassConfig) FirewallMark() uint32 {
+	return s.HTBClassFirewallMark
+}
+
+// Delay implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Delay() time.Duration {
+	return s.NetemDelay
+}
+
+// Jitter implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Jitter() time.Duration {
+	return s.NetemJitter
+}
+
+// Loss implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Loss() float64 {
+	return s.NetemLoss
+}
+
+// Duplicate implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Duplicate() float64 {
+	return s.NetemDuplicate
+}
+
+// Corrupt implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Corrupt() float64 {
+	return s.NetemCorrupt
+}
+
+// Reorder implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Reorder() float64 {
+	return s.NetemReorder
+}
+
+// Rate implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Rate() uint64 {
+	return s.NetemRate.Value()
+}
+
+// Limit implements config.NetworkNetemQdiscConfig interface.
+func (s *NetemQdiscConfig) Limit() uint32 {
+	return s.NetemLimit
+}
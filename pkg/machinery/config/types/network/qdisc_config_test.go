@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/qdiscconfig.yaml
+var expectedQdiscConfigDocument []byte
+
+func TestQdiscConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewQdiscConfigV1Alpha1()
+	cfg.MetaName = "enp1s0"
+	cfg.QdiscHTB = &network.HTBQdiscConfig{
+		HTBRate:         network.MustBandwidth("10Gbit"),
+		HTBDefaultClass: 20,
+		HTBClasses: []network.HTBClassConfig{
+			{
+				HTBClassID:           10,
+				HTBClassRate:         network.MustBandwidth("200Mbit"),
+				HTBClassCeil:         network.MustBandwidth("1Gbit"),
+				HTBClassPriority:     1,
+				HTBClassFirewallMark: 0x10,
+			},
+			{
+				HTBClassID:   20,
+				HTBClassRate: network.MustBandwidth("9Gbit"),
+			},
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedQdiscConfigDocument, marshaled)
+}
+
+func TestQdiscConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedQdiscConfigDocument)
+	require.NoError(t, err)
+
+	configs := provider.NetworkQdiscConfigs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "enp1s0", configs[0].Name())
+	assert.False(t, configs[0].TBF().IsPresent())
+
+	htb, ok := configs[0].HTB().Get()
+	require.True(t, ok)
+
+	assert.Equal(t, uint64(10_000_000_000), htb.Rate())
+	assert.Equal(t, uint16(20), htb.DefaultClass())
+
+	classes := htb.Classes()
+	require.Len(t, classes, 2)
+
+	assert.Equal(t, uint16(10), classes[0].ID())
+	assert.Equal(t, uint64(200_000_000), classes[0].Rate())
+	assert.Equal(t, uint64(1_000_000_000), classes[0].Ceil())
+	assert.Equal(t, uint32(1), classes[0].Priority())
+	assert.Equal(t, uint32(0x10), classes[0].FirewallMark())
+
+	assert.Equal(t, uint16(20), classes[1].ID())
+	assert.Equal(t, uint64(9_000_000_000), classes[1].Rate())
+	assert.Zero(t, classes[1].Ceil())
+}
+
+func TestQdiscConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.QdiscConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewQdiscConfigV1Alpha1,
+
+			expectedError: "name is required\nexactly one of fq, fqCodel, cake, tbf, htb or netem should be specified",
+		},
+		{
+			name: "multiple types",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscFQ = &network.FQQdiscConfig{}
+				cfg.QdiscFQCodel = &network.FQCodelQdiscConfig{}
+
+				return cfg
+			},
+
+			expectedError: "exactly one of fq, fqCodel, cake, tbf, htb or netem should be specified",
+		},
+		{
+			name: "invalid tbf",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscTBF = &network.TBFQdiscConfig{
+					TBFLatency: -time.Second,
+				}
+
+				return cfg
+			},
+
+			expectedError: "tbf rate is required\ntbf latency -1s should be between 0 and 1h0m0s",
+		},
+		{
+			name: "tbf rate too large",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscTBF = &network.TBFQdiscConfig{
+					TBFRate: network.MustBandwidth("100Gbit"),
+				}
+
+				return cfg
+			},
+
+			expectedError: "tbf rate should not exceed 34359738360 bit/s",
+		},
+		{
+			name: "invalid htb",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "bond0"
+				cfg.QdiscHTB = &network.HTBQdiscConfig{
+					HTBRate:         network.MustBandwidth("1Gbit"),
+					HTBDefaultClass: 30,
+					HTBClasses: []network.HTBClassConfig{
+						{
+							HTBClassID:           1,
+							HTBClassRate:         network.MustBandwidth("100Mbit"),
+							HTBClassFirewallMark: 0x10,
+						},
+						{
+							HTBClassID:           10,
+							HTBClassRate:         network.MustBandwidth("200Mbit"),
+							HTBClassCeil:         network.MustBandwidth("10Gbit"),
+							HTBClassPriority:     8,
+							HTBClassFirewallMark: 0x10,
+						},
+						{
+							HTBClassID: 10,
+						},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "htb class 1: id should be between 2 and 65535\n" +
+				"htb class 10: ceil should be between the class rate and the total rate\n" +
+				"htb class 10: priority should be between 0 and 7\n" +
+				"htb class 10: duplicate firewall mark 0x10\n" +
+				"htb class 10: duplicate id\n" +
+				"htb class 10: rate is required\n" +
+				"htb default class 30 is not defined",
+		},
+		{
+			name: "invalid netem",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscNetem = &network.NetemQdiscConfig{
+					NetemJitter:  time.Second,
+					NetemLoss:    120,
+					NetemReorder: 25,
+				}
+
+				return cfg
+			},
+
+			expectedError:    "netem loss should be between 0 and 100\nnetem jitter should not exceed the delay",
+			expectedWarnings: []string{"netem reorder has no effect without the delay"},
+		},
+		{
+			name: "valid fq_codel",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscFQCodel = &network.FQCodelQdiscConfig{
+					FQCodelTarget:   5 * time.Millisecond,
+					FQCodelInterval: 100 * time.Millisecond,
+					FQCodelECN:      pointer.To(false),
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "valid netem",
+			cfg: func() *network.QdiscConfigV1Alpha1 {
+				cfg := network.NewQdiscConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.QdiscNetem = &network.NetemQdiscConfig{
+					NetemDelay:   100 * time.Millisecond,
+					NetemJitter:  10 * time.Millisecond,
+					NetemLoss:    0.5,
+					NetemReorder: 25,
+					NetemRate:    network.MustBandwidth("50Mbit"),
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
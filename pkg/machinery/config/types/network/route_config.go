@@ -0,0 +1,284 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// RouteConfigKind is a route config document kind.
+const RouteConfigKind = "RouteConfig"
+
+func init() {
+	registry.Register(RouteConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &RouteConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRouteConfig = &RouteConfigV1Alpha1{}
+	_ config.NamedDocument      = &RouteConfigV1Alpha1{}
+	_ config.Validator          = &RouteConfigV1Alpha1{}
+)
+
+// RouteConfigV1Alpha1 is a static network route configuration document.
+//
+//	examples:
+//	  - value: exampleRouteConfigV1Alpha1()
+//	alias: RouteConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/RouteConfig
+type RouteConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the link to route the traffic through.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         "uplink0"
+	//   schemaRequired: true
+	RouteLink string `yaml:"link"`
+	//   description: |
+	//     The route destination as an IP prefix.
+	//
+	//     If not set, the route is a default route.
+	//   examples:
+	//     - value: >
+	//         netip.MustParsePrefix("10.0.0.0/8")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	RouteDestination Prefix `yaml:"destination,omitempty"`
+	//   description: |
+	//     The route gateway.
+	//   examples:
+	//     - value: >
+	//         netip.MustParseAddr("10.0.0.1")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	RouteGateway Addr `yaml:"gateway,omitempty"`
+	//   description: |
+	//     The route source address.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	RouteSource Addr `yaml:"source,omitempty"`
+	//   description: |
+	//     The route metric (priority).
+	//
+	//     Defaults to 1024.
+	RouteMetric uint32 `yaml:"metric,omitempty"`
+	//   description: |
+	//     The route MTU.
+	RouteMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     The routing table to add the route to.
+	//
+	//     Defaults to the main routing table.
+	//     Custom routing tables are used with the `RoutingRuleConfig` documents (policy routing).
+	//   examples:
+	//     - value: >
+	//         uint32(100)
+	RouteTable uint32 `yaml:"table,omitempty"`
+	//   description: |
+	//     Name of the `NetworkProbeConfig` document which gates the route.
+	//
+	//     When the probe fails, the route is withdrawn (or its metric is raised, see `probeFailureMetric`),
+	//     and the route is restored when the probe recovers.
+	//     Until the probe reports the first result, the route is considered healthy.
+	//   examples:
+	//     - value: >
+	//         "uplink0-gateway"
+	RouteProbe string `yaml:"probe,omitempty"`
+	//   description: |
+	//     The route metric (priority) to use when the probe fails.
+	//
+	//     If not set, the route is withdrawn when the probe fails.
+	//     Required for routes via a gateway, as the probe bound to the link might not reach its target
+	//     once the route is withdrawn.
+	//   examples:
+	//     - value: >
+	//         uint32(4096)
+	RouteProbeFailureMetric uint32 `yaml:"probeFailureMetric,omitempty"`
+}
+
+// Addr is a wrapper for netip.Addr.
+//
+// It implements IsZero() so that yaml.Marshal correctly skips empty values.
+//
+//docgen:nodoc
+type Addr struct {
+	netip.Addr
+}
+
+// IsZero implements yaml.IsZeroer interface.
+func (n Addr) IsZero() bool {
+	return n.Addr == netip.Addr{}
+}
+
+// NewRouteConfigV1Alpha1 creates a new RouteConfig config document.
+func NewRouteConfigV1Alpha1() *RouteConfigV1Alpha1 {
+	return &RouteConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RouteConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleRouteConfigV1Alpha1() *RouteConfigV1Alpha1 {
+	cfg := NewRouteConfigV1Alpha1()
+	cfg.MetaName = "default-uplink0"
+	cfg.RouteLink = "uplink0"
+	cfg.RouteGateway = Addr{netip.MustParseAddr("192.168.1.1")}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *RouteConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *RouteConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkRouteConfigSignal implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) NetworkRouteConfigSignal() {}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *RouteConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if s.RouteLink == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("route link is required"))
+	}
+
+	if s.RouteDestination.IsValid() && s.RouteGateway.IsValid() && s.RouteDestination.Addr().Is4() != s.RouteGateway.Is4() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("route destination %s and gateway %s address families don't match", s.RouteDestination, s.RouteGateway))
+	}
+
+	if s.RouteSource.IsValid() && s.RouteGateway.IsValid() && s.RouteSource.Is4() != s.RouteGateway.Is4() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("route source %s and gateway %s address families don't match", s.RouteSource, s.RouteGateway))
+	}
+
+	if s.RouteTable > maxRoutingTable {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("route table %d should be less than or equal to %d", s.RouteTable, maxRoutingTable))
+	}
+
+	if s.RouteProbeFailureMetric != 0 && s.RouteProbe == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("probe failure metric requires probe to be set"))
+	}
+
+	// a probe bound to the link might only reach its target via the gateway, so the route can't be withdrawn
+	if s.RouteProbe != "" && s.RouteProbeFailureMetric == 0 && s.RouteGateway.IsValid() {
+		validationErrors = errors.Join(validationErrors, errors.New("probe failure metric is required for routes via a gateway"))
+	}
+
+	return nil, validationErrors
+}
+
+// Link implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Link() string {
+	return s.RouteLink
+}
+
+// Destination implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Destination() optional.Optional[netip.Prefix] {
+	if !s.RouteDestination.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(s.RouteDestination.Prefix)
+}
+
+// Gateway implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Gateway() optional.Optional[netip.Addr] {
+	if !s.RouteGateway.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.RouteGateway.Addr)
+}
+
+// Source implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Source() optional.Optional[netip.Addr] {
+	if !s.RouteSource.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.RouteSource.Addr)
+}
+
+// Metric implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Metric() uint32 {
+	return s.RouteMetric
+}
+
+// MTU implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) MTU() uint32 {
+	return s.RouteMTU
+}
+
+// Table implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Table() nethelpers.RoutingTable {
+	if s.RouteTable == 0 {
+		return nethelpers.TableMain
+	}
+
+	return nethelpers.RoutingTable(s.RouteTable)
+}
+
+// Probe implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) Probe() optional.Optional[string] {
+	if s.RouteProbe == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.RouteProbe)
+}
+
+// ProbeFailureMetric implements config.NetworkRouteConfig interface.
+func (s *RouteConfigV1Alpha1) ProbeFailureMetric() optional.Optional[uint32] {
+	if s.RouteProbeFailureMetric == 0 {
+		return optional.None[uint32]()
+	}
+
+	return optional.Some(s.RouteProbeFailureMetric)
+}
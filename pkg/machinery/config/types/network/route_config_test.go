@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/routeconfig.yaml
+var expectedRouteConfigDocument []byte
+
+func TestRouteConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRouteConfigV1Alpha1()
+	cfg.MetaName = "private"
+	cfg.RouteLink = "uplink0"
+	cfg.RouteDestination = network.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	cfg.RouteGateway = network.Addr{netip.MustParseAddr("192.168.1.1")}
+	cfg.RouteMetric = 100
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedRouteConfigDocument, marshaled)
+}
+
+func TestRouteConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedRouteConfigDocument)
+	require.NoError(t, err)
+
+	routes := provider.NetworkRouteConfigs()
+	require.Len(t, routes, 1)
+
+	assert.Equal(t, "private", routes[0].Name())
+	assert.Equal(t, "uplink0", routes[0].Link())
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), routes[0].Destination().ValueOrZero())
+	assert.Equal(t, netip.MustParseAddr("192.168.1.1"), routes[0].Gateway().ValueOrZero())
+	assert.False(t, routes[0].Source().IsPresent())
+	assert.Equal(t, uint32(100), routes[0].Metric())
+	assert.Equal(t, nethelpers.TableMain, routes[0].Table())
+}
+
+func TestRouteConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RouteConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRouteConfigV1Alpha1,
+
+			expectedError: "name is required\nroute link is required",
+		},
+		{
+			name: "family mismatch",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "default"
+				cfg.RouteLink = "eth0"
+				cfg.RouteDestination = network.Prefix{netip.MustParsePrefix("2001:db8::/32")}
+				cfg.RouteGateway = network.Addr{netip.MustParseAddr("10.0.0.1")}
+
+				return cfg
+			},
+
+			expectedError: "route destination 2001:db8::/32 and gateway 10.0.0.1 address families don't match",
+		},
+		{
+			name: "probe failure metric without probe",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "default"
+				cfg.RouteLink = "eth0"
+				cfg.RouteGateway = network.Addr{netip.MustParseAddr("10.0.0.1")}
+				cfg.RouteProbeFailureMetric = 4096
+
+				return cfg
+			},
+
+			expectedError: "probe failure metric requires probe to be set",
+		},
+		{
+			name: "probe without failure metric via gateway",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "default"
+				cfg.RouteLink = "eth0"
+				cfg.RouteGateway = network.Addr{netip.MustParseAddr("10.0.0.1")}
+				cfg.RouteProbe = "gateway"
+
+				return cfg
+			},
+
+			expectedError: "probe failure metric is required for routes via a gateway",
+		},
+		{
+			name: "probe without failure metric on link",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "lan"
+				cfg.RouteLink = "eth0"
+				cfg.RouteDestination = network.Prefix{netip.MustParsePrefix("10.0.0.0/24")}
+				cfg.RouteProbe = "lan"
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid table",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "default"
+				cfg.RouteLink = "eth0"
+				cfg.RouteTable = 1000
+
+				return cfg
+			},
+
+			expectedError: "route table 1000 should be less than or equal to 255",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.RouteConfigV1Alpha1 {
+				cfg := network.NewRouteConfigV1Alpha1()
+				cfg.MetaName = "default"
+				cfg.RouteLink = "eth0"
+				cfg.RouteGateway = network.Addr{netip.MustParseAddr("10.0.0.1")}
+				cfg.RouteProbe = "gateway"
+				cfg.RouteProbeFailureMetric = 4096
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
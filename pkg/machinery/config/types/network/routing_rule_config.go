@@ -0,0 +1,242 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// RoutingRuleConfigKind is a routing rule config document kind.
+const RoutingRuleConfigKind = "RoutingRuleConfig"
+
+const (
+	// Priorities 0, 32766 and 32767 are used by the default kernel rules.
+	minRoutingRulePriority = 1
+	maxRoutingRulePriority = 32765
+
+	maxRoutingTable = 255
+)
+
+func init() {
+	registry.Register(RoutingRuleConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &RoutingRuleConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRoutingRuleConfig = &RoutingRuleConfigV1Alpha1{}
+	_ config.NamedDocument            = &RoutingRuleConfigV1Alpha1{}
+	_ config.Validator                = &RoutingRuleConfigV1Alpha1{}
+)
+
+// RoutingRuleConfigV1Alpha1 is a routing rule (policy routing) configuration document.
+//
+//	examples:
+//	  - value: exampleRoutingRuleConfigV1Alpha1()
+//	alias: RoutingRuleConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/RoutingRuleConfig
+type RoutingRuleConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The rule priority.
+	//
+	//     Rules are evaluated in the order of the priority (lower value first).
+	//     The priority should be unique for each address family, and be in the range 1-32765.
+	//   examples:
+	//     - value: >
+	//         uint32(1000)
+	//   schemaRequired: true
+	RulePriority uint32 `yaml:"priority"`
+	//   description: |
+	//     The source prefix to match.
+	//   examples:
+	//     - value: >
+	//         netip.MustParsePrefix("10.10.0.0/24")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	RuleSource Prefix `yaml:"source,omitempty"`
+	//   description: |
+	//     The destination prefix to match.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	RuleDestination Prefix `yaml:"destination,omitempty"`
+	//   description: |
+	//     The input link name to match.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	RuleIIF string `yaml:"iif,omitempty"`
+	//   description: |
+	//     The output link name to match.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	RuleOIF string `yaml:"oif,omitempty"`
+	//   description: |
+	//     The firewall mark to match.
+	RuleFwMark uint32 `yaml:"fwMark,omitempty"`
+	//   description: |
+	//     The firewall mark mask.
+	//
+	//     Defaults to matching all bits of the mark.
+	RuleFwMask uint32 `yaml:"fwMask,omitempty"`
+	//   description: |
+	//     The routing table to look up the route in, if the rule matches.
+	//
+	//     The routes can be added to the table with the `table` field of the `RouteConfig` document.
+	//   examples:
+	//     - value: >
+	//         uint32(100)
+	//   schemaRequired: true
+	RuleTable uint32 `yaml:"table"`
+}
+
+// NewRoutingRuleConfigV1Alpha1 creates a new RoutingRuleConfig config document.
+func NewRoutingRuleConfigV1Alpha1() *RoutingRuleConfigV1Alpha1 {
+	return &RoutingRuleConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       RoutingRuleConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleRoutingRuleConfigV1Alpha1() *RoutingRuleConfigV1Alpha1 {
+	cfg := NewRoutingRuleConfigV1Alpha1()
+	cfg.MetaName = "storage"
+	cfg.RulePriority = 1000
+	cfg.RuleSource = Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+	cfg.RuleTable = 100
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *RoutingRuleConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *RoutingRuleConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkRoutingRuleConfigSignal implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) NetworkRoutingRuleConfigSignal() {}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *RoutingRuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if s.RulePriority < minRoutingRulePriority || s.RulePriority > maxRoutingRulePriority {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("rule priority %d should be in the range %d-%d", s.RulePriority, minRoutingRulePriority, maxRoutingRulePriority))
+	}
+
+	if s.RuleTable == 0 || s.RuleTable > maxRoutingTable {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("rule table %d should be in the range 1-%d", s.RuleTable, maxRoutingTable))
+	}
+
+	if s.RuleSource.IsValid() && s.RuleDestination.IsValid() && s.RuleSource.Addr().Is4() != s.RuleDestination.Addr().Is4() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("rule source %s and destination %s address families don't match", s.RuleSource, s.RuleDestination))
+	}
+
+	if s.RuleFwMask != 0 && s.RuleFwMark == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("firewall mark mask requires firewall mark to be set"))
+	}
+
+	return nil, validationErrors
+}
+
+// Priority implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) Priority() uint32 {
+	return s.RulePriority
+}
+
+// Source implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) Source() optional.Optional[netip.Prefix] {
+	if !s.RuleSource.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(s.RuleSource.Prefix)
+}
+
+// Destination implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) Destination() optional.Optional[netip.Prefix] {
+	if !s.RuleDestination.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(s.RuleDestination.Prefix)
+}
+
+// InputLink implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) InputLink() optional.Optional[string] {
+	if s.RuleIIF == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.RuleIIF)
+}
+
+// OutputLink implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) OutputLink() optional.Optional[string] {
+	if s.RuleOIF == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.RuleOIF)
+}
+
+// FwMark implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) FwMark() uint32 {
+	return s.RuleFwMark
+}
+
+// FwMask implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) FwMask() uint32 {
+	if s.RuleFwMark != 0 && s.RuleFwMask == 0 {
+		return 0xffffffff
+	}
+
+	return s.RuleFwMask
+}
+
+// Table implements config.NetworkRoutingRuleConfig interface.
+func (s *RoutingRuleConfigV1Alpha1) Table() nethelpers.RoutingTable {
+	return nethelpers.RoutingTable(s.RuleTable)
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/routingruleconfig.yaml
+var expectedRoutingRuleConfigDocument []byte
+
+func TestRoutingRuleConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRoutingRuleConfigV1Alpha1()
+	cfg.MetaName = "storage"
+	cfg.RulePriority = 1000
+	cfg.RuleSource = network.Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+	cfg.RuleIIF = "storage0"
+	cfg.RuleFwMark = 256
+	cfg.RuleTable = 100
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedRoutingRuleConfigDocument, marshaled)
+}
+
+func TestRoutingRuleConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedRoutingRuleConfigDocument)
+	require.NoError(t, err)
+
+	rules := provider.NetworkRoutingRuleConfigs()
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, "storage", rules[0].Name())
+	assert.Equal(t, uint32(1000), rules[0].Priority())
+	assert.Equal(t, netip.MustParsePrefix("10.10.0.0/24"), rules[0].Source().ValueOrZero())
+	assert.False(t, rules[0].Destination().IsPresent())
+	assert.Equal(t, "storage0", rules[0].InputLink().ValueOrZero())
+	assert.False(t, rules[0].OutputLink().IsPresent())
+	assert.Equal(t, uint32(256), rules[0].FwMark())
+	assert.Equal(t, uint32(0xffffffff), rules[0].FwMask())
+	assert.Equal(t, nethelpers.RoutingTable(100), rules[0].Table())
+}
+
+func TestRoutingRuleConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.RoutingRuleConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewRoutingRuleConfigV1Alpha1,
+
+			expectedError: "name is required\nrule priority 0 should be in the range 1-32765\nrule table 0 should be in the range 1-255",
+		},
+		{
+			name: "family mismatch",
+			cfg: func() *network.RoutingRuleConfigV1Alpha1 {
+				cfg := network.NewRoutingRuleConfigV1Alpha1()
+				cfg.MetaName = "storage"
+				cfg.RulePriority = 1000
+				cfg.RuleSource = network.Prefix{netip.MustParsePrefix("10.10.0.0/24")}
+				cfg.RuleDestination = network.Prefix{netip.MustParsePrefix("2001:db8::/32")}
+				cfg.RuleTable = 100
+
+				return cfg
+			},
+
+			expectedError: "rule source 10.10.0.0/24 and destination 2001:db8::/32 address families don't match",
+		},
+		{
+			name: "mask without mark",
+			cfg: func() *network.RoutingRuleConfigV1Alpha1 {
+				cfg := network.NewRoutingRuleConfigV1Alpha1()
+				cfg.MetaName = "marked"
+				cfg.RulePriority = 32766
+				cfg.RuleFwMask = 0xff00
+				cfg.RuleTable = 100
+
+				return cfg
+			},
+
+			expectedError: "rule priority 32766 should be in the range 1-32765\nfirewall mark mask requires firewall mark to be set",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.RoutingRuleConfigV1Alpha1 {
+				cfg := network.NewRoutingRuleConfigV1Alpha1()
+				cfg.MetaName = "marked"
+				cfg.RulePriority = 1000
+				cfg.RuleFwMark = 0x100
+				cfg.RuleFwMask = 0xff00
+				cfg.RuleOIF = "eth1"
+				cfg.RuleTable = 100
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"slices"
 
 	"github.com/siderolabs/gen/value"
 	"github.com/siderolabs/gen/xslices"
@@ -57,11 +58,19 @@ type RuleConfigV1Alpha1 struct {
 	//   schemaRequired: true
 	MetaName string `yaml:"name"`
 	//   description: |
-	//     Port selector defines which ports and protocols on the host are affected by the rule.
+	//     Port selector defines which ports and protocols are affected by the rule.
+	//
+	//     For the ingress rules, these are the host ports, for the egress and forward rules, these are the destination ports.
 	PortSelector RulePortSelector `yaml:"portSelector"`
 	//   description: |
 	//     Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.
 	Ingress IngressConfig `yaml:"ingress" merge:"replace"`
+	//   description: |
+	//     Egress defines which destination subnets the host is allowed to access on the ports/protocols defined by the `portSelector`.
+	Egress EgressConfig `yaml:"egress,omitempty" merge:"replace"`
+	//   description: |
+	//     Forward defines which source subnets are allowed to be forwarded by the host to the ports/protocols defined by the `portSelector`.
+	Forward IngressConfig `yaml:"forward,omitempty" merge:"replace"`
 }
 
 // RulePortSelector is a port selector for the network rule.
@@ -119,6 +128,32 @@ type IngressRule struct {
 	Except Prefix `yaml:"except,omitempty"`
 }
 
+// EgressConfig is a egress config.
+//
+//docgen:alias
+type EgressConfig []EgressRule
+
+// EgressRule is a egress rule.
+type EgressRule struct {
+	//   description: |
+	//     Subnet defines a destination subnet.
+	//   examples:
+	//    - value: >
+	//       netip.MustParsePrefix("10.3.4.0/24")
+	//    - value: >
+	//       netip.MustParsePrefix("2001:db8::/32")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Subnet netip.Prefix `yaml:"subnet"`
+	//   description: |
+	//     Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Except Prefix `yaml:"except,omitempty"`
+}
+
 // Prefix is a wrapper for netip.Prefix.
 //
 // It implements IsZero() so that yaml.Marshal correctly skips empty values.
@@ -183,7 +218,7 @@ func (s *RuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Opti
 		return nil, err
 	}
 
-	for _, rule := range s.Ingress {
+	for _, rule := range slices.Concat(s.Ingress, s.Forward) {
 		if !rule.Subnet.IsValid() {
 			return nil, fmt.Errorf("invalid subnet: %s", rule.Subnet)
 		}
@@ -193,6 +228,16 @@ func (s *RuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Opti
 		}
 	}
 
+	for _, rule := range s.Egress {
+		if !rule.Subnet.IsValid() {
+			return nil, fmt.Errorf("invalid egress subnet: %s", rule.Subnet)
+		}
+
+		if !value.IsZero(rule.Except) && !rule.Except.IsValid() {
+			return nil, fmt.Errorf("invalid egress except: %s", rule.Except)
+		}
+	}
+
 	return nil, nil
 }
 
@@ -201,9 +246,33 @@ func (s *RuleConfigV1Alpha1) NetworkRuleConfigSignal() {}
 
 // Rules implements config.NetworkRuleConfigRules interface.
 func (s *RuleConfigV1Alpha1) Rules() []config.NetworkRule {
+	// a document without any subnets is still an ingress rule (no source is allowed to access the ports),
+	// unless the document defines egress or forward rules
+	if len(s.Ingress) == 0 && (len(s.Egress) > 0 || len(s.Forward) > 0) {
+		return nil
+	}
+
 	return []config.NetworkRule{s}
 }
 
+// EgressRules implements config.NetworkRuleConfigRules interface.
+func (s *RuleConfigV1Alpha1) EgressRules() []config.NetworkRule {
+	if len(s.Egress) == 0 {
+		return nil
+	}
+
+	return []config.NetworkRule{ruleEgress{s}}
+}
+
+// ForwardRules implements config.NetworkRuleConfigRules interface.
+func (s *RuleConfigV1Alpha1) ForwardRules() []config.NetworkRule {
+	if len(s.Forward) == 0 {
+		return nil
+	}
+
+	return []config.NetworkRule{ruleForward{s}}
+}
+
 // Protocol implements config.NetworkRule interface.
 func (s *RuleConfigV1Alpha1) Protocol() nethelpers.Protocol {
 	return s.PortSelector.Protocol
@@ -218,16 +287,70 @@ func (s *RuleConfigV1Alpha1) PortRanges() [][2]uint16 {
 
 // Subnets implements config.NetworkRule interface.
 func (s *RuleConfigV1Alpha1) Subnets() []netip.Prefix {
-	return xslices.Map(s.Ingress, func(rule IngressRule) netip.Prefix {
+	return ingressSubnets(s.Ingress)
+}
+
+// ExceptSubnets implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
+	return ingressExceptSubnets(s.Ingress)
+}
+
+// ruleEgress is a view of the rule config as the egress rule.
+//
+//docgen:nodoc
+type ruleEgress struct {
+	*RuleConfigV1Alpha1
+}
+
+// Subnets implements config.NetworkRule interface.
+func (r ruleEgress) Subnets() []netip.Prefix {
+	return xslices.Map(r.Egress, func(rule EgressRule) netip.Prefix {
 		return rule.Subnet
 	})
 }
 
 // ExceptSubnets implements config.NetworkRule interface.
-func (s *RuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
+func (r ruleEgress) ExceptSubnets() []netip.Prefix {
+	return xslices.Map(
+		xslices.Filter(
+			r.Egress,
+			func(rule EgressRule) bool {
+				return rule.Except.IsValid()
+			},
+		),
+		func(rule EgressRule) netip.Prefix {
+			return rule.Except.Prefix
+		},
+	)
+}
+
+// ruleForward is a view of the rule config as the forward rule.
+//
+//docgen:nodoc
+type ruleForward struct {
+	*RuleConfigV1Alpha1
+}
+
+// Subnets implements config.NetworkRule interface.
+func (r ruleForward) Subnets() []netip.Prefix {
+	return ingressSubnets(r.Forward)
+}
+
+// ExceptSubnets implements config.NetworkRule interface.
+func (r ruleForward) ExceptSubnets() []netip.Prefix {
+	return ingressExceptSubnets(r.Forward)
+}
+
+func ingressSubnets(rules []IngressRule) []netip.Prefix {
+	return xslices.Map(rules, func(rule IngressRule) netip.Prefix {
+		return rule.Subnet
+	})
+}
+
+func ingressExceptSubnets(rules []IngressRule) []netip.Prefix {
 	return xslices.Map(
 		xslices.Filter(
-			s.Ingress,
+			rules,
 			func(rule IngressRule) bool {
 				return rule.Except.IsValid()
 			},
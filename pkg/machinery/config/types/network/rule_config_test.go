@@ -147,6 +147,23 @@ func TestRuleConfigValidate(t *testing.T) {
 
 			expectedError: "invalid subnet: invalid Prefix",
 		},
+		{
+			name: "invalid egress subnet",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 443, Hi: 443},
+				}
+				cfg.Egress = network.EgressConfig{
+					{},
+				}
+
+				return cfg
+			},
+
+			expectedError: "invalid egress subnet: invalid Prefix",
+		},
 		{
 			name: "valid",
 			cfg: func() *network.RuleConfigV1Alpha1 {
@@ -186,6 +203,42 @@ func TestRuleConfigValidate(t *testing.T) {
 	}
 }
 
+func TestRuleConfigRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewRuleConfigV1Alpha1()
+	cfg.MetaName = "egress-https"
+	cfg.PortSelector = network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports: network.PortRanges{
+			{Lo: 443, Hi: 443},
+		},
+	}
+	cfg.Egress = network.EgressConfig{
+		{
+			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+			Except: network.Prefix{netip.MustParsePrefix("10.5.0.0/16")},
+		},
+	}
+
+	assert.Empty(t, cfg.Rules())
+	assert.Empty(t, cfg.ForwardRules())
+
+	egressRules := cfg.EgressRules()
+	require.Len(t, egressRules, 1)
+
+	assert.Equal(t, nethelpers.ProtocolTCP, egressRules[0].Protocol())
+	assert.Equal(t, [][2]uint16{{443, 443}}, egressRules[0].PortRanges())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, egressRules[0].Subnets())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.5.0.0/16")}, egressRules[0].ExceptSubnets())
+
+	cfg.Egress = nil
+
+	// legacy behavior: a document without subnets is an ingress rule
+	assert.Len(t, cfg.Rules(), 1)
+	assert.Empty(t, cfg.EgressRules())
+}
+
 type validationMode struct{}
 
 func (validationMode) String() string {
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// SRIOVConfigKind is a SR-IOV config document kind.
+const SRIOVConfigKind = "SRIOVConfig"
+
+func init() {
+	registry.Register(SRIOVConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SRIOVConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkSRIOVConfig = &SRIOVConfigV1Alpha1{}
+	_ config.NamedDocument      = &SRIOVConfigV1Alpha1{}
+	_ config.Validator          = &SRIOVConfigV1Alpha1{}
+)
+
+// maxVFVLANID is the maximum VLAN ID of the virtual function.
+const maxVFVLANID = 4094
+
+// SRIOVConfigV1Alpha1 is a SR-IOV physical function configuration document.
+//
+//	examples:
+//	  - value: exampleSRIOVConfigV1Alpha1()
+//	alias: SRIOVConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SRIOVConfig
+type SRIOVConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the physical function link.
+	//
+	//     If the `selector` is not set, the name is the name of the link (or the name of the `LinkConfig` document with the link selector).
+	//     If the `selector` is set, the name is only used to identify the document.
+	//   examples:
+	//     - value: >
+	//         "enp59s0f0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Selector picks the physical function link by matching the link status,
+	//     e.g. by the driver (`link.driver`) or the PCI address (`link.bus_path`).
+	//
+	//     If multiple links match, the first one (sorted by the link name) is used.
+	LinkSelectorSpec LinkSelector `yaml:"selector,omitempty"`
+	//   description: |
+	//     Number of virtual functions to create.
+	//
+	//     Zero removes all virtual functions of the physical function.
+	//   examples:
+	//     - value: >
+	//         8
+	//   schemaRequired: true
+	SRIOVNumVFs uint32 `yaml:"numVFs"`
+	//   description: |
+	//     Settings of the virtual functions.
+	//
+	//     The virtual functions which are not listed keep the default settings.
+	SRIOVVFs []SRIOVVFConfig `yaml:"vfs,omitempty"`
+}
+
+// SRIOVVFConfig is the configuration of a single virtual function.
+type SRIOVVFConfig struct {
+	//   description: |
+	//     Index of the virtual function (starting with zero).
+	//   schemaRequired: true
+	VFIndex uint32 `yaml:"index"`
+	//   description: |
+	//     MAC address of the virtual function.
+	//
+	//     If not set, the MAC address is not changed.
+	//   examples:
+	//     - value: >
+	//         nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+	//   schema:
+	//     type: string
+	//     pattern: ^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$
+	VFHardwareAddr nethelpers.HardwareAddr `yaml:"mac,omitempty"`
+	//   description: |
+	//     VLAN ID which is transparently tagged by the physical function.
+	//
+	//     If not set, the VLAN tagging is disabled.
+	//   examples:
+	//     - value: >
+	//         100
+	VFVLAN uint16 `yaml:"vlan,omitempty"`
+	//   description: |
+	//     Allow the virtual function to change its MAC address and to enter the promiscuous mode.
+	//
+	//     If not set, the setting is not changed.
+	VFTrust *bool `yaml:"trust,omitempty"`
+	//   description: |
+	//     Drop the packets sent by the virtual function with a spoofed source MAC address.
+	//
+	//     If not set, the setting is not changed.
+	VFSpoofCheck *bool `yaml:"spoofCheck,omitempty"`
+}
+
+// NewSRIOVConfigV1Alpha1 creates a new SRIOVConfig config document.
+func NewSRIOVConfigV1Alpha1() *SRIOVConfigV1Alpha1 {
+	return &SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SRIOVConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSRIOVConfigV1Alpha1() *SRIOVConfigV1Alpha1 {
+	cfg := NewSRIOVConfigV1Alpha1()
+	cfg.MetaName = "sriov-pf0"
+	cfg.LinkSelectorSpec.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.bus_path == "0000:3b:00.0"`, celenv.LinkLocator()))
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVVFs = []SRIOVVFConfig{
+		{
+			VFIndex:        0,
+			VFHardwareAddr: nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+			VFVLAN:         100,
+			VFSpoofCheck:   pointer.To(false),
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SRIOVConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *SRIOVConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkSRIOVConfigSignal implements config.NetworkSRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) NetworkSRIOVConfigSignal() {}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *SRIOVConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if s.LinkSelectorSpec.Match.IsZero() {
+		if err := validateLinkName(s.MetaName); s.MetaName != "" && err != nil {
+			validationErrors = errors.Join(validationErrors, err)
+		}
+	} else {
+		if err := s.LinkSelectorSpec.Match.ParseBool(celenv.LinkLocator()); err != nil {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("link selector is invalid: %w", err))
+		}
+	}
+
+	seenIndexes := map[uint32]struct{}{}
+
+	for _, vf := range s.SRIOVVFs {
+		if vf.VFIndex >= s.SRIOVNumVFs {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("vf %d: index should be less than numVFs (%d)", vf.VFIndex, s.SRIOVNumVFs))
+		}
+
+		if _, seen := seenIndexes[vf.VFIndex]; seen {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("vf %d: duplicate index", vf.VFIndex))
+		}
+
+		seenIndexes[vf.VFIndex] = struct{}{}
+
+		if len(vf.VFHardwareAddr) > 0 && (len(vf.VFHardwareAddr) != 6 || vf.VFHardwareAddr[0]&1 != 0) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("vf %d: MAC address %s should be a unicast Ethernet address", vf.VFIndex, vf.VFHardwareAddr))
+		}
+
+		if vf.VFVLAN > maxVFVLANID {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("vf %d: invalid VLAN ID %d, should be in range 1-%d", vf.VFIndex, vf.VFVLAN, maxVFVLANID))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// Selector implements config.NetworkSRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) Selector() optional.Optional[cel.Expression] {
+	return linkSelector(s.LinkSelectorSpec)
+}
+
+// NumVFs implements config.NetworkSRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) NumVFs() uint32 {
+	return s.SRIOVNumVFs
+}
+
+// VFs implements config.NetworkSRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) VFs() []config.NetworkSRIOVVFConfig {
+	vfs := make([]config.NetworkSRIOVVFConfig, 0, len(s.SRIOVVFs))
+
+	for i := range s.SRIOVVFs {
+		vfs = append(vfs, &s.SRIOVVFs[i])
+	}
+
+	return vfs
+}
+
+// Index implements config.NetworkSRIOVVFConfig interface.
+func (s *SRIOVVFConfig) Index() uint32 {
+	return s.VFIndex
+}
+
+// HardwareAddr implements config.NetworkSRIOVVFConfig interface.
+func (s *SRIOVVFConfig) HardwareAddr() optional.Optional[nethelpers.HardwareAddr] {
+	if len(s.VFHardwareAddr) == 0 {
+		return optional.None[nethelpers.HardwareAddr]()
+	}
+
+	return optional.Some(s.VFHardwareAddr)
+}
+
+// VLAN implements config.NetworkSRIOVVFConfig interface.
+func (s *SRIOVVFConfig) VLAN() uint16 {
+	return s.VFVLAN
+}
+
+// Trust implements config.NetworkSRIOVVFConfig interface.
+func (s *SRIOVVFConfig) Trust() optional.Optional[bool] {
+	if s.VFTrust == nil {
+		return optional.None[bool]()
+	}
+
+	return optional.Some(*s.VFTrust)
+}
+
+// SpoofCheck implements config.NetworkSRIOVVFConfig interface.
+func (s *SRIOVVFConfig) SpoofCheck() optional.Optional[bool] {
+	if s.VFSpoofCheck == nil {
+		return optional.None[bool]()
+	}
+
+	return optional.Some(*s.VFSpoofCheck)
+}
@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/cel"
+	"github.com/siderolabs/talos/pkg/machinery/cel/celenv"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/sriovconfig.yaml
+var expectedSRIOVConfigDocument []byte
+
+func TestSRIOVConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewSRIOVConfigV1Alpha1()
+	cfg.MetaName = "sriov-pf0"
+	cfg.LinkSelectorSpec.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "ice"`, celenv.LinkLocator()))
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVVFs = []network.SRIOVVFConfig{
+		{
+			VFIndex:        0,
+			VFHardwareAddr: nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+			VFVLAN:         100,
+			VFTrust:        pointer.To(true),
+			VFSpoofCheck:   pointer.To(false),
+		},
+		{
+			VFIndex: 3,
+			VFVLAN:  200,
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSRIOVConfigDocument, marshaled)
+}
+
+func TestSRIOVConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSRIOVConfigDocument)
+	require.NoError(t, err)
+
+	configs := provider.NetworkSRIOVConfigs()
+	require.Len(t, configs, 1)
+
+	assert.Equal(t, "sriov-pf0", configs[0].Name())
+	assert.Equal(t, `link.driver == "ice"`, configs[0].Selector().ValueOrZero().String())
+	assert.Equal(t, uint32(4), configs[0].NumVFs())
+
+	vfs := configs[0].VFs()
+	require.Len(t, vfs, 2)
+
+	assert.Equal(t, uint32(0), vfs[0].Index())
+	assert.Equal(t, "02:00:00:00:00:01", vfs[0].HardwareAddr().ValueOrZero().String())
+	assert.Equal(t, uint16(100), vfs[0].VLAN())
+	assert.Equal(t, true, vfs[0].Trust().ValueOrZero())
+	assert.Equal(t, false, vfs[0].SpoofCheck().ValueOr(true))
+
+	assert.Equal(t, uint32(3), vfs[1].Index())
+	assert.False(t, vfs[1].HardwareAddr().IsPresent())
+	assert.Equal(t, uint16(200), vfs[1].VLAN())
+	assert.False(t, vfs[1].Trust().IsPresent())
+	assert.False(t, vfs[1].SpoofCheck().IsPresent())
+}
+
+func TestSRIOVConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.SRIOVConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewSRIOVConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "invalid link name",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1()
+				cfg.MetaName = "this-link-name-is-way-too-long"
+				cfg.SRIOVNumVFs = 2
+
+				return cfg
+			},
+
+			expectedError: "link name \"this-link-name-is-way-too-long\" is too long, maximum length is 15",
+		},
+		{
+			name: "invalid VFs",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1()
+				cfg.MetaName = "pf0"
+				cfg.LinkSelectorSpec.Match = cel.MustExpression(cel.ParseBooleanExpression(`link.driver == "ice"`, celenv.LinkLocator()))
+				cfg.SRIOVNumVFs = 2
+				cfg.SRIOVVFs = []network.SRIOVVFConfig{
+					{
+						VFIndex:        0,
+						VFHardwareAddr: nethelpers.HardwareAddr{0x01, 0x00, 0x5e, 0x00, 0x00, 0x01},
+					},
+					{
+						VFIndex: 0,
+						VFVLAN:  4095,
+					},
+					{
+						VFIndex: 2,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "vf 0: MAC address 01:00:5e:00:00:01 should be a unicast Ethernet address\nvf 0: duplicate index\nvf 0: invalid VLAN ID 4095, should be in range 1-4094\nvf 2: index should be less than numVFs (2)",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1()
+				cfg.MetaName = "enp59s0f0"
+				cfg.SRIOVNumVFs = 8
+				cfg.SRIOVVFs = []network.SRIOVVFConfig{
+					{
+						VFIndex:      7,
+						VFVLAN:       10,
+						VFSpoofCheck: pointer.To(true),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// StaticAddressConfigKind is a static address config document kind.
+const StaticAddressConfigKind = "StaticAddressConfig"
+
+func init() {
+	registry.Register(StaticAddressConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &StaticAddressConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkStaticAddressConfig = &StaticAddressConfigV1Alpha1{}
+	_ config.NamedDocument              = &StaticAddressConfigV1Alpha1{}
+	_ config.Validator                  = &StaticAddressConfigV1Alpha1{}
+)
+
+// StaticAddressConfigV1Alpha1 is a static network address configuration document.
+//
+//	examples:
+//	  - value: exampleStaticAddressConfigV1Alpha1()
+//	alias: StaticAddressConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/StaticAddressConfig
+type StaticAddressConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link to assign the addresses to.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         "uplink0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     List of addresses (in CIDR notation) to assign to the link.
+	//   examples:
+	//     - value: >
+	//         []netip.Prefix{netip.MustParsePrefix("192.168.1.10/24"), netip.MustParsePrefix("2001:db8::10/64")}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+/\d{1,3}$
+	AddressesConfig []netip.Prefix `yaml:"addresses"`
+}
+
+// NewStaticAddressConfigV1Alpha1 creates a new StaticAddressConfig config document.
+func NewStaticAddressConfigV1Alpha1() *StaticAddressConfigV1Alpha1 {
+	return &StaticAddressConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       StaticAddressConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleStaticAddressConfigV1Alpha1() *StaticAddressConfigV1Alpha1 {
+	cfg := NewStaticAddressConfigV1Alpha1()
+	cfg.MetaName = "uplink0"
+	cfg.AddressesConfig = []netip.Prefix{
+		netip.MustParsePrefix("192.168.1.10/24"),
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *StaticAddressConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *StaticAddressConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkStaticAddressConfigSignal implements config.NetworkStaticAddressConfig interface.
+func (s *StaticAddressConfigV1Alpha1) NetworkStaticAddressConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *StaticAddressConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if s.MetaName == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("name is required"))
+	}
+
+	if len(s.AddressesConfig) == 0 {
+		validationErrors = errors.Join(validationErrors, errors.New("at least one address is required"))
+	}
+
+	for _, address := range s.AddressesConfig {
+		if !address.IsValid() {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid address: %s", address))
+		}
+	}
+
+	return nil, validationErrors
+}
+
+// Link implements config.NetworkStaticAddressConfig interface.
+func (s *StaticAddressConfigV1Alpha1) Link() string {
+	return s.MetaName
+}
+
+// Addresses implements config.NetworkStaticAddressConfig interface.
+func (s *StaticAddressConfigV1Alpha1) Addresses() []netip.Prefix {
+	return s.AddressesConfig
+}
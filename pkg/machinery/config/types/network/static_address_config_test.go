@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/staticaddressconfig.yaml
+var expectedStaticAddressConfigDocument []byte
+
+func TestStaticAddressConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewStaticAddressConfigV1Alpha1()
+	cfg.MetaName = "uplink0"
+	cfg.AddressesConfig = []netip.Prefix{
+		netip.MustParsePrefix("192.168.1.10/24"),
+		netip.MustParsePrefix("2001:db8::10/64"),
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedStaticAddressConfigDocument, marshaled)
+}
+
+func TestStaticAddressConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedStaticAddressConfigDocument)
+	require.NoError(t, err)
+
+	addresses := provider.NetworkStaticAddressConfigs()
+	require.Len(t, addresses, 1)
+
+	assert.Equal(t, "uplink0", addresses[0].Link())
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("192.168.1.10/24"),
+		netip.MustParsePrefix("2001:db8::10/64"),
+	}, addresses[0].Addresses())
+}
+
+func TestStaticAddressConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.StaticAddressConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewStaticAddressConfigV1Alpha1,
+
+			expectedError: "name is required\nat least one address is required",
+		},
+		{
+			name: "invalid address",
+			cfg: func() *network.StaticAddressConfigV1Alpha1 {
+				cfg := network.NewStaticAddressConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.AddressesConfig = []netip.Prefix{{}}
+
+				return cfg
+			},
+
+			expectedError: "invalid address: invalid Prefix",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.StaticAddressConfigV1Alpha1 {
+				cfg := network.NewStaticAddressConfigV1Alpha1()
+				cfg.MetaName = "eth0"
+				cfg.AddressesConfig = []netip.Prefix{netip.MustParsePrefix("10.0.0.2/8")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
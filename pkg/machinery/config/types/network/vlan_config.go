@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// VLANConfigKind is a VLAN config document kind.
+const VLANConfigKind = "VLANConfig"
+
+func init() {
+	registry.Register(VLANConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &VLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkVLANConfig = &VLANConfigV1Alpha1{}
+	_ config.NamedDocument     = &VLANConfigV1Alpha1{}
+	_ config.Validator         = &VLANConfigV1Alpha1{}
+)
+
+// VLANConfigV1Alpha1 is a VLAN network link configuration document.
+//
+//	examples:
+//	  - value: exampleVLANConfigV1Alpha1()
+//	alias: VLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/VLANConfig
+type VLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the VLAN link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "uplink0.100"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the parent link.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	//   schemaRequired: true
+	VLANParent string `yaml:"parent"`
+	//   description: |
+	//     VLAN ID.
+	//   examples:
+	//     - value: >
+	//         100
+	//   schemaRequired: true
+	VLANIDConfig uint16 `yaml:"vlanID"`
+	//   description: |
+	//     VLAN protocol.
+	//
+	//     Defaults to `802.1q`.
+	//   values:
+	//     - "802.1q"
+	//     - "802.1ad"
+	VLANModeConfig nethelpers.VLANProtocol `yaml:"vlanMode,omitempty"`
+	//   description: |
+	//     Bring the VLAN link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the VLAN link MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewVLANConfigV1Alpha1 creates a new VLANConfig config document.
+func NewVLANConfigV1Alpha1() *VLANConfigV1Alpha1 {
+	return &VLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       VLANConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleVLANConfigV1Alpha1() *VLANConfigV1Alpha1 {
+	cfg := NewVLANConfigV1Alpha1()
+	cfg.MetaName = "uplink0.100"
+	cfg.VLANParent = "uplink0"
+	cfg.VLANIDConfig = 100
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *VLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *VLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkVLANConfigSignal implements config.NetworkVLANConfig interface.
+func (s *VLANConfigV1Alpha1) NetworkVLANConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *VLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.VLANParent == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("parent link is required"))
+	}
+
+	if s.VLANIDConfig == 0 || s.VLANIDConfig > 4094 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid VLAN ID %d, should be in range 1-4094", s.VLANIDConfig))
+	}
+
+	switch s.VLANModeConfig {
+	case 0, nethelpers.VLANProtocol8021Q, nethelpers.VLANProtocol8021AD:
+	default:
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid VLAN mode %s", s.VLANModeConfig))
+	}
+
+	return nil, validationErrors
+}
+
+// ParentLink implements config.NetworkVLANConfig interface.
+func (s *VLANConfigV1Alpha1) ParentLink() string {
+	return s.VLANParent
+}
+
+// VLANID implements config.NetworkVLANConfig interface.
+func (s *VLANConfigV1Alpha1) VLANID() uint16 {
+	return s.VLANIDConfig
+}
+
+// VLANMode implements config.NetworkVLANConfig interface.
+func (s *VLANConfigV1Alpha1) VLANMode() nethelpers.VLANProtocol {
+	if s.VLANModeConfig == 0 {
+		return nethelpers.VLANProtocol8021Q
+	}
+
+	return s.VLANModeConfig
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *VLANConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *VLANConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/vlanconfig.yaml
+var expectedVLANConfigDocument []byte
+
+func TestVLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewVLANConfigV1Alpha1()
+	cfg.MetaName = "uplink0.100"
+	cfg.VLANParent = "uplink0"
+	cfg.VLANIDConfig = 100
+	cfg.VLANModeConfig = nethelpers.VLANProtocol8021AD
+	cfg.LinkMTU = 1500
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedVLANConfigDocument, marshaled)
+}
+
+func TestVLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedVLANConfigDocument)
+	require.NoError(t, err)
+
+	vlans := provider.NetworkVLANConfigs()
+	require.Len(t, vlans, 1)
+
+	assert.Equal(t, "uplink0.100", vlans[0].Name())
+	assert.Equal(t, "uplink0", vlans[0].ParentLink())
+	assert.Equal(t, uint16(100), vlans[0].VLANID())
+	assert.Equal(t, nethelpers.VLANProtocol8021AD, vlans[0].VLANMode())
+	assert.Equal(t, uint32(1500), vlans[0].MTU().ValueOrZero())
+}
+
+func TestVLANConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.VLANConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewVLANConfigV1Alpha1,
+
+			expectedError: "link name is required\nparent link is required\ninvalid VLAN ID 0, should be in range 1-4094",
+		},
+		{
+			name: "invalid VLAN ID",
+			cfg: func() *network.VLANConfigV1Alpha1 {
+				cfg := network.NewVLANConfigV1Alpha1()
+				cfg.MetaName = "eth0.5000"
+				cfg.VLANParent = "eth0"
+				cfg.VLANIDConfig = 5000
+
+				return cfg
+			},
+
+			expectedError: "invalid VLAN ID 5000, should be in range 1-4094",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.VLANConfigV1Alpha1 {
+				cfg := network.NewVLANConfigV1Alpha1()
+				cfg.MetaName = "eth0.5"
+				cfg.VLANParent = "eth0"
+				cfg.VLANIDConfig = 5
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// VXLANConfigKind is a VXLAN config document kind.
+const VXLANConfigKind = "VXLANConfig"
+
+// DefaultVXLANPort is the IANA-assigned VXLAN UDP port.
+const DefaultVXLANPort = 4789
+
+// maxVNI is the maximum VXLAN and GENEVE network identifier (24 bits).
+const maxVNI = 1<<24 - 1
+
+func init() {
+	registry.Register(VXLANConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &VXLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkVXLANConfig = &VXLANConfigV1Alpha1{}
+	_ config.NamedDocument      = &VXLANConfigV1Alpha1{}
+	_ config.Validator          = &VXLANConfigV1Alpha1{}
+)
+
+// VXLANConfigV1Alpha1 is a VXLAN network link configuration document.
+//
+//	examples:
+//	  - value: exampleVXLANConfigV1Alpha1()
+//	alias: VXLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/VXLANConfig
+type VXLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the VXLAN link (interface) to create.
+	//   examples:
+	//     - value: >
+	//         "vxlan100"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the underlying link to send the encapsulated packets through.
+	//
+	//     The name might be either the link name, or the name of the `LinkConfig` document with the link selector.
+	//     Required if the remote address is a multicast group.
+	//   examples:
+	//     - value: >
+	//         "enp0s2"
+	VXLANParent string `yaml:"parent,omitempty"`
+	//   description: |
+	//     VXLAN network identifier (VNI).
+	//   examples:
+	//     - value: >
+	//         100
+	//   schemaRequired: true
+	VXLANVNI uint32 `yaml:"vni"`
+	//   description: |
+	//     Remote address to send the encapsulated packets to.
+	//
+	//     Either a unicast address of the remote endpoint, or a multicast group address.
+	//   examples:
+	//     - value: >
+	//         netip.MustParseAddr("239.1.1.1")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	VXLANRemote Addr `yaml:"remote,omitempty"`
+	//   description: |
+	//     Source address of the encapsulated packets.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	VXLANLocal Addr `yaml:"local,omitempty"`
+	//   description: |
+	//     Destination UDP port.
+	//
+	//     Defaults to 4789.
+	VXLANPort uint16 `yaml:"port,omitempty"`
+	//   description: |
+	//     Bring the VXLAN link up or keep it down.
+	//
+	//     Defaults to `true`.
+	LinkUp *bool `yaml:"up,omitempty"`
+	//   description: |
+	//     Configure the VXLAN link MTU.
+	LinkMTU uint32 `yaml:"mtu,omitempty"`
+}
+
+// NewVXLANConfigV1Alpha1 creates a new VXLANConfig config document.
+func NewVXLANConfigV1Alpha1() *VXLANConfigV1Alpha1 {
+	return &VXLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       VXLANConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleVXLANConfigV1Alpha1() *VXLANConfigV1Alpha1 {
+	cfg := NewVXLANConfigV1Alpha1()
+	cfg.MetaName = "vxlan100"
+	cfg.VXLANParent = "uplink0"
+	cfg.VXLANVNI = 100
+	cfg.VXLANRemote = Addr{netip.MustParseAddr("239.1.1.1")}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *VXLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *VXLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// NetworkVXLANConfigSignal implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) NetworkVXLANConfigSignal() {}
+
+// Validate implements config.Validator interface.
+func (s *VXLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var validationErrors error
+
+	if err := validateLinkName(s.MetaName); err != nil {
+		validationErrors = errors.Join(validationErrors, err)
+	}
+
+	if s.VXLANVNI == 0 || s.VXLANVNI > maxVNI {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid VNI %d, should be in range 1-%d", s.VXLANVNI, maxVNI))
+	}
+
+	if s.VXLANRemote.IsMulticast() && s.VXLANParent == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("parent link is required for the multicast remote address"))
+	}
+
+	if s.VXLANRemote.IsValid() && s.VXLANLocal.IsValid() && s.VXLANRemote.Is4() != s.VXLANLocal.Is4() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("remote %s and local %s address families don't match", s.VXLANRemote, s.VXLANLocal))
+	}
+
+	return nil, validationErrors
+}
+
+// ParentLink implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) ParentLink() optional.Optional[string] {
+	if s.VXLANParent == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.VXLANParent)
+}
+
+// VNI implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) VNI() uint32 {
+	return s.VXLANVNI
+}
+
+// Remote implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Remote() netip.Addr {
+	return s.VXLANRemote.Addr
+}
+
+// Local implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Local() netip.Addr {
+	return s.VXLANLocal.Addr
+}
+
+// Port implements config.NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Port() uint16 {
+	if s.VXLANPort == 0 {
+		return DefaultVXLANPort
+	}
+
+	return s.VXLANPort
+}
+
+// Up implements config.NetworkCommonLinkConfig interface.
+func (s *VXLANConfigV1Alpha1) Up() bool {
+	return linkUp(s.LinkUp)
+}
+
+// MTU implements config.NetworkCommonLinkConfig interface.
+func (s *VXLANConfigV1Alpha1) MTU() optional.Optional[uint32] {
+	return linkMTU(s.LinkMTU)
+}
@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/vxlanconfig.yaml
+var expectedVXLANConfigDocument []byte
+
+func TestVXLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewVXLANConfigV1Alpha1()
+	cfg.MetaName = "vxlan100"
+	cfg.VXLANParent = "uplink0"
+	cfg.VXLANVNI = 100
+	cfg.VXLANRemote = network.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+	cfg.VXLANLocal = network.Addr{Addr: netip.MustParseAddr("10.5.0.2")}
+	cfg.VXLANPort = 8472
+	cfg.LinkMTU = 1450
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedVXLANConfigDocument, marshaled)
+}
+
+func TestVXLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedVXLANConfigDocument)
+	require.NoError(t, err)
+
+	vxlans := provider.NetworkVXLANConfigs()
+	require.Len(t, vxlans, 1)
+
+	assert.Equal(t, "vxlan100", vxlans[0].Name())
+	assert.Equal(t, "uplink0", vxlans[0].ParentLink().ValueOrZero())
+	assert.Equal(t, uint32(100), vxlans[0].VNI())
+	assert.Equal(t, netip.MustParseAddr("239.1.1.1"), vxlans[0].Remote())
+	assert.Equal(t, netip.MustParseAddr("10.5.0.2"), vxlans[0].Local())
+	assert.Equal(t, uint16(8472), vxlans[0].Port())
+	assert.True(t, vxlans[0].Up())
+	assert.Equal(t, uint32(1450), vxlans[0].MTU().ValueOrZero())
+}
+
+func TestVXLANConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.VXLANConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewVXLANConfigV1Alpha1,
+
+			expectedError: "link name is required\ninvalid VNI 0, should be in range 1-16777215",
+		},
+		{
+			name: "multicast without parent",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1()
+				cfg.MetaName = "vxlan100"
+				cfg.VXLANVNI = 100
+				cfg.VXLANRemote = network.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+				return cfg
+			},
+
+			expectedError: "parent link is required for the multicast remote address",
+		},
+		{
+			name: "address family mismatch",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1()
+				cfg.MetaName = "vxlan100"
+				cfg.VXLANVNI = 16777216
+				cfg.VXLANRemote = network.Addr{Addr: netip.MustParseAddr("2001:db8::1")}
+				cfg.VXLANLocal = network.Addr{Addr: netip.MustParseAddr("10.5.0.2")}
+
+				return cfg
+			},
+
+			expectedError: "invalid VNI 16777216, should be in range 1-16777215\nremote 2001:db8::1 and local 10.5.0.2 address families don't match",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1()
+				cfg.MetaName = "vxlan100"
+				cfg.VXLANVNI = 100
+				cfg.VXLANRemote = network.Addr{Addr: netip.MustParseAddr("10.5.0.3")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -635,6 +635,12 @@ func kubeletNodeIPExample() *KubeletNodeIPConfig {
 	}
 }
 
+func kubeletMemorySwapExample() *KubeletMemorySwapConfig {
+	return &KubeletMemorySwapConfig{
+		KubeletSwapBehavior: "LimitedSwap",
+	}
+}
+
 func kubeletExtraConfigExample() Unstructured {
 	return Unstructured{
 		Object: map[string]any{
@@ -773,3 +779,14 @@ func kmsKeyExample() *EncryptionKeyKMS {
 		KMSEndpoint: "https://192.168.88.21:4443",
 	}
 }
+
+func vaultKeyExample() *EncryptionKeyVault {
+	return &EncryptionKeyVault{
+		VaultAddress:    "https://vault.example.com:8200",
+		VaultTransitKey: "talos",
+		VaultAppRole: &EncryptionKeyVaultAppRole{
+			AppRoleRoleID:   "b0d5b2f5-0d4c-4d7e-8c3a-1c9a1f0c6c7e",
+			AppRoleSecretID: "4a9f1c2e-7b3d-4e5f-9a8b-6c7d8e9f0a1b",
+		},
+	}
+}
@@ -138,6 +138,10 @@ func (c *Config) Redact(replacement string) {
 		if c.MachineConfig.MachineCA != nil {
 			c.MachineConfig.MachineCA.Key = redactBytes(c.MachineConfig.MachineCA.Key)
 		}
+
+		if c.MachineConfig.MachineNodeIdentityCA != nil {
+			c.MachineConfig.MachineNodeIdentityCA.Key = redactBytes(c.MachineConfig.MachineNodeIdentityCA.Key)
+		}
 	}
 
 	if c.ClusterConfig != nil {
@@ -302,6 +306,11 @@ func (m *MachineConfig) AcceptedCAs() []*x509.PEMEncodedCertificate {
 	return slices.Clone(m.MachineAcceptedCAs)
 }
 
+// NodeIdentityCA implements the config.Provider interface.
+func (m *MachineConfig) NodeIdentityCA() *x509.PEMEncodedCertificateAndKey {
+	return m.MachineNodeIdentityCA
+}
+
 // Token implements the config.Provider interface.
 func (m *MachineConfig) Token() string {
 	return m.MachineToken
@@ -461,11 +470,25 @@ func (k *KubeletConfig) DisableManifestsDirectory() bool {
 	return pointer.SafeDeref(k.KubeletDisableManifestsDirectory)
 }
 
+// MemorySwap implements the config.Provider interface.
+func (k *KubeletConfig) MemorySwap() config.KubeletMemorySwap {
+	if k.KubeletMemorySwap == nil {
+		return &KubeletMemorySwapConfig{}
+	}
+
+	return k.KubeletMemorySwap
+}
+
 // ValidSubnets implements the config.Provider interface.
 func (k *KubeletNodeIPConfig) ValidSubnets() []string {
 	return k.KubeletNodeIPValidSubnets
 }
 
+// SwapBehavior implements the config.Provider interface.
+func (k *KubeletMemorySwapConfig) SwapBehavior() string {
+	return k.KubeletSwapBehavior
+}
+
 // Mirrors implements the Registries interface.
 func (r *RegistriesConfig) Mirrors() map[string]config.RegistryMirrorConfig {
 	mirrors := make(map[string]config.RegistryMirrorConfig, len(r.RegistryMirrors))
@@ -801,6 +824,16 @@ func (d *DHCPOptions) DUIDv6() string {
 	return d.DHCPDUIDv6
 }
 
+// Probe implements the DHCPOptions interface.
+func (d *DHCPOptions) Probe() string {
+	return d.DHCPProbe
+}
+
+// ProbeFailureMetric implements the DHCPOptions interface.
+func (d *DHCPOptions) ProbeFailureMetric() uint32 {
+	return d.DHCPProbeFailureMetric
+}
+
 // PrivateKey implements the MachineNetwork interface.
 func (wc *DeviceWireguardConfig) PrivateKey() string {
 	return wc.WireguardPrivateKey
@@ -891,6 +924,16 @@ func (r *Route) MTU() uint32 {
 	return r.RouteMTU
 }
 
+// Probe implements the MachineNetwork interface.
+func (r *Route) Probe() string {
+	return r.RouteProbe
+}
+
+// ProbeFailureMetric implements the MachineNetwork interface.
+func (r *Route) ProbeFailureMetric() uint32 {
+	return r.RouteProbeFailureMetric
+}
+
 // Interfaces implements the MachineNetwork interface.
 func (b *Bond) Interfaces() []string {
 	if b == nil {
@@ -1574,6 +1617,15 @@ func (e *EncryptionKey) TPM() config.EncryptionKeyTPM {
 	return e.KeyTPM
 }
 
+// Vault implements the config.Provider interface.
+func (e *EncryptionKey) Vault() config.EncryptionKeyVault {
+	if e.KeyVault == nil {
+		return nil
+	}
+
+	return e.KeyVault
+}
+
 // String implements the config.Provider interface.
 func (e *EncryptionKeyNodeID) String() string {
 	return "nodeid"
@@ -1618,6 +1670,95 @@ func (e *EncryptionKeyKMS) String() string {
 	return "kms"
 }
 
+// Address implements the config.Provider interface.
+func (e *EncryptionKeyVault) Address() string {
+	return e.VaultAddress
+}
+
+// CA implements the config.Provider interface.
+func (e *EncryptionKeyVault) CA() []byte {
+	if e.VaultCA == nil {
+		return nil
+	}
+
+	return e.VaultCA.Crt
+}
+
+// TransitMount implements the config.Provider interface.
+func (e *EncryptionKeyVault) TransitMount() string {
+	if e.VaultTransitMount == "" {
+		return constants.DefaultVaultTransitMount
+	}
+
+	return e.VaultTransitMount
+}
+
+// TransitKey implements the config.Provider interface.
+func (e *EncryptionKeyVault) TransitKey() string {
+	return e.VaultTransitKey
+}
+
+// AppRole implements the config.Provider interface.
+func (e *EncryptionKeyVault) AppRole() config.EncryptionKeyVaultAppRole {
+	if e.VaultAppRole == nil {
+		return nil
+	}
+
+	return e.VaultAppRole
+}
+
+// Cert implements the config.Provider interface.
+func (e *EncryptionKeyVault) Cert() config.EncryptionKeyVaultCert {
+	if e.VaultCert == nil {
+		return nil
+	}
+
+	return e.VaultCert
+}
+
+// String implements the config.Provider interface.
+func (e *EncryptionKeyVault) String() string {
+	return "vault"
+}
+
+// MountPath implements the config.Provider interface.
+func (e *EncryptionKeyVaultAppRole) MountPath() string {
+	if e.AppRoleMountPath == "" {
+		return constants.DefaultVaultAppRoleMount
+	}
+
+	return e.AppRoleMountPath
+}
+
+// RoleID implements the config.Provider interface.
+func (e *EncryptionKeyVaultAppRole) RoleID() string {
+	return e.AppRoleRoleID
+}
+
+// SecretID implements the config.Provider interface.
+func (e *EncryptionKeyVaultAppRole) SecretID() string {
+	return e.AppRoleSecretID
+}
+
+// MountPath implements the config.Provider interface.
+func (e *EncryptionKeyVaultCert) MountPath() string {
+	if e.CertMountPath == "" {
+		return constants.DefaultVaultCertMount
+	}
+
+	return e.CertMountPath
+}
+
+// Name implements the config.Provider interface.
+func (e *EncryptionKeyVaultCert) Name() string {
+	return e.CertName
+}
+
+// ClientIdentity implements the config.Provider interface.
+func (e *EncryptionKeyVaultCert) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return e.CertClientIdentity
+}
+
 // Get implements the config.Provider interface.
 func (e *SystemDiskEncryptionConfig) Get(label string) config.Encryption {
 	switch label {
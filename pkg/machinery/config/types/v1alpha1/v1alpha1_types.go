@@ -135,6 +135,24 @@ type MachineConfig struct {
 	//         type: string
 	MachineAcceptedCAs []*x509.PEMEncodedCertificate `yaml:"acceptedCAs,omitempty"`
 	//   description: |
+	//     The certificate authority which issues the node identities.
+	//     The node identity is a client certificate used to authenticate the machine to external services (e.g. Vault),
+	//     it can't be used to access the Talos API.
+	//     It is composed of a base64 encoded `crt` and `key`.
+	//     The node identity CA is required only on control plane machines, worker machines request the node identity from trustd.
+	//   examples:
+	//     - value: pemEncodedCertificateExample()
+	//       name: node identity CA example
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	MachineNodeIdentityCA *x509.PEMEncodedCertificateAndKey `yaml:"nodeIdentityCA,omitempty"`
+	//   description: |
 	//     Extra certificate subject alternative names for the machine's certificate.
 	//     By default, all non-loopback interface IPs are automatically added to the certificate's SANs.
 	//   examples:
@@ -672,6 +690,26 @@ type KubeletConfig struct {
 	//     - false
 	//     - no
 	KubeletDisableManifestsDirectory *bool `yaml:"disableManifestsDirectory,omitempty"`
+	//   description: |
+	//     The `memorySwap` field configures the swap memory usage by the workloads.
+	//
+	//     Swap devices are configured with the `SwapVolumeConfig` and `ZramSwapConfig` documents.
+	//   examples:
+	//     - value: kubeletMemorySwapExample()
+	KubeletMemorySwap *KubeletMemorySwapConfig `yaml:"memorySwap,omitempty"`
+}
+
+// KubeletMemorySwapConfig represents the kubelet swap memory configuration.
+type KubeletMemorySwapConfig struct {
+	//   description: |
+	//     The `swapBehavior` field configures swap memory available to the container workloads.
+	//
+	//     `NoSwap` (the default) disables swap for the workloads, with `LimitedSwap` the swap limit is
+	//     proportionate to the container's memory request.
+	//   values:
+	//     - NoSwap
+	//     - LimitedSwap
+	KubeletSwapBehavior string `yaml:"swapBehavior,omitempty"`
 }
 
 // KubeletNodeIPConfig represents the kubelet node IP configuration.
@@ -1560,6 +1598,11 @@ type EncryptionKey struct {
 	//   description: >
 	//     Enable TPM based disk encryption.
 	KeyTPM *EncryptionKeyTPM `yaml:"tpm,omitempty"`
+	//   description: >
+	//     Vault Transit secrets engine managed encryption key.
+	//   examples:
+	//     - value: vaultKeyExample()
+	KeyVault *EncryptionKeyVault `yaml:"vault,omitempty"`
 }
 
 // EncryptionKeyStatic represents throw away key type.
@@ -1576,6 +1619,87 @@ type EncryptionKeyKMS struct {
 	KMSEndpoint string `yaml:"endpoint"`
 }
 
+// EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine.
+type EncryptionKeyVault struct {
+	//   description: >
+	//     Vault server address.
+	//   examples:
+	//     - value: '"https://vault.example.com:8200"'
+	VaultAddress string `yaml:"address"`
+	//   description: >
+	//     CA certificate to verify the Vault server certificate.
+	//
+	//     If not set, the system trusted CA certificates are used.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	VaultCA *x509.PEMEncodedCertificate `yaml:"ca,omitempty"`
+	//   description: >
+	//     Mount path of the Transit secrets engine.
+	//
+	//     Defaults to `transit`.
+	VaultTransitMount string `yaml:"transitMount,omitempty"`
+	//   description: >
+	//     Name of the Transit encryption key used to seal/unseal the volume key.
+	//
+	//     The volume key is sealed with the node UUID as the key derivation context,
+	//     so the key might be created with `derived=true` to bind the sealed data to the node.
+	//   examples:
+	//     - value: '"talos"'
+	VaultTransitKey string `yaml:"transitKey"`
+	//   description: >
+	//     Authenticate to Vault using the AppRole auth method.
+	VaultAppRole *EncryptionKeyVaultAppRole `yaml:"appRole,omitempty"`
+	//   description: >
+	//     Authenticate to Vault using the TLS certificates auth method.
+	VaultCert *EncryptionKeyVaultCert `yaml:"cert,omitempty"`
+}
+
+// EncryptionKeyVaultAppRole represents Vault AppRole auth method settings.
+type EncryptionKeyVaultAppRole struct {
+	//   description: >
+	//     Mount path of the AppRole auth method.
+	//
+	//     Defaults to `approle`.
+	AppRoleMountPath string `yaml:"mountPath,omitempty"`
+	//   description: >
+	//     AppRole role ID.
+	AppRoleRoleID string `yaml:"roleID"`
+	//   description: >
+	//     AppRole secret ID.
+	AppRoleSecretID string `yaml:"secretID,omitempty"`
+}
+
+// EncryptionKeyVaultCert represents Vault TLS certificates auth method settings.
+type EncryptionKeyVaultCert struct {
+	//   description: >
+	//     Mount path of the TLS certificates auth method.
+	//
+	//     Defaults to `cert`.
+	CertMountPath string `yaml:"mountPath,omitempty"`
+	//   description: >
+	//     Name of the certificate role to authenticate against.
+	//
+	//     If not set, Vault tries all certificate roles.
+	CertName string `yaml:"name,omitempty"`
+	//   description: >
+	//     The client certificate and key to authenticate with.
+	//
+	//     If not set, the node identity is used: the client certificate issued by the node identity CA.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	CertClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+}
+
 // EncryptionKeyTPM represents a key that is generated and then sealed/unsealed by the TPM.
 type EncryptionKeyTPM struct {
 	//   description: >
@@ -1759,6 +1883,18 @@ type DHCPOptions struct {
 	DHCPIPv6 *bool `yaml:"ipv6,omitempty"`
 	//   description: Set client DUID (hex string).
 	DHCPDUIDv6 string `yaml:"duidv6,omitempty"`
+	//   description: |
+	//     The name of the `NetworkProbeConfig` document which gates the routes received via DHCPv4 (optional).
+	//
+	//     When the probe fails, the metric of the routes via the DHCP gateway is raised to `probeFailureMetric`,
+	//     and the metric is restored when the probe recovers.
+	DHCPProbe string `yaml:"probe,omitempty"`
+	//   description: |
+	//     The metric of the routes received via DHCPv4 to use when the probe fails (optional).
+	//
+	//     Required if `probe` is set: the routes go via the DHCP gateway, so the probe bound to the link
+	//     might not reach its target if the routes were withdrawn.
+	DHCPProbeFailureMetric uint32 `yaml:"probeFailureMetric,omitempty"`
 }
 
 // DeviceWireguardConfig contains settings for configuring Wireguard network interface.
@@ -2043,6 +2179,19 @@ type Route struct {
 	RouteMetric uint32 `yaml:"metric,omitempty"`
 	//   description: The optional MTU for the route.
 	RouteMTU uint32 `yaml:"mtu,omitempty"`
+	//   description: |
+	//     The name of the `NetworkProbeConfig` document which gates the route (optional).
+	//
+	//     When the probe fails, the route is withdrawn (or its metric is raised, see `probeFailureMetric`),
+	//     and the route is restored when the probe recovers.
+	RouteProbe string `yaml:"probe,omitempty"`
+	//   description: |
+	//     The route metric to use when the probe fails (optional).
+	//
+	//     If not set, the route is withdrawn when the probe fails.
+	//     Required for routes via a gateway, as the probe bound to the link might not reach its target
+	//     once the route is withdrawn.
+	RouteProbeFailureMetric uint32 `yaml:"probeFailureMetric,omitempty"`
 }
 
 // RegistryMirrorConfig represents mirror configuration for a registry.
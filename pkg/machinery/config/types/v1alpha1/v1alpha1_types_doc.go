@@ -109,6 +109,13 @@ func (MachineConfig) Doc() *encoder.Doc {
 				Description: "The certificates issued by certificate authorities are accepted in addition to issuing 'ca'.\nIt is composed of a base64 encoded `crt``.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The certificates issued by certificate authorities are accepted in addition to issuing 'ca'." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "nodeIdentityCA",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "The certificate authority which issues the node identities.\nThe node identity is a client certificate used to authenticate the machine to external services (e.g. Vault),\nit can't be used to access the Talos API.\nIt is composed of a base64 encoded `crt` and `key`.\nThe node identity CA is required only on control plane machines, worker machines request the node identity from trustd.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The certificate authority which issues the node identities." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "certSANs",
 				Type:        "[]string",
@@ -277,30 +284,31 @@ func (MachineConfig) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("example token", "328hom.uqjzh6jnn2eie9oi")
 	doc.Fields[2].AddExample("machine CA example", pemEncodedCertificateExample())
-	doc.Fields[4].AddExample("Uncomment this to enable SANs.", []string{"10.0.0.10", "172.16.0.10", "192.168.0.10"})
-	doc.Fields[5].AddExample("ControlPlane definition example.", machineControlplaneExample())
-	doc.Fields[6].AddExample("Kubelet definition example.", machineKubeletExample())
-	doc.Fields[7].AddExample("nginx static pod.", machinePodsExample())
-	doc.Fields[8].AddExample("Network definition example.", machineNetworkConfigExample())
-	doc.Fields[9].AddExample("MachineDisks list example.", machineDisksExample())
-	doc.Fields[10].AddExample("MachineInstall config usage example.", machineInstallExample())
-	doc.Fields[11].AddExample("MachineFiles usage example.", machineFilesExample())
-	doc.Fields[12].AddExample("Environment variables definition examples.", machineEnvExamples0())
-	doc.Fields[12].AddExample("", machineEnvExamples1())
-	doc.Fields[12].AddExample("", machineEnvExamples2())
-	doc.Fields[13].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample())
-	doc.Fields[14].AddExample("MachineSysctls usage example.", machineSysctlsExample())
-	doc.Fields[15].AddExample("MachineSysfs usage example.", machineSysfsExample())
-	doc.Fields[16].AddExample("", machineConfigRegistriesExample())
-	doc.Fields[17].AddExample("", machineSystemDiskEncryptionExample())
-	doc.Fields[18].AddExample("", machineFeaturesExample())
-	doc.Fields[19].AddExample("", machineUdevExample())
-	doc.Fields[20].AddExample("", machineLoggingExample())
-	doc.Fields[21].AddExample("", machineKernelExample())
-	doc.Fields[22].AddExample("", machineSeccompExample())
-	doc.Fields[23].AddExample("node labels example.", map[string]string{"exampleLabel": "exampleLabelValue"})
-	doc.Fields[24].AddExample("node annotations example.", map[string]string{"customer.io/rack": "r13a25"})
-	doc.Fields[25].AddExample("node taints example.", map[string]string{"exampleTaint": "exampleTaintValue:NoSchedule"})
+	doc.Fields[4].AddExample("node identity CA example", pemEncodedCertificateExample())
+	doc.Fields[5].AddExample("Uncomment this to enable SANs.", []string{"10.0.0.10", "172.16.0.10", "192.168.0.10"})
+	doc.Fields[6].AddExample("ControlPlane definition example.", machineControlplaneExample())
+	doc.Fields[7].AddExample("Kubelet definition example.", machineKubeletExample())
+	doc.Fields[8].AddExample("nginx static pod.", machinePodsExample())
+	doc.Fields[9].AddExample("Network definition example.", machineNetworkConfigExample())
+	doc.Fields[10].AddExample("MachineDisks list example.", machineDisksExample())
+	doc.Fields[11].AddExample("MachineInstall config usage example.", machineInstallExample())
+	doc.Fields[12].AddExample("MachineFiles usage example.", machineFilesExample())
+	doc.Fields[13].AddExample("Environment variables definition examples.", machineEnvExamples0())
+	doc.Fields[13].AddExample("", machineEnvExamples1())
+	doc.Fields[13].AddExample("", machineEnvExamples2())
+	doc.Fields[14].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample())
+	doc.Fields[15].AddExample("MachineSysctls usage example.", machineSysctlsExample())
+	doc.Fields[16].AddExample("MachineSysfs usage example.", machineSysfsExample())
+	doc.Fields[17].AddExample("", machineConfigRegistriesExample())
+	doc.Fields[18].AddExample("", machineSystemDiskEncryptionExample())
+	doc.Fields[19].AddExample("", machineFeaturesExample())
+	doc.Fields[20].AddExample("", machineUdevExample())
+	doc.Fields[21].AddExample("", machineLoggingExample())
+	doc.Fields[22].AddExample("", machineKernelExample())
+	doc.Fields[23].AddExample("", machineSeccompExample())
+	doc.Fields[24].AddExample("node labels example.", map[string]string{"exampleLabel": "exampleLabelValue"})
+	doc.Fields[25].AddExample("node annotations example.", map[string]string{"customer.io/rack": "r13a25"})
+	doc.Fields[26].AddExample("node taints example.", map[string]string{"exampleTaint": "exampleTaintValue:NoSchedule"})
 
 	return doc
 }
@@ -871,6 +879,13 @@ func (KubeletConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "memorySwap",
+				Type:        "KubeletMemorySwapConfig",
+				Note:        "",
+				Description: "The `memorySwap` field configures the swap memory usage by the workloads.\n\nSwap devices are configured with the `SwapVolumeConfig` and `ZramSwapConfig` documents.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `memorySwap` field configures the swap memory usage by the workloads." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -885,6 +900,38 @@ func (KubeletConfig) Doc() *encoder.Doc {
 	doc.Fields[4].AddExample("", kubeletExtraConfigExample())
 	doc.Fields[5].AddExample("", kubeletCredentialProviderConfigExample())
 	doc.Fields[8].AddExample("", kubeletNodeIPExample())
+	doc.Fields[11].AddExample("", kubeletMemorySwapExample())
+
+	return doc
+}
+
+func (KubeletMemorySwapConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KubeletMemorySwapConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KubeletMemorySwapConfig represents the kubelet swap memory configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KubeletMemorySwapConfig represents the kubelet swap memory configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KubeletConfig",
+				FieldName: "memorySwap",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "swapBehavior",
+				Type:        "string",
+				Note:        "",
+				Description: "The `swapBehavior` field configures swap memory available to the container workloads.\n\n`NoSwap` (the default) disables swap for the workloads, with `LimitedSwap` the swap limit is\nproportionate to the container's memory request.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The `swapBehavior` field configures swap memory available to the container workloads." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"NoSwap",
+					"LimitedSwap",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", kubeletMemorySwapExample())
 
 	return doc
 }
@@ -2120,10 +2167,18 @@ func (EncryptionKey) Doc() *encoder.Doc {
 				Description: "Enable TPM based disk encryption.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable TPM based disk encryption." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "vault",
+				Type:        "EncryptionKeyVault",
+				Note:        "",
+				Description: "Vault Transit secrets engine managed encryption key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vault Transit secrets engine managed encryption key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.Fields[2].AddExample("", kmsKeyExample())
+	doc.Fields[5].AddExample("", vaultKeyExample())
 
 	return doc
 }
@@ -2180,6 +2235,149 @@ func (EncryptionKeyKMS) Doc() *encoder.Doc {
 	return doc
 }
 
+func (EncryptionKeyVault) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVault",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVault represents a key that is generated and then sealed/unsealed by the Vault Transit secrets engine.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKey",
+				FieldName: "vault",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "string",
+				Note:        "",
+				Description: "Vault server address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vault server address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "PEMEncodedCertificate",
+				Note:        "",
+				Description: "CA certificate to verify the Vault server certificate.\nIf not set, the system trusted CA certificates are used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the Vault server certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "transitMount",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the Transit secrets engine.\nDefaults to `transit`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the Transit secrets engine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "transitKey",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the Transit encryption key used to seal/unseal the volume key.\nThe volume key is sealed with the node UUID as the key derivation context, so the key might be created with `derived=true` to bind the sealed data to the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the Transit encryption key used to seal/unseal the volume key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "appRole",
+				Type:        "EncryptionKeyVaultAppRole",
+				Note:        "",
+				Description: "Authenticate to Vault using the AppRole auth method.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authenticate to Vault using the AppRole auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cert",
+				Type:        "EncryptionKeyVaultCert",
+				Note:        "",
+				Description: "Authenticate to Vault using the TLS certificates auth method.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authenticate to Vault using the TLS certificates auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", vaultKeyExample())
+
+	doc.Fields[0].AddExample("", "https://vault.example.com:8200")
+	doc.Fields[3].AddExample("", "talos")
+
+	return doc
+}
+
+func (EncryptionKeyVaultAppRole) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVaultAppRole",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVaultAppRole represents Vault AppRole auth method settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVaultAppRole represents Vault AppRole auth method settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKeyVault",
+				FieldName: "appRole",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the AppRole auth method.\nDefaults to `approle`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the AppRole auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "roleID",
+				Type:        "string",
+				Note:        "",
+				Description: "AppRole role ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AppRole role ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "secretID",
+				Type:        "string",
+				Note:        "",
+				Description: "AppRole secret ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "AppRole secret ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EncryptionKeyVaultCert) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EncryptionKeyVaultCert",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EncryptionKeyVaultCert represents Vault TLS certificates auth method settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EncryptionKeyVaultCert represents Vault TLS certificates auth method settings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EncryptionKeyVault",
+				FieldName: "cert",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mountPath",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount path of the TLS certificates auth method.\nDefaults to `cert`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount path of the TLS certificates auth method." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the certificate role to authenticate against.\nIf not set, Vault tries all certificate roles.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the certificate role to authenticate against." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientIdentity",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "The client certificate and key to authenticate with.\nIf not set, the node identity is used: the client certificate issued by the node identity CA.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The client certificate and key to authenticate with." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (EncryptionKeyTPM) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "EncryptionKeyTPM",
@@ -2536,6 +2734,20 @@ func (DHCPOptions) Doc() *encoder.Doc {
 				Description: "Set client DUID (hex string).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Set client DUID (hex string)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the `NetworkProbeConfig` document which gates the routes received via DHCPv4 (optional).\n\nWhen the probe fails, the metric of the routes via the DHCP gateway is raised to `probeFailureMetric`,\nand the metric is restored when the probe recovers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the `NetworkProbeConfig` document which gates the routes received via DHCPv4 (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeFailureMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The metric of the routes received via DHCPv4 to use when the probe fails (optional).\n\nRequired if `probe` is set: the routes go via the DHCP gateway, so the probe bound to the link\nmight not reach its target if the routes were withdrawn.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The metric of the routes received via DHCPv4 to use when the probe fails (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -3190,6 +3402,20 @@ func (Route) Doc() *encoder.Doc {
 				Description: "The optional MTU for the route.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional MTU for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the `NetworkProbeConfig` document which gates the route (optional).\n\nWhen the probe fails, the route is withdrawn (or its metric is raised, see `probeFailureMetric`),\nand the route is restored when the probe recovers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the `NetworkProbeConfig` document which gates the route (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probeFailureMetric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The route metric to use when the probe fails (optional).\n\nIf not set, the route is withdrawn when the probe fails.\nRequired for routes via a gateway, as the probe bound to the link might not reach its target\nonce the route is withdrawn.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route metric to use when the probe fails (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -4119,6 +4345,7 @@ func GetFileDoc() *encoder.FileDoc {
 			MachineControllerManagerConfig{}.Doc(),
 			MachineSchedulerConfig{}.Doc(),
 			KubeletConfig{}.Doc(),
+			KubeletMemorySwapConfig{}.Doc(),
 			KubeletNodeIPConfig{}.Doc(),
 			NetworkConfig{}.Doc(),
 			InstallConfig{}.Doc(),
@@ -4146,6 +4373,9 @@ func GetFileDoc() *encoder.FileDoc {
 			EncryptionKey{}.Doc(),
 			EncryptionKeyStatic{}.Doc(),
 			EncryptionKeyKMS{}.Doc(),
+			EncryptionKeyVault{}.Doc(),
+			EncryptionKeyVaultAppRole{}.Doc(),
+			EncryptionKeyVaultCert{}.Doc(),
 			EncryptionKeyTPM{}.Doc(),
 			EncryptionKeyNodeID{}.Doc(),
 			ResourcesConfig{}.Doc(),
@@ -244,9 +244,23 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 
 				slotsInUse[key.Slot()] = struct{}{}
 
-				if key.NodeID() == nil && key.Static() == nil && key.KMS() == nil && key.TPM() == nil {
+				if key.NodeID() == nil && key.Static() == nil && key.KMS() == nil && key.TPM() == nil && key.Vault() == nil {
 					result = multierror.Append(result, fmt.Errorf("partition %q: encryption key at slot %d doesn't have the configuration parameters", label, key.Slot()))
 				}
+
+				if key.Vault() != nil {
+					if err := blockhelpers.ValidateVaultKey(key.Vault()); err != nil {
+						result = multierror.Append(result, fmt.Errorf("partition %q: encryption key at slot %d: %w", label, key.Slot(), err))
+					}
+
+					// the node identity is issued based on the machine configuration, which is stored in the STATE partition
+					if label == constants.StatePartitionLabel && key.Vault().Cert() != nil && key.Vault().Cert().ClientIdentity() == nil {
+						result = multierror.Append(result, fmt.Errorf(
+							"partition %q: encryption key at slot %d: vault cert client identity is required, the node identity is not available before the STATE partition is mounted",
+							label, key.Slot(),
+						))
+					}
+				}
 			}
 		}
 	}
@@ -744,6 +758,16 @@ func checkVlans(d *Device) error {
 				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %w", "networking.os.device.vlan.addresses", d.DeviceInterface, vlan.VlanID, err))
 			}
 		}
+
+		if vlan.VlanDHCPOptions != nil && vlan.VlanDHCPOptions.DHCPProbe != "" && vlan.VlanDHCPOptions.DHCPProbeFailureMetric == 0 {
+			result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %s", "networking.os.device.vlan.dhcpOptions", d.DeviceInterface, vlan.VlanID, "probe failure metric is required when probe is set"))
+		}
+
+		for idx, route := range vlan.VlanRoutes {
+			if route.Probe() != "" && route.ProbeFailureMetric() == 0 && route.Gateway() != "" {
+				result = multierror.Append(result, fmt.Errorf("[%s] %s.%d: %s", "networking.os.device.vlan.route["+strconv.Itoa(idx)+"]", d.DeviceInterface, vlan.VlanID, "probe failure metric is required for routes via a gateway"))
+			}
+		}
 	}
 
 	return result.ErrorOrNil()
@@ -810,6 +834,14 @@ func CheckDeviceAddressing(d *Device, secondaryInterfaces map[string]string) ([]
 		}
 	}
 
+	if d.DeviceDHCPOptions != nil && d.DeviceDHCPOptions.DHCPProbeFailureMetric != 0 && d.DeviceDHCPOptions.DHCPProbe == "" {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpOptions", d.DeviceInterface, "probe failure metric requires probe to be set"))
+	}
+
+	if d.DeviceDHCPOptions != nil && d.DeviceDHCPOptions.DHCPProbe != "" && d.DeviceDHCPOptions.DHCPProbeFailureMetric == 0 {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %s", "networking.os.device.dhcpOptions", d.DeviceInterface, "probe failure metric is required when probe is set"))
+	}
+
 	return warnings, result.ErrorOrNil()
 }
 
@@ -849,6 +881,14 @@ func CheckDeviceRoutes(d *Device, _ map[string]string) ([]string, error) {
 				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.route["+strconv.Itoa(idx)+"].source", route.Source(), ErrInvalidAddress))
 			}
 		}
+
+		if route.ProbeFailureMetric() != 0 && route.Probe() == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %s", "networking.os.device.route["+strconv.Itoa(idx)+"]", "probe failure metric requires probe to be set"))
+		}
+
+		if route.Probe() != "" && route.ProbeFailureMetric() == 0 && route.Gateway() != "" {
+			result = multierror.Append(result, fmt.Errorf("[%s]: %s", "networking.os.device.route["+strconv.Itoa(idx)+"]", "probe failure metric is required for routes via a gateway"))
+		}
 	}
 
 	return nil, result.ErrorOrNil()
@@ -868,6 +908,14 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 		}
 	}
 
+	if k.KubeletMemorySwap != nil {
+		switch k.KubeletMemorySwap.KubeletSwapBehavior {
+		case "", "NoSwap", "LimitedSwap":
+		default:
+			result = multierror.Append(result, fmt.Errorf("kubelet swap behavior is not valid: %q", k.KubeletMemorySwap.KubeletSwapBehavior))
+		}
+	}
+
 	for _, field := range kubelet.ProtectedConfigurationFields {
 		if _, exists := k.KubeletExtraConfig.Object[field]; exists {
 			result = multierror.Append(result, fmt.Errorf("kubelet configuration field %q can't be overridden", field))
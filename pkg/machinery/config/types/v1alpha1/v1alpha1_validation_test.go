@@ -161,6 +161,81 @@ func TestValidate(t *testing.T) {
 			},
 			strict: true,
 		},
+		{
+			name: "VaultCertNodeIdentity",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						EphemeralPartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeySlot: 0,
+									KeyVault: &v1alpha1.EncryptionKeyVault{
+										VaultAddress:    "https://vault.example.com:8200",
+										VaultTransitKey: "talos",
+										VaultCert:       &v1alpha1.EncryptionKeyVaultCert{},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			strict: true,
+		},
+		{
+			name: "VaultCertNodeIdentityState",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{
+							Crt: []byte("foo"),
+						},
+					},
+					MachineSystemDiskEncryption: &v1alpha1.SystemDiskEncryptionConfig{
+						StatePartition: &v1alpha1.EncryptionConfig{
+							EncryptionProvider: "luks2",
+							EncryptionKeys: []*v1alpha1.EncryptionKey{
+								{
+									KeySlot: 0,
+									KeyVault: &v1alpha1.EncryptionKeyVault{
+										VaultAddress:    "https://vault.example.com:8200",
+										VaultTransitKey: "talos",
+										VaultCert:       &v1alpha1.EncryptionKeyVaultCert{},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			strict:        true,
+			expectedError: "1 error occurred:\n\t* partition \"STATE\": encryption key at slot 0: vault cert client identity is required, the node identity is not available before the STATE partition is mounted\n\n",
+		},
 		{
 			name: "ControlplaneNoCAKey",
 			config: &v1alpha1.Config{
@@ -1142,6 +1217,18 @@ func TestValidate(t *testing.T) {
 									{
 										RouteSource: "10.0.0.3",
 									},
+									{
+										RouteGateway:            "10.0.0.1",
+										RouteProbeFailureMetric: 4096,
+									},
+									{
+										RouteGateway: "10.0.0.1",
+										RouteProbe:   "gateway",
+									},
+									{
+										RouteNetwork: "10.0.0.0/24",
+										RouteProbe:   "lan",
+									},
 								},
 							},
 						},
@@ -1155,10 +1242,12 @@ func TestValidate(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "4 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
+			expectedError: "6 errors occurred:\n\t* [networking.os.device.route[3].gateway] \"172.0.0.x\": invalid network address\n" +
 				"\t* [networking.os.device.route[4].network] \"10.0.0.0\": invalid network address\n" +
 				"\t* [networking.os.device.route[5].source] \"10.0.0.3/32\": invalid network address\n" +
-				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n\n",
+				"\t* [networking.os.device.route[7]]: either network or gateway should be set\n" +
+				"\t* [networking.os.device.route[8]]: probe failure metric requires probe to be set\n" +
+				"\t* [networking.os.device.route[9]]: probe failure metric is required for routes via a gateway\n\n",
 		},
 		{
 			name: "KubeSpanNoDiscovery",
@@ -1408,6 +1497,33 @@ func TestValidate(t *testing.T) {
 				"\t* kubelet nodeIP subnet is not valid: \"[fd00::169:254:2:53]:344\"\n" +
 				"\n",
 		},
+		{
+			name: "BadKubeletSwapBehavior",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineKubelet: &v1alpha1.KubeletConfig{
+						KubeletMemorySwap: &v1alpha1.KubeletMemorySwapConfig{
+							KubeletSwapBehavior: "UnlimitedSwap",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n" +
+				"\t* kubelet swap behavior is not valid: \"UnlimitedSwap\"\n" +
+				"\n",
+		},
 		{
 			name: "BadKubeletExtraConfig",
 			config: &v1alpha1.Config{
@@ -847,6 +847,11 @@ func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
 		*out = new(EncryptionKeyTPM)
 		**out = **in
 	}
+	if in.KeyVault != nil {
+		in, out := &in.KeyVault, &out.KeyVault
+		*out = new(EncryptionKeyVault)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -924,6 +929,72 @@ func (in *EncryptionKeyTPM) DeepCopy() *EncryptionKeyTPM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyVault) DeepCopyInto(out *EncryptionKeyVault) {
+	*out = *in
+	if in.VaultCA != nil {
+		in, out := &in.VaultCA, &out.VaultCA
+		*out = (*in).DeepCopy()
+	}
+	if in.VaultAppRole != nil {
+		in, out := &in.VaultAppRole, &out.VaultAppRole
+		*out = new(EncryptionKeyVaultAppRole)
+		**out = **in
+	}
+	if in.VaultCert != nil {
+		in, out := &in.VaultCert, &out.VaultCert
+		*out = new(EncryptionKeyVaultCert)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyVault.
+func (in *EncryptionKeyVault) DeepCopy() *EncryptionKeyVault {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyVaultAppRole) DeepCopyInto(out *EncryptionKeyVaultAppRole) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyVaultAppRole.
+func (in *EncryptionKeyVaultAppRole) DeepCopy() *EncryptionKeyVaultAppRole {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyVaultAppRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKeyVaultCert) DeepCopyInto(out *EncryptionKeyVaultCert) {
+	*out = *in
+	if in.CertClientIdentity != nil {
+		in, out := &in.CertClientIdentity, &out.CertClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyVaultCert.
+func (in *EncryptionKeyVaultCert) DeepCopy() *EncryptionKeyVaultCert {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKeyVaultCert)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -1423,6 +1494,11 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubeletMemorySwap != nil {
+		in, out := &in.KubeletMemorySwap, &out.KubeletMemorySwap
+		*out = new(KubeletMemorySwapConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1436,6 +1512,22 @@ func (in *KubeletConfig) DeepCopy() *KubeletConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletMemorySwapConfig) DeepCopyInto(out *KubeletMemorySwapConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletMemorySwapConfig.
+func (in *KubeletMemorySwapConfig) DeepCopy() *KubeletMemorySwapConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletMemorySwapConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletNodeIPConfig) DeepCopyInto(out *KubeletNodeIPConfig) {
 	*out = *in
@@ -1571,6 +1663,10 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineNodeIdentityCA != nil {
+		in, out := &in.MachineNodeIdentityCA, &out.MachineNodeIdentityCA
+		*out = (*in).DeepCopy()
+	}
 	if in.MachineCertSANs != nil {
 		in, out := &in.MachineCertSANs, &out.MachineCertSANs
 		*out = make([]string, len(*in))
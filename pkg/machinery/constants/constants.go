@@ -209,6 +209,18 @@ const (
 	// the data path.
 	EphemeralMountPoint = "/var"
 
+	// UserVolumePrefix is the prefix used for the partition (and filesystem) label and the volume ID of user volumes.
+	UserVolumePrefix = "u-"
+
+	// UserVolumeMountPoint is the directory where user volumes are mounted (each under its own name).
+	UserVolumeMountPoint = "/var/mnt"
+
+	// UserVolumeMountTimeout is the default time the boot waits for a user volume to be mounted.
+	UserVolumeMountTimeout = 5 * time.Minute
+
+	// SwapVolumePrefix is the prefix used for the partition label and the volume ID of swap volumes.
+	SwapVolumePrefix = "s-"
+
 	// RootMountPoint is the label of the partition to use for mounting at
 	// the root path.
 	RootMountPoint = "/"
@@ -971,6 +983,11 @@ const (
 	// KubeSpanDefaultPeerKeepalive is the interval at which Wireguard Peer Keepalives should be sent.
 	KubeSpanDefaultPeerKeepalive = 25 * time.Second
 
+	// RoutingRuleProtocol is the protocol used to mark the routing rules installed by Talos.
+	//
+	// The protocol is not registered in the iproute2 protocol list, so the rules installed by other tools are not touched.
+	RoutingRuleProtocol = 84
+
 	// NetworkSelfIPsAnnotation is the node annotation used to list the (comma-separated) IP addresses of the host, as discovered by Talos tooling.
 	NetworkSelfIPsAnnotation = "networking.talos.dev/self-ips"
 
@@ -1159,6 +1176,21 @@ const (
 	// DefaultNfTablesTableName is the default name of the nftables table created by Talos.
 	DefaultNfTablesTableName = "talos"
 
+	// NfTablesLogGroup is the netlink log group used to report the packets dropped by the firewall.
+	NfTablesLogGroup = 100
+
+	// NfTablesLogRate is the rate limit (packets per second) of the dropped packets logging per firewall rule.
+	NfTablesLogRate = 5
+
+	// DefaultVaultTransitMount is the default mount path of the Vault Transit secrets engine.
+	DefaultVaultTransitMount = "transit"
+
+	// DefaultVaultAppRoleMount is the default mount path of the Vault AppRole auth method.
+	DefaultVaultAppRoleMount = "approle"
+
+	// DefaultVaultCertMount is the default mount path of the Vault TLS certificates auth method.
+	DefaultVaultCertMount = "cert"
+
 	// PodResolvConfPath is the path to the pod resolv.conf file.
 	PodResolvConfPath = "/system/resolved/resolv.conf"
 
@@ -1,4 +1,4 @@
-// Code generated by "enumer -type=ARPAllTargets,ARPValidate,AddressFlag,ADSelect,BondMode,BondXmitHashPolicy,ConntrackState,DefaultAction,Duplex,Family,LACPRate,LinkFlag,LinkType,MatchOperator,NfTablesChainHook,NfTablesChainPriority,NfTablesVerdict,OperationalState,Port,PrimaryReselect,Protocol,RouteFlag,RouteProtocol,RouteType,RoutingTable,Scope,Status,VLANProtocol -linecomment -text"; DO NOT EDIT.
+// Code generated by "enumer -type=ARPAllTargets,ARPValidate,AddressFlag,ADSelect,BondMode,BondXmitHashPolicy,ConntrackState,DefaultAction,Dot1XState,Duplex,Family,IPVLANMode,LACPRate,LinkFlag,LinkType,MACVLANMode,MatchOperator,NfTablesChainHook,NfTablesChainPriority,NfTablesVerdict,OperationalState,Port,PrimaryReselect,Protocol,RouteFlag,RouteProtocol,RouteType,RoutingTable,Scope,Status,VLANProtocol,WOLMode -linecomment -text"; DO NOT EDIT.
 
 package nethelpers
 
@@ -779,6 +779,100 @@ func (i *DefaultAction) UnmarshalText(text []byte) error {
 	return err
 }
 
+const _Dot1XStateName = "disabledconnectingauthenticatingauthenticatedfailed"
+
+var _Dot1XStateIndex = [...]uint8{0, 8, 18, 32, 45, 51}
+
+const _Dot1XStateLowerName = "disabledconnectingauthenticatingauthenticatedfailed"
+
+func (i Dot1XState) String() string {
+	if i >= Dot1XState(len(_Dot1XStateIndex)-1) {
+		return fmt.Sprintf("Dot1XState(%d)", i)
+	}
+	return _Dot1XStateName[_Dot1XStateIndex[i]:_Dot1XStateIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _Dot1XStateNoOp() {
+	var x [1]struct{}
+	_ = x[Dot1XStateDisabled-(0)]
+	_ = x[Dot1XStateConnecting-(1)]
+	_ = x[Dot1XStateAuthenticating-(2)]
+	_ = x[Dot1XStateAuthenticated-(3)]
+	_ = x[Dot1XStateFailed-(4)]
+}
+
+var _Dot1XStateValues = []Dot1XState{Dot1XStateDisabled, Dot1XStateConnecting, Dot1XStateAuthenticating, Dot1XStateAuthenticated, Dot1XStateFailed}
+
+var _Dot1XStateNameToValueMap = map[string]Dot1XState{
+	_Dot1XStateName[0:8]:        Dot1XStateDisabled,
+	_Dot1XStateLowerName[0:8]:   Dot1XStateDisabled,
+	_Dot1XStateName[8:18]:       Dot1XStateConnecting,
+	_Dot1XStateLowerName[8:18]:  Dot1XStateConnecting,
+	_Dot1XStateName[18:32]:      Dot1XStateAuthenticating,
+	_Dot1XStateLowerName[18:32]: Dot1XStateAuthenticating,
+	_Dot1XStateName[32:45]:      Dot1XStateAuthenticated,
+	_Dot1XStateLowerName[32:45]: Dot1XStateAuthenticated,
+	_Dot1XStateName[45:51]:      Dot1XStateFailed,
+	_Dot1XStateLowerName[45:51]: Dot1XStateFailed,
+}
+
+var _Dot1XStateNames = []string{
+	_Dot1XStateName[0:8],
+	_Dot1XStateName[8:18],
+	_Dot1XStateName[18:32],
+	_Dot1XStateName[32:45],
+	_Dot1XStateName[45:51],
+}
+
+// Dot1XStateString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func Dot1XStateString(s string) (Dot1XState, error) {
+	if val, ok := _Dot1XStateNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _Dot1XStateNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Dot1XState values", s)
+}
+
+// Dot1XStateValues returns all values of the enum
+func Dot1XStateValues() []Dot1XState {
+	return _Dot1XStateValues
+}
+
+// Dot1XStateStrings returns a slice of all String values of the enum
+func Dot1XStateStrings() []string {
+	strs := make([]string, len(_Dot1XStateNames))
+	copy(strs, _Dot1XStateNames)
+	return strs
+}
+
+// IsADot1XState returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Dot1XState) IsADot1XState() bool {
+	for _, v := range _Dot1XStateValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Dot1XState
+func (i Dot1XState) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Dot1XState
+func (i *Dot1XState) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = Dot1XStateString(string(text))
+	return err
+}
+
 const (
 	_DuplexName_0      = "HalfFull"
 	_DuplexLowerName_0 = "halffull"
@@ -967,6 +1061,92 @@ func (i *Family) UnmarshalText(text []byte) error {
 	return err
 }
 
+const _IPVLANModeName = "l2l3l3s"
+
+var _IPVLANModeIndex = [...]uint8{0, 2, 4, 7}
+
+const _IPVLANModeLowerName = "l2l3l3s"
+
+func (i IPVLANMode) String() string {
+	if i >= IPVLANMode(len(_IPVLANModeIndex)-1) {
+		return fmt.Sprintf("IPVLANMode(%d)", i)
+	}
+	return _IPVLANModeName[_IPVLANModeIndex[i]:_IPVLANModeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _IPVLANModeNoOp() {
+	var x [1]struct{}
+	_ = x[IPVLANModeL2-(0)]
+	_ = x[IPVLANModeL3-(1)]
+	_ = x[IPVLANModeL3S-(2)]
+}
+
+var _IPVLANModeValues = []IPVLANMode{IPVLANModeL2, IPVLANModeL3, IPVLANModeL3S}
+
+var _IPVLANModeNameToValueMap = map[string]IPVLANMode{
+	_IPVLANModeName[0:2]:      IPVLANModeL2,
+	_IPVLANModeLowerName[0:2]: IPVLANModeL2,
+	_IPVLANModeName[2:4]:      IPVLANModeL3,
+	_IPVLANModeLowerName[2:4]: IPVLANModeL3,
+	_IPVLANModeName[4:7]:      IPVLANModeL3S,
+	_IPVLANModeLowerName[4:7]: IPVLANModeL3S,
+}
+
+var _IPVLANModeNames = []string{
+	_IPVLANModeName[0:2],
+	_IPVLANModeName[2:4],
+	_IPVLANModeName[4:7],
+}
+
+// IPVLANModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func IPVLANModeString(s string) (IPVLANMode, error) {
+	if val, ok := _IPVLANModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _IPVLANModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to IPVLANMode values", s)
+}
+
+// IPVLANModeValues returns all values of the enum
+func IPVLANModeValues() []IPVLANMode {
+	return _IPVLANModeValues
+}
+
+// IPVLANModeStrings returns a slice of all String values of the enum
+func IPVLANModeStrings() []string {
+	strs := make([]string, len(_IPVLANModeNames))
+	copy(strs, _IPVLANModeNames)
+	return strs
+}
+
+// IsAIPVLANMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i IPVLANMode) IsAIPVLANMode() bool {
+	for _, v := range _IPVLANModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for IPVLANMode
+func (i IPVLANMode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for IPVLANMode
+func (i *IPVLANMode) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = IPVLANModeString(string(text))
+	return err
+}
+
 const _LACPRateName = "slowfast"
 
 var _LACPRateIndex = [...]uint8{0, 4, 8}
@@ -1664,6 +1844,121 @@ func (i *LinkType) UnmarshalText(text []byte) error {
 	return err
 }
 
+const (
+	_MACVLANModeName_0      = "privatevepa"
+	_MACVLANModeLowerName_0 = "privatevepa"
+	_MACVLANModeName_1      = "bridge"
+	_MACVLANModeLowerName_1 = "bridge"
+	_MACVLANModeName_2      = "passthru"
+	_MACVLANModeLowerName_2 = "passthru"
+	_MACVLANModeName_3      = "source"
+	_MACVLANModeLowerName_3 = "source"
+)
+
+var (
+	_MACVLANModeIndex_0 = [...]uint8{0, 7, 11}
+	_MACVLANModeIndex_1 = [...]uint8{0, 6}
+	_MACVLANModeIndex_2 = [...]uint8{0, 8}
+	_MACVLANModeIndex_3 = [...]uint8{0, 6}
+)
+
+func (i MACVLANMode) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _MACVLANModeName_0[_MACVLANModeIndex_0[i]:_MACVLANModeIndex_0[i+1]]
+	case i == 4:
+		return _MACVLANModeName_1
+	case i == 8:
+		return _MACVLANModeName_2
+	case i == 16:
+		return _MACVLANModeName_3
+	default:
+		return fmt.Sprintf("MACVLANMode(%d)", i)
+	}
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _MACVLANModeNoOp() {
+	var x [1]struct{}
+	_ = x[MACVLANModePrivate-(1)]
+	_ = x[MACVLANModeVEPA-(2)]
+	_ = x[MACVLANModeBridge-(4)]
+	_ = x[MACVLANModePassthru-(8)]
+	_ = x[MACVLANModeSource-(16)]
+}
+
+var _MACVLANModeValues = []MACVLANMode{MACVLANModePrivate, MACVLANModeVEPA, MACVLANModeBridge, MACVLANModePassthru, MACVLANModeSource}
+
+var _MACVLANModeNameToValueMap = map[string]MACVLANMode{
+	_MACVLANModeName_0[0:7]:       MACVLANModePrivate,
+	_MACVLANModeLowerName_0[0:7]:  MACVLANModePrivate,
+	_MACVLANModeName_0[7:11]:      MACVLANModeVEPA,
+	_MACVLANModeLowerName_0[7:11]: MACVLANModeVEPA,
+	_MACVLANModeName_1[0:6]:       MACVLANModeBridge,
+	_MACVLANModeLowerName_1[0:6]:  MACVLANModeBridge,
+	_MACVLANModeName_2[0:8]:       MACVLANModePassthru,
+	_MACVLANModeLowerName_2[0:8]:  MACVLANModePassthru,
+	_MACVLANModeName_3[0:6]:       MACVLANModeSource,
+	_MACVLANModeLowerName_3[0:6]:  MACVLANModeSource,
+}
+
+var _MACVLANModeNames = []string{
+	_MACVLANModeName_0[0:7],
+	_MACVLANModeName_0[7:11],
+	_MACVLANModeName_1[0:6],
+	_MACVLANModeName_2[0:8],
+	_MACVLANModeName_3[0:6],
+}
+
+// MACVLANModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func MACVLANModeString(s string) (MACVLANMode, error) {
+	if val, ok := _MACVLANModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _MACVLANModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to MACVLANMode values", s)
+}
+
+// MACVLANModeValues returns all values of the enum
+func MACVLANModeValues() []MACVLANMode {
+	return _MACVLANModeValues
+}
+
+// MACVLANModeStrings returns a slice of all String values of the enum
+func MACVLANModeStrings() []string {
+	strs := make([]string, len(_MACVLANModeNames))
+	copy(strs, _MACVLANModeNames)
+	return strs
+}
+
+// IsAMACVLANMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i MACVLANMode) IsAMACVLANMode() bool {
+	for _, v := range _MACVLANModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for MACVLANMode
+func (i MACVLANMode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for MACVLANMode
+func (i *MACVLANMode) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = MACVLANModeString(string(text))
+	return err
+}
+
 const _MatchOperatorName = "==!="
 
 var _MatchOperatorIndex = [...]uint8{0, 2, 4}
@@ -3331,3 +3626,145 @@ func (i *VLANProtocol) UnmarshalText(text []byte) error {
 	*i, err = VLANProtocolString(string(text))
 	return err
 }
+
+const (
+	_WOLModeName_0      = "phyunicast"
+	_WOLModeLowerName_0 = "phyunicast"
+	_WOLModeName_1      = "multicast"
+	_WOLModeLowerName_1 = "multicast"
+	_WOLModeName_2      = "broadcast"
+	_WOLModeLowerName_2 = "broadcast"
+	_WOLModeName_3      = "arp"
+	_WOLModeLowerName_3 = "arp"
+	_WOLModeName_4      = "magic"
+	_WOLModeLowerName_4 = "magic"
+	_WOLModeName_5      = "magicsecure"
+	_WOLModeLowerName_5 = "magicsecure"
+	_WOLModeName_6      = "filter"
+	_WOLModeLowerName_6 = "filter"
+)
+
+var (
+	_WOLModeIndex_0 = [...]uint8{0, 3, 10}
+	_WOLModeIndex_1 = [...]uint8{0, 9}
+	_WOLModeIndex_2 = [...]uint8{0, 9}
+	_WOLModeIndex_3 = [...]uint8{0, 3}
+	_WOLModeIndex_4 = [...]uint8{0, 5}
+	_WOLModeIndex_5 = [...]uint8{0, 11}
+	_WOLModeIndex_6 = [...]uint8{0, 6}
+)
+
+func (i WOLMode) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _WOLModeName_0[_WOLModeIndex_0[i]:_WOLModeIndex_0[i+1]]
+	case i == 4:
+		return _WOLModeName_1
+	case i == 8:
+		return _WOLModeName_2
+	case i == 16:
+		return _WOLModeName_3
+	case i == 32:
+		return _WOLModeName_4
+	case i == 64:
+		return _WOLModeName_5
+	case i == 128:
+		return _WOLModeName_6
+	default:
+		return fmt.Sprintf("WOLMode(%d)", i)
+	}
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _WOLModeNoOp() {
+	var x [1]struct{}
+	_ = x[WOLModePHY-(1)]
+	_ = x[WOLModeUnicast-(2)]
+	_ = x[WOLModeMulticast-(4)]
+	_ = x[WOLModeBroadcast-(8)]
+	_ = x[WOLModeARP-(16)]
+	_ = x[WOLModeMagic-(32)]
+	_ = x[WOLModeMagicSecure-(64)]
+	_ = x[WOLModeFilter-(128)]
+}
+
+var _WOLModeValues = []WOLMode{WOLModePHY, WOLModeUnicast, WOLModeMulticast, WOLModeBroadcast, WOLModeARP, WOLModeMagic, WOLModeMagicSecure, WOLModeFilter}
+
+var _WOLModeNameToValueMap = map[string]WOLMode{
+	_WOLModeName_0[0:3]:       WOLModePHY,
+	_WOLModeLowerName_0[0:3]:  WOLModePHY,
+	_WOLModeName_0[3:10]:      WOLModeUnicast,
+	_WOLModeLowerName_0[3:10]: WOLModeUnicast,
+	_WOLModeName_1[0:9]:       WOLModeMulticast,
+	_WOLModeLowerName_1[0:9]:  WOLModeMulticast,
+	_WOLModeName_2[0:9]:       WOLModeBroadcast,
+	_WOLModeLowerName_2[0:9]:  WOLModeBroadcast,
+	_WOLModeName_3[0:3]:       WOLModeARP,
+	_WOLModeLowerName_3[0:3]:  WOLModeARP,
+	_WOLModeName_4[0:5]:       WOLModeMagic,
+	_WOLModeLowerName_4[0:5]:  WOLModeMagic,
+	_WOLModeName_5[0:11]:      WOLModeMagicSecure,
+	_WOLModeLowerName_5[0:11]: WOLModeMagicSecure,
+	_WOLModeName_6[0:6]:       WOLModeFilter,
+	_WOLModeLowerName_6[0:6]:  WOLModeFilter,
+}
+
+var _WOLModeNames = []string{
+	_WOLModeName_0[0:3],
+	_WOLModeName_0[3:10],
+	_WOLModeName_1[0:9],
+	_WOLModeName_2[0:9],
+	_WOLModeName_3[0:3],
+	_WOLModeName_4[0:5],
+	_WOLModeName_5[0:11],
+	_WOLModeName_6[0:6],
+}
+
+// WOLModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func WOLModeString(s string) (WOLMode, error) {
+	if val, ok := _WOLModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _WOLModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to WOLMode values", s)
+}
+
+// WOLModeValues returns all values of the enum
+func WOLModeValues() []WOLMode {
+	return _WOLModeValues
+}
+
+// WOLModeStrings returns a slice of all String values of the enum
+func WOLModeStrings() []string {
+	strs := make([]string, len(_WOLModeNames))
+	copy(strs, _WOLModeNames)
+	return strs
+}
+
+// IsAWOLMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i WOLMode) IsAWOLMode() bool {
+	for _, v := range _WOLModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for WOLMode
+func (i WOLMode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for WOLMode
+func (i *WOLMode) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = WOLModeString(string(text))
+	return err
+}
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// Dot1XState is a 802.1X port authentication state.
+type Dot1XState uint8
+
+// Dot1XState constants.
+//
+//structprotogen:gen_enum
+const (
+	Dot1XStateDisabled       Dot1XState = iota // disabled
+	Dot1XStateConnecting                       // connecting
+	Dot1XStateAuthenticating                   // authenticating
+	Dot1XStateAuthenticated                    // authenticated
+	Dot1XStateFailed                           // failed
+)
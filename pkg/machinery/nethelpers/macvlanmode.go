@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// MACVLANMode is a macvlan link mode.
+type MACVLANMode uint32
+
+// MACVLANMode constants.
+//
+//structprotogen:gen_enum
+const (
+	MACVLANModePrivate  MACVLANMode = 1  // private
+	MACVLANModeVEPA     MACVLANMode = 2  // vepa
+	MACVLANModeBridge   MACVLANMode = 4  // bridge
+	MACVLANModePassthru MACVLANMode = 8  // passthru
+	MACVLANModeSource   MACVLANMode = 16 // source
+)
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"strings"
+)
+
+// WOLModes is a bitmask of WOLMode.
+type WOLModes uint32
+
+func (modes WOLModes) String() string {
+	var values []string
+
+	for mode := WOLModePHY; mode <= WOLModeFilter; mode <<= 1 {
+		if (WOLMode(modes) & mode) == mode {
+			values = append(values, mode.String())
+		}
+	}
+
+	return strings.Join(values, ",")
+}
+
+// WOLModesString parses string representation into WOLModes.
+func WOLModesString(s string) (WOLModes, error) {
+	modes := WOLModes(0)
+
+	if s == "" {
+		return modes, nil
+	}
+
+	for _, p := range strings.Split(s, ",") {
+		mode, err := WOLModeString(p)
+		if err != nil {
+			return modes, err
+		}
+
+		modes |= WOLModes(mode)
+	}
+
+	return modes, nil
+}
+
+// MarshalText implements text.Marshaler.
+func (modes WOLModes) MarshalText() ([]byte, error) {
+	return []byte(modes.String()), nil
+}
+
+// UnmarshalText implements text.Unmarshaler.
+func (modes *WOLModes) UnmarshalText(b []byte) error {
+	var err error
+
+	*modes, err = WOLModesString(string(b))
+
+	return err
+}
+
+// WOLMode wraps WAKE_* (Wake-on-LAN) constants.
+type WOLMode uint32
+
+// WOLMode constants.
+//
+//structprotogen:gen_enum
+const (
+	WOLModePHY         WOLMode = 1 << iota // phy
+	WOLModeUnicast                         // unicast
+	WOLModeMulticast                       // multicast
+	WOLModeBroadcast                       // broadcast
+	WOLModeARP                             // arp
+	WOLModeMagic                           // magic
+	WOLModeMagicSecure                     // magicsecure
+	WOLModeFilter                          // filter
+)
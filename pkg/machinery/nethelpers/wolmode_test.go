@@ -0,0 +1,40 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestWOLModesStrings(t *testing.T) {
+	for _, tt := range []struct {
+		wolModesString string
+		expected       nethelpers.WOLModes
+	}{
+		{
+			wolModesString: "",
+			expected:       nethelpers.WOLModes(0),
+		},
+		{
+			wolModesString: "magic",
+			expected:       nethelpers.WOLModes(nethelpers.WOLModeMagic),
+		},
+		{
+			wolModesString: "unicast,magic",
+			expected:       nethelpers.WOLModes(nethelpers.WOLModeUnicast | nethelpers.WOLModeMagic),
+		},
+	} {
+		wolModes, err := nethelpers.WOLModesString(tt.wolModesString)
+		require.NoError(t, err)
+
+		assert.Equal(t, tt.expected, wolModes)
+		assert.Equal(t, tt.wolModesString, wolModes.String())
+	}
+}
@@ -30,6 +30,10 @@ func TestRegisterResource(t *testing.T) {
 		&block.DiscoveryRefreshStatus{},
 		&block.DiscoveredVolume{},
 		&block.Disk{},
+		&block.DiskHealth{},
+		&block.KeySlotRefreshRequest{},
+		&block.ProjectQuotaStatus{},
+		&block.SwapStatus{},
 		&block.SystemDisk{},
 		&block.UserDiskConfigStatus{},
 		&block.VolumeConfig{},
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DeviceSpec -type DiscoveredVolumeSpec -type DiscoveryRefreshRequestSpec -type DiscoveryRefreshStatusSpec -type DiskSpec -type SystemDiskSpec -type UserDiskConfigStatusSpec -type VolumeConfigSpec -type VolumeLifecycleSpec -type VolumeStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type DeviceSpec -type DiscoveredVolumeSpec -type DiscoveryRefreshRequestSpec -type DiscoveryRefreshStatusSpec -type DiskHealthSpec -type DiskSpec -type KeySlotRefreshRequestSpec -type ProjectQuotaStatusSpec -type SwapStatusSpec -type SystemDiskSpec -type UserDiskConfigStatusSpec -type VolumeConfigSpec -type VolumeLifecycleSpec -type VolumeStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package block
 
@@ -15,6 +15,14 @@ func (o DeviceSpec) DeepCopy() DeviceSpec {
 // DeepCopy generates a deep copy of DiscoveredVolumeSpec.
 func (o DiscoveredVolumeSpec) DeepCopy() DiscoveredVolumeSpec {
 	var cp DiscoveredVolumeSpec = o
+	if o.RAID != nil {
+		cp.RAID = new(RAIDStatus)
+		*cp.RAID = *o.RAID
+		if o.RAID.Members != nil {
+			cp.RAID.Members = make([]string, len(o.RAID.Members))
+			copy(cp.RAID.Members, o.RAID.Members)
+		}
+	}
 	return cp
 }
 
@@ -30,12 +38,36 @@ func (o DiscoveryRefreshStatusSpec) DeepCopy() DiscoveryRefreshStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DiskHealthSpec.
+func (o DiskHealthSpec) DeepCopy() DiskHealthSpec {
+	var cp DiskHealthSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of DiskSpec.
 func (o DiskSpec) DeepCopy() DiskSpec {
 	var cp DiskSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of KeySlotRefreshRequestSpec.
+func (o KeySlotRefreshRequestSpec) DeepCopy() KeySlotRefreshRequestSpec {
+	var cp KeySlotRefreshRequestSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ProjectQuotaStatusSpec.
+func (o ProjectQuotaStatusSpec) DeepCopy() ProjectQuotaStatusSpec {
+	var cp ProjectQuotaStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of SwapStatusSpec.
+func (o SwapStatusSpec) DeepCopy() SwapStatusSpec {
+	var cp SwapStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of SystemDiskSpec.
 func (o SystemDiskSpec) DeepCopy() SystemDiskSpec {
 	var cp SystemDiskSpec = o
@@ -51,6 +83,14 @@ func (o UserDiskConfigStatusSpec) DeepCopy() UserDiskConfigStatusSpec {
 // DeepCopy generates a deep copy of VolumeConfigSpec.
 func (o VolumeConfigSpec) DeepCopy() VolumeConfigSpec {
 	var cp VolumeConfigSpec = o
+	if o.Provisioning.FilesystemSpec.EXT4 != nil {
+		cp.Provisioning.FilesystemSpec.EXT4 = new(EXT4FilesystemSpec)
+		*cp.Provisioning.FilesystemSpec.EXT4 = *o.Provisioning.FilesystemSpec.EXT4
+	}
+	if o.Provisioning.FilesystemSpec.BTRFS != nil {
+		cp.Provisioning.FilesystemSpec.BTRFS = new(BTRFSFilesystemSpec)
+		*cp.Provisioning.FilesystemSpec.BTRFS = *o.Provisioning.FilesystemSpec.BTRFS
+	}
 	if o.Encryption.Keys != nil {
 		cp.Encryption.Keys = make([]EncryptionKey, len(o.Encryption.Keys))
 		copy(cp.Encryption.Keys, o.Encryption.Keys)
@@ -59,6 +99,18 @@ func (o VolumeConfigSpec) DeepCopy() VolumeConfigSpec {
 				cp.Encryption.Keys[i3].StaticPassphrase = make([]byte, len(o.Encryption.Keys[i3].StaticPassphrase))
 				copy(cp.Encryption.Keys[i3].StaticPassphrase, o.Encryption.Keys[i3].StaticPassphrase)
 			}
+			if o.Encryption.Keys[i3].Vault.CACert != nil {
+				cp.Encryption.Keys[i3].Vault.CACert = make([]byte, len(o.Encryption.Keys[i3].Vault.CACert))
+				copy(cp.Encryption.Keys[i3].Vault.CACert, o.Encryption.Keys[i3].Vault.CACert)
+			}
+			if o.Encryption.Keys[i3].Vault.CertClientCert != nil {
+				cp.Encryption.Keys[i3].Vault.CertClientCert = make([]byte, len(o.Encryption.Keys[i3].Vault.CertClientCert))
+				copy(cp.Encryption.Keys[i3].Vault.CertClientCert, o.Encryption.Keys[i3].Vault.CertClientCert)
+			}
+			if o.Encryption.Keys[i3].Vault.CertClientKey != nil {
+				cp.Encryption.Keys[i3].Vault.CertClientKey = make([]byte, len(o.Encryption.Keys[i3].Vault.CertClientKey))
+				copy(cp.Encryption.Keys[i3].Vault.CertClientKey, o.Encryption.Keys[i3].Vault.CertClientKey)
+			}
 		}
 	}
 	if o.Encryption.PerfOptions != nil {
@@ -77,5 +129,25 @@ func (o VolumeLifecycleSpec) DeepCopy() VolumeLifecycleSpec {
 // DeepCopy generates a deep copy of VolumeStatusSpec.
 func (o VolumeStatusSpec) DeepCopy() VolumeStatusSpec {
 	var cp VolumeStatusSpec = o
+	if o.EncryptionKeySlots != nil {
+		cp.EncryptionKeySlots = make([]EncryptionKeySlotStatus, len(o.EncryptionKeySlots))
+		copy(cp.EncryptionKeySlots, o.EncryptionKeySlots)
+	}
+	if o.LVM != nil {
+		cp.LVM = new(LVMStatus)
+		*cp.LVM = *o.LVM
+		if o.LVM.PhysicalVolumes != nil {
+			cp.LVM.PhysicalVolumes = make([]string, len(o.LVM.PhysicalVolumes))
+			copy(cp.LVM.PhysicalVolumes, o.LVM.PhysicalVolumes)
+		}
+	}
+	if o.RAID != nil {
+		cp.RAID = new(RAIDStatus)
+		*cp.RAID = *o.RAID
+		if o.RAID.Members != nil {
+			cp.RAID.Members = make([]string, len(o.RAID.Members))
+			copy(cp.RAID.Members, o.RAID.Members)
+		}
+	}
 	return cp
 }
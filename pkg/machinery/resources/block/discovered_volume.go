@@ -52,6 +52,9 @@ type DiscoveredVolumeSpec struct {
 	PartitionType  string `yaml:"partition_type,omitempty" protobuf:"11"`
 	PartitionLabel string `yaml:"partition_label,omitempty" protobuf:"12"`
 	PartitionIndex uint   `yaml:"partition_index,omitempty" protobuf:"13"`
+
+	// RAID is the status of the software RAID array (only for md devices).
+	RAID *RAIDStatus `yaml:"raid,omitempty" protobuf:"20"`
 }
 
 // SetSize sets the size of the DiscoveredVolume, including the pretty size.
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DiskHealthType is type of DiskHealth resource.
+const DiskHealthType = resource.Type("DiskHealths.block.talos.dev")
+
+// DiskHealth resource holds the SMART/NVMe health information of a disk.
+type DiskHealth = typed.Resource[DiskHealthSpec, DiskHealthExtension]
+
+// DiskHealthSpec is the spec for DiskHealth resource.
+//
+//gotagsrewrite:gen
+type DiskHealthSpec struct {
+	DevPath  string `yaml:"devPath" protobuf:"1"`
+	Protocol string `yaml:"protocol" protobuf:"2"`
+
+	// Overall health assessment reported by the disk.
+	Healthy bool `yaml:"healthy" protobuf:"3"`
+	// NVMe critical warning bitmask.
+	CriticalWarning uint32 `yaml:"criticalWarning,omitempty" protobuf:"4"`
+
+	Temperature        int32  `yaml:"temperature,omitempty" protobuf:"5"`
+	PercentageUsed     uint32 `yaml:"percentageUsed,omitempty" protobuf:"6"`
+	MediaErrors        uint64 `yaml:"mediaErrors,omitempty" protobuf:"7"`
+	ReallocatedSectors uint64 `yaml:"reallocatedSectors,omitempty" protobuf:"8"`
+	PowerOnHours       uint64 `yaml:"powerOnHours,omitempty" protobuf:"9"`
+	PowerCycles        uint64 `yaml:"powerCycles,omitempty" protobuf:"10"`
+}
+
+// NewDiskHealth initializes a DiskHealth resource.
+func NewDiskHealth(namespace resource.Namespace, id resource.ID) *DiskHealth {
+	return typed.NewResource[DiskHealthSpec, DiskHealthExtension](
+		resource.NewMetadata(namespace, DiskHealthType, id, resource.VersionUndefined),
+		DiskHealthSpec{},
+	)
+}
+
+// DiskHealthExtension is auxiliary resource data for DiskHealth.
+type DiskHealthExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DiskHealthExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskHealthType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Protocol",
+				JSONPath: `{.protocol}`,
+			},
+			{
+				Name:     "Healthy",
+				JSONPath: `{.healthy}`,
+			},
+			{
+				Name:     "Temperature",
+				JSONPath: `{.temperature}`,
+			},
+			{
+				Name:     "Used",
+				JSONPath: `{.percentageUsed}`,
+			},
+			{
+				Name:     "Media Errors",
+				JSONPath: `{.mediaErrors}`,
+			},
+			{
+				Name:     "Reallocated",
+				JSONPath: `{.reallocatedSectors}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DiskHealthSpec](DiskHealthType, &DiskHealth{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -16,4 +16,6 @@ const (
 	FilesystemTypeVFAT                          // vfat
 	FilesystemTypeEXT4                          // ext4
 	FilesystemTypeISO9660                       // iso9660
+	FilesystemTypeBTRFS                         // btrfs
+	FilesystemTypeSwap                          // swap
 )
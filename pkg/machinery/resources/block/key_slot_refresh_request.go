@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// KeySlotRefreshRequestType is type of KeySlotRefreshRequest resource.
+const KeySlotRefreshRequestType = resource.Type("KeySlotRefreshRequests.block.talos.dev")
+
+// KeySlotRefreshRequest resource holds a request to refresh the encryption key slots of the volume.
+//
+// The resource ID is the volume ID, the request is created when the key slots are changed via the machine API,
+// and destroyed once the volume manager acknowledges it.
+type KeySlotRefreshRequest = typed.Resource[KeySlotRefreshRequestSpec, KeySlotRefreshRequestExtension]
+
+// KeySlotRefreshRequestSpec is the spec for KeySlotRefreshRequest.
+//
+//gotagsrewrite:gen
+type KeySlotRefreshRequestSpec struct {
+	Request int `yaml:"request" protobuf:"1"`
+}
+
+// NewKeySlotRefreshRequest initializes a KeySlotRefreshRequest resource.
+func NewKeySlotRefreshRequest(namespace resource.Namespace, id resource.ID) *KeySlotRefreshRequest {
+	return typed.NewResource[KeySlotRefreshRequestSpec, KeySlotRefreshRequestExtension](
+		resource.NewMetadata(namespace, KeySlotRefreshRequestType, id, resource.VersionUndefined),
+		KeySlotRefreshRequestSpec{},
+	)
+}
+
+// KeySlotRefreshRequestExtension is auxiliary resource data for KeySlotRefreshRequest.
+type KeySlotRefreshRequestExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (KeySlotRefreshRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KeySlotRefreshRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Request",
+				JSONPath: `{.request}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[KeySlotRefreshRequestSpec](KeySlotRefreshRequestType, &KeySlotRefreshRequest{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ProjectQuotaStatusType is type of ProjectQuotaStatus resource.
+const ProjectQuotaStatusType = resource.Type("ProjectQuotaStatuses.block.talos.dev")
+
+// ProjectQuotaStatus resource holds the status of the directory project quota.
+//
+// The ID of the resource is the path of the directory.
+type ProjectQuotaStatus = typed.Resource[ProjectQuotaStatusSpec, ProjectQuotaStatusExtension]
+
+// ProjectQuotaStatusSpec is the spec for ProjectQuotaStatus resource.
+//
+//gotagsrewrite:gen
+type ProjectQuotaStatusSpec struct {
+	ProjectID uint32 `yaml:"projectID" protobuf:"1"`
+
+	HardLimit uint64 `yaml:"hardLimit" protobuf:"2"`
+	SoftLimit uint64 `yaml:"softLimit,omitempty" protobuf:"3"`
+
+	UsedBytes       uint64 `yaml:"usedBytes" protobuf:"4"`
+	PrettyUsedBytes string `yaml:"prettyUsedBytes" protobuf:"5"`
+	UsedInodes      uint64 `yaml:"usedInodes" protobuf:"6"`
+}
+
+// NewProjectQuotaStatus initializes a ProjectQuotaStatus resource.
+func NewProjectQuotaStatus(namespace resource.Namespace, id resource.ID) *ProjectQuotaStatus {
+	return typed.NewResource[ProjectQuotaStatusSpec, ProjectQuotaStatusExtension](
+		resource.NewMetadata(namespace, ProjectQuotaStatusType, id, resource.VersionUndefined),
+		ProjectQuotaStatusSpec{},
+	)
+}
+
+// ProjectQuotaStatusExtension is auxiliary resource data for ProjectQuotaStatus.
+type ProjectQuotaStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ProjectQuotaStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ProjectQuotaStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Project ID",
+				JSONPath: `{.projectID}`,
+			},
+			{
+				Name:     "Hard Limit",
+				JSONPath: `{.hardLimit}`,
+			},
+			{
+				Name:     "Used",
+				JSONPath: `{.prettyUsedBytes}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[ProjectQuotaStatusSpec](ProjectQuotaStatusType, &ProjectQuotaStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -66,6 +66,12 @@ type ProvisioningSpec struct {
 
 	// FilesystemSpec describes how to provision the volume (filesystem type).
 	FilesystemSpec FilesystemSpec `yaml:"filesystemSpec,omitempty" protobuf:"4"`
+
+	// LVMSpec describes how to provision the volume (LVM logical volume).
+	LVMSpec LVMSpec `yaml:"lvmSpec,omitempty" protobuf:"5"`
+
+	// RAIDSpec describes how to provision the volume (software RAID array).
+	RAIDSpec RAIDSpec `yaml:"raidSpec,omitempty" protobuf:"6"`
 }
 
 // DiskSelector selects a disk for the volume.
@@ -95,6 +101,61 @@ type PartitionSpec struct {
 	TypeUUID string `yaml:"typeUUID,omitempty" protobuf:"5"`
 }
 
+// LVMSpec is the spec for LVM logical volume provisioning.
+//
+// The volume group is created (or extended) on as many disks matched by the disk selector
+// as needed to fit the requested size, the size of the logical volume is taken from the PartitionSpec.
+//
+//gotagsrewrite:gen
+type LVMSpec struct {
+	// Volume group name.
+	VolumeGroup string `yaml:"volumeGroup" protobuf:"1"`
+
+	// Logical volume name.
+	LogicalVolume string `yaml:"logicalVolume" protobuf:"2"`
+
+	// Thin pool name, if set the logical volume is thin-provisioned in the pool.
+	ThinPool string `yaml:"thinPool,omitempty" protobuf:"3"`
+
+	// Thin pool size, used when the thin pool is created (defaults to the size of the logical volume).
+	ThinPoolSize uint64 `yaml:"thinPoolSize,omitempty" protobuf:"4"`
+}
+
+// RAIDSpec is the spec for software RAID (md) array provisioning.
+//
+// The array is created on the disks matched by the disk selector,
+// the volume is placed directly on the array device.
+//
+//gotagsrewrite:gen
+type RAIDSpec struct {
+	// Array name (stored in the md superblock).
+	Name string `yaml:"name" protobuf:"1"`
+
+	// RAID level (raid1, raid10).
+	Level string `yaml:"level" protobuf:"2"`
+
+	// Number of member disks in the array.
+	Devices int `yaml:"devices" protobuf:"3"`
+}
+
+// RAID levels supported for volume provisioning.
+const (
+	RAIDLevel1  = "raid1"
+	RAIDLevel10 = "raid10"
+)
+
+// RAIDMinDevices returns the minimum number of member devices for the RAID level.
+func RAIDMinDevices(level string) (int, bool) {
+	switch level {
+	case RAIDLevel1:
+		return 2, true
+	case RAIDLevel10:
+		return 4, true
+	default:
+		return 0, false
+	}
+}
+
 // LocatorSpec is the spec for volume locator.
 //
 //gotagsrewrite:gen
@@ -110,6 +171,26 @@ type FilesystemSpec struct {
 	Type FilesystemType `yaml:"type" protobuf:"1"`
 	// Filesystem label.
 	Label string `yaml:"label,omitempty" protobuf:"2"`
+	// ext4 filesystem options (only for Type == ext4).
+	EXT4 *EXT4FilesystemSpec `yaml:"ext4,omitempty" protobuf:"3"`
+	// btrfs filesystem options (only for Type == btrfs).
+	BTRFS *BTRFSFilesystemSpec `yaml:"btrfs,omitempty" protobuf:"4"`
+}
+
+// EXT4FilesystemSpec is the spec for ext4 filesystem options.
+//
+//gotagsrewrite:gen
+type EXT4FilesystemSpec struct {
+	// Percentage of the filesystem blocks reserved for the super-user.
+	ReservedBlocksPercentage uint32 `yaml:"reservedBlocksPercentage" protobuf:"1"`
+}
+
+// BTRFSFilesystemSpec is the spec for btrfs filesystem options.
+//
+//gotagsrewrite:gen
+type BTRFSFilesystemSpec struct {
+	// Compression algorithm (and optional level), passed as a mount option.
+	Compression string `yaml:"compression,omitempty" protobuf:"1"`
 }
 
 // EncryptionSpec is the spec for volume encryption.
@@ -139,6 +220,34 @@ type EncryptionKey struct {
 
 	// Only for Type == "tpm":
 	TPMCheckSecurebootStatusOnEnroll bool `yaml:"tpmCheckSecurebootStatusOnEnroll,omitempty" protobuf:"5"`
+
+	// Only for Type == "vault":
+	Vault EncryptionKeyVaultSpec `yaml:"vault,omitempty" protobuf:"6"`
+}
+
+// EncryptionKeyVaultSpec is the spec for the encryption key sealed by the Vault Transit secrets engine.
+//
+//gotagsrewrite:gen
+type EncryptionKeyVaultSpec struct {
+	// Vault server address, e.g. https://vault.example.com:8200.
+	Address string `yaml:"address" protobuf:"1"`
+	// PEM-encoded CA certificate(s) to verify the Vault server certificate.
+	CACert []byte `yaml:"caCert,omitempty" protobuf:"2"`
+
+	// Transit secrets engine mount path and the name of the key.
+	TransitMount string `yaml:"transitMount" protobuf:"3"`
+	TransitKey   string `yaml:"transitKey" protobuf:"4"`
+
+	// AppRole auth method settings.
+	AppRoleMount    string `yaml:"appRoleMount,omitempty" protobuf:"5"`
+	AppRoleRoleID   string `yaml:"appRoleRoleID,omitempty" protobuf:"6"`
+	AppRoleSecretID string `yaml:"appRoleSecretID,omitempty" protobuf:"7"`
+
+	// TLS certificates auth method settings.
+	CertMount      string `yaml:"certMount,omitempty" protobuf:"8"`
+	CertRole       string `yaml:"certRole,omitempty" protobuf:"9"`
+	CertClientCert []byte `yaml:"certClientCert,omitempty" protobuf:"10"`
+	CertClientKey  []byte `yaml:"certClientKey,omitempty" protobuf:"11"`
 }
 
 // MountSpec is the spec for volume mount.
@@ -46,8 +46,58 @@ type VolumeStatusSpec struct {
 
 	// EncryptionProvider is the provider of the encryption.
 	EncryptionProvider EncryptionProviderType `yaml:"encryptionProvider,omitempty" protobuf:"12"`
+	// EncryptionKeySlots is the key slot layout of the encrypted volume.
+	EncryptionKeySlots []EncryptionKeySlotStatus `yaml:"encryptionKeySlots,omitempty" protobuf:"16"`
 
 	ErrorMessage string `yaml:"errorMessage,omitempty" protobuf:"3"`
+
+	// LVM is the status of the LVM logical volume (only for LVM volumes).
+	LVM *LVMStatus `yaml:"lvm,omitempty" protobuf:"14"`
+
+	// RAID is the status of the software RAID array (only for RAID volumes).
+	RAID *RAIDStatus `yaml:"raid,omitempty" protobuf:"15"`
+}
+
+// LVMStatus describes the state of the LVM logical volume and its volume group.
+//
+//gotagsrewrite:gen
+type LVMStatus struct {
+	VolumeGroup     string   `yaml:"volumeGroup" protobuf:"1"`
+	LogicalVolume   string   `yaml:"logicalVolume" protobuf:"2"`
+	ThinPool        string   `yaml:"thinPool,omitempty" protobuf:"3"`
+	PhysicalVolumes []string `yaml:"physicalVolumes,omitempty" protobuf:"4"`
+
+	VolumeGroupSize uint64 `yaml:"volumeGroupSize,omitempty" protobuf:"5"`
+	VolumeGroupFree uint64 `yaml:"volumeGroupFree,omitempty" protobuf:"6"`
+}
+
+// RAIDStatus describes the state of the software RAID (md) array.
+//
+//gotagsrewrite:gen
+type RAIDStatus struct {
+	Name       string   `yaml:"name,omitempty" protobuf:"1"`
+	Level      string   `yaml:"level" protobuf:"2"`
+	ArrayState string   `yaml:"arrayState" protobuf:"3"`
+	Devices    int      `yaml:"devices" protobuf:"4"`
+	Members    []string `yaml:"members,omitempty" protobuf:"5"`
+
+	// DegradedDevices is the number of missing or failed member devices.
+	DegradedDevices int `yaml:"degradedDevices,omitempty" protobuf:"6"`
+
+	// SyncAction is the current sync action (idle, resync, recover, check, repair).
+	SyncAction string `yaml:"syncAction,omitempty" protobuf:"7"`
+	// SyncProgress is the progress of the current sync action (in percent).
+	SyncProgress float64 `yaml:"syncProgress,omitempty" protobuf:"8"`
+}
+
+// EncryptionKeySlotStatus describes a key slot of the encrypted volume.
+//
+//gotagsrewrite:gen
+type EncryptionKeySlotStatus struct {
+	Slot int `yaml:"slot" protobuf:"1"`
+
+	// Type is the key type from the volume configuration, empty if the key slot is not configured.
+	Type string `yaml:"type,omitempty" protobuf:"2"`
 }
 
 // SetSize sets the size of the volume status, including the pretty size.
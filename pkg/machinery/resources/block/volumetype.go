@@ -14,4 +14,6 @@ const (
 	VolumeTypePartition VolumeType = iota // partition
 	VolumeTypeDisk                        // disk
 	VolumeTypeTmpfs                       // tmpfs
+	VolumeTypeLVM                         // lvm
+	VolumeTypeRAID                        // raid
 )
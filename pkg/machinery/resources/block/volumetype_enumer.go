@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _VolumeTypeName = "partitiondisktmpfs"
+const _VolumeTypeName = "partitiondisktmpfslvmraid"
 
-var _VolumeTypeIndex = [...]uint8{0, 9, 13, 18}
+var _VolumeTypeIndex = [...]uint8{0, 9, 13, 18, 21, 25}
 
-const _VolumeTypeLowerName = "partitiondisktmpfs"
+const _VolumeTypeLowerName = "partitiondisktmpfslvmraid"
 
 func (i VolumeType) String() string {
 	if i < 0 || i >= VolumeType(len(_VolumeTypeIndex)-1) {
@@ -27,9 +27,11 @@ func _VolumeTypeNoOp() {
 	_ = x[VolumeTypePartition-(0)]
 	_ = x[VolumeTypeDisk-(1)]
 	_ = x[VolumeTypeTmpfs-(2)]
+	_ = x[VolumeTypeLVM-(3)]
+	_ = x[VolumeTypeRAID-(4)]
 }
 
-var _VolumeTypeValues = []VolumeType{VolumeTypePartition, VolumeTypeDisk, VolumeTypeTmpfs}
+var _VolumeTypeValues = []VolumeType{VolumeTypePartition, VolumeTypeDisk, VolumeTypeTmpfs, VolumeTypeLVM, VolumeTypeRAID}
 
 var _VolumeTypeNameToValueMap = map[string]VolumeType{
 	_VolumeTypeName[0:9]:        VolumeTypePartition,
@@ -38,12 +40,18 @@ var _VolumeTypeNameToValueMap = map[string]VolumeType{
 	_VolumeTypeLowerName[9:13]:  VolumeTypeDisk,
 	_VolumeTypeName[13:18]:      VolumeTypeTmpfs,
 	_VolumeTypeLowerName[13:18]: VolumeTypeTmpfs,
+	_VolumeTypeName[18:21]:      VolumeTypeLVM,
+	_VolumeTypeLowerName[18:21]: VolumeTypeLVM,
+	_VolumeTypeName[21:25]:      VolumeTypeRAID,
+	_VolumeTypeLowerName[21:25]: VolumeTypeRAID,
 }
 
 var _VolumeTypeNames = []string{
 	_VolumeTypeName[0:9],
 	_VolumeTypeName[9:13],
 	_VolumeTypeName[13:18],
+	_VolumeTypeName[18:21],
+	_VolumeTypeName[21:25],
 }
 
 // VolumeTypeString retrieves an enum value from the enum constants string name.
@@ -199,11 +207,11 @@ func (i *VolumePhase) UnmarshalText(text []byte) error {
 	return err
 }
 
-const _FilesystemTypeName = "nonexfsvfatext4iso9660"
+const _FilesystemTypeName = "nonexfsvfatext4iso9660btrfsswap"
 
-var _FilesystemTypeIndex = [...]uint8{0, 4, 7, 11, 15, 22}
+var _FilesystemTypeIndex = [...]uint8{0, 4, 7, 11, 15, 22, 27, 31}
 
-const _FilesystemTypeLowerName = "nonexfsvfatext4iso9660"
+const _FilesystemTypeLowerName = "nonexfsvfatext4iso9660btrfsswap"
 
 func (i FilesystemType) String() string {
 	if i < 0 || i >= FilesystemType(len(_FilesystemTypeIndex)-1) {
@@ -221,9 +229,11 @@ func _FilesystemTypeNoOp() {
 	_ = x[FilesystemTypeVFAT-(2)]
 	_ = x[FilesystemTypeEXT4-(3)]
 	_ = x[FilesystemTypeISO9660-(4)]
+	_ = x[FilesystemTypeBTRFS-(5)]
+	_ = x[FilesystemTypeSwap-(6)]
 }
 
-var _FilesystemTypeValues = []FilesystemType{FilesystemTypeNone, FilesystemTypeXFS, FilesystemTypeVFAT, FilesystemTypeEXT4, FilesystemTypeISO9660}
+var _FilesystemTypeValues = []FilesystemType{FilesystemTypeNone, FilesystemTypeXFS, FilesystemTypeVFAT, FilesystemTypeEXT4, FilesystemTypeISO9660, FilesystemTypeBTRFS, FilesystemTypeSwap}
 
 var _FilesystemTypeNameToValueMap = map[string]FilesystemType{
 	_FilesystemTypeName[0:4]:        FilesystemTypeNone,
@@ -236,6 +246,10 @@ var _FilesystemTypeNameToValueMap = map[string]FilesystemType{
 	_FilesystemTypeLowerName[11:15]: FilesystemTypeEXT4,
 	_FilesystemTypeName[15:22]:      FilesystemTypeISO9660,
 	_FilesystemTypeLowerName[15:22]: FilesystemTypeISO9660,
+	_FilesystemTypeName[22:27]:      FilesystemTypeBTRFS,
+	_FilesystemTypeLowerName[22:27]: FilesystemTypeBTRFS,
+	_FilesystemTypeName[27:31]:      FilesystemTypeSwap,
+	_FilesystemTypeLowerName[27:31]: FilesystemTypeSwap,
 }
 
 var _FilesystemTypeNames = []string{
@@ -244,6 +258,8 @@ var _FilesystemTypeNames = []string{
 	_FilesystemTypeName[7:11],
 	_FilesystemTypeName[11:15],
 	_FilesystemTypeName[15:22],
+	_FilesystemTypeName[22:27],
+	_FilesystemTypeName[27:31],
 }
 
 // FilesystemTypeString retrieves an enum value from the enum constants string name.
@@ -293,11 +309,11 @@ func (i *FilesystemType) UnmarshalText(text []byte) error {
 	return err
 }
 
-const _EncryptionKeyTypeName = "staticnodeIDkmstpm"
+const _EncryptionKeyTypeName = "staticnodeIDkmstpmvault"
 
-var _EncryptionKeyTypeIndex = [...]uint8{0, 6, 12, 15, 18}
+var _EncryptionKeyTypeIndex = [...]uint8{0, 6, 12, 15, 18, 23}
 
-const _EncryptionKeyTypeLowerName = "staticnodeidkmstpm"
+const _EncryptionKeyTypeLowerName = "staticnodeidkmstpmvault"
 
 func (i EncryptionKeyType) String() string {
 	if i < 0 || i >= EncryptionKeyType(len(_EncryptionKeyTypeIndex)-1) {
@@ -314,9 +330,10 @@ func _EncryptionKeyTypeNoOp() {
 	_ = x[EncryptionKeyNodeID-(1)]
 	_ = x[EncryptionKeyKMS-(2)]
 	_ = x[EncryptionKeyTPM-(3)]
+	_ = x[EncryptionKeyVault-(4)]
 }
 
-var _EncryptionKeyTypeValues = []EncryptionKeyType{EncryptionKeyStatic, EncryptionKeyNodeID, EncryptionKeyKMS, EncryptionKeyTPM}
+var _EncryptionKeyTypeValues = []EncryptionKeyType{EncryptionKeyStatic, EncryptionKeyNodeID, EncryptionKeyKMS, EncryptionKeyTPM, EncryptionKeyVault}
 
 var _EncryptionKeyTypeNameToValueMap = map[string]EncryptionKeyType{
 	_EncryptionKeyTypeName[0:6]:        EncryptionKeyStatic,
@@ -327,6 +344,8 @@ var _EncryptionKeyTypeNameToValueMap = map[string]EncryptionKeyType{
 	_EncryptionKeyTypeLowerName[12:15]: EncryptionKeyKMS,
 	_EncryptionKeyTypeName[15:18]:      EncryptionKeyTPM,
 	_EncryptionKeyTypeLowerName[15:18]: EncryptionKeyTPM,
+	_EncryptionKeyTypeName[18:23]:      EncryptionKeyVault,
+	_EncryptionKeyTypeLowerName[18:23]: EncryptionKeyVault,
 }
 
 var _EncryptionKeyTypeNames = []string{
@@ -334,6 +353,7 @@ var _EncryptionKeyTypeNames = []string{
 	_EncryptionKeyTypeName[6:12],
 	_EncryptionKeyTypeName[12:15],
 	_EncryptionKeyTypeName[15:18],
+	_EncryptionKeyTypeName[18:23],
 }
 
 // EncryptionKeyTypeString retrieves an enum value from the enum constants string name.
@@ -40,6 +40,7 @@ type KubeletConfigSpec struct {
 	DisableManifestsDirectory    bool              `yaml:"disableManifestsDirectory" protobuf:"11"`
 	EnableFSQuotaMonitoring      bool              `yaml:"enableFSQuotaMonitoring" protobuf:"12"`
 	CredentialProviderConfig     map[string]any    `yaml:"credentialProviderConfig,omitempty" protobuf:"13"`
+	SwapBehavior                 string            `yaml:"swapBehavior,omitempty" protobuf:"14"`
 }
 
 // NewKubeletConfig initializes an empty KubeletConfig resource.
@@ -2,14 +2,13 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSResolveCacheSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DelegatedPrefixSpec -type DNSResolveCacheSpec -type Dot1XStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LLDPNeighborSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NfTablesChainStatusSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type QdiscSpecSpec -type QdiscStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type SRIOVVFStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
 import (
-	"net/netip"
-
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"net/netip"
 )
 
 // DeepCopy generates a deep copy of AddressSpecSpec.
@@ -24,12 +23,32 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DelegatedPrefixSpec.
+func (o DelegatedPrefixSpec) DeepCopy() DelegatedPrefixSpec {
+	var cp DelegatedPrefixSpec = o
+	if o.Assignments != nil {
+		cp.Assignments = make([]DelegatedPrefixAssignment, len(o.Assignments))
+		copy(cp.Assignments, o.Assignments)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of Dot1XStatusSpec.
+func (o Dot1XStatusSpec) DeepCopy() Dot1XStatusSpec {
+	var cp Dot1XStatusSpec = o
+	if o.Authenticator != nil {
+		cp.Authenticator = make([]byte, len(o.Authenticator))
+		copy(cp.Authenticator, o.Authenticator)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HardwareAddrSpec.
 func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	var cp HardwareAddrSpec = o
@@ -47,6 +66,30 @@ func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
 		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
 		copy(cp.ListenAddresses, o.ListenAddresses)
 	}
+	if o.EncryptedUpstreams != nil {
+		cp.EncryptedUpstreams = make([]HostDNSEncryptedUpstream, len(o.EncryptedUpstreams))
+		copy(cp.EncryptedUpstreams, o.EncryptedUpstreams)
+	}
+	if o.ForwardZones != nil {
+		cp.ForwardZones = make([]HostDNSForwardZone, len(o.ForwardZones))
+		copy(cp.ForwardZones, o.ForwardZones)
+		for i2 := range o.ForwardZones {
+			if o.ForwardZones[i2].Servers != nil {
+				cp.ForwardZones[i2].Servers = make([]netip.AddrPort, len(o.ForwardZones[i2].Servers))
+				copy(cp.ForwardZones[i2].Servers, o.ForwardZones[i2].Servers)
+			}
+		}
+	}
+	if o.StaticRecords != nil {
+		cp.StaticRecords = make([]HostDNSStaticRecord, len(o.StaticRecords))
+		copy(cp.StaticRecords, o.StaticRecords)
+		for i2 := range o.StaticRecords {
+			if o.StaticRecords[i2].Addresses != nil {
+				cp.StaticRecords[i2].Addresses = make([]netip.Addr, len(o.StaticRecords[i2].Addresses))
+				copy(cp.StaticRecords[i2].Addresses, o.StaticRecords[i2].Addresses)
+			}
+		}
+	}
 	return cp
 }
 
@@ -62,6 +105,20 @@ func (o HostnameStatusSpec) DeepCopy() HostnameStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LLDPNeighborSpec.
+func (o LLDPNeighborSpec) DeepCopy() LLDPNeighborSpec {
+	var cp LLDPNeighborSpec = o
+	if o.ManagementAddresses != nil {
+		cp.ManagementAddresses = make([]netip.Addr, len(o.ManagementAddresses))
+		copy(cp.ManagementAddresses, o.ManagementAddresses)
+	}
+	if o.VLANList != nil {
+		cp.VLANList = make([]LLDPVLAN, len(o.VLANList))
+		copy(cp.VLANList, o.VLANList)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of LinkRefreshSpec.
 func (o LinkRefreshSpec) DeepCopy() LinkRefreshSpec {
 	var cp LinkRefreshSpec = o
@@ -81,6 +138,20 @@ func (o LinkSpecSpec) DeepCopy() LinkSpecSpec {
 			}
 		}
 	}
+	if o.Ethtool.Features != nil {
+		cp.Ethtool.Features = make(map[string]bool, len(o.Ethtool.Features))
+		for k3, v3 := range o.Ethtool.Features {
+			cp.Ethtool.Features[k3] = v3
+		}
+	}
+	if o.Ethtool.LinkMode != nil {
+		cp.Ethtool.LinkMode = new(EthtoolLinkModeSpec)
+		*cp.Ethtool.LinkMode = *o.Ethtool.LinkMode
+	}
+	if o.Ethtool.WakeOnLAN != nil {
+		cp.Ethtool.WakeOnLAN = new(EthtoolWakeOnLANSpec)
+		*cp.Ethtool.WakeOnLAN = *o.Ethtool.WakeOnLAN
+	}
 	return cp
 }
 
@@ -99,6 +170,12 @@ func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 		cp.BroadcastAddr = make([]byte, len(o.BroadcastAddr))
 		copy(cp.BroadcastAddr, o.BroadcastAddr)
 	}
+	if o.Ethtool.Features != nil {
+		cp.Ethtool.Features = make(map[string]bool, len(o.Ethtool.Features))
+		for k3, v3 := range o.Ethtool.Features {
+			cp.Ethtool.Features[k3] = v3
+		}
+	}
 	if o.Wireguard.Peers != nil {
 		cp.Wireguard.Peers = make([]WireguardPeer, len(o.Wireguard.Peers))
 		copy(cp.Wireguard.Peers, o.Wireguard.Peers)
@@ -203,6 +280,18 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 				cp.Rules[i2].SetMark = new(NfTablesMark)
 				*cp.Rules[i2].SetMark = *o.Rules[i2].SetMark
 			}
+			if o.Rules[i2].SNAT != nil {
+				cp.Rules[i2].SNAT = new(NfTablesNATTarget)
+				*cp.Rules[i2].SNAT = *o.Rules[i2].SNAT
+			}
+			if o.Rules[i2].DNAT != nil {
+				cp.Rules[i2].DNAT = new(NfTablesNATTarget)
+				*cp.Rules[i2].DNAT = *o.Rules[i2].DNAT
+			}
+			if o.Rules[i2].Log != nil {
+				cp.Rules[i2].Log = new(NfTablesLog)
+				*cp.Rules[i2].Log = *o.Rules[i2].Log
+			}
 			if o.Rules[i2].Verdict != nil {
 				cp.Rules[i2].Verdict = new(nethelpers.NfTablesVerdict)
 				*cp.Rules[i2].Verdict = *o.Rules[i2].Verdict
@@ -212,6 +301,118 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NfTablesChainStatusSpec.
+func (o NfTablesChainStatusSpec) DeepCopy() NfTablesChainStatusSpec {
+	var cp NfTablesChainStatusSpec = o
+	if o.Rules != nil {
+		cp.Rules = make([]NfTablesRuleStatus, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].Rule.MatchIIfName != nil {
+				cp.Rules[i2].Rule.MatchIIfName = new(NfTablesIfNameMatch)
+				*cp.Rules[i2].Rule.MatchIIfName = *o.Rules[i2].Rule.MatchIIfName
+				if o.Rules[i2].Rule.MatchIIfName.InterfaceNames != nil {
+					cp.Rules[i2].Rule.MatchIIfName.InterfaceNames = make([]string, len(o.Rules[i2].Rule.MatchIIfName.InterfaceNames))
+					copy(cp.Rules[i2].Rule.MatchIIfName.InterfaceNames, o.Rules[i2].Rule.MatchIIfName.InterfaceNames)
+				}
+			}
+			if o.Rules[i2].Rule.MatchOIfName != nil {
+				cp.Rules[i2].Rule.MatchOIfName = new(NfTablesIfNameMatch)
+				*cp.Rules[i2].Rule.MatchOIfName = *o.Rules[i2].Rule.MatchOIfName
+				if o.Rules[i2].Rule.MatchOIfName.InterfaceNames != nil {
+					cp.Rules[i2].Rule.MatchOIfName.InterfaceNames = make([]string, len(o.Rules[i2].Rule.MatchOIfName.InterfaceNames))
+					copy(cp.Rules[i2].Rule.MatchOIfName.InterfaceNames, o.Rules[i2].Rule.MatchOIfName.InterfaceNames)
+				}
+			}
+			if o.Rules[i2].Rule.MatchMark != nil {
+				cp.Rules[i2].Rule.MatchMark = new(NfTablesMark)
+				*cp.Rules[i2].Rule.MatchMark = *o.Rules[i2].Rule.MatchMark
+			}
+			if o.Rules[i2].Rule.MatchConntrackState != nil {
+				cp.Rules[i2].Rule.MatchConntrackState = new(NfTablesConntrackStateMatch)
+				*cp.Rules[i2].Rule.MatchConntrackState = *o.Rules[i2].Rule.MatchConntrackState
+				if o.Rules[i2].Rule.MatchConntrackState.States != nil {
+					cp.Rules[i2].Rule.MatchConntrackState.States = make([]nethelpers.ConntrackState, len(o.Rules[i2].Rule.MatchConntrackState.States))
+					copy(cp.Rules[i2].Rule.MatchConntrackState.States, o.Rules[i2].Rule.MatchConntrackState.States)
+				}
+			}
+			if o.Rules[i2].Rule.MatchSourceAddress != nil {
+				cp.Rules[i2].Rule.MatchSourceAddress = new(NfTablesAddressMatch)
+				*cp.Rules[i2].Rule.MatchSourceAddress = *o.Rules[i2].Rule.MatchSourceAddress
+				if o.Rules[i2].Rule.MatchSourceAddress.IncludeSubnets != nil {
+					cp.Rules[i2].Rule.MatchSourceAddress.IncludeSubnets = make([]netip.Prefix, len(o.Rules[i2].Rule.MatchSourceAddress.IncludeSubnets))
+					copy(cp.Rules[i2].Rule.MatchSourceAddress.IncludeSubnets, o.Rules[i2].Rule.MatchSourceAddress.IncludeSubnets)
+				}
+				if o.Rules[i2].Rule.MatchSourceAddress.ExcludeSubnets != nil {
+					cp.Rules[i2].Rule.MatchSourceAddress.ExcludeSubnets = make([]netip.Prefix, len(o.Rules[i2].Rule.MatchSourceAddress.ExcludeSubnets))
+					copy(cp.Rules[i2].Rule.MatchSourceAddress.ExcludeSubnets, o.Rules[i2].Rule.MatchSourceAddress.ExcludeSubnets)
+				}
+			}
+			if o.Rules[i2].Rule.MatchDestinationAddress != nil {
+				cp.Rules[i2].Rule.MatchDestinationAddress = new(NfTablesAddressMatch)
+				*cp.Rules[i2].Rule.MatchDestinationAddress = *o.Rules[i2].Rule.MatchDestinationAddress
+				if o.Rules[i2].Rule.MatchDestinationAddress.IncludeSubnets != nil {
+					cp.Rules[i2].Rule.MatchDestinationAddress.IncludeSubnets = make([]netip.Prefix, len(o.Rules[i2].Rule.MatchDestinationAddress.IncludeSubnets))
+					copy(cp.Rules[i2].Rule.MatchDestinationAddress.IncludeSubnets, o.Rules[i2].Rule.MatchDestinationAddress.IncludeSubnets)
+				}
+				if o.Rules[i2].Rule.MatchDestinationAddress.ExcludeSubnets != nil {
+					cp.Rules[i2].Rule.MatchDestinationAddress.ExcludeSubnets = make([]netip.Prefix, len(o.Rules[i2].Rule.MatchDestinationAddress.ExcludeSubnets))
+					copy(cp.Rules[i2].Rule.MatchDestinationAddress.ExcludeSubnets, o.Rules[i2].Rule.MatchDestinationAddress.ExcludeSubnets)
+				}
+			}
+			if o.Rules[i2].Rule.MatchLayer4 != nil {
+				cp.Rules[i2].Rule.MatchLayer4 = new(NfTablesLayer4Match)
+				*cp.Rules[i2].Rule.MatchLayer4 = *o.Rules[i2].Rule.MatchLayer4
+				if o.Rules[i2].Rule.MatchLayer4.MatchSourcePort != nil {
+					cp.Rules[i2].Rule.MatchLayer4.MatchSourcePort = new(NfTablesPortMatch)
+					*cp.Rules[i2].Rule.MatchLayer4.MatchSourcePort = *o.Rules[i2].Rule.MatchLayer4.MatchSourcePort
+					if o.Rules[i2].Rule.MatchLayer4.MatchSourcePort.Ranges != nil {
+						cp.Rules[i2].Rule.MatchLayer4.MatchSourcePort.Ranges = make([]PortRange, len(o.Rules[i2].Rule.MatchLayer4.MatchSourcePort.Ranges))
+						copy(cp.Rules[i2].Rule.MatchLayer4.MatchSourcePort.Ranges, o.Rules[i2].Rule.MatchLayer4.MatchSourcePort.Ranges)
+					}
+				}
+				if o.Rules[i2].Rule.MatchLayer4.MatchDestinationPort != nil {
+					cp.Rules[i2].Rule.MatchLayer4.MatchDestinationPort = new(NfTablesPortMatch)
+					*cp.Rules[i2].Rule.MatchLayer4.MatchDestinationPort = *o.Rules[i2].Rule.MatchLayer4.MatchDestinationPort
+					if o.Rules[i2].Rule.MatchLayer4.MatchDestinationPort.Ranges != nil {
+						cp.Rules[i2].Rule.MatchLayer4.MatchDestinationPort.Ranges = make([]PortRange, len(o.Rules[i2].Rule.MatchLayer4.MatchDestinationPort.Ranges))
+						copy(cp.Rules[i2].Rule.MatchLayer4.MatchDestinationPort.Ranges, o.Rules[i2].Rule.MatchLayer4.MatchDestinationPort.Ranges)
+					}
+				}
+			}
+			if o.Rules[i2].Rule.MatchLimit != nil {
+				cp.Rules[i2].Rule.MatchLimit = new(NfTablesLimitMatch)
+				*cp.Rules[i2].Rule.MatchLimit = *o.Rules[i2].Rule.MatchLimit
+			}
+			if o.Rules[i2].Rule.ClampMSS != nil {
+				cp.Rules[i2].Rule.ClampMSS = new(NfTablesClampMSS)
+				*cp.Rules[i2].Rule.ClampMSS = *o.Rules[i2].Rule.ClampMSS
+			}
+			if o.Rules[i2].Rule.SetMark != nil {
+				cp.Rules[i2].Rule.SetMark = new(NfTablesMark)
+				*cp.Rules[i2].Rule.SetMark = *o.Rules[i2].Rule.SetMark
+			}
+			if o.Rules[i2].Rule.SNAT != nil {
+				cp.Rules[i2].Rule.SNAT = new(NfTablesNATTarget)
+				*cp.Rules[i2].Rule.SNAT = *o.Rules[i2].Rule.SNAT
+			}
+			if o.Rules[i2].Rule.DNAT != nil {
+				cp.Rules[i2].Rule.DNAT = new(NfTablesNATTarget)
+				*cp.Rules[i2].Rule.DNAT = *o.Rules[i2].Rule.DNAT
+			}
+			if o.Rules[i2].Rule.Log != nil {
+				cp.Rules[i2].Rule.Log = new(NfTablesLog)
+				*cp.Rules[i2].Rule.Log = *o.Rules[i2].Rule.Log
+			}
+			if o.Rules[i2].Rule.Verdict != nil {
+				cp.Rules[i2].Rule.Verdict = new(nethelpers.NfTablesVerdict)
+				*cp.Rules[i2].Rule.Verdict = *o.Rules[i2].Rule.Verdict
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -239,6 +440,18 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.UserClass != nil {
+		cp.DHCP4.UserClass = make([]string, len(o.DHCP4.UserClass))
+		copy(cp.DHCP4.UserClass, o.DHCP4.UserClass)
+	}
+	if o.DHCP4.RequestedOptions != nil {
+		cp.DHCP4.RequestedOptions = make([]byte, len(o.DHCP4.RequestedOptions))
+		copy(cp.DHCP4.RequestedOptions, o.DHCP4.RequestedOptions)
+	}
+	if o.DHCP6.PrefixAssignments != nil {
+		cp.DHCP6.PrefixAssignments = make([]DHCP6PrefixAssignment, len(o.DHCP6.PrefixAssignments))
+		copy(cp.DHCP6.PrefixAssignments, o.DHCP6.PrefixAssignments)
+	}
 	return cp
 }
 
@@ -254,6 +467,26 @@ func (o ProbeStatusSpec) DeepCopy() ProbeStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of QdiscSpecSpec.
+func (o QdiscSpecSpec) DeepCopy() QdiscSpecSpec {
+	var cp QdiscSpecSpec = o
+	if o.HTB.Classes != nil {
+		cp.HTB.Classes = make([]HTBClassSpec, len(o.HTB.Classes))
+		copy(cp.HTB.Classes, o.HTB.Classes)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of QdiscStatusSpec.
+func (o QdiscStatusSpec) DeepCopy() QdiscStatusSpec {
+	var cp QdiscStatusSpec = o
+	if o.Classes != nil {
+		cp.Classes = make([]QdiscClassStatus, len(o.Classes))
+		copy(cp.Classes, o.Classes)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of ResolverSpecSpec.
 func (o ResolverSpecSpec) DeepCopy() ResolverSpecSpec {
 	var cp ResolverSpecSpec = o
@@ -261,6 +494,10 @@ func (o ResolverSpecSpec) DeepCopy() ResolverSpecSpec {
 		cp.DNSServers = make([]netip.Addr, len(o.DNSServers))
 		copy(cp.DNSServers, o.DNSServers)
 	}
+	if o.SearchDomains != nil {
+		cp.SearchDomains = make([]string, len(o.SearchDomains))
+		copy(cp.SearchDomains, o.SearchDomains)
+	}
 	return cp
 }
 
@@ -271,6 +508,10 @@ func (o ResolverStatusSpec) DeepCopy() ResolverStatusSpec {
 		cp.DNSServers = make([]netip.Addr, len(o.DNSServers))
 		copy(cp.DNSServers, o.DNSServers)
 	}
+	if o.SearchDomains != nil {
+		cp.SearchDomains = make([]string, len(o.SearchDomains))
+		copy(cp.SearchDomains, o.SearchDomains)
+	}
 	return cp
 }
 
@@ -286,6 +527,28 @@ func (o RouteStatusSpec) DeepCopy() RouteStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RoutingRuleSpecSpec.
+func (o RoutingRuleSpecSpec) DeepCopy() RoutingRuleSpecSpec {
+	var cp RoutingRuleSpecSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RoutingRuleStatusSpec.
+func (o RoutingRuleStatusSpec) DeepCopy() RoutingRuleStatusSpec {
+	var cp RoutingRuleStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of SRIOVVFStatusSpec.
+func (o SRIOVVFStatusSpec) DeepCopy() SRIOVVFStatusSpec {
+	var cp SRIOVVFStatusSpec = o
+	if o.HardwareAddr != nil {
+		cp.HardwareAddr = make([]byte, len(o.HardwareAddr))
+		copy(cp.HardwareAddr, o.HardwareAddr)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of StatusSpec.
 func (o StatusSpec) DeepCopy() StatusSpec {
 	var cp StatusSpec = o
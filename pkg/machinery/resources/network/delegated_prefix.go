@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DelegatedPrefixType is type of DelegatedPrefix resource.
+const DelegatedPrefixType = resource.Type("DelegatedPrefixes.net.talos.dev")
+
+// DelegatedPrefix resource holds the IPv6 prefix delegated via DHCPv6.
+type DelegatedPrefix = typed.Resource[DelegatedPrefixSpec, DelegatedPrefixExtension]
+
+// DelegatedPrefixSpec describes the IPv6 prefix delegated via DHCPv6 and the subnets assigned from it.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixSpec struct {
+	LinkName          string                      `yaml:"linkName" protobuf:"1"`
+	Prefix            netip.Prefix                `yaml:"prefix" protobuf:"2"`
+	PreferredLifetime time.Duration               `yaml:"preferredLifetime" protobuf:"3"`
+	ValidLifetime     time.Duration               `yaml:"validLifetime" protobuf:"4"`
+	Assignments       []DelegatedPrefixAssignment `yaml:"assignments,omitempty" protobuf:"5"`
+}
+
+// DelegatedPrefixAssignment describes a subnet of the delegated prefix.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixAssignment struct {
+	Prefix         netip.Prefix `yaml:"prefix" protobuf:"1"`
+	LinkName       string       `yaml:"linkName,omitempty" protobuf:"2"`
+	NodeAnnotation string       `yaml:"nodeAnnotation,omitempty" protobuf:"3"`
+}
+
+// NewDelegatedPrefix initializes a DelegatedPrefix resource.
+func NewDelegatedPrefix(namespace resource.Namespace, id resource.ID) *DelegatedPrefix {
+	return typed.NewResource[DelegatedPrefixSpec, DelegatedPrefixExtension](
+		resource.NewMetadata(namespace, DelegatedPrefixType, id, resource.VersionUndefined),
+		DelegatedPrefixSpec{},
+	)
+}
+
+// DelegatedPrefixExtension provides auxiliary methods for DelegatedPrefix.
+type DelegatedPrefixExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DelegatedPrefixExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DelegatedPrefixType,
+		Aliases:          []resource.Type{"pd"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Prefix",
+				JSONPath: `{.prefix}`,
+			},
+			{
+				Name:     "Valid Lifetime",
+				JSONPath: `{.validLifetime}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DelegatedPrefixSpec](DelegatedPrefixType, &DelegatedPrefix{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -28,6 +28,8 @@ type DNSUpstreamSpec = handle.ResourceSpec[DNSUpstreamSpecSpec]
 // DNSUpstreamSpecSpec describes DNS upstreams status.
 type DNSUpstreamSpecSpec struct {
 	Conn *DNSConn
+	// Zone is the conditional forwarding zone the upstream is used for, empty for the default upstreams.
+	Zone string
 }
 
 // MarshalYAML implements yaml.Marshaler interface.
@@ -37,6 +39,7 @@ func (d DNSUpstreamSpecSpec) MarshalYAML() (any, error) {
 	return map[string]string{
 		"healthy": strconv.FormatBool(d.Conn.Fails() == 0),
 		"addr":    d.Conn.Addr(),
+		"zone":    d.Zone,
 	}, nil
 }
 
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// Dot1XStatusType is type of Dot1XStatus resource.
+const Dot1XStatusType = resource.Type("Dot1XStatuses.net.talos.dev")
+
+// Dot1XStatus resource holds the 802.1X port authentication state of the link.
+type Dot1XStatus = typed.Resource[Dot1XStatusSpec, Dot1XStatusExtension]
+
+// Dot1XStatusSpec describes the 802.1X port authentication state of the link.
+//
+//gotagsrewrite:gen
+type Dot1XStatusSpec struct {
+	LinkName      string                  `yaml:"linkName" protobuf:"1"`
+	State         nethelpers.Dot1XState   `yaml:"state" protobuf:"2"`
+	Identity      string                  `yaml:"identity" protobuf:"3"`
+	Authenticator nethelpers.HardwareAddr `yaml:"authenticator,omitempty" protobuf:"4"`
+	LastError     string                  `yaml:"lastError,omitempty" protobuf:"5"`
+}
+
+// NewDot1XStatus initializes a Dot1XStatus resource.
+func NewDot1XStatus(namespace resource.Namespace, id resource.ID) *Dot1XStatus {
+	return typed.NewResource[Dot1XStatusSpec, Dot1XStatusExtension](
+		resource.NewMetadata(namespace, Dot1XStatusType, id, resource.VersionUndefined),
+		Dot1XStatusSpec{},
+	)
+}
+
+// Dot1XStatusExtension provides auxiliary methods for Dot1XStatus.
+type Dot1XStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (Dot1XStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             Dot1XStatusType,
+		Aliases:          []resource.Type{"dot1x"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+			{
+				Name:     "Identity",
+				JSONPath: `{.identity}`,
+			},
+			{
+				Name:     "Authenticator",
+				JSONPath: `{.authenticator}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[Dot1XStatusSpec](Dot1XStatusType, &Dot1XStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -32,6 +33,41 @@ type HostDNSConfigSpec struct {
 	ListenAddresses       []netip.AddrPort `yaml:"listenAddresses,omitempty" protobuf:"2"`
 	ServiceHostDNSAddress netip.Addr       `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
 	ResolveMemberNames    bool             `yaml:"resolveMemberNames,omitempty" protobuf:"4"`
+
+	EncryptedUpstreams []HostDNSEncryptedUpstream `yaml:"encryptedUpstreams,omitempty" protobuf:"5"`
+	ForwardZones       []HostDNSForwardZone       `yaml:"forwardZones,omitempty" protobuf:"6"`
+	StaticRecords      []HostDNSStaticRecord      `yaml:"staticRecords,omitempty" protobuf:"7"`
+
+	CacheMinTTL      time.Duration `yaml:"cacheMinTTL,omitempty" protobuf:"8"`
+	CacheMaxTTL      time.Duration `yaml:"cacheMaxTTL,omitempty" protobuf:"9"`
+	CacheNegativeTTL time.Duration `yaml:"cacheNegativeTTL,omitempty" protobuf:"10"`
+}
+
+// HostDNSEncryptedUpstream describes DNS-over-TLS or DNS-over-HTTPS upstream.
+//
+//gotagsrewrite:gen
+type HostDNSEncryptedUpstream struct {
+	URL            string `yaml:"url" protobuf:"1"`
+	ServerName     string `yaml:"serverName,omitempty" protobuf:"2"`
+	CACertificates string `yaml:"caCertificates,omitempty" protobuf:"3"`
+}
+
+// HostDNSForwardZone describes conditional forwarding of a domain to the specific servers.
+//
+//gotagsrewrite:gen
+type HostDNSForwardZone struct {
+	Domain  string           `yaml:"domain" protobuf:"1"`
+	Servers []netip.AddrPort `yaml:"servers" protobuf:"2"`
+}
+
+// HostDNSStaticRecord describes a static A/AAAA or CNAME record.
+//
+//gotagsrewrite:gen
+type HostDNSStaticRecord struct {
+	Name      string        `yaml:"name" protobuf:"1"`
+	Addresses []netip.Addr  `yaml:"addresses,omitempty" protobuf:"2"`
+	CNAME     string        `yaml:"cname,omitempty" protobuf:"3"`
+	TTL       time.Duration `yaml:"ttl" protobuf:"4"`
 }
 
 // NewHostDNSConfig initializes a HostDNSConfig resource.
@@ -6,6 +6,7 @@ package network
 
 import (
 	"cmp"
+	"maps"
 	"net/netip"
 	"slices"
 	"time"
@@ -89,6 +90,128 @@ type WireguardSpec struct {
 	Peers        []WireguardPeer `yaml:"peers" protobuf:"5"`
 }
 
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+//
+// The underlying link is specified with ParentName of the link spec.
+//
+//gotagsrewrite:gen
+type VXLANSpec struct {
+	// VNI is the VXLAN network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+	// Remote is either the unicast remote address or the multicast group address.
+	Remote netip.Addr `yaml:"remote,omitempty" protobuf:"2"`
+	// Local is the source address of the outgoing packets.
+	Local netip.Addr `yaml:"local,omitempty" protobuf:"3"`
+	// Port is the destination UDP port.
+	Port uint16 `yaml:"port" protobuf:"4"`
+}
+
+// GENEVESpec describes GENEVE settings if Kind == "geneve".
+//
+//gotagsrewrite:gen
+type GENEVESpec struct {
+	// VNI is the GENEVE virtual network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+	// Remote is the unicast remote address.
+	Remote netip.Addr `yaml:"remote,omitempty" protobuf:"2"`
+	// Port is the destination UDP port.
+	Port uint16 `yaml:"port" protobuf:"3"`
+}
+
+// MACVLANSpec describes macvlan settings if Kind == "macvlan".
+//
+//gotagsrewrite:gen
+type MACVLANSpec struct {
+	Mode nethelpers.MACVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// IPVLANSpec describes ipvlan settings if Kind == "ipvlan".
+//
+//gotagsrewrite:gen
+type IPVLANSpec struct {
+	Mode nethelpers.IPVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// EthtoolSpec describes ethtool settings of the link.
+//
+// Zero values keep the current settings of the link.
+//
+//gotagsrewrite:gen
+type EthtoolSpec struct {
+	// Features maps the feature names (as in `ethtool --show-features`) to the desired state.
+	Features map[string]bool `yaml:"features,omitempty" protobuf:"1"`
+	// RXRingSize and TXRingSize are the number of descriptors in the RX/TX rings.
+	RXRingSize uint32 `yaml:"rxRingSize,omitempty" protobuf:"2"`
+	TXRingSize uint32 `yaml:"txRingSize,omitempty" protobuf:"3"`
+	// CombinedChannels is the number of combined RX/TX channels (queues).
+	CombinedChannels uint32 `yaml:"combinedChannels,omitempty" protobuf:"4"`
+	// LinkMode configures speed, duplex and autonegotiation.
+	LinkMode *EthtoolLinkModeSpec `yaml:"linkMode,omitempty" protobuf:"5"`
+	// WakeOnLAN configures Wake-on-LAN modes.
+	WakeOnLAN *EthtoolWakeOnLANSpec `yaml:"wakeOnLAN,omitempty" protobuf:"6"`
+}
+
+// EthtoolLinkModeSpec describes link speed, duplex and autonegotiation settings.
+//
+//gotagsrewrite:gen
+type EthtoolLinkModeSpec struct {
+	// Autonegotiation enables autonegotiation, if the speed is set, only matching link modes are advertised.
+	Autonegotiation bool `yaml:"autonegotiation" protobuf:"1"`
+	// SpeedMegabits is the link speed, zero means any speed.
+	SpeedMegabits uint32 `yaml:"speedMbit,omitempty" protobuf:"2"`
+	// Duplex is only used if the speed is set.
+	Duplex nethelpers.Duplex `yaml:"duplex" protobuf:"3"`
+}
+
+// EthtoolWakeOnLANSpec describes Wake-on-LAN settings.
+//
+//gotagsrewrite:gen
+type EthtoolWakeOnLANSpec struct {
+	// Modes is a set of enabled Wake-on-LAN modes, zero disables Wake-on-LAN.
+	Modes nethelpers.WOLModes `yaml:"modes" protobuf:"1"`
+}
+
+// IsZero checks if the spec doesn't change any settings.
+func (spec EthtoolSpec) IsZero() bool {
+	return len(spec.Features) == 0 && spec.RXRingSize == 0 && spec.TXRingSize == 0 && spec.CombinedChannels == 0 &&
+		spec.LinkMode == nil && spec.WakeOnLAN == nil
+}
+
+// Merge with other, overwriting settings from other if set.
+func (spec *EthtoolSpec) Merge(other EthtoolSpec) {
+	if len(other.Features) > 0 {
+		// always allocate a new map, as the existing one might be shared with another spec
+		features := make(map[string]bool, len(spec.Features)+len(other.Features))
+
+		maps.Copy(features, spec.Features)
+		maps.Copy(features, other.Features)
+
+		spec.Features = features
+	}
+
+	updateIfNotZero(&spec.RXRingSize, other.RXRingSize)
+	updateIfNotZero(&spec.TXRingSize, other.TXRingSize)
+	updateIfNotZero(&spec.CombinedChannels, other.CombinedChannels)
+	updateIfNotZero(&spec.LinkMode, other.LinkMode)
+	updateIfNotZero(&spec.WakeOnLAN, other.WakeOnLAN)
+}
+
+// EthtoolStatus describes current ethtool settings of the link.
+//
+//gotagsrewrite:gen
+type EthtoolStatus struct {
+	// Features maps the feature names to the active state, only the features which can be changed are included.
+	Features            map[string]bool     `yaml:"features,omitempty" protobuf:"1"`
+	RXRingSize          uint32              `yaml:"rxRingSize,omitempty" protobuf:"2"`
+	RXRingSizeMax       uint32              `yaml:"rxRingSizeMax,omitempty" protobuf:"3"`
+	TXRingSize          uint32              `yaml:"txRingSize,omitempty" protobuf:"4"`
+	TXRingSizeMax       uint32              `yaml:"txRingSizeMax,omitempty" protobuf:"5"`
+	CombinedChannels    uint32              `yaml:"combinedChannels,omitempty" protobuf:"6"`
+	CombinedChannelsMax uint32              `yaml:"combinedChannelsMax,omitempty" protobuf:"7"`
+	Autonegotiation     bool                `yaml:"autonegotiation,omitempty" protobuf:"8"`
+	WakeOnLAN           nethelpers.WOLModes `yaml:"wakeOnLAN,omitempty" protobuf:"9"`
+}
+
 // WireguardPeer describes a single peer.
 //
 //gotagsrewrite:gen
@@ -56,6 +56,13 @@ type LinkSpecSpec struct {
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"11"`
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"12"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"13"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"15"`
+	GENEVE       GENEVESpec       `yaml:"geneve,omitempty" protobuf:"16"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"17"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"18"`
+
+	// Ethtool settings apply to any link supporting them (usually physical links).
+	Ethtool EthtoolSpec `yaml:"ethtool,omitempty" protobuf:"19"`
 
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"14"`
@@ -94,6 +101,11 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.BondMaster, other.BondMaster)
 	updateIfNotZero(&spec.BridgeMaster, other.BridgeMaster)
 	updateIfNotZero(&spec.BridgeSlave, other.BridgeSlave)
+	updateIfNotZero(&spec.VXLAN, other.VXLAN)
+	updateIfNotZero(&spec.GENEVE, other.GENEVE)
+	updateIfNotZero(&spec.MACVLAN, other.MACVLAN)
+	updateIfNotZero(&spec.IPVLAN, other.IPVLAN)
+	spec.Ethtool.Merge(other.Ethtool)
 
 	// Wireguard config should be able to apply non-zero values in earlier config layers which may be zero values in later layers.
 	// Thus, we handle each Wireguard configuration value discretely.
@@ -52,11 +52,18 @@ type LinkStatusSpec struct {
 	SpeedMegabits int               `yaml:"speedMbit,omitempty" protobuf:"23"`
 	Port          nethelpers.Port   `yaml:"port" protobuf:"24"`
 	Duplex        nethelpers.Duplex `yaml:"duplex" protobuf:"25"`
+	Ethtool       EthtoolStatus     `yaml:"ethtool,omitempty" protobuf:"35"`
+	// Fields coming from 802.1X supplicant.
+	Dot1XState nethelpers.Dot1XState `yaml:"dot1xState,omitempty" protobuf:"36"`
 	// Following fields are only populated with respective Kind.
 	VLAN         VLANSpec         `yaml:"vlan,omitempty" protobuf:"26"`
 	BridgeMaster BridgeMasterSpec `yaml:"bridgeMaster,omitempty" protobuf:"27"`
 	BondMaster   BondMasterSpec   `yaml:"bondMaster,omitempty" protobuf:"28"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"29"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"31"`
+	GENEVE       GENEVESpec       `yaml:"geneve,omitempty" protobuf:"32"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"33"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"34"`
 }
 
 // Physical checks if the link is physical ethernet.
@@ -214,3 +214,33 @@ func TestWireguardSpecMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestEthtoolSpecMerge(t *testing.T) {
+	spec := network.EthtoolSpec{
+		Features:   map[string]bool{"rx-gro": false, "rx-lro": true},
+		RXRingSize: 1024,
+		LinkMode:   &network.EthtoolLinkModeSpec{Autonegotiation: true},
+	}
+
+	features := spec.Features
+
+	spec.Merge(network.EthtoolSpec{
+		Features:   map[string]bool{"rx-lro": false, "tx-tcp-segmentation": true},
+		TXRingSize: 2048,
+		WakeOnLAN:  &network.EthtoolWakeOnLANSpec{},
+	})
+
+	assert.Equal(t, network.EthtoolSpec{
+		Features:   map[string]bool{"rx-gro": false, "rx-lro": false, "tx-tcp-segmentation": true},
+		RXRingSize: 1024,
+		TXRingSize: 2048,
+		LinkMode:   &network.EthtoolLinkModeSpec{Autonegotiation: true},
+		WakeOnLAN:  &network.EthtoolWakeOnLANSpec{},
+	}, spec)
+
+	// the original map is not modified
+	assert.Equal(t, map[string]bool{"rx-gro": false, "rx-lro": true}, features)
+
+	assert.True(t, network.EthtoolSpec{}.IsZero())
+	assert.False(t, spec.IsZero())
+}
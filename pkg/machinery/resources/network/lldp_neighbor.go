@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// LLDPNeighborType is type of LLDPNeighbor resource.
+const LLDPNeighborType = resource.Type("LLDPNeighbors.net.talos.dev")
+
+// LLDPNeighbor resource holds the LLDP neighbor discovered on the link.
+type LLDPNeighbor = typed.Resource[LLDPNeighborSpec, LLDPNeighborExtension]
+
+// LLDPNeighborSpec describes the LLDP neighbor (e.g. the switch port) of the link.
+//
+//gotagsrewrite:gen
+type LLDPNeighborSpec struct {
+	LinkName            string        `yaml:"linkName" protobuf:"1"`
+	ChassisID           string        `yaml:"chassisID" protobuf:"2"`
+	PortID              string        `yaml:"portID" protobuf:"3"`
+	PortDescription     string        `yaml:"portDescription,omitempty" protobuf:"4"`
+	SystemName          string        `yaml:"systemName,omitempty" protobuf:"5"`
+	SystemDescription   string        `yaml:"systemDescription,omitempty" protobuf:"6"`
+	ManagementAddresses []netip.Addr  `yaml:"managementAddresses,omitempty" protobuf:"7"`
+	PortVLANID          uint16        `yaml:"portVLANID,omitempty" protobuf:"8"`
+	VLANList            []LLDPVLAN    `yaml:"vlans,omitempty" protobuf:"9"`
+	TTL                 time.Duration `yaml:"ttl" protobuf:"10"`
+}
+
+// LLDPVLAN describes the VLAN advertised by the LLDP neighbor.
+//
+//gotagsrewrite:gen
+type LLDPVLAN struct {
+	ID   uint16 `yaml:"id" protobuf:"1"`
+	Name string `yaml:"name,omitempty" protobuf:"2"`
+}
+
+// NewLLDPNeighbor initializes a LLDPNeighbor resource.
+func NewLLDPNeighbor(namespace resource.Namespace, id resource.ID) *LLDPNeighbor {
+	return typed.NewResource[LLDPNeighborSpec, LLDPNeighborExtension](
+		resource.NewMetadata(namespace, LLDPNeighborType, id, resource.VersionUndefined),
+		LLDPNeighborSpec{},
+	)
+}
+
+// LLDPNeighborExtension provides auxiliary methods for LLDPNeighbor.
+type LLDPNeighborExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LLDPNeighborExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LLDPNeighborType,
+		Aliases:          []resource.Type{"lldp"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Chassis ID",
+				JSONPath: `{.chassisID}`,
+			},
+			{
+				Name:     "Port ID",
+				JSONPath: `{.portID}`,
+			},
+			{
+				Name:     "System Name",
+				JSONPath: `{.systemName}`,
+			},
+			{
+				Name:     "Port VLAN",
+				JSONPath: `{.portVLANID}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LLDPNeighborSpec](LLDPNeighborType, &LLDPNeighbor{})
+	if err != nil {
+		panic(err)
+	}
+}
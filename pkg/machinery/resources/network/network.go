@@ -55,11 +55,21 @@ func RouteID(table nethelpers.RoutingTable, family nethelpers.Family, destinatio
 	return fmt.Sprintf("%s%s/%s/%s/%d", prefix, family, string(gw), string(dst), priority)
 }
 
+// RoutingRuleID builds ID (primary key) for the routing rule.
+func RoutingRuleID(family nethelpers.Family, priority uint32) string {
+	return fmt.Sprintf("%s/%05d", family, priority)
+}
+
 // OperatorID builds ID (primary key) for the operators.
 func OperatorID(operator Operator, linkName string) string {
 	return fmt.Sprintf("%s/%s", operator, linkName)
 }
 
+// SRIOVVFID builds ID (primary key) for the SR-IOV virtual function.
+func SRIOVVFID(physicalFunction string, index uint32) string {
+	return fmt.Sprintf("%s/vf%d", physicalFunction, index)
+}
+
 // LayeredID builds configuration for the entity at some layer.
 func LayeredID(layer ConfigLayer, id string) string {
 	return fmt.Sprintf("%s/%s", layer, id)
@@ -71,4 +81,18 @@ const (
 	LinkKindBond      = "bond"
 	LinkKindBridge    = "bridge"
 	LinkKindWireguard = "wireguard"
+	LinkKindVXLAN     = "vxlan"
+	LinkKindGENEVE    = "geneve"
+	LinkKindMACVLAN   = "macvlan"
+	LinkKindIPVLAN    = "ipvlan"
+)
+
+// Qdisc kinds.
+const (
+	QdiscKindFQ      = "fq"
+	QdiscKindFQCodel = "fq_codel"
+	QdiscKindCAKE    = "cake"
+	QdiscKindTBF     = "tbf"
+	QdiscKindHTB     = "htb"
+	QdiscKindNetem   = "netem"
 )
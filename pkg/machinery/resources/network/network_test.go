@@ -27,23 +27,32 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []meta.ResourceWithRD{
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.DelegatedPrefix{},
+		&network.Dot1XStatus{},
 		&network.HardwareAddr{},
 		&network.DNSUpstream{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
+		&network.LLDPNeighbor{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NfTablesChain{},
+		&network.NfTablesChainStatus{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.OperatorSpec{},
 		&network.ProbeSpec{},
+		&network.QdiscSpec{},
+		&network.QdiscStatus{},
 		&network.ResolverStatus{},
 		&network.ResolverSpec{},
 		&network.RouteStatus{},
 		&network.RouteSpec{},
+		&network.RoutingRuleStatus{},
+		&network.RoutingRuleSpec{},
+		&network.SRIOVVFStatus{},
 		&network.Status{},
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
@@ -50,6 +50,10 @@ type NfTablesRule struct {
 	ClampMSS    *NfTablesClampMSS           `yaml:"clampMSS,omitempty" protobuf:"9"`
 	SetMark     *NfTablesMark               `yaml:"setMark,omitempty" protobuf:"4"`
 	AnonCounter bool                        `yaml:"anonymousCounter,omitempty" protobuf:"12"`
+	Masquerade  bool                        `yaml:"masquerade,omitempty" protobuf:"13"`
+	SNAT        *NfTablesNATTarget          `yaml:"snat,omitempty" protobuf:"14"`
+	DNAT        *NfTablesNATTarget          `yaml:"dnat,omitempty" protobuf:"15"`
+	Log         *NfTablesLog                `yaml:"log,omitempty" protobuf:"16"`
 	Verdict     *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty" protobuf:"2"`
 }
 
@@ -136,6 +140,24 @@ type NfTablesConntrackStateMatch struct {
 	States []nethelpers.ConntrackState `yaml:"states" protobuf:"1"`
 }
 
+// NfTablesNATTarget describes the address (and optionally the port) to translate to.
+//
+// The rule with the NAT target matches only the address family of the target address.
+//
+//gotagsrewrite:gen
+type NfTablesNATTarget struct {
+	Address netip.Addr `yaml:"address" protobuf:"1"`
+	Port    uint16     `yaml:"port,omitempty" protobuf:"2"`
+}
+
+// NfTablesLog describes logging of the matched packets to the netlink log group.
+//
+//gotagsrewrite:gen
+type NfTablesLog struct {
+	Prefix string `yaml:"prefix,omitempty" protobuf:"1"`
+	Group  uint16 `yaml:"group" protobuf:"2"`
+}
+
 // NewNfTablesChain initializes a NfTablesChain resource.
 func NewNfTablesChain(namespace resource.Namespace, id resource.ID) *NfTablesChain {
 	return typed.NewResource[NfTablesChainSpec, NfTablesChainExtension](
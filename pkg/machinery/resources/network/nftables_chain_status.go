@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NfTablesChainStatusType is type of NfTablesChainStatus resource.
+const NfTablesChainStatusType = resource.Type("NfTablesChainStatuses.net.talos.dev")
+
+// NfTablesChainStatus resource holds the status of the nftables chain applied to the kernel.
+type NfTablesChainStatus = typed.Resource[NfTablesChainStatusSpec, NfTablesChainStatusExtension]
+
+// NfTablesChainStatusSpec describes the status of the nftables chain with the rule counters.
+//
+//gotagsrewrite:gen
+type NfTablesChainStatusSpec struct {
+	Type     nethelpers.NfTablesChainType     `yaml:"type" protobuf:"1"`
+	Hook     nethelpers.NfTablesChainHook     `yaml:"hook" protobuf:"2"`
+	Priority nethelpers.NfTablesChainPriority `yaml:"priority" protobuf:"3"`
+	Policy   nethelpers.NfTablesVerdict       `yaml:"policy" protobuf:"4"`
+
+	Rules []NfTablesRuleStatus `yaml:"rules" protobuf:"5"`
+}
+
+// NfTablesRuleStatus describes the counters of a single rule in the nftables chain.
+//
+// The counters are only collected for the rules with the anonymous counter.
+//
+//gotagsrewrite:gen
+type NfTablesRuleStatus struct {
+	Rule    NfTablesRule `yaml:"rule" protobuf:"1"`
+	Packets uint64       `yaml:"packets" protobuf:"2"`
+	Bytes   uint64       `yaml:"bytes" protobuf:"3"`
+}
+
+// NewNfTablesChainStatus initializes a NfTablesChainStatus resource.
+func NewNfTablesChainStatus(namespace resource.Namespace, id resource.ID) *NfTablesChainStatus {
+	return typed.NewResource[NfTablesChainStatusSpec, NfTablesChainStatusExtension](
+		resource.NewMetadata(namespace, NfTablesChainStatusType, id, resource.VersionUndefined),
+		NfTablesChainStatusSpec{},
+	)
+}
+
+// NfTablesChainStatusExtension provides auxiliary methods for NfTablesChainStatus.
+type NfTablesChainStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NfTablesChainStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NfTablesChainStatusType,
+		Aliases:          []resource.Type{"nftableschainstatus", "nftableschainstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: `{.type}`,
+			},
+			{
+				Name:     "Hook",
+				JSONPath: `{.hook}`,
+			},
+			{
+				Name:     "Policy",
+				JSONPath: `{.policy}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NfTablesChainStatusSpec](NfTablesChainStatusType, &NfTablesChainStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
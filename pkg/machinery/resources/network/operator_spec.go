@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -37,28 +38,76 @@ type OperatorSpecSpec struct {
 }
 
 // Equal implements equality check for OperatorSpecSpec.
+//
+// Config layer is not important for equality check.
 func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
-	// config layer is not important for equality check
-	spec.ConfigLayer = other.ConfigLayer
-
-	return spec == other
+	return spec.Operator == other.Operator &&
+		spec.LinkName == other.LinkName &&
+		spec.RequireUp == other.RequireUp &&
+		spec.DHCP4.Equal(other.DHCP4) &&
+		spec.DHCP6.Equal(other.DHCP6) &&
+		spec.VIP == other.VIP
 }
 
 // DHCP4OperatorSpec describes DHCP4 operator options.
 //
 //gotagsrewrite:gen
 type DHCP4OperatorSpec struct {
-	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"1"`
-	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	RouteMetric         uint32   `yaml:"routeMetric" protobuf:"1"`
+	SkipHostnameRequest bool     `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
+	ClientIdentifier    string   `yaml:"clientIdentifier,omitempty" protobuf:"3"`
+	VendorClass         string   `yaml:"vendorClass,omitempty" protobuf:"4"`
+	UserClass           []string `yaml:"userClass,omitempty" protobuf:"5"`
+	RequestedOptions    []byte   `yaml:"requestedOptions,omitempty" protobuf:"6"`
+
+	RouteProbe              string `yaml:"routeProbe,omitempty" protobuf:"7"`
+	RouteProbeFailureMetric uint32 `yaml:"routeProbeFailureMetric,omitempty" protobuf:"8"`
+}
+
+// Equal implements equality check for DHCP4OperatorSpec.
+func (spec DHCP4OperatorSpec) Equal(other DHCP4OperatorSpec) bool {
+	return spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.ClientIdentifier == other.ClientIdentifier &&
+		spec.VendorClass == other.VendorClass &&
+		slices.Equal(spec.UserClass, other.UserClass) &&
+		slices.Equal(spec.RequestedOptions, other.RequestedOptions) &&
+		spec.RouteProbe == other.RouteProbe &&
+		spec.RouteProbeFailureMetric == other.RouteProbeFailureMetric
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
 //
 //gotagsrewrite:gen
 type DHCP6OperatorSpec struct {
-	DUID                string `yaml:"DUID,omitempty" protobuf:"1"`
-	RouteMetric         uint32 `yaml:"routeMetric" protobuf:"2"`
-	SkipHostnameRequest bool   `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
+	DUID                string                  `yaml:"DUID,omitempty" protobuf:"1"`
+	RouteMetric         uint32                  `yaml:"routeMetric" protobuf:"2"`
+	SkipHostnameRequest bool                    `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
+	PrefixDelegation    bool                    `yaml:"prefixDelegation,omitempty" protobuf:"4"`
+	PrefixLength        int                     `yaml:"prefixLength,omitempty" protobuf:"5"`
+	PrefixAssignments   []DHCP6PrefixAssignment `yaml:"prefixAssignments,omitempty" protobuf:"6"`
+}
+
+// Equal implements equality check for DHCP6OperatorSpec.
+func (spec DHCP6OperatorSpec) Equal(other DHCP6OperatorSpec) bool {
+	return spec.DUID == other.DUID &&
+		spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.PrefixDelegation == other.PrefixDelegation &&
+		spec.PrefixLength == other.PrefixLength &&
+		slices.Equal(spec.PrefixAssignments, other.PrefixAssignments)
+}
+
+// DHCP6PrefixAssignment describes how a subnet of the delegated prefix is used.
+//
+// The subnet is either assigned to the link, or published as a node annotation.
+//
+//gotagsrewrite:gen
+type DHCP6PrefixAssignment struct {
+	LinkName       string `yaml:"linkName,omitempty" protobuf:"1"`
+	NodeAnnotation string `yaml:"nodeAnnotation,omitempty" protobuf:"2"`
+	SubnetID       uint64 `yaml:"subnetID" protobuf:"3"`
+	PrefixLength   int    `yaml:"prefixLength" protobuf:"4"`
 }
 
 // VIPOperatorSpec describes virtual IP operator options.
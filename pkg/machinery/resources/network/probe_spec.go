@@ -7,6 +7,7 @@ package network
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -31,21 +32,38 @@ type ProbeSpecSpec struct {
 	Interval time.Duration `yaml:"interval" protobuf:"1"`
 	// FailureThreshold is the number of consecutive failures for the probe to be considered failed after having succeeded.
 	FailureThreshold int `yaml:"failureThreshold" protobuf:"2"`
-	// One of the probe types should be specified, for now it's only TCP.
-	TCP TCPProbeSpec `yaml:"tcp,omitempty" protobuf:"3"`
+	// One of the probe types should be specified.
+	TCP  TCPProbeSpec  `yaml:"tcp,omitempty" protobuf:"3"`
+	ICMP ICMPProbeSpec `yaml:"icmp,omitempty" protobuf:"5"`
+	HTTP HTTPProbeSpec `yaml:"http,omitempty" protobuf:"6"`
+	DNS  DNSProbeSpec  `yaml:"dns,omitempty" protobuf:"7"`
+	// LinkName binds the probe to the link (optional).
+	LinkName string `yaml:"linkName,omitempty" protobuf:"8"`
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"4"`
 }
 
 // ID returns the ID of the resource based on the spec.
 func (spec *ProbeSpecSpec) ID() (resource.ID, error) {
-	var zeroTCP TCPProbeSpec
+	var (
+		zeroTCP  TCPProbeSpec
+		zeroICMP ICMPProbeSpec
+		zeroHTTP HTTPProbeSpec
+		zeroDNS  DNSProbeSpec
+	)
 
-	if spec.TCP == zeroTCP {
+	switch {
+	case spec.TCP != zeroTCP:
+		return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
+	case spec.ICMP != zeroICMP:
+		return fmt.Sprintf("icmp:%s", spec.ICMP.Address), nil
+	case spec.HTTP != zeroHTTP:
+		return fmt.Sprintf("http:%s", spec.HTTP.URL), nil
+	case spec.DNS != zeroDNS:
+		return fmt.Sprintf("dns:%s/%s", spec.DNS.Server, spec.DNS.Name), nil
+	default:
 		return "", errors.New("no probe type specified")
 	}
-
-	return fmt.Sprintf("tcp:%s", spec.TCP.Endpoint), nil
 }
 
 // Equal returns true if the specs are equal.
@@ -63,6 +81,40 @@ type TCPProbeSpec struct {
 	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
 }
 
+// ICMPProbeSpec describes the ICMP echo Probe.
+//
+//gotagsrewrite:gen
+type ICMPProbeSpec struct {
+	// Address to send the ICMP echo request to.
+	Address netip.Addr `yaml:"address" protobuf:"1"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"2"`
+}
+
+// HTTPProbeSpec describes the HTTP(S) GET Probe.
+//
+//gotagsrewrite:gen
+type HTTPProbeSpec struct {
+	// URL to probe.
+	URL string `yaml:"url" protobuf:"1"`
+	// ExpectedStatusCode is the HTTP status code expected in the response.
+	ExpectedStatusCode int `yaml:"expectedStatusCode" protobuf:"2"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"3"`
+}
+
+// DNSProbeSpec describes the DNS query Probe.
+//
+//gotagsrewrite:gen
+type DNSProbeSpec struct {
+	// Server to query: host:port.
+	Server string `yaml:"server" protobuf:"1"`
+	// Name to resolve.
+	Name string `yaml:"name" protobuf:"2"`
+	// Timeout for the probe.
+	Timeout time.Duration `yaml:"timeout" protobuf:"3"`
+}
+
 // NewProbeSpec initializes a ProbeSpec resource.
 func NewProbeSpec(namespace resource.Namespace, id resource.ID) *ProbeSpec {
 	return typed.NewResource[ProbeSpecSpec, ProbeSpecExtension](
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// QdiscSpecType is type of QdiscSpec resource.
+const QdiscSpecType = resource.Type("QdiscSpecs.net.talos.dev")
+
+// QdiscSpec resource holds the root qdisc specification of the link.
+type QdiscSpec = typed.Resource[QdiscSpecSpec, QdiscSpecExtension]
+
+// QdiscSpecSpec describes the root qdisc of the link.
+//
+// All rates are in bits per second, only the settings of the qdisc of the Kind are used.
+//
+//gotagsrewrite:gen
+type QdiscSpecSpec struct {
+	LinkName string           `yaml:"linkName" protobuf:"1"`
+	Kind     string           `yaml:"kind" protobuf:"2"`
+	FQ       FQQdiscSpec      `yaml:"fq,omitempty" protobuf:"3"`
+	FQCodel  FQCodelQdiscSpec `yaml:"fqCodel,omitempty" protobuf:"4"`
+	CAKE     CAKEQdiscSpec    `yaml:"cake,omitempty" protobuf:"5"`
+	TBF      TBFQdiscSpec     `yaml:"tbf,omitempty" protobuf:"6"`
+	HTB      HTBQdiscSpec     `yaml:"htb,omitempty" protobuf:"7"`
+	Netem    NetemQdiscSpec   `yaml:"netem,omitempty" protobuf:"8"`
+}
+
+// FQQdiscSpec describes the fq qdisc.
+//
+//gotagsrewrite:gen
+type FQQdiscSpec struct {
+	FlowLimit uint32 `yaml:"flowLimit,omitempty" protobuf:"1"`
+	MaxRate   uint64 `yaml:"maxRate,omitempty" protobuf:"2"`
+}
+
+// FQCodelQdiscSpec describes the fq_codel qdisc.
+//
+//gotagsrewrite:gen
+type FQCodelQdiscSpec struct {
+	Limit    uint32        `yaml:"limit,omitempty" protobuf:"1"`
+	Flows    uint32        `yaml:"flows,omitempty" protobuf:"2"`
+	Target   time.Duration `yaml:"target,omitempty" protobuf:"3"`
+	Interval time.Duration `yaml:"interval,omitempty" protobuf:"4"`
+	ECN      bool          `yaml:"ecn" protobuf:"5"`
+}
+
+// CAKEQdiscSpec describes the cake qdisc.
+//
+//gotagsrewrite:gen
+type CAKEQdiscSpec struct {
+	Bandwidth uint64        `yaml:"bandwidth,omitempty" protobuf:"1"`
+	RTT       time.Duration `yaml:"rtt,omitempty" protobuf:"2"`
+}
+
+// TBFQdiscSpec describes the tbf qdisc.
+//
+//gotagsrewrite:gen
+type TBFQdiscSpec struct {
+	Rate    uint64        `yaml:"rate" protobuf:"1"`
+	Burst   uint32        `yaml:"burst,omitempty" protobuf:"2"`
+	Latency time.Duration `yaml:"latency,omitempty" protobuf:"3"`
+}
+
+// HTBQdiscSpec describes the htb qdisc and its classes.
+//
+//gotagsrewrite:gen
+type HTBQdiscSpec struct {
+	Rate         uint64         `yaml:"rate" protobuf:"1"`
+	DefaultClass uint16         `yaml:"defaultClass,omitempty" protobuf:"2"`
+	Classes      []HTBClassSpec `yaml:"classes" protobuf:"3"`
+}
+
+// HTBClassSpec describes a single htb class.
+//
+//gotagsrewrite:gen
+type HTBClassSpec struct {
+	ID           uint16 `yaml:"id" protobuf:"1"`
+	Rate         uint64 `yaml:"rate" protobuf:"2"`
+	Ceil         uint64 `yaml:"ceil,omitempty" protobuf:"3"`
+	Priority     uint32 `yaml:"priority,omitempty" protobuf:"4"`
+	FirewallMark uint32 `yaml:"firewallMark,omitempty" protobuf:"5"`
+}
+
+// NetemQdiscSpec describes the netem qdisc.
+//
+// Probabilities are in percent.
+//
+//gotagsrewrite:gen
+type NetemQdiscSpec struct {
+	Delay     time.Duration `yaml:"delay,omitempty" protobuf:"1"`
+	Jitter    time.Duration `yaml:"jitter,omitempty" protobuf:"2"`
+	Loss      float64       `yaml:"loss,omitempty" protobuf:"3"`
+	Duplicate float64       `yaml:"duplicate,omitempty" protobuf:"4"`
+	Corrupt   float64       `yaml:"corrupt,omitempty" protobuf:"5"`
+	Reorder   float64       `yaml:"reorder,omitempty" protobuf:"6"`
+	Rate      uint64        `yaml:"rate,omitempty" protobuf:"7"`
+	Limit     uint32        `yaml:"limit,omitempty" protobuf:"8"`
+}
+
+// NewQdiscSpec initializes a QdiscSpec resource.
+func NewQdiscSpec(namespace resource.Namespace, id resource.ID) *QdiscSpec {
+	return typed.NewResource[QdiscSpecSpec, QdiscSpecExtension](
+		resource.NewMetadata(namespace, QdiscSpecType, id, resource.VersionUndefined),
+		QdiscSpecSpec{},
+	)
+}
+
+// QdiscSpecExtension provides auxiliary methods for QdiscSpec.
+type QdiscSpecExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (QdiscSpecExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             QdiscSpecType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Kind",
+				JSONPath: `{.kind}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[QdiscSpecSpec](QdiscSpecType, &QdiscSpec{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// QdiscStatusType is type of QdiscStatus resource.
+const QdiscStatusType = resource.Type("QdiscStatuses.net.talos.dev")
+
+// QdiscStatus resource holds the current state and statistics of the root qdisc of the link.
+type QdiscStatus = typed.Resource[QdiscStatusSpec, QdiscStatusExtension]
+
+// QdiscStatusSpec describes the root qdisc of the link.
+//
+//gotagsrewrite:gen
+type QdiscStatusSpec struct {
+	LinkName   string             `yaml:"linkName" protobuf:"1"`
+	Kind       string             `yaml:"kind" protobuf:"2"`
+	Handle     string             `yaml:"handle" protobuf:"3"`
+	Bytes      uint64             `yaml:"bytes" protobuf:"4"`
+	Packets    uint32             `yaml:"packets" protobuf:"5"`
+	Drops      uint32             `yaml:"drops" protobuf:"6"`
+	Overlimits uint32             `yaml:"overlimits" protobuf:"7"`
+	Requeues   uint32             `yaml:"requeues" protobuf:"8"`
+	Backlog    uint32             `yaml:"backlog" protobuf:"9"`
+	Qlen       uint32             `yaml:"qlen" protobuf:"10"`
+	Classes    []QdiscClassStatus `yaml:"classes,omitempty" protobuf:"11"`
+}
+
+// QdiscClassStatus describes the class of the classful root qdisc.
+//
+//gotagsrewrite:gen
+type QdiscClassStatus struct {
+	Handle     string `yaml:"handle" protobuf:"1"`
+	Parent     string `yaml:"parent" protobuf:"2"`
+	Rate       uint64 `yaml:"rate,omitempty" protobuf:"3"`
+	Ceil       uint64 `yaml:"ceil,omitempty" protobuf:"4"`
+	Bytes      uint64 `yaml:"bytes" protobuf:"5"`
+	Packets    uint32 `yaml:"packets" protobuf:"6"`
+	Drops      uint32 `yaml:"drops" protobuf:"7"`
+	Overlimits uint32 `yaml:"overlimits" protobuf:"8"`
+}
+
+// NewQdiscStatus initializes a QdiscStatus resource.
+func NewQdiscStatus(namespace resource.Namespace, id resource.ID) *QdiscStatus {
+	return typed.NewResource[QdiscStatusSpec, QdiscStatusExtension](
+		resource.NewMetadata(namespace, QdiscStatusType, id, resource.VersionUndefined),
+		QdiscStatusSpec{},
+	)
+}
+
+// QdiscStatusExtension provides auxiliary methods for QdiscStatus.
+type QdiscStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (QdiscStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             QdiscStatusType,
+		Aliases:          []resource.Type{"qdisc", "qdiscs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Kind",
+				JSONPath: `{.kind}`,
+			},
+			{
+				Name:     "Handle",
+				JSONPath: `{.handle}`,
+			},
+			{
+				Name:     "Bytes",
+				JSONPath: `{.bytes}`,
+			},
+			{
+				Name:     "Drops",
+				JSONPath: `{.drops}`,
+			},
+			{
+				Name:     "Overlimits",
+				JSONPath: `{.overlimits}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[QdiscStatusSpec](QdiscStatusType, &QdiscStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
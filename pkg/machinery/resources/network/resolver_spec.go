@@ -28,8 +28,9 @@ const ResolverID resource.ID = "resolvers"
 //
 //gotagsrewrite:gen
 type ResolverSpecSpec struct {
-	DNSServers  []netip.Addr `yaml:"dnsServers" protobuf:"1"`
-	ConfigLayer ConfigLayer  `yaml:"layer" protobuf:"2"`
+	DNSServers    []netip.Addr `yaml:"dnsServers" protobuf:"1"`
+	ConfigLayer   ConfigLayer  `yaml:"layer" protobuf:"2"`
+	SearchDomains []string     `yaml:"searchDomains,omitempty" protobuf:"3"`
 }
 
 // NewResolverSpec initializes a ResolverSpec resource.
@@ -40,6 +40,10 @@ type RouteSpecSpec struct {
 	Protocol    nethelpers.RouteProtocol `yaml:"protocol" protobuf:"11"`
 	ConfigLayer ConfigLayer              `yaml:"layer" protobuf:"12"`
 	MTU         uint32                   `yaml:"mtu,omitempty" protobuf:"13"`
+
+	// Probe gates the route: if the probe fails, the route is withdrawn or gets ProbeFailureMetric (if set).
+	Probe              string `yaml:"probe,omitempty" protobuf:"14"`
+	ProbeFailureMetric uint32 `yaml:"probeFailureMetric,omitempty" protobuf:"15"`
 }
 
 var (
@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SRIOVVFStatusType is type of SRIOVVFStatus resource.
+const SRIOVVFStatusType = resource.Type("SRIOVVFStatuses.net.talos.dev")
+
+// SRIOVVFStatus resource holds the status of the SR-IOV virtual function.
+type SRIOVVFStatus = typed.Resource[SRIOVVFStatusSpec, SRIOVVFStatusExtension]
+
+// SRIOVVFStatusSpec describes the SR-IOV virtual function created on the physical function.
+//
+//gotagsrewrite:gen
+type SRIOVVFStatusSpec struct {
+	PhysicalFunction string                  `yaml:"physicalFunction" protobuf:"1"`
+	Index            uint32                  `yaml:"index" protobuf:"2"`
+	PCIAddress       string                  `yaml:"pciAddress" protobuf:"3"`
+	LinkName         string                  `yaml:"linkName,omitempty" protobuf:"4"`
+	Driver           string                  `yaml:"driver,omitempty" protobuf:"5"`
+	HardwareAddr     nethelpers.HardwareAddr `yaml:"hardwareAddr" protobuf:"6"`
+	VLAN             uint16                  `yaml:"vlan" protobuf:"7"`
+	Trust            bool                    `yaml:"trust" protobuf:"8"`
+	SpoofCheck       bool                    `yaml:"spoofCheck" protobuf:"9"`
+}
+
+// NewSRIOVVFStatus initializes a SRIOVVFStatus resource.
+func NewSRIOVVFStatus(namespace resource.Namespace, id resource.ID) *SRIOVVFStatus {
+	return typed.NewResource[SRIOVVFStatusSpec, SRIOVVFStatusExtension](
+		resource.NewMetadata(namespace, SRIOVVFStatusType, id, resource.VersionUndefined),
+		SRIOVVFStatusSpec{},
+	)
+}
+
+// SRIOVVFStatusExtension provides auxiliary methods for SRIOVVFStatus.
+type SRIOVVFStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SRIOVVFStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SRIOVVFStatusType,
+		Aliases:          []resource.Type{"vf", "vfs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "PCI Address",
+				JSONPath: `{.pciAddress}`,
+			},
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Driver",
+				JSONPath: `{.driver}`,
+			},
+			{
+				Name:     "MAC",
+				JSONPath: `{.hardwareAddr}`,
+			},
+			{
+				Name:     "VLAN",
+				JSONPath: `{.vlan}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SRIOVVFStatusSpec](SRIOVVFStatusType, &SRIOVVFStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -30,6 +30,11 @@ type APICertsSpec struct {
 	AcceptedCAs []*x509.PEMEncodedCertificate     `yaml:"acceptedCAs" protobuf:"4"`
 	Client      *x509.PEMEncodedCertificateAndKey `yaml:"client" protobuf:"2"`
 	Server      *x509.PEMEncodedCertificateAndKey `yaml:"server" protobuf:"3"`
+	// NodeIdentity is the client certificate of the node without any Talos API roles.
+	// It is used to authenticate the node to the external services (e.g. Vault).
+	NodeIdentity *x509.PEMEncodedCertificateAndKey `yaml:"nodeIdentity" protobuf:"5"`
+	// NodeIdentityCA is the CA which issues the node identities, it should be trusted by the external services.
+	NodeIdentityCA *x509.PEMEncodedCertificate `yaml:"nodeIdentityCA" protobuf:"6"`
 }
 
 // NewAPI initializes an API resource.
@@ -31,6 +31,12 @@ func (o APICertsSpec) DeepCopy() APICertsSpec {
 	if o.Server != nil {
 		cp.Server = o.Server.DeepCopy()
 	}
+	if o.NodeIdentity != nil {
+		cp.NodeIdentity = o.NodeIdentity.DeepCopy()
+	}
+	if o.NodeIdentityCA != nil {
+		cp.NodeIdentityCA = o.NodeIdentityCA.DeepCopy()
+	}
 	return cp
 }
 
@@ -203,6 +209,9 @@ func (o OSRootSpec) DeepCopy() OSRootSpec {
 			}
 		}
 	}
+	if o.NodeIdentityCA != nil {
+		cp.NodeIdentityCA = o.NodeIdentityCA.DeepCopy()
+	}
 	if o.CertSANIPs != nil {
 		cp.CertSANIPs = make([]netip.Addr, len(o.CertSANIPs))
 		copy(cp.CertSANIPs, o.CertSANIPs)
@@ -31,6 +31,7 @@ type OSRoot = typed.Resource[OSRootSpec, OSRootExtension]
 type OSRootSpec struct {
 	IssuingCA       *x509.PEMEncodedCertificateAndKey `yaml:"issuingCA" protobuf:"1"`
 	AcceptedCAs     []*x509.PEMEncodedCertificate     `yaml:"acceptedCAs" protobuf:"5"`
+	NodeIdentityCA  *x509.PEMEncodedCertificateAndKey `yaml:"nodeIdentityCA,omitempty" protobuf:"6"`
 	CertSANIPs      []netip.Addr                      `yaml:"certSANIPs" protobuf:"2"`
 	CertSANDNSNames []string                          `yaml:"certSANDNSNames" protobuf:"3"`
 
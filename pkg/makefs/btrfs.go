@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+)
+
+const (
+	// FilesystemTypeBTRFS is the filesystem type for btrfs.
+	FilesystemTypeBTRFS = "btrfs"
+)
+
+// BtrfsGrow expands a btrfs filesystem to the maximum possible. The partition
+// MUST be mounted, or this will fail.
+func BtrfsGrow(mountpoint string) error {
+	_, err := cmd.Run("btrfs", "filesystem", "resize", "max", mountpoint)
+
+	return err
+}
+
+// BtrfsRepair repairs a btrfs filesystem on the specified partition.
+//
+// As `btrfs check --repair` is not considered safe, only the log tree is cleared,
+// which is the most common reason for the btrfs filesystem failing to mount.
+func BtrfsRepair(partname, fsType string) error {
+	if fsType != FilesystemTypeBTRFS {
+		return fmt.Errorf("unsupported filesystem type: %s", fsType)
+	}
+
+	_, err := cmd.Run("btrfs", "rescue", "zero-log", partname)
+
+	return err
+}
+
+// Btrfs creates a btrfs filesystem on the specified partition.
+func Btrfs(partname string, setters ...Option) error {
+	if partname == "" {
+		return errors.New("missing path to disk")
+	}
+
+	opts := NewDefaultOptions(setters...)
+
+	var args []string
+
+	if opts.Force {
+		args = append(args, "-f")
+	}
+
+	if opts.Label != "" {
+		args = append(args, "-L", opts.Label)
+	}
+
+	args = append(args, partname)
+
+	_, err := cmd.Run("mkfs.btrfs", args...)
+
+	return err
+}
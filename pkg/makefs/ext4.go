@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+)
+
+const (
+	// FilesystemTypeEXT4 is the filesystem type for ext4.
+	FilesystemTypeEXT4 = "ext4"
+)
+
+// Ext4Resize expands an ext4 filesystem to the maximum possible.
+//
+// The partition should not be mounted, and the filesystem should be checked with Ext4Repair first,
+// as resize2fs refuses to resize offline filesystem which wasn't checked recently.
+func Ext4Resize(partname string) error {
+	_, err := cmd.Run("resize2fs", partname)
+
+	return err
+}
+
+// Ext4Repair repairs an ext4 filesystem on the specified partition.
+//
+// Ext4Repair returns true if the filesystem errors were corrected (the filesystem was modified).
+func Ext4Repair(partname, fsType string) (bool, error) {
+	if fsType != FilesystemTypeEXT4 {
+		return false, fmt.Errorf("unsupported filesystem type: %s", fsType)
+	}
+
+	// -f forces the check, -p repairs automatically what can be safely fixed
+	_, err := cmd.Run("e2fsck", "-f", "-p", partname)
+
+	return e2fsckResult(err)
+}
+
+// e2fsckResult interprets the e2fsck exit status.
+//
+// The exit status is a bit mask: 0 - no errors, 1 - errors corrected, 2 - errors corrected, system should be rebooted,
+// 4 and above - errors left uncorrected or operational error.
+//
+// The reboot request is only relevant for the mounted root filesystem, so 2 is treated the same way as 1.
+func e2fsckResult(err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+
+	var exitErr *cmd.ExitError
+
+	if errors.As(err, &exitErr) && exitErr.ExitCode > 0 && exitErr.ExitCode < 4 {
+		return true, nil
+	}
+
+	return false, err
+}
+
+// Ext4 creates an ext4 filesystem on the specified partition.
+func Ext4(partname string, setters ...Option) error {
+	if partname == "" {
+		return errors.New("missing path to disk")
+	}
+
+	opts := NewDefaultOptions(setters...)
+
+	var args []string
+
+	if opts.Force {
+		args = append(args, "-F")
+	}
+
+	if opts.Label != "" {
+		args = append(args, "-L", opts.Label)
+	}
+
+	if percentage, ok := opts.ReservedBlocksPercentage.Get(); ok {
+		args = append(args, "-m", strconv.FormatUint(uint64(percentage), 10))
+	}
+
+	args = append(args, partname)
+
+	_, err := cmd.Run("mkfs.ext4", args...)
+
+	return err
+}
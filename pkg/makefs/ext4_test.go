@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/makefs"
+)
+
+func TestE2fsckResult(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		err  error
+
+		expectedModified bool
+		expectedError    bool
+	}{
+		{
+			name: "no errors",
+		},
+		{
+			name: "errors corrected",
+			err:  &cmd.ExitError{ExitCode: 1},
+
+			expectedModified: true,
+		},
+		{
+			name: "errors corrected, reboot",
+			err:  &cmd.ExitError{ExitCode: 2},
+
+			expectedModified: true,
+		},
+		{
+			name: "errors corrected and reboot",
+			err:  &cmd.ExitError{ExitCode: 3},
+
+			expectedModified: true,
+		},
+		{
+			name: "errors left uncorrected",
+			err:  &cmd.ExitError{ExitCode: 4},
+
+			expectedError: true,
+		},
+		{
+			name: "operational error",
+			err:  &cmd.ExitError{ExitCode: 8},
+
+			expectedError: true,
+		},
+		{
+			name: "not an exit error",
+			err:  os.ErrNotExist,
+
+			expectedError: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			modified, err := makefs.E2fsckResult(test.err)
+
+			if test.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedModified, modified)
+		})
+	}
+}
+
+func TestExt4Repair(t *testing.T) {
+	t.Parallel()
+
+	for _, binary := range []string{"mkfs.ext4", "e2fsck", "debugfs"} {
+		if _, err := exec.LookPath(binary); err != nil {
+			t.Skipf("%s is not available", binary)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "ext4.img")
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Truncate(64*1024*1024))
+	require.NoError(t, f.Close())
+
+	require.NoError(t, makefs.Ext4(path, makefs.WithForce(true), makefs.WithLabel("u-data")))
+
+	modified, err := makefs.Ext4Repair(path, makefs.FilesystemTypeEXT4)
+	require.NoError(t, err)
+	assert.False(t, modified)
+
+	// mark a block in use as free, e2fsck fixes the block bitmap and exits with status 1
+	_, err = cmd.Run("debugfs", "-w", "-R", "freeb 300", path)
+	require.NoError(t, err)
+
+	modified, err = makefs.Ext4Repair(path, makefs.FilesystemTypeEXT4)
+	require.NoError(t, err)
+	assert.True(t, modified)
+
+	modified, err = makefs.Ext4Repair(path, makefs.FilesystemTypeEXT4)
+	require.NoError(t, err)
+	assert.False(t, modified)
+
+	_, err = makefs.Ext4Repair(filepath.Join(t.TempDir(), "missing.img"), makefs.FilesystemTypeEXT4)
+	assert.Error(t, err)
+}
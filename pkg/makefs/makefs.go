@@ -5,15 +5,18 @@
 // Package makefs provides function to format and grow filesystems.
 package makefs
 
+import "github.com/siderolabs/gen/optional"
+
 // Option to control makefs settings.
 type Option func(*Options)
 
 // Options for makefs.
 type Options struct {
-	Label               string
-	Force               bool
-	Reproducible        bool
-	UnsupportedFSOption bool
+	Label                    string
+	Force                    bool
+	Reproducible             bool
+	UnsupportedFSOption      bool
+	ReservedBlocksPercentage optional.Optional[uint32]
 }
 
 // WithLabel sets the label for the filesystem to be created.
@@ -45,6 +48,13 @@ func WithUnsupportedFSOption(unsupported bool) Option {
 	}
 }
 
+// WithReservedBlocksPercentage sets the percentage of the filesystem blocks reserved for the super-user (ext4 only).
+func WithReservedBlocksPercentage(percentage uint32) Option {
+	return func(o *Options) {
+		o.ReservedBlocksPercentage = optional.Some(percentage)
+	}
+}
+
 // NewDefaultOptions builds options with specified setters applied.
 func NewDefaultOptions(setters ...Option) Options {
 	var opt Options
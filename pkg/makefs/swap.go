@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// FilesystemTypeSwap is the filesystem type for swap.
+	FilesystemTypeSwap = "swap"
+
+	swapSignature    = "SWAPSPACE2"
+	swapHeaderOffset = 1024
+	swapLabelLength  = 16
+	swapMinPages     = 10
+)
+
+// Swap creates a swap space (version 1 header) on the specified partition (or file).
+//
+// This is an equivalent of `mkswap`.
+func Swap(partname string, setters ...Option) error {
+	if partname == "" {
+		return errors.New("missing path to disk")
+	}
+
+	opts := NewDefaultOptions(setters...)
+
+	if len(opts.Label) > swapLabelLength {
+		return fmt.Errorf("swap label %q is too long: %d > %d", opts.Label, len(opts.Label), swapLabelLength)
+	}
+
+	f, err := os.OpenFile(partname, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("error getting size of %q: %w", partname, err)
+	}
+
+	pageSize := os.Getpagesize()
+	pages := size / int64(pageSize)
+
+	if pages < swapMinPages {
+		return fmt.Errorf("swap space on %q is too small: %d bytes", partname, size)
+	}
+
+	swapUUID, err := uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("error generating swap UUID: %w", err)
+	}
+
+	// the first page holds the header: boot bits, version info and the signature at the end of the page
+	header := make([]byte, pageSize)
+
+	binary.LittleEndian.PutUint32(header[swapHeaderOffset:], 1)                 // version
+	binary.LittleEndian.PutUint32(header[swapHeaderOffset+4:], uint32(pages-1)) // last page
+	binary.LittleEndian.PutUint32(header[swapHeaderOffset+8:], 0)               // number of bad pages
+	copy(header[swapHeaderOffset+12:], swapUUID[:])
+	copy(header[swapHeaderOffset+28:], opts.Label)
+	copy(header[pageSize-len(swapSignature):], swapSignature)
+
+	if _, err = f.WriteAt(header, 0); err != nil {
+		return fmt.Errorf("error writing swap header: %w", err)
+	}
+
+	return f.Sync()
+}
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package makefs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-blockdevice/v2/blkid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/makefs"
+)
+
+func TestSwap(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "swap.img")
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	const size = 64 * 1024 * 1024
+
+	require.NoError(t, f.Truncate(size))
+	require.NoError(t, f.Close())
+
+	require.NoError(t, makefs.Swap(path, makefs.WithLabel("s-swap1")))
+
+	info, err := blkid.ProbePath(path, blkid.WithSkipLocking(true))
+	require.NoError(t, err)
+
+	assert.Equal(t, makefs.FilesystemTypeSwap, info.Name)
+	require.NotNil(t, info.Label)
+	assert.Equal(t, "s-swap1", *info.Label)
+	assert.NotNil(t, info.UUID)
+	assert.EqualValues(t, size-os.Getpagesize(), info.ProbedSize)
+}
+
+func TestSwapTooSmall(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "swap.img")
+
+	require.NoError(t, os.WriteFile(path, make([]byte, 4096), 0o600))
+
+	assert.Error(t, makefs.Swap(path))
+}